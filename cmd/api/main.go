@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -13,11 +14,15 @@ import (
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/cors"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/config"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/handler"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/database"
 	authMiddleware "github.com/lucianoZgabriel/kitnet-manager/internal/pkg/middleware"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/notifier"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/scheduler"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/storage"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/webhook"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/postgres"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
 
@@ -48,6 +53,8 @@ import (
 
 // @tag.name Auth
 // @tag.description Autenticação e gerenciamento de usuários
+// @tag.name Properties
+// @tag.description Imóveis (prédios) que agrupam as unidades
 // @tag.name Units
 // @tag.description Operações relacionadas a unidades/kitnets
 // @tag.name Tenants
@@ -60,6 +67,14 @@ import (
 // @tag.description Métricas consolidadas e visão executiva
 // @tag.name Reports
 // @tag.description Relatórios financeiros e de pagamentos
+// @tag.name Maintenance
+// @tag.description Chamados de manutenção e ordens de serviço das unidades
+// @tag.name Inventory
+// @tag.description Inventário de móveis das unidades e checklists de entrada/saída
+// @tag.name Utilities
+// @tag.description Leituras de medidores, tarifas e cobrança de água e energia
+// @tag.name Renovations
+// @tag.description Projetos de reforma das unidades com orçamento e retorno do investimento
 
 // @tag.name Health
 // @tag.description Health check e status do sistema
@@ -97,15 +112,99 @@ func main() {
 	dashboardRepo := postgres.NewDashboardRepo(dbConn.DB)
 	userRepo := postgres.NewUserRepository(dbConn.DB)
 	adjustmentRepo := postgres.NewLeaseRentAdjustmentRepository(dbConn.DB)
+	maintenanceRepo := postgres.NewMaintenanceTicketRepo(dbConn.DB)
+	renovationRepo := postgres.NewRenovationProjectRepo(dbConn.DB)
+	statusHistoryRepo := postgres.NewUnitStatusHistoryRepo(dbConn.DB)
+	propertyRepo := postgres.NewPropertyRepo(dbConn.DB)
+	inventoryRepo := postgres.NewInventoryRepo(dbConn.DB)
+	utilityRepo := postgres.NewUtilityRepo(dbConn.DB)
+	depositSettlementRepo := postgres.NewDepositSettlementRepo(dbConn.DB)
+	loginCodeRepo := postgres.NewTenantLoginCodeRepo(dbConn.DB)
+	documentRepo := postgres.NewTenantDocumentRepo(dbConn.DB)
+	tenantProfileRepo := postgres.NewTenantProfileRepo(dbConn.DB)
+	tenantPrivacyRepo := postgres.NewTenantPrivacyRepo(dbConn.DB)
+	prospectRepo := postgres.NewProspectRepo(dbConn.DB)
+	searchRepo := postgres.NewSearchRepo(dbConn.DB)
+	notificationRepo := postgres.NewNotificationRepo(dbConn.DB)
+	dunningRepo := postgres.NewDunningRepo(dbConn.DB)
+	webhookRepo := postgres.NewWebhookRepo(dbConn.DB)
+	calendarFeedRepo := postgres.NewCalendarFeedRepo(dbConn.DB)
+	reportRepo := postgres.NewReportRepo(dbConn.DB)
+
+	// Storage
+	fileStorage, err := storage.NewLocalStorage(cfg.Storage.LocalPath)
+	if err != nil {
+		log.Fatal("Erro ao inicializar armazenamento de arquivos:", err)
+	}
 
 	// Service
-	unitService := service.NewUnitService(unitRepo)
+	unitService := service.NewUnitService(unitRepo, statusHistoryRepo, propertyRepo)
 	tenantService := service.NewTenantService(tenantRepo)
 	paymentService := service.NewPaymentService(paymentRepo, leaseRepo)
-	leaseService := service.NewLeaseService(leaseRepo, unitRepo, tenantRepo, paymentService, adjustmentRepo)
-	dashboardService := service.NewDashboardService(dashboardRepo, leaseRepo, paymentRepo, unitRepo)
-	reportService := service.NewReportService(paymentRepo, leaseRepo, unitRepo, tenantRepo)
+	inventoryService := service.NewInventoryService(inventoryRepo, unitRepo, leaseRepo)
+	leaseService := service.NewLeaseService(leaseRepo, unitRepo, tenantRepo, paymentService, adjustmentRepo, statusHistoryRepo, inventoryService)
+	dashboardService := service.NewDashboardService(dashboardRepo, leaseRepo, paymentRepo, unitRepo, statusHistoryRepo)
+	reportService := service.NewReportService(reportRepo, paymentRepo, leaseRepo, unitRepo, tenantRepo, propertyRepo)
+	maintenanceService := service.NewMaintenanceService(maintenanceRepo, unitRepo, leaseRepo, statusHistoryRepo)
+	renovationService := service.NewRenovationService(renovationRepo, unitRepo, statusHistoryRepo)
+	propertyService := service.NewPropertyService(propertyRepo)
+	utilityService := service.NewUtilityService(utilityRepo, unitRepo, leaseRepo, paymentRepo)
+	depositService := service.NewDepositService(leaseRepo, paymentRepo, depositSettlementRepo, inventoryService)
 	authService := service.NewAuthService(userRepo, cfg.JWT.Secret, cfg.JWT.Expiry)
+	portalAuthService := service.NewPortalAuthService(authService, userRepo, tenantRepo, loginCodeRepo)
+	portalService := service.NewPortalService(tenantRepo, leaseRepo, paymentRepo, unitRepo, propertyRepo, maintenanceRepo)
+	documentService := service.NewTenantDocumentService(documentRepo, tenantRepo, leaseRepo, fileStorage)
+	tenantProfileService := service.NewTenantProfileService(tenantRepo, tenantProfileRepo)
+	tenantScoreService := service.NewTenantScoreService(tenantRepo, leaseRepo, paymentRepo)
+	tenantPrivacyService := service.NewTenantPrivacyService(tenantRepo, leaseRepo, paymentRepo, tenantProfileRepo, documentRepo, maintenanceRepo, notificationRepo, prospectRepo, tenantPrivacyRepo, fileStorage)
+	prospectService := service.NewProspectService(prospectRepo, unitService, tenantService)
+	searchService := service.NewSearchService(searchRepo)
+	calendarService := service.NewCalendarService(calendarFeedRepo, userRepo, paymentRepo, leaseRepo, unitRepo, tenantRepo, prospectRepo)
+	notificationService := service.NewNotificationService(notificationRepo, paymentRepo, leaseRepo, tenantRepo, unitRepo)
+	notificationService.RegisterSender(domain.NotificationChannelInternal, notifier.NewLogSender(nil))
+	if cfg.Email.SMTPHost != "" {
+		emailSender, err := notifier.NewSMTPSender(notifier.SMTPConfig{
+			Host:     cfg.Email.SMTPHost,
+			Port:     cfg.Email.SMTPPort,
+			Username: cfg.Email.SMTPUsername,
+			Password: cfg.Email.SMTPPassword,
+			From:     cfg.Email.From,
+			FromName: cfg.Email.FromName,
+		})
+		if err != nil {
+			log.Fatal("Erro ao configurar envio de e-mail:", err)
+		}
+		notificationService.RegisterSender(domain.NotificationChannelEmail, emailSender)
+		log.Println("✅ Envio de e-mail habilitado")
+	}
+	if cfg.Messaging.ProviderURL != "" {
+		provider, err := notifier.NewHTTPProvider(notifier.HTTPProviderConfig{
+			URL:   cfg.Messaging.ProviderURL,
+			Token: cfg.Messaging.ProviderToken,
+		})
+		if err != nil {
+			log.Fatal("Erro ao configurar provedor de mensagens:", err)
+		}
+		for _, name := range strings.Split(cfg.Messaging.Channels, ",") {
+			channel := domain.NotificationChannel(strings.TrimSpace(name))
+			if channel != domain.NotificationChannelWhatsApp && channel != domain.NotificationChannelSMS {
+				log.Fatalf("Canal de mensagens inválido em MESSAGING_CHANNELS: %q", name)
+			}
+			notificationService.RegisterSender(channel, notifier.NewMessagingSender(provider, string(channel)))
+			log.Printf("✅ Envio por %s habilitado", channel)
+		}
+	}
+	notificationService.ConfigureDeliveryWebhook(cfg.Messaging.WebhookToken)
+	dunningService := service.NewDunningService(dunningRepo, paymentRepo, notificationService)
+
+	// Webhooks de saída: os services publicam eventos e o scheduler faz as entregas
+	webhookService := service.NewWebhookService(webhookRepo, webhook.NewHTTPSender(webhook.DefaultTimeout))
+	unitService.SetEventPublisher(webhookService)
+	paymentService.SetEventPublisher(webhookService)
+	leaseService.SetEventPublisher(webhookService)
+	maintenanceService.SetEventPublisher(webhookService)
+	renovationService.SetEventPublisher(webhookService)
+	depositService.SetEventPublisher(webhookService)
 
 	// Criar middleware de autenticação
 	authMiddleware := authMiddleware.NewAuthMiddleware(authService)
@@ -155,10 +254,10 @@ func main() {
 	))
 
 	// Iniciar scheduler de tarefas automáticas
-	taskScheduler := scheduler.New(paymentService, leaseService, cfg.Scheduler.IntervalHours)
+	taskScheduler := scheduler.New(paymentService, leaseService, notificationService, dunningService, webhookService, cfg.Scheduler.IntervalHours)
 
 	// Registrar rotas da aplicação
-	handler.SetupRoutes(r, unitService, tenantService, leaseService, paymentService, dashboardService, reportService, authService, authMiddleware, taskScheduler)
+	handler.SetupRoutes(r, propertyService, unitService, tenantService, leaseService, paymentService, dashboardService, reportService, maintenanceService, renovationService, inventoryService, utilityService, depositService, portalAuthService, portalService, documentService, tenantProfileService, tenantScoreService, tenantPrivacyService, prospectService, searchService, notificationService, dunningService, webhookService, calendarService, authService, authMiddleware, taskScheduler)
 
 	log.Println("✅ Rotas configuradas")
 	log.Printf("📚 Documentação Swagger: http://localhost:%s/swagger/index.html", cfg.Port)
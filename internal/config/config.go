@@ -16,6 +16,9 @@ type Config struct {
 	Database    DatabaseConfig
 	JWT         JWTConfig
 	Scheduler   SchedulerConfig
+	Storage     StorageConfig
+	Email       EmailConfig
+	Messaging   MessagingConfig
 }
 
 // JWTConfig contém configurações de autenticação JWT
@@ -37,6 +40,29 @@ type SchedulerConfig struct {
 	IntervalHours int // Intervalo em horas entre execuções (padrão: 24h = 1x ao dia)
 }
 
+// StorageConfig contém configurações do armazenamento de arquivos
+type StorageConfig struct {
+	LocalPath string // Diretório onde os documentos enviados são gravados
+}
+
+// EmailConfig contém configurações do servidor SMTP usado nas notificações por e-mail
+type EmailConfig struct {
+	SMTPHost     string // Vazio desabilita o canal de e-mail
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	From         string
+	FromName     string
+}
+
+// MessagingConfig contém configurações do provedor HTTP de WhatsApp/SMS
+type MessagingConfig struct {
+	ProviderURL   string // Vazio desabilita os canais de WhatsApp/SMS
+	ProviderToken string
+	Channels      string // Canais habilitados separados por vírgula (whatsapp, sms)
+	WebhookToken  string // Token exigido no webhook de status de entrega
+}
+
 // Load carrega as configurações do ambiente
 func Load() *Config {
 	// Carregar .env apenas em desenvolvimento
@@ -60,6 +86,23 @@ func Load() *Config {
 		Scheduler: SchedulerConfig{
 			IntervalHours: getEnvAsInt("SCHEDULER_INTERVAL_HOURS", 24), // Padrão: 1x ao dia
 		},
+		Storage: StorageConfig{
+			LocalPath: getEnvOrDefault("STORAGE_LOCAL_PATH", "./data/uploads"),
+		},
+		Email: EmailConfig{
+			SMTPHost:     getEnvOrDefault("SMTP_HOST", ""),
+			SMTPPort:     getEnvAsInt("SMTP_PORT", 587),
+			SMTPUsername: getEnvOrDefault("SMTP_USERNAME", ""),
+			SMTPPassword: getEnvOrDefault("SMTP_PASSWORD", ""),
+			From:         getEnvOrDefault("SMTP_FROM", ""),
+			FromName:     getEnvOrDefault("SMTP_FROM_NAME", "Kitnet Manager"),
+		},
+		Messaging: MessagingConfig{
+			ProviderURL:   getEnvOrDefault("MESSAGING_PROVIDER_URL", ""),
+			ProviderToken: getEnvOrDefault("MESSAGING_PROVIDER_TOKEN", ""),
+			Channels:      getEnvOrDefault("MESSAGING_CHANNELS", "whatsapp"),
+			WebhookToken:  getEnvOrDefault("MESSAGING_WEBHOOK_TOKEN", ""),
+		},
 	}
 }
 
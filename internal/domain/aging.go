@@ -0,0 +1,63 @@
+package domain
+
+import "time"
+
+// AgingBucket representa a faixa de atraso de um pagamento em aberto
+type AgingBucket string
+
+const (
+	AgingBucket0To30  AgingBucket = "0_30"    // Até 30 dias de atraso
+	AgingBucket31To60 AgingBucket = "31_60"   // De 31 a 60 dias
+	AgingBucket61To90 AgingBucket = "61_90"   // De 61 a 90 dias
+	AgingBucketOver90 AgingBucket = "over_90" // Mais de 90 dias
+)
+
+// AgingBuckets contém as faixas de atraso em ordem crescente
+var AgingBuckets = []AgingBucket{
+	AgingBucket0To30,
+	AgingBucket31To60,
+	AgingBucket61To90,
+	AgingBucketOver90,
+}
+
+var agingBucketLabels = map[AgingBucket]string{
+	AgingBucket0To30:  "0-30 dias",
+	AgingBucket31To60: "31-60 dias",
+	AgingBucket61To90: "61-90 dias",
+	AgingBucketOver90: "Mais de 90 dias",
+}
+
+// Label retorna a descrição da faixa de atraso para relatórios
+func (b AgingBucket) Label() string {
+	if label, ok := agingBucketLabels[b]; ok {
+		return label
+	}
+	return string(b)
+}
+
+// AgingBucketFor retorna a faixa correspondente aos dias de atraso
+func AgingBucketFor(daysPastDue int) AgingBucket {
+	switch {
+	case daysPastDue <= 30:
+		return AgingBucket0To30
+	case daysPastDue <= 60:
+		return AgingBucket31To60
+	case daysPastDue <= 90:
+		return AgingBucket61To90
+	default:
+		return AgingBucketOver90
+	}
+}
+
+// DaysPastDue retorna quantos dias corridos se passaram do vencimento até a data de referência
+// Considera apenas as datas (sem horário); pagamentos ainda não vencidos retornam zero
+func DaysPastDue(dueDate, asOf time.Time) int {
+	due := time.Date(dueDate.Year(), dueDate.Month(), dueDate.Day(), 0, 0, 0, 0, time.UTC)
+	ref := time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, time.UTC)
+
+	days := int(ref.Sub(due).Hours() / 24)
+	if days < 0 {
+		return 0
+	}
+	return days
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAgingBucketFor(t *testing.T) {
+	cases := map[int]AgingBucket{
+		1:   AgingBucket0To30,
+		30:  AgingBucket0To30,
+		31:  AgingBucket31To60,
+		60:  AgingBucket31To60,
+		61:  AgingBucket61To90,
+		90:  AgingBucket61To90,
+		91:  AgingBucketOver90,
+		400: AgingBucketOver90,
+	}
+	for days, expected := range cases {
+		assert.Equal(t, expected, AgingBucketFor(days), days)
+	}
+}
+
+func TestDaysPastDue(t *testing.T) {
+	asOf := time.Date(2025, 3, 31, 18, 30, 0, 0, time.UTC)
+
+	t.Run("should count calendar days ignoring time of day", func(t *testing.T) {
+		assert.Equal(t, 31, DaysPastDue(time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), asOf))
+		assert.Equal(t, 0, DaysPastDue(time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), asOf))
+	})
+
+	t.Run("should return zero before due date", func(t *testing.T) {
+		assert.Equal(t, 0, DaysPastDue(time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC), asOf))
+	})
+}
+
+func TestAgingBucketLabel(t *testing.T) {
+	assert.Equal(t, "31-60 dias", AgingBucket31To60.Label())
+	assert.Equal(t, "Mais de 90 dias", AgingBucketOver90.Label())
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Domain errors específicos dos feeds de calendário
+var (
+	ErrInvalidCalendarFeedToken = errors.New("invalid calendar feed token")
+)
+
+// CalendarFeed representa o feed iCalendar de um usuário
+// O token vai na URL do feed (aplicativos de calendário não enviam o header Authorization),
+// por isso apenas o hash é armazenado e gerar um novo token invalida a URL anterior
+type CalendarFeed struct {
+	UserID         uuid.UUID  `json:"user_id"`
+	TokenHash      string     `json:"-"`
+	LastAccessedAt *time.Time `json:"last_accessed_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// NewCalendarFeed cria o feed do usuário e retorna o token em texto plano, exibido uma única vez
+func NewCalendarFeed(userID uuid.UUID) (*CalendarFeed, string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	return &CalendarFeed{
+		UserID:    userID,
+		TokenHash: HashCalendarFeedToken(token),
+		CreatedAt: time.Now(),
+	}, token, nil
+}
+
+// HashCalendarFeedToken retorna o hash SHA-256 (hex) do token do feed
+func HashCalendarFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCalendarFeed(t *testing.T) {
+	userID := uuid.New()
+
+	feed, token, err := NewCalendarFeed(userID)
+
+	require.NoError(t, err)
+	assert.Equal(t, userID, feed.UserID)
+	assert.Len(t, token, 43)
+	assert.Equal(t, HashCalendarFeedToken(token), feed.TokenHash)
+	assert.NotContains(t, feed.TokenHash, token)
+	assert.Nil(t, feed.LastAccessedAt)
+
+	_, other, err := NewCalendarFeed(userID)
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+// TenantDocumentType identifica se o morador é pessoa física (CPF) ou jurídica (CNPJ)
+type TenantDocumentType string
+
+const (
+	DocumentTypeCPF  TenantDocumentType = "cpf"
+	DocumentTypeCNPJ TenantDocumentType = "cnpj"
+)
+
+// CNPJ regex pattern: XX.XXX.XXX/XXXX-XX (raiz e ordem podem ser alfanuméricas desde 2026)
+var cnpjRegex = regexp.MustCompile(`^[0-9A-Z]{2}\.[0-9A-Z]{3}\.[0-9A-Z]{3}/[0-9A-Z]{4}-\d{2}$`)
+
+// documentSeparators remove pontuação e espaços de CPF/CNPJ
+var documentSeparators = regexp.MustCompile(`[.\-/\s]`)
+
+var (
+	cpfDigitsRegex  = regexp.MustCompile(`^\d{11}$`)
+	cnpjCharsRegex  = regexp.MustCompile(`^[0-9A-Z]{12}\d{2}$`)
+	cnpjFirstWeight = []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	cnpjSecWeight   = []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+)
+
+// NormalizeDocument remove a formatação de um CPF ou CNPJ e aplica a máscara correspondente
+// Entradas que não têm o tamanho de um CPF ou CNPJ são devolvidas sem alteração
+func NormalizeDocument(document string) string {
+	raw := strings.ToUpper(documentSeparators.ReplaceAllString(document, ""))
+
+	switch {
+	case cpfDigitsRegex.MatchString(raw):
+		return raw[0:3] + "." + raw[3:6] + "." + raw[6:9] + "-" + raw[9:11]
+	case cnpjCharsRegex.MatchString(raw):
+		return raw[0:2] + "." + raw[2:5] + "." + raw[5:8] + "/" + raw[8:12] + "-" + raw[12:14]
+	}
+
+	return strings.TrimSpace(document)
+}
+
+// IsValidCPF verifica os dígitos verificadores do CPF (módulo 11)
+// Sequências de dígitos repetidos (ex: 111.111.111-11) são rejeitadas
+func IsValidCPF(cpf string) bool {
+	raw := documentSeparators.ReplaceAllString(cpf, "")
+	if !cpfDigitsRegex.MatchString(raw) || isRepeatedSequence(raw) {
+		return false
+	}
+
+	digits := make([]int, 11)
+	for i, c := range raw {
+		digits[i] = int(c - '0')
+	}
+
+	for _, size := range []int{9, 10} {
+		sum := 0
+		for i := 0; i < size; i++ {
+			sum += digits[i] * (size + 1 - i)
+		}
+		check := sum * 10 % 11
+		if check == 10 {
+			check = 0
+		}
+		if check != digits[size] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsValidCNPJ verifica os dígitos verificadores do CNPJ (módulo 11)
+// Aceita o formato alfanumérico, em que cada caractere vale seu código ASCII menos 48
+func IsValidCNPJ(cnpj string) bool {
+	raw := strings.ToUpper(documentSeparators.ReplaceAllString(cnpj, ""))
+	if !cnpjCharsRegex.MatchString(raw) || isRepeatedSequence(raw) {
+		return false
+	}
+
+	values := make([]int, 14)
+	for i, c := range raw {
+		values[i] = int(c - '0')
+	}
+
+	for _, weights := range [][]int{cnpjFirstWeight, cnpjSecWeight} {
+		sum := 0
+		for i, weight := range weights {
+			sum += values[i] * weight
+		}
+		check := 0
+		if rest := sum % 11; rest >= 2 {
+			check = 11 - rest
+		}
+		if check != values[len(weights)] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isRepeatedSequence verifica se todos os caracteres são iguais
+func isRepeatedSequence(s string) bool {
+	return strings.Count(s, s[:1]) == len(s)
+}
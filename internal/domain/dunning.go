@@ -0,0 +1,213 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DunningAction representa a ação executada em uma etapa da régua de cobrança
+type DunningAction string
+
+const (
+	DunningActionReminder       DunningAction = "reminder"        // Lembrete amigável antes do vencimento
+	DunningActionNotice         DunningAction = "notice"          // Aviso de pagamento em atraso
+	DunningActionFormalNotice   DunningAction = "formal_notice"   // Notificação formal de atraso
+	DunningActionEvictionReview DunningAction = "eviction_review" // Sinaliza o pagamento para análise de despejo (sem mensagem ao morador)
+)
+
+// CollectionActionStatus representa o resultado de uma etapa no histórico de cobrança
+type CollectionActionStatus string
+
+const (
+	CollectionActionExecuted CollectionActionStatus = "executed"
+	CollectionActionSkipped  CollectionActionStatus = "skipped" // Etapa superada por outra mais avançada
+)
+
+// ValidDunningActions contém todas as ações válidas da régua
+var ValidDunningActions = []DunningAction{
+	DunningActionReminder,
+	DunningActionNotice,
+	DunningActionFormalNotice,
+	DunningActionEvictionReview,
+}
+
+const (
+	// DunningMinOffsetDays é a maior antecedência permitida para uma etapa (D-30)
+	DunningMinOffsetDays = -30
+	// DunningMaxOffsetDays é o maior atraso permitido para uma etapa (D+365)
+	DunningMaxOffsetDays = 365
+)
+
+// dunningStepCodePattern aceita códigos em minúsculas com dígitos e sublinhado (ex: first_notice)
+var dunningStepCodePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,49}$`)
+
+// Domain errors específicos da régua de cobrança
+var (
+	ErrInvalidDunningAction   = errors.New("invalid dunning action")
+	ErrInvalidDunningStepCode = errors.New("dunning step code must be 2-50 lowercase letters, digits or underscores")
+	ErrInvalidDunningStepName = errors.New("dunning step name is required (max 100 characters)")
+	ErrInvalidDunningOffset   = fmt.Errorf("dunning step offset must be between %d and %d days", DunningMinOffsetDays, DunningMaxOffsetDays)
+)
+
+// DunningStep representa uma etapa da régua de cobrança, relativa ao vencimento do pagamento
+type DunningStep struct {
+	ID         uuid.UUID     `json:"id"`
+	Code       string        `json:"code"`
+	Name       string        `json:"name"`
+	OffsetDays int           `json:"offset_days"` // Negativo = antes do vencimento (D-3), positivo = após (D+5)
+	Action     DunningAction `json:"action"`
+	Active     bool          `json:"active"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+}
+
+// CollectionAction representa uma etapa da régua registrada no histórico de cobrança do pagamento
+type CollectionAction struct {
+	ID                   uuid.UUID              `json:"id"`
+	PaymentID            uuid.UUID              `json:"payment_id"`
+	StepID               uuid.UUID              `json:"step_id"`
+	StepCode             string                 `json:"step_code"`
+	StepName             string                 `json:"step_name"`
+	OffsetDays           int                    `json:"offset_days"`
+	Action               DunningAction          `json:"action"`
+	Status               CollectionActionStatus `json:"status"`
+	NotificationsCreated int                    `json:"notifications_created"`
+	Notes                *string                `json:"notes,omitempty"`
+	ExecutedAt           time.Time              `json:"executed_at"`
+	CreatedAt            time.Time              `json:"created_at"`
+}
+
+// IsValidDunningAction verifica se a ação é válida
+func IsValidDunningAction(action DunningAction) bool {
+	for _, valid := range ValidDunningActions {
+		if action == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// NewDunningStep cria uma nova etapa ativa da régua de cobrança
+func NewDunningStep(code, name string, offsetDays int, action DunningAction) (*DunningStep, error) {
+	code = strings.TrimSpace(code)
+	if !dunningStepCodePattern.MatchString(code) {
+		return nil, ErrInvalidDunningStepCode
+	}
+
+	now := time.Now()
+	step := &DunningStep{
+		ID:        uuid.New(),
+		Code:      code,
+		Active:    true,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := step.Update(name, offsetDays, action, true); err != nil {
+		return nil, err
+	}
+
+	return step, nil
+}
+
+// Update altera nome, prazo, ação e situação da etapa (o código é imutável)
+func (s *DunningStep) Update(name string, offsetDays int, action DunningAction, active bool) error {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return ErrInvalidDunningStepName
+	}
+	if offsetDays < DunningMinOffsetDays || offsetDays > DunningMaxOffsetDays {
+		return ErrInvalidDunningOffset
+	}
+	if !IsValidDunningAction(action) {
+		return ErrInvalidDunningAction
+	}
+
+	s.Name = name
+	s.OffsetDays = offsetDays
+	s.Action = action
+	s.Active = active
+	s.UpdatedAt = time.Now()
+	return nil
+}
+
+// Label retorna a etapa no formato D-3 / D+5
+func (s *DunningStep) Label() string {
+	if s.OffsetDays < 0 {
+		return fmt.Sprintf("D%d", s.OffsetDays)
+	}
+	return fmt.Sprintf("D+%d", s.OffsetDays)
+}
+
+// NewCollectionAction registra a etapa da régua executada (ou pulada) para o pagamento
+func NewCollectionAction(paymentID uuid.UUID, step *DunningStep, status CollectionActionStatus, notificationsCreated int, notes string, executedAt time.Time) *CollectionAction {
+	action := &CollectionAction{
+		ID:                   uuid.New(),
+		PaymentID:            paymentID,
+		StepID:               step.ID,
+		StepCode:             step.Code,
+		StepName:             step.Name,
+		OffsetDays:           step.OffsetDays,
+		Action:               step.Action,
+		Status:               status,
+		NotificationsCreated: notificationsCreated,
+		ExecutedAt:           executedAt,
+		CreatedAt:            time.Now(),
+	}
+	if notes = strings.TrimSpace(notes); notes != "" {
+		action.Notes = &notes
+	}
+	return action
+}
+
+// DaysFromDueDate retorna quantos dias se passaram desde o vencimento (negativo = antes do vencimento)
+func DaysFromDueDate(dueDate, today time.Time) int {
+	due := time.Date(dueDate.Year(), dueDate.Month(), dueDate.Day(), 0, 0, 0, 0, time.UTC)
+	day := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	return int(day.Sub(due).Hours() / 24)
+}
+
+// PlanDunning define as etapas da régua a aplicar hoje a um pagamento em aberto, a partir do histórico de cobrança
+// Apenas a etapa mais avançada já alcançada é executada; as anteriores ainda não executadas
+// são puladas, evitando várias mensagens no mesmo dia (ex: scheduler parado por alguns dias)
+func PlanDunning(steps []*DunningStep, history []*CollectionAction, payment *Payment, today time.Time) (*DunningStep, []*DunningStep) {
+	if !payment.CanBePaid() {
+		return nil, nil
+	}
+
+	done := make(map[uuid.UUID]bool, len(history))
+	lastDoneOffset := DunningMinOffsetDays - 1
+	for _, action := range history {
+		done[action.StepID] = true
+		if action.OffsetDays > lastDoneOffset {
+			lastDoneOffset = action.OffsetDays
+		}
+	}
+
+	days := DaysFromDueDate(payment.DueDate, today)
+	reached := make([]*DunningStep, 0, len(steps))
+	for _, step := range steps {
+		if step.Active && !done[step.ID] && step.OffsetDays <= days {
+			reached = append(reached, step)
+		}
+	}
+	if len(reached) == 0 {
+		return nil, nil
+	}
+
+	sort.SliceStable(reached, func(i, j int) bool {
+		return reached[i].OffsetDays < reached[j].OffsetDays
+	})
+
+	// Etapas anteriores a uma etapa já registrada (ex: criadas depois) também são puladas
+	last := reached[len(reached)-1]
+	if last.OffsetDays < lastDoneOffset {
+		return nil, reached
+	}
+	return last, reached[:len(reached)-1]
+}
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDunningLadder(t *testing.T) []*DunningStep {
+	definitions := []struct {
+		code   string
+		offset int
+		action DunningAction
+	}{
+		{"friendly_reminder", -3, DunningActionReminder},
+		{"first_notice", 1, DunningActionNotice},
+		{"second_notice", 5, DunningActionNotice},
+		{"formal_notice", 15, DunningActionFormalNotice},
+		{"eviction_review", 30, DunningActionEvictionReview},
+	}
+
+	steps := make([]*DunningStep, 0, len(definitions))
+	for _, d := range definitions {
+		step, err := NewDunningStep(d.code, "Etapa "+d.code, d.offset, d.action)
+		require.NoError(t, err)
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+func TestNewDunningStep(t *testing.T) {
+	t.Run("should create active step", func(t *testing.T) {
+		step, err := NewDunningStep("second_notice", " Segundo aviso ", 5, DunningActionNotice)
+
+		require.NoError(t, err)
+		assert.True(t, step.Active)
+		assert.Equal(t, "Segundo aviso", step.Name)
+		assert.Equal(t, "D+5", step.Label())
+	})
+
+	t.Run("should validate fields", func(t *testing.T) {
+		_, err := NewDunningStep("Second Notice", "Segundo aviso", 5, DunningActionNotice)
+		assert.Equal(t, ErrInvalidDunningStepCode, err)
+
+		_, err = NewDunningStep("second_notice", " ", 5, DunningActionNotice)
+		assert.Equal(t, ErrInvalidDunningStepName, err)
+
+		_, err = NewDunningStep("second_notice", "Segundo aviso", -31, DunningActionNotice)
+		assert.Equal(t, ErrInvalidDunningOffset, err)
+
+		_, err = NewDunningStep("second_notice", "Segundo aviso", 5, DunningAction("sue"))
+		assert.Equal(t, ErrInvalidDunningAction, err)
+	})
+
+	t.Run("should label steps before due date", func(t *testing.T) {
+		step, err := NewDunningStep("friendly_reminder", "Lembrete", -3, DunningActionReminder)
+		require.NoError(t, err)
+		assert.Equal(t, "D-3", step.Label())
+	})
+}
+
+func TestDaysFromDueDate(t *testing.T) {
+	due := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, -3, DaysFromDueDate(due, time.Date(2026, 3, 7, 23, 59, 0, 0, time.UTC)))
+	assert.Equal(t, 0, DaysFromDueDate(due, time.Date(2026, 3, 10, 8, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 21, DaysFromDueDate(due, time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestPlanDunning(t *testing.T) {
+	steps := newTestDunningLadder(t)
+	due := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	payment := &Payment{ID: uuid.New(), Status: PaymentStatusOverdue, DueDate: due}
+
+	t.Run("should run reached step", func(t *testing.T) {
+		run, skipped := PlanDunning(steps, nil, &Payment{Status: PaymentStatusPending, DueDate: due}, due.AddDate(0, 0, -3))
+
+		require.NotNil(t, run)
+		assert.Equal(t, "friendly_reminder", run.Code)
+		assert.Empty(t, skipped)
+	})
+
+	t.Run("should do nothing before first step or after executing it", func(t *testing.T) {
+		run, _ := PlanDunning(steps, nil, payment, due.AddDate(0, 0, -4))
+		assert.Nil(t, run)
+
+		history := []*CollectionAction{NewCollectionAction(payment.ID, steps[0], CollectionActionExecuted, 1, "", due)}
+		run, skipped := PlanDunning(steps, history, payment, due)
+		assert.Nil(t, run)
+		assert.Empty(t, skipped)
+	})
+
+	t.Run("should run only the most advanced step and skip the others", func(t *testing.T) {
+		history := []*CollectionAction{NewCollectionAction(payment.ID, steps[0], CollectionActionExecuted, 1, "", due)}
+
+		run, skipped := PlanDunning(steps, history, payment, due.AddDate(0, 0, 16))
+
+		require.NotNil(t, run)
+		assert.Equal(t, "formal_notice", run.Code)
+		require.Len(t, skipped, 2)
+		assert.Equal(t, "first_notice", skipped[0].Code)
+		assert.Equal(t, "second_notice", skipped[1].Code)
+	})
+
+	t.Run("should skip steps added before an executed step", func(t *testing.T) {
+		history := []*CollectionAction{NewCollectionAction(payment.ID, steps[2], CollectionActionExecuted, 1, "", due)}
+		late, err := NewDunningStep("extra_notice", "Aviso extra", 3, DunningActionNotice)
+		require.NoError(t, err)
+
+		run, skipped := PlanDunning(append(steps[:2:2], late), history, payment, due.AddDate(0, 0, 6))
+
+		assert.Nil(t, run)
+		require.Len(t, skipped, 3)
+		assert.Equal(t, "extra_notice", skipped[2].Code)
+	})
+
+	t.Run("should ignore inactive steps and settled payments", func(t *testing.T) {
+		inactive := *steps[1]
+		inactive.Active = false
+
+		run, _ := PlanDunning([]*DunningStep{&inactive}, nil, payment, due.AddDate(0, 0, 2))
+		assert.Nil(t, run)
+
+		run, skipped := PlanDunning(steps, nil, &Payment{Status: PaymentStatusPaid, DueDate: due}, due.AddDate(0, 0, 40))
+		assert.Nil(t, run)
+		assert.Nil(t, skipped)
+	})
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// paymentTypeLabels contém a descrição em português de cada tipo de pagamento
+var paymentTypeLabels = map[PaymentType]string{
+	PaymentTypeRent:            "Aluguel",
+	PaymentTypePaintingFee:     "Taxa de pintura",
+	PaymentTypeAdjustment:      "Ajuste",
+	PaymentTypeUtility:         "Consumo (água/luz)",
+	PaymentTypeSecurityDeposit: "Caução",
+}
+
+// paymentMethodLabels contém a descrição em português de cada forma de pagamento
+var paymentMethodLabels = map[PaymentMethod]string{
+	PaymentMethodPix:          "PIX",
+	PaymentMethodCash:         "Dinheiro",
+	PaymentMethodBankTransfer: "Transferência bancária",
+	PaymentMethodCreditCard:   "Cartão de crédito",
+
+	PaymentMethodSecurityDeposit: "Caução",
+}
+
+// paymentStatusLabels contém a descrição em português de cada status de pagamento
+var paymentStatusLabels = map[PaymentStatus]string{
+	PaymentStatusPending:   "Pendente",
+	PaymentStatusPaid:      "Pago",
+	PaymentStatusOverdue:   "Em atraso",
+	PaymentStatusCancelled: "Cancelado",
+}
+
+// Label retorna a descrição do tipo de pagamento para mensagens e relatórios
+func (t PaymentType) Label() string {
+	if label, ok := paymentTypeLabels[t]; ok {
+		return label
+	}
+	return string(t)
+}
+
+// Label retorna a descrição da forma de pagamento para mensagens e relatórios
+func (m PaymentMethod) Label() string {
+	if label, ok := paymentMethodLabels[m]; ok {
+		return label
+	}
+	return string(m)
+}
+
+// Label retorna a descrição do status de pagamento para relatórios
+func (s PaymentStatus) Label() string {
+	if label, ok := paymentStatusLabels[s]; ok {
+		return label
+	}
+	return string(s)
+}
+
+// FormatBRL formata um valor no padrão brasileiro (ex: R$ 1.250,00)
+func FormatBRL(amount decimal.Decimal) string {
+	if amount.IsNegative() {
+		return "-R$ " + FormatDecimalBR(amount.Abs())
+	}
+	return "R$ " + FormatDecimalBR(amount)
+}
+
+// FormatDecimalBR formata um valor com duas casas decimais, ponto de milhar e vírgula decimal
+func FormatDecimalBR(amount decimal.Decimal) string {
+	fixed := amount.Abs().StringFixed(2)
+	integer, cents, _ := strings.Cut(fixed, ".")
+
+	var grouped strings.Builder
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			grouped.WriteByte('.')
+		}
+		grouped.WriteRune(digit)
+	}
+
+	sign := ""
+	if amount.IsNegative() {
+		sign = "-"
+	}
+	return sign + grouped.String() + "," + cents
+}
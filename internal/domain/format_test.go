@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatBRL(t *testing.T) {
+	cases := map[string]string{
+		"0":          "R$ 0,00",
+		"850":        "R$ 850,00",
+		"1250.5":     "R$ 1.250,50",
+		"1234567.89": "R$ 1.234.567,89",
+		"-99.9":      "-R$ 99,90",
+	}
+	for value, expected := range cases {
+		assert.Equal(t, expected, FormatBRL(decimal.RequireFromString(value)), value)
+	}
+}
+
+func TestPaymentLabels(t *testing.T) {
+	assert.Equal(t, "Aluguel", PaymentTypeRent.Label())
+	assert.Equal(t, "PIX", PaymentMethodPix.Label())
+	assert.Equal(t, "Em atraso", PaymentStatusOverdue.Label())
+	assert.Equal(t, "other", PaymentType("other").Label())
+}
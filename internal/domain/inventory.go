@@ -0,0 +1,380 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// InventoryItemType representa o tipo de um item do inventário da unidade
+type InventoryItemType string
+
+const (
+	InventoryItemTypeFurniture   InventoryItemType = "furniture"
+	InventoryItemTypeAppliance   InventoryItemType = "appliance"
+	InventoryItemTypeElectronics InventoryItemType = "electronics"
+	InventoryItemTypeFixture     InventoryItemType = "fixture"
+	InventoryItemTypeOther       InventoryItemType = "other"
+)
+
+// ItemCondition representa o estado de conservação de um item
+type ItemCondition string
+
+const (
+	ItemConditionNew     ItemCondition = "new"
+	ItemConditionGood    ItemCondition = "good"
+	ItemConditionFair    ItemCondition = "fair"
+	ItemConditionDamaged ItemCondition = "damaged"
+	ItemConditionBroken  ItemCondition = "broken"
+)
+
+// ChecklistType representa o momento da conferência do inventário
+type ChecklistType string
+
+const (
+	ChecklistTypeMoveIn  ChecklistType = "move_in"
+	ChecklistTypeMoveOut ChecklistType = "move_out"
+)
+
+// InventoryIssue representa o tipo de divergência encontrada na saída do morador
+type InventoryIssue string
+
+const (
+	InventoryIssueMissing InventoryIssue = "missing"
+	InventoryIssueDamaged InventoryIssue = "damaged"
+)
+
+// ValidInventoryItemTypes contém todos os tipos de item válidos
+var ValidInventoryItemTypes = []InventoryItemType{
+	InventoryItemTypeFurniture,
+	InventoryItemTypeAppliance,
+	InventoryItemTypeElectronics,
+	InventoryItemTypeFixture,
+	InventoryItemTypeOther,
+}
+
+// itemConditionRank ordena os estados do melhor para o pior
+var itemConditionRank = map[ItemCondition]int{
+	ItemConditionNew:     0,
+	ItemConditionGood:    1,
+	ItemConditionFair:    2,
+	ItemConditionDamaged: 3,
+	ItemConditionBroken:  4,
+}
+
+// UnitInventoryItem representa um móvel ou eletrodoméstico que mobilia a unidade
+type UnitInventoryItem struct {
+	ID            uuid.UUID         `json:"id"`
+	UnitID        uuid.UUID         `json:"unit_id"`
+	Name          string            `json:"name"`
+	Type          InventoryItemType `json:"item_type"`
+	Brand         *string           `json:"brand,omitempty"`
+	SerialNumber  *string           `json:"serial_number,omitempty"`
+	Condition     ItemCondition     `json:"condition"`
+	PurchaseDate  *time.Time        `json:"purchase_date,omitempty"`
+	PurchaseValue *decimal.Decimal  `json:"purchase_value,omitempty"`
+	Notes         *string           `json:"notes,omitempty"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+}
+
+// InventoryChecklist representa a conferência do inventário na entrada ou saída do morador
+type InventoryChecklist struct {
+	ID          uuid.UUID                 `json:"id"`
+	LeaseID     uuid.UUID                 `json:"lease_id"`
+	UnitID      uuid.UUID                 `json:"unit_id"`
+	Type        ChecklistType             `json:"checklist_type"`
+	Notes       *string                   `json:"notes,omitempty"`
+	PerformedBy *uuid.UUID                `json:"performed_by,omitempty"`
+	PerformedAt time.Time                 `json:"performed_at"`
+	Items       []*InventoryChecklistItem `json:"items"`
+	CreatedAt   time.Time                 `json:"created_at"`
+}
+
+// InventoryChecklistItem representa o estado de um item no momento da conferência
+type InventoryChecklistItem struct {
+	ID               uuid.UUID         `json:"id"`
+	ChecklistID      uuid.UUID         `json:"checklist_id"`
+	ItemID           *uuid.UUID        `json:"item_id,omitempty"` // nil se o item foi removido do inventário
+	ItemName         string            `json:"item_name"`
+	ItemType         InventoryItemType `json:"item_type"`
+	Brand            *string           `json:"brand,omitempty"`
+	SerialNumber     *string           `json:"serial_number,omitempty"`
+	Condition        ItemCondition     `json:"condition"`
+	Present          bool              `json:"present"`
+	ReplacementValue *decimal.Decimal  `json:"replacement_value,omitempty"`
+	Notes            *string           `json:"notes,omitempty"`
+}
+
+// InventoryDiscrepancy representa um item faltante ou danificado na saída do morador
+type InventoryDiscrepancy struct {
+	ItemID             *uuid.UUID      `json:"item_id,omitempty"`
+	ItemName           string          `json:"item_name"`
+	Issue              InventoryIssue  `json:"issue"`
+	ConditionAtMoveIn  ItemCondition   `json:"condition_at_move_in"`
+	ConditionAtMoveOut *ItemCondition  `json:"condition_at_move_out,omitempty"` // nil quando o item não foi encontrado
+	ReplacementCost    decimal.Decimal `json:"replacement_cost"`
+	Notes              *string         `json:"notes,omitempty"`
+}
+
+// Domain errors específicos do inventário
+var (
+	ErrInvalidInventoryItemName = errors.New("inventory item name cannot be empty")
+	ErrInvalidInventoryItemType = errors.New("invalid inventory item type")
+	ErrInvalidItemCondition     = errors.New("invalid item condition")
+	ErrInvalidPurchaseValue     = errors.New("purchase value cannot be negative")
+	ErrInvalidReplacementValue  = errors.New("replacement value cannot be negative")
+	ErrChecklistItemNotInMoveIn = errors.New("checklist item is not part of the move-in checklist")
+)
+
+// NewUnitInventoryItem cria um novo item no inventário da unidade
+func NewUnitInventoryItem(unitID uuid.UUID, name string, itemType InventoryItemType, condition ItemCondition) (*UnitInventoryItem, error) {
+	now := time.Now()
+	item := &UnitInventoryItem{
+		ID:        uuid.New(),
+		UnitID:    unitID,
+		Name:      strings.TrimSpace(name),
+		Type:      itemType,
+		Condition: condition,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := item.Validate(); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// Validate verifica se o item possui dados válidos
+func (i *UnitInventoryItem) Validate() error {
+	if i.Name == "" {
+		return ErrInvalidInventoryItemName
+	}
+
+	if !IsValidInventoryItemType(i.Type) {
+		return ErrInvalidInventoryItemType
+	}
+
+	if !IsValidItemCondition(i.Condition) {
+		return ErrInvalidItemCondition
+	}
+
+	if i.PurchaseValue != nil && i.PurchaseValue.IsNegative() {
+		return ErrInvalidPurchaseValue
+	}
+
+	return nil
+}
+
+// IsValidInventoryItemType verifica se o tipo de item é válido
+func IsValidInventoryItemType(itemType InventoryItemType) bool {
+	for _, t := range ValidInventoryItemTypes {
+		if itemType == t {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidItemCondition verifica se o estado de conservação é válido
+func IsValidItemCondition(condition ItemCondition) bool {
+	_, ok := itemConditionRank[condition]
+	return ok
+}
+
+// IsWorseThan verifica se o estado é pior que o informado
+func (c ItemCondition) IsWorseThan(other ItemCondition) bool {
+	return itemConditionRank[c] > itemConditionRank[other]
+}
+
+// RequiresRepair verifica se o estado exige conserto ou reposição (desgaste normal não conta)
+func (c ItemCondition) RequiresRepair() bool {
+	return c == ItemConditionDamaged || c == ItemConditionBroken
+}
+
+// NewMoveInChecklist cria o checklist de entrada copiando o inventário atual da unidade
+func NewMoveInChecklist(leaseID, unitID uuid.UUID, items []*UnitInventoryItem, performedBy *uuid.UUID) *InventoryChecklist {
+	now := time.Now()
+	checklist := &InventoryChecklist{
+		ID:          uuid.New(),
+		LeaseID:     leaseID,
+		UnitID:      unitID,
+		Type:        ChecklistTypeMoveIn,
+		PerformedBy: performedBy,
+		PerformedAt: now,
+		Items:       make([]*InventoryChecklistItem, 0, len(items)),
+		CreatedAt:   now,
+	}
+
+	for _, item := range items {
+		itemID := item.ID
+		checklist.Items = append(checklist.Items, &InventoryChecklistItem{
+			ID:               uuid.New(),
+			ChecklistID:      checklist.ID,
+			ItemID:           &itemID,
+			ItemName:         item.Name,
+			ItemType:         item.Type,
+			Brand:            item.Brand,
+			SerialNumber:     item.SerialNumber,
+			Condition:        item.Condition,
+			Present:          true,
+			ReplacementValue: item.PurchaseValue,
+			Notes:            item.Notes,
+		})
+	}
+
+	return checklist
+}
+
+// MoveOutItemCheck representa a conferência de um item na saída do morador
+type MoveOutItemCheck struct {
+	ItemID           uuid.UUID
+	Present          bool
+	Condition        ItemCondition
+	ReplacementValue *decimal.Decimal // sobrescreve o valor do checklist de entrada
+	Notes            *string
+}
+
+// NewMoveOutChecklist cria o checklist de saída a partir do checklist de entrada
+// O contrato pode ser uma renovação do contrato em que foi feita a entrada
+// Itens não conferidos explicitamente são considerados presentes no mesmo estado da entrada
+func NewMoveOutChecklist(leaseID uuid.UUID, moveIn *InventoryChecklist, checks []MoveOutItemCheck, notes *string, performedBy *uuid.UUID) (*InventoryChecklist, error) {
+	byItemID := make(map[uuid.UUID]MoveOutItemCheck, len(checks))
+	for _, check := range checks {
+		byItemID[check.ItemID] = check
+	}
+
+	now := time.Now()
+	checklist := &InventoryChecklist{
+		ID:          uuid.New(),
+		LeaseID:     leaseID,
+		UnitID:      moveIn.UnitID,
+		Type:        ChecklistTypeMoveOut,
+		Notes:       notes,
+		PerformedBy: performedBy,
+		PerformedAt: now,
+		Items:       make([]*InventoryChecklistItem, 0, len(moveIn.Items)),
+		CreatedAt:   now,
+	}
+
+	matched := 0
+	for _, entry := range moveIn.Items {
+		item := &InventoryChecklistItem{
+			ID:               uuid.New(),
+			ChecklistID:      checklist.ID,
+			ItemID:           entry.ItemID,
+			ItemName:         entry.ItemName,
+			ItemType:         entry.ItemType,
+			Brand:            entry.Brand,
+			SerialNumber:     entry.SerialNumber,
+			Condition:        entry.Condition,
+			Present:          true,
+			ReplacementValue: entry.ReplacementValue,
+		}
+
+		if entry.ItemID != nil {
+			if check, ok := byItemID[*entry.ItemID]; ok {
+				matched++
+				if !IsValidItemCondition(check.Condition) {
+					return nil, ErrInvalidItemCondition
+				}
+				if check.ReplacementValue != nil {
+					if check.ReplacementValue.IsNegative() {
+						return nil, ErrInvalidReplacementValue
+					}
+					item.ReplacementValue = check.ReplacementValue
+				}
+				item.Present = check.Present
+				item.Condition = check.Condition
+				item.Notes = check.Notes
+			}
+		}
+
+		checklist.Items = append(checklist.Items, item)
+	}
+
+	if matched != len(byItemID) {
+		return nil, ErrChecklistItemNotInMoveIn
+	}
+
+	return checklist, nil
+}
+
+// CompareChecklists lista os itens faltantes ou danificados entre a entrada e a saída
+// Desgaste normal (ex: good -> fair) não gera cobrança
+func CompareChecklists(moveIn, moveOut *InventoryChecklist) []*InventoryDiscrepancy {
+	outByItemID := make(map[uuid.UUID]*InventoryChecklistItem, len(moveOut.Items))
+	for _, item := range moveOut.Items {
+		if item.ItemID != nil {
+			outByItemID[*item.ItemID] = item
+		}
+	}
+
+	discrepancies := make([]*InventoryDiscrepancy, 0)
+	for _, in := range moveIn.Items {
+		if in.ItemID == nil {
+			continue
+		}
+
+		out, ok := outByItemID[*in.ItemID]
+		if !ok || !out.Present {
+			discrepancies = append(discrepancies, &InventoryDiscrepancy{
+				ItemID:            in.ItemID,
+				ItemName:          in.ItemName,
+				Issue:             InventoryIssueMissing,
+				ConditionAtMoveIn: in.Condition,
+				ReplacementCost:   replacementCost(in, out),
+				Notes:             notesOf(out),
+			})
+			continue
+		}
+
+		if out.Condition.RequiresRepair() && out.Condition.IsWorseThan(in.Condition) {
+			condition := out.Condition
+			discrepancies = append(discrepancies, &InventoryDiscrepancy{
+				ItemID:             in.ItemID,
+				ItemName:           in.ItemName,
+				Issue:              InventoryIssueDamaged,
+				ConditionAtMoveIn:  in.Condition,
+				ConditionAtMoveOut: &condition,
+				ReplacementCost:    replacementCost(in, out),
+				Notes:              out.Notes,
+			})
+		}
+	}
+
+	return discrepancies
+}
+
+// TotalReplacementCost soma o custo de reposição das divergências
+func TotalReplacementCost(discrepancies []*InventoryDiscrepancy) decimal.Decimal {
+	total := decimal.Zero
+	for _, d := range discrepancies {
+		total = total.Add(d.ReplacementCost)
+	}
+	return total
+}
+
+// replacementCost prioriza o valor informado na saída e usa o da entrada como padrão
+func replacementCost(in, out *InventoryChecklistItem) decimal.Decimal {
+	if out != nil && out.ReplacementValue != nil {
+		return *out.ReplacementValue
+	}
+	if in.ReplacementValue != nil {
+		return *in.ReplacementValue
+	}
+	return decimal.Zero
+}
+
+// notesOf retorna as observações do item conferido, se houver
+func notesOf(item *InventoryChecklistItem) *string {
+	if item == nil {
+		return nil
+	}
+	return item.Notes
+}
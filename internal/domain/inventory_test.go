@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUnitInventoryItem(t *testing.T) {
+	unitID := uuid.New()
+
+	t.Run("should create item", func(t *testing.T) {
+		item, err := NewUnitInventoryItem(unitID, "  Geladeira  ", InventoryItemTypeAppliance, ItemConditionGood)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Geladeira", item.Name)
+		assert.Equal(t, unitID, item.UnitID)
+	})
+
+	t.Run("should fail with empty name", func(t *testing.T) {
+		item, err := NewUnitInventoryItem(unitID, " ", InventoryItemTypeAppliance, ItemConditionGood)
+
+		assert.Nil(t, item)
+		assert.Equal(t, ErrInvalidInventoryItemName, err)
+	})
+
+	t.Run("should fail with invalid type", func(t *testing.T) {
+		item, err := NewUnitInventoryItem(unitID, "Geladeira", InventoryItemType("vehicle"), ItemConditionGood)
+
+		assert.Nil(t, item)
+		assert.Equal(t, ErrInvalidInventoryItemType, err)
+	})
+
+	t.Run("should fail with invalid condition", func(t *testing.T) {
+		item, err := NewUnitInventoryItem(unitID, "Geladeira", InventoryItemTypeAppliance, ItemCondition("excellent"))
+
+		assert.Nil(t, item)
+		assert.Equal(t, ErrInvalidItemCondition, err)
+	})
+}
+
+func TestCompareChecklists(t *testing.T) {
+	unitID := uuid.New()
+	newItem := func(name string, condition ItemCondition, value int64) *UnitInventoryItem {
+		item, err := NewUnitInventoryItem(unitID, name, InventoryItemTypeFurniture, condition)
+		require.NoError(t, err)
+		purchase := decimal.NewFromInt(value)
+		item.PurchaseValue = &purchase
+		return item
+	}
+
+	bed := newItem("Cama", ItemConditionGood, 900)
+	wardrobe := newItem("Guarda-roupa", ItemConditionGood, 1200)
+	microwave := newItem("Micro-ondas", ItemConditionNew, 500)
+	chair := newItem("Cadeira", ItemConditionGood, 150)
+
+	moveIn := NewMoveInChecklist(uuid.New(), unitID, []*UnitInventoryItem{bed, wardrobe, microwave, chair}, nil)
+	require.Len(t, moveIn.Items, 4)
+	assert.Equal(t, ChecklistTypeMoveIn, moveIn.Type)
+
+	t.Run("should list missing and damaged items ignoring normal wear", func(t *testing.T) {
+		repairCost := decimal.NewFromInt(300)
+		moveOut, err := NewMoveOutChecklist(moveIn.LeaseID, moveIn, []MoveOutItemCheck{
+			{ItemID: bed.ID, Present: true, Condition: ItemConditionFair},
+			{ItemID: wardrobe.ID, Present: true, Condition: ItemConditionDamaged, ReplacementValue: &repairCost},
+			{ItemID: microwave.ID, Present: false, Condition: ItemConditionNew},
+		}, nil, nil)
+		require.NoError(t, err)
+
+		discrepancies := CompareChecklists(moveIn, moveOut)
+
+		require.Len(t, discrepancies, 2)
+		assert.Equal(t, "Guarda-roupa", discrepancies[0].ItemName)
+		assert.Equal(t, InventoryIssueDamaged, discrepancies[0].Issue)
+		assert.True(t, discrepancies[0].ReplacementCost.Equal(repairCost))
+		assert.Equal(t, "Micro-ondas", discrepancies[1].ItemName)
+		assert.Equal(t, InventoryIssueMissing, discrepancies[1].Issue)
+		assert.True(t, discrepancies[1].ReplacementCost.Equal(decimal.NewFromInt(500)))
+		assert.True(t, TotalReplacementCost(discrepancies).Equal(decimal.NewFromInt(800)))
+	})
+
+	t.Run("should reject items outside the move-in checklist", func(t *testing.T) {
+		moveOut, err := NewMoveOutChecklist(moveIn.LeaseID, moveIn, []MoveOutItemCheck{
+			{ItemID: uuid.New(), Present: true, Condition: ItemConditionGood},
+		}, nil, nil)
+
+		assert.Nil(t, moveOut)
+		assert.Equal(t, ErrChecklistItemNotInMoveIn, err)
+	})
+}
@@ -28,22 +28,26 @@ var ValidLeaseStatuses = []LeaseStatus{
 
 // Lease representa um contrato de locação entre uma unidade e um morador
 type Lease struct {
-	ID                      uuid.UUID       `json:"id"`
-	UnitID                  uuid.UUID       `json:"unit_id"`
-	TenantID                uuid.UUID       `json:"tenant_id"`
-	ContractSignedDate      time.Time       `json:"contract_signed_date"`
-	StartDate               time.Time       `json:"start_date"`
-	EndDate                 time.Time       `json:"end_date"`
-	PaymentDueDay           int             `json:"payment_due_day"`
-	MonthlyRentValue        decimal.Decimal `json:"monthly_rent_value"`
-	PaintingFeeTotal        decimal.Decimal `json:"painting_fee_total"`
-	PaintingFeeInstallments int             `json:"painting_fee_installments"`
-	PaintingFeePaid         decimal.Decimal `json:"painting_fee_paid"`
-	Status                  LeaseStatus     `json:"status"`
-	ParentLeaseID           *uuid.UUID      `json:"parent_lease_id,omitempty"` // ID do contrato anterior (renovação)
-	Generation              int             `json:"generation"`                // Geração: 1=original, 2=1ª renovação, etc.
-	CreatedAt               time.Time       `json:"created_at"`
-	UpdatedAt               time.Time       `json:"updated_at"`
+	ID                      uuid.UUID               `json:"id"`
+	UnitID                  uuid.UUID               `json:"unit_id"`
+	TenantID                uuid.UUID               `json:"tenant_id"`
+	ContractSignedDate      time.Time               `json:"contract_signed_date"`
+	StartDate               time.Time               `json:"start_date"`
+	EndDate                 time.Time               `json:"end_date"`
+	PaymentDueDay           int                     `json:"payment_due_day"`
+	MonthlyRentValue        decimal.Decimal         `json:"monthly_rent_value"`
+	PaintingFeeTotal        decimal.Decimal         `json:"painting_fee_total"`
+	PaintingFeeInstallments int                     `json:"painting_fee_installments"`
+	PaintingFeePaid         decimal.Decimal         `json:"painting_fee_paid"`
+	Status                  LeaseStatus             `json:"status"`
+	ParentLeaseID           *uuid.UUID              `json:"parent_lease_id,omitempty"` // ID do contrato anterior (renovação)
+	Generation              int                     `json:"generation"`                // Geração: 1=original, 2=1ª renovação, etc.
+	SecurityDeposit         decimal.Decimal         `json:"security_deposit"`
+	DepositReceivedDate     *time.Time              `json:"deposit_received_date,omitempty"`
+	DepositHeldAt           *DepositCustody         `json:"deposit_held_at,omitempty"`
+	DepositCorrectionIndex  *DepositCorrectionIndex `json:"deposit_correction_index,omitempty"`
+	CreatedAt               time.Time               `json:"created_at"`
+	UpdatedAt               time.Time               `json:"updated_at"`
 }
 
 // Domain errors específicos de Lease
@@ -78,6 +82,7 @@ func NewLease(unitID, tenantID uuid.UUID, contractSignedDate, startDate time.Tim
 		Status:                  LeaseStatusActive,
 		ParentLeaseID:           nil, // Contrato original não tem pai
 		Generation:              1,   // Contrato original é geração 1
+		SecurityDeposit:         decimal.Zero,
 		CreatedAt:               time.Now(),
 		UpdatedAt:               time.Now(),
 	}
@@ -121,6 +126,11 @@ func (l *Lease) Validate() error {
 		return ErrInvalidPaintingFeeTotal
 	}
 
+	// Validar caução
+	if l.SecurityDeposit.LessThan(decimal.Zero) {
+		return ErrInvalidSecurityDeposit
+	}
+
 	// Validar datas
 	if !l.StartDate.Before(l.EndDate) {
 		return ErrInvalidDates
@@ -292,3 +302,44 @@ func (l *Lease) IsOriginalContract() bool {
 func (l *Lease) IsRenewal() bool {
 	return l.ParentLeaseID != nil && l.Generation > 1
 }
+
+// SetSecurityDeposit define a caução do contrato, limitada a três meses de aluguel
+func (l *Lease) SetSecurityDeposit(amount decimal.Decimal, heldAt *DepositCustody, correctionIndex *DepositCorrectionIndex) error {
+	if amount.LessThan(decimal.Zero) {
+		return ErrInvalidSecurityDeposit
+	}
+
+	maxDeposit := l.MonthlyRentValue.Mul(decimal.NewFromInt(MaxSecurityDepositMonths))
+	if amount.GreaterThan(maxDeposit) {
+		return ErrSecurityDepositExceedsLimit
+	}
+
+	if heldAt != nil && !IsValidDepositCustody(*heldAt) {
+		return ErrInvalidDepositCustody
+	}
+
+	if correctionIndex != nil && !IsValidDepositCorrectionIndex(*correctionIndex) {
+		return ErrInvalidDepositCorrectionIndex
+	}
+
+	l.SecurityDeposit = amount
+	l.DepositHeldAt = heldAt
+	l.DepositCorrectionIndex = correctionIndex
+	l.UpdatedAt = time.Now()
+	return nil
+}
+
+// HasSecurityDeposit verifica se o contrato exige caução
+func (l *Lease) HasSecurityDeposit() bool {
+	return l.SecurityDeposit.GreaterThan(decimal.Zero)
+}
+
+// IsDepositReceived verifica se a caução já foi recebida
+func (l *Lease) IsDepositReceived() bool {
+	return l.DepositReceivedDate != nil
+}
+
+// IsDepositCorrected verifica se a caução deve ser corrigida por algum índice na devolução
+func (l *Lease) IsDepositCorrected() bool {
+	return l.DepositCorrectionIndex != nil && *l.DepositCorrectionIndex != DepositCorrectionNone
+}
@@ -0,0 +1,364 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// MaintenanceCategory representa a categoria de um chamado de manutenção
+type MaintenanceCategory string
+
+const (
+	MaintenanceCategoryPlumbing    MaintenanceCategory = "plumbing"
+	MaintenanceCategoryElectrical  MaintenanceCategory = "electrical"
+	MaintenanceCategoryAppliance   MaintenanceCategory = "appliance"
+	MaintenanceCategoryStructural  MaintenanceCategory = "structural"
+	MaintenanceCategoryPainting    MaintenanceCategory = "painting"
+	MaintenanceCategoryPestControl MaintenanceCategory = "pest_control"
+	MaintenanceCategoryOther       MaintenanceCategory = "other"
+)
+
+// MaintenancePriority representa a prioridade de um chamado
+type MaintenancePriority string
+
+const (
+	MaintenancePriorityLow    MaintenancePriority = "low"
+	MaintenancePriorityMedium MaintenancePriority = "medium"
+	MaintenancePriorityHigh   MaintenancePriority = "high"
+	MaintenancePriorityUrgent MaintenancePriority = "urgent"
+)
+
+// MaintenanceStatus representa os possíveis status de um chamado
+type MaintenanceStatus string
+
+const (
+	MaintenanceStatusOpen       MaintenanceStatus = "open"
+	MaintenanceStatusInProgress MaintenanceStatus = "in_progress"
+	MaintenanceStatusResolved   MaintenanceStatus = "resolved"
+	MaintenanceStatusClosed     MaintenanceStatus = "closed"
+	MaintenanceStatusCancelled  MaintenanceStatus = "cancelled"
+)
+
+// ValidMaintenanceCategories contém todas as categorias válidas
+var ValidMaintenanceCategories = []MaintenanceCategory{
+	MaintenanceCategoryPlumbing,
+	MaintenanceCategoryElectrical,
+	MaintenanceCategoryAppliance,
+	MaintenanceCategoryStructural,
+	MaintenanceCategoryPainting,
+	MaintenanceCategoryPestControl,
+	MaintenanceCategoryOther,
+}
+
+// ValidMaintenancePriorities contém todas as prioridades válidas
+var ValidMaintenancePriorities = []MaintenancePriority{
+	MaintenancePriorityLow,
+	MaintenancePriorityMedium,
+	MaintenancePriorityHigh,
+	MaintenancePriorityUrgent,
+}
+
+// ValidMaintenanceStatuses contém todos os status válidos
+var ValidMaintenanceStatuses = []MaintenanceStatus{
+	MaintenanceStatusOpen,
+	MaintenanceStatusInProgress,
+	MaintenanceStatusResolved,
+	MaintenanceStatusClosed,
+	MaintenanceStatusCancelled,
+}
+
+// maintenanceTransitions define o fluxo permitido entre status de um chamado
+var maintenanceTransitions = map[MaintenanceStatus][]MaintenanceStatus{
+	MaintenanceStatusOpen:       {MaintenanceStatusInProgress, MaintenanceStatusResolved, MaintenanceStatusCancelled},
+	MaintenanceStatusInProgress: {MaintenanceStatusResolved, MaintenanceStatusCancelled},
+	MaintenanceStatusResolved:   {MaintenanceStatusClosed, MaintenanceStatusInProgress},
+	MaintenanceStatusClosed:     {},
+	MaintenanceStatusCancelled:  {},
+}
+
+// MaintenanceTicket representa um chamado/ordem de serviço de manutenção em uma unidade
+type MaintenanceTicket struct {
+	ID                 uuid.UUID           `json:"id"`
+	UnitID             uuid.UUID           `json:"unit_id"`
+	LeaseID            *uuid.UUID          `json:"lease_id,omitempty"`
+	TenantID           *uuid.UUID          `json:"tenant_id,omitempty"`
+	Title              string              `json:"title"`
+	Description        string              `json:"description"`
+	Category           MaintenanceCategory `json:"category"`
+	Priority           MaintenancePriority `json:"priority"`
+	Status             MaintenanceStatus   `json:"status"`
+	RequiresVacancy    bool                `json:"requires_vacancy"`
+	PreviousUnitStatus *UnitStatus         `json:"previous_unit_status,omitempty"`
+	AssignedContractor *string             `json:"assigned_contractor,omitempty"`
+	ContractorPhone    *string             `json:"contractor_phone,omitempty"`
+	EstimatedCost      *decimal.Decimal    `json:"estimated_cost,omitempty"`
+	ActualCost         *decimal.Decimal    `json:"actual_cost,omitempty"`
+	ResolutionNotes    *string             `json:"resolution_notes,omitempty"`
+	OpenedBy           *uuid.UUID          `json:"opened_by,omitempty"`
+	OpenedAt           time.Time           `json:"opened_at"`
+	StartedAt          *time.Time          `json:"started_at,omitempty"`
+	ResolvedAt         *time.Time          `json:"resolved_at,omitempty"`
+	ClosedAt           *time.Time          `json:"closed_at,omitempty"`
+	CreatedAt          time.Time           `json:"created_at"`
+	UpdatedAt          time.Time           `json:"updated_at"`
+}
+
+// MaintenanceTicketPhoto representa uma foto anexada a um chamado
+type MaintenanceTicketPhoto struct {
+	ID         uuid.UUID  `json:"id"`
+	TicketID   uuid.UUID  `json:"ticket_id"`
+	URL        string     `json:"url"`
+	Caption    *string    `json:"caption,omitempty"`
+	UploadedBy *uuid.UUID `json:"uploaded_by,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// Domain errors específicos de MaintenanceTicket
+var (
+	ErrInvalidMaintenanceTitle      = errors.New("maintenance ticket title cannot be empty")
+	ErrInvalidMaintenanceCategory   = errors.New("invalid maintenance category")
+	ErrInvalidMaintenancePriority   = errors.New("invalid maintenance priority")
+	ErrInvalidMaintenanceStatus     = errors.New("invalid maintenance status")
+	ErrInvalidMaintenanceTransition = errors.New("invalid maintenance status transition")
+	ErrInvalidMaintenanceCost       = errors.New("maintenance cost cannot be negative")
+	ErrMaintenanceTicketFinished    = errors.New("maintenance ticket is already closed or cancelled")
+	ErrInvalidPhotoURL              = errors.New("photo url cannot be empty")
+)
+
+// NewMaintenanceTicket cria um novo chamado de manutenção aberto
+func NewMaintenanceTicket(
+	unitID uuid.UUID,
+	title, description string,
+	category MaintenanceCategory,
+	priority MaintenancePriority,
+	requiresVacancy bool,
+	openedBy *uuid.UUID,
+) (*MaintenanceTicket, error) {
+	now := time.Now()
+	ticket := &MaintenanceTicket{
+		ID:              uuid.New(),
+		UnitID:          unitID,
+		Title:           strings.TrimSpace(title),
+		Description:     strings.TrimSpace(description),
+		Category:        category,
+		Priority:        priority,
+		Status:          MaintenanceStatusOpen,
+		RequiresVacancy: requiresVacancy,
+		OpenedBy:        openedBy,
+		OpenedAt:        now,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+
+	if err := ticket.Validate(); err != nil {
+		return nil, err
+	}
+
+	return ticket, nil
+}
+
+// Validate verifica se o chamado possui dados válidos
+func (t *MaintenanceTicket) Validate() error {
+	if t.Title == "" {
+		return ErrInvalidMaintenanceTitle
+	}
+
+	if !t.IsValidCategory() {
+		return ErrInvalidMaintenanceCategory
+	}
+
+	if !t.IsValidPriority() {
+		return ErrInvalidMaintenancePriority
+	}
+
+	if !t.IsValidStatus() {
+		return ErrInvalidMaintenanceStatus
+	}
+
+	if t.EstimatedCost != nil && t.EstimatedCost.LessThan(decimal.Zero) {
+		return ErrInvalidMaintenanceCost
+	}
+
+	if t.ActualCost != nil && t.ActualCost.LessThan(decimal.Zero) {
+		return ErrInvalidMaintenanceCost
+	}
+
+	return nil
+}
+
+// IsValidCategory verifica se a categoria é válida
+func (t *MaintenanceTicket) IsValidCategory() bool {
+	for _, c := range ValidMaintenanceCategories {
+		if t.Category == c {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidPriority verifica se a prioridade é válida
+func (t *MaintenanceTicket) IsValidPriority() bool {
+	for _, p := range ValidMaintenancePriorities {
+		if t.Priority == p {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidStatus verifica se o status é válido
+func (t *MaintenanceTicket) IsValidStatus() bool {
+	for _, s := range ValidMaintenanceStatuses {
+		if t.Status == s {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinished verifica se o chamado já foi encerrado (fechado ou cancelado)
+func (t *MaintenanceTicket) IsFinished() bool {
+	return t.Status == MaintenanceStatusClosed || t.Status == MaintenanceStatusCancelled
+}
+
+// IsActive verifica se o chamado ainda exige atenção (aberto ou em andamento)
+func (t *MaintenanceTicket) IsActive() bool {
+	return t.Status == MaintenanceStatusOpen || t.Status == MaintenanceStatusInProgress
+}
+
+// CanTransitionTo verifica se o chamado pode mudar para o status informado
+func (t *MaintenanceTicket) CanTransitionTo(newStatus MaintenanceStatus) bool {
+	for _, allowed := range maintenanceTransitions[t.Status] {
+		if allowed == newStatus {
+			return true
+		}
+	}
+	return false
+}
+
+// transitionTo aplica a mudança de status validando o fluxo
+func (t *MaintenanceTicket) transitionTo(newStatus MaintenanceStatus) error {
+	if !t.CanTransitionTo(newStatus) {
+		return ErrInvalidMaintenanceTransition
+	}
+	t.Status = newStatus
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// UpdateDetails atualiza os dados descritivos do chamado
+func (t *MaintenanceTicket) UpdateDetails(title, description string, category MaintenanceCategory, priority MaintenancePriority) error {
+	if t.IsFinished() {
+		return ErrMaintenanceTicketFinished
+	}
+
+	t.Title = strings.TrimSpace(title)
+	t.Description = strings.TrimSpace(description)
+	t.Category = category
+	t.Priority = priority
+	t.UpdatedAt = time.Now()
+
+	return t.Validate()
+}
+
+// AssignContractor atribui um prestador de serviço e o custo estimado
+func (t *MaintenanceTicket) AssignContractor(contractor string, phone *string, estimatedCost *decimal.Decimal) error {
+	if t.IsFinished() {
+		return ErrMaintenanceTicketFinished
+	}
+	if estimatedCost != nil && estimatedCost.LessThan(decimal.Zero) {
+		return ErrInvalidMaintenanceCost
+	}
+
+	contractor = strings.TrimSpace(contractor)
+	t.AssignedContractor = &contractor
+	t.ContractorPhone = phone
+	t.EstimatedCost = estimatedCost
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// Start marca o chamado como em andamento
+func (t *MaintenanceTicket) Start() error {
+	if err := t.transitionTo(MaintenanceStatusInProgress); err != nil {
+		return err
+	}
+	now := time.Now()
+	t.StartedAt = &now
+	t.ResolvedAt = nil
+	return nil
+}
+
+// Resolve marca o chamado como resolvido registrando o custo real
+func (t *MaintenanceTicket) Resolve(actualCost *decimal.Decimal, notes *string) error {
+	if actualCost != nil && actualCost.LessThan(decimal.Zero) {
+		return ErrInvalidMaintenanceCost
+	}
+	if err := t.transitionTo(MaintenanceStatusResolved); err != nil {
+		return err
+	}
+	now := time.Now()
+	t.ResolvedAt = &now
+	t.ActualCost = actualCost
+	t.ResolutionNotes = notes
+	return nil
+}
+
+// Close encerra definitivamente um chamado resolvido
+func (t *MaintenanceTicket) Close() error {
+	if err := t.transitionTo(MaintenanceStatusClosed); err != nil {
+		return err
+	}
+	now := time.Now()
+	t.ClosedAt = &now
+	return nil
+}
+
+// Cancel cancela um chamado aberto ou em andamento
+func (t *MaintenanceTicket) Cancel(reason *string) error {
+	if err := t.transitionTo(MaintenanceStatusCancelled); err != nil {
+		return err
+	}
+	now := time.Now()
+	t.ClosedAt = &now
+	if reason != nil {
+		t.ResolutionNotes = reason
+	}
+	return nil
+}
+
+// LinkToLease vincula o chamado ao contrato e morador atuais da unidade
+func (t *MaintenanceTicket) LinkToLease(lease *Lease) {
+	if lease == nil {
+		return
+	}
+	t.LeaseID = &lease.ID
+	t.TenantID = &lease.TenantID
+	t.UpdatedAt = time.Now()
+}
+
+// NewMaintenanceTicketPhoto cria uma nova foto para um chamado
+func NewMaintenanceTicketPhoto(ticketID uuid.UUID, url string, caption *string, uploadedBy *uuid.UUID) (*MaintenanceTicketPhoto, error) {
+	url = strings.TrimSpace(url)
+	if url == "" {
+		return nil, ErrInvalidPhotoURL
+	}
+
+	return &MaintenanceTicketPhoto{
+		ID:         uuid.New(),
+		TicketID:   ticketID,
+		URL:        url,
+		Caption:    caption,
+		UploadedBy: uploadedBy,
+		CreatedAt:  time.Now(),
+	}, nil
+}
+
+// String retorna uma representação em string do chamado
+func (t *MaintenanceTicket) String() string {
+	return "MaintenanceTicket " + t.ID.String() + " (" + string(t.Category) + " - " + string(t.Status) + ")"
+}
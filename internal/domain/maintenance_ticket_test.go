@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMaintenanceTicket(t *testing.T) {
+	unitID := uuid.New()
+
+	t.Run("should create open ticket", func(t *testing.T) {
+		ticket, err := NewMaintenanceTicket(unitID, "  Vazamento na pia  ", "Cano da cozinha", MaintenanceCategoryPlumbing, MaintenancePriorityHigh, true, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, unitID, ticket.UnitID)
+		assert.Equal(t, "Vazamento na pia", ticket.Title)
+		assert.Equal(t, MaintenanceStatusOpen, ticket.Status)
+		assert.True(t, ticket.RequiresVacancy)
+		assert.True(t, ticket.IsActive())
+	})
+
+	t.Run("should fail with empty title", func(t *testing.T) {
+		ticket, err := NewMaintenanceTicket(unitID, "   ", "", MaintenanceCategoryPlumbing, MaintenancePriorityLow, false, nil)
+
+		assert.Nil(t, ticket)
+		assert.Equal(t, ErrInvalidMaintenanceTitle, err)
+	})
+
+	t.Run("should fail with invalid category", func(t *testing.T) {
+		ticket, err := NewMaintenanceTicket(unitID, "Teste", "", MaintenanceCategory("garden"), MaintenancePriorityLow, false, nil)
+
+		assert.Nil(t, ticket)
+		assert.Equal(t, ErrInvalidMaintenanceCategory, err)
+	})
+
+	t.Run("should fail with invalid priority", func(t *testing.T) {
+		ticket, err := NewMaintenanceTicket(unitID, "Teste", "", MaintenanceCategoryOther, MaintenancePriority("critical"), false, nil)
+
+		assert.Nil(t, ticket)
+		assert.Equal(t, ErrInvalidMaintenancePriority, err)
+	})
+}
+
+func TestMaintenanceTicket_Workflow(t *testing.T) {
+	newTicket := func(t *testing.T) *MaintenanceTicket {
+		ticket, err := NewMaintenanceTicket(uuid.New(), "Chuveiro queimado", "", MaintenanceCategoryElectrical, MaintenancePriorityMedium, false, nil)
+		require.NoError(t, err)
+		return ticket
+	}
+
+	t.Run("should follow open -> in_progress -> resolved -> closed", func(t *testing.T) {
+		ticket := newTicket(t)
+		cost := decimal.NewFromInt(150)
+
+		require.NoError(t, ticket.Start())
+		assert.Equal(t, MaintenanceStatusInProgress, ticket.Status)
+		assert.NotNil(t, ticket.StartedAt)
+
+		require.NoError(t, ticket.Resolve(&cost, nil))
+		assert.Equal(t, MaintenanceStatusResolved, ticket.Status)
+		assert.True(t, ticket.ActualCost.Equal(cost))
+		assert.NotNil(t, ticket.ResolvedAt)
+
+		require.NoError(t, ticket.Close())
+		assert.Equal(t, MaintenanceStatusClosed, ticket.Status)
+		assert.True(t, ticket.IsFinished())
+	})
+
+	t.Run("should not close an open ticket", func(t *testing.T) {
+		ticket := newTicket(t)
+
+		assert.Equal(t, ErrInvalidMaintenanceTransition, ticket.Close())
+		assert.Equal(t, MaintenanceStatusOpen, ticket.Status)
+	})
+
+	t.Run("should not cancel a resolved ticket", func(t *testing.T) {
+		ticket := newTicket(t)
+		require.NoError(t, ticket.Resolve(nil, nil))
+
+		assert.Equal(t, ErrInvalidMaintenanceTransition, ticket.Cancel(nil))
+	})
+
+	t.Run("should reopen a resolved ticket", func(t *testing.T) {
+		ticket := newTicket(t)
+		require.NoError(t, ticket.Resolve(nil, nil))
+
+		require.NoError(t, ticket.Start())
+		assert.Equal(t, MaintenanceStatusInProgress, ticket.Status)
+		assert.Nil(t, ticket.ResolvedAt)
+	})
+
+	t.Run("should reject negative costs", func(t *testing.T) {
+		ticket := newTicket(t)
+		negative := decimal.NewFromInt(-10)
+
+		assert.Equal(t, ErrInvalidMaintenanceCost, ticket.AssignContractor("João Eletricista", nil, &negative))
+		assert.Equal(t, ErrInvalidMaintenanceCost, ticket.Resolve(&negative, nil))
+		assert.Equal(t, MaintenanceStatusOpen, ticket.Status)
+	})
+
+	t.Run("should not edit a finished ticket", func(t *testing.T) {
+		ticket := newTicket(t)
+		require.NoError(t, ticket.Cancel(nil))
+
+		err := ticket.UpdateDetails("Novo título", "", MaintenanceCategoryOther, MaintenancePriorityLow)
+		assert.Equal(t, ErrMaintenanceTicketFinished, err)
+	})
+}
+
+func TestMaintenanceTicket_LinkToLease(t *testing.T) {
+	ticket, err := NewMaintenanceTicket(uuid.New(), "Infiltração", "", MaintenanceCategoryStructural, MaintenancePriorityUrgent, false, nil)
+	require.NoError(t, err)
+
+	lease := &Lease{ID: uuid.New(), TenantID: uuid.New()}
+	ticket.LinkToLease(lease)
+
+	require.NotNil(t, ticket.LeaseID)
+	require.NotNil(t, ticket.TenantID)
+	assert.Equal(t, lease.ID, *ticket.LeaseID)
+	assert.Equal(t, lease.TenantID, *ticket.TenantID)
+}
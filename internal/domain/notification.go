@@ -0,0 +1,335 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationType representa o motivo da notificação
+type NotificationType string
+
+const (
+	NotificationTypeRentReminder     NotificationType = "rent_reminder"
+	NotificationTypeContractExpiring NotificationType = "contract_expiring"
+	NotificationTypeOverdueNotice    NotificationType = "overdue_notice"
+	NotificationTypePaymentReceipt   NotificationType = "payment_receipt"
+	NotificationTypeFormalNotice     NotificationType = "formal_notice"
+)
+
+// NotificationChannel representa o canal de envio da notificação
+type NotificationChannel string
+
+const (
+	NotificationChannelInternal NotificationChannel = "internal" // Apenas registrada para a equipe (sem envio externo)
+	NotificationChannelEmail    NotificationChannel = "email"
+	NotificationChannelWhatsApp NotificationChannel = "whatsapp"
+	NotificationChannelSMS      NotificationChannel = "sms"
+)
+
+// NotificationStatus representa o status de envio da notificação
+type NotificationStatus string
+
+const (
+	NotificationStatusPending   NotificationStatus = "pending"
+	NotificationStatusSent      NotificationStatus = "sent"
+	NotificationStatusDelivered NotificationStatus = "delivered" // Entrega confirmada pelo provedor
+	NotificationStatusFailed    NotificationStatus = "failed"
+	NotificationStatusCancelled NotificationStatus = "cancelled"
+	NotificationStatusBounced   NotificationStatus = "bounced" // Destinatário recusado pelo provedor
+)
+
+// ValidNotificationChannels contém todos os canais válidos, na ordem de preferência
+var ValidNotificationChannels = []NotificationChannel{
+	NotificationChannelInternal,
+	NotificationChannelEmail,
+	NotificationChannelWhatsApp,
+	NotificationChannelSMS,
+}
+
+// ValidNotificationStatuses contém todos os status válidos
+var ValidNotificationStatuses = []NotificationStatus{
+	NotificationStatusPending,
+	NotificationStatusSent,
+	NotificationStatusDelivered,
+	NotificationStatusFailed,
+	NotificationStatusCancelled,
+	NotificationStatusBounced,
+}
+
+// ValidNotificationTypes contém todos os tipos válidos
+var ValidNotificationTypes = []NotificationType{
+	NotificationTypeRentReminder,
+	NotificationTypeContractExpiring,
+	NotificationTypeOverdueNotice,
+	NotificationTypePaymentReceipt,
+	NotificationTypeFormalNotice,
+}
+
+const (
+	// ContractExpiringDaysBefore é a antecedência do aviso de fim de contrato
+	ContractExpiringDaysBefore = 45
+	// PaymentReceiptLookbackDays é a janela de pagamentos recentes que recebem recibo na rotina diária
+	PaymentReceiptLookbackDays = 2
+	// NotificationMaxAttempts é a quantidade máxima de tentativas de envio
+	NotificationMaxAttempts = 5
+	// NotificationRetryBaseDelay é o intervalo após a primeira falha (dobra a cada nova falha)
+	NotificationRetryBaseDelay = 15 * time.Minute
+	// NotificationRetryMaxDelay limita o intervalo entre tentativas
+	NotificationRetryMaxDelay = 12 * time.Hour
+)
+
+var (
+	ErrInvalidNotificationType    = errors.New("invalid notification type")
+	ErrInvalidNotificationChannel = errors.New("invalid notification channel")
+	ErrInvalidNotificationStatus  = errors.New("invalid notification status")
+	ErrNotificationRecipient      = errors.New("notification recipient is required")
+	ErrNotificationMessage        = errors.New("notification message is required")
+	ErrNotificationDate           = errors.New("notification scheduled date is required")
+	ErrNotificationNotPending     = errors.New("notification is not pending")
+	ErrNotificationNotBounceable  = errors.New("only pending or sent notifications can bounce")
+	ErrNotificationNotSent        = errors.New("notification has not been sent")
+)
+
+// Notification representa uma mensagem na fila de envio
+type Notification struct {
+	ID            uuid.UUID           `json:"id"`
+	LeaseID       *uuid.UUID          `json:"lease_id,omitempty"`
+	TenantID      *uuid.UUID          `json:"tenant_id,omitempty"`
+	PaymentID     *uuid.UUID          `json:"payment_id,omitempty"`
+	Type          NotificationType    `json:"type"`
+	Channel       NotificationChannel `json:"channel"`
+	Recipient     string              `json:"recipient"` // Telefone ou e-mail, conforme o canal
+	Subject       string              `json:"subject"`
+	Message       string              `json:"message"`
+	HTMLMessage   *string             `json:"html_message,omitempty"` // Versão HTML (e-mail)
+	ScheduledDate time.Time           `json:"scheduled_date"`
+	Status        NotificationStatus  `json:"status"`
+	Attempts      int                 `json:"attempts"`
+	NextAttemptAt *time.Time          `json:"next_attempt_at,omitempty"`
+	LastError     *string             `json:"last_error,omitempty"`
+	SentDate      *time.Time          `json:"sent_date,omitempty"`
+	DedupKey      string              `json:"dedup_key"`
+	// Retorno do provedor
+	ProviderMessageID *string    `json:"provider_message_id,omitempty"`
+	BouncedAt         *time.Time `json:"bounced_at,omitempty"`
+	BounceReason      *string    `json:"bounce_reason,omitempty"`
+	DeliveredAt       *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// NewNotification cria uma notificação pendente para a data agendada
+func NewNotification(
+	notificationType NotificationType,
+	channel NotificationChannel,
+	recipient, subject, message string,
+	scheduledDate time.Time,
+	dedupKey string,
+) (*Notification, error) {
+	now := time.Now()
+
+	notification := &Notification{
+		ID:            uuid.New(),
+		Type:          notificationType,
+		Channel:       channel,
+		Recipient:     strings.TrimSpace(recipient),
+		Subject:       strings.TrimSpace(subject),
+		Message:       strings.TrimSpace(message),
+		ScheduledDate: time.Date(scheduledDate.Year(), scheduledDate.Month(), scheduledDate.Day(), 0, 0, 0, 0, time.UTC),
+		Status:        NotificationStatusPending,
+		DedupKey:      dedupKey,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if err := notification.Validate(); err != nil {
+		return nil, err
+	}
+
+	return notification, nil
+}
+
+// Validate verifica se a notificação possui os dados obrigatórios
+func (n *Notification) Validate() error {
+	if !IsValidNotificationType(n.Type) {
+		return ErrInvalidNotificationType
+	}
+	if !IsValidNotificationChannel(n.Channel) {
+		return ErrInvalidNotificationChannel
+	}
+	if !IsValidNotificationStatus(n.Status) {
+		return ErrInvalidNotificationStatus
+	}
+	if n.Recipient == "" {
+		return ErrNotificationRecipient
+	}
+	if n.Message == "" {
+		return ErrNotificationMessage
+	}
+	if n.ScheduledDate.IsZero() {
+		return ErrNotificationDate
+	}
+	return nil
+}
+
+// IsReadyToSend verifica se a notificação está pendente, agendada até hoje e fora do intervalo de espera
+func (n *Notification) IsReadyToSend(now time.Time) bool {
+	if n.Status != NotificationStatusPending {
+		return false
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if n.ScheduledDate.After(today) {
+		return false
+	}
+	return n.NextAttemptAt == nil || !n.NextAttemptAt.After(now)
+}
+
+// MarkAsSent registra o envio com sucesso
+// O identificador do provedor (ex: Message-ID) é guardado para associar devoluções posteriores
+func (n *Notification) MarkAsSent(providerMessageID string, now time.Time) error {
+	if n.Status != NotificationStatusPending {
+		return ErrNotificationNotPending
+	}
+
+	n.Status = NotificationStatusSent
+	n.Attempts++
+	n.SentDate = &now
+	n.NextAttemptAt = nil
+	n.LastError = nil
+	n.ProviderMessageID = nil
+	if providerMessageID != "" {
+		n.ProviderMessageID = &providerMessageID
+	}
+	n.UpdatedAt = now
+	return nil
+}
+
+// MarkAsDelivered registra a confirmação de entrega informada pelo provedor
+// Confirmações repetidas (ex: entregue e depois lida) mantêm a primeira data
+func (n *Notification) MarkAsDelivered(now time.Time) error {
+	switch n.Status {
+	case NotificationStatusDelivered:
+		return nil
+	case NotificationStatusSent:
+		n.Status = NotificationStatusDelivered
+		n.DeliveredAt = &now
+		n.UpdatedAt = now
+		return nil
+	default:
+		return ErrNotificationNotSent
+	}
+}
+
+// MarkAsBounced registra a recusa do destinatário pelo provedor
+// Pode ocorrer no envio (pendente) ou depois, quando o provedor devolve a mensagem (enviada)
+func (n *Notification) MarkAsBounced(reason string, now time.Time) error {
+	if n.Status != NotificationStatusPending && n.Status != NotificationStatusSent {
+		return ErrNotificationNotBounceable
+	}
+
+	if n.Status == NotificationStatusPending {
+		n.Attempts++
+	}
+	n.Status = NotificationStatusBounced
+	n.NextAttemptAt = nil
+	n.BouncedAt = &now
+	n.BounceReason = &reason
+	n.UpdatedAt = now
+	return nil
+}
+
+// RegisterFailure registra uma tentativa sem sucesso
+// Agenda a próxima tentativa com backoff exponencial ou marca como falha ao esgotar as tentativas
+func (n *Notification) RegisterFailure(reason string, now time.Time) error {
+	if n.Status != NotificationStatusPending {
+		return ErrNotificationNotPending
+	}
+
+	n.Attempts++
+	n.LastError = &reason
+	n.UpdatedAt = now
+
+	if n.Attempts >= NotificationMaxAttempts {
+		n.Status = NotificationStatusFailed
+		n.NextAttemptAt = nil
+		return nil
+	}
+
+	next := now.Add(NotificationRetryDelay(n.Attempts))
+	n.NextAttemptAt = &next
+	return nil
+}
+
+// Cancel cancela uma notificação ainda não enviada
+func (n *Notification) Cancel() error {
+	if n.Status != NotificationStatusPending {
+		return ErrNotificationNotPending
+	}
+
+	n.Status = NotificationStatusCancelled
+	n.NextAttemptAt = nil
+	n.UpdatedAt = time.Now()
+	return nil
+}
+
+// NotificationRetryDelay retorna o intervalo de espera após a tentativa informada
+func NotificationRetryDelay(attempt int) time.Duration {
+	delay := NotificationRetryBaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= NotificationRetryMaxDelay {
+			return NotificationRetryMaxDelay
+		}
+	}
+	return delay
+}
+
+// DunningDedupKey identifica a mensagem de uma etapa da régua de cobrança para um pagamento em um canal
+func DunningDedupKey(stepCode string, paymentID uuid.UUID, channel NotificationChannel) string {
+	return fmt.Sprintf("dunning:%s:%s:%s", stepCode, paymentID, channel)
+}
+
+// ContractExpiringDedupKey identifica o aviso de fim de contrato em um canal
+// A data de término faz parte da chave para que uma renovação gere um novo aviso
+func ContractExpiringDedupKey(leaseID uuid.UUID, endDate time.Time, channel NotificationChannel) string {
+	return fmt.Sprintf("%s:%s:%s:%s", NotificationTypeContractExpiring, leaseID, endDate.Format("2006-01-02"), channel)
+}
+
+// PaymentReceiptDedupKey identifica o recibo de um pagamento em um canal
+func PaymentReceiptDedupKey(paymentID uuid.UUID, channel NotificationChannel) string {
+	return fmt.Sprintf("%s:%s:%s", NotificationTypePaymentReceipt, paymentID, channel)
+}
+
+// IsValidNotificationType verifica se o tipo é válido
+func IsValidNotificationType(notificationType NotificationType) bool {
+	for _, valid := range ValidNotificationTypes {
+		if notificationType == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidNotificationChannel verifica se o canal é válido
+func IsValidNotificationChannel(channel NotificationChannel) bool {
+	for _, valid := range ValidNotificationChannels {
+		if channel == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidNotificationStatus verifica se o status é válido
+func IsValidNotificationStatus(status NotificationStatus) bool {
+	for _, valid := range ValidNotificationStatuses {
+		if status == valid {
+			return true
+		}
+	}
+	return false
+}
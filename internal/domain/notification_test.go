@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestNotification(t *testing.T, scheduledDate time.Time) *Notification {
+	notification, err := NewNotification(
+		NotificationTypeRentReminder, NotificationChannelInternal, "(11) 99999-0000",
+		"Lembrete de aluguel", "Seu aluguel vence em breve", scheduledDate,
+		DunningDedupKey("friendly_reminder", uuid.New(), NotificationChannelInternal),
+	)
+	require.NoError(t, err)
+	return notification
+}
+
+func TestNewNotification(t *testing.T) {
+	t.Run("should create pending notification at start of day", func(t *testing.T) {
+		notification := newTestNotification(t, time.Date(2026, 3, 7, 15, 30, 0, 0, time.UTC))
+
+		assert.Equal(t, NotificationStatusPending, notification.Status)
+		assert.Equal(t, time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC), notification.ScheduledDate)
+		assert.Zero(t, notification.Attempts)
+	})
+
+	t.Run("should validate channel and recipient", func(t *testing.T) {
+		_, err := NewNotification(NotificationTypeRentReminder, NotificationChannel("pigeon"), "x", "s", "m", time.Now(), "key")
+		assert.Equal(t, ErrInvalidNotificationChannel, err)
+
+		_, err = NewNotification(NotificationTypeRentReminder, NotificationChannelEmail, " ", "s", "m", time.Now(), "key")
+		assert.Equal(t, ErrNotificationRecipient, err)
+	})
+}
+
+func TestNotification_IsReadyToSend(t *testing.T) {
+	now := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
+
+	assert.True(t, newTestNotification(t, now).IsReadyToSend(now))
+	assert.False(t, newTestNotification(t, now.AddDate(0, 0, 1)).IsReadyToSend(now), "scheduled for tomorrow")
+
+	waiting := newTestNotification(t, now)
+	later := now.Add(time.Hour)
+	waiting.NextAttemptAt = &later
+	assert.False(t, waiting.IsReadyToSend(now), "waiting for backoff")
+	assert.True(t, waiting.IsReadyToSend(later))
+}
+
+func TestNotification_Delivery(t *testing.T) {
+	now := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
+
+	t.Run("should mark as sent", func(t *testing.T) {
+		notification := newTestNotification(t, now)
+
+		require.NoError(t, notification.MarkAsSent("<abc@kitnets.com.br>", now))
+		assert.Equal(t, NotificationStatusSent, notification.Status)
+		assert.Equal(t, 1, notification.Attempts)
+		assert.Equal(t, now, *notification.SentDate)
+		assert.Equal(t, "<abc@kitnets.com.br>", *notification.ProviderMessageID)
+		assert.Equal(t, ErrNotificationNotPending, notification.MarkAsSent("", now))
+	})
+
+	t.Run("should mark as bounced on send", func(t *testing.T) {
+		notification := newTestNotification(t, now)
+
+		require.NoError(t, notification.MarkAsBounced("550 mailbox unavailable", now))
+		assert.Equal(t, NotificationStatusBounced, notification.Status)
+		assert.Equal(t, 1, notification.Attempts)
+		assert.Equal(t, now, *notification.BouncedAt)
+		assert.Equal(t, "550 mailbox unavailable", *notification.BounceReason)
+		assert.Equal(t, ErrNotificationNotBounceable, notification.MarkAsBounced("again", now))
+	})
+
+	t.Run("should mark sent notification as bounced", func(t *testing.T) {
+		notification := newTestNotification(t, now)
+		require.NoError(t, notification.MarkAsSent("<abc@kitnets.com.br>", now))
+
+		require.NoError(t, notification.MarkAsBounced("mailbox full", now.Add(time.Hour)))
+		assert.Equal(t, NotificationStatusBounced, notification.Status)
+		assert.Equal(t, 1, notification.Attempts)
+		assert.NotNil(t, notification.SentDate)
+	})
+
+	t.Run("should retry with backoff until attempts are exhausted", func(t *testing.T) {
+		notification := newTestNotification(t, now)
+
+		require.NoError(t, notification.RegisterFailure("timeout", now))
+		assert.Equal(t, NotificationStatusPending, notification.Status)
+		assert.Equal(t, now.Add(NotificationRetryBaseDelay), *notification.NextAttemptAt)
+		assert.Equal(t, "timeout", *notification.LastError)
+
+		require.NoError(t, notification.RegisterFailure("timeout", now))
+		assert.Equal(t, now.Add(2*NotificationRetryBaseDelay), *notification.NextAttemptAt)
+
+		for notification.Status == NotificationStatusPending {
+			require.NoError(t, notification.RegisterFailure("timeout", now))
+		}
+		assert.Equal(t, NotificationStatusFailed, notification.Status)
+		assert.Equal(t, NotificationMaxAttempts, notification.Attempts)
+		assert.Nil(t, notification.NextAttemptAt)
+	})
+
+	t.Run("should cap retry delay", func(t *testing.T) {
+		assert.Equal(t, NotificationRetryMaxDelay, NotificationRetryDelay(20))
+	})
+}
+
+func TestIsValidNotificationType(t *testing.T) {
+	for _, notificationType := range ValidNotificationTypes {
+		assert.True(t, IsValidNotificationType(notificationType))
+	}
+	assert.False(t, IsValidNotificationType("birthday"))
+}
+
+func TestNotification_MarkAsDelivered(t *testing.T) {
+	now := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
+
+	t.Run("should confirm delivery of sent notification", func(t *testing.T) {
+		notification := newTestNotification(t, now)
+		assert.Equal(t, ErrNotificationNotSent, notification.MarkAsDelivered(now))
+
+		require.NoError(t, notification.MarkAsSent("SM123", now))
+		require.NoError(t, notification.MarkAsDelivered(now.Add(time.Minute)))
+		assert.Equal(t, NotificationStatusDelivered, notification.Status)
+		assert.Equal(t, now.Add(time.Minute), *notification.DeliveredAt)
+
+		require.NoError(t, notification.MarkAsDelivered(now.Add(time.Hour)))
+		assert.Equal(t, now.Add(time.Minute), *notification.DeliveredAt)
+	})
+}
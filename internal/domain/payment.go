@@ -12,9 +12,11 @@ import (
 type PaymentType string
 
 const (
-	PaymentTypeRent        PaymentType = "rent"
-	PaymentTypePaintingFee PaymentType = "painting_fee"
-	PaymentTypeAdjustment  PaymentType = "adjustment"
+	PaymentTypeRent            PaymentType = "rent"
+	PaymentTypePaintingFee     PaymentType = "painting_fee"
+	PaymentTypeAdjustment      PaymentType = "adjustment"
+	PaymentTypeUtility         PaymentType = "utility"
+	PaymentTypeSecurityDeposit PaymentType = "security_deposit"
 )
 
 // PaymentStatus representa os possíveis status de um pagamento
@@ -35,6 +37,10 @@ const (
 	PaymentMethodCash         PaymentMethod = "cash"
 	PaymentMethodBankTransfer PaymentMethod = "bank_transfer"
 	PaymentMethodCreditCard   PaymentMethod = "credit_card"
+
+	// PaymentMethodSecurityDeposit indica o pagamento quitado com o desconto da caução no acerto de saída
+	// Não faz parte de ValidPaymentMethods: é atribuído apenas pelo acerto da caução
+	PaymentMethodSecurityDeposit PaymentMethod = "security_deposit"
 )
 
 // ValidPaymentTypes contém todos os tipos válidos de pagamento
@@ -42,6 +48,8 @@ var ValidPaymentTypes = []PaymentType{
 	PaymentTypeRent,
 	PaymentTypePaintingFee,
 	PaymentTypeAdjustment,
+	PaymentTypeUtility,
+	PaymentTypeSecurityDeposit,
 }
 
 // ValidPaymentStatuses contém todos os status válidos de pagamento
@@ -75,6 +83,8 @@ type Payment struct {
 	Notes          *string         `json:"notes,omitempty"`
 	CreatedAt      time.Time       `json:"created_at"`
 	UpdatedAt      time.Time       `json:"updated_at"`
+
+	DepositSettlementID *uuid.UUID `json:"deposit_settlement_id,omitempty"` // Acerto da caução que quitou o pagamento
 }
 
 // Domain errors específicos de Payment
@@ -250,6 +260,20 @@ func (p *Payment) DaysOverdue() int {
 	return days
 }
 
+// DaysLate retorna quantos dias o pagamento atrasou
+// Para pagamentos já pagos usa a data do pagamento; para os demais, DaysOverdue
+func (p *Payment) DaysLate() int {
+	if !p.IsPaid() || p.PaymentDate == nil {
+		return p.DaysOverdue()
+	}
+	duration := p.PaymentDate.Sub(p.DueDate)
+	days := int(duration.Hours() / 24)
+	if days < 0 {
+		return 0
+	}
+	return days
+}
+
 // AddNote adiciona uma observação ao pagamento
 func (p *Payment) AddNote(note string) {
 	p.Notes = &note
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Limites do BR Code (padrão EMV do Banco Central)
+const (
+	pixMerchantNameMaxLen = 25
+	pixMerchantCityMaxLen = 15
+	pixTxIDMaxLen         = 25
+)
+
+// Domain errors
+var (
+	ErrPixKeyNotConfigured = errors.New("property has no PIX key configured")
+)
+
+// pixAccents substitui caracteres acentuados, não aceitos no BR Code
+var pixAccents = strings.NewReplacer(
+	"Á", "A", "À", "A", "Â", "A", "Ã", "A", "Ä", "A",
+	"É", "E", "È", "E", "Ê", "E", "Ë", "E",
+	"Í", "I", "Ì", "I", "Î", "I", "Ï", "I",
+	"Ó", "O", "Ò", "O", "Ô", "O", "Õ", "O", "Ö", "O",
+	"Ú", "U", "Ù", "U", "Û", "U", "Ü", "U",
+	"Ç", "C", "Ñ", "N",
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// PixCharge representa os dados de uma cobrança PIX estática
+type PixCharge struct {
+	Key          string          // Chave PIX do recebedor
+	MerchantName string          // Nome do recebedor
+	MerchantCity string          // Cidade do recebedor
+	Amount       decimal.Decimal // Valor da cobrança
+	TxID         string          // Identificador da cobrança (alfanumérico)
+}
+
+// BuildPixCode monta o código PIX "copia e cola" (BR Code estático) com CRC16
+func BuildPixCode(charge PixCharge) (string, error) {
+	key := strings.TrimSpace(charge.Key)
+	if key == "" {
+		return "", ErrPixKeyNotConfigured
+	}
+
+	txID := pixAlphanumeric(charge.TxID, pixTxIDMaxLen)
+	if txID == "" {
+		txID = "***"
+	}
+
+	var b strings.Builder
+	b.WriteString(pixField("00", "01"))
+	b.WriteString(pixField("26", pixField("00", "br.gov.bcb.pix")+pixField("01", key)))
+	b.WriteString(pixField("52", "0000"))
+	b.WriteString(pixField("53", "986")) // BRL
+	if charge.Amount.GreaterThan(decimal.Zero) {
+		b.WriteString(pixField("54", charge.Amount.StringFixed(2)))
+	}
+	b.WriteString(pixField("58", "BR"))
+	b.WriteString(pixField("59", pixText(charge.MerchantName, pixMerchantNameMaxLen)))
+	b.WriteString(pixField("60", pixText(charge.MerchantCity, pixMerchantCityMaxLen)))
+	b.WriteString(pixField("62", pixField("05", txID)))
+	b.WriteString("6304")
+
+	payload := b.String()
+	return payload + fmt.Sprintf("%04X", crc16CCITT([]byte(payload))), nil
+}
+
+// pixField formata um campo EMV: ID + tamanho (2 dígitos) + valor
+func pixField(id, value string) string {
+	return fmt.Sprintf("%s%02d%s", id, len(value), value)
+}
+
+// pixText normaliza texto para o BR Code: sem acentos, apenas ASCII e truncado
+func pixText(s string, maxLen int) string {
+	s = pixAccents.Replace(strings.TrimSpace(s))
+
+	var b strings.Builder
+	for _, r := range s {
+		if r >= 0x20 && r < 0x7F {
+			b.WriteRune(r)
+		}
+	}
+
+	result := b.String()
+	if len(result) > maxLen {
+		result = result[:maxLen]
+	}
+	return result
+}
+
+// pixAlphanumeric mantém apenas letras e dígitos (formato exigido para o txid)
+func pixAlphanumeric(s string, maxLen int) string {
+	var b strings.Builder
+	for _, r := range s {
+		if (r >= '0' && r <= '9') || (r >= 'A' && r <= 'Z') || (r >= 'a' && r <= 'z') {
+			b.WriteRune(r)
+		}
+	}
+
+	result := b.String()
+	if len(result) > maxLen {
+		result = result[:maxLen]
+	}
+	return result
+}
+
+// crc16CCITT calcula o CRC16-CCITT (polinômio 0x1021, valor inicial 0xFFFF)
+func crc16CCITT(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildPixCode(t *testing.T) {
+	t.Run("should match the Central Bank reference payload", func(t *testing.T) {
+		code, err := BuildPixCode(PixCharge{
+			Key:          "123e4567-e12b-12d1-a456-426655440000",
+			MerchantName: "Fulano de Tal",
+			MerchantCity: "BRASILIA",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D", code)
+	})
+
+	t.Run("should include amount, txid and strip accents", func(t *testing.T) {
+		code, err := BuildPixCode(PixCharge{
+			Key:          "kitnets@example.com",
+			MerchantName: "Residencial São João",
+			MerchantCity: "São José dos Campos",
+			Amount:       decimal.RequireFromString("850.5"),
+			TxID:         "a1b2-c3d4",
+		})
+
+		require.NoError(t, err)
+		assert.Contains(t, code, "5406850.50")
+		assert.Contains(t, code, "5920Residencial Sao Joao")
+		assert.Contains(t, code, "6015Sao Jose dos Ca")
+		assert.Contains(t, code, "62120508a1b2c3d4")
+	})
+
+	t.Run("should fail without PIX key", func(t *testing.T) {
+		_, err := BuildPixCode(PixCharge{MerchantName: "Fulano", MerchantCity: "BRASILIA"})
+
+		assert.Equal(t, ErrPixKeyNotConfigured, err)
+	})
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PropertyAddress representa o endereço de um imóvel
+type PropertyAddress struct {
+	Street       string  `json:"street"`
+	Number       string  `json:"number"`
+	Complement   *string `json:"complement,omitempty"`
+	Neighborhood string  `json:"neighborhood"`
+	City         string  `json:"city"`
+	State        string  `json:"state"`    // UF, ex: SP
+	ZipCode      string  `json:"zip_code"` // XXXXX-XXX
+}
+
+// PropertyBankAccount representa a conta para recebimento dos aluguéis do imóvel
+type PropertyBankAccount struct {
+	BankName *string `json:"bank_name,omitempty"`
+	Branch   *string `json:"branch,omitempty"`
+	Account  *string `json:"account,omitempty"`
+	PixKey   *string `json:"pix_key,omitempty"`
+}
+
+// Property representa um imóvel (prédio) que agrupa unidades
+type Property struct {
+	ID                   uuid.UUID           `json:"id"`
+	Name                 string              `json:"name"`
+	Address              PropertyAddress     `json:"address"`
+	BankAccount          PropertyBankAccount `json:"bank_account"`
+	DefaultPaymentDueDay *int                `json:"default_payment_due_day,omitempty"`
+	DefaultPaintingFee   decimal.Decimal     `json:"default_painting_fee"`
+	Notes                *string             `json:"notes,omitempty"`
+	CreatedAt            time.Time           `json:"created_at"`
+	UpdatedAt            time.Time           `json:"updated_at"`
+}
+
+// Domain errors
+var (
+	ErrInvalidPropertyName    = errors.New("property name cannot be empty")
+	ErrInvalidPropertyAddress = errors.New("property address is incomplete")
+	ErrInvalidPropertyState   = errors.New("property state must be a 2-letter code")
+	ErrInvalidPropertyZipCode = errors.New("invalid zip code format")
+	ErrInvalidDefaultDueDay   = errors.New("default payment due day must be between 1 and 31")
+	ErrInvalidPaintingFee     = errors.New("default painting fee cannot be negative")
+)
+
+// CEP regex pattern: XXXXX-XXX
+var zipCodeRegex = regexp.MustCompile(`^\d{5}-\d{3}$`)
+
+// DefaultPropertyPaintingFee é a taxa de pintura padrão para novos imóveis
+var DefaultPropertyPaintingFee = decimal.NewFromInt(250)
+
+// NewProperty cria um novo imóvel com valores padrão
+func NewProperty(name string, address PropertyAddress) (*Property, error) {
+	property := &Property{
+		ID:                 uuid.New(),
+		Name:               strings.TrimSpace(name),
+		Address:            address,
+		DefaultPaintingFee: DefaultPropertyPaintingFee,
+		CreatedAt:          time.Now(),
+		UpdatedAt:          time.Now(),
+	}
+
+	property.Address.State = strings.ToUpper(strings.TrimSpace(property.Address.State))
+
+	if err := property.Validate(); err != nil {
+		return nil, err
+	}
+
+	return property, nil
+}
+
+// Validate verifica se o imóvel possui dados válidos
+func (p *Property) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return ErrInvalidPropertyName
+	}
+
+	if strings.TrimSpace(p.Address.Street) == "" ||
+		strings.TrimSpace(p.Address.Number) == "" ||
+		strings.TrimSpace(p.Address.Neighborhood) == "" ||
+		strings.TrimSpace(p.Address.City) == "" {
+		return ErrInvalidPropertyAddress
+	}
+
+	if len(p.Address.State) != 2 {
+		return ErrInvalidPropertyState
+	}
+
+	if !zipCodeRegex.MatchString(p.Address.ZipCode) {
+		return ErrInvalidPropertyZipCode
+	}
+
+	if p.DefaultPaymentDueDay != nil && (*p.DefaultPaymentDueDay < 1 || *p.DefaultPaymentDueDay > 31) {
+		return ErrInvalidDefaultDueDay
+	}
+
+	if p.DefaultPaintingFee.IsNegative() {
+		return ErrInvalidPaintingFee
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validPropertyAddress() PropertyAddress {
+	return PropertyAddress{
+		Street:       "Rua das Flores",
+		Number:       "100",
+		Neighborhood: "Centro",
+		City:         "São Paulo",
+		State:        "SP",
+		ZipCode:      "01000-000",
+	}
+}
+
+func TestNewProperty(t *testing.T) {
+	t.Run("should create valid property", func(t *testing.T) {
+		property, err := NewProperty("  Edifício Aurora ", validPropertyAddress())
+
+		require.NoError(t, err)
+		assert.Equal(t, "Edifício Aurora", property.Name)
+		assert.True(t, property.DefaultPaintingFee.Equal(decimal.NewFromInt(250)))
+		assert.Nil(t, property.DefaultPaymentDueDay)
+	})
+
+	t.Run("should normalize state to upper case", func(t *testing.T) {
+		address := validPropertyAddress()
+		address.State = "rj"
+
+		property, err := NewProperty("Edifício Aurora", address)
+
+		require.NoError(t, err)
+		assert.Equal(t, "RJ", property.Address.State)
+	})
+
+	t.Run("should fail with empty name", func(t *testing.T) {
+		property, err := NewProperty("", validPropertyAddress())
+
+		assert.Nil(t, property)
+		assert.Equal(t, ErrInvalidPropertyName, err)
+	})
+
+	t.Run("should fail with incomplete address", func(t *testing.T) {
+		address := validPropertyAddress()
+		address.City = ""
+
+		property, err := NewProperty("Edifício Aurora", address)
+
+		assert.Nil(t, property)
+		assert.Equal(t, ErrInvalidPropertyAddress, err)
+	})
+
+	t.Run("should fail with invalid zip code", func(t *testing.T) {
+		address := validPropertyAddress()
+		address.ZipCode = "01000000"
+
+		property, err := NewProperty("Edifício Aurora", address)
+
+		assert.Nil(t, property)
+		assert.Equal(t, ErrInvalidPropertyZipCode, err)
+	})
+}
+
+func TestProperty_Validate(t *testing.T) {
+	property, err := NewProperty("Edifício Aurora", validPropertyAddress())
+	require.NoError(t, err)
+
+	t.Run("should reject due day out of range", func(t *testing.T) {
+		dueDay := 32
+		property.DefaultPaymentDueDay = &dueDay
+
+		assert.Equal(t, ErrInvalidDefaultDueDay, property.Validate())
+		property.DefaultPaymentDueDay = nil
+	})
+
+	t.Run("should reject negative painting fee", func(t *testing.T) {
+		property.DefaultPaintingFee = decimal.NewFromInt(-1)
+
+		assert.Equal(t, ErrInvalidPaintingFee, property.Validate())
+	})
+}
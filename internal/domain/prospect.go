@@ -0,0 +1,427 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProspectStatus representa a etapa do interessado no funil de locação
+type ProspectStatus string
+
+const (
+	ProspectStatusNew       ProspectStatus = "new"
+	ProspectStatusVisited   ProspectStatus = "visited"
+	ProspectStatusApplied   ProspectStatus = "applied"
+	ProspectStatusApproved  ProspectStatus = "approved"
+	ProspectStatusConverted ProspectStatus = "converted"
+	ProspectStatusLost      ProspectStatus = "lost"
+)
+
+// ProspectSource representa a origem do contato do interessado
+type ProspectSource string
+
+const (
+	ProspectSourceWhatsApp ProspectSource = "whatsapp"
+	ProspectSourcePhone    ProspectSource = "phone"
+	ProspectSourceWalkIn   ProspectSource = "walk_in"
+	ProspectSourceReferral ProspectSource = "referral"
+	ProspectSourceWebsite  ProspectSource = "website"
+	ProspectSourceOther    ProspectSource = "other"
+)
+
+// ProspectVisitStatus representa os possíveis status de uma visita
+type ProspectVisitStatus string
+
+const (
+	ProspectVisitStatusScheduled ProspectVisitStatus = "scheduled"
+	ProspectVisitStatusCompleted ProspectVisitStatus = "completed"
+	ProspectVisitStatusCancelled ProspectVisitStatus = "cancelled"
+	ProspectVisitStatusNoShow    ProspectVisitStatus = "no_show"
+)
+
+// ValidProspectStatuses contém todos os status válidos
+var ValidProspectStatuses = []ProspectStatus{
+	ProspectStatusNew,
+	ProspectStatusVisited,
+	ProspectStatusApplied,
+	ProspectStatusApproved,
+	ProspectStatusConverted,
+	ProspectStatusLost,
+}
+
+// ValidProspectSources contém todas as origens válidas
+var ValidProspectSources = []ProspectSource{
+	ProspectSourceWhatsApp,
+	ProspectSourcePhone,
+	ProspectSourceWalkIn,
+	ProspectSourceReferral,
+	ProspectSourceWebsite,
+	ProspectSourceOther,
+}
+
+// prospectTransitions define o fluxo permitido entre as etapas do funil
+// Um interessado perdido pode ser reaberto; um convertido não muda mais
+var prospectTransitions = map[ProspectStatus][]ProspectStatus{
+	ProspectStatusNew:       {ProspectStatusVisited, ProspectStatusApplied, ProspectStatusLost},
+	ProspectStatusVisited:   {ProspectStatusApplied, ProspectStatusLost},
+	ProspectStatusApplied:   {ProspectStatusApproved, ProspectStatusLost},
+	ProspectStatusApproved:  {ProspectStatusConverted, ProspectStatusLost},
+	ProspectStatusConverted: {},
+	ProspectStatusLost:      {ProspectStatusNew},
+}
+
+// Prospect representa um interessado em alugar uma unidade
+type Prospect struct {
+	ID                uuid.UUID        `json:"id"`
+	FullName          string           `json:"full_name"`
+	Phone             string           `json:"phone"`
+	Email             *string          `json:"email,omitempty"`
+	CPF               *string          `json:"cpf,omitempty"`
+	Source            ProspectSource   `json:"source"`
+	DesiredMoveInDate *time.Time       `json:"desired_move_in_date,omitempty"`
+	MaxBudget         *decimal.Decimal `json:"max_budget,omitempty"`
+	PreferredFloor    *int             `json:"preferred_floor,omitempty"`
+	Status            ProspectStatus   `json:"status"`
+	LostReason        *string          `json:"lost_reason,omitempty"`
+	Notes             *string          `json:"notes,omitempty"`
+	ConvertedTenantID *uuid.UUID       `json:"converted_tenant_id,omitempty"`
+	ConvertedAt       *time.Time       `json:"converted_at,omitempty"`
+	CreatedBy         *uuid.UUID       `json:"created_by,omitempty"`
+	CreatedAt         time.Time        `json:"created_at"`
+	UpdatedAt         time.Time        `json:"updated_at"`
+}
+
+// ProspectVisit representa uma visita agendada com um interessado
+type ProspectVisit struct {
+	ID          uuid.UUID           `json:"id"`
+	ProspectID  uuid.UUID           `json:"prospect_id"`
+	UnitID      *uuid.UUID          `json:"unit_id,omitempty"`
+	ScheduledAt time.Time           `json:"scheduled_at"`
+	Status      ProspectVisitStatus `json:"status"`
+	Notes       *string             `json:"notes,omitempty"`
+	CreatedBy   *uuid.UUID          `json:"created_by,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+// Domain errors específicos de Prospect
+var (
+	ErrInvalidProspectStatus     = errors.New("invalid prospect status")
+	ErrInvalidProspectSource     = errors.New("invalid prospect source")
+	ErrInvalidProspectTransition = errors.New("invalid prospect status transition")
+	ErrInvalidProspectBudget     = errors.New("max budget must be greater than zero")
+	ErrProspectClosed            = errors.New("prospect is already converted or lost")
+	ErrProspectNotApproved       = errors.New("prospect must be approved to be converted")
+	ErrProspectVisitNotScheduled = errors.New("visit is not scheduled")
+	ErrInvalidVisitDate          = errors.New("visit date cannot be empty")
+)
+
+// NewProspect cria um novo interessado no início do funil
+func NewProspect(fullName, phone string, source ProspectSource, createdBy *uuid.UUID) (*Prospect, error) {
+	now := time.Now()
+	prospect := &Prospect{
+		ID:        uuid.New(),
+		FullName:  strings.TrimSpace(fullName),
+		Phone:     strings.TrimSpace(phone),
+		Source:    source,
+		Status:    ProspectStatusNew,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := prospect.Validate(); err != nil {
+		return nil, err
+	}
+
+	return prospect, nil
+}
+
+// Validate verifica se o interessado possui dados válidos
+// O CPF só é validado na conversão em morador
+func (p *Prospect) Validate() error {
+	if p.FullName == "" {
+		return ErrInvalidFullName
+	}
+
+	if p.Phone == "" {
+		return ErrInvalidPhone
+	}
+
+	if p.Email != nil && *p.Email != "" && !emailRegex.MatchString(*p.Email) {
+		return ErrInvalidEmail
+	}
+
+	if !p.IsValidSource() {
+		return ErrInvalidProspectSource
+	}
+
+	if !p.IsValidStatus() {
+		return ErrInvalidProspectStatus
+	}
+
+	if p.MaxBudget != nil && p.MaxBudget.LessThanOrEqual(decimal.Zero) {
+		return ErrInvalidProspectBudget
+	}
+
+	if p.PreferredFloor != nil && *p.PreferredFloor < 1 {
+		return ErrInvalidFloor
+	}
+
+	return nil
+}
+
+// IsValidSource verifica se a origem é válida
+func (p *Prospect) IsValidSource() bool {
+	for _, s := range ValidProspectSources {
+		if p.Source == s {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidStatus verifica se o status é válido
+func (p *Prospect) IsValidStatus() bool {
+	return IsValidProspectStatus(p.Status)
+}
+
+// IsValidProspectStatus verifica se o status informado pertence ao funil
+func IsValidProspectStatus(status ProspectStatus) bool {
+	for _, s := range ValidProspectStatuses {
+		if status == s {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOpen verifica se o interessado ainda está no funil (não convertido nem perdido)
+func (p *Prospect) IsOpen() bool {
+	return p.Status != ProspectStatusConverted && p.Status != ProspectStatusLost
+}
+
+// CanTransitionTo verifica se o interessado pode mudar para o status informado
+func (p *Prospect) CanTransitionTo(newStatus ProspectStatus) bool {
+	for _, allowed := range prospectTransitions[p.Status] {
+		if allowed == newStatus {
+			return true
+		}
+	}
+	return false
+}
+
+// transitionTo aplica a mudança de status validando o fluxo
+func (p *Prospect) transitionTo(newStatus ProspectStatus) error {
+	if !p.CanTransitionTo(newStatus) {
+		return ErrInvalidProspectTransition
+	}
+	p.Status = newStatus
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// UpdateDetails atualiza os dados de contato e as preferências do interessado
+func (p *Prospect) UpdateDetails(
+	fullName, phone string,
+	email, cpf *string,
+	source ProspectSource,
+	desiredMoveInDate *time.Time,
+	maxBudget *decimal.Decimal,
+	preferredFloor *int,
+	notes *string,
+) error {
+	if p.Status == ProspectStatusConverted {
+		return ErrProspectClosed
+	}
+
+	p.FullName = strings.TrimSpace(fullName)
+	p.Phone = strings.TrimSpace(phone)
+	p.Email = email
+	p.CPF = cpf
+	p.Source = source
+	p.DesiredMoveInDate = desiredMoveInDate
+	p.MaxBudget = maxBudget
+	p.PreferredFloor = preferredFloor
+	p.Notes = notes
+	p.UpdatedAt = time.Now()
+
+	return p.Validate()
+}
+
+// Advance move o interessado para a próxima etapa do funil (visited, applied ou approved)
+// Perda, reabertura e conversão têm métodos próprios
+func (p *Prospect) Advance(newStatus ProspectStatus) error {
+	switch newStatus {
+	case ProspectStatusVisited, ProspectStatusApplied, ProspectStatusApproved:
+		return p.transitionTo(newStatus)
+	default:
+		return ErrInvalidProspectTransition
+	}
+}
+
+// MarkAsLost encerra o interessado registrando o motivo
+func (p *Prospect) MarkAsLost(reason *string) error {
+	if err := p.transitionTo(ProspectStatusLost); err != nil {
+		return err
+	}
+	p.LostReason = reason
+	return nil
+}
+
+// Reopen devolve um interessado perdido para o início do funil
+func (p *Prospect) Reopen() error {
+	if err := p.transitionTo(ProspectStatusNew); err != nil {
+		return err
+	}
+	p.LostReason = nil
+	return nil
+}
+
+// MarkAsConverted registra o morador criado a partir do interessado
+func (p *Prospect) MarkAsConverted(tenantID uuid.UUID) error {
+	if p.Status != ProspectStatusApproved {
+		return ErrProspectNotApproved
+	}
+	if err := p.transitionTo(ProspectStatusConverted); err != nil {
+		return err
+	}
+	now := time.Now()
+	p.ConvertedTenantID = &tenantID
+	p.ConvertedAt = &now
+	return nil
+}
+
+// FitsBudget verifica se o aluguel atual da unidade cabe no orçamento do interessado
+func (p *Prospect) FitsBudget(unit *Unit) bool {
+	return p.MaxBudget == nil || unit.CurrentRentValue.LessThanOrEqual(*p.MaxBudget)
+}
+
+// MatchesUnit verifica se a unidade está vaga e atende ao orçamento do interessado
+// O andar preferido não elimina unidades, apenas as prioriza
+func (p *Prospect) MatchesUnit(unit *Unit) bool {
+	return unit.Status == UnitStatusAvailable && p.FitsBudget(unit)
+}
+
+// prefersFloor verifica se a unidade está no andar preferido do interessado
+func (p *Prospect) prefersFloor(unit *Unit) bool {
+	return p.PreferredFloor != nil && *p.PreferredFloor == unit.Floor
+}
+
+// MatchUnits retorna as unidades que atendem ao interessado, começando pelas do andar
+// preferido e, em seguida, pelo menor aluguel
+func (p *Prospect) MatchUnits(units []*Unit) []*Unit {
+	matches := make([]*Unit, 0, len(units))
+	for _, unit := range units {
+		if p.MatchesUnit(unit) {
+			matches = append(matches, unit)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		pi, pj := p.prefersFloor(matches[i]), p.prefersFloor(matches[j])
+		if pi != pj {
+			return pi
+		}
+		if !matches[i].CurrentRentValue.Equal(matches[j].CurrentRentValue) {
+			return matches[i].CurrentRentValue.LessThan(matches[j].CurrentRentValue)
+		}
+		return matches[i].Number < matches[j].Number
+	})
+
+	return matches
+}
+
+// BuildWaitlist retorna os interessados em aberto cujo orçamento comporta a unidade, formando a
+// fila de espera (a unidade pode estar ocupada): primeiro quem prefere o andar da unidade, depois
+// pela data de mudança desejada mais próxima (sem data por último) e, por fim, por ordem de cadastro
+func BuildWaitlist(unit *Unit, prospects []*Prospect) []*Prospect {
+	waitlist := make([]*Prospect, 0, len(prospects))
+	for _, prospect := range prospects {
+		if prospect.IsOpen() && prospect.FitsBudget(unit) {
+			waitlist = append(waitlist, prospect)
+		}
+	}
+
+	sort.SliceStable(waitlist, func(i, j int) bool {
+		a, b := waitlist[i], waitlist[j]
+		pa, pb := a.prefersFloor(unit), b.prefersFloor(unit)
+		if pa != pb {
+			return pa
+		}
+		switch {
+		case a.DesiredMoveInDate != nil && b.DesiredMoveInDate == nil:
+			return true
+		case a.DesiredMoveInDate == nil && b.DesiredMoveInDate != nil:
+			return false
+		case a.DesiredMoveInDate != nil && !a.DesiredMoveInDate.Equal(*b.DesiredMoveInDate):
+			return a.DesiredMoveInDate.Before(*b.DesiredMoveInDate)
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+
+	return waitlist
+}
+
+// NewProspectVisit agenda uma visita para o interessado
+func NewProspectVisit(prospectID uuid.UUID, unitID *uuid.UUID, scheduledAt time.Time, notes *string, createdBy *uuid.UUID) (*ProspectVisit, error) {
+	if scheduledAt.IsZero() {
+		return nil, ErrInvalidVisitDate
+	}
+
+	now := time.Now()
+	return &ProspectVisit{
+		ID:          uuid.New(),
+		ProspectID:  prospectID,
+		UnitID:      unitID,
+		ScheduledAt: scheduledAt,
+		Status:      ProspectVisitStatusScheduled,
+		Notes:       notes,
+		CreatedBy:   createdBy,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// IsScheduled verifica se a visita ainda está agendada
+func (v *ProspectVisit) IsScheduled() bool {
+	return v.Status == ProspectVisitStatusScheduled
+}
+
+// finish encerra uma visita agendada com o status informado
+func (v *ProspectVisit) finish(status ProspectVisitStatus, notes *string) error {
+	if !v.IsScheduled() {
+		return ErrProspectVisitNotScheduled
+	}
+	v.Status = status
+	if notes != nil {
+		v.Notes = notes
+	}
+	v.UpdatedAt = time.Now()
+	return nil
+}
+
+// Complete marca a visita como realizada
+func (v *ProspectVisit) Complete(notes *string) error {
+	return v.finish(ProspectVisitStatusCompleted, notes)
+}
+
+// Cancel cancela a visita agendada
+func (v *ProspectVisit) Cancel(notes *string) error {
+	return v.finish(ProspectVisitStatusCancelled, notes)
+}
+
+// MarkAsNoShow registra que o interessado não compareceu
+func (v *ProspectVisit) MarkAsNoShow(notes *string) error {
+	return v.finish(ProspectVisitStatusNoShow, notes)
+}
+
+// String retorna uma representação em string do interessado
+func (p *Prospect) String() string {
+	return "Prospect " + p.ID.String() + " (" + p.FullName + " - " + string(p.Status) + ")"
+}
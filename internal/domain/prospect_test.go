@@ -0,0 +1,197 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProspect(t *testing.T) {
+	t.Run("should create new prospect", func(t *testing.T) {
+		prospect, err := NewProspect("  Maria Souza  ", " (11) 99999-0000 ", ProspectSourceWhatsApp, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Maria Souza", prospect.FullName)
+		assert.Equal(t, "(11) 99999-0000", prospect.Phone)
+		assert.Equal(t, ProspectStatusNew, prospect.Status)
+		assert.True(t, prospect.IsOpen())
+	})
+
+	t.Run("should fail without phone", func(t *testing.T) {
+		prospect, err := NewProspect("Maria Souza", "  ", ProspectSourceWhatsApp, nil)
+
+		assert.Nil(t, prospect)
+		assert.Equal(t, ErrInvalidPhone, err)
+	})
+
+	t.Run("should fail with invalid source", func(t *testing.T) {
+		prospect, err := NewProspect("Maria Souza", "11999990000", ProspectSource("instagram"), nil)
+
+		assert.Nil(t, prospect)
+		assert.Equal(t, ErrInvalidProspectSource, err)
+	})
+}
+
+func TestProspect_UpdateDetails(t *testing.T) {
+	prospect, err := NewProspect("Maria Souza", "11999990000", ProspectSourceWhatsApp, nil)
+	require.NoError(t, err)
+
+	t.Run("should reject non-positive budget", func(t *testing.T) {
+		budget := decimal.Zero
+		err := prospect.UpdateDetails("Maria Souza", "11999990000", nil, nil, ProspectSourceWhatsApp, nil, &budget, nil, nil)
+
+		assert.Equal(t, ErrInvalidProspectBudget, err)
+	})
+
+	t.Run("should reject invalid email", func(t *testing.T) {
+		email := "maria@"
+		err := prospect.UpdateDetails("Maria Souza", "11999990000", &email, nil, ProspectSourceWhatsApp, nil, nil, nil, nil)
+
+		assert.Equal(t, ErrInvalidEmail, err)
+	})
+
+	t.Run("should not update converted prospect", func(t *testing.T) {
+		converted := &Prospect{Status: ProspectStatusConverted}
+		err := converted.UpdateDetails("Maria Souza", "11999990000", nil, nil, ProspectSourceWhatsApp, nil, nil, nil, nil)
+
+		assert.Equal(t, ErrProspectClosed, err)
+	})
+}
+
+func TestProspect_Workflow(t *testing.T) {
+	newProspect := func(t *testing.T) *Prospect {
+		prospect, err := NewProspect("Maria Souza", "11999990000", ProspectSourcePhone, nil)
+		require.NoError(t, err)
+		return prospect
+	}
+
+	t.Run("should follow pipeline until conversion", func(t *testing.T) {
+		prospect := newProspect(t)
+		tenantID := uuid.New()
+
+		require.NoError(t, prospect.Advance(ProspectStatusVisited))
+		require.NoError(t, prospect.Advance(ProspectStatusApplied))
+		require.NoError(t, prospect.Advance(ProspectStatusApproved))
+		require.NoError(t, prospect.MarkAsConverted(tenantID))
+
+		assert.Equal(t, ProspectStatusConverted, prospect.Status)
+		assert.Equal(t, tenantID, *prospect.ConvertedTenantID)
+		assert.NotNil(t, prospect.ConvertedAt)
+		assert.False(t, prospect.IsOpen())
+	})
+
+	t.Run("should not skip approval", func(t *testing.T) {
+		prospect := newProspect(t)
+
+		assert.Equal(t, ErrInvalidProspectTransition, prospect.Advance(ProspectStatusApproved))
+		assert.Equal(t, ErrProspectNotApproved, prospect.MarkAsConverted(uuid.New()))
+	})
+
+	t.Run("should not advance to lost or converted", func(t *testing.T) {
+		prospect := newProspect(t)
+
+		assert.Equal(t, ErrInvalidProspectTransition, prospect.Advance(ProspectStatusLost))
+		assert.Equal(t, ErrInvalidProspectTransition, prospect.Advance(ProspectStatusConverted))
+	})
+
+	t.Run("should mark as lost and reopen", func(t *testing.T) {
+		prospect := newProspect(t)
+		reason := "Alugou em outro lugar"
+
+		require.NoError(t, prospect.MarkAsLost(&reason))
+		assert.Equal(t, ProspectStatusLost, prospect.Status)
+		assert.Equal(t, reason, *prospect.LostReason)
+		assert.Equal(t, ErrInvalidProspectTransition, prospect.Advance(ProspectStatusVisited))
+
+		require.NoError(t, prospect.Reopen())
+		assert.Equal(t, ProspectStatusNew, prospect.Status)
+		assert.Nil(t, prospect.LostReason)
+	})
+
+	t.Run("should not reopen open prospect", func(t *testing.T) {
+		prospect := newProspect(t)
+
+		assert.Equal(t, ErrInvalidProspectTransition, prospect.Reopen())
+	})
+}
+
+func TestProspect_MatchUnits(t *testing.T) {
+	budget := decimal.NewFromInt(900)
+	floor := 2
+	prospect := &Prospect{MaxBudget: &budget, PreferredFloor: &floor}
+
+	unit := func(number string, floor int, rent int64, status UnitStatus) *Unit {
+		return &Unit{ID: uuid.New(), Number: number, Floor: floor, Status: status, CurrentRentValue: decimal.NewFromInt(rent)}
+	}
+	cheapFirstFloor := unit("101", 1, 700, UnitStatusAvailable)
+	secondFloor := unit("201", 2, 850, UnitStatusAvailable)
+	expensive := unit("202", 2, 950, UnitStatusAvailable)
+	occupied := unit("102", 1, 600, UnitStatusOccupied)
+	atBudget := unit("103", 1, 900, UnitStatusAvailable)
+
+	matches := prospect.MatchUnits([]*Unit{cheapFirstFloor, expensive, occupied, atBudget, secondFloor})
+
+	require.Len(t, matches, 3)
+	assert.Equal(t, secondFloor.ID, matches[0].ID, "preferred floor comes first")
+	assert.Equal(t, cheapFirstFloor.ID, matches[1].ID)
+	assert.Equal(t, atBudget.ID, matches[2].ID)
+
+	t.Run("should match any unit without budget", func(t *testing.T) {
+		open := &Prospect{}
+
+		assert.Len(t, open.MatchUnits([]*Unit{cheapFirstFloor, expensive, occupied}), 2)
+	})
+}
+
+func TestBuildWaitlist(t *testing.T) {
+	unit := &Unit{ID: uuid.New(), Number: "201", Floor: 2, Status: UnitStatusOccupied, CurrentRentValue: decimal.NewFromInt(850)}
+
+	now := time.Now()
+	soon := now.AddDate(0, 0, 10)
+	later := now.AddDate(0, 1, 0)
+	highBudget := decimal.NewFromInt(1000)
+	lowBudget := decimal.NewFromInt(800)
+	secondFloor := 2
+
+	noDate := &Prospect{ID: uuid.New(), Status: ProspectStatusNew, CreatedAt: now.Add(-time.Hour)}
+	movingLater := &Prospect{ID: uuid.New(), Status: ProspectStatusVisited, DesiredMoveInDate: &later, MaxBudget: &highBudget, CreatedAt: now}
+	movingSoon := &Prospect{ID: uuid.New(), Status: ProspectStatusApplied, DesiredMoveInDate: &soon, CreatedAt: now}
+	prefersFloor := &Prospect{ID: uuid.New(), Status: ProspectStatusNew, PreferredFloor: &secondFloor, CreatedAt: now}
+	overBudget := &Prospect{ID: uuid.New(), Status: ProspectStatusApproved, MaxBudget: &lowBudget, CreatedAt: now}
+	lost := &Prospect{ID: uuid.New(), Status: ProspectStatusLost, CreatedAt: now}
+
+	waitlist := BuildWaitlist(unit, []*Prospect{noDate, movingLater, overBudget, movingSoon, lost, prefersFloor})
+
+	require.Len(t, waitlist, 4)
+	assert.Equal(t, prefersFloor.ID, waitlist[0].ID)
+	assert.Equal(t, movingSoon.ID, waitlist[1].ID)
+	assert.Equal(t, movingLater.ID, waitlist[2].ID)
+	assert.Equal(t, noDate.ID, waitlist[3].ID)
+}
+
+func TestProspectVisit(t *testing.T) {
+	t.Run("should require date", func(t *testing.T) {
+		visit, err := NewProspectVisit(uuid.New(), nil, time.Time{}, nil, nil)
+
+		assert.Nil(t, visit)
+		assert.Equal(t, ErrInvalidVisitDate, err)
+	})
+
+	t.Run("should only finish scheduled visits", func(t *testing.T) {
+		visit, err := NewProspectVisit(uuid.New(), nil, time.Now().Add(24*time.Hour), nil, nil)
+		require.NoError(t, err)
+		assert.True(t, visit.IsScheduled())
+
+		notes := "Gostou da unidade"
+		require.NoError(t, visit.Complete(&notes))
+		assert.Equal(t, ProspectVisitStatusCompleted, visit.Status)
+		assert.Equal(t, notes, *visit.Notes)
+
+		assert.Equal(t, ErrProspectVisitNotScheduled, visit.Cancel(nil))
+		assert.Equal(t, ErrProspectVisitNotScheduled, visit.MarkAsNoShow(nil))
+	})
+}
@@ -0,0 +1,309 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RenovationStatus representa os possíveis status de um projeto de reforma
+type RenovationStatus string
+
+const (
+	RenovationStatusPlanned    RenovationStatus = "planned"
+	RenovationStatusInProgress RenovationStatus = "in_progress"
+	RenovationStatusCompleted  RenovationStatus = "completed"
+	RenovationStatusCancelled  RenovationStatus = "cancelled"
+)
+
+// RenovationExpenseCategory representa a categoria de uma despesa de reforma
+type RenovationExpenseCategory string
+
+const (
+	RenovationExpenseCategoryMaterials  RenovationExpenseCategory = "materials"
+	RenovationExpenseCategoryLabor      RenovationExpenseCategory = "labor"
+	RenovationExpenseCategoryFurniture  RenovationExpenseCategory = "furniture"
+	RenovationExpenseCategoryAppliances RenovationExpenseCategory = "appliances"
+	RenovationExpenseCategoryServices   RenovationExpenseCategory = "services"
+	RenovationExpenseCategoryOther      RenovationExpenseCategory = "other"
+)
+
+// ValidRenovationStatuses contém todos os status válidos
+var ValidRenovationStatuses = []RenovationStatus{
+	RenovationStatusPlanned,
+	RenovationStatusInProgress,
+	RenovationStatusCompleted,
+	RenovationStatusCancelled,
+}
+
+// ValidRenovationExpenseCategories contém todas as categorias de despesa válidas
+var ValidRenovationExpenseCategories = []RenovationExpenseCategory{
+	RenovationExpenseCategoryMaterials,
+	RenovationExpenseCategoryLabor,
+	RenovationExpenseCategoryFurniture,
+	RenovationExpenseCategoryAppliances,
+	RenovationExpenseCategoryServices,
+	RenovationExpenseCategoryOther,
+}
+
+// renovationTransitions define o fluxo permitido entre status de um projeto de reforma
+var renovationTransitions = map[RenovationStatus][]RenovationStatus{
+	RenovationStatusPlanned:    {RenovationStatusInProgress, RenovationStatusCancelled},
+	RenovationStatusInProgress: {RenovationStatusCompleted, RenovationStatusCancelled},
+	RenovationStatusCompleted:  {},
+	RenovationStatusCancelled:  {},
+}
+
+// RenovationProject representa um projeto de reforma de uma unidade
+type RenovationProject struct {
+	ID                 uuid.UUID        `json:"id"`
+	UnitID             uuid.UUID        `json:"unit_id"`
+	Title              string           `json:"title"`
+	Description        string           `json:"description"`
+	Status             RenovationStatus `json:"status"`
+	PlannedStartDate   time.Time        `json:"planned_start_date"`
+	PlannedEndDate     time.Time        `json:"planned_end_date"`
+	Budget             decimal.Decimal  `json:"budget"`
+	PreviousUnitStatus *UnitStatus      `json:"previous_unit_status,omitempty"`
+	StartedAt          *time.Time       `json:"started_at,omitempty"`
+	CompletedAt        *time.Time       `json:"completed_at,omitempty"`
+	CancelledAt        *time.Time       `json:"cancelled_at,omitempty"`
+	Notes              *string          `json:"notes,omitempty"`
+	CreatedBy          *uuid.UUID       `json:"created_by,omitempty"`
+	CreatedAt          time.Time        `json:"created_at"`
+	UpdatedAt          time.Time        `json:"updated_at"`
+}
+
+// RenovationExpense representa uma despesa lançada em um projeto de reforma
+type RenovationExpense struct {
+	ID          uuid.UUID                 `json:"id"`
+	ProjectID   uuid.UUID                 `json:"project_id"`
+	Description string                    `json:"description"`
+	Category    RenovationExpenseCategory `json:"category"`
+	Amount      decimal.Decimal           `json:"amount"`
+	ExpenseDate time.Time                 `json:"expense_date"`
+	Supplier    *string                   `json:"supplier,omitempty"`
+	ReceiptURL  *string                   `json:"receipt_url,omitempty"`
+	CreatedBy   *uuid.UUID                `json:"created_by,omitempty"`
+	CreatedAt   time.Time                 `json:"created_at"`
+}
+
+// Domain errors específicos de RenovationProject
+var (
+	ErrInvalidRenovationTitle      = errors.New("renovation title cannot be empty")
+	ErrInvalidRenovationStatus     = errors.New("invalid renovation status")
+	ErrInvalidRenovationTransition = errors.New("invalid renovation status transition")
+	ErrInvalidRenovationDates      = errors.New("planned end date must be on or after planned start date")
+	ErrInvalidRenovationBudget     = errors.New("renovation budget cannot be negative")
+	ErrRenovationFinished          = errors.New("renovation project is already completed or cancelled")
+	ErrInvalidExpenseDescription   = errors.New("expense description cannot be empty")
+	ErrInvalidExpenseCategory      = errors.New("invalid expense category")
+	ErrInvalidExpenseAmount        = errors.New("expense amount must be greater than zero")
+	ErrInvalidExpenseDate          = errors.New("expense date is required")
+)
+
+// NewRenovationProject cria um novo projeto de reforma planejado
+func NewRenovationProject(
+	unitID uuid.UUID,
+	title, description string,
+	plannedStart, plannedEnd time.Time,
+	budget decimal.Decimal,
+	createdBy *uuid.UUID,
+) (*RenovationProject, error) {
+	now := time.Now()
+	project := &RenovationProject{
+		ID:               uuid.New(),
+		UnitID:           unitID,
+		Title:            strings.TrimSpace(title),
+		Description:      strings.TrimSpace(description),
+		Status:           RenovationStatusPlanned,
+		PlannedStartDate: plannedStart,
+		PlannedEndDate:   plannedEnd,
+		Budget:           budget,
+		CreatedBy:        createdBy,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	if err := project.Validate(); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// Validate verifica se o projeto possui dados válidos
+func (p *RenovationProject) Validate() error {
+	if p.Title == "" {
+		return ErrInvalidRenovationTitle
+	}
+
+	if !p.IsValidStatus() {
+		return ErrInvalidRenovationStatus
+	}
+
+	if p.PlannedEndDate.Before(p.PlannedStartDate) {
+		return ErrInvalidRenovationDates
+	}
+
+	if p.Budget.IsNegative() {
+		return ErrInvalidRenovationBudget
+	}
+
+	return nil
+}
+
+// IsValidStatus verifica se o status é válido
+func (p *RenovationProject) IsValidStatus() bool {
+	for _, s := range ValidRenovationStatuses {
+		if p.Status == s {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinished verifica se o projeto já foi concluído ou cancelado
+func (p *RenovationProject) IsFinished() bool {
+	return p.Status == RenovationStatusCompleted || p.Status == RenovationStatusCancelled
+}
+
+// CanTransitionTo verifica se o projeto pode mudar para o status informado
+func (p *RenovationProject) CanTransitionTo(newStatus RenovationStatus) bool {
+	for _, allowed := range renovationTransitions[p.Status] {
+		if allowed == newStatus {
+			return true
+		}
+	}
+	return false
+}
+
+// transitionTo aplica a mudança de status validando o fluxo
+func (p *RenovationProject) transitionTo(newStatus RenovationStatus) error {
+	if !p.CanTransitionTo(newStatus) {
+		return ErrInvalidRenovationTransition
+	}
+	p.Status = newStatus
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// UpdateDetails atualiza os dados de planejamento do projeto
+func (p *RenovationProject) UpdateDetails(title, description string, plannedStart, plannedEnd time.Time, budget decimal.Decimal, notes *string) error {
+	if p.IsFinished() {
+		return ErrRenovationFinished
+	}
+
+	p.Title = strings.TrimSpace(title)
+	p.Description = strings.TrimSpace(description)
+	p.PlannedStartDate = plannedStart
+	p.PlannedEndDate = plannedEnd
+	p.Budget = budget
+	p.Notes = notes
+	p.UpdatedAt = time.Now()
+
+	return p.Validate()
+}
+
+// Start inicia a obra guardando o status da unidade para eventual cancelamento
+func (p *RenovationProject) Start(previousUnitStatus UnitStatus) error {
+	if err := p.transitionTo(RenovationStatusInProgress); err != nil {
+		return err
+	}
+	now := time.Now()
+	p.StartedAt = &now
+	p.PreviousUnitStatus = &previousUnitStatus
+	return nil
+}
+
+// Complete conclui a obra
+func (p *RenovationProject) Complete() error {
+	if err := p.transitionTo(RenovationStatusCompleted); err != nil {
+		return err
+	}
+	now := time.Now()
+	p.CompletedAt = &now
+	return nil
+}
+
+// Cancel cancela um projeto planejado ou em andamento
+func (p *RenovationProject) Cancel(reason *string) error {
+	if err := p.transitionTo(RenovationStatusCancelled); err != nil {
+		return err
+	}
+	now := time.Now()
+	p.CancelledAt = &now
+	if reason != nil {
+		p.Notes = reason
+	}
+	return nil
+}
+
+// NewRenovationExpense cria uma nova despesa para um projeto de reforma
+func NewRenovationExpense(
+	projectID uuid.UUID,
+	description string,
+	category RenovationExpenseCategory,
+	amount decimal.Decimal,
+	expenseDate time.Time,
+	supplier, receiptURL *string,
+	createdBy *uuid.UUID,
+) (*RenovationExpense, error) {
+	expense := &RenovationExpense{
+		ID:          uuid.New(),
+		ProjectID:   projectID,
+		Description: strings.TrimSpace(description),
+		Category:    category,
+		Amount:      amount,
+		ExpenseDate: expenseDate,
+		Supplier:    supplier,
+		ReceiptURL:  receiptURL,
+		CreatedBy:   createdBy,
+		CreatedAt:   time.Now(),
+	}
+
+	if err := expense.Validate(); err != nil {
+		return nil, err
+	}
+
+	return expense, nil
+}
+
+// Validate verifica se a despesa possui dados válidos
+func (e *RenovationExpense) Validate() error {
+	if e.Description == "" {
+		return ErrInvalidExpenseDescription
+	}
+
+	if !e.IsValidCategory() {
+		return ErrInvalidExpenseCategory
+	}
+
+	if e.Amount.LessThanOrEqual(decimal.Zero) {
+		return ErrInvalidExpenseAmount
+	}
+
+	if e.ExpenseDate.IsZero() {
+		return ErrInvalidExpenseDate
+	}
+
+	return nil
+}
+
+// IsValidCategory verifica se a categoria é válida
+func (e *RenovationExpense) IsValidCategory() bool {
+	for _, c := range ValidRenovationExpenseCategories {
+		if e.Category == c {
+			return true
+		}
+	}
+	return false
+}
+
+// String retorna uma representação em string do projeto
+func (p *RenovationProject) String() string {
+	return "RenovationProject " + p.ID.String() + " (" + p.Title + " - " + string(p.Status) + ")"
+}
@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRenovationProject(t *testing.T) {
+	unitID := uuid.New()
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	t.Run("should create planned project", func(t *testing.T) {
+		project, err := NewRenovationProject(unitID, "  Reforma completa  ", "Piso e pintura", start, end, decimal.NewFromInt(8000), nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Reforma completa", project.Title)
+		assert.Equal(t, RenovationStatusPlanned, project.Status)
+		assert.False(t, project.IsFinished())
+	})
+
+	t.Run("should fail with empty title", func(t *testing.T) {
+		project, err := NewRenovationProject(unitID, "  ", "", start, end, decimal.NewFromInt(8000), nil)
+
+		assert.Nil(t, project)
+		assert.Equal(t, ErrInvalidRenovationTitle, err)
+	})
+
+	t.Run("should fail when end date is before start date", func(t *testing.T) {
+		project, err := NewRenovationProject(unitID, "Reforma", "", end, start, decimal.NewFromInt(8000), nil)
+
+		assert.Nil(t, project)
+		assert.Equal(t, ErrInvalidRenovationDates, err)
+	})
+
+	t.Run("should fail with negative budget", func(t *testing.T) {
+		project, err := NewRenovationProject(unitID, "Reforma", "", start, end, decimal.NewFromInt(-1), nil)
+
+		assert.Nil(t, project)
+		assert.Equal(t, ErrInvalidRenovationBudget, err)
+	})
+}
+
+func TestRenovationProject_Workflow(t *testing.T) {
+	newProject := func(t *testing.T) *RenovationProject {
+		start := time.Now()
+		project, err := NewRenovationProject(uuid.New(), "Reforma", "", start, start.AddDate(0, 0, 30), decimal.NewFromInt(5000), nil)
+		require.NoError(t, err)
+		return project
+	}
+
+	t.Run("should follow planned -> in_progress -> completed", func(t *testing.T) {
+		project := newProject(t)
+
+		require.NoError(t, project.Start(UnitStatusAvailable))
+		assert.Equal(t, RenovationStatusInProgress, project.Status)
+		assert.NotNil(t, project.StartedAt)
+		require.NotNil(t, project.PreviousUnitStatus)
+		assert.Equal(t, UnitStatusAvailable, *project.PreviousUnitStatus)
+
+		require.NoError(t, project.Complete())
+		assert.Equal(t, RenovationStatusCompleted, project.Status)
+		assert.NotNil(t, project.CompletedAt)
+		assert.True(t, project.IsFinished())
+	})
+
+	t.Run("should not complete a planned project", func(t *testing.T) {
+		project := newProject(t)
+
+		assert.Equal(t, ErrInvalidRenovationTransition, project.Complete())
+	})
+
+	t.Run("should cancel and block further updates", func(t *testing.T) {
+		project := newProject(t)
+		reason := "Orçamento não aprovado"
+
+		require.NoError(t, project.Cancel(&reason))
+		assert.Equal(t, RenovationStatusCancelled, project.Status)
+		assert.Equal(t, &reason, project.Notes)
+
+		err := project.UpdateDetails("Nova", "", project.PlannedStartDate, project.PlannedEndDate, project.Budget, nil)
+		assert.Equal(t, ErrRenovationFinished, err)
+	})
+}
+
+func TestNewRenovationExpense(t *testing.T) {
+	projectID := uuid.New()
+	date := time.Now()
+
+	t.Run("should create expense", func(t *testing.T) {
+		expense, err := NewRenovationExpense(projectID, "Porcelanato", RenovationExpenseCategoryMaterials, decimal.NewFromInt(1200), date, nil, nil, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, projectID, expense.ProjectID)
+		assert.True(t, expense.Amount.Equal(decimal.NewFromInt(1200)))
+	})
+
+	t.Run("should fail with invalid category", func(t *testing.T) {
+		expense, err := NewRenovationExpense(projectID, "Porcelanato", RenovationExpenseCategory("tax"), decimal.NewFromInt(1200), date, nil, nil, nil)
+
+		assert.Nil(t, expense)
+		assert.Equal(t, ErrInvalidExpenseCategory, err)
+	})
+
+	t.Run("should fail with zero amount", func(t *testing.T) {
+		expense, err := NewRenovationExpense(projectID, "Porcelanato", RenovationExpenseCategoryMaterials, decimal.Zero, date, nil, nil, nil)
+
+		assert.Nil(t, expense)
+		assert.Equal(t, ErrInvalidExpenseAmount, err)
+	})
+}
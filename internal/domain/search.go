@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SearchResultType identifica a entidade encontrada na busca global
+type SearchResultType string
+
+const (
+	SearchResultTenant  SearchResultType = "tenant"
+	SearchResultUnit    SearchResultType = "unit"
+	SearchResultLease   SearchResultType = "lease"
+	SearchResultPayment SearchResultType = "payment"
+)
+
+// ValidSearchResultTypes contém todos os tipos pesquisáveis
+var ValidSearchResultTypes = []SearchResultType{
+	SearchResultTenant,
+	SearchResultUnit,
+	SearchResultLease,
+	SearchResultPayment,
+}
+
+const (
+	// SearchQueryMinLength é o tamanho mínimo do termo de busca
+	SearchQueryMinLength = 2
+	// SearchQueryMaxLength é o tamanho máximo do termo de busca
+	SearchQueryMaxLength = 100
+	// searchDigitsMinLength é a quantidade mínima de dígitos para buscar CPF e telefone
+	searchDigitsMinLength = 3
+)
+
+var (
+	ErrSearchQueryTooShort  = errors.New("search query is too short")
+	ErrSearchQueryTooLong   = errors.New("search query is too long")
+	ErrInvalidSearchType    = errors.New("invalid search result type")
+	searchNumericPattern    = regexp.MustCompile(`^[0-9\s.\-/()+]+$`)
+	searchAmountPattern     = regexp.MustCompile(`^(?i:r\$)?\s*[0-9][0-9.,]*$`)
+	searchThousandsPattern  = regexp.MustCompile(`^[0-9]{1,3}(\.[0-9]{3})+$`)
+	searchMonthSlashPattern = regexp.MustCompile(`^(0?[1-9]|1[0-2])/([0-9]{4})$`)
+	searchMonthISOPattern   = regexp.MustCompile(`^([0-9]{4})-(0[1-9]|1[0-2])$`)
+	searchNonDigitPattern   = regexp.MustCompile(`[^0-9]`)
+	searchWhitespacePattern = regexp.MustCompile(`\s+`)
+)
+
+// SearchResult representa um item encontrado na busca global
+type SearchResult struct {
+	Type     SearchResultType
+	ID       uuid.UUID
+	Title    string
+	Subtitle string
+	Status   string
+	Rank     float64
+}
+
+// SearchQuery representa o termo de busca já interpretado
+type SearchQuery struct {
+	Text           string           // Termo normalizado, usado em nomes, e-mail e número da unidade
+	Digits         string           // Apenas os dígitos, usado em CPF e telefone (vazio se o termo não for numérico)
+	Amount         *decimal.Decimal // Valor monetário, quando o termo puder ser lido como valor
+	ReferenceMonth *time.Time       // Primeiro dia do mês, quando o termo for MM/AAAA ou AAAA-MM
+}
+
+// ParseSearchQuery valida e interpreta o termo digitado na caixa de busca
+func ParseSearchQuery(raw string) (*SearchQuery, error) {
+	text := searchWhitespacePattern.ReplaceAllString(strings.TrimSpace(raw), " ")
+
+	length := utf8.RuneCountInString(text)
+	if length < SearchQueryMinLength {
+		return nil, ErrSearchQueryTooShort
+	}
+	if length > SearchQueryMaxLength {
+		return nil, ErrSearchQueryTooLong
+	}
+
+	query := &SearchQuery{Text: text}
+
+	if searchNumericPattern.MatchString(text) {
+		digits := searchNonDigitPattern.ReplaceAllString(text, "")
+		if len(digits) >= searchDigitsMinLength {
+			query.Digits = digits
+		}
+	}
+
+	query.Amount = parseSearchAmount(text)
+	query.ReferenceMonth = parseSearchReferenceMonth(text)
+
+	return query, nil
+}
+
+// IsValidSearchResultType verifica se o tipo de resultado é pesquisável
+func IsValidSearchResultType(resultType SearchResultType) bool {
+	for _, valid := range ValidSearchResultTypes {
+		if resultType == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSearchAmount aceita "850", "850,00", "850.00", "1.250,00" e "R$ 850,00"
+func parseSearchAmount(text string) *decimal.Decimal {
+	if !searchAmountPattern.MatchString(text) {
+		return nil
+	}
+
+	value := strings.TrimSpace(text)
+	if len(value) >= 2 && strings.EqualFold(value[:2], "r$") {
+		value = strings.TrimSpace(value[2:])
+	}
+
+	switch {
+	case strings.Contains(value, ","):
+		// Formato brasileiro: ponto separa milhar e vírgula separa centavos
+		value = strings.ReplaceAll(value, ".", "")
+		value = strings.Replace(value, ",", ".", 1)
+	case searchThousandsPattern.MatchString(value):
+		value = strings.ReplaceAll(value, ".", "")
+	}
+
+	amount, err := decimal.NewFromString(value)
+	if err != nil || !amount.IsPositive() || amount.Exponent() < -2 {
+		return nil
+	}
+
+	return &amount
+}
+
+// parseSearchReferenceMonth aceita "03/2025", "3/2025" e "2025-03"
+func parseSearchReferenceMonth(text string) *time.Time {
+	var layout, value string
+
+	switch {
+	case searchMonthSlashPattern.MatchString(text):
+		layout, value = "1/2006", text
+	case searchMonthISOPattern.MatchString(text):
+		layout, value = "2006-01", text
+	default:
+		return nil
+	}
+
+	month, err := time.Parse(layout, value)
+	if err != nil {
+		return nil
+	}
+
+	month = time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return &month
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	t.Run("should reject short query", func(t *testing.T) {
+		query, err := ParseSearchQuery("  a ")
+
+		assert.Nil(t, query)
+		assert.Equal(t, ErrSearchQueryTooShort, err)
+	})
+
+	t.Run("should normalize text without numeric interpretations", func(t *testing.T) {
+		query, err := ParseSearchQuery("  Maria   Souza ")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Maria Souza", query.Text)
+		assert.Empty(t, query.Digits)
+		assert.Nil(t, query.Amount)
+		assert.Nil(t, query.ReferenceMonth)
+	})
+
+	t.Run("should extract digits from CPF and phone fragments", func(t *testing.T) {
+		cpf, err := ParseSearchQuery("123.456")
+		require.NoError(t, err)
+		assert.Equal(t, "123456", cpf.Digits)
+
+		phone, err := ParseSearchQuery("(11) 99999")
+		require.NoError(t, err)
+		assert.Equal(t, "1199999", phone.Digits)
+
+		short, err := ParseSearchQuery("12")
+		require.NoError(t, err)
+		assert.Empty(t, short.Digits)
+	})
+
+	t.Run("should parse amounts", func(t *testing.T) {
+		cases := map[string]string{
+			"850":         "850",
+			"850,00":      "850",
+			"850.50":      "850.5",
+			"1.250,00":    "1250",
+			"1.250":       "1250",
+			"R$ 1.250,75": "1250.75",
+		}
+		for raw, expected := range cases {
+			query, err := ParseSearchQuery(raw)
+			require.NoError(t, err)
+			require.NotNil(t, query.Amount, raw)
+			assert.True(t, decimal.RequireFromString(expected).Equal(*query.Amount), raw)
+		}
+
+		query, err := ParseSearchQuery("12,345")
+		require.NoError(t, err)
+		assert.Nil(t, query.Amount)
+	})
+
+	t.Run("should parse reference month", func(t *testing.T) {
+		expected := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+		for _, raw := range []string{"03/2025", "3/2025", "2025-03"} {
+			query, err := ParseSearchQuery(raw)
+			require.NoError(t, err)
+			require.NotNil(t, query.ReferenceMonth, raw)
+			assert.True(t, expected.Equal(*query.ReferenceMonth), raw)
+		}
+
+		query, err := ParseSearchQuery("13/2025")
+		require.NoError(t, err)
+		assert.Nil(t, query.ReferenceMonth)
+	})
+}
+
+func TestIsValidSearchResultType(t *testing.T) {
+	assert.True(t, IsValidSearchResultType(SearchResultPayment))
+	assert.False(t, IsValidSearchResultType(SearchResultType("property")))
+}
@@ -0,0 +1,188 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// DepositCustody representa onde a caução fica guardada durante o contrato
+type DepositCustody string
+
+const (
+	DepositCustodySavingsAccount DepositCustody = "savings_account"
+	DepositCustodyBankAccount    DepositCustody = "bank_account"
+	DepositCustodyCash           DepositCustody = "cash"
+	DepositCustodyOther          DepositCustody = "other"
+)
+
+// ValidDepositCustodies contém todos os locais válidos de guarda da caução
+var ValidDepositCustodies = []DepositCustody{
+	DepositCustodySavingsAccount,
+	DepositCustodyBankAccount,
+	DepositCustodyCash,
+	DepositCustodyOther,
+}
+
+// DepositCorrectionIndex representa o índice usado para corrigir a caução na devolução
+type DepositCorrectionIndex string
+
+const (
+	DepositCorrectionSavings DepositCorrectionIndex = "savings"
+	DepositCorrectionIPCA    DepositCorrectionIndex = "ipca"
+	DepositCorrectionIGPM    DepositCorrectionIndex = "igpm"
+	DepositCorrectionNone    DepositCorrectionIndex = "none"
+)
+
+// ValidDepositCorrectionIndexes contém todos os índices válidos de correção
+var ValidDepositCorrectionIndexes = []DepositCorrectionIndex{
+	DepositCorrectionSavings,
+	DepositCorrectionIPCA,
+	DepositCorrectionIGPM,
+	DepositCorrectionNone,
+}
+
+// MaxSecurityDepositMonths é o limite legal da caução em meses de aluguel (Lei 8.245/91, art. 38)
+const MaxSecurityDepositMonths = 3
+
+// DepositSettlement representa o acerto da caução na saída do morador
+type DepositSettlement struct {
+	ID               uuid.UUID       `json:"id"`
+	LeaseID          uuid.UUID       `json:"lease_id"`
+	DepositAmount    decimal.Decimal `json:"deposit_amount"`
+	CorrectionRate   decimal.Decimal `json:"correction_rate"` // Percentual acumulado do índice no período
+	CorrectionAmount decimal.Decimal `json:"correction_amount"`
+	DamageDeductions decimal.Decimal `json:"damage_deductions"`
+	DebtDeductions   decimal.Decimal `json:"debt_deductions"`
+	OtherDeductions  decimal.Decimal `json:"other_deductions"`
+	RefundAmount     decimal.Decimal `json:"refund_amount"`
+	BalanceDue       decimal.Decimal `json:"balance_due"` // Quanto o morador ainda deve se os descontos superam a caução
+	RefundedAt       *time.Time      `json:"refunded_at,omitempty"`
+	RefundMethod     *PaymentMethod  `json:"refund_method,omitempty"`
+	Notes            *string         `json:"notes,omitempty"`
+	CreatedBy        *uuid.UUID      `json:"created_by,omitempty"`
+	CreatedAt        time.Time       `json:"created_at"`
+
+	SettledPaymentIDs []uuid.UUID `json:"settled_payment_ids,omitempty"` // Pagamentos em aberto quitados com a caução
+}
+
+// Domain errors específicos da caução
+var (
+	ErrInvalidSecurityDeposit        = errors.New("security deposit must be greater than or equal to zero")
+	ErrSecurityDepositExceedsLimit   = errors.New("security deposit cannot exceed three months of rent")
+	ErrInvalidDepositCustody         = errors.New("invalid deposit custody")
+	ErrInvalidDepositCorrectionIndex = errors.New("invalid deposit correction index")
+	ErrInvalidDepositDeduction       = errors.New("deposit deductions must be greater than or equal to zero")
+	ErrInvalidCorrectionRate         = errors.New("correction rate must be greater than -100")
+)
+
+// IsValidDepositCustody verifica se o local de guarda da caução é válido
+func IsValidDepositCustody(custody DepositCustody) bool {
+	for _, valid := range ValidDepositCustodies {
+		if custody == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidDepositCorrectionIndex verifica se o índice de correção é válido
+func IsValidDepositCorrectionIndex(index DepositCorrectionIndex) bool {
+	for _, valid := range ValidDepositCorrectionIndexes {
+		if index == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// NewDepositSettlement calcula o acerto da caução: valor corrigido menos os descontos
+func NewDepositSettlement(leaseID uuid.UUID, depositAmount, correctionRate, damages, debts, other decimal.Decimal) (*DepositSettlement, error) {
+	if depositAmount.LessThan(decimal.Zero) {
+		return nil, ErrInvalidSecurityDeposit
+	}
+	if correctionRate.LessThanOrEqual(decimal.NewFromInt(-100)) {
+		return nil, ErrInvalidCorrectionRate
+	}
+	if damages.LessThan(decimal.Zero) || debts.LessThan(decimal.Zero) || other.LessThan(decimal.Zero) {
+		return nil, ErrInvalidDepositDeduction
+	}
+
+	settlement := &DepositSettlement{
+		ID:               uuid.New(),
+		LeaseID:          leaseID,
+		DepositAmount:    depositAmount,
+		CorrectionRate:   correctionRate,
+		CorrectionAmount: depositAmount.Mul(correctionRate).Div(decimal.NewFromInt(100)).Round(2),
+		DamageDeductions: damages,
+		DebtDeductions:   debts,
+		OtherDeductions:  other,
+		CreatedAt:        time.Now(),
+	}
+	settlement.calculateBalance()
+
+	return settlement, nil
+}
+
+// AvailableAmount retorna o valor corrigido da caução
+func (s *DepositSettlement) AvailableAmount() decimal.Decimal {
+	return s.DepositAmount.Add(s.CorrectionAmount)
+}
+
+// calculateBalance calcula o saldo a devolver ou, se os descontos superam a caução, o saldo devedor
+func (s *DepositSettlement) calculateBalance() {
+	refund := s.AvailableAmount().Sub(s.TotalDeductions())
+	s.BalanceDue = decimal.Zero
+	if refund.LessThan(decimal.Zero) {
+		s.BalanceDue = refund.Neg()
+		refund = decimal.Zero
+	}
+	s.RefundAmount = refund
+}
+
+// CoverDebts quita com a caução os pagamentos em aberto, do vencimento mais antigo ao mais recente, e
+// desconta apenas os quitados. As dívidas têm prioridade sobre danos e outros descontos, que usam o que sobrar
+// Um pagamento só é quitado se couber inteiro no saldo restante; os que não cabem continuam em aberto
+// para cobrança e não entram no saldo devedor do acerto
+func (s *DepositSettlement) CoverDebts(debts []*Payment) {
+	sorted := make([]*Payment, len(debts))
+	copy(sorted, debts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].DueDate.Before(sorted[j].DueDate)
+	})
+
+	available := s.AvailableAmount()
+	s.DebtDeductions = decimal.Zero
+	s.SettledPaymentIDs = nil
+	for _, p := range sorted {
+		if p.Amount.GreaterThan(available) {
+			continue
+		}
+		available = available.Sub(p.Amount)
+		s.DebtDeductions = s.DebtDeductions.Add(p.Amount)
+		s.SettledPaymentIDs = append(s.SettledPaymentIDs, p.ID)
+	}
+
+	s.calculateBalance()
+}
+
+// TotalDeductions retorna a soma de todos os descontos aplicados à caução
+func (s *DepositSettlement) TotalDeductions() decimal.Decimal {
+	return s.DamageDeductions.Add(s.DebtDeductions).Add(s.OtherDeductions)
+}
+
+// MarkRefunded registra a devolução do saldo ao morador
+func (s *DepositSettlement) MarkRefunded(refundedAt time.Time, method PaymentMethod) error {
+	// Validar método de devolução
+	tempPayment := &Payment{PaymentMethod: &method}
+	if !tempPayment.IsValidMethod() {
+		return ErrInvalidPaymentMethod
+	}
+
+	s.RefundedAt = &refundedAt
+	s.RefundMethod = &method
+	return nil
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLease_SetSecurityDeposit(t *testing.T) {
+	newLease := func() *Lease {
+		start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		lease, err := NewLease(uuid.New(), uuid.New(), start, start, 5, decimal.NewFromInt(1000), decimal.Zero, 1)
+		require.NoError(t, err)
+		return lease
+	}
+
+	t.Run("should accept up to three months of rent", func(t *testing.T) {
+		lease := newLease()
+		heldAt := DepositCustodySavingsAccount
+		index := DepositCorrectionSavings
+
+		err := lease.SetSecurityDeposit(decimal.NewFromInt(3000), &heldAt, &index)
+
+		require.NoError(t, err)
+		assert.True(t, lease.HasSecurityDeposit())
+		assert.True(t, lease.IsDepositCorrected())
+		assert.False(t, lease.IsDepositReceived())
+	})
+
+	t.Run("should reject deposit above three months of rent", func(t *testing.T) {
+		lease := newLease()
+
+		err := lease.SetSecurityDeposit(decimal.RequireFromString("3000.01"), nil, nil)
+
+		assert.Equal(t, ErrSecurityDepositExceedsLimit, err)
+		assert.False(t, lease.HasSecurityDeposit())
+	})
+
+	t.Run("should reject invalid custody", func(t *testing.T) {
+		lease := newLease()
+		heldAt := DepositCustody("safe")
+
+		err := lease.SetSecurityDeposit(decimal.NewFromInt(1000), &heldAt, nil)
+
+		assert.Equal(t, ErrInvalidDepositCustody, err)
+	})
+}
+
+func TestNewDepositSettlement(t *testing.T) {
+	leaseID := uuid.New()
+
+	t.Run("should refund corrected deposit minus deductions", func(t *testing.T) {
+		settlement, err := NewDepositSettlement(leaseID, decimal.NewFromInt(2000), decimal.RequireFromString("3.5"),
+			decimal.NewFromInt(300), decimal.NewFromInt(500), decimal.Zero)
+
+		require.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(70).Equal(settlement.CorrectionAmount))
+		assert.True(t, decimal.NewFromInt(800).Equal(settlement.TotalDeductions()))
+		assert.True(t, decimal.NewFromInt(1270).Equal(settlement.RefundAmount))
+		assert.True(t, settlement.BalanceDue.IsZero())
+	})
+
+	t.Run("should record balance due when deductions exceed deposit", func(t *testing.T) {
+		settlement, err := NewDepositSettlement(leaseID, decimal.NewFromInt(1000), decimal.Zero,
+			decimal.NewFromInt(400), decimal.NewFromInt(900), decimal.Zero)
+
+		require.NoError(t, err)
+		assert.True(t, settlement.RefundAmount.IsZero())
+		assert.True(t, decimal.NewFromInt(300).Equal(settlement.BalanceDue))
+	})
+
+	t.Run("should fail with negative deductions", func(t *testing.T) {
+		settlement, err := NewDepositSettlement(leaseID, decimal.NewFromInt(1000), decimal.Zero,
+			decimal.NewFromInt(-1), decimal.Zero, decimal.Zero)
+
+		assert.Nil(t, settlement)
+		assert.Equal(t, ErrInvalidDepositDeduction, err)
+	})
+}
+
+func TestDepositSettlement_CoverDebts(t *testing.T) {
+	due := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+	newDebt := func(amount int64, months int) *Payment {
+		return &Payment{ID: uuid.New(), Amount: decimal.NewFromInt(amount), DueDate: due.AddDate(0, months, 0)}
+	}
+
+	t.Run("should settle oldest debts that fit in the corrected deposit", func(t *testing.T) {
+		// Caução de 1000 com 10% de correção: 1100 disponíveis
+		settlement, err := NewDepositSettlement(uuid.New(), decimal.NewFromInt(1000), decimal.NewFromInt(10), decimal.Zero, decimal.NewFromInt(1700), decimal.Zero)
+		require.NoError(t, err)
+
+		march := newDebt(800, 2)
+		january := newDebt(600, 0)
+		february := newDebt(300, 1)
+		settlement.CoverDebts([]*Payment{march, january, february})
+
+		// Março não cabe no saldo restante e continua em aberto, fora do desconto
+		assert.Equal(t, []uuid.UUID{january.ID, february.ID}, settlement.SettledPaymentIDs)
+		assert.True(t, decimal.NewFromInt(900).Equal(settlement.DebtDeductions))
+		assert.True(t, decimal.NewFromInt(200).Equal(settlement.RefundAmount))
+		assert.True(t, settlement.BalanceDue.IsZero())
+	})
+
+	t.Run("should deduct only settled debts when deposit covers them partially", func(t *testing.T) {
+		// Caução de 1000 e dívidas de 800 e 700: a de 700 continua em aberto e não é cobrada em dobro
+		settlement, err := NewDepositSettlement(uuid.New(), decimal.NewFromInt(1000), decimal.Zero, decimal.Zero, decimal.Zero, decimal.Zero)
+		require.NoError(t, err)
+
+		january := newDebt(800, 0)
+		february := newDebt(700, 1)
+		settlement.CoverDebts([]*Payment{february, january})
+
+		assert.Equal(t, []uuid.UUID{january.ID}, settlement.SettledPaymentIDs)
+		assert.True(t, decimal.NewFromInt(800).Equal(settlement.DebtDeductions))
+		assert.True(t, decimal.NewFromInt(200).Equal(settlement.RefundAmount))
+		assert.True(t, settlement.BalanceDue.IsZero())
+		assert.True(t, settlement.AvailableAmount().Equal(settlement.TotalDeductions().Add(settlement.RefundAmount)))
+	})
+
+	t.Run("should apply damages to what is left after debts", func(t *testing.T) {
+		settlement, err := NewDepositSettlement(uuid.New(), decimal.NewFromInt(1000), decimal.Zero, decimal.NewFromInt(500), decimal.Zero, decimal.NewFromInt(100))
+		require.NoError(t, err)
+
+		settlement.CoverDebts([]*Payment{newDebt(800, 0)})
+
+		// Dívida quitada primeiro; danos e outros descontos excedem o saldo de 200 em 400
+		assert.True(t, decimal.NewFromInt(800).Equal(settlement.DebtDeductions))
+		assert.True(t, settlement.RefundAmount.IsZero())
+		assert.True(t, decimal.NewFromInt(400).Equal(settlement.BalanceDue))
+	})
+
+	t.Run("should settle nothing without deposit", func(t *testing.T) {
+		settlement, err := NewDepositSettlement(uuid.New(), decimal.Zero, decimal.Zero, decimal.Zero, decimal.NewFromInt(800), decimal.Zero)
+		require.NoError(t, err)
+
+		settlement.CoverDebts([]*Payment{newDebt(800, 0)})
+
+		assert.Empty(t, settlement.SettledPaymentIDs)
+	})
+}
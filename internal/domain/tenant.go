@@ -11,22 +11,28 @@ import (
 
 // Tenant representa um morador/inquilino
 type Tenant struct {
-	ID               uuid.UUID `json:"id"`
-	FullName         string    `json:"full_name"`
-	CPF              string    `json:"cpf"`
-	Phone            string    `json:"phone"`
-	Email            string    `json:"email,omitempty"`
-	IDDocumentType   string    `json:"id_document_type,omitempty"`
-	IDDocumentNumber string    `json:"id_document_number,omitempty"`
-	CreatedAt        time.Time `json:"created_at"`
-	UpdatedAt        time.Time `json:"updated_at"`
+	ID                uuid.UUID       `json:"id"`
+	FullName          string          `json:"full_name"`
+	CPF               string          `json:"cpf"`
+	Phone             string          `json:"phone"`
+	Email             string          `json:"email,omitempty"`
+	IDDocumentType    string          `json:"id_document_type,omitempty"`
+	IDDocumentNumber  string          `json:"id_document_number,omitempty"`
+	Employer          *TenantEmployer `json:"employer,omitempty"`
+	AnonymizedAt      *time.Time      `json:"anonymized_at,omitempty"`
+	AnonymizedBy      *uuid.UUID      `json:"anonymized_by,omitempty"`
+	MessagingOptOutAt *time.Time      `json:"messaging_opt_out_at,omitempty"` // Pedido para não receber WhatsApp/SMS
+	CreatedAt         time.Time       `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
 }
 
 // Domain errors
 var (
 	ErrInvalidFullName  = errors.New("full name cannot be empty")
-	ErrInvalidCPF       = errors.New("invalid CPF format")
+	ErrInvalidCPF       = errors.New("invalid CPF or CNPJ format")
 	ErrInvalidCPFDigits = errors.New("CPF must contain exactly 11 digits")
+	ErrInvalidCPFCheck  = errors.New("invalid CPF check digits")
+	ErrInvalidCNPJCheck = errors.New("invalid CNPJ check digits")
 	ErrInvalidPhone     = errors.New("phone cannot be empty")
 	ErrInvalidEmail     = errors.New("invalid email format")
 	ErrCPFAlreadyExists = errors.New("CPF already registered")
@@ -39,11 +45,12 @@ var cpfRegex = regexp.MustCompile(`^\d{3}\.\d{3}\.\d{3}-\d{2}$`)
 var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`)
 
 // NewTenant cria um novo morador com valores padrão
+// O documento pode ser um CPF ou, para empresas, um CNPJ, com ou sem formatação
 func NewTenant(fullname, cpf, phone, email string) (*Tenant, error) {
 	tenant := &Tenant{
 		ID:        uuid.New(),
 		FullName:  strings.TrimSpace(fullname),
-		CPF:       cpf,
+		CPF:       NormalizeDocument(cpf),
 		Phone:     strings.TrimSpace(phone),
 		Email:     strings.TrimSpace(email),
 		CreatedAt: time.Now(),
@@ -60,14 +67,23 @@ func NewTenant(fullname, cpf, phone, email string) (*Tenant, error) {
 
 // Validate verifica se o morador possui dados válidos
 func (t *Tenant) Validate() error {
+	return t.validate(true)
+}
+
+// validate verifica os dados do morador
+// checkDocument controla a validação do CPF/CNPJ, que só é feita quando o documento é informado,
+// para que moradores antigos com dígitos verificadores inválidos continuem editáveis
+func (t *Tenant) validate(checkDocument bool) error {
 	// Valida nome completo
 	if strings.TrimSpace(t.FullName) == "" {
 		return ErrInvalidFullName
 	}
 
 	// Validar CPF
-	if err := t.ValidateCPF(); err != nil {
-		return err
+	if checkDocument {
+		if err := t.ValidateCPF(); err != nil {
+			return err
+		}
 	}
 
 	// Validar telefone
@@ -82,11 +98,25 @@ func (t *Tenant) Validate() error {
 		}
 	}
 
+	// Validar empregador (se informado)
+	if t.Employer != nil {
+		if err := t.Employer.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-// ValidateCPF verifica se o CPF está no formato correto
+// ValidateCPF verifica o formato e os dígitos verificadores do CPF ou CNPJ
 func (t *Tenant) ValidateCPF() error {
+	if cnpjRegex.MatchString(t.CPF) {
+		if !IsValidCNPJ(t.CPF) {
+			return ErrInvalidCNPJCheck
+		}
+		return nil
+	}
+
 	if !cpfRegex.MatchString(t.CPF) {
 		return ErrInvalidCPF
 	}
@@ -97,9 +127,26 @@ func (t *Tenant) ValidateCPF() error {
 		return ErrInvalidCPFDigits
 	}
 
+	if !IsValidCPF(digits) {
+		return ErrInvalidCPFCheck
+	}
+
 	return nil
 }
 
+// DocumentType retorna se o morador foi cadastrado por CPF ou CNPJ
+func (t *Tenant) DocumentType() TenantDocumentType {
+	if cnpjRegex.MatchString(t.CPF) {
+		return DocumentTypeCNPJ
+	}
+	return DocumentTypeCPF
+}
+
+// IsCompany verifica se o morador é uma empresa (cadastrada por CNPJ)
+func (t *Tenant) IsCompany() bool {
+	return t.DocumentType() == DocumentTypeCNPJ
+}
+
 // FormatPhone formata o número de telefone
 // Aceita vários formatos e retorna no formato (XX) XXXXX-XXXX ou (XX) XXXX-XXXX
 func (t *Tenant) FormatPhone() string {
@@ -119,6 +166,10 @@ func (t *Tenant) FormatPhone() string {
 
 // UpdateInfo atualiza as informações do morador
 func (t *Tenant) UpdateInfo(fullName, phone, email, idDocType, idDocNumber string) error {
+	if t.IsAnonymized() {
+		return ErrTenantAnonymized
+	}
+
 	if fullName != "" {
 		t.FullName = strings.TrimSpace(fullName)
 	}
@@ -137,8 +188,8 @@ func (t *Tenant) UpdateInfo(fullName, phone, email, idDocType, idDocNumber strin
 
 	t.UpdatedAt = time.Now()
 
-	// Valida após atualização
-	return t.Validate()
+	// Valida após atualização; o documento não é alterado aqui
+	return t.validate(false)
 }
 
 // HasActiveContract indica se o morador pode ser removido
@@ -150,5 +201,5 @@ func (t *Tenant) HasActiveContract() bool {
 
 // String retorna uma representação em string do morador
 func (t *Tenant) String() string {
-	return t.FullName + " (CPF: " + t.CPF + ")"
+	return t.FullName + " (" + strings.ToUpper(string(t.DocumentType())) + ": " + t.CPF + ")"
 }
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"errors"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TenantDocumentKind representa o tipo de arquivo anexado ao morador
+// (não confundir com TenantDocumentType, que indica CPF ou CNPJ)
+type TenantDocumentKind string
+
+const (
+	DocumentKindRG               TenantDocumentKind = "id_rg"
+	DocumentKindCNH              TenantDocumentKind = "id_cnh"
+	DocumentKindProofOfIncome    TenantDocumentKind = "proof_of_income"
+	DocumentKindSignedContract   TenantDocumentKind = "signed_contract"
+	DocumentKindMoveInInspection TenantDocumentKind = "move_in_inspection"
+	DocumentKindOther            TenantDocumentKind = "other"
+)
+
+// ValidTenantDocumentKinds contém todos os tipos de documento válidos
+var ValidTenantDocumentKinds = []TenantDocumentKind{
+	DocumentKindRG,
+	DocumentKindCNH,
+	DocumentKindProofOfIncome,
+	DocumentKindSignedContract,
+	DocumentKindMoveInInspection,
+	DocumentKindOther,
+}
+
+// TenantDocument representa um arquivo do morador (ou de um contrato dele) guardado no armazenamento
+type TenantDocument struct {
+	ID          uuid.UUID          `json:"id"`
+	TenantID    uuid.UUID          `json:"tenant_id"`
+	LeaseID     *uuid.UUID         `json:"lease_id,omitempty"`
+	Kind        TenantDocumentKind `json:"document_type"`
+	ExpiresAt   *time.Time         `json:"expires_at,omitempty"`
+	FileName    string             `json:"file_name"`
+	ContentType string             `json:"content_type"`
+	SizeBytes   int64              `json:"size_bytes"`
+	Checksum    string             `json:"checksum"`    // SHA-256 em hexadecimal
+	StorageKey  string             `json:"storage_key"` // Caminho do arquivo no backend de armazenamento
+	UploadedBy  *uuid.UUID         `json:"uploaded_by,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
+// Domain errors específicos dos documentos do morador
+var (
+	ErrInvalidTenantDocumentKind = errors.New("invalid tenant document type")
+	ErrInvalidDocumentFileName   = errors.New("document file name cannot be empty")
+	ErrEmptyDocumentFile         = errors.New("document file cannot be empty")
+	ErrDocumentRequiresLease     = errors.New("signed contracts and move-in inspections must be linked to a lease")
+)
+
+// NewTenantDocument cria o registro de um documento do morador
+// O tamanho e o checksum são preenchidos após a gravação do arquivo
+func NewTenantDocument(tenantID uuid.UUID, kind TenantDocumentKind, fileName, contentType string, leaseID *uuid.UUID) (*TenantDocument, error) {
+	id := uuid.New()
+	doc := &TenantDocument{
+		ID:          id,
+		TenantID:    tenantID,
+		LeaseID:     leaseID,
+		Kind:        kind,
+		FileName:    sanitizeFileName(fileName),
+		ContentType: strings.TrimSpace(contentType),
+		StorageKey:  path.Join("tenants", tenantID.String(), id.String()),
+		CreatedAt:   time.Now(),
+	}
+
+	if doc.ContentType == "" {
+		doc.ContentType = "application/octet-stream"
+	}
+
+	if err := doc.validateMetadata(); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// Validate verifica se o documento possui dados válidos
+func (d *TenantDocument) Validate() error {
+	if err := d.validateMetadata(); err != nil {
+		return err
+	}
+
+	if d.SizeBytes <= 0 {
+		return ErrEmptyDocumentFile
+	}
+
+	return nil
+}
+
+// validateMetadata valida os dados informados no upload, antes da gravação do arquivo
+func (d *TenantDocument) validateMetadata() error {
+	if !IsValidTenantDocumentKind(d.Kind) {
+		return ErrInvalidTenantDocumentKind
+	}
+
+	if d.FileName == "" {
+		return ErrInvalidDocumentFileName
+	}
+
+	if d.Kind.RequiresLease() && d.LeaseID == nil {
+		return ErrDocumentRequiresLease
+	}
+
+	return nil
+}
+
+// IsExpired verifica se o documento está vencido na data informada
+// O documento continua válido durante todo o dia do vencimento
+func (d *TenantDocument) IsExpired(now time.Time) bool {
+	if d.ExpiresAt == nil {
+		return false
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, d.ExpiresAt.Location())
+	return d.ExpiresAt.Before(today)
+}
+
+// RequiresLease indica se o tipo de documento pertence a um contrato específico
+func (k TenantDocumentKind) RequiresLease() bool {
+	return k == DocumentKindSignedContract || k == DocumentKindMoveInInspection
+}
+
+// IsValidTenantDocumentKind verifica se o tipo de documento é válido
+func IsValidTenantDocumentKind(kind TenantDocumentKind) bool {
+	for _, k := range ValidTenantDocumentKinds {
+		if kind == k {
+			return true
+		}
+	}
+	return false
+}
+
+// sanitizeFileName mantém apenas o nome do arquivo enviado, sem diretórios
+func sanitizeFileName(name string) string {
+	name = strings.TrimSpace(strings.ReplaceAll(name, "\\", "/"))
+	if name == "" {
+		return ""
+	}
+
+	base := path.Base(name)
+	if base == "." || base == "/" {
+		return ""
+	}
+	return base
+}
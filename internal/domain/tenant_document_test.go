@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTenantDocument(t *testing.T) {
+	tenantID := uuid.New()
+
+	t.Run("should create document with storage key under the tenant", func(t *testing.T) {
+		doc, err := NewTenantDocument(tenantID, DocumentKindRG, " ../../rg.pdf ", "", nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "rg.pdf", doc.FileName)
+		assert.Equal(t, "application/octet-stream", doc.ContentType)
+		assert.Equal(t, "tenants/"+tenantID.String()+"/"+doc.ID.String(), doc.StorageKey)
+	})
+
+	t.Run("should reject invalid type", func(t *testing.T) {
+		_, err := NewTenantDocument(tenantID, TenantDocumentKind("passport"), "rg.pdf", "application/pdf", nil)
+		assert.ErrorIs(t, err, ErrInvalidTenantDocumentKind)
+	})
+
+	t.Run("should reject empty file name", func(t *testing.T) {
+		_, err := NewTenantDocument(tenantID, DocumentKindOther, "  ", "application/pdf", nil)
+		assert.ErrorIs(t, err, ErrInvalidDocumentFileName)
+	})
+
+	t.Run("should require lease for signed contract and move-in inspection", func(t *testing.T) {
+		_, err := NewTenantDocument(tenantID, DocumentKindSignedContract, "contrato.pdf", "application/pdf", nil)
+		assert.ErrorIs(t, err, ErrDocumentRequiresLease)
+
+		_, err = NewTenantDocument(tenantID, DocumentKindMoveInInspection, "vistoria.pdf", "application/pdf", nil)
+		assert.ErrorIs(t, err, ErrDocumentRequiresLease)
+
+		leaseID := uuid.New()
+		doc, err := NewTenantDocument(tenantID, DocumentKindSignedContract, "contrato.pdf", "application/pdf", &leaseID)
+		require.NoError(t, err)
+		assert.Equal(t, &leaseID, doc.LeaseID)
+	})
+
+	t.Run("should require file content on validate", func(t *testing.T) {
+		doc, err := NewTenantDocument(tenantID, DocumentKindProofOfIncome, "holerite.pdf", "application/pdf", nil)
+		require.NoError(t, err)
+
+		assert.ErrorIs(t, doc.Validate(), ErrEmptyDocumentFile)
+
+		doc.SizeBytes = 1024
+		assert.NoError(t, doc.Validate())
+	})
+}
+
+func TestTenantDocument_IsExpired(t *testing.T) {
+	now := time.Date(2026, 3, 15, 14, 0, 0, 0, time.UTC)
+	doc := &TenantDocument{}
+
+	assert.False(t, doc.IsExpired(now), "sem validade nunca vence")
+
+	expiresToday := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	doc.ExpiresAt = &expiresToday
+	assert.False(t, doc.IsExpired(now), "válido durante o dia do vencimento")
+
+	expiredYesterday := time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)
+	doc.ExpiresAt = &expiredYesterday
+	assert.True(t, doc.IsExpired(now))
+}
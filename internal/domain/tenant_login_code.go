@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// LoginCodeTTL é a validade de um código de acesso ao portal
+	LoginCodeTTL = 15 * time.Minute
+	// MaxLoginCodeAttempts é o número de tentativas antes de o código ser bloqueado
+	MaxLoginCodeAttempts = 5
+)
+
+// TenantLoginCode representa um código de uso único para login do morador no portal
+type TenantLoginCode struct {
+	ID        uuid.UUID  `json:"id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	CodeHash  string     `json:"-"` // nunca expor na API
+	Attempts  int        `json:"attempts"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// Domain errors
+var (
+	ErrInvalidLoginCode = errors.New("invalid or expired login code")
+	ErrLoginCodeLocked  = errors.New("login code blocked after too many attempts")
+)
+
+// NewTenantLoginCode gera um código numérico de 6 dígitos para o usuário
+// Retorna o código em texto plano, que deve ser repassado ao morador e nunca armazenado
+func NewTenantLoginCode(userID uuid.UUID) (*TenantLoginCode, string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return nil, "", err
+	}
+	code := fmt.Sprintf("%06d", n.Int64())
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(code), 10)
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	loginCode := &TenantLoginCode{
+		ID:        uuid.New(),
+		UserID:    userID,
+		CodeHash:  string(hash),
+		ExpiresAt: now.Add(LoginCodeTTL),
+		CreatedAt: now,
+	}
+
+	return loginCode, code, nil
+}
+
+// IsExpired verifica se o código passou da validade
+func (c *TenantLoginCode) IsExpired() bool {
+	return time.Now().After(c.ExpiresAt)
+}
+
+// IsUsable verifica se o código ainda pode ser usado
+func (c *TenantLoginCode) IsUsable() bool {
+	return c.UsedAt == nil && !c.IsExpired() && c.Attempts < MaxLoginCodeAttempts
+}
+
+// Verify compara o código informado e registra a tentativa
+// Em caso de sucesso o código é marcado como usado
+func (c *TenantLoginCode) Verify(code string) error {
+	if c.Attempts >= MaxLoginCodeAttempts {
+		return ErrLoginCodeLocked
+	}
+	if c.UsedAt != nil || c.IsExpired() {
+		return ErrInvalidLoginCode
+	}
+
+	c.Attempts++
+	if err := bcrypt.CompareHashAndPassword([]byte(c.CodeHash), []byte(code)); err != nil {
+		return ErrInvalidLoginCode
+	}
+
+	now := time.Now()
+	c.UsedAt = &now
+	return nil
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantLoginCode_Verify(t *testing.T) {
+	t.Run("should accept the generated code only once", func(t *testing.T) {
+		loginCode, code, err := NewTenantLoginCode(uuid.New())
+		require.NoError(t, err)
+		assert.Len(t, code, 6)
+
+		require.NoError(t, loginCode.Verify(code))
+		assert.NotNil(t, loginCode.UsedAt)
+		assert.Equal(t, ErrInvalidLoginCode, loginCode.Verify(code))
+	})
+
+	t.Run("should lock after too many attempts", func(t *testing.T) {
+		loginCode, code, err := NewTenantLoginCode(uuid.New())
+		require.NoError(t, err)
+
+		for i := 0; i < MaxLoginCodeAttempts; i++ {
+			assert.Equal(t, ErrInvalidLoginCode, loginCode.Verify("wrong"))
+		}
+
+		assert.Equal(t, ErrLoginCodeLocked, loginCode.Verify(code))
+		assert.False(t, loginCode.IsUsable())
+	})
+
+	t.Run("should reject expired code", func(t *testing.T) {
+		loginCode, code, err := NewTenantLoginCode(uuid.New())
+		require.NoError(t, err)
+		loginCode.ExpiresAt = time.Now().Add(-time.Minute)
+
+		assert.Equal(t, ErrInvalidLoginCode, loginCode.Verify(code))
+	})
+}
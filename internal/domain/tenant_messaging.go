@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// brazilCountryCode é o código do país usado quando o telefone não informa o DDI
+const brazilCountryCode = "55"
+
+var (
+	ErrInvalidPhoneE164 = errors.New("phone cannot be converted to E.164")
+	phoneNonDigitRegex  = regexp.MustCompile(`\D`)
+)
+
+// PhoneE164 retorna o telefone do morador no formato E.164 (ex: +5511987654321)
+// Usado no envio de mensagens por WhatsApp e SMS
+func (t *Tenant) PhoneE164() (string, error) {
+	return NormalizePhoneE164(t.Phone)
+}
+
+// NormalizePhoneE164 converte um telefone para o formato E.164
+// Números com DDD (10 ou 11 dígitos) recebem o código do Brasil; números iniciados por "+" mantêm o DDI informado
+func NormalizePhoneE164(phone string) (string, error) {
+	phone = strings.TrimSpace(phone)
+	international := strings.HasPrefix(phone, "+") || strings.HasPrefix(phone, "00")
+	digits := phoneNonDigitRegex.ReplaceAllString(phone, "")
+
+	if international {
+		digits = strings.TrimPrefix(digits, "00")
+		if len(digits) < 8 || len(digits) > 15 || digits[0] == '0' {
+			return "", ErrInvalidPhoneE164
+		}
+		return "+" + digits, nil
+	}
+
+	// Remove o zero de discagem de longa distância (ex: 011 98765-4321)
+	digits = strings.TrimLeft(digits, "0")
+
+	switch {
+	case len(digits) == 10 || len(digits) == 11:
+		digits = brazilCountryCode + digits
+	case (len(digits) == 12 || len(digits) == 13) && strings.HasPrefix(digits, brazilCountryCode):
+	default:
+		return "", ErrInvalidPhoneE164
+	}
+
+	// Celulares brasileiros têm 9 dígitos começando por 9; fixos têm 8 dígitos
+	local := digits[4:]
+	if len(local) == 9 && local[0] != '9' {
+		return "", ErrInvalidPhoneE164
+	}
+
+	return "+" + digits, nil
+}
+
+// HasMessagingOptOut indica se o morador pediu para não receber mensagens por WhatsApp/SMS
+func (t *Tenant) HasMessagingOptOut() bool {
+	return t.MessagingOptOutAt != nil
+}
+
+// SetMessagingOptOut registra ou remove o pedido do morador para não receber mensagens
+func (t *Tenant) SetMessagingOptOut(optOut bool) error {
+	if t.IsAnonymized() {
+		return ErrTenantAnonymized
+	}
+
+	now := time.Now()
+	switch {
+	case optOut && t.MessagingOptOutAt == nil:
+		t.MessagingOptOutAt = &now
+	case !optOut:
+		t.MessagingOptOutAt = nil
+	}
+	t.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizePhoneE164(t *testing.T) {
+	tests := []struct {
+		name    string
+		phone   string
+		want    string
+		wantErr bool
+	}{
+		{"formatted mobile", "(11) 98765-4321", "+5511987654321", false},
+		{"digits only mobile", "11987654321", "+5511987654321", false},
+		{"landline", "(11) 3456-7890", "+551134567890", false},
+		{"trunk prefix", "011 98765-4321", "+5511987654321", false},
+		{"country code without plus", "55 11 98765-4321", "+5511987654321", false},
+		{"already E.164", "+55 11 98765-4321", "+5511987654321", false},
+		{"foreign number", "+1 (415) 555-2671", "+14155552671", false},
+		{"international prefix", "00 351 912 345 678", "+351912345678", false},
+		{"too short", "98765-4321", "", true},
+		{"mobile without leading 9", "(11) 88765-4321", "", true},
+		{"empty", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizePhoneE164(tt.phone)
+
+			if tt.wantErr {
+				assert.Equal(t, ErrInvalidPhoneE164, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTenant_SetMessagingOptOut(t *testing.T) {
+	t.Run("should toggle opt-out", func(t *testing.T) {
+		tenant := &Tenant{Phone: "(11) 98765-4321"}
+
+		require.NoError(t, tenant.SetMessagingOptOut(true))
+		assert.True(t, tenant.HasMessagingOptOut())
+		optedOutAt := *tenant.MessagingOptOutAt
+
+		require.NoError(t, tenant.SetMessagingOptOut(true))
+		assert.Equal(t, optedOutAt, *tenant.MessagingOptOutAt, "keeps the original date")
+
+		require.NoError(t, tenant.SetMessagingOptOut(false))
+		assert.False(t, tenant.HasMessagingOptOut())
+	})
+
+	t.Run("should reject anonymized tenant", func(t *testing.T) {
+		now := time.Now()
+		tenant := &Tenant{AnonymizedAt: &now}
+
+		assert.Equal(t, ErrTenantAnonymized, tenant.SetMessagingOptOut(true))
+	})
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AnonymizedTenantName substitui o nome do morador após a anonimização
+const AnonymizedTenantName = "Morador anonimizado"
+
+// AnonymizedContent substitui destinatário e conteúdo das notificações do morador anonimizado
+const AnonymizedContent = "Dados removidos (LGPD)"
+
+// anonymizedDocumentPrefix identifica o documento de moradores anonimizados
+// O sufixo vem do ID do morador para manter a unicidade da coluna
+const anonymizedDocumentPrefix = "ANON-"
+
+// TenantDataRequestType representa o tipo de solicitação do titular dos dados (LGPD)
+type TenantDataRequestType string
+
+const (
+	TenantDataRequestExport        TenantDataRequestType = "export"
+	TenantDataRequestAnonymization TenantDataRequestType = "anonymization"
+)
+
+// TenantDataRequest registra quando e por quem os dados do morador foram exportados ou anonimizados
+type TenantDataRequest struct {
+	ID          uuid.UUID             `json:"id"`
+	TenantID    uuid.UUID             `json:"tenant_id"`
+	Type        TenantDataRequestType `json:"request_type"`
+	PerformedBy *uuid.UUID            `json:"performed_by,omitempty"`
+	PerformedAt time.Time             `json:"performed_at"`
+	Notes       *string               `json:"notes,omitempty"`
+}
+
+// Domain errors específicos de privacidade do morador
+var (
+	ErrTenantAnonymized        = errors.New("tenant data has been anonymized")
+	ErrInvalidDataRequestType  = errors.New("invalid data request type")
+	ErrTenantAlreadyAnonymized = errors.New("tenant is already anonymized")
+)
+
+// NewTenantDataRequest cria o registro de uma solicitação do titular
+func NewTenantDataRequest(tenantID uuid.UUID, requestType TenantDataRequestType, performedBy *uuid.UUID, notes *string) (*TenantDataRequest, error) {
+	if requestType != TenantDataRequestExport && requestType != TenantDataRequestAnonymization {
+		return nil, ErrInvalidDataRequestType
+	}
+
+	if notes != nil && strings.TrimSpace(*notes) == "" {
+		notes = nil
+	}
+
+	return &TenantDataRequest{
+		ID:          uuid.New(),
+		TenantID:    tenantID,
+		Type:        requestType,
+		PerformedBy: performedBy,
+		PerformedAt: time.Now(),
+		Notes:       notes,
+	}, nil
+}
+
+// Anonymize remove os dados pessoais do morador mantendo o ID,
+// para que contratos e pagamentos continuem íntegros para a contabilidade
+func (t *Tenant) Anonymize(performedBy *uuid.UUID) error {
+	if t.IsAnonymized() {
+		return ErrTenantAlreadyAnonymized
+	}
+
+	now := time.Now()
+	t.FullName = AnonymizedTenantName
+	t.CPF = anonymizedDocumentPrefix + strings.ReplaceAll(t.ID.String(), "-", "")[:12]
+	t.Phone = ""
+	t.Email = ""
+	t.IDDocumentType = ""
+	t.IDDocumentNumber = ""
+	t.Employer = nil
+	t.AnonymizedAt = &now
+	t.AnonymizedBy = performedBy
+	t.UpdatedAt = now
+	return nil
+}
+
+// IsAnonymized verifica se os dados pessoais do morador já foram removidos
+func (t *Tenant) IsAnonymized() bool {
+	return t.AnonymizedAt != nil
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenant_Anonymize(t *testing.T) {
+	t.Run("should scrub personal data", func(t *testing.T) {
+		tenant, err := NewTenant("João Silva", "123.456.789-09", "(11) 98765-4321", "joao@email.com")
+		require.NoError(t, err)
+		tenant.IDDocumentType = "rg"
+		tenant.IDDocumentNumber = "12.345.678-9"
+		tenant.Employer = &TenantEmployer{CompanyName: "Padaria Central"}
+		adminID := uuid.New()
+
+		require.NoError(t, tenant.Anonymize(&adminID))
+
+		assert.True(t, tenant.IsAnonymized())
+		assert.Equal(t, AnonymizedTenantName, tenant.FullName)
+		assert.True(t, strings.HasPrefix(tenant.CPF, "ANON-"))
+		assert.Len(t, tenant.CPF, 17)
+		assert.Empty(t, tenant.Phone)
+		assert.Empty(t, tenant.Email)
+		assert.Empty(t, tenant.IDDocumentType)
+		assert.Empty(t, tenant.IDDocumentNumber)
+		assert.Nil(t, tenant.Employer)
+		assert.Equal(t, &adminID, tenant.AnonymizedBy)
+	})
+
+	t.Run("should not anonymize twice", func(t *testing.T) {
+		tenant, err := NewTenant("João Silva", "123.456.789-09", "(11) 98765-4321", "")
+		require.NoError(t, err)
+		require.NoError(t, tenant.Anonymize(nil))
+
+		assert.ErrorIs(t, tenant.Anonymize(nil), ErrTenantAlreadyAnonymized)
+	})
+
+	t.Run("should block updates after anonymization", func(t *testing.T) {
+		tenant, err := NewTenant("João Silva", "123.456.789-09", "(11) 98765-4321", "")
+		require.NoError(t, err)
+		require.NoError(t, tenant.Anonymize(nil))
+
+		assert.ErrorIs(t, tenant.UpdateInfo("João Silva", "", "", "", ""), ErrTenantAnonymized)
+		assert.ErrorIs(t, tenant.SetEmployer(&TenantEmployer{CompanyName: "Padaria"}), ErrTenantAnonymized)
+	})
+}
+
+func TestNewTenantDataRequest(t *testing.T) {
+	tenantID := uuid.New()
+
+	blank := "  "
+	request, err := NewTenantDataRequest(tenantID, TenantDataRequestExport, nil, &blank)
+	require.NoError(t, err)
+	assert.Equal(t, TenantDataRequestExport, request.Type)
+	assert.Nil(t, request.Notes)
+
+	_, err = NewTenantDataRequest(tenantID, TenantDataRequestType("delete"), nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidDataRequestType)
+}
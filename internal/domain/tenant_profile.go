@@ -0,0 +1,278 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TenantContactType representa o tipo de contato do morador
+type TenantContactType string
+
+const (
+	ContactTypeEmergency TenantContactType = "emergency"
+	ContactTypeReference TenantContactType = "reference" // ex: antigo locador
+)
+
+// PetSpecies representa a espécie de um animal de estimação
+type PetSpecies string
+
+const (
+	PetSpeciesDog    PetSpecies = "dog"
+	PetSpeciesCat    PetSpecies = "cat"
+	PetSpeciesBird   PetSpecies = "bird"
+	PetSpeciesFish   PetSpecies = "fish"
+	PetSpeciesRodent PetSpecies = "rodent"
+	PetSpeciesOther  PetSpecies = "other"
+)
+
+// ValidPetSpecies contém todas as espécies válidas
+var ValidPetSpecies = []PetSpecies{
+	PetSpeciesDog,
+	PetSpeciesCat,
+	PetSpeciesBird,
+	PetSpeciesFish,
+	PetSpeciesRodent,
+	PetSpeciesOther,
+}
+
+// TenantEmployer representa o vínculo de trabalho declarado pelo morador
+type TenantEmployer struct {
+	CompanyName   string           `json:"company_name"`
+	Phone         *string          `json:"phone,omitempty"`
+	JobTitle      *string          `json:"job_title,omitempty"`
+	MonthlyIncome *decimal.Decimal `json:"monthly_income,omitempty"`
+	StartDate     *time.Time       `json:"start_date,omitempty"`
+}
+
+// TenantContact representa um contato de emergência ou uma referência do morador
+type TenantContact struct {
+	ID           uuid.UUID         `json:"id"`
+	TenantID     uuid.UUID         `json:"tenant_id"`
+	Type         TenantContactType `json:"contact_type"`
+	Name         string            `json:"name"`
+	Relationship string            `json:"relationship"` // ex: mãe, irmão, antigo locador
+	Phone        string            `json:"phone"`
+	Email        *string           `json:"email,omitempty"`
+	Notes        *string           `json:"notes,omitempty"`
+	CreatedAt    time.Time         `json:"created_at"`
+}
+
+// TenantOccupant representa uma pessoa que mora na unidade junto com o morador titular
+type TenantOccupant struct {
+	ID           uuid.UUID  `json:"id"`
+	TenantID     uuid.UUID  `json:"tenant_id"`
+	FullName     string     `json:"full_name"`
+	Relationship string     `json:"relationship"`
+	Document     *string    `json:"document,omitempty"` // CPF ou RG; opcional para menores
+	BirthDate    *time.Time `json:"birth_date,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// TenantPet representa um animal de estimação do morador
+type TenantPet struct {
+	ID        uuid.UUID  `json:"id"`
+	TenantID  uuid.UUID  `json:"tenant_id"`
+	Name      string     `json:"name"`
+	Species   PetSpecies `json:"species"`
+	Breed     *string    `json:"breed,omitempty"`
+	Notes     *string    `json:"notes,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// Domain errors específicos dos dados complementares do morador
+var (
+	ErrInvalidEmployerName       = errors.New("employer name cannot be empty")
+	ErrInvalidMonthlyIncome      = errors.New("monthly income cannot be negative")
+	ErrEmployerStartDateInFuture = errors.New("employment start date cannot be in the future")
+	ErrInvalidContactType        = errors.New("invalid contact type")
+	ErrInvalidContactName        = errors.New("contact name cannot be empty")
+	ErrInvalidContactRelation    = errors.New("contact relationship cannot be empty")
+	ErrInvalidContactPhone       = errors.New("contact phone cannot be empty")
+	ErrInvalidOccupantName       = errors.New("occupant name cannot be empty")
+	ErrInvalidOccupantRelation   = errors.New("occupant relationship cannot be empty")
+	ErrInvalidOccupantDocument   = errors.New("invalid occupant CPF")
+	ErrOccupantBirthDateInFuture = errors.New("occupant birth date cannot be in the future")
+	ErrOccupantIsTenant          = errors.New("the tenant cannot be listed as an occupant")
+	ErrInvalidPetName            = errors.New("pet name cannot be empty")
+	ErrInvalidPetSpecies         = errors.New("invalid pet species")
+)
+
+// NewTenantEmployer cria os dados de empregador do morador
+func NewTenantEmployer(companyName string) (*TenantEmployer, error) {
+	employer := &TenantEmployer{CompanyName: strings.TrimSpace(companyName)}
+
+	if err := employer.Validate(); err != nil {
+		return nil, err
+	}
+
+	return employer, nil
+}
+
+// Validate verifica se os dados do empregador são válidos
+func (e *TenantEmployer) Validate() error {
+	if strings.TrimSpace(e.CompanyName) == "" {
+		return ErrInvalidEmployerName
+	}
+
+	if e.MonthlyIncome != nil && e.MonthlyIncome.IsNegative() {
+		return ErrInvalidMonthlyIncome
+	}
+
+	if e.StartDate != nil && e.StartDate.After(time.Now()) {
+		return ErrEmployerStartDateInFuture
+	}
+
+	return nil
+}
+
+// SetEmployer define (ou remove, com nil) o empregador do morador
+func (t *Tenant) SetEmployer(employer *TenantEmployer) error {
+	if t.IsAnonymized() {
+		return ErrTenantAnonymized
+	}
+
+	if employer != nil {
+		if err := employer.Validate(); err != nil {
+			return err
+		}
+	}
+
+	t.Employer = employer
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// NewTenantContact cria um contato de emergência ou uma referência do morador
+func NewTenantContact(tenantID uuid.UUID, contactType TenantContactType, name, relationship, phone string) (*TenantContact, error) {
+	contact := &TenantContact{
+		ID:           uuid.New(),
+		TenantID:     tenantID,
+		Type:         contactType,
+		Name:         strings.TrimSpace(name),
+		Relationship: strings.TrimSpace(relationship),
+		Phone:        strings.TrimSpace(phone),
+		CreatedAt:    time.Now(),
+	}
+
+	if err := contact.Validate(); err != nil {
+		return nil, err
+	}
+
+	return contact, nil
+}
+
+// Validate verifica se o contato possui dados válidos
+func (c *TenantContact) Validate() error {
+	if c.Type != ContactTypeEmergency && c.Type != ContactTypeReference {
+		return ErrInvalidContactType
+	}
+
+	if c.Name == "" {
+		return ErrInvalidContactName
+	}
+
+	if c.Relationship == "" {
+		return ErrInvalidContactRelation
+	}
+
+	if c.Phone == "" {
+		return ErrInvalidContactPhone
+	}
+
+	if c.Email != nil && *c.Email != "" && !emailRegex.MatchString(*c.Email) {
+		return ErrInvalidEmail
+	}
+
+	return nil
+}
+
+// NewTenantOccupant cria um ocupante da unidade
+// Documentos com 11 dígitos são tratados como CPF e têm os dígitos verificadores conferidos
+func NewTenantOccupant(tenantID uuid.UUID, fullName, relationship string, document *string) (*TenantOccupant, error) {
+	occupant := &TenantOccupant{
+		ID:           uuid.New(),
+		TenantID:     tenantID,
+		FullName:     strings.TrimSpace(fullName),
+		Relationship: strings.TrimSpace(relationship),
+		CreatedAt:    time.Now(),
+	}
+
+	if document != nil && strings.TrimSpace(*document) != "" {
+		normalized := NormalizeDocument(*document)
+		occupant.Document = &normalized
+	}
+
+	if err := occupant.Validate(); err != nil {
+		return nil, err
+	}
+
+	return occupant, nil
+}
+
+// Validate verifica se o ocupante possui dados válidos
+func (o *TenantOccupant) Validate() error {
+	if o.FullName == "" {
+		return ErrInvalidOccupantName
+	}
+
+	if o.Relationship == "" {
+		return ErrInvalidOccupantRelation
+	}
+
+	if o.Document != nil && cpfRegex.MatchString(*o.Document) {
+		digits := documentSeparators.ReplaceAllString(*o.Document, "")
+		if !IsValidCPF(digits) {
+			return ErrInvalidOccupantDocument
+		}
+	}
+
+	if o.BirthDate != nil && o.BirthDate.After(time.Now()) {
+		return ErrOccupantBirthDateInFuture
+	}
+
+	return nil
+}
+
+// NewTenantPet cria um animal de estimação do morador
+func NewTenantPet(tenantID uuid.UUID, name string, species PetSpecies) (*TenantPet, error) {
+	pet := &TenantPet{
+		ID:        uuid.New(),
+		TenantID:  tenantID,
+		Name:      strings.TrimSpace(name),
+		Species:   species,
+		CreatedAt: time.Now(),
+	}
+
+	if err := pet.Validate(); err != nil {
+		return nil, err
+	}
+
+	return pet, nil
+}
+
+// Validate verifica se o animal possui dados válidos
+func (p *TenantPet) Validate() error {
+	if p.Name == "" {
+		return ErrInvalidPetName
+	}
+
+	if !IsValidPetSpecies(p.Species) {
+		return ErrInvalidPetSpecies
+	}
+
+	return nil
+}
+
+// IsValidPetSpecies verifica se a espécie é válida
+func IsValidPetSpecies(species PetSpecies) bool {
+	for _, s := range ValidPetSpecies {
+		if species == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantEmployer_Validate(t *testing.T) {
+	t.Run("should require company name", func(t *testing.T) {
+		_, err := NewTenantEmployer("  ")
+		assert.ErrorIs(t, err, ErrInvalidEmployerName)
+	})
+
+	t.Run("should reject negative income and future start date", func(t *testing.T) {
+		employer, err := NewTenantEmployer("Padaria Central")
+		require.NoError(t, err)
+
+		income := decimal.NewFromInt(-1)
+		employer.MonthlyIncome = &income
+		assert.ErrorIs(t, employer.Validate(), ErrInvalidMonthlyIncome)
+
+		income = decimal.NewFromInt(3500)
+		future := time.Now().AddDate(0, 1, 0)
+		employer.StartDate = &future
+		assert.ErrorIs(t, employer.Validate(), ErrEmployerStartDateInFuture)
+	})
+
+	t.Run("should set and clear tenant employer", func(t *testing.T) {
+		tenant := &Tenant{ID: uuid.New()}
+		employer, err := NewTenantEmployer("Padaria Central")
+		require.NoError(t, err)
+
+		require.NoError(t, tenant.SetEmployer(employer))
+		assert.Equal(t, "Padaria Central", tenant.Employer.CompanyName)
+
+		require.NoError(t, tenant.SetEmployer(nil))
+		assert.Nil(t, tenant.Employer)
+	})
+}
+
+func TestNewTenantContact(t *testing.T) {
+	tenantID := uuid.New()
+
+	t.Run("should create emergency contact", func(t *testing.T) {
+		contact, err := NewTenantContact(tenantID, ContactTypeEmergency, " Maria Silva ", "mãe", "(11) 98765-4321")
+		require.NoError(t, err)
+		assert.Equal(t, "Maria Silva", contact.Name)
+		assert.Equal(t, ContactTypeEmergency, contact.Type)
+	})
+
+	t.Run("should validate required fields", func(t *testing.T) {
+		_, err := NewTenantContact(tenantID, TenantContactType("friend"), "Maria", "mãe", "11987654321")
+		assert.ErrorIs(t, err, ErrInvalidContactType)
+
+		_, err = NewTenantContact(tenantID, ContactTypeReference, "", "antigo locador", "11987654321")
+		assert.ErrorIs(t, err, ErrInvalidContactName)
+
+		_, err = NewTenantContact(tenantID, ContactTypeReference, "José", "", "11987654321")
+		assert.ErrorIs(t, err, ErrInvalidContactRelation)
+
+		_, err = NewTenantContact(tenantID, ContactTypeReference, "José", "antigo locador", " ")
+		assert.ErrorIs(t, err, ErrInvalidContactPhone)
+	})
+
+	t.Run("should validate optional email", func(t *testing.T) {
+		contact, err := NewTenantContact(tenantID, ContactTypeReference, "José", "antigo locador", "11987654321")
+		require.NoError(t, err)
+
+		email := "invalido"
+		contact.Email = &email
+		assert.ErrorIs(t, contact.Validate(), ErrInvalidEmail)
+	})
+}
+
+func TestNewTenantOccupant(t *testing.T) {
+	tenantID := uuid.New()
+
+	t.Run("should normalize and check CPF document", func(t *testing.T) {
+		document := "12345678909"
+		occupant, err := NewTenantOccupant(tenantID, "Ana Silva", "filha", &document)
+		require.NoError(t, err)
+		assert.Equal(t, "123.456.789-09", *occupant.Document)
+
+		invalid := "12345678900"
+		_, err = NewTenantOccupant(tenantID, "Ana Silva", "filha", &invalid)
+		assert.ErrorIs(t, err, ErrInvalidOccupantDocument)
+	})
+
+	t.Run("should accept occupant without document or with RG", func(t *testing.T) {
+		occupant, err := NewTenantOccupant(tenantID, "Pedro Silva", "filho", nil)
+		require.NoError(t, err)
+		assert.Nil(t, occupant.Document)
+
+		rg := "12.345.678-9"
+		occupant, err = NewTenantOccupant(tenantID, "Pedro Silva", "filho", &rg)
+		require.NoError(t, err)
+		assert.Equal(t, rg, *occupant.Document)
+	})
+
+	t.Run("should validate name, relationship and birth date", func(t *testing.T) {
+		_, err := NewTenantOccupant(tenantID, "", "filho", nil)
+		assert.ErrorIs(t, err, ErrInvalidOccupantName)
+
+		_, err = NewTenantOccupant(tenantID, "Pedro", " ", nil)
+		assert.ErrorIs(t, err, ErrInvalidOccupantRelation)
+
+		occupant, err := NewTenantOccupant(tenantID, "Pedro", "filho", nil)
+		require.NoError(t, err)
+		future := time.Now().AddDate(1, 0, 0)
+		occupant.BirthDate = &future
+		assert.ErrorIs(t, occupant.Validate(), ErrOccupantBirthDateInFuture)
+	})
+}
+
+func TestNewTenantPet(t *testing.T) {
+	tenantID := uuid.New()
+
+	pet, err := NewTenantPet(tenantID, "Rex", PetSpeciesDog)
+	require.NoError(t, err)
+	assert.Equal(t, "Rex", pet.Name)
+
+	_, err = NewTenantPet(tenantID, " ", PetSpeciesCat)
+	assert.ErrorIs(t, err, ErrInvalidPetName)
+
+	_, err = NewTenantPet(tenantID, "Nemo", PetSpecies("shark"))
+	assert.ErrorIs(t, err, ErrInvalidPetSpecies)
+}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LowPaymentScoreThreshold é a pontuação abaixo da qual o histórico do morador gera alerta
+// na criação ou renovação de contratos
+const LowPaymentScoreThreshold = 60
+
+// Pesos das penalidades aplicadas sobre a taxa de pontualidade
+const (
+	maxDaysLatePenalty        = 30 // cada dia médio de atraso desconta 1 ponto, até 30
+	renegotiationPenalty      = 5  // por pagamento de ajuste (mudança de vencimento, acordo)
+	maxRenegotiationPenalty   = 20
+	cancelledLeasePenalty     = 10 // por contrato cancelado antes do fim
+	maxCancelledLeasePenalty  = 30
+	paymentScoreMax           = 100
+	paymentScoreExcellentFrom = 90
+	paymentScoreGoodFrom      = 75
+)
+
+// PaymentScoreRating representa a classificação do histórico de pagamentos
+type PaymentScoreRating string
+
+const (
+	PaymentScoreExcellent PaymentScoreRating = "excellent"
+	PaymentScoreGood      PaymentScoreRating = "good"
+	PaymentScoreFair      PaymentScoreRating = "fair"
+	PaymentScorePoor      PaymentScoreRating = "poor"
+	PaymentScoreNoHistory PaymentScoreRating = "no_history"
+)
+
+// TenantPaymentScore representa a avaliação do comportamento de pagamento do morador
+// considerando todos os seus contratos
+type TenantPaymentScore struct {
+	TenantID          uuid.UUID          `json:"tenant_id"`
+	Score             int                `json:"score"` // 0 a 100
+	Rating            PaymentScoreRating `json:"rating"`
+	EvaluatedPayments int                `json:"evaluated_payments"`
+	OnTimePayments    int                `json:"on_time_payments"`
+	LatePayments      int                `json:"late_payments"`
+	OnTimeRatio       float64            `json:"on_time_ratio"`
+	AverageDaysLate   float64            `json:"average_days_late"`
+	Renegotiations    int                `json:"renegotiations"`
+	CancelledLeases   int                `json:"cancelled_leases"`
+	CalculatedAt      time.Time          `json:"calculated_at"`
+}
+
+// CalculateTenantPaymentScore calcula a pontuação a partir dos contratos e pagamentos do morador
+// Entram na conta os pagamentos já pagos ou já vencidos; cancelados são ignorados.
+// Pagamentos de ajuste contam como renegociação.
+func CalculateTenantPaymentScore(tenantID uuid.UUID, leases []*Lease, payments []*Payment) *TenantPaymentScore {
+	score := &TenantPaymentScore{
+		TenantID:     tenantID,
+		CalculatedAt: time.Now(),
+	}
+
+	for _, lease := range leases {
+		if lease.Status == LeaseStatusCancelled {
+			score.CancelledLeases++
+		}
+	}
+
+	totalDaysLate := 0
+	for _, payment := range payments {
+		if payment.IsCancelled() {
+			continue
+		}
+		if payment.PaymentType == PaymentTypeAdjustment {
+			score.Renegotiations++
+		}
+		if !payment.IsPaid() && !payment.IsOverdue() {
+			continue
+		}
+
+		score.EvaluatedPayments++
+		daysLate := payment.DaysLate()
+		if daysLate > 0 {
+			score.LatePayments++
+			totalDaysLate += daysLate
+		} else {
+			score.OnTimePayments++
+		}
+	}
+
+	if score.EvaluatedPayments == 0 {
+		score.Score = paymentScoreMax
+		score.Rating = PaymentScoreNoHistory
+		return score
+	}
+
+	score.OnTimeRatio = float64(score.OnTimePayments) / float64(score.EvaluatedPayments)
+	score.AverageDaysLate = float64(totalDaysLate) / float64(score.EvaluatedPayments)
+
+	value := score.OnTimeRatio * paymentScoreMax
+	value -= math.Min(score.AverageDaysLate, maxDaysLatePenalty)
+	value -= math.Min(float64(score.Renegotiations*renegotiationPenalty), maxRenegotiationPenalty)
+	value -= math.Min(float64(score.CancelledLeases*cancelledLeasePenalty), maxCancelledLeasePenalty)
+	score.Score = int(math.Round(math.Max(0, value)))
+
+	switch {
+	case score.Score >= paymentScoreExcellentFrom:
+		score.Rating = PaymentScoreExcellent
+	case score.Score >= paymentScoreGoodFrom:
+		score.Rating = PaymentScoreGood
+	case score.Score >= LowPaymentScoreThreshold:
+		score.Rating = PaymentScoreFair
+	default:
+		score.Rating = PaymentScorePoor
+	}
+
+	return score
+}
+
+// HasHistory verifica se há pagamentos suficientes para avaliar o morador
+func (s *TenantPaymentScore) HasHistory() bool {
+	return s.EvaluatedPayments > 0
+}
+
+// IsBelowThreshold verifica se a pontuação está abaixo do limite de alerta
+// Moradores sem histórico não geram alerta
+func (s *TenantPaymentScore) IsBelowThreshold() bool {
+	return s.HasHistory() && s.Score < LowPaymentScoreThreshold
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func newScorePayment(paymentType PaymentType, status PaymentStatus, dueDate time.Time, paidAt *time.Time) *Payment {
+	return &Payment{
+		ID:          uuid.New(),
+		LeaseID:     uuid.New(),
+		PaymentType: paymentType,
+		Amount:      decimal.NewFromInt(800),
+		Status:      status,
+		DueDate:     dueDate,
+		PaymentDate: paidAt,
+	}
+}
+
+func TestPayment_DaysLate(t *testing.T) {
+	dueDate := time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	paidEarly := dueDate.AddDate(0, 0, -2)
+	assert.Equal(t, 0, newScorePayment(PaymentTypeRent, PaymentStatusPaid, dueDate, &paidEarly).DaysLate())
+
+	paidLate := dueDate.AddDate(0, 0, 7)
+	assert.Equal(t, 7, newScorePayment(PaymentTypeRent, PaymentStatusPaid, dueDate, &paidLate).DaysLate())
+
+	overdue := newScorePayment(PaymentTypeRent, PaymentStatusOverdue, time.Now().AddDate(0, 0, -10), nil)
+	assert.Equal(t, overdue.DaysOverdue(), overdue.DaysLate())
+}
+
+func TestCalculateTenantPaymentScore(t *testing.T) {
+	tenantID := uuid.New()
+	dueDate := time.Now().AddDate(0, -3, 0)
+	onTime := dueDate.AddDate(0, 0, -1)
+
+	t.Run("should return no history for tenant without due payments", func(t *testing.T) {
+		payments := []*Payment{
+			newScorePayment(PaymentTypeRent, PaymentStatusPending, time.Now().AddDate(0, 1, 0), nil),
+		}
+
+		score := CalculateTenantPaymentScore(tenantID, nil, payments)
+
+		assert.Equal(t, PaymentScoreNoHistory, score.Rating)
+		assert.Equal(t, 100, score.Score)
+		assert.False(t, score.IsBelowThreshold())
+	})
+
+	t.Run("should score punctual tenant as excellent", func(t *testing.T) {
+		payments := []*Payment{
+			newScorePayment(PaymentTypeRent, PaymentStatusPaid, dueDate, &onTime),
+			newScorePayment(PaymentTypeRent, PaymentStatusPaid, dueDate, &onTime),
+			newScorePayment(PaymentTypeRent, PaymentStatusCancelled, dueDate, nil),
+			newScorePayment(PaymentTypeRent, PaymentStatusPending, time.Now().AddDate(0, 1, 0), nil),
+		}
+
+		score := CalculateTenantPaymentScore(tenantID, nil, payments)
+
+		assert.Equal(t, 2, score.EvaluatedPayments)
+		assert.Equal(t, 100, score.Score)
+		assert.Equal(t, PaymentScoreExcellent, score.Rating)
+	})
+
+	t.Run("should penalize late payments, renegotiations and cancelled leases", func(t *testing.T) {
+		paidLate := dueDate.AddDate(0, 0, 10)
+		leases := []*Lease{
+			{ID: uuid.New(), TenantID: tenantID, Status: LeaseStatusCancelled},
+			{ID: uuid.New(), TenantID: tenantID, Status: LeaseStatusActive},
+		}
+		payments := []*Payment{
+			newScorePayment(PaymentTypeRent, PaymentStatusPaid, dueDate, &onTime),
+			newScorePayment(PaymentTypeRent, PaymentStatusPaid, dueDate, &paidLate),
+			newScorePayment(PaymentTypeAdjustment, PaymentStatusPaid, dueDate, &onTime),
+			newScorePayment(PaymentTypeRent, PaymentStatusPaid, dueDate, &paidLate),
+		}
+
+		score := CalculateTenantPaymentScore(tenantID, leases, payments)
+
+		// 50% pontual (50) - 5 dias médios de atraso - 1 renegociação (5) - 1 contrato cancelado (10)
+		assert.Equal(t, 4, score.EvaluatedPayments)
+		assert.Equal(t, 2, score.LatePayments)
+		assert.Equal(t, 1, score.Renegotiations)
+		assert.Equal(t, 1, score.CancelledLeases)
+		assert.InDelta(t, 5.0, score.AverageDaysLate, 0.001)
+		assert.Equal(t, 30, score.Score)
+		assert.Equal(t, PaymentScorePoor, score.Rating)
+		assert.True(t, score.IsBelowThreshold())
+	})
+
+	t.Run("should never go below zero", func(t *testing.T) {
+		overdueDate := time.Now().AddDate(0, -6, 0)
+		payments := []*Payment{
+			newScorePayment(PaymentTypeRent, PaymentStatusOverdue, overdueDate, nil),
+		}
+
+		score := CalculateTenantPaymentScore(tenantID, nil, payments)
+
+		assert.Equal(t, 0, score.Score)
+	})
+}
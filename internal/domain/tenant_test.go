@@ -11,7 +11,7 @@ func TestNewTenant(t *testing.T) {
 	t.Run("should create valid tenant", func(t *testing.T) {
 		tenant, err := NewTenant(
 			"João da Silva",
-			"123.456.789-00",
+			"123.456.789-09",
 			"11987654321",
 			"joao@example.com",
 		)
@@ -19,7 +19,7 @@ func TestNewTenant(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, tenant)
 		assert.Equal(t, "João da Silva", tenant.FullName)
-		assert.Equal(t, "123.456.789-00", tenant.CPF)
+		assert.Equal(t, "123.456.789-09", tenant.CPF)
 		assert.Equal(t, "11987654321", tenant.Phone)
 		assert.Equal(t, "joao@example.com", tenant.Email)
 		assert.NotEqual(t, "", tenant.ID.String())
@@ -41,7 +41,7 @@ func TestNewTenant(t *testing.T) {
 	t.Run("should fail with empty name", func(t *testing.T) {
 		tenant, err := NewTenant(
 			"",
-			"123.456.789-00",
+			"123.456.789-09",
 			"11987654321",
 			"",
 		)
@@ -51,10 +51,35 @@ func TestNewTenant(t *testing.T) {
 		assert.Equal(t, ErrInvalidFullName, err)
 	})
 
+	t.Run("should normalize unformatted CPF", func(t *testing.T) {
+		tenant, err := NewTenant(
+			"João da Silva",
+			"12345678909", // sem formatação
+			"11987654321",
+			"",
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "123.456.789-09", tenant.CPF)
+		assert.Equal(t, DocumentTypeCPF, tenant.DocumentType())
+	})
+
+	t.Run("should fail with invalid CPF check digits", func(t *testing.T) {
+		tenant, err := NewTenant(
+			"João da Silva",
+			"123.456.789-00",
+			"11987654321",
+			"",
+		)
+
+		assert.Nil(t, tenant)
+		assert.Equal(t, ErrInvalidCPFCheck, err)
+	})
+
 	t.Run("should fail with invalid CPF format", func(t *testing.T) {
 		tenant, err := NewTenant(
 			"João da Silva",
-			"12345678900", // sem formatação
+			"123.456.789", // incompleto
 			"11987654321",
 			"",
 		)
@@ -64,10 +89,35 @@ func TestNewTenant(t *testing.T) {
 		assert.Equal(t, ErrInvalidCPF, err)
 	})
 
+	t.Run("should create company tenant with CNPJ", func(t *testing.T) {
+		tenant, err := NewTenant(
+			"Construtora Exemplo Ltda",
+			"11222333000181",
+			"11987654321",
+			"",
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "11.222.333/0001-81", tenant.CPF)
+		assert.True(t, tenant.IsCompany())
+	})
+
+	t.Run("should fail with invalid CNPJ check digits", func(t *testing.T) {
+		tenant, err := NewTenant(
+			"Construtora Exemplo Ltda",
+			"11.222.333/0001-80",
+			"11987654321",
+			"",
+		)
+
+		assert.Nil(t, tenant)
+		assert.Equal(t, ErrInvalidCNPJCheck, err)
+	})
+
 	t.Run("should fail with empty phone", func(t *testing.T) {
 		tenant, err := NewTenant(
 			"João da Silva",
-			"123.456.789-00",
+			"123.456.789-09",
 			"",
 			"",
 		)
@@ -80,7 +130,7 @@ func TestNewTenant(t *testing.T) {
 	t.Run("should fail with invalid email", func(t *testing.T) {
 		tenant, err := NewTenant(
 			"João da Silva",
-			"123.456.789-00",
+			"123.456.789-09",
 			"11987654321",
 			"email-invalido",
 		)
@@ -97,9 +147,14 @@ func TestTenant_ValidateCPF(t *testing.T) {
 		cpf         string
 		shouldError bool
 	}{
-		{"valid CPF", "123.456.789-00", false},
-		{"valid CPF 2", "987.654.321-99", false},
-		{"invalid format - no dots", "12345678900", true},
+		{"valid CPF", "123.456.789-09", false},
+		{"valid CPF 2", "987.654.321-00", false},
+		{"valid CNPJ", "11.222.333/0001-81", false},
+		{"valid alphanumeric CNPJ", "12.ABC.345/01DE-35", false},
+		{"invalid check digits", "987.654.321-99", true},
+		{"repeated digits", "111.111.111-11", true},
+		{"invalid CNPJ check digits", "11.222.333/0001-82", true},
+		{"invalid format - no dots", "12345678909", true},
 		{"invalid format - no dash", "123.456.78900", true},
 		{"invalid format - letters", "123.456.789-AB", true},
 		{"invalid format - incomplete", "123.456.789-0", true},
@@ -147,7 +202,7 @@ func TestTenant_UpdateInfo(t *testing.T) {
 	t.Run("should update tenant info", func(t *testing.T) {
 		tenant, _ := NewTenant(
 			"João da Silva",
-			"123.456.789-00",
+			"123.456.789-09",
 			"11987654321",
 			"joao@example.com",
 		)
@@ -171,7 +226,7 @@ func TestTenant_UpdateInfo(t *testing.T) {
 	t.Run("should fail with invalid email on update", func(t *testing.T) {
 		tenant, _ := NewTenant(
 			"João da Silva",
-			"123.456.789-00",
+			"123.456.789-09",
 			"11987654321",
 			"",
 		)
@@ -185,7 +240,7 @@ func TestTenant_UpdateInfo(t *testing.T) {
 	t.Run("should not update if field is empty", func(t *testing.T) {
 		tenant, _ := NewTenant(
 			"João da Silva",
-			"123.456.789-00",
+			"123.456.789-09",
 			"11987654321",
 			"joao@example.com",
 		)
@@ -196,17 +251,33 @@ func TestTenant_UpdateInfo(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, originalName, tenant.FullName) // mantém o original
 	})
+
+	t.Run("should update legacy tenant with invalid CPF check digits", func(t *testing.T) {
+		// Cadastro anterior à validação dos dígitos verificadores
+		tenant := &Tenant{
+			FullName: "João da Silva",
+			CPF:      "111.222.333-44",
+			Phone:    "11987654321",
+		}
+		require.ErrorIs(t, tenant.Validate(), ErrInvalidCPFCheck)
+
+		err := tenant.UpdateInfo("", "11999887766", "", "", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, "11999887766", tenant.Phone)
+		assert.Equal(t, "111.222.333-44", tenant.CPF)
+	})
 }
 
 func TestTenant_String(t *testing.T) {
 	tenant, _ := NewTenant(
 		"João da Silva",
-		"123.456.789-00",
+		"123.456.789-09",
 		"11987654321",
 		"",
 	)
 
 	result := tenant.String()
 
-	assert.Equal(t, "João da Silva (CPF: 123.456.789-00)", result)
+	assert.Equal(t, "João da Silva (CPF: 123.456.789-09)", result)
 }
@@ -29,6 +29,7 @@ var ValidStatuses = []UnitStatus{
 // Unit representa uma unidade/kitnet do prédio
 type Unit struct {
 	ID                 uuid.UUID       `json:"id"`
+	PropertyID         uuid.UUID       `json:"property_id"`
 	Number             string          `json:"number"`
 	Floor              int             `json:"floor"`
 	Status             UnitStatus      `json:"status"`
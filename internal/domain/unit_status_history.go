@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UnitStatusChangeReason representa a causa de uma mudança de status da unidade
+type UnitStatusChangeReason string
+
+const (
+	UnitStatusChangeReasonInitial             UnitStatusChangeReason = "initial"
+	UnitStatusChangeReasonUnitCreated         UnitStatusChangeReason = "unit_created"
+	UnitStatusChangeReasonManual              UnitStatusChangeReason = "manual"
+	UnitStatusChangeReasonLeaseCreated        UnitStatusChangeReason = "lease_created"
+	UnitStatusChangeReasonLeaseCancelled      UnitStatusChangeReason = "lease_cancelled"
+	UnitStatusChangeReasonLeaseExpired        UnitStatusChangeReason = "lease_expired"
+	UnitStatusChangeReasonMaintenanceStarted  UnitStatusChangeReason = "maintenance_started"
+	UnitStatusChangeReasonMaintenanceFinished UnitStatusChangeReason = "maintenance_finished"
+	UnitStatusChangeReasonRenovationStarted   UnitStatusChangeReason = "renovation_started"
+	UnitStatusChangeReasonRenovationFinished  UnitStatusChangeReason = "renovation_finished"
+)
+
+// UnitStatusChange representa uma transição de status registrada no histórico da unidade
+type UnitStatusChange struct {
+	ID          uuid.UUID              `json:"id"`
+	UnitID      uuid.UUID              `json:"unit_id"`
+	FromStatus  *UnitStatus            `json:"from_status,omitempty"`
+	ToStatus    UnitStatus             `json:"to_status"`
+	Reason      UnitStatusChangeReason `json:"reason"`
+	ReferenceID *uuid.UUID             `json:"reference_id,omitempty"` // Contrato, chamado ou reforma que originou a mudança
+	ChangedAt   time.Time              `json:"changed_at"`
+	CreatedAt   time.Time              `json:"created_at"`
+}
+
+// UnitStatusPeriod representa um intervalo contínuo em que a unidade permaneceu em um status
+type UnitStatusPeriod struct {
+	Status    UnitStatus `json:"status"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"` // nil = período atual
+	Days      int        `json:"days"`
+}
+
+// NewUnitStatusChange cria um novo registro de mudança de status
+func NewUnitStatusChange(unitID uuid.UUID, from *UnitStatus, to UnitStatus, reason UnitStatusChangeReason, referenceID *uuid.UUID) *UnitStatusChange {
+	now := time.Now()
+	return &UnitStatusChange{
+		ID:          uuid.New(),
+		UnitID:      unitID,
+		FromStatus:  from,
+		ToStatus:    to,
+		Reason:      reason,
+		ReferenceID: referenceID,
+		ChangedAt:   now,
+		CreatedAt:   now,
+	}
+}
+
+// BuildUnitStatusPeriods converte o histórico (em ordem cronológica) em períodos contínuos por status
+func BuildUnitStatusPeriods(changes []*UnitStatusChange, now time.Time) []*UnitStatusPeriod {
+	periods := make([]*UnitStatusPeriod, 0, len(changes))
+
+	for i, change := range changes {
+		period := &UnitStatusPeriod{
+			Status:    change.ToStatus,
+			StartedAt: change.ChangedAt,
+		}
+
+		end := now
+		if i+1 < len(changes) {
+			next := changes[i+1].ChangedAt
+			period.EndedAt = &next
+			end = next
+		}
+		period.Days = int(end.Sub(change.ChangedAt).Hours() / 24)
+
+		periods = append(periods, period)
+	}
+
+	return periods
+}
+
+// OccupiedDurationBetween calcula, dentro da janela [start, end), o tempo em que a unidade esteve ocupada
+// e o tempo coberto pelo histórico (antes do primeiro registro a unidade não é considerada)
+func OccupiedDurationBetween(changes []*UnitStatusChange, start, end time.Time) (occupied, tracked time.Duration) {
+	for i, change := range changes {
+		periodStart := change.ChangedAt
+		periodEnd := end
+		if i+1 < len(changes) {
+			periodEnd = changes[i+1].ChangedAt
+		}
+
+		// Recortar o período para a janela solicitada
+		if periodStart.Before(start) {
+			periodStart = start
+		}
+		if periodEnd.After(end) {
+			periodEnd = end
+		}
+		if !periodEnd.After(periodStart) {
+			continue
+		}
+
+		duration := periodEnd.Sub(periodStart)
+		tracked += duration
+		if change.ToStatus == UnitStatusOccupied {
+			occupied += duration
+		}
+	}
+
+	return occupied, tracked
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStatusChange(to UnitStatus, changedAt time.Time) *UnitStatusChange {
+	change := NewUnitStatusChange(uuid.New(), nil, to, UnitStatusChangeReasonManual, nil)
+	change.ChangedAt = changedAt
+	return change
+}
+
+func TestBuildUnitStatusPeriods(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := base.AddDate(0, 0, 50)
+
+	changes := []*UnitStatusChange{
+		newTestStatusChange(UnitStatusAvailable, base),
+		newTestStatusChange(UnitStatusOccupied, base.AddDate(0, 0, 10)),
+	}
+
+	periods := BuildUnitStatusPeriods(changes, now)
+
+	require.Len(t, periods, 2)
+	assert.Equal(t, UnitStatusAvailable, periods[0].Status)
+	assert.Equal(t, 10, periods[0].Days)
+	require.NotNil(t, periods[0].EndedAt)
+	assert.Equal(t, UnitStatusOccupied, periods[1].Status)
+	assert.Equal(t, 40, periods[1].Days)
+	assert.Nil(t, periods[1].EndedAt)
+}
+
+func TestOccupiedDurationBetween(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	changes := []*UnitStatusChange{
+		newTestStatusChange(UnitStatusAvailable, base.AddDate(0, 0, 5)),
+		newTestStatusChange(UnitStatusOccupied, base.AddDate(0, 0, 15)),
+		newTestStatusChange(UnitStatusAvailable, base.AddDate(0, 0, 25)),
+	}
+
+	t.Run("should ignore time before first record", func(t *testing.T) {
+		occupied, tracked := OccupiedDurationBetween(changes, base, base.AddDate(0, 0, 30))
+
+		assert.Equal(t, 10*day, occupied)
+		assert.Equal(t, 25*day, tracked)
+	})
+
+	t.Run("should clip periods to the window", func(t *testing.T) {
+		occupied, tracked := OccupiedDurationBetween(changes, base.AddDate(0, 0, 20), base.AddDate(0, 0, 30))
+
+		assert.Equal(t, 5*day, occupied)
+		assert.Equal(t, 10*day, tracked)
+	})
+
+	t.Run("should return zero without history", func(t *testing.T) {
+		occupied, tracked := OccupiedDurationBetween(nil, base, base.AddDate(0, 0, 30))
+
+		assert.Zero(t, occupied)
+		assert.Zero(t, tracked)
+	})
+}
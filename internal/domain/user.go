@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"crypto/rand"
+	"encoding/base64"
 	"errors"
 	"slices"
 	"strings"
@@ -17,6 +19,7 @@ const (
 	UserRoleAdmin   UserRole = "admin"
 	UserRoleManager UserRole = "manager"
 	UserRoleViewer  UserRole = "viewer"
+	UserRoleTenant  UserRole = "tenant" // morador com acesso ao portal
 )
 
 // ValidRoles contém todos os roles válidos
@@ -24,6 +27,14 @@ var ValidRoles = []UserRole{
 	UserRoleAdmin,
 	UserRoleManager,
 	UserRoleViewer,
+	UserRoleTenant,
+}
+
+// StaffRoles contém os roles da equipe de gestão (todos exceto morador)
+var StaffRoles = []UserRole{
+	UserRoleAdmin,
+	UserRoleManager,
+	UserRoleViewer,
 }
 
 // User representa um usuário do sistema
@@ -32,6 +43,7 @@ type User struct {
 	Username     string     `json:"username"`
 	PasswordHash string     `json:"-"` // nunca expor na API
 	Role         UserRole   `json:"role"`
+	TenantID     *uuid.UUID `json:"tenant_id,omitempty"` // apenas para o papel tenant
 	IsActive     bool       `json:"is_active"`
 	LastLoginAt  *time.Time `json:"last_login_at,omitempty"`
 	CreatedAt    time.Time  `json:"created_at"`
@@ -47,6 +59,9 @@ var (
 	ErrUsernameAlreadyExists = errors.New("username already exists")
 	ErrInvalidCredentials    = errors.New("invalid username or password")
 	ErrUserInactive          = errors.New("user account is inactive")
+	ErrTenantUserWithoutLink = errors.New("tenant user must be linked to a tenant")
+	ErrStaffUserWithTenant   = errors.New("staff user cannot be linked to a tenant")
+	ErrTenantRoleChange      = errors.New("cannot change role to or from tenant")
 )
 
 // NewUser cria um novo usuário com password em texto plano (será hasheado)
@@ -73,6 +88,38 @@ func NewUser(username, password string, role UserRole) (*User, error) {
 	return user, nil
 }
 
+// NewTenantUser cria o usuário do portal vinculado a um morador
+// O username é o documento do morador; sem senha, o acesso é feito apenas por código de uso único
+func NewTenantUser(tenantID uuid.UUID, document, password string) (*User, error) {
+	if password == "" {
+		random, err := randomSecret()
+		if err != nil {
+			return nil, err
+		}
+		password = random
+	}
+
+	user := &User{
+		ID:        uuid.New(),
+		Username:  strings.TrimSpace(strings.ToLower(document)),
+		Role:      UserRoleTenant,
+		TenantID:  &tenantID,
+		IsActive:  true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	if err := user.SetPassword(password); err != nil {
+		return nil, err
+	}
+
+	if err := user.Validate(); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
 // Validate verifica se o usuário possui dados válidos
 func (u *User) Validate() error {
 	// Valida username
@@ -90,6 +137,14 @@ func (u *User) Validate() error {
 		return ErrInvalidRole
 	}
 
+	// Apenas moradores são vinculados a um tenant
+	if u.IsTenant() && u.TenantID == nil {
+		return ErrTenantUserWithoutLink
+	}
+	if !u.IsTenant() && u.TenantID != nil {
+		return ErrStaffUserWithTenant
+	}
+
 	return nil
 }
 
@@ -152,6 +207,11 @@ func (u *User) ChangeRole(newRole UserRole) error {
 		return ErrInvalidRole
 	}
 
+	// Usuários do portal não podem virar equipe e vice-versa
+	if u.IsTenant() || tempUser.IsTenant() {
+		return ErrTenantRoleChange
+	}
+
 	u.Role = newRole
 	u.UpdatedAt = time.Now()
 	return nil
@@ -172,6 +232,16 @@ func (u *User) IsViewer() bool {
 	return u.Role == UserRoleViewer
 }
 
+// IsTenant verifica se o usuário é um morador (portal)
+func (u *User) IsTenant() bool {
+	return u.Role == UserRoleTenant
+}
+
+// IsStaff verifica se o usuário faz parte da equipe de gestão
+func (u *User) IsStaff() bool {
+	return slices.Contains(StaffRoles, u.Role)
+}
+
 // CanManageUsers verifica se o usuário pode gerenciar outros usuários
 func (u *User) CanManageUsers() bool {
 	return u.IsAdmin()
@@ -187,6 +257,15 @@ func (u *User) CanRead() bool {
 	return u.IsActive // todos os usuários ativos podem ler
 }
 
+// randomSecret gera uma senha aleatória para contas acessadas apenas por código
+func randomSecret() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
 // String retorna uma representação em string do usuário
 func (u *User) String() string {
 	return u.Username + " (" + string(u.Role) + ")"
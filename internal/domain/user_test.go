@@ -3,6 +3,7 @@ package domain
 import (
 	"testing"
 
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -268,3 +269,30 @@ func TestUser_ChangeRole(t *testing.T) {
 		t.Errorf("ChangeRole() expected ErrInvalidRole, got %v", err)
 	}
 }
+
+func TestNewTenantUser(t *testing.T) {
+	tenantID := uuid.New()
+
+	user, err := NewTenantUser(tenantID, "123.456.789-09", "")
+	if err != nil {
+		t.Fatalf("NewTenantUser() unexpected error: %v", err)
+	}
+
+	if !user.IsTenant() || user.IsStaff() {
+		t.Errorf("IsTenant() = %v, IsStaff() = %v, want tenant only", user.IsTenant(), user.IsStaff())
+	}
+	if user.TenantID == nil || *user.TenantID != tenantID {
+		t.Errorf("TenantID = %v, want %v", user.TenantID, tenantID)
+	}
+	if user.CanWrite() {
+		t.Error("tenant user should not have write permission")
+	}
+
+	if _, err := NewUser("portal", "password123", UserRoleTenant); err != ErrTenantUserWithoutLink {
+		t.Errorf("NewUser() expected ErrTenantUserWithoutLink, got %v", err)
+	}
+
+	if err := user.ChangeRole(UserRoleAdmin); err != ErrTenantRoleChange {
+		t.Errorf("ChangeRole() expected ErrTenantRoleChange, got %v", err)
+	}
+}
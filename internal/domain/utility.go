@@ -0,0 +1,249 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// UtilityType representa os tipos de consumo cobrados dos moradores
+type UtilityType string
+
+const (
+	UtilityTypeWater       UtilityType = "water"
+	UtilityTypeElectricity UtilityType = "electricity"
+)
+
+// ValidUtilityTypes contém todos os tipos válidos de consumo
+var ValidUtilityTypes = []UtilityType{
+	UtilityTypeWater,
+	UtilityTypeElectricity,
+}
+
+// UtilityBillingMethod representa como o consumo é calculado para cada contrato
+type UtilityBillingMethod string
+
+const (
+	UtilityBillingMetered        UtilityBillingMethod = "metered"         // Leitura do medidor da unidade
+	UtilityBillingSplitOccupants UtilityBillingMethod = "split_occupants" // Rateio da conta do prédio por moradores
+	UtilityBillingSplitEqual     UtilityBillingMethod = "split_equal"     // Rateio da conta do prédio em partes iguais
+)
+
+// utilityTypeLabels contém os nomes usados no detalhamento dos pagamentos
+var utilityTypeLabels = map[UtilityType]string{
+	UtilityTypeWater:       "Água",
+	UtilityTypeElectricity: "Energia",
+}
+
+// UtilityTariff representa a tarifa de um tipo de consumo a partir de uma data
+type UtilityTariff struct {
+	ID            uuid.UUID       `json:"id"`
+	UtilityType   UtilityType     `json:"utility_type"`
+	PricePerUnit  decimal.Decimal `json:"price_per_unit"`
+	FixedFee      decimal.Decimal `json:"fixed_fee"`
+	EffectiveFrom time.Time       `json:"effective_from"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// MeterReading representa a leitura mensal do medidor de uma unidade
+type MeterReading struct {
+	ID             uuid.UUID       `json:"id"`
+	UnitID         uuid.UUID       `json:"unit_id"`
+	UtilityType    UtilityType     `json:"utility_type"`
+	ReferenceMonth time.Time       `json:"reference_month"`
+	Reading        decimal.Decimal `json:"reading"`
+	ReadingDate    time.Time       `json:"reading_date"`
+	Notes          *string         `json:"notes,omitempty"`
+	CreatedBy      *uuid.UUID      `json:"created_by,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// UtilityCharge representa o detalhamento de uma cobrança de consumo em um pagamento
+type UtilityCharge struct {
+	ID              uuid.UUID            `json:"id"`
+	PaymentID       uuid.UUID            `json:"payment_id"`
+	LeaseID         uuid.UUID            `json:"lease_id"`
+	UnitID          uuid.UUID            `json:"unit_id"`
+	UtilityType     UtilityType          `json:"utility_type"`
+	ReferenceMonth  time.Time            `json:"reference_month"`
+	BillingMethod   UtilityBillingMethod `json:"billing_method"`
+	PreviousReading *decimal.Decimal     `json:"previous_reading,omitempty"`
+	CurrentReading  *decimal.Decimal     `json:"current_reading,omitempty"`
+	Consumption     *decimal.Decimal     `json:"consumption,omitempty"`
+	PricePerUnit    *decimal.Decimal     `json:"price_per_unit,omitempty"`
+	FixedFee        decimal.Decimal      `json:"fixed_fee"`
+	BuildingTotal   *decimal.Decimal     `json:"building_total,omitempty"`
+	ShareWeight     *int                 `json:"share_weight,omitempty"`
+	TotalShares     *int                 `json:"total_shares,omitempty"`
+	Amount          decimal.Decimal      `json:"amount"`
+	CreatedAt       time.Time            `json:"created_at"`
+}
+
+// Domain errors específicos de cobrança de consumo
+var (
+	ErrInvalidUtilityType    = errors.New("invalid utility type")
+	ErrInvalidTariffPrice    = errors.New("tariff price per unit must be greater than zero")
+	ErrInvalidTariffFixedFee = errors.New("tariff fixed fee cannot be negative")
+	ErrInvalidMeterReading   = errors.New("meter reading cannot be negative")
+	ErrMeterReadingDecreased = errors.New("current meter reading is lower than the previous reading")
+	ErrInvalidBuildingBill   = errors.New("building bill total must be greater than zero")
+	ErrInvalidShareWeight    = errors.New("share weight must be at least 1")
+)
+
+// IsValidUtilityType verifica se o tipo de consumo é válido
+func IsValidUtilityType(t UtilityType) bool {
+	for _, valid := range ValidUtilityTypes {
+		if t == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// ReferenceMonthOf retorna o primeiro dia do mês da data informada
+func ReferenceMonthOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// NewUtilityTariff cria uma nova tarifa de consumo
+func NewUtilityTariff(utilityType UtilityType, pricePerUnit, fixedFee decimal.Decimal, effectiveFrom time.Time) (*UtilityTariff, error) {
+	if !IsValidUtilityType(utilityType) {
+		return nil, ErrInvalidUtilityType
+	}
+	if pricePerUnit.LessThanOrEqual(decimal.Zero) {
+		return nil, ErrInvalidTariffPrice
+	}
+	if fixedFee.LessThan(decimal.Zero) {
+		return nil, ErrInvalidTariffFixedFee
+	}
+
+	return &UtilityTariff{
+		ID:            uuid.New(),
+		UtilityType:   utilityType,
+		PricePerUnit:  pricePerUnit,
+		FixedFee:      fixedFee,
+		EffectiveFrom: effectiveFrom,
+		CreatedAt:     time.Now(),
+	}, nil
+}
+
+// NewMeterReading cria uma nova leitura de medidor para o mês de referência
+func NewMeterReading(unitID uuid.UUID, utilityType UtilityType, referenceMonth time.Time, reading decimal.Decimal, readingDate time.Time, createdBy *uuid.UUID) (*MeterReading, error) {
+	if !IsValidUtilityType(utilityType) {
+		return nil, ErrInvalidUtilityType
+	}
+	if reading.LessThan(decimal.Zero) {
+		return nil, ErrInvalidMeterReading
+	}
+
+	return &MeterReading{
+		ID:             uuid.New(),
+		UnitID:         unitID,
+		UtilityType:    utilityType,
+		ReferenceMonth: ReferenceMonthOf(referenceMonth),
+		Reading:        reading,
+		ReadingDate:    readingDate,
+		CreatedBy:      createdBy,
+		CreatedAt:      time.Now(),
+	}, nil
+}
+
+// NewMeteredCharge calcula a cobrança pelo consumo medido entre duas leituras
+func NewMeteredCharge(lease *Lease, previous, current *MeterReading, tariff *UtilityTariff) (*UtilityCharge, error) {
+	if current.Reading.LessThan(previous.Reading) {
+		return nil, ErrMeterReadingDecreased
+	}
+
+	consumption := current.Reading.Sub(previous.Reading)
+	amount := consumption.Mul(tariff.PricePerUnit).Add(tariff.FixedFee).Round(2)
+
+	return &UtilityCharge{
+		ID:              uuid.New(),
+		LeaseID:         lease.ID,
+		UnitID:          lease.UnitID,
+		UtilityType:     current.UtilityType,
+		ReferenceMonth:  current.ReferenceMonth,
+		BillingMethod:   UtilityBillingMetered,
+		PreviousReading: &previous.Reading,
+		CurrentReading:  &current.Reading,
+		Consumption:     &consumption,
+		PricePerUnit:    &tariff.PricePerUnit,
+		FixedFee:        tariff.FixedFee,
+		Amount:          amount,
+		CreatedAt:       time.Now(),
+	}, nil
+}
+
+// NewSplitCharge cria a cobrança da parte de um contrato no rateio da conta do prédio
+func NewSplitCharge(lease *Lease, utilityType UtilityType, referenceMonth time.Time, method UtilityBillingMethod, buildingTotal decimal.Decimal, shareWeight, totalShares int, amount decimal.Decimal) *UtilityCharge {
+	return &UtilityCharge{
+		ID:             uuid.New(),
+		LeaseID:        lease.ID,
+		UnitID:         lease.UnitID,
+		UtilityType:    utilityType,
+		ReferenceMonth: ReferenceMonthOf(referenceMonth),
+		BillingMethod:  method,
+		FixedFee:       decimal.Zero,
+		BuildingTotal:  &buildingTotal,
+		ShareWeight:    &shareWeight,
+		TotalShares:    &totalShares,
+		Amount:         amount,
+		CreatedAt:      time.Now(),
+	}
+}
+
+// SplitBuildingBill divide o valor da conta proporcionalmente aos pesos informados
+// O arredondamento é ajustado na última parte para que a soma feche com o total
+func SplitBuildingBill(total decimal.Decimal, weights []int) ([]decimal.Decimal, error) {
+	if total.LessThanOrEqual(decimal.Zero) {
+		return nil, ErrInvalidBuildingBill
+	}
+
+	totalWeight := 0
+	for _, w := range weights {
+		if w < 1 {
+			return nil, ErrInvalidShareWeight
+		}
+		totalWeight += w
+	}
+
+	shares := make([]decimal.Decimal, len(weights))
+	allocated := decimal.Zero
+	for i, w := range weights {
+		if i == len(weights)-1 {
+			shares[i] = total.Sub(allocated)
+			break
+		}
+		shares[i] = total.Mul(decimal.NewFromInt(int64(w))).Div(decimal.NewFromInt(int64(totalWeight))).Round(2)
+		allocated = allocated.Add(shares[i])
+	}
+
+	return shares, nil
+}
+
+// Describe retorna a linha de detalhamento da cobrança usada nas observações do pagamento
+func (c *UtilityCharge) Describe() string {
+	label := utilityTypeLabels[c.UtilityType]
+
+	switch c.BillingMethod {
+	case UtilityBillingMetered:
+		return fmt.Sprintf("%s: consumo %s (%s → %s) x R$ %s + taxa fixa R$ %s = R$ %s",
+			label,
+			c.Consumption.String(),
+			c.PreviousReading.String(),
+			c.CurrentReading.String(),
+			c.PricePerUnit.String(),
+			c.FixedFee.StringFixed(2),
+			c.Amount.StringFixed(2),
+		)
+	case UtilityBillingSplitOccupants:
+		return fmt.Sprintf("%s: rateio por moradores (%d/%d) da conta de R$ %s = R$ %s",
+			label, *c.ShareWeight, *c.TotalShares, c.BuildingTotal.StringFixed(2), c.Amount.StringFixed(2))
+	default:
+		return fmt.Sprintf("%s: rateio igual (%d/%d) da conta de R$ %s = R$ %s",
+			label, *c.ShareWeight, *c.TotalShares, c.BuildingTotal.StringFixed(2), c.Amount.StringFixed(2))
+	}
+}
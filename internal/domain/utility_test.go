@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMeterReading(t *testing.T) {
+	unitID := uuid.New()
+	readingDate := time.Date(2026, 3, 28, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should normalize reference month", func(t *testing.T) {
+		reading, err := NewMeterReading(unitID, UtilityTypeElectricity, time.Date(2026, 3, 15, 10, 0, 0, 0, time.UTC), decimal.NewFromInt(1200), readingDate, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), reading.ReferenceMonth)
+	})
+
+	t.Run("should fail with invalid utility type", func(t *testing.T) {
+		reading, err := NewMeterReading(unitID, UtilityType("gas"), readingDate, decimal.NewFromInt(10), readingDate, nil)
+
+		assert.Nil(t, reading)
+		assert.Equal(t, ErrInvalidUtilityType, err)
+	})
+
+	t.Run("should fail with negative reading", func(t *testing.T) {
+		reading, err := NewMeterReading(unitID, UtilityTypeWater, readingDate, decimal.NewFromInt(-1), readingDate, nil)
+
+		assert.Nil(t, reading)
+		assert.Equal(t, ErrInvalidMeterReading, err)
+	})
+}
+
+func TestNewUtilityTariff(t *testing.T) {
+	effective := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should create tariff", func(t *testing.T) {
+		tariff, err := NewUtilityTariff(UtilityTypeElectricity, decimal.RequireFromString("0.85"), decimal.NewFromInt(5), effective)
+
+		require.NoError(t, err)
+		assert.Equal(t, UtilityTypeElectricity, tariff.UtilityType)
+	})
+
+	t.Run("should fail with zero price", func(t *testing.T) {
+		tariff, err := NewUtilityTariff(UtilityTypeWater, decimal.Zero, decimal.Zero, effective)
+
+		assert.Nil(t, tariff)
+		assert.Equal(t, ErrInvalidTariffPrice, err)
+	})
+
+	t.Run("should fail with negative fixed fee", func(t *testing.T) {
+		tariff, err := NewUtilityTariff(UtilityTypeWater, decimal.NewFromInt(10), decimal.NewFromInt(-1), effective)
+
+		assert.Nil(t, tariff)
+		assert.Equal(t, ErrInvalidTariffFixedFee, err)
+	})
+}
+
+func TestNewMeteredCharge(t *testing.T) {
+	lease := &Lease{ID: uuid.New(), UnitID: uuid.New()}
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	tariff, _ := NewUtilityTariff(UtilityTypeElectricity, decimal.RequireFromString("0.85"), decimal.NewFromInt(5), march)
+
+	previous, _ := NewMeterReading(lease.UnitID, UtilityTypeElectricity, march.AddDate(0, -1, 0), decimal.NewFromInt(1000), march, nil)
+	current, _ := NewMeterReading(lease.UnitID, UtilityTypeElectricity, march, decimal.NewFromInt(1120), march, nil)
+
+	t.Run("should charge consumption plus fixed fee", func(t *testing.T) {
+		charge, err := NewMeteredCharge(lease, previous, current, tariff)
+
+		require.NoError(t, err)
+		assert.Equal(t, UtilityBillingMetered, charge.BillingMethod)
+		assert.True(t, decimal.NewFromInt(120).Equal(*charge.Consumption))
+		assert.True(t, decimal.NewFromInt(107).Equal(charge.Amount))
+		assert.Contains(t, charge.Describe(), "Energia: consumo 120")
+	})
+
+	t.Run("should fail when reading decreased", func(t *testing.T) {
+		charge, err := NewMeteredCharge(lease, current, previous, tariff)
+
+		assert.Nil(t, charge)
+		assert.Equal(t, ErrMeterReadingDecreased, err)
+	})
+}
+
+func TestSplitBuildingBill(t *testing.T) {
+	t.Run("should split proportionally and keep the total", func(t *testing.T) {
+		shares, err := SplitBuildingBill(decimal.NewFromInt(100), []int{1, 1, 1})
+
+		require.NoError(t, err)
+		assert.Equal(t, "33.33", shares[0].StringFixed(2))
+		assert.Equal(t, "33.33", shares[1].StringFixed(2))
+		assert.Equal(t, "33.34", shares[2].StringFixed(2))
+	})
+
+	t.Run("should weight by occupants", func(t *testing.T) {
+		shares, err := SplitBuildingBill(decimal.NewFromInt(300), []int{2, 1})
+
+		require.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(200).Equal(shares[0]))
+		assert.True(t, decimal.NewFromInt(100).Equal(shares[1]))
+	})
+
+	t.Run("should fail with invalid total", func(t *testing.T) {
+		_, err := SplitBuildingBill(decimal.Zero, []int{1})
+		assert.Equal(t, ErrInvalidBuildingBill, err)
+	})
+
+	t.Run("should fail with invalid weight", func(t *testing.T) {
+		_, err := SplitBuildingBill(decimal.NewFromInt(100), []int{1, 0})
+		assert.Equal(t, ErrInvalidShareWeight, err)
+	})
+}
@@ -0,0 +1,335 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookEventType representa um evento do sistema enviado às integrações externas
+type WebhookEventType string
+
+const (
+	WebhookEventLeaseCreated      WebhookEventType = "lease.created"
+	WebhookEventLeaseRenewed      WebhookEventType = "lease.renewed"
+	WebhookEventLeaseCancelled    WebhookEventType = "lease.cancelled"
+	WebhookEventPaymentPaid       WebhookEventType = "payment.paid"
+	WebhookEventPaymentOverdue    WebhookEventType = "payment.overdue"
+	WebhookEventUnitStatusChanged WebhookEventType = "unit.status_changed"
+)
+
+// WebhookDeliveryStatus representa o status de entrega de um evento a um endpoint
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered" // Endpoint respondeu 2xx
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"    // Tentativas esgotadas ou endpoint desativado
+)
+
+// ValidWebhookEventTypes contém todos os eventos publicados pelo sistema
+var ValidWebhookEventTypes = []WebhookEventType{
+	WebhookEventLeaseCreated,
+	WebhookEventLeaseRenewed,
+	WebhookEventLeaseCancelled,
+	WebhookEventPaymentPaid,
+	WebhookEventPaymentOverdue,
+	WebhookEventUnitStatusChanged,
+}
+
+const (
+	// WebhookMaxAttempts é a quantidade máxima de tentativas de entrega
+	WebhookMaxAttempts = 8
+	// WebhookRetryBaseDelay é o intervalo após a primeira falha (dobra a cada nova falha)
+	WebhookRetryBaseDelay = time.Minute
+	// WebhookRetryMaxDelay limita o intervalo entre tentativas
+	WebhookRetryMaxDelay = 6 * time.Hour
+	// webhookSecretPrefix identifica os segredos gerados pelo sistema
+	webhookSecretPrefix = "whsec_"
+)
+
+// Domain errors específicos de webhooks
+var (
+	ErrInvalidWebhookURL         = errors.New("webhook URL must be an absolute http or https URL (max 500 characters)")
+	ErrInvalidWebhookDescription = errors.New("webhook description must have at most 255 characters")
+	ErrInvalidWebhookEventType   = errors.New("invalid webhook event type")
+	ErrWebhookEventsRequired     = errors.New("at least one webhook event is required")
+	ErrWebhookDeliveryNotPending = errors.New("webhook delivery is not pending")
+	ErrWebhookDeliveryPending    = errors.New("webhook delivery is still pending")
+)
+
+// WebhookEndpoint representa uma integração externa inscrita em eventos do sistema
+type WebhookEndpoint struct {
+	ID          uuid.UUID          `json:"id"`
+	URL         string             `json:"url"`
+	Description string             `json:"description"`
+	Secret      string             `json:"-"` // Usado na assinatura HMAC-SHA256 das entregas
+	Events      []WebhookEventType `json:"events"`
+	Active      bool               `json:"active"`
+	CreatedAt   time.Time          `json:"created_at"`
+	UpdatedAt   time.Time          `json:"updated_at"`
+}
+
+// WebhookEvent representa um evento publicado, no formato enviado aos endpoints
+type WebhookEvent struct {
+	ID         uuid.UUID        `json:"id"`
+	Type       WebhookEventType `json:"type"`
+	OccurredAt time.Time        `json:"occurred_at"`
+	Data       any              `json:"data"`
+}
+
+// WebhookDelivery representa a entrega de um evento a um endpoint (registro de entregas)
+type WebhookDelivery struct {
+	ID             uuid.UUID             `json:"id"`
+	EndpointID     uuid.UUID             `json:"endpoint_id"`
+	EventID        uuid.UUID             `json:"event_id"`
+	EventType      WebhookEventType      `json:"event_type"`
+	Payload        json.RawMessage       `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	NextAttemptAt  *time.Time            `json:"next_attempt_at,omitempty"`
+	LastError      *string               `json:"last_error,omitempty"`
+	ResponseStatus *int                  `json:"response_status,omitempty"` // Código HTTP da última tentativa
+	DeliveredAt    *time.Time            `json:"delivered_at,omitempty"`
+	ReplayOf       *uuid.UUID            `json:"replay_of,omitempty"` // Entrega original, quando criada por reenvio manual
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+// IsValidWebhookEventType verifica se o evento é publicado pelo sistema
+func IsValidWebhookEventType(eventType WebhookEventType) bool {
+	for _, valid := range ValidWebhookEventTypes {
+		if eventType == valid {
+			return true
+		}
+	}
+	return false
+}
+
+// NewWebhookEndpoint cria um endpoint ativo com um novo segredo de assinatura
+func NewWebhookEndpoint(rawURL, description string, events []WebhookEventType) (*WebhookEndpoint, error) {
+	secret, err := GenerateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	endpoint := &WebhookEndpoint{
+		ID:        uuid.New(),
+		Secret:    secret,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := endpoint.Update(rawURL, description, events, true); err != nil {
+		return nil, err
+	}
+
+	return endpoint, nil
+}
+
+// Update altera URL, descrição, eventos e situação do endpoint
+// Eventos repetidos são descartados e a lista é mantida em ordem alfabética
+func (e *WebhookEndpoint) Update(rawURL, description string, events []WebhookEventType, active bool) error {
+	rawURL = strings.TrimSpace(rawURL)
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" || len(rawURL) > 500 {
+		return ErrInvalidWebhookURL
+	}
+
+	description = strings.TrimSpace(description)
+	if len(description) > 255 {
+		return ErrInvalidWebhookDescription
+	}
+
+	if len(events) == 0 {
+		return ErrWebhookEventsRequired
+	}
+	seen := make(map[WebhookEventType]bool, len(events))
+	unique := make([]WebhookEventType, 0, len(events))
+	for _, event := range events {
+		if !IsValidWebhookEventType(event) {
+			return ErrInvalidWebhookEventType
+		}
+		if !seen[event] {
+			seen[event] = true
+			unique = append(unique, event)
+		}
+	}
+	sort.Slice(unique, func(i, j int) bool { return unique[i] < unique[j] })
+
+	e.URL = rawURL
+	e.Description = description
+	e.Events = unique
+	e.Active = active
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// RotateSecret gera um novo segredo de assinatura; o anterior deixa de ser usado imediatamente
+func (e *WebhookEndpoint) RotateSecret() error {
+	secret, err := GenerateWebhookSecret()
+	if err != nil {
+		return err
+	}
+
+	e.Secret = secret
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// Subscribes verifica se o endpoint está inscrito no evento
+func (e *WebhookEndpoint) Subscribes(eventType WebhookEventType) bool {
+	for _, event := range e.Events {
+		if event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateWebhookSecret gera um segredo aleatório de 256 bits (ex: whsec_3f9a...)
+func GenerateWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return webhookSecretPrefix + hex.EncodeToString(buf), nil
+}
+
+// NewWebhookEvent cria um evento com os dados do recurso afetado
+func NewWebhookEvent(eventType WebhookEventType, data any) (*WebhookEvent, error) {
+	if !IsValidWebhookEventType(eventType) {
+		return nil, ErrInvalidWebhookEventType
+	}
+
+	return &WebhookEvent{
+		ID:         uuid.New(),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	}, nil
+}
+
+// NewWebhookDelivery cria uma entrega pendente do evento para o endpoint
+func NewWebhookDelivery(endpointID uuid.UUID, event *WebhookEvent) (*WebhookDelivery, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &WebhookDelivery{
+		ID:         uuid.New(),
+		EndpointID: endpointID,
+		EventID:    event.ID,
+		EventType:  event.Type,
+		Payload:    payload,
+		Status:     WebhookDeliveryPending,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// Replay cria uma nova entrega pendente do mesmo evento (mesmo event_id e payload)
+// A entrega original é mantida no registro; o receptor pode usar o event_id para ignorar duplicatas
+func (d *WebhookDelivery) Replay() (*WebhookDelivery, error) {
+	if d.Status == WebhookDeliveryPending {
+		return nil, ErrWebhookDeliveryPending
+	}
+
+	now := time.Now()
+	original := d.ID
+	return &WebhookDelivery{
+		ID:         uuid.New(),
+		EndpointID: d.EndpointID,
+		EventID:    d.EventID,
+		EventType:  d.EventType,
+		Payload:    d.Payload,
+		Status:     WebhookDeliveryPending,
+		ReplayOf:   &original,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}, nil
+}
+
+// IsReadyToSend verifica se a entrega está pendente e fora do intervalo de espera
+func (d *WebhookDelivery) IsReadyToSend(now time.Time) bool {
+	if d.Status != WebhookDeliveryPending {
+		return false
+	}
+	return d.NextAttemptAt == nil || !d.NextAttemptAt.After(now)
+}
+
+// MarkAsDelivered registra a resposta 2xx do endpoint
+func (d *WebhookDelivery) MarkAsDelivered(statusCode int, now time.Time) error {
+	if d.Status != WebhookDeliveryPending {
+		return ErrWebhookDeliveryNotPending
+	}
+
+	d.Status = WebhookDeliveryDelivered
+	d.Attempts++
+	d.ResponseStatus = &statusCode
+	d.DeliveredAt = &now
+	d.NextAttemptAt = nil
+	d.LastError = nil
+	d.UpdatedAt = now
+	return nil
+}
+
+// RegisterFailure registra uma tentativa sem sucesso (statusCode = 0 quando não houve resposta)
+// Agenda a próxima tentativa com backoff exponencial ou marca como falha ao esgotar as tentativas
+func (d *WebhookDelivery) RegisterFailure(reason string, statusCode int, now time.Time) error {
+	if d.Status != WebhookDeliveryPending {
+		return ErrWebhookDeliveryNotPending
+	}
+
+	d.Attempts++
+	d.LastError = &reason
+	d.ResponseStatus = nil
+	if statusCode > 0 {
+		d.ResponseStatus = &statusCode
+	}
+	d.UpdatedAt = now
+
+	if d.Attempts >= WebhookMaxAttempts {
+		d.Status = WebhookDeliveryFailed
+		d.NextAttemptAt = nil
+		return nil
+	}
+
+	next := now.Add(WebhookRetryDelay(d.Attempts))
+	d.NextAttemptAt = &next
+	return nil
+}
+
+// Abandon encerra a entrega sem novas tentativas (ex: endpoint desativado ou removido)
+func (d *WebhookDelivery) Abandon(reason string, now time.Time) error {
+	if d.Status != WebhookDeliveryPending {
+		return ErrWebhookDeliveryNotPending
+	}
+
+	d.Status = WebhookDeliveryFailed
+	d.LastError = &reason
+	d.NextAttemptAt = nil
+	d.UpdatedAt = now
+	return nil
+}
+
+// WebhookRetryDelay retorna o intervalo de espera após a tentativa informada
+func WebhookRetryDelay(attempt int) time.Duration {
+	delay := WebhookRetryBaseDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= WebhookRetryMaxDelay {
+			return WebhookRetryMaxDelay
+		}
+	}
+	return delay
+}
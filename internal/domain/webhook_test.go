@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestWebhookDelivery(t *testing.T) *WebhookDelivery {
+	event, err := NewWebhookEvent(WebhookEventPaymentPaid, map[string]string{"payment_id": "123"})
+	require.NoError(t, err)
+	delivery, err := NewWebhookDelivery(uuid.New(), event)
+	require.NoError(t, err)
+	return delivery
+}
+
+func TestNewWebhookEndpoint(t *testing.T) {
+	t.Run("should create active endpoint with secret and unique events", func(t *testing.T) {
+		endpoint, err := NewWebhookEndpoint(" https://hooks.example.com/kitnet ", "Planilha", []WebhookEventType{
+			WebhookEventPaymentPaid, WebhookEventLeaseCreated, WebhookEventPaymentPaid,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, endpoint.Active)
+		assert.Equal(t, "https://hooks.example.com/kitnet", endpoint.URL)
+		assert.True(t, strings.HasPrefix(endpoint.Secret, "whsec_"))
+		assert.Len(t, endpoint.Secret, len("whsec_")+64)
+		assert.Equal(t, []WebhookEventType{WebhookEventLeaseCreated, WebhookEventPaymentPaid}, endpoint.Events)
+		assert.True(t, endpoint.Subscribes(WebhookEventPaymentPaid))
+		assert.False(t, endpoint.Subscribes(WebhookEventUnitStatusChanged))
+	})
+
+	t.Run("should validate url and events", func(t *testing.T) {
+		_, err := NewWebhookEndpoint("ftp://hooks.example.com", "", []WebhookEventType{WebhookEventLeaseCreated})
+		assert.Equal(t, ErrInvalidWebhookURL, err)
+
+		_, err = NewWebhookEndpoint("https://hooks.example.com", "", nil)
+		assert.Equal(t, ErrWebhookEventsRequired, err)
+
+		_, err = NewWebhookEndpoint("https://hooks.example.com", "", []WebhookEventType{"lease.deleted"})
+		assert.Equal(t, ErrInvalidWebhookEventType, err)
+	})
+
+	t.Run("should rotate secret", func(t *testing.T) {
+		endpoint, err := NewWebhookEndpoint("https://hooks.example.com", "", []WebhookEventType{WebhookEventLeaseCreated})
+		require.NoError(t, err)
+		previous := endpoint.Secret
+
+		require.NoError(t, endpoint.RotateSecret())
+		assert.NotEqual(t, previous, endpoint.Secret)
+	})
+}
+
+func TestNewWebhookDelivery(t *testing.T) {
+	delivery := newTestWebhookDelivery(t)
+
+	var envelope map[string]any
+	require.NoError(t, json.Unmarshal(delivery.Payload, &envelope))
+	assert.Equal(t, delivery.EventID.String(), envelope["id"])
+	assert.Equal(t, "payment.paid", envelope["type"])
+	assert.Equal(t, map[string]any{"payment_id": "123"}, envelope["data"])
+	assert.Equal(t, WebhookDeliveryPending, delivery.Status)
+
+	_, err := NewWebhookEvent("payment.refunded", nil)
+	assert.Equal(t, ErrInvalidWebhookEventType, err)
+}
+
+func TestWebhookDelivery_Attempts(t *testing.T) {
+	now := time.Date(2026, 3, 7, 10, 0, 0, 0, time.UTC)
+
+	t.Run("should mark as delivered", func(t *testing.T) {
+		delivery := newTestWebhookDelivery(t)
+
+		require.NoError(t, delivery.MarkAsDelivered(204, now))
+		assert.Equal(t, WebhookDeliveryDelivered, delivery.Status)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Equal(t, 204, *delivery.ResponseStatus)
+		assert.Equal(t, ErrWebhookDeliveryNotPending, delivery.MarkAsDelivered(200, now))
+	})
+
+	t.Run("should retry with exponential backoff until failing", func(t *testing.T) {
+		delivery := newTestWebhookDelivery(t)
+
+		require.NoError(t, delivery.RegisterFailure("503 Service Unavailable", 503, now))
+		assert.Equal(t, WebhookDeliveryPending, delivery.Status)
+		assert.Equal(t, now.Add(time.Minute), *delivery.NextAttemptAt)
+		assert.False(t, delivery.IsReadyToSend(now))
+		assert.True(t, delivery.IsReadyToSend(now.Add(time.Minute)))
+
+		require.NoError(t, delivery.RegisterFailure("connection refused", 0, now))
+		assert.Equal(t, now.Add(2*time.Minute), *delivery.NextAttemptAt)
+		assert.Nil(t, delivery.ResponseStatus)
+
+		for delivery.Status == WebhookDeliveryPending {
+			require.NoError(t, delivery.RegisterFailure("timeout", 0, now))
+		}
+		assert.Equal(t, WebhookDeliveryFailed, delivery.Status)
+		assert.Equal(t, WebhookMaxAttempts, delivery.Attempts)
+		assert.Nil(t, delivery.NextAttemptAt)
+	})
+
+	t.Run("should cap retry delay", func(t *testing.T) {
+		assert.Equal(t, time.Minute, WebhookRetryDelay(1))
+		assert.Equal(t, 64*time.Minute, WebhookRetryDelay(7))
+		assert.Equal(t, WebhookRetryMaxDelay, WebhookRetryDelay(20))
+	})
+}
+
+func TestWebhookDelivery_Replay(t *testing.T) {
+	delivery := newTestWebhookDelivery(t)
+
+	_, err := delivery.Replay()
+	assert.Equal(t, ErrWebhookDeliveryPending, err)
+
+	require.NoError(t, delivery.Abandon("endpoint disabled", time.Now()))
+	replay, err := delivery.Replay()
+
+	require.NoError(t, err)
+	assert.NotEqual(t, delivery.ID, replay.ID)
+	assert.Equal(t, delivery.EventID, replay.EventID)
+	assert.Equal(t, delivery.Payload, replay.Payload)
+	assert.Equal(t, delivery.ID, *replay.ReplayOf)
+	assert.Equal(t, WebhookDeliveryPending, replay.Status)
+	assert.Zero(t, replay.Attempts)
+}
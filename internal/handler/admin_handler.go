@@ -45,6 +45,7 @@ func (h *AdminHandler) ForceSchedulerRun(w http.ResponseWriter, r *http.Request)
 			"mark_overdue_payments",
 			"check_expiring_soon_leases",
 			"auto_renew_leases",
+			"process_notifications",
 		},
 	})
 }
@@ -47,13 +47,14 @@ type ChangeRoleRequest struct {
 
 // UserResponse representa a resposta com dados de um usuário
 type UserResponse struct {
-	ID          uuid.UUID `json:"id"`
-	Username    string    `json:"username"`
-	Role        string    `json:"role"`
-	IsActive    bool      `json:"is_active"`
-	LastLoginAt *string   `json:"last_login_at,omitempty"`
-	CreatedAt   string    `json:"created_at"`
-	UpdatedAt   string    `json:"updated_at"`
+	ID          uuid.UUID  `json:"id"`
+	Username    string     `json:"username"`
+	Role        string     `json:"role"`
+	TenantID    *uuid.UUID `json:"tenant_id,omitempty"` // apenas para usuários do portal
+	IsActive    bool       `json:"is_active"`
+	LastLoginAt *string    `json:"last_login_at,omitempty"`
+	CreatedAt   string     `json:"created_at"`
+	UpdatedAt   string     `json:"updated_at"`
 }
 
 // ToUserResponse converte domain.User para UserResponse
@@ -62,6 +63,7 @@ func ToUserResponse(user *domain.User) *UserResponse {
 		ID:        user.ID,
 		Username:  user.Username,
 		Role:      string(user.Role),
+		TenantID:  user.TenantID,
 		IsActive:  user.IsActive,
 		CreatedAt: user.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt: user.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
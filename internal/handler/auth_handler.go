@@ -420,7 +420,8 @@ func (h *AuthHandler) handleServiceError(w http.ResponseWriter, err error) {
 		response.Error(w, http.StatusUnauthorized, err.Error())
 	case errors.Is(err, domain.ErrInvalidUsername),
 		errors.Is(err, domain.ErrInvalidPassword),
-		errors.Is(err, domain.ErrInvalidRole):
+		errors.Is(err, domain.ErrInvalidRole),
+		errors.Is(err, domain.ErrTenantRoleChange):
 		response.Error(w, http.StatusBadRequest, err.Error())
 	default:
 		response.Error(w, http.StatusInternalServerError, "Internal server error")
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+)
+
+// CalendarFeedResponse representa a situação do feed de calendário do usuário
+type CalendarFeedResponse struct {
+	CreatedAt      time.Time `json:"created_at"`
+	LastAccessedAt *string   `json:"last_accessed_at,omitempty"`
+}
+
+// CalendarFeedURLResponse inclui a URL do feed, exibida apenas quando o token é gerado
+type CalendarFeedURLResponse struct {
+	CalendarFeedResponse
+	URL string `json:"url"` // URL para assinar no aplicativo de calendário (contém o token)
+}
+
+// ToCalendarFeedResponse converte domain.CalendarFeed para CalendarFeedResponse
+func ToCalendarFeedResponse(feed *domain.CalendarFeed) *CalendarFeedResponse {
+	return &CalendarFeedResponse{
+		CreatedAt:      feed.CreatedAt,
+		LastAccessedAt: formatOptionalTimestamp(feed.LastAccessedAt),
+	}
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/ical"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// calendarFeedPath é o caminho público do feed; o token é concatenado com a extensão .ics
+const calendarFeedPath = "/api/v1/calendar/feeds/"
+
+// CalendarHandler lida com requisições HTTP dos feeds iCalendar
+type CalendarHandler struct {
+	calendarService *service.CalendarService
+}
+
+// NewCalendarHandler cria uma nova instância do handler
+func NewCalendarHandler(calendarService *service.CalendarService) *CalendarHandler {
+	return &CalendarHandler{
+		calendarService: calendarService,
+	}
+}
+
+// CreateFeed godoc
+// @Summary      Gerar URL do feed de calendário
+// @Description  Gera a URL do feed iCalendar (.ics) do usuário autenticado, com vencimentos, fins de contrato, entradas de moradores e visitas agendadas. A URL contém o token de acesso e é exibida apenas nesta resposta; gerar uma nova invalida a anterior
+// @Tags         Calendar
+// @Produce      json
+// @Success      201 {object} CalendarFeedURLResponse
+// @Failure      401 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /calendar/feed [post]
+func (h *CalendarHandler) CreateFeed(w http.ResponseWriter, r *http.Request) {
+	userID := currentUserID(r)
+	if userID == nil {
+		response.Error(w, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	feed, token, err := h.calendarService.CreateFeed(r.Context(), *userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Calendar feed created successfully", &CalendarFeedURLResponse{
+		CalendarFeedResponse: *ToCalendarFeedResponse(feed),
+		URL:                  calendarFeedURL(r, token),
+	})
+}
+
+// GetFeed godoc
+// @Summary      Consultar feed de calendário
+// @Description  Retorna a data de criação e o último acesso ao feed do usuário autenticado (a URL não é exibida novamente)
+// @Tags         Calendar
+// @Produce      json
+// @Success      200 {object} CalendarFeedResponse
+// @Failure      401 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /calendar/feed [get]
+func (h *CalendarHandler) GetFeed(w http.ResponseWriter, r *http.Request) {
+	userID := currentUserID(r)
+	if userID == nil {
+		response.Error(w, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	feed, err := h.calendarService.GetFeed(r.Context(), *userID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Calendar feed retrieved successfully", ToCalendarFeedResponse(feed))
+}
+
+// RevokeFeed godoc
+// @Summary      Revogar feed de calendário
+// @Description  Remove o feed do usuário autenticado; a URL deixa de funcionar imediatamente
+// @Tags         Calendar
+// @Produce      json
+// @Success      200 {object} response.Response
+// @Failure      401 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /calendar/feed [delete]
+func (h *CalendarHandler) RevokeFeed(w http.ResponseWriter, r *http.Request) {
+	userID := currentUserID(r)
+	if userID == nil {
+		response.Error(w, http.StatusUnauthorized, "User not authenticated")
+		return
+	}
+
+	if err := h.calendarService.RevokeFeed(r.Context(), *userID); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Calendar feed revoked successfully", nil)
+}
+
+// ServeFeed godoc
+// @Summary      Feed iCalendar
+// @Description  Retorna o calendário no formato iCalendar para assinatura em aplicativos de calendário. Autenticado pelo token da URL
+// @Tags         Calendar
+// @Produce      text/calendar
+// @Param        token path string true "Token do feed"
+// @Success      200 {string} string "Calendário iCalendar"
+// @Failure      404 {object} response.ErrorResponse
+// @Router       /calendar/feeds/{token}.ics [get]
+func (h *CalendarHandler) ServeFeed(w http.ResponseWriter, r *http.Request) {
+	calendar, err := h.calendarService.BuildFeed(r.Context(), chi.URLParam(r, "token"))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", ical.ContentType)
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	w.WriteHeader(http.StatusOK)
+	_ = calendar.Write(w, time.Now())
+}
+
+// calendarFeedURL monta a URL absoluta do feed a partir do host da requisição
+func calendarFeedURL(r *http.Request, token string) string {
+	scheme := "http"
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + calendarFeedPath + token + ".ics"
+}
+
+// handleServiceError mapeia erros do service para respostas HTTP
+func (h *CalendarHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrCalendarFeedNotFound),
+		errors.Is(err, domain.ErrInvalidCalendarFeedToken):
+		// Token inválido responde 404 para não revelar se a URL já existiu
+		response.Error(w, http.StatusNotFound, "Calendar feed not found")
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
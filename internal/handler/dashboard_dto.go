@@ -8,4 +8,6 @@ type DashboardResponse struct {
 	Financial *service.FinancialMetrics `json:"financial"`
 	Contracts *service.ContractMetrics  `json:"contracts"`
 	Alerts    *service.DashboardAlerts  `json:"alerts"`
+	// ByProperty separa ocupação e financeiro por imóvel
+	ByProperty []*service.PropertyDashboard `json:"by_property"`
 }
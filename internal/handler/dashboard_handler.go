@@ -21,7 +21,7 @@ func NewDashboardHandler(dashboardService *service.DashboardService) *DashboardH
 
 // GetDashboard godoc
 // @Summary      Obter dados do dashboard
-// @Description  Retorna métricas consolidadas de ocupação, financeiras, contratos e alertas
+// @Description  Retorna métricas consolidadas de ocupação, financeiras, contratos e alertas, além do detalhamento por imóvel
 // @Tags         Dashboard
 // @Produce      json
 // @Success      200 {object} DashboardResponse
@@ -59,12 +59,20 @@ func (h *DashboardHandler) GetDashboard(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// 5. Montar resposta consolidada
+	// 5. Buscar métricas por imóvel
+	byProperty, err := h.dashboardService.GetPropertyBreakdown(ctx)
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, "Failed to retrieve property metrics")
+		return
+	}
+
+	// 6. Montar resposta consolidada
 	dashboardData := DashboardResponse{
-		Occupancy: occupancyMetrics,
-		Financial: financialMetrics,
-		Contracts: contractMetrics,
-		Alerts:    alerts,
+		Occupancy:  occupancyMetrics,
+		Financial:  financialMetrics,
+		Contracts:  contractMetrics,
+		Alerts:     alerts,
+		ByProperty: byProperty,
 	}
 
 	response.Success(w, http.StatusOK, "Dashboard data retrieved successfully", dashboardData)
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+	"github.com/shopspring/decimal"
+)
+
+// SettleDepositRequest representa o payload para registrar o acerto da caução
+type SettleDepositRequest struct {
+	CorrectionRate    decimal.Decimal `json:"correction_rate"`    // Percentual acumulado do índice no período
+	AdditionalDamages decimal.Decimal `json:"additional_damages"` // Danos não apurados no checklist de saída
+	OtherDeductions   decimal.Decimal `json:"other_deductions"`
+	RefundedAt        *time.Time      `json:"refunded_at,omitempty"`
+	RefundMethod      *string         `json:"refund_method,omitempty" validate:"omitempty,oneof=pix cash bank_transfer credit_card"`
+	Notes             *string         `json:"notes,omitempty" validate:"omitempty,max=1000"`
+}
+
+// RefundDepositRequest representa o payload para registrar a devolução da caução
+type RefundDepositRequest struct {
+	RefundedAt   *time.Time `json:"refunded_at,omitempty"` // Padrão: hoje
+	RefundMethod string     `json:"refund_method" validate:"required,oneof=pix cash bank_transfer credit_card"`
+}
+
+// DepositSettlementResponse representa o acerto da caução na resposta HTTP
+type DepositSettlementResponse struct {
+	ID               uuid.UUID       `json:"id"`
+	LeaseID          uuid.UUID       `json:"lease_id"`
+	DepositAmount    decimal.Decimal `json:"deposit_amount"`
+	CorrectionRate   decimal.Decimal `json:"correction_rate"`
+	CorrectionAmount decimal.Decimal `json:"correction_amount"`
+	DamageDeductions decimal.Decimal `json:"damage_deductions"`
+	DebtDeductions   decimal.Decimal `json:"debt_deductions"`
+	OtherDeductions  decimal.Decimal `json:"other_deductions"`
+	TotalDeductions  decimal.Decimal `json:"total_deductions"`
+	RefundAmount     decimal.Decimal `json:"refund_amount"`
+	BalanceDue       decimal.Decimal `json:"balance_due"`
+	RefundedAt       *time.Time      `json:"refunded_at,omitempty"`
+	RefundMethod     *string         `json:"refund_method,omitempty"`
+	Notes            *string         `json:"notes,omitempty"`
+	CreatedBy        *uuid.UUID      `json:"created_by,omitempty"`
+	CreatedAt        time.Time       `json:"created_at"`
+
+	SettledPaymentIDs []uuid.UUID `json:"settled_payment_ids,omitempty"` // Pagamentos quitados com a caução (somados em debt_deductions)
+}
+
+// DepositSummaryResponse representa a situação da caução de um contrato
+type DepositSummaryResponse struct {
+	LeaseID                uuid.UUID                  `json:"lease_id"`
+	SecurityDeposit        decimal.Decimal            `json:"security_deposit"`
+	DepositReceivedDate    *time.Time                 `json:"deposit_received_date,omitempty"`
+	DepositHeldAt          *string                    `json:"deposit_held_at,omitempty"`
+	DepositCorrectionIndex *string                    `json:"deposit_correction_index,omitempty"`
+	Settlement             *DepositSettlementResponse `json:"settlement,omitempty"`
+	Preview                *DepositSettlementResponse `json:"preview,omitempty"`
+}
+
+// ToServiceRequest converte o payload para o request do service
+func (r SettleDepositRequest) ToServiceRequest(createdBy *uuid.UUID) service.SettleDepositRequest {
+	req := service.SettleDepositRequest{
+		CorrectionRate:    r.CorrectionRate,
+		AdditionalDamages: r.AdditionalDamages,
+		OtherDeductions:   r.OtherDeductions,
+		RefundedAt:        r.RefundedAt,
+		Notes:             r.Notes,
+		CreatedBy:         createdBy,
+	}
+	if r.RefundMethod != nil {
+		method := domain.PaymentMethod(*r.RefundMethod)
+		req.RefundMethod = &method
+	}
+	return req
+}
+
+// ToDepositSettlementResponse converte domain.DepositSettlement para DepositSettlementResponse
+func ToDepositSettlementResponse(s *domain.DepositSettlement) *DepositSettlementResponse {
+	if s == nil {
+		return nil
+	}
+
+	var refundMethod *string
+	if s.RefundMethod != nil {
+		method := string(*s.RefundMethod)
+		refundMethod = &method
+	}
+
+	return &DepositSettlementResponse{
+		ID:               s.ID,
+		LeaseID:          s.LeaseID,
+		DepositAmount:    s.DepositAmount,
+		CorrectionRate:   s.CorrectionRate,
+		CorrectionAmount: s.CorrectionAmount,
+		DamageDeductions: s.DamageDeductions,
+		DebtDeductions:   s.DebtDeductions,
+		OtherDeductions:  s.OtherDeductions,
+		TotalDeductions:  s.TotalDeductions(),
+		RefundAmount:     s.RefundAmount,
+		BalanceDue:       s.BalanceDue,
+		RefundedAt:       s.RefundedAt,
+		RefundMethod:     refundMethod,
+		Notes:            s.Notes,
+		CreatedBy:        s.CreatedBy,
+		CreatedAt:        s.CreatedAt,
+
+		SettledPaymentIDs: s.SettledPaymentIDs,
+	}
+}
+
+// ToDepositSummaryResponse converte service.DepositSummary para DepositSummaryResponse
+func ToDepositSummaryResponse(summary *service.DepositSummary) DepositSummaryResponse {
+	lease := ToLeaseResponse(summary.Lease)
+
+	return DepositSummaryResponse{
+		LeaseID:                lease.ID,
+		SecurityDeposit:        lease.SecurityDeposit,
+		DepositReceivedDate:    summary.DepositReceivedDate,
+		DepositHeldAt:          lease.DepositHeldAt,
+		DepositCorrectionIndex: lease.DepositCorrectionIndex,
+		Settlement:             ToDepositSettlementResponse(summary.Settlement),
+		Preview:                ToDepositSettlementResponse(summary.Preview),
+	}
+}
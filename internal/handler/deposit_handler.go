@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// DepositHandler lida com requisições HTTP da caução dos contratos
+type DepositHandler struct {
+	depositService *service.DepositService
+	validator      *validator.Validate
+}
+
+// NewDepositHandler cria uma nova instância do handler
+func NewDepositHandler(depositService *service.DepositService) *DepositHandler {
+	return &DepositHandler{
+		depositService: depositService,
+		validator:      validator.New(),
+	}
+}
+
+// GetDeposit godoc
+// @Summary      Consultar caução do contrato
+// @Description  Retorna a caução do contrato com o acerto registrado ou, se ainda não houver, uma simulação com os descontos atuais
+// @Tags         Leases
+// @Produce      json
+// @Param        id path string true "Lease ID (UUID)"
+// @Success      200 {object} DepositSummaryResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /leases/{id}/deposit [get]
+func (h *DepositHandler) GetDeposit(w http.ResponseWriter, r *http.Request) {
+	leaseID, ok := parseUUIDParam(w, r, "id", "Invalid lease ID")
+	if !ok {
+		return
+	}
+
+	summary, err := h.depositService.GetDepositSummary(r.Context(), leaseID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Security deposit retrieved successfully", ToDepositSummaryResponse(summary))
+}
+
+// SettleDeposit godoc
+// @Summary      Registrar acerto da caução
+// @Description  Calcula o saldo da caução após a saída do morador, descontando danos do checklist de saída e pagamentos em aberto, e registra a devolução
+// @Tags         Leases
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Lease ID (UUID)"
+// @Param        settlement body SettleDepositRequest true "Dados do acerto"
+// @Success      201 {object} DepositSettlementResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /leases/{id}/deposit/settlement [post]
+func (h *DepositHandler) SettleDeposit(w http.ResponseWriter, r *http.Request) {
+	leaseID, ok := parseUUIDParam(w, r, "id", "Invalid lease ID")
+	if !ok {
+		return
+	}
+
+	var req SettleDepositRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	settlement, err := h.depositService.SettleDeposit(r.Context(), leaseID, req.ToServiceRequest(currentUserID(r)))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Security deposit settled successfully", ToDepositSettlementResponse(settlement))
+}
+
+// RefundDeposit godoc
+// @Summary      Registrar devolução da caução
+// @Description  Registra a devolução do saldo de uma caução já acertada
+// @Tags         Leases
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Lease ID (UUID)"
+// @Param        refund body RefundDepositRequest true "Dados da devolução"
+// @Success      200 {object} DepositSettlementResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /leases/{id}/deposit/refund [post]
+func (h *DepositHandler) RefundDeposit(w http.ResponseWriter, r *http.Request) {
+	leaseID, ok := parseUUIDParam(w, r, "id", "Invalid lease ID")
+	if !ok {
+		return
+	}
+
+	var req RefundDepositRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	settlement, err := h.depositService.RefundDeposit(r.Context(), leaseID, req.RefundedAt, domain.PaymentMethod(req.RefundMethod))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Security deposit refund recorded successfully", ToDepositSettlementResponse(settlement))
+}
+
+// handleServiceError mapeia erros do service para respostas HTTP
+func (h *DepositHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrLeaseNotFound):
+		response.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrDepositNotSettled):
+		response.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrDepositAlreadySettled),
+		errors.Is(err, service.ErrDepositAlreadyRefunded):
+		response.Error(w, http.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrLeaseHasNoDeposit),
+		errors.Is(err, service.ErrDepositNotReceived),
+		errors.Is(err, service.ErrLeaseNotEnded),
+		errors.Is(err, service.ErrLeaseRenewedForDeposit),
+		errors.Is(err, domain.ErrInvalidDepositDeduction),
+		errors.Is(err, domain.ErrInvalidCorrectionRate),
+		errors.Is(err, domain.ErrInvalidPaymentMethod):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+)
+
+// CreateDunningStepRequest representa a requisição para adicionar uma etapa à régua de cobrança
+type CreateDunningStepRequest struct {
+	Code       string `json:"code" validate:"required,min=2,max=50"`
+	Name       string `json:"name" validate:"required,max=100"`
+	OffsetDays int    `json:"offset_days" validate:"min=-30,max=365"` // Negativo = antes do vencimento
+	Action     string `json:"action" validate:"required,oneof=reminder notice formal_notice eviction_review"`
+}
+
+// UpdateDunningStepRequest representa a requisição para alterar uma etapa da régua de cobrança
+type UpdateDunningStepRequest struct {
+	Name       string `json:"name" validate:"required,max=100"`
+	OffsetDays int    `json:"offset_days" validate:"min=-30,max=365"`
+	Action     string `json:"action" validate:"required,oneof=reminder notice formal_notice eviction_review"`
+	Active     *bool  `json:"active" validate:"required"`
+}
+
+// DunningStepResponse representa a resposta com dados de uma etapa da régua
+type DunningStepResponse struct {
+	ID         uuid.UUID `json:"id"`
+	Code       string    `json:"code"`
+	Name       string    `json:"name"`
+	OffsetDays int       `json:"offset_days"`
+	Label      string    `json:"label"` // D-3, D+5...
+	Action     string    `json:"action"`
+	Active     bool      `json:"active"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// CollectionActionResponse representa uma etapa registrada no histórico de cobrança do pagamento
+type CollectionActionResponse struct {
+	ID                   uuid.UUID `json:"id"`
+	PaymentID            uuid.UUID `json:"payment_id"`
+	StepID               uuid.UUID `json:"step_id"`
+	StepCode             string    `json:"step_code"`
+	StepName             string    `json:"step_name"`
+	OffsetDays           int       `json:"offset_days"`
+	Action               string    `json:"action"`
+	Status               string    `json:"status"`
+	NotificationsCreated int       `json:"notifications_created"`
+	Notes                *string   `json:"notes,omitempty"`
+	ExecutedAt           time.Time `json:"executed_at"`
+}
+
+// ToDunningStepResponse converte domain.DunningStep para DunningStepResponse
+func ToDunningStepResponse(step *domain.DunningStep) *DunningStepResponse {
+	return &DunningStepResponse{
+		ID:         step.ID,
+		Code:       step.Code,
+		Name:       step.Name,
+		OffsetDays: step.OffsetDays,
+		Label:      step.Label(),
+		Action:     string(step.Action),
+		Active:     step.Active,
+		CreatedAt:  step.CreatedAt,
+		UpdatedAt:  step.UpdatedAt,
+	}
+}
+
+// ToDunningStepResponseList converte slice de etapas para slice de responses
+func ToDunningStepResponseList(steps []*domain.DunningStep) []*DunningStepResponse {
+	responses := make([]*DunningStepResponse, len(steps))
+	for i, step := range steps {
+		responses[i] = ToDunningStepResponse(step)
+	}
+	return responses
+}
+
+// ToCollectionActionResponse converte domain.CollectionAction para CollectionActionResponse
+func ToCollectionActionResponse(action *domain.CollectionAction) *CollectionActionResponse {
+	return &CollectionActionResponse{
+		ID:                   action.ID,
+		PaymentID:            action.PaymentID,
+		StepID:               action.StepID,
+		StepCode:             action.StepCode,
+		StepName:             action.StepName,
+		OffsetDays:           action.OffsetDays,
+		Action:               string(action.Action),
+		Status:               string(action.Status),
+		NotificationsCreated: action.NotificationsCreated,
+		Notes:                action.Notes,
+		ExecutedAt:           action.ExecutedAt,
+	}
+}
+
+// ToCollectionActionResponseList converte slice do histórico de cobrança para slice de responses
+func ToCollectionActionResponseList(actions []*domain.CollectionAction) []*CollectionActionResponse {
+	responses := make([]*CollectionActionResponse, len(actions))
+	for i, action := range actions {
+		responses[i] = ToCollectionActionResponse(action)
+	}
+	return responses
+}
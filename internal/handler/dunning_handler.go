@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// DunningHandler lida com requisições HTTP da régua de cobrança
+type DunningHandler struct {
+	dunningService *service.DunningService
+	validator      *validator.Validate
+}
+
+// NewDunningHandler cria uma nova instância do handler
+func NewDunningHandler(dunningService *service.DunningService) *DunningHandler {
+	return &DunningHandler{
+		dunningService: dunningService,
+		validator:      validator.New(),
+	}
+}
+
+// ListSteps godoc
+// @Summary      Listar etapas da régua de cobrança
+// @Description  Retorna as etapas da régua ordenadas pelo prazo relativo ao vencimento (D-3, D+1, D+5...)
+// @Tags         Dunning
+// @Produce      json
+// @Success      200 {array} DunningStepResponse
+// @Security     BearerAuth
+// @Router       /dunning/steps [get]
+func (h *DunningHandler) ListSteps(w http.ResponseWriter, r *http.Request) {
+	steps, err := h.dunningService.ListSteps(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Dunning steps retrieved successfully", ToDunningStepResponseList(steps))
+}
+
+// CreateStep godoc
+// @Summary      Adicionar etapa à régua de cobrança
+// @Description  Adiciona uma etapa à régua. Ações: reminder, notice, formal_notice (mensagens ao morador) e eviction_review (sinaliza o pagamento para análise de despejo)
+// @Tags         Dunning
+// @Accept       json
+// @Produce      json
+// @Param        request body CreateDunningStepRequest true "Dados da etapa"
+// @Success      201 {object} DunningStepResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /dunning/steps [post]
+func (h *DunningHandler) CreateStep(w http.ResponseWriter, r *http.Request) {
+	var req CreateDunningStepRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	step, err := h.dunningService.CreateStep(r.Context(), req.Code, req.Name, req.OffsetDays, domain.DunningAction(req.Action))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Dunning step created successfully", ToDunningStepResponse(step))
+}
+
+// UpdateStep godoc
+// @Summary      Alterar etapa da régua de cobrança
+// @Description  Altera nome, prazo, ação ou situação de uma etapa. Etapas desativadas deixam de ser executadas; etapas já registradas no histórico não são reexecutadas
+// @Tags         Dunning
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Dunning step ID (UUID)"
+// @Param        request body UpdateDunningStepRequest true "Dados da etapa"
+// @Success      200 {object} DunningStepResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /dunning/steps/{id} [put]
+func (h *DunningHandler) UpdateStep(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid dunning step ID")
+	if !ok {
+		return
+	}
+
+	var req UpdateDunningStepRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	step, err := h.dunningService.UpdateStep(r.Context(), id, req.Name, req.OffsetDays, domain.DunningAction(req.Action), *req.Active)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Dunning step updated successfully", ToDunningStepResponse(step))
+}
+
+// RunDunning godoc
+// @Summary      Executar régua de cobrança
+// @Description  Aplica as etapas alcançadas aos pagamentos em aberto. Cada etapa é executada uma única vez por pagamento e pagamentos quitados saem da régua
+// @Tags         Dunning
+// @Produce      json
+// @Success      200 {object} service.DunningRunResult
+// @Failure      500 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /dunning/run [post]
+func (h *DunningHandler) RunDunning(w http.ResponseWriter, r *http.Request) {
+	result, err := h.dunningService.RunDunning(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Dunning processed successfully", result)
+}
+
+// ListEvictionReviews godoc
+// @Summary      Listar pagamentos para análise de despejo
+// @Description  Retorna os pagamentos ainda em aberto que alcançaram a etapa de análise de despejo
+// @Tags         Dunning
+// @Produce      json
+// @Success      200 {array} CollectionActionResponse
+// @Security     BearerAuth
+// @Router       /dunning/eviction-reviews [get]
+func (h *DunningHandler) ListEvictionReviews(w http.ResponseWriter, r *http.Request) {
+	actions, err := h.dunningService.ListEvictionReviews(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Eviction reviews retrieved successfully", ToCollectionActionResponseList(actions))
+}
+
+// GetCollectionHistory godoc
+// @Summary      Histórico de cobrança do pagamento
+// @Description  Retorna as etapas da régua executadas ou puladas para um pagamento
+// @Tags         Dunning
+// @Produce      json
+// @Param        id path string true "Payment ID (UUID)"
+// @Success      200 {array} CollectionActionResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /payments/{id}/collection-history [get]
+func (h *DunningHandler) GetCollectionHistory(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid payment ID")
+	if !ok {
+		return
+	}
+
+	actions, err := h.dunningService.GetCollectionHistory(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Collection history retrieved successfully", ToCollectionActionResponseList(actions))
+}
+
+// handleServiceError mapeia erros do service para respostas HTTP
+func (h *DunningHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrDunningStepNotFound),
+		errors.Is(err, service.ErrPaymentNotFound):
+		response.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrDunningStepCodeExists):
+		response.Error(w, http.StatusConflict, err.Error())
+	case errors.Is(err, domain.ErrInvalidDunningAction),
+		errors.Is(err, domain.ErrInvalidDunningStepCode),
+		errors.Is(err, domain.ErrInvalidDunningStepName),
+		errors.Is(err, domain.ErrInvalidDunningOffset):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/middleware"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+)
+
+// currentUserID retorna o ID do usuário autenticado na requisição (nil se ausente)
+func currentUserID(r *http.Request) *uuid.UUID {
+	user, ok := middleware.GetUserFromContext(r.Context())
+	if !ok || user == nil {
+		return nil
+	}
+	id := user.ID
+	return &id
+}
+
+// parseOptionalPropertyID lê o filtro property_id da query string
+// Retorna false (e já escreve a resposta de erro) se o valor for inválido
+func parseOptionalPropertyID(w http.ResponseWriter, r *http.Request) (*uuid.UUID, bool) {
+	propertyIDStr := r.URL.Query().Get("property_id")
+	if propertyIDStr == "" {
+		return nil, true
+	}
+
+	propertyID, err := uuid.Parse(propertyIDStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid property_id parameter")
+		return nil, false
+	}
+	return &propertyID, true
+}
+
+// parseUUIDParam extrai e valida um UUID da URL
+func parseUUIDParam(w http.ResponseWriter, r *http.Request, name, message string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, name))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, message)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// parseOptionalDate converte uma data YYYY-MM-DD já validada (nil se vazia)
+func parseOptionalDate(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil
+	}
+	return &date
+}
+
+// formatOptionalDate formata uma data opcional como YYYY-MM-DD
+func formatOptionalDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+	formatted := date.Format("2006-01-02")
+	return &formatted
+}
+
+// formatOptionalTimestamp formata uma data/hora opcional no padrão RFC 3339
+func formatOptionalTimestamp(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format("2006-01-02T15:04:05Z07:00")
+	return &formatted
+}
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+	"github.com/shopspring/decimal"
+)
+
+// InventoryItemRequest representa o payload para cadastrar ou atualizar um item do inventário
+type InventoryItemRequest struct {
+	Name          string           `json:"name" validate:"required,min=2,max=150"`
+	ItemType      string           `json:"item_type" validate:"required,oneof=furniture appliance electronics fixture other"`
+	Brand         *string          `json:"brand,omitempty" validate:"omitempty,max=100"`
+	SerialNumber  *string          `json:"serial_number,omitempty" validate:"omitempty,max=100"`
+	Condition     string           `json:"condition" validate:"required,oneof=new good fair damaged broken"`
+	PurchaseDate  *time.Time       `json:"purchase_date,omitempty"`
+	PurchaseValue *decimal.Decimal `json:"purchase_value,omitempty"`
+	Notes         *string          `json:"notes,omitempty" validate:"omitempty,max=1000"`
+}
+
+// MoveOutItemRequest representa a conferência de um item na saída do morador
+type MoveOutItemRequest struct {
+	ItemID           uuid.UUID        `json:"item_id" validate:"required"`
+	Present          bool             `json:"present"`
+	Condition        string           `json:"condition" validate:"required,oneof=new good fair damaged broken"`
+	ReplacementValue *decimal.Decimal `json:"replacement_value,omitempty"`
+	Notes            *string          `json:"notes,omitempty" validate:"omitempty,max=1000"`
+}
+
+// MoveOutChecklistRequest representa o payload do checklist de saída
+// Itens do checklist de entrada não informados são considerados presentes no mesmo estado
+type MoveOutChecklistRequest struct {
+	Items []MoveOutItemRequest `json:"items" validate:"dive"`
+	Notes *string              `json:"notes,omitempty" validate:"omitempty,max=2000"`
+}
+
+// InventoryItemResponse representa a resposta com dados de um item do inventário
+type InventoryItemResponse struct {
+	ID            uuid.UUID        `json:"id"`
+	UnitID        uuid.UUID        `json:"unit_id"`
+	Name          string           `json:"name"`
+	ItemType      string           `json:"item_type"`
+	Brand         *string          `json:"brand,omitempty"`
+	SerialNumber  *string          `json:"serial_number,omitempty"`
+	Condition     string           `json:"condition"`
+	PurchaseDate  *time.Time       `json:"purchase_date,omitempty"`
+	PurchaseValue *decimal.Decimal `json:"purchase_value,omitempty"`
+	Notes         *string          `json:"notes,omitempty"`
+	CreatedAt     time.Time        `json:"created_at"`
+	UpdatedAt     time.Time        `json:"updated_at"`
+}
+
+// InventoryChecklistItemResponse representa um item conferido no checklist
+type InventoryChecklistItemResponse struct {
+	ItemID           *uuid.UUID       `json:"item_id,omitempty"`
+	ItemName         string           `json:"item_name"`
+	ItemType         string           `json:"item_type"`
+	Brand            *string          `json:"brand,omitempty"`
+	SerialNumber     *string          `json:"serial_number,omitempty"`
+	Condition        string           `json:"condition"`
+	Present          bool             `json:"present"`
+	ReplacementValue *decimal.Decimal `json:"replacement_value,omitempty"`
+	Notes            *string          `json:"notes,omitempty"`
+}
+
+// InventoryChecklistResponse representa um checklist de entrada ou saída
+type InventoryChecklistResponse struct {
+	ID            uuid.UUID                         `json:"id"`
+	LeaseID       uuid.UUID                         `json:"lease_id"`
+	UnitID        uuid.UUID                         `json:"unit_id"`
+	ChecklistType string                            `json:"checklist_type"`
+	Notes         *string                           `json:"notes,omitempty"`
+	PerformedBy   *uuid.UUID                        `json:"performed_by,omitempty"`
+	PerformedAt   time.Time                         `json:"performed_at"`
+	Items         []*InventoryChecklistItemResponse `json:"items"`
+}
+
+// InventoryDiscrepancyResponse representa um item faltante ou danificado
+type InventoryDiscrepancyResponse struct {
+	ItemID             *uuid.UUID      `json:"item_id,omitempty"`
+	ItemName           string          `json:"item_name"`
+	Issue              string          `json:"issue"`
+	ConditionAtMoveIn  string          `json:"condition_at_move_in"`
+	ConditionAtMoveOut *string         `json:"condition_at_move_out,omitempty"`
+	ReplacementCost    decimal.Decimal `json:"replacement_cost"`
+	Notes              *string         `json:"notes,omitempty"`
+}
+
+// LeaseInventoryResponse representa a comparação entre entrada e saída de um contrato
+type LeaseInventoryResponse struct {
+	LeaseID              uuid.UUID                       `json:"lease_id"`
+	MoveIn               *InventoryChecklistResponse     `json:"move_in"`
+	MoveOut              *InventoryChecklistResponse     `json:"move_out,omitempty"`
+	Discrepancies        []*InventoryDiscrepancyResponse `json:"discrepancies"`
+	TotalReplacementCost decimal.Decimal                 `json:"total_replacement_cost"`
+}
+
+// ToInventoryItemResponse converte domain.UnitInventoryItem para InventoryItemResponse
+func ToInventoryItemResponse(item *domain.UnitInventoryItem) *InventoryItemResponse {
+	return &InventoryItemResponse{
+		ID:            item.ID,
+		UnitID:        item.UnitID,
+		Name:          item.Name,
+		ItemType:      string(item.Type),
+		Brand:         item.Brand,
+		SerialNumber:  item.SerialNumber,
+		Condition:     string(item.Condition),
+		PurchaseDate:  item.PurchaseDate,
+		PurchaseValue: item.PurchaseValue,
+		Notes:         item.Notes,
+		CreatedAt:     item.CreatedAt,
+		UpdatedAt:     item.UpdatedAt,
+	}
+}
+
+// ToInventoryItemResponseList converte uma lista de itens
+func ToInventoryItemResponseList(items []*domain.UnitInventoryItem) []*InventoryItemResponse {
+	result := make([]*InventoryItemResponse, len(items))
+	for i, item := range items {
+		result[i] = ToInventoryItemResponse(item)
+	}
+	return result
+}
+
+// ToInventoryChecklistResponse converte domain.InventoryChecklist para InventoryChecklistResponse
+func ToInventoryChecklistResponse(checklist *domain.InventoryChecklist) *InventoryChecklistResponse {
+	if checklist == nil {
+		return nil
+	}
+
+	resp := &InventoryChecklistResponse{
+		ID:            checklist.ID,
+		LeaseID:       checklist.LeaseID,
+		UnitID:        checklist.UnitID,
+		ChecklistType: string(checklist.Type),
+		Notes:         checklist.Notes,
+		PerformedBy:   checklist.PerformedBy,
+		PerformedAt:   checklist.PerformedAt,
+		Items:         make([]*InventoryChecklistItemResponse, len(checklist.Items)),
+	}
+
+	for i, item := range checklist.Items {
+		resp.Items[i] = &InventoryChecklistItemResponse{
+			ItemID:           item.ItemID,
+			ItemName:         item.ItemName,
+			ItemType:         string(item.ItemType),
+			Brand:            item.Brand,
+			SerialNumber:     item.SerialNumber,
+			Condition:        string(item.Condition),
+			Present:          item.Present,
+			ReplacementValue: item.ReplacementValue,
+			Notes:            item.Notes,
+		}
+	}
+
+	return resp
+}
+
+// ToLeaseInventoryResponse converte service.LeaseInventoryReport para LeaseInventoryResponse
+func ToLeaseInventoryResponse(report *service.LeaseInventoryReport) *LeaseInventoryResponse {
+	resp := &LeaseInventoryResponse{
+		LeaseID:              report.LeaseID,
+		MoveIn:               ToInventoryChecklistResponse(report.MoveIn),
+		MoveOut:              ToInventoryChecklistResponse(report.MoveOut),
+		Discrepancies:        make([]*InventoryDiscrepancyResponse, len(report.Discrepancies)),
+		TotalReplacementCost: report.TotalReplacementCost,
+	}
+
+	for i, d := range report.Discrepancies {
+		item := &InventoryDiscrepancyResponse{
+			ItemID:            d.ItemID,
+			ItemName:          d.ItemName,
+			Issue:             string(d.Issue),
+			ConditionAtMoveIn: string(d.ConditionAtMoveIn),
+			ReplacementCost:   d.ReplacementCost,
+			Notes:             d.Notes,
+		}
+		if d.ConditionAtMoveOut != nil {
+			condition := string(*d.ConditionAtMoveOut)
+			item.ConditionAtMoveOut = &condition
+		}
+		resp.Discrepancies[i] = item
+	}
+
+	return resp
+}
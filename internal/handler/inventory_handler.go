@@ -0,0 +1,308 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// InventoryHandler lida com requisições HTTP do inventário das unidades e checklists
+type InventoryHandler struct {
+	inventoryService *service.InventoryService
+	validator        *validator.Validate
+}
+
+// NewInventoryHandler cria uma nova instância do handler
+func NewInventoryHandler(inventoryService *service.InventoryService) *InventoryHandler {
+	return &InventoryHandler{
+		inventoryService: inventoryService,
+		validator:        validator.New(),
+	}
+}
+
+// ListUnitInventory godoc
+// @Summary      Listar inventário da unidade
+// @Description  Retorna os móveis e eletrodomésticos cadastrados na unidade
+// @Tags         Inventory
+// @Produce      json
+// @Param        id path string true "Unit ID (UUID)"
+// @Success      200 {array} InventoryItemResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      500 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /units/{id}/inventory [get]
+func (h *InventoryHandler) ListUnitInventory(w http.ResponseWriter, r *http.Request) {
+	unitID, ok := parseUUIDParam(w, r, "id", "Invalid unit ID")
+	if !ok {
+		return
+	}
+
+	items, err := h.inventoryService.ListItems(r.Context(), unitID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Inventory items retrieved successfully", ToInventoryItemResponseList(items))
+}
+
+// AddInventoryItem godoc
+// @Summary      Cadastrar item no inventário
+// @Description  Adiciona um móvel ou eletrodoméstico ao inventário da unidade
+// @Tags         Inventory
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Unit ID (UUID)"
+// @Param        item body InventoryItemRequest true "Dados do item"
+// @Success      201 {object} InventoryItemResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /units/{id}/inventory [post]
+func (h *InventoryHandler) AddInventoryItem(w http.ResponseWriter, r *http.Request) {
+	unitID, ok := parseUUIDParam(w, r, "id", "Invalid unit ID")
+	if !ok {
+		return
+	}
+
+	req, ok := h.decodeItemRequest(w, r)
+	if !ok {
+		return
+	}
+
+	item, err := h.inventoryService.AddItem(r.Context(), unitID, req)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Inventory item created successfully", ToInventoryItemResponse(item))
+}
+
+// UpdateInventoryItem godoc
+// @Summary      Atualizar item do inventário
+// @Description  Atualiza os dados de um item do inventário da unidade
+// @Tags         Inventory
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Unit ID (UUID)"
+// @Param        itemId path string true "Item ID (UUID)"
+// @Param        item body InventoryItemRequest true "Dados do item"
+// @Success      200 {object} InventoryItemResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /units/{id}/inventory/{itemId} [put]
+func (h *InventoryHandler) UpdateInventoryItem(w http.ResponseWriter, r *http.Request) {
+	unitID, ok := parseUUIDParam(w, r, "id", "Invalid unit ID")
+	if !ok {
+		return
+	}
+	itemID, ok := parseUUIDParam(w, r, "itemId", "Invalid inventory item ID")
+	if !ok {
+		return
+	}
+
+	req, ok := h.decodeItemRequest(w, r)
+	if !ok {
+		return
+	}
+
+	item, err := h.inventoryService.UpdateItem(r.Context(), unitID, itemID, req)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Inventory item updated successfully", ToInventoryItemResponse(item))
+}
+
+// DeleteInventoryItem godoc
+// @Summary      Remover item do inventário
+// @Description  Remove um item do inventário. Checklists já registrados mantêm a cópia dos dados
+// @Tags         Inventory
+// @Produce      json
+// @Param        id path string true "Unit ID (UUID)"
+// @Param        itemId path string true "Item ID (UUID)"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /units/{id}/inventory/{itemId} [delete]
+func (h *InventoryHandler) DeleteInventoryItem(w http.ResponseWriter, r *http.Request) {
+	unitID, ok := parseUUIDParam(w, r, "id", "Invalid unit ID")
+	if !ok {
+		return
+	}
+	itemID, ok := parseUUIDParam(w, r, "itemId", "Invalid inventory item ID")
+	if !ok {
+		return
+	}
+
+	if err := h.inventoryService.DeleteItem(r.Context(), unitID, itemID); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Inventory item deleted successfully", nil)
+}
+
+// GetLeaseInventory godoc
+// @Summary      Inventário do contrato
+// @Description  Retorna os checklists de entrada e saída do contrato com os itens faltantes ou danificados e o custo de reposição
+// @Tags         Inventory
+// @Produce      json
+// @Param        id path string true "Lease ID (UUID)"
+// @Success      200 {object} LeaseInventoryResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /leases/{id}/inventory [get]
+func (h *InventoryHandler) GetLeaseInventory(w http.ResponseWriter, r *http.Request) {
+	leaseID, ok := parseUUIDParam(w, r, "id", "Invalid lease ID")
+	if !ok {
+		return
+	}
+
+	report, err := h.inventoryService.GetLeaseInventoryReport(r.Context(), leaseID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Lease inventory retrieved successfully", ToLeaseInventoryResponse(report))
+}
+
+// CreateMoveInChecklist godoc
+// @Summary      Registrar checklist de entrada
+// @Description  Copia o inventário atual da unidade para o checklist de entrada do contrato (criado automaticamente na criação do contrato)
+// @Tags         Inventory
+// @Produce      json
+// @Param        id path string true "Lease ID (UUID)"
+// @Success      201 {object} InventoryChecklistResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /leases/{id}/inventory/move-in [post]
+func (h *InventoryHandler) CreateMoveInChecklist(w http.ResponseWriter, r *http.Request) {
+	leaseID, ok := parseUUIDParam(w, r, "id", "Invalid lease ID")
+	if !ok {
+		return
+	}
+
+	checklist, err := h.inventoryService.CreateMoveInChecklist(r.Context(), leaseID, currentUserID(r))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Move-in checklist created successfully", ToInventoryChecklistResponse(checklist))
+}
+
+// CreateMoveOutChecklist godoc
+// @Summary      Registrar checklist de saída
+// @Description  Registra a conferência de saída e compara com a entrada, listando itens faltantes ou danificados
+// @Tags         Inventory
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Lease ID (UUID)"
+// @Param        checklist body MoveOutChecklistRequest true "Conferência dos itens"
+// @Success      201 {object} LeaseInventoryResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /leases/{id}/inventory/move-out [post]
+func (h *InventoryHandler) CreateMoveOutChecklist(w http.ResponseWriter, r *http.Request) {
+	leaseID, ok := parseUUIDParam(w, r, "id", "Invalid lease ID")
+	if !ok {
+		return
+	}
+
+	var req MoveOutChecklistRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	checks := make([]domain.MoveOutItemCheck, len(req.Items))
+	for i, item := range req.Items {
+		checks[i] = domain.MoveOutItemCheck{
+			ItemID:           item.ItemID,
+			Present:          item.Present,
+			Condition:        domain.ItemCondition(item.Condition),
+			ReplacementValue: item.ReplacementValue,
+			Notes:            item.Notes,
+		}
+	}
+
+	report, err := h.inventoryService.CreateMoveOutChecklist(r.Context(), leaseID, service.MoveOutChecklistRequest{
+		Items:       checks,
+		Notes:       req.Notes,
+		PerformedBy: currentUserID(r),
+	})
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Move-out checklist created successfully", ToLeaseInventoryResponse(report))
+}
+
+// decodeItemRequest decodifica e valida o payload de um item do inventário
+func (h *InventoryHandler) decodeItemRequest(w http.ResponseWriter, r *http.Request) (service.InventoryItemRequest, bool) {
+	var req InventoryItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return service.InventoryItemRequest{}, false
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return service.InventoryItemRequest{}, false
+	}
+
+	return service.InventoryItemRequest{
+		Name:          req.Name,
+		Type:          domain.InventoryItemType(req.ItemType),
+		Brand:         req.Brand,
+		SerialNumber:  req.SerialNumber,
+		Condition:     domain.ItemCondition(req.Condition),
+		PurchaseDate:  req.PurchaseDate,
+		PurchaseValue: req.PurchaseValue,
+		Notes:         req.Notes,
+	}, true
+}
+
+// handleServiceError mapeia erros do service para respostas HTTP
+func (h *InventoryHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrInventoryItemNotFound),
+		errors.Is(err, service.ErrMoveInChecklistNotFound),
+		errors.Is(err, service.ErrUnitNotFound),
+		errors.Is(err, service.ErrLeaseNotFound):
+		response.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrChecklistAlreadyExists):
+		response.Error(w, http.StatusConflict, err.Error())
+	case errors.Is(err, domain.ErrInvalidInventoryItemName),
+		errors.Is(err, domain.ErrInvalidInventoryItemType),
+		errors.Is(err, domain.ErrInvalidItemCondition),
+		errors.Is(err, domain.ErrInvalidPurchaseValue),
+		errors.Is(err, domain.ErrInvalidReplacementValue),
+		errors.Is(err, domain.ErrChecklistItemNotInMoveIn):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -19,6 +19,9 @@ type CreateLeaseRequestDTO struct {
 	MonthlyRentValue        decimal.Decimal `json:"monthly_rent_value" validate:"required"`
 	PaintingFeeTotal        decimal.Decimal `json:"painting_fee_total" validate:"required"`
 	PaintingFeeInstallments int             `json:"painting_fee_installments" validate:"required,min=1,max=4"`
+	SecurityDeposit         decimal.Decimal `json:"security_deposit"` // Opcional: caução de até 3 aluguéis
+	DepositHeldAt           *string         `json:"deposit_held_at,omitempty" validate:"omitempty,oneof=savings_account bank_account cash other"`
+	DepositCorrectionIndex  *string         `json:"deposit_correction_index,omitempty" validate:"omitempty,oneof=savings ipca igpm none"`
 }
 
 // LeaseResponse representa a resposta de um contrato
@@ -37,6 +40,10 @@ type LeaseResponse struct {
 	Status                  string          `json:"status"`
 	ParentLeaseID           *uuid.UUID      `json:"parent_lease_id,omitempty"`
 	Generation              int             `json:"generation"`
+	SecurityDeposit         decimal.Decimal `json:"security_deposit"`
+	DepositReceivedDate     *time.Time      `json:"deposit_received_date,omitempty"`
+	DepositHeldAt           *string         `json:"deposit_held_at,omitempty"`
+	DepositCorrectionIndex  *string         `json:"deposit_correction_index,omitempty"`
 	TotalMonths             int             `json:"total_months"` // Total de meses desde contrato original
 	ShouldApplyAdjustment   bool            `json:"should_apply_adjustment"` // Indica se está na geração de reajuste
 	DaysUntilExpiry         int             `json:"days_until_expiry"`
@@ -47,6 +54,18 @@ type LeaseResponse struct {
 
 // ToLeaseResponse converte domain.Lease para LeaseResponse
 func ToLeaseResponse(lease *domain.Lease) *LeaseResponse {
+	var depositHeldAt *string
+	if lease.DepositHeldAt != nil {
+		heldAt := string(*lease.DepositHeldAt)
+		depositHeldAt = &heldAt
+	}
+
+	var depositCorrectionIndex *string
+	if lease.DepositCorrectionIndex != nil {
+		index := string(*lease.DepositCorrectionIndex)
+		depositCorrectionIndex = &index
+	}
+
 	return &LeaseResponse{
 		ID:                      lease.ID,
 		UnitID:                  lease.UnitID,
@@ -62,6 +81,10 @@ func ToLeaseResponse(lease *domain.Lease) *LeaseResponse {
 		Status:                  string(lease.Status),
 		ParentLeaseID:           lease.ParentLeaseID,
 		Generation:              lease.Generation,
+		SecurityDeposit:         lease.SecurityDeposit,
+		DepositReceivedDate:     lease.DepositReceivedDate,
+		DepositHeldAt:           depositHeldAt,
+		DepositCorrectionIndex:  depositCorrectionIndex,
 		TotalMonths:             lease.GetTotalMonths(),
 		ShouldApplyAdjustment:   lease.ShouldApplyAnnualAdjustment(),
 		DaysUntilExpiry:         lease.DaysUntilExpiry(),
@@ -82,15 +105,19 @@ func ToLeaseResponseList(leases []*domain.Lease) []*LeaseResponse {
 
 // CreateLeaseResponseDTO representa a resposta ao criar um contrato com pagamentos
 type CreateLeaseResponseDTO struct {
-	Lease    *LeaseResponse    `json:"lease"`
-	Payments []*PaymentResponse `json:"payments"`
+	Lease              *LeaseResponse              `json:"lease"`
+	Payments           []*PaymentResponse          `json:"payments"`
+	TenantPaymentScore *TenantPaymentScoreResponse `json:"tenant_payment_score,omitempty"`
+	Warnings           []string                    `json:"warnings,omitempty"`
 }
 
 // ToCreateLeaseResponse converte service.CreateLeaseResponse para CreateLeaseResponseDTO
 func ToCreateLeaseResponse(response *service.CreateLeaseResponse) *CreateLeaseResponseDTO {
 	return &CreateLeaseResponseDTO{
-		Lease:    ToLeaseResponse(response.Lease),
-		Payments: ToPaymentResponseList(response.Payments),
+		Lease:              ToLeaseResponse(response.Lease),
+		Payments:           ToPaymentResponseList(response.Payments),
+		TenantPaymentScore: ToTenantPaymentScoreResponse(response.TenantPaymentScore),
+		Warnings:           response.Warnings,
 	}
 }
 
@@ -65,6 +65,15 @@ func (h *LeaseHandler) CreateLease(w http.ResponseWriter, r *http.Request) {
 		MonthlyRentValue:        req.MonthlyRentValue,
 		PaintingFeeTotal:        req.PaintingFeeTotal,
 		PaintingFeeInstallments: req.PaintingFeeInstallments,
+		SecurityDeposit:         req.SecurityDeposit,
+	}
+	if req.DepositHeldAt != nil {
+		heldAt := domain.DepositCustody(*req.DepositHeldAt)
+		serviceReq.DepositHeldAt = &heldAt
+	}
+	if req.DepositCorrectionIndex != nil {
+		index := domain.DepositCorrectionIndex(*req.DepositCorrectionIndex)
+		serviceReq.DepositCorrectionIndex = &index
 	}
 
 	// Chamar service
@@ -110,13 +119,15 @@ func (h *LeaseHandler) GetLease(w http.ResponseWriter, r *http.Request) {
 
 // ListLeases godoc
 // @Summary      Listar contratos
-// @Description  Retorna lista de contratos com filtros opcionais
+// @Description  Retorna lista de contratos com filtro opcional por status, unidade ou morador; property_id pode ser combinado com os demais filtros
 // @Tags         Leases
 // @Produce      json
 // @Param        status query string false "Filter by status" Enums(active, expiring_soon, expired, cancelled)
 // @Param        unit_id query string false "Filter by unit ID (UUID)"
 // @Param        tenant_id query string false "Filter by tenant ID (UUID)"
+// @Param        property_id query string false "Filter by property ID (UUID)"
 // @Success      200 {array} LeaseResponse
+// @Failure      400 {object} response.ErrorResponse
 // @Failure      500 {object} response.ErrorResponse
 // @Security     BearerAuth
 // @Router       /leases [get]
@@ -127,6 +138,10 @@ func (h *LeaseHandler) ListLeases(w http.ResponseWriter, r *http.Request) {
 	statusFilter := r.URL.Query().Get("status")
 	unitIDFilter := r.URL.Query().Get("unit_id")
 	tenantIDFilter := r.URL.Query().Get("tenant_id")
+	propertyID, ok := parseOptionalPropertyID(w, r)
+	if !ok {
+		return
+	}
 
 	var leases []*domain.Lease
 	var err error
@@ -149,6 +164,8 @@ func (h *LeaseHandler) ListLeases(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		leases, err = h.leaseService.ListLeasesByTenantID(ctx, tenantID)
+	} else if propertyID != nil {
+		leases, err = h.leaseService.ListLeasesByPropertyID(ctx, *propertyID)
 	} else {
 		leases, err = h.leaseService.ListLeases(ctx)
 	}
@@ -158,6 +175,26 @@ func (h *LeaseHandler) ListLeases(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Restringir o resultado dos demais filtros aos contratos do imóvel
+	if propertyID != nil && (statusFilter != "" || unitIDFilter != "" || tenantIDFilter != "") {
+		propertyLeases, err := h.leaseService.ListLeasesByPropertyID(ctx, *propertyID)
+		if err != nil {
+			h.handleServiceError(w, err)
+			return
+		}
+		inProperty := make(map[uuid.UUID]bool, len(propertyLeases))
+		for _, lease := range propertyLeases {
+			inProperty[lease.ID] = true
+		}
+		scoped := make([]*domain.Lease, 0, len(leases))
+		for _, lease := range leases {
+			if inProperty[lease.ID] {
+				scoped = append(scoped, lease)
+			}
+		}
+		leases = scoped
+	}
+
 	response.Success(w, http.StatusOK, "Leases retrieved successfully", ToLeaseResponseList(leases))
 }
 
@@ -392,7 +429,11 @@ func (h *LeaseHandler) handleServiceError(w http.ResponseWriter, err error) {
 		errors.Is(err, domain.ErrInvalidPaintingFeeInstallments),
 		errors.Is(err, domain.ErrInvalidMonthlyRentValue),
 		errors.Is(err, domain.ErrInvalidDates),
-		errors.Is(err, domain.ErrPaintingFeePaidExceedsTotal):
+		errors.Is(err, domain.ErrPaintingFeePaidExceedsTotal),
+		errors.Is(err, domain.ErrInvalidSecurityDeposit),
+		errors.Is(err, domain.ErrSecurityDepositExceedsLimit),
+		errors.Is(err, domain.ErrInvalidDepositCustody),
+		errors.Is(err, domain.ErrInvalidDepositCorrectionIndex):
 		response.Error(w, http.StatusBadRequest, err.Error())
 	default:
 		response.Error(w, http.StatusInternalServerError, "Internal server error")
@@ -410,6 +451,7 @@ type PaymentResponse struct {
 	DueDate        string  `json:"due_date"`
 	PaymentDate    *string `json:"payment_date,omitempty"`
 	PaymentMethod  *string `json:"payment_method,omitempty"`
+	Notes          *string `json:"notes,omitempty"`
 	CreatedAt      string  `json:"created_at"`
 	UpdatedAt      string  `json:"updated_at"`
 }
@@ -444,6 +486,7 @@ func ToPaymentResponse(p *domain.Payment) *PaymentResponse {
 		DueDate:        p.DueDate.Format("2006-01-02"),
 		PaymentDate:    paymentDate,
 		PaymentMethod:  paymentMethod,
+		Notes:          p.Notes,
 		CreatedAt:      p.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:      p.UpdatedAt.Format(time.RFC3339),
 	}
@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// CreateMaintenanceTicketRequest representa o payload para abrir um chamado
+type CreateMaintenanceTicketRequest struct {
+	UnitID             uuid.UUID `json:"unit_id" validate:"required"`
+	Title              string    `json:"title" validate:"required,min=3,max=200"`
+	Description        string    `json:"description" validate:"max=2000"`
+	Category           string    `json:"category" validate:"required,oneof=plumbing electrical appliance structural painting pest_control other"`
+	Priority           string    `json:"priority" validate:"required,oneof=low medium high urgent"`
+	RequiresVacancy    bool      `json:"requires_vacancy"`
+	LinkToCurrentLease bool      `json:"link_to_current_lease"`
+}
+
+// UpdateMaintenanceTicketRequest representa o payload para atualizar um chamado
+type UpdateMaintenanceTicketRequest struct {
+	Title       string `json:"title" validate:"required,min=3,max=200"`
+	Description string `json:"description" validate:"max=2000"`
+	Category    string `json:"category" validate:"required,oneof=plumbing electrical appliance structural painting pest_control other"`
+	Priority    string `json:"priority" validate:"required,oneof=low medium high urgent"`
+}
+
+// AssignContractorRequest representa o payload para atribuir um prestador
+type AssignContractorRequest struct {
+	Contractor      string           `json:"contractor" validate:"required,min=2,max=255"`
+	ContractorPhone *string          `json:"contractor_phone,omitempty" validate:"omitempty,max=20"`
+	EstimatedCost   *decimal.Decimal `json:"estimated_cost,omitempty"`
+}
+
+// ResolveMaintenanceTicketRequest representa o payload para resolver um chamado
+type ResolveMaintenanceTicketRequest struct {
+	ActualCost      *decimal.Decimal `json:"actual_cost,omitempty"`
+	ResolutionNotes *string          `json:"resolution_notes,omitempty" validate:"omitempty,max=2000"`
+}
+
+// CancelMaintenanceTicketRequest representa o payload para cancelar um chamado
+type CancelMaintenanceTicketRequest struct {
+	Reason *string `json:"reason,omitempty" validate:"omitempty,max=2000"`
+}
+
+// AddMaintenancePhotoRequest representa o payload para anexar uma foto
+type AddMaintenancePhotoRequest struct {
+	URL     string  `json:"url" validate:"required,url"`
+	Caption *string `json:"caption,omitempty" validate:"omitempty,max=500"`
+}
+
+// MaintenanceTicketResponse representa a resposta com dados de um chamado
+type MaintenanceTicketResponse struct {
+	ID                 uuid.UUID                   `json:"id"`
+	UnitID             uuid.UUID                   `json:"unit_id"`
+	LeaseID            *uuid.UUID                  `json:"lease_id,omitempty"`
+	TenantID           *uuid.UUID                  `json:"tenant_id,omitempty"`
+	Title              string                      `json:"title"`
+	Description        string                      `json:"description"`
+	Category           string                      `json:"category"`
+	Priority           string                      `json:"priority"`
+	Status             string                      `json:"status"`
+	RequiresVacancy    bool                        `json:"requires_vacancy"`
+	PreviousUnitStatus *string                     `json:"previous_unit_status,omitempty"`
+	AssignedContractor *string                     `json:"assigned_contractor,omitempty"`
+	ContractorPhone    *string                     `json:"contractor_phone,omitempty"`
+	EstimatedCost      *decimal.Decimal            `json:"estimated_cost,omitempty"`
+	ActualCost         *decimal.Decimal            `json:"actual_cost,omitempty"`
+	ResolutionNotes    *string                     `json:"resolution_notes,omitempty"`
+	OpenedBy           *uuid.UUID                  `json:"opened_by,omitempty"`
+	OpenedAt           time.Time                   `json:"opened_at"`
+	StartedAt          *time.Time                  `json:"started_at,omitempty"`
+	ResolvedAt         *time.Time                  `json:"resolved_at,omitempty"`
+	ClosedAt           *time.Time                  `json:"closed_at,omitempty"`
+	Photos             []*MaintenancePhotoResponse `json:"photos,omitempty"`
+	CreatedAt          time.Time                   `json:"created_at"`
+	UpdatedAt          time.Time                   `json:"updated_at"`
+}
+
+// MaintenancePhotoResponse representa uma foto de chamado na resposta
+type MaintenancePhotoResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	URL        string     `json:"url"`
+	Caption    *string    `json:"caption,omitempty"`
+	UploadedBy *uuid.UUID `json:"uploaded_by,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// ToMaintenanceTicketResponse converte domain.MaintenanceTicket para MaintenanceTicketResponse
+func ToMaintenanceTicketResponse(ticket *domain.MaintenanceTicket) *MaintenanceTicketResponse {
+	resp := &MaintenanceTicketResponse{
+		ID:                 ticket.ID,
+		UnitID:             ticket.UnitID,
+		LeaseID:            ticket.LeaseID,
+		TenantID:           ticket.TenantID,
+		Title:              ticket.Title,
+		Description:        ticket.Description,
+		Category:           string(ticket.Category),
+		Priority:           string(ticket.Priority),
+		Status:             string(ticket.Status),
+		RequiresVacancy:    ticket.RequiresVacancy,
+		AssignedContractor: ticket.AssignedContractor,
+		ContractorPhone:    ticket.ContractorPhone,
+		EstimatedCost:      ticket.EstimatedCost,
+		ActualCost:         ticket.ActualCost,
+		ResolutionNotes:    ticket.ResolutionNotes,
+		OpenedBy:           ticket.OpenedBy,
+		OpenedAt:           ticket.OpenedAt,
+		StartedAt:          ticket.StartedAt,
+		ResolvedAt:         ticket.ResolvedAt,
+		ClosedAt:           ticket.ClosedAt,
+		CreatedAt:          ticket.CreatedAt,
+		UpdatedAt:          ticket.UpdatedAt,
+	}
+
+	if ticket.PreviousUnitStatus != nil {
+		status := string(*ticket.PreviousUnitStatus)
+		resp.PreviousUnitStatus = &status
+	}
+
+	return resp
+}
+
+// ToMaintenanceTicketResponseList converte slice de chamados para slice de responses
+func ToMaintenanceTicketResponseList(tickets []*domain.MaintenanceTicket) []*MaintenanceTicketResponse {
+	responses := make([]*MaintenanceTicketResponse, len(tickets))
+	for i, ticket := range tickets {
+		responses[i] = ToMaintenanceTicketResponse(ticket)
+	}
+	return responses
+}
+
+// ToMaintenancePhotoResponse converte domain.MaintenanceTicketPhoto para MaintenancePhotoResponse
+func ToMaintenancePhotoResponse(photo *domain.MaintenanceTicketPhoto) *MaintenancePhotoResponse {
+	return &MaintenancePhotoResponse{
+		ID:         photo.ID,
+		URL:        photo.URL,
+		Caption:    photo.Caption,
+		UploadedBy: photo.UploadedBy,
+		CreatedAt:  photo.CreatedAt,
+	}
+}
+
+// ToMaintenancePhotoResponseList converte slice de fotos para slice de responses
+func ToMaintenancePhotoResponseList(photos []*domain.MaintenanceTicketPhoto) []*MaintenancePhotoResponse {
+	responses := make([]*MaintenancePhotoResponse, len(photos))
+	for i, photo := range photos {
+		responses[i] = ToMaintenancePhotoResponse(photo)
+	}
+	return responses
+}
@@ -0,0 +1,458 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// MaintenanceHandler lida com requisições HTTP relacionadas a chamados de manutenção
+type MaintenanceHandler struct {
+	maintenanceService *service.MaintenanceService
+	validator          *validator.Validate
+}
+
+// NewMaintenanceHandler cria uma nova instância do handler
+func NewMaintenanceHandler(maintenanceService *service.MaintenanceService) *MaintenanceHandler {
+	return &MaintenanceHandler{
+		maintenanceService: maintenanceService,
+		validator:          validator.New(),
+	}
+}
+
+// CreateTicket godoc
+// @Summary      Abrir chamado de manutenção
+// @Description  Abre um chamado para uma unidade. Se requires_vacancy=true a unidade passa para status maintenance
+// @Tags         Maintenance
+// @Accept       json
+// @Produce      json
+// @Param        ticket body CreateMaintenanceTicketRequest true "Dados do chamado"
+// @Success      201 {object} MaintenanceTicketResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /maintenance [post]
+func (h *MaintenanceHandler) CreateTicket(w http.ResponseWriter, r *http.Request) {
+	var req CreateMaintenanceTicketRequest
+
+	// Decodificar JSON
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validar request
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ticket, err := h.maintenanceService.CreateTicket(r.Context(), service.CreateMaintenanceTicketRequest{
+		UnitID:             req.UnitID,
+		Title:              req.Title,
+		Description:        req.Description,
+		Category:           domain.MaintenanceCategory(req.Category),
+		Priority:           domain.MaintenancePriority(req.Priority),
+		RequiresVacancy:    req.RequiresVacancy,
+		LinkToCurrentLease: req.LinkToCurrentLease,
+		OpenedBy:           currentUserID(r),
+	})
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Maintenance ticket created successfully", ToMaintenanceTicketResponse(ticket))
+}
+
+// GetTicket godoc
+// @Summary      Buscar chamado por ID
+// @Description  Retorna os dados de um chamado com suas fotos
+// @Tags         Maintenance
+// @Produce      json
+// @Param        id path string true "Ticket ID (UUID)"
+// @Success      200 {object} MaintenanceTicketResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /maintenance/{id} [get]
+func (h *MaintenanceHandler) GetTicket(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseTicketID(w, r)
+	if !ok {
+		return
+	}
+
+	ticket, err := h.maintenanceService.GetTicketByID(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	photos, err := h.maintenanceService.ListPhotos(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	resp := ToMaintenanceTicketResponse(ticket)
+	resp.Photos = ToMaintenancePhotoResponseList(photos)
+
+	response.Success(w, http.StatusOK, "Maintenance ticket retrieved successfully", resp)
+}
+
+// ListTickets godoc
+// @Summary      Listar chamados
+// @Description  Retorna lista de chamados com filtros opcionais
+// @Tags         Maintenance
+// @Produce      json
+// @Param        status query string false "Filter by status" Enums(open, in_progress, resolved, closed, cancelled)
+// @Param        unit_id query string false "Filter by unit ID (UUID)"
+// @Param        property_id query string false "Filter by property ID (UUID)"
+// @Success      200 {array} MaintenanceTicketResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      500 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /maintenance [get]
+func (h *MaintenanceHandler) ListTickets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	statusFilter := r.URL.Query().Get("status")
+	unitIDFilter := r.URL.Query().Get("unit_id")
+	propertyID, ok := parseOptionalPropertyID(w, r)
+	if !ok {
+		return
+	}
+
+	var tickets []*domain.MaintenanceTicket
+	var err error
+
+	if statusFilter != "" {
+		tickets, err = h.maintenanceService.ListTicketsByStatus(ctx, domain.MaintenanceStatus(statusFilter))
+	} else if unitIDFilter != "" {
+		unitID, parseErr := uuid.Parse(unitIDFilter)
+		if parseErr != nil {
+			response.Error(w, http.StatusBadRequest, "Invalid unit ID")
+			return
+		}
+		tickets, err = h.maintenanceService.ListTicketsByUnit(ctx, unitID)
+	} else if propertyID != nil {
+		tickets, err = h.maintenanceService.ListTicketsByProperty(ctx, *propertyID)
+	} else {
+		tickets, err = h.maintenanceService.ListTickets(ctx)
+	}
+
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	// Restringir o resultado dos demais filtros aos chamados do imóvel
+	if propertyID != nil && (statusFilter != "" || unitIDFilter != "") {
+		propertyTickets, err := h.maintenanceService.ListTicketsByProperty(ctx, *propertyID)
+		if err != nil {
+			h.handleServiceError(w, err)
+			return
+		}
+		inProperty := make(map[uuid.UUID]bool, len(propertyTickets))
+		for _, ticket := range propertyTickets {
+			inProperty[ticket.ID] = true
+		}
+		scoped := make([]*domain.MaintenanceTicket, 0, len(tickets))
+		for _, ticket := range tickets {
+			if inProperty[ticket.ID] {
+				scoped = append(scoped, ticket)
+			}
+		}
+		tickets = scoped
+	}
+
+	response.Success(w, http.StatusOK, "Maintenance tickets retrieved successfully", ToMaintenanceTicketResponseList(tickets))
+}
+
+// UpdateTicket godoc
+// @Summary      Atualizar chamado
+// @Description  Atualiza título, descrição, categoria e prioridade de um chamado
+// @Tags         Maintenance
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Ticket ID (UUID)"
+// @Param        ticket body UpdateMaintenanceTicketRequest true "Dados do chamado"
+// @Success      200 {object} MaintenanceTicketResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /maintenance/{id} [put]
+func (h *MaintenanceHandler) UpdateTicket(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseTicketID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateMaintenanceTicketRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ticket, err := h.maintenanceService.UpdateTicketDetails(r.Context(), id, req.Title, req.Description,
+		domain.MaintenanceCategory(req.Category), domain.MaintenancePriority(req.Priority))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Maintenance ticket updated successfully", ToMaintenanceTicketResponse(ticket))
+}
+
+// AssignContractor godoc
+// @Summary      Atribuir prestador
+// @Description  Atribui um prestador de serviço e o custo estimado ao chamado
+// @Tags         Maintenance
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Ticket ID (UUID)"
+// @Param        contractor body AssignContractorRequest true "Dados do prestador"
+// @Success      200 {object} MaintenanceTicketResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /maintenance/{id}/assign [post]
+func (h *MaintenanceHandler) AssignContractor(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseTicketID(w, r)
+	if !ok {
+		return
+	}
+
+	var req AssignContractorRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ticket, err := h.maintenanceService.AssignContractor(r.Context(), id, req.Contractor, req.ContractorPhone, req.EstimatedCost)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Contractor assigned successfully", ToMaintenanceTicketResponse(ticket))
+}
+
+// StartTicket godoc
+// @Summary      Iniciar atendimento
+// @Description  Marca o chamado como em andamento
+// @Tags         Maintenance
+// @Produce      json
+// @Param        id path string true "Ticket ID (UUID)"
+// @Success      200 {object} MaintenanceTicketResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /maintenance/{id}/start [post]
+func (h *MaintenanceHandler) StartTicket(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseTicketID(w, r)
+	if !ok {
+		return
+	}
+
+	ticket, err := h.maintenanceService.StartTicket(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Maintenance ticket started successfully", ToMaintenanceTicketResponse(ticket))
+}
+
+// ResolveTicket godoc
+// @Summary      Resolver chamado
+// @Description  Marca o chamado como resolvido, registra o custo real e libera a unidade se necessário
+// @Tags         Maintenance
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Ticket ID (UUID)"
+// @Param        resolution body ResolveMaintenanceTicketRequest true "Dados da resolução"
+// @Success      200 {object} MaintenanceTicketResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /maintenance/{id}/resolve [post]
+func (h *MaintenanceHandler) ResolveTicket(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseTicketID(w, r)
+	if !ok {
+		return
+	}
+
+	var req ResolveMaintenanceTicketRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ticket, err := h.maintenanceService.ResolveTicket(r.Context(), id, req.ActualCost, req.ResolutionNotes)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Maintenance ticket resolved successfully", ToMaintenanceTicketResponse(ticket))
+}
+
+// CloseTicket godoc
+// @Summary      Encerrar chamado
+// @Description  Encerra definitivamente um chamado resolvido
+// @Tags         Maintenance
+// @Produce      json
+// @Param        id path string true "Ticket ID (UUID)"
+// @Success      200 {object} MaintenanceTicketResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /maintenance/{id}/close [post]
+func (h *MaintenanceHandler) CloseTicket(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseTicketID(w, r)
+	if !ok {
+		return
+	}
+
+	ticket, err := h.maintenanceService.CloseTicket(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Maintenance ticket closed successfully", ToMaintenanceTicketResponse(ticket))
+}
+
+// CancelTicket godoc
+// @Summary      Cancelar chamado
+// @Description  Cancela um chamado aberto ou em andamento e libera a unidade se necessário
+// @Tags         Maintenance
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Ticket ID (UUID)"
+// @Param        request body CancelMaintenanceTicketRequest false "Motivo do cancelamento"
+// @Success      200 {object} MaintenanceTicketResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /maintenance/{id}/cancel [post]
+func (h *MaintenanceHandler) CancelTicket(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseTicketID(w, r)
+	if !ok {
+		return
+	}
+
+	// Body opcional
+	var req CancelMaintenanceTicketRequest
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			response.Error(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+		if err := h.validator.Struct(req); err != nil {
+			response.Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	ticket, err := h.maintenanceService.CancelTicket(r.Context(), id, req.Reason)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Maintenance ticket cancelled successfully", ToMaintenanceTicketResponse(ticket))
+}
+
+// AddPhoto godoc
+// @Summary      Anexar foto
+// @Description  Anexa a URL de uma foto ao chamado
+// @Tags         Maintenance
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Ticket ID (UUID)"
+// @Param        photo body AddMaintenancePhotoRequest true "Dados da foto"
+// @Success      201 {object} MaintenancePhotoResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /maintenance/{id}/photos [post]
+func (h *MaintenanceHandler) AddPhoto(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseTicketID(w, r)
+	if !ok {
+		return
+	}
+
+	var req AddMaintenancePhotoRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	photo, err := h.maintenanceService.AddPhoto(r.Context(), id, req.URL, req.Caption, currentUserID(r))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Photo added successfully", ToMaintenancePhotoResponse(photo))
+}
+
+// parseTicketID extrai e valida o ID do chamado da URL
+func (h *MaintenanceHandler) parseTicketID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid maintenance ticket ID")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// handleServiceError mapeia erros do service para respostas HTTP
+func (h *MaintenanceHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrMaintenanceTicketNotFound),
+		errors.Is(err, service.ErrUnitNotFound):
+		response.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidMaintenanceTitle),
+		errors.Is(err, domain.ErrInvalidMaintenanceCategory),
+		errors.Is(err, domain.ErrInvalidMaintenancePriority),
+		errors.Is(err, domain.ErrInvalidMaintenanceStatus),
+		errors.Is(err, domain.ErrInvalidMaintenanceTransition),
+		errors.Is(err, domain.ErrInvalidMaintenanceCost),
+		errors.Is(err, domain.ErrMaintenanceTicketFinished),
+		errors.Is(err, domain.ErrInvalidPhotoURL):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
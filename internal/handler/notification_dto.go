@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+)
+
+// NotificationResponse representa a resposta com dados de uma notificação
+type NotificationResponse struct {
+	ID            uuid.UUID  `json:"id"`
+	LeaseID       *uuid.UUID `json:"lease_id,omitempty"`
+	TenantID      *uuid.UUID `json:"tenant_id,omitempty"`
+	PaymentID     *uuid.UUID `json:"payment_id,omitempty"`
+	Type          string     `json:"type"`
+	Channel       string     `json:"channel"`
+	Recipient     string     `json:"recipient"`
+	Subject       string     `json:"subject"`
+	Message       string     `json:"message"`
+	ScheduledDate string     `json:"scheduled_date"` // YYYY-MM-DD
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	LastError     *string    `json:"last_error,omitempty"`
+	SentDate      *time.Time `json:"sent_date,omitempty"`
+	// Retorno do provedor
+	ProviderMessageID *string    `json:"provider_message_id,omitempty"`
+	DeliveredAt       *time.Time `json:"delivered_at,omitempty"`
+	BouncedAt         *time.Time `json:"bounced_at,omitempty"`
+	BounceReason      *string    `json:"bounce_reason,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// RecordBounceRequest representa a devolução de uma mensagem informada pelo provedor
+type RecordBounceRequest struct {
+	MessageID string `json:"message_id" validate:"required,max=255"`
+	Reason    string `json:"reason" validate:"required,max=2000"`
+}
+
+// DeliveryStatusRequest representa o status de entrega informado pelo provedor de WhatsApp/SMS
+type DeliveryStatusRequest struct {
+	MessageID string `json:"message_id" validate:"required,max=255"`
+	Status    string `json:"status" validate:"required,oneof=queued sent delivered read failed undelivered"`
+	Error     string `json:"error" validate:"max=2000"`
+}
+
+// ToNotificationResponse converte domain.Notification para NotificationResponse
+func ToNotificationResponse(notification *domain.Notification) *NotificationResponse {
+	return &NotificationResponse{
+		ID:                notification.ID,
+		LeaseID:           notification.LeaseID,
+		TenantID:          notification.TenantID,
+		PaymentID:         notification.PaymentID,
+		Type:              string(notification.Type),
+		Channel:           string(notification.Channel),
+		Recipient:         notification.Recipient,
+		Subject:           notification.Subject,
+		Message:           notification.Message,
+		ScheduledDate:     notification.ScheduledDate.Format("2006-01-02"),
+		Status:            string(notification.Status),
+		Attempts:          notification.Attempts,
+		NextAttemptAt:     notification.NextAttemptAt,
+		LastError:         notification.LastError,
+		SentDate:          notification.SentDate,
+		ProviderMessageID: notification.ProviderMessageID,
+		DeliveredAt:       notification.DeliveredAt,
+		BouncedAt:         notification.BouncedAt,
+		BounceReason:      notification.BounceReason,
+		CreatedAt:         notification.CreatedAt,
+		UpdatedAt:         notification.UpdatedAt,
+	}
+}
+
+// ToNotificationResponseList converte slice de notificações para slice de responses
+func ToNotificationResponseList(notifications []*domain.Notification) []*NotificationResponse {
+	responses := make([]*NotificationResponse, len(notifications))
+	for i, notification := range notifications {
+		responses[i] = ToNotificationResponse(notification)
+	}
+	return responses
+}
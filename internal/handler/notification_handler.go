@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/notifier"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// NotificationHandler lida com requisições HTTP da fila de notificações
+type NotificationHandler struct {
+	notificationService *service.NotificationService
+	validator           *validator.Validate
+}
+
+// NewNotificationHandler cria uma nova instância do handler
+func NewNotificationHandler(notificationService *service.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+		validator:           validator.New(),
+	}
+}
+
+// ListNotifications godoc
+// @Summary      Listar notificações
+// @Description  Retorna a fila de notificações (lembretes, avisos de atraso e de fim de contrato, recibos), opcionalmente filtrada por status
+// @Tags         Notifications
+// @Produce      json
+// @Param        status query string false "Filtrar por status (pending, sent, delivered, failed, cancelled, bounced)"
+// @Success      200 {array} NotificationResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /notifications [get]
+func (h *NotificationHandler) ListNotifications(w http.ResponseWriter, r *http.Request) {
+	var status *domain.NotificationStatus
+	if statusFilter := r.URL.Query().Get("status"); statusFilter != "" {
+		s := domain.NotificationStatus(statusFilter)
+		if !domain.IsValidNotificationStatus(s) {
+			response.Error(w, http.StatusBadRequest, domain.ErrInvalidNotificationStatus.Error())
+			return
+		}
+		status = &s
+	}
+
+	notifications, err := h.notificationService.ListNotifications(r.Context(), status)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Notifications retrieved successfully", ToNotificationResponseList(notifications))
+}
+
+// ListLeaseNotifications godoc
+// @Summary      Listar notificações do contrato
+// @Description  Retorna as notificações geradas para um contrato
+// @Tags         Notifications
+// @Produce      json
+// @Param        id path string true "Lease ID (UUID)"
+// @Success      200 {array} NotificationResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /leases/{id}/notifications [get]
+func (h *NotificationHandler) ListLeaseNotifications(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid lease ID")
+	if !ok {
+		return
+	}
+
+	notifications, err := h.notificationService.ListLeaseNotifications(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Lease notifications retrieved successfully", ToNotificationResponseList(notifications))
+}
+
+// ProcessNotifications godoc
+// @Summary      Processar notificações
+// @Description  Gera os recibos de pagamentos recentes e os avisos de fim de contrato (45 dias antes) e envia a fila pendente, com novas tentativas para falhas anteriores. Lembretes e avisos de atraso são gerados pela régua de cobrança (POST /dunning/run)
+// @Tags         Notifications
+// @Produce      json
+// @Success      200 {object} service.NotificationProcessResult
+// @Failure      500 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /notifications/process [post]
+func (h *NotificationHandler) ProcessNotifications(w http.ResponseWriter, r *http.Request) {
+	result, err := h.notificationService.ProcessDailyNotifications(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Notifications processed successfully", result)
+}
+
+// SendPaymentReceipt godoc
+// @Summary      Enviar recibo de pagamento
+// @Description  Gera o recibo de um pagamento quitado e o envia imediatamente pelos canais habilitados (por e-mail segue com o PDF anexo). Recibos já enviados não são duplicados
+// @Tags         Notifications
+// @Produce      json
+// @Param        id path string true "Payment ID (UUID)"
+// @Success      200 {array} NotificationResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      422 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /payments/{id}/receipt/send [post]
+func (h *NotificationHandler) SendPaymentReceipt(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid payment ID")
+	if !ok {
+		return
+	}
+
+	notifications, err := h.notificationService.SendPaymentReceipt(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Payment receipt sent successfully", ToNotificationResponseList(notifications))
+}
+
+// RecordBounce godoc
+// @Summary      Registrar devolução de mensagem
+// @Description  Registra a devolução (bounce) informada pelo provedor de e-mail a partir do Message-ID retornado no envio
+// @Tags         Notifications
+// @Accept       json
+// @Produce      json
+// @Param        request body RecordBounceRequest true "Dados da devolução"
+// @Success      200 {object} NotificationResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /notifications/bounces [post]
+func (h *NotificationHandler) RecordBounce(w http.ResponseWriter, r *http.Request) {
+	var req RecordBounceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	notification, err := h.notificationService.RecordBounce(r.Context(), req.MessageID, req.Reason)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Bounce recorded successfully", ToNotificationResponse(notification))
+}
+
+// RecordDeliveryStatus godoc
+// @Summary      Webhook de status de entrega
+// @Description  Recebe do provedor de WhatsApp/SMS o status de entrega de uma mensagem. Autenticado pelo header X-Webhook-Token
+// @Tags         Notifications
+// @Accept       json
+// @Produce      json
+// @Param        X-Webhook-Token header string true "Token do webhook"
+// @Param        request body DeliveryStatusRequest true "Status de entrega"
+// @Success      200 {object} NotificationResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      401 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Router       /webhooks/messaging/status [post]
+func (h *NotificationHandler) RecordDeliveryStatus(w http.ResponseWriter, r *http.Request) {
+	var req DeliveryStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	notification, err := h.notificationService.RecordDeliveryStatus(
+		r.Context(),
+		r.Header.Get("X-Webhook-Token"),
+		req.MessageID,
+		notifier.DeliveryStatus(req.Status),
+		req.Error,
+	)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Delivery status recorded successfully", ToNotificationResponse(notification))
+}
+
+// handleServiceError mapeia erros do service para respostas HTTP
+func (h *NotificationHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrLeaseNotFound),
+		errors.Is(err, service.ErrLeaseNotFoundForPayment),
+		errors.Is(err, service.ErrPaymentNotFound),
+		errors.Is(err, service.ErrNotificationNotFound):
+		response.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrPaymentNotPaidForReceipt),
+		errors.Is(err, service.ErrNoNotificationRecipient):
+		response.Error(w, http.StatusUnprocessableEntity, err.Error())
+	case errors.Is(err, service.ErrInvalidWebhookToken):
+		response.Error(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, domain.ErrNotificationNotBounceable),
+		errors.Is(err, domain.ErrNotificationNotSent):
+		response.Error(w, http.StatusConflict, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
 )
@@ -123,12 +124,25 @@ func (h *PaymentHandler) GetCancellablePayments(w http.ResponseWriter, r *http.R
 // @Description  Retorna todos os pagamentos com status overdue
 // @Tags         Payments
 // @Produce      json
+// @Param        property_id query string false "Filtrar por imóvel (UUID)"
 // @Success      200 {array} PaymentResponse
+// @Failure      400 {object} response.ErrorResponse
 // @Failure      500 {object} response.ErrorResponse
 // @Security     BearerAuth
 // @Router       /payments/overdue [get]
 func (h *PaymentHandler) GetOverduePayments(w http.ResponseWriter, r *http.Request) {
-	payments, err := h.paymentService.GetOverduePayments(r.Context())
+	propertyID, ok := parseOptionalPropertyID(w, r)
+	if !ok {
+		return
+	}
+
+	var payments []*domain.Payment
+	var err error
+	if propertyID != nil {
+		payments, err = h.paymentService.GetOverduePaymentsByProperty(r.Context(), *propertyID)
+	} else {
+		payments, err = h.paymentService.GetOverduePayments(r.Context())
+	}
 	if err != nil {
 		response.Error(w, http.StatusInternalServerError, err.Error())
 		return
@@ -143,6 +157,7 @@ func (h *PaymentHandler) GetOverduePayments(w http.ResponseWriter, r *http.Reque
 // @Tags         Payments
 // @Produce      json
 // @Param        days query int false "Número de dias à frente (padrão: 7)"
+// @Param        property_id query string false "Filtrar por imóvel (UUID)"
 // @Success      200 {array} PaymentResponse
 // @Failure      500 {object} response.ErrorResponse
 // @Security     BearerAuth
@@ -161,7 +176,18 @@ func (h *PaymentHandler) GetUpcomingPayments(w http.ResponseWriter, r *http.Requ
 		days = parsedDays
 	}
 
-	payments, err := h.paymentService.GetUpcomingPayments(r.Context(), days)
+	propertyID, ok := parseOptionalPropertyID(w, r)
+	if !ok {
+		return
+	}
+
+	var payments []*domain.Payment
+	var err error
+	if propertyID != nil {
+		payments, err = h.paymentService.GetUpcomingPaymentsByProperty(r.Context(), *propertyID, days)
+	} else {
+		payments, err = h.paymentService.GetUpcomingPayments(r.Context(), days)
+	}
 	if err != nil {
 		response.Error(w, http.StatusInternalServerError, err.Error())
 		return
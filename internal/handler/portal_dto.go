@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// PortalLoginRequest representa o payload de login do morador
+// Informe a senha ou o código de uso único recebido da administração
+type PortalLoginRequest struct {
+	CPF      string `json:"cpf" validate:"required,min=11,max=18"` // CPF ou CNPJ, com ou sem formatação
+	Password string `json:"password,omitempty" validate:"omitempty,min=6"`
+	Code     string `json:"code,omitempty" validate:"omitempty,len=6,numeric"`
+}
+
+// EnablePortalAccessRequest representa o payload para liberar o portal a um morador
+type EnablePortalAccessRequest struct {
+	Password string `json:"password,omitempty" validate:"omitempty,min=6"` // Opcional: sem senha, acesso apenas por código
+}
+
+// PortalLoginCodeResponse representa um código de acesso gerado para o morador
+type PortalLoginCodeResponse struct {
+	Code      string `json:"code"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// PaymentReceiptResponse representa o recibo de um pagamento quitado
+type PaymentReceiptResponse struct {
+	Payment        *PaymentResponse `json:"payment"`
+	TenantName     string           `json:"tenant_name"`
+	TenantDocument string           `json:"tenant_document"`
+	UnitNumber     string           `json:"unit_number"`
+	PropertyName   string           `json:"property_name,omitempty"`
+	IssuedAt       string           `json:"issued_at"`
+}
+
+// PaymentPixResponse representa o código PIX "copia e cola" de um pagamento
+type PaymentPixResponse struct {
+	PaymentID string  `json:"payment_id"`
+	Amount    float64 `json:"amount"`
+	DueDate   string  `json:"due_date"`
+	PixKey    string  `json:"pix_key"`
+	Code      string  `json:"code"`
+}
+
+// ToPortalLoginCodeResponse converte o código gerado para PortalLoginCodeResponse
+func ToPortalLoginCodeResponse(code string, loginCode *domain.TenantLoginCode) *PortalLoginCodeResponse {
+	return &PortalLoginCodeResponse{
+		Code:      code,
+		ExpiresAt: loginCode.ExpiresAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// ToPaymentReceiptResponse converte service.PaymentReceipt para PaymentReceiptResponse
+func ToPaymentReceiptResponse(receipt *service.PaymentReceipt) *PaymentReceiptResponse {
+	return &PaymentReceiptResponse{
+		Payment:        ToPaymentResponse(receipt.Payment),
+		TenantName:     receipt.TenantName,
+		TenantDocument: receipt.TenantDocument,
+		UnitNumber:     receipt.UnitNumber,
+		PropertyName:   receipt.PropertyName,
+		IssuedAt:       receipt.IssuedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// ToPaymentPixResponse converte service.PaymentPix para PaymentPixResponse
+func ToPaymentPixResponse(pix *service.PaymentPix) *PaymentPixResponse {
+	amount, _ := pix.Amount.Float64()
+	return &PaymentPixResponse{
+		PaymentID: pix.PaymentID.String(),
+		Amount:    amount,
+		DueDate:   pix.DueDate.Format("2006-01-02"),
+		PixKey:    pix.PixKey,
+		Code:      pix.Code,
+	}
+}
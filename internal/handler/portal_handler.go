@@ -0,0 +1,329 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/middleware"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// PortalHandler lida com requisições HTTP do portal do morador
+type PortalHandler struct {
+	portalAuthService *service.PortalAuthService
+	portalService     *service.PortalService
+	validator         *validator.Validate
+}
+
+// NewPortalHandler cria uma nova instância do handler
+func NewPortalHandler(portalAuthService *service.PortalAuthService, portalService *service.PortalService) *PortalHandler {
+	return &PortalHandler{
+		portalAuthService: portalAuthService,
+		portalService:     portalService,
+		validator:         validator.New(),
+	}
+}
+
+// Login godoc
+// @Summary      Login do morador
+// @Description  Autentica o morador pelo CPF/CNPJ com senha ou código de uso único e retorna um token JWT do portal
+// @Tags         Portal
+// @Accept       json
+// @Produce      json
+// @Param        credentials body PortalLoginRequest true "Credenciais do morador"
+// @Success      200 {object} LoginResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      401 {object} response.ErrorResponse
+// @Failure      500 {object} response.ErrorResponse
+// @Router       /portal/auth/login [post]
+func (h *PortalHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var req PortalLoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	token, user, err := h.portalAuthService.Login(r.Context(), req.CPF, req.Password, req.Code)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Login successful", &LoginResponse{
+		Token: token,
+		User:  ToUserResponse(user),
+	})
+}
+
+// EnablePortalAccess godoc
+// @Summary      Liberar portal ao morador
+// @Description  Cria o usuário do portal vinculado ao morador. Sem senha, o acesso é feito apenas por código de uso único
+// @Tags         Tenants
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Tenant ID (UUID)"
+// @Param        access body EnablePortalAccessRequest false "Senha inicial (opcional)"
+// @Success      201 {object} UserResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/portal-access [post]
+func (h *PortalHandler) EnablePortalAccess(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+
+	var req EnablePortalAccessRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			response.Error(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	user, err := h.portalAuthService.EnablePortalAccess(r.Context(), tenantID, req.Password)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Portal access enabled successfully", ToUserResponse(user))
+}
+
+// GeneratePortalLoginCode godoc
+// @Summary      Gerar código de acesso ao portal
+// @Description  Gera um código de 6 dígitos, válido por 15 minutos, para a administração repassar ao morador
+// @Tags         Tenants
+// @Produce      json
+// @Param        id path string true "Tenant ID (UUID)"
+// @Success      201 {object} PortalLoginCodeResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/portal-access/code [post]
+func (h *PortalHandler) GeneratePortalLoginCode(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+
+	code, loginCode, err := h.portalAuthService.GenerateLoginCode(r.Context(), tenantID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Login code generated successfully", ToPortalLoginCodeResponse(code, loginCode))
+}
+
+// GetProfile godoc
+// @Summary      Meus dados
+// @Description  Retorna os dados cadastrais do morador autenticado
+// @Tags         Portal
+// @Produce      json
+// @Success      200 {object} TenantResponse
+// @Failure      403 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /portal/me [get]
+func (h *PortalHandler) GetProfile(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenantID(w, r)
+	if !ok {
+		return
+	}
+
+	tenant, err := h.portalService.GetProfile(r.Context(), tenantID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Profile retrieved successfully", ToTenantResponse(tenant))
+}
+
+// ListLeases godoc
+// @Summary      Meus contratos
+// @Description  Lista os contratos do morador autenticado
+// @Tags         Portal
+// @Produce      json
+// @Success      200 {array} LeaseResponse
+// @Failure      403 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /portal/leases [get]
+func (h *PortalHandler) ListLeases(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenantID(w, r)
+	if !ok {
+		return
+	}
+
+	leases, err := h.portalService.ListLeases(r.Context(), tenantID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Leases retrieved successfully", ToLeaseResponseList(leases))
+}
+
+// ListPayments godoc
+// @Summary      Meus pagamentos
+// @Description  Lista o cronograma de pagamentos de todos os contratos do morador autenticado
+// @Tags         Portal
+// @Produce      json
+// @Success      200 {array} PaymentResponse
+// @Failure      403 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /portal/payments [get]
+func (h *PortalHandler) ListPayments(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenantID(w, r)
+	if !ok {
+		return
+	}
+
+	payments, err := h.portalService.ListPayments(r.Context(), tenantID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Payments retrieved successfully", ToPaymentResponseList(payments))
+}
+
+// GetPaymentReceipt godoc
+// @Summary      Recibo de pagamento
+// @Description  Retorna o recibo de um pagamento quitado do morador autenticado
+// @Tags         Portal
+// @Produce      json
+// @Param        id path string true "Payment ID (UUID)"
+// @Success      200 {object} PaymentReceiptResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /portal/payments/{id}/receipt [get]
+func (h *PortalHandler) GetPaymentReceipt(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenantID(w, r)
+	if !ok {
+		return
+	}
+
+	paymentID, ok := parseUUIDParam(w, r, "id", "Invalid payment ID")
+	if !ok {
+		return
+	}
+
+	receipt, err := h.portalService.GetPaymentReceipt(r.Context(), tenantID, paymentID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Receipt retrieved successfully", ToPaymentReceiptResponse(receipt))
+}
+
+// GetPaymentPix godoc
+// @Summary      Código PIX do pagamento
+// @Description  Gera o código PIX "copia e cola" de um pagamento em aberto do morador autenticado, com a chave PIX do imóvel
+// @Tags         Portal
+// @Produce      json
+// @Param        id path string true "Payment ID (UUID)"
+// @Success      200 {object} PaymentPixResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /portal/payments/{id}/pix [get]
+func (h *PortalHandler) GetPaymentPix(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenantID(w, r)
+	if !ok {
+		return
+	}
+
+	paymentID, ok := parseUUIDParam(w, r, "id", "Invalid payment ID")
+	if !ok {
+		return
+	}
+
+	pix, err := h.portalService.GetPaymentPix(r.Context(), tenantID, paymentID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "PIX code generated successfully", ToPaymentPixResponse(pix))
+}
+
+// ListMaintenanceTickets godoc
+// @Summary      Meus chamados de manutenção
+// @Description  Lista os chamados de manutenção do morador autenticado
+// @Tags         Portal
+// @Produce      json
+// @Success      200 {array} MaintenanceTicketResponse
+// @Failure      403 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /portal/maintenance [get]
+func (h *PortalHandler) ListMaintenanceTickets(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := h.tenantID(w, r)
+	if !ok {
+		return
+	}
+
+	tickets, err := h.portalService.ListMaintenanceTickets(r.Context(), tenantID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Maintenance tickets retrieved successfully", ToMaintenanceTicketResponseList(tickets))
+}
+
+// tenantID retorna o morador autenticado, definido pelo middleware RequireTenant
+func (h *PortalHandler) tenantID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	tenantID, ok := middleware.GetTenantIDFromContext(r.Context())
+	if !ok {
+		response.Error(w, http.StatusForbidden, "Insufficient permissions")
+		return uuid.Nil, false
+	}
+	return tenantID, true
+}
+
+// handleServiceError mapeia erros do service para respostas HTTP
+func (h *PortalHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrInvalidCredentials),
+		errors.Is(err, domain.ErrInvalidLoginCode),
+		errors.Is(err, domain.ErrLoginCodeLocked):
+		response.Error(w, http.StatusUnauthorized, err.Error())
+	case errors.Is(err, service.ErrUserInactive):
+		response.Error(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, service.ErrTenantNotFound),
+		errors.Is(err, service.ErrPaymentNotFound),
+		errors.Is(err, service.ErrUnitNotFound),
+		errors.Is(err, service.ErrPortalAccessNotEnabled):
+		response.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrPortalAccessExists):
+		response.Error(w, http.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrPortalCredentialMissing),
+		errors.Is(err, service.ErrPaymentNotPaidForReceipt),
+		errors.Is(err, service.ErrPaymentNotPayable),
+		errors.Is(err, domain.ErrPixKeyNotConfigured),
+		errors.Is(err, domain.ErrInvalidPassword):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
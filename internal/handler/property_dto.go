@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+	"github.com/shopspring/decimal"
+)
+
+// PropertyAddressRequest representa o endereço enviado no payload de imóvel
+type PropertyAddressRequest struct {
+	Street       string  `json:"street" validate:"required,max=255"`
+	Number       string  `json:"number" validate:"required,max=20"`
+	Complement   *string `json:"complement,omitempty" validate:"omitempty,max=100"`
+	Neighborhood string  `json:"neighborhood" validate:"required,max=100"`
+	City         string  `json:"city" validate:"required,max=100"`
+	State        string  `json:"state" validate:"required,len=2"`
+	ZipCode      string  `json:"zip_code" validate:"required,len=9"`
+}
+
+// PropertyBankAccountRequest representa os dados bancários enviados no payload de imóvel
+type PropertyBankAccountRequest struct {
+	BankName *string `json:"bank_name,omitempty" validate:"omitempty,max=100"`
+	Branch   *string `json:"branch,omitempty" validate:"omitempty,max=10"`
+	Account  *string `json:"account,omitempty" validate:"omitempty,max=20"`
+	PixKey   *string `json:"pix_key,omitempty" validate:"omitempty,max=100"`
+}
+
+// PropertyRequest representa o payload para criar ou atualizar um imóvel
+type PropertyRequest struct {
+	Name                 string                     `json:"name" validate:"required,min=2,max=100"`
+	Address              PropertyAddressRequest     `json:"address" validate:"required"`
+	BankAccount          PropertyBankAccountRequest `json:"bank_account"`
+	DefaultPaymentDueDay *int                       `json:"default_payment_due_day,omitempty" validate:"omitempty,min=1,max=31"`
+	DefaultPaintingFee   *decimal.Decimal           `json:"default_painting_fee,omitempty"`
+	Notes                *string                    `json:"notes,omitempty" validate:"omitempty,max=1000"`
+}
+
+// ToServiceRequest converte o payload HTTP para a request do service
+func (r PropertyRequest) ToServiceRequest() service.PropertyRequest {
+	return service.PropertyRequest{
+		Name: r.Name,
+		Address: domain.PropertyAddress{
+			Street:       r.Address.Street,
+			Number:       r.Address.Number,
+			Complement:   r.Address.Complement,
+			Neighborhood: r.Address.Neighborhood,
+			City:         r.Address.City,
+			State:        r.Address.State,
+			ZipCode:      r.Address.ZipCode,
+		},
+		BankAccount: domain.PropertyBankAccount{
+			BankName: r.BankAccount.BankName,
+			Branch:   r.BankAccount.Branch,
+			Account:  r.BankAccount.Account,
+			PixKey:   r.BankAccount.PixKey,
+		},
+		DefaultPaymentDueDay: r.DefaultPaymentDueDay,
+		DefaultPaintingFee:   r.DefaultPaintingFee,
+		Notes:                r.Notes,
+	}
+}
@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// PropertyHandler lida com requisições HTTP relacionadas a imóveis
+type PropertyHandler struct {
+	propertyService *service.PropertyService
+	validator       *validator.Validate
+}
+
+// NewPropertyHandler cria uma nova instância do handler
+func NewPropertyHandler(propertyService *service.PropertyService) *PropertyHandler {
+	return &PropertyHandler{
+		propertyService: propertyService,
+		validator:       validator.New(),
+	}
+}
+
+// CreateProperty godoc
+// @Summary      Cadastrar imóvel
+// @Description  Cadastra um novo imóvel (prédio) com endereço, dados bancários e configurações padrão
+// @Tags         Properties
+// @Accept       json
+// @Produce      json
+// @Param        property body PropertyRequest true "Dados do imóvel"
+// @Success      201 {object} domain.Property
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /properties [post]
+func (h *PropertyHandler) CreateProperty(w http.ResponseWriter, r *http.Request) {
+	var req PropertyRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	property, err := h.propertyService.CreateProperty(r.Context(), req.ToServiceRequest())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Property created successfully", property)
+}
+
+// GetProperty godoc
+// @Summary      Buscar imóvel por ID
+// @Description  Retorna os dados de um imóvel específico
+// @Tags         Properties
+// @Produce      json
+// @Param        id path string true "Property ID (UUID)"
+// @Success      200 {object} domain.Property
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /properties/{id} [get]
+func (h *PropertyHandler) GetProperty(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid property ID")
+		return
+	}
+
+	property, err := h.propertyService.GetPropertyByID(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Property retrieved successfully", property)
+}
+
+// ListProperties godoc
+// @Summary      Listar imóveis
+// @Description  Retorna todos os imóveis ordenados por nome
+// @Tags         Properties
+// @Produce      json
+// @Success      200 {array} domain.Property
+// @Failure      500 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /properties [get]
+func (h *PropertyHandler) ListProperties(w http.ResponseWriter, r *http.Request) {
+	properties, err := h.propertyService.ListProperties(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Properties retrieved successfully", properties)
+}
+
+// UpdateProperty godoc
+// @Summary      Atualizar imóvel
+// @Description  Atualiza os dados de um imóvel existente
+// @Tags         Properties
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Property ID (UUID)"
+// @Param        property body PropertyRequest true "Dados do imóvel"
+// @Success      200 {object} domain.Property
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /properties/{id} [put]
+func (h *PropertyHandler) UpdateProperty(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid property ID")
+		return
+	}
+
+	var req PropertyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	property, err := h.propertyService.UpdateProperty(r.Context(), id, req.ToServiceRequest())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Property updated successfully", property)
+}
+
+// DeleteProperty godoc
+// @Summary      Remover imóvel
+// @Description  Remove um imóvel que não possui unidades vinculadas
+// @Tags         Properties
+// @Produce      json
+// @Param        id path string true "Property ID (UUID)"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /properties/{id} [delete]
+func (h *PropertyHandler) DeleteProperty(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid property ID")
+		return
+	}
+
+	if err := h.propertyService.DeleteProperty(r.Context(), id); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Property deleted successfully", nil)
+}
+
+// handleServiceError mapeia erros do service para respostas HTTP
+func (h *PropertyHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrPropertyNotFound):
+		response.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrPropertyNameAlreadyExists):
+		response.Error(w, http.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrCannotDeletePropertyWithUnits),
+		errors.Is(err, domain.ErrInvalidPropertyName),
+		errors.Is(err, domain.ErrInvalidPropertyAddress),
+		errors.Is(err, domain.ErrInvalidPropertyState),
+		errors.Is(err, domain.ErrInvalidPropertyZipCode),
+		errors.Is(err, domain.ErrInvalidDefaultDueDay),
+		errors.Is(err, domain.ErrInvalidPaintingFee):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
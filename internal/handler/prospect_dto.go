@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+	"github.com/shopspring/decimal"
+)
+
+// ProspectRequest representa o payload para cadastrar ou atualizar um interessado
+type ProspectRequest struct {
+	FullName          string           `json:"full_name" validate:"required,min=3,max=255"`
+	Phone             string           `json:"phone" validate:"required,min=8,max=20"`
+	Email             *string          `json:"email,omitempty" validate:"omitempty,email"`
+	CPF               *string          `json:"cpf,omitempty" validate:"omitempty,max=18"`
+	Source            string           `json:"source" validate:"required,oneof=whatsapp phone walk_in referral website other"`
+	DesiredMoveInDate string           `json:"desired_move_in_date,omitempty" validate:"omitempty,datetime=2006-01-02"` // YYYY-MM-DD
+	MaxBudget         *decimal.Decimal `json:"max_budget,omitempty"`
+	PreferredFloor    *int             `json:"preferred_floor,omitempty" validate:"omitempty,min=1"`
+	Notes             *string          `json:"notes,omitempty" validate:"omitempty,max=2000"`
+}
+
+// ToInput converte o payload para os dados do serviço
+func (r *ProspectRequest) ToInput() service.ProspectInput {
+	return service.ProspectInput{
+		FullName:          r.FullName,
+		Phone:             r.Phone,
+		Email:             r.Email,
+		CPF:               r.CPF,
+		Source:            domain.ProspectSource(r.Source),
+		DesiredMoveInDate: parseOptionalDate(r.DesiredMoveInDate),
+		MaxBudget:         r.MaxBudget,
+		PreferredFloor:    r.PreferredFloor,
+		Notes:             r.Notes,
+	}
+}
+
+// ChangeProspectStatusRequest representa o payload para mover o interessado no funil
+type ChangeProspectStatusRequest struct {
+	Status     string  `json:"status" validate:"required,oneof=new visited applied approved lost"`
+	LostReason *string `json:"lost_reason,omitempty" validate:"omitempty,max=2000"`
+}
+
+// ScheduleVisitRequest representa o payload para agendar uma visita
+type ScheduleVisitRequest struct {
+	UnitID      *uuid.UUID `json:"unit_id,omitempty"`
+	ScheduledAt time.Time  `json:"scheduled_at" validate:"required"`
+	Notes       *string    `json:"notes,omitempty" validate:"omitempty,max=2000"`
+}
+
+// RecordVisitOutcomeRequest representa o payload para registrar o resultado de uma visita
+type RecordVisitOutcomeRequest struct {
+	Status string  `json:"status" validate:"required,oneof=completed cancelled no_show"`
+	Notes  *string `json:"notes,omitempty" validate:"omitempty,max=2000"`
+}
+
+// ConvertProspectRequest representa o payload para converter o interessado em morador
+type ConvertProspectRequest struct {
+	UnitID           uuid.UUID `json:"unit_id" validate:"required"`
+	CPF              *string   `json:"cpf,omitempty" validate:"omitempty,max=18"` // Obrigatório se não informado no cadastro
+	IDDocumentType   string    `json:"id_document_type,omitempty" validate:"omitempty,max=20"`
+	IDDocumentNumber string    `json:"id_document_number,omitempty" validate:"omitempty,max=50"`
+}
+
+// ProspectResponse representa a resposta com dados de um interessado
+type ProspectResponse struct {
+	ID                uuid.UUID        `json:"id"`
+	FullName          string           `json:"full_name"`
+	Phone             string           `json:"phone"`
+	Email             *string          `json:"email,omitempty"`
+	CPF               *string          `json:"cpf,omitempty"`
+	Source            string           `json:"source"`
+	DesiredMoveInDate *string          `json:"desired_move_in_date,omitempty"`
+	MaxBudget         *decimal.Decimal `json:"max_budget,omitempty"`
+	PreferredFloor    *int             `json:"preferred_floor,omitempty"`
+	Status            string           `json:"status"`
+	LostReason        *string          `json:"lost_reason,omitempty"`
+	Notes             *string          `json:"notes,omitempty"`
+	ConvertedTenantID *uuid.UUID       `json:"converted_tenant_id,omitempty"`
+	ConvertedAt       *time.Time       `json:"converted_at,omitempty"`
+	CreatedBy         *uuid.UUID       `json:"created_by,omitempty"`
+	CreatedAt         time.Time        `json:"created_at"`
+	UpdatedAt         time.Time        `json:"updated_at"`
+}
+
+// ProspectVisitResponse representa a resposta com dados de uma visita
+type ProspectVisitResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	ProspectID  uuid.UUID  `json:"prospect_id"`
+	UnitID      *uuid.UUID `json:"unit_id,omitempty"`
+	ScheduledAt time.Time  `json:"scheduled_at"`
+	Status      string     `json:"status"`
+	Notes       *string    `json:"notes,omitempty"`
+	CreatedBy   *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// ProspectConversionResponse representa o morador criado e o rascunho do contrato
+// O rascunho pode ser revisado e enviado para POST /leases
+type ProspectConversionResponse struct {
+	Prospect   *ProspectResponse      `json:"prospect"`
+	Tenant     *TenantResponse        `json:"tenant"`
+	LeaseDraft *CreateLeaseRequestDTO `json:"lease_draft"`
+}
+
+// ToProspectResponse converte domain.Prospect para ProspectResponse
+func ToProspectResponse(prospect *domain.Prospect) *ProspectResponse {
+	return &ProspectResponse{
+		ID:                prospect.ID,
+		FullName:          prospect.FullName,
+		Phone:             prospect.Phone,
+		Email:             prospect.Email,
+		CPF:               prospect.CPF,
+		Source:            string(prospect.Source),
+		DesiredMoveInDate: formatOptionalDate(prospect.DesiredMoveInDate),
+		MaxBudget:         prospect.MaxBudget,
+		PreferredFloor:    prospect.PreferredFloor,
+		Status:            string(prospect.Status),
+		LostReason:        prospect.LostReason,
+		Notes:             prospect.Notes,
+		ConvertedTenantID: prospect.ConvertedTenantID,
+		ConvertedAt:       prospect.ConvertedAt,
+		CreatedBy:         prospect.CreatedBy,
+		CreatedAt:         prospect.CreatedAt,
+		UpdatedAt:         prospect.UpdatedAt,
+	}
+}
+
+// ToProspectResponseList converte slice de interessados para slice de responses
+func ToProspectResponseList(prospects []*domain.Prospect) []*ProspectResponse {
+	responses := make([]*ProspectResponse, len(prospects))
+	for i, prospect := range prospects {
+		responses[i] = ToProspectResponse(prospect)
+	}
+	return responses
+}
+
+// ToProspectVisitResponse converte domain.ProspectVisit para ProspectVisitResponse
+func ToProspectVisitResponse(visit *domain.ProspectVisit) *ProspectVisitResponse {
+	return &ProspectVisitResponse{
+		ID:          visit.ID,
+		ProspectID:  visit.ProspectID,
+		UnitID:      visit.UnitID,
+		ScheduledAt: visit.ScheduledAt,
+		Status:      string(visit.Status),
+		Notes:       visit.Notes,
+		CreatedBy:   visit.CreatedBy,
+		CreatedAt:   visit.CreatedAt,
+		UpdatedAt:   visit.UpdatedAt,
+	}
+}
+
+// ToProspectVisitResponseList converte slice de visitas para slice de responses
+func ToProspectVisitResponseList(visits []*domain.ProspectVisit) []*ProspectVisitResponse {
+	responses := make([]*ProspectVisitResponse, len(visits))
+	for i, visit := range visits {
+		responses[i] = ToProspectVisitResponse(visit)
+	}
+	return responses
+}
+
+// ToProspectConversionResponse converte service.ProspectConversion para ProspectConversionResponse
+func ToProspectConversionResponse(conversion *service.ProspectConversion) *ProspectConversionResponse {
+	draft := conversion.LeaseDraft
+	return &ProspectConversionResponse{
+		Prospect: ToProspectResponse(conversion.Prospect),
+		Tenant:   ToTenantResponse(conversion.Tenant),
+		LeaseDraft: &CreateLeaseRequestDTO{
+			UnitID:                  draft.UnitID,
+			TenantID:                draft.TenantID,
+			ContractSignedDate:      draft.ContractSignedDate,
+			StartDate:               draft.StartDate,
+			PaymentDueDay:           draft.PaymentDueDay,
+			MonthlyRentValue:        draft.MonthlyRentValue,
+			PaintingFeeTotal:        draft.PaintingFeeTotal,
+			PaintingFeeInstallments: draft.PaintingFeeInstallments,
+			SecurityDeposit:         draft.SecurityDeposit,
+		},
+	}
+}
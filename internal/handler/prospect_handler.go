@@ -0,0 +1,455 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// ProspectHandler lida com requisições HTTP do funil de interessados e da lista de espera
+type ProspectHandler struct {
+	prospectService *service.ProspectService
+	validator       *validator.Validate
+}
+
+// NewProspectHandler cria uma nova instância do handler
+func NewProspectHandler(prospectService *service.ProspectService) *ProspectHandler {
+	return &ProspectHandler{
+		prospectService: prospectService,
+		validator:       validator.New(),
+	}
+}
+
+// CreateProspect godoc
+// @Summary      Cadastrar interessado
+// @Description  Cadastra um interessado (lead) com contato, data de mudança desejada, orçamento e andar preferido. Entra no funil com status new
+// @Tags         Prospects
+// @Accept       json
+// @Produce      json
+// @Param        prospect body ProspectRequest true "Dados do interessado"
+// @Success      201 {object} ProspectResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /prospects [post]
+func (h *ProspectHandler) CreateProspect(w http.ResponseWriter, r *http.Request) {
+	var req ProspectRequest
+	if !h.decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	prospect, err := h.prospectService.CreateProspect(r.Context(), req.ToInput(), currentUserID(r))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Prospect created successfully", ToProspectResponse(prospect))
+}
+
+// GetProspect godoc
+// @Summary      Buscar interessado por ID
+// @Description  Retorna os dados de um interessado
+// @Tags         Prospects
+// @Produce      json
+// @Param        id path string true "Prospect ID (UUID)"
+// @Success      200 {object} ProspectResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /prospects/{id} [get]
+func (h *ProspectHandler) GetProspect(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid prospect ID")
+	if !ok {
+		return
+	}
+
+	prospect, err := h.prospectService.GetProspect(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Prospect retrieved successfully", ToProspectResponse(prospect))
+}
+
+// ListProspects godoc
+// @Summary      Listar interessados
+// @Description  Retorna os interessados, com filtro opcional por etapa do funil
+// @Tags         Prospects
+// @Produce      json
+// @Param        status query string false "Filter by status" Enums(new, visited, applied, approved, converted, lost)
+// @Success      200 {array} ProspectResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      500 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /prospects [get]
+func (h *ProspectHandler) ListProspects(w http.ResponseWriter, r *http.Request) {
+	var status *domain.ProspectStatus
+	if statusFilter := r.URL.Query().Get("status"); statusFilter != "" {
+		s := domain.ProspectStatus(statusFilter)
+		if !domain.IsValidProspectStatus(s) {
+			response.Error(w, http.StatusBadRequest, domain.ErrInvalidProspectStatus.Error())
+			return
+		}
+		status = &s
+	}
+
+	prospects, err := h.prospectService.ListProspects(r.Context(), status)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Prospects retrieved successfully", ToProspectResponseList(prospects))
+}
+
+// UpdateProspect godoc
+// @Summary      Atualizar interessado
+// @Description  Atualiza contato e preferências de um interessado ainda não convertido
+// @Tags         Prospects
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Prospect ID (UUID)"
+// @Param        prospect body ProspectRequest true "Dados do interessado"
+// @Success      200 {object} ProspectResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /prospects/{id} [put]
+func (h *ProspectHandler) UpdateProspect(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid prospect ID")
+	if !ok {
+		return
+	}
+
+	var req ProspectRequest
+	if !h.decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	prospect, err := h.prospectService.UpdateProspect(r.Context(), id, req.ToInput())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Prospect updated successfully", ToProspectResponse(prospect))
+}
+
+// ChangeProspectStatus godoc
+// @Summary      Mover interessado no funil
+// @Description  Fluxo: new -> visited -> applied -> approved. Qualquer etapa em aberto pode ir para lost (com motivo), e lost pode voltar para new. A conversão usa POST /prospects/{id}/convert
+// @Tags         Prospects
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Prospect ID (UUID)"
+// @Param        request body ChangeProspectStatusRequest true "Novo status"
+// @Success      200 {object} ProspectResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /prospects/{id}/status [patch]
+func (h *ProspectHandler) ChangeProspectStatus(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid prospect ID")
+	if !ok {
+		return
+	}
+
+	var req ChangeProspectStatusRequest
+	if !h.decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	prospect, err := h.prospectService.ChangeStatus(r.Context(), id, domain.ProspectStatus(req.Status), req.LostReason)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Prospect status updated successfully", ToProspectResponse(prospect))
+}
+
+// DeleteProspect godoc
+// @Summary      Remover interessado
+// @Description  Remove um interessado e suas visitas
+// @Tags         Prospects
+// @Param        id path string true "Prospect ID (UUID)"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /prospects/{id} [delete]
+func (h *ProspectHandler) DeleteProspect(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid prospect ID")
+	if !ok {
+		return
+	}
+
+	if err := h.prospectService.DeleteProspect(r.Context(), id); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Prospect deleted successfully", nil)
+}
+
+// ScheduleVisit godoc
+// @Summary      Agendar visita
+// @Description  Agenda uma visita do interessado, opcionalmente a uma unidade específica
+// @Tags         Prospects
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Prospect ID (UUID)"
+// @Param        visit body ScheduleVisitRequest true "Dados da visita"
+// @Success      201 {object} ProspectVisitResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /prospects/{id}/visits [post]
+func (h *ProspectHandler) ScheduleVisit(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid prospect ID")
+	if !ok {
+		return
+	}
+
+	var req ScheduleVisitRequest
+	if !h.decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	visit, err := h.prospectService.ScheduleVisit(r.Context(), id, req.UnitID, req.ScheduledAt, req.Notes, currentUserID(r))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Visit scheduled successfully", ToProspectVisitResponse(visit))
+}
+
+// ListVisits godoc
+// @Summary      Listar visitas do interessado
+// @Description  Retorna as visitas de um interessado em ordem cronológica
+// @Tags         Prospects
+// @Produce      json
+// @Param        id path string true "Prospect ID (UUID)"
+// @Success      200 {array} ProspectVisitResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /prospects/{id}/visits [get]
+func (h *ProspectHandler) ListVisits(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid prospect ID")
+	if !ok {
+		return
+	}
+
+	visits, err := h.prospectService.ListVisits(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Visits retrieved successfully", ToProspectVisitResponseList(visits))
+}
+
+// ListUpcomingVisits godoc
+// @Summary      Agenda de visitas
+// @Description  Retorna as visitas agendadas a partir de agora
+// @Tags         Prospects
+// @Produce      json
+// @Success      200 {array} ProspectVisitResponse
+// @Failure      500 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /prospects/visits/upcoming [get]
+func (h *ProspectHandler) ListUpcomingVisits(w http.ResponseWriter, r *http.Request) {
+	visits, err := h.prospectService.ListUpcomingVisits(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Upcoming visits retrieved successfully", ToProspectVisitResponseList(visits))
+}
+
+// RecordVisitOutcome godoc
+// @Summary      Registrar resultado da visita
+// @Description  Marca a visita como completed, cancelled ou no_show. Uma visita realizada move o interessado de new para visited
+// @Tags         Prospects
+// @Accept       json
+// @Produce      json
+// @Param        visitId path string true "Visit ID (UUID)"
+// @Param        request body RecordVisitOutcomeRequest true "Resultado da visita"
+// @Success      200 {object} ProspectVisitResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /prospects/visits/{visitId} [patch]
+func (h *ProspectHandler) RecordVisitOutcome(w http.ResponseWriter, r *http.Request) {
+	visitID, ok := parseUUIDParam(w, r, "visitId", "Invalid visit ID")
+	if !ok {
+		return
+	}
+
+	var req RecordVisitOutcomeRequest
+	if !h.decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	visit, err := h.prospectService.RecordVisitOutcome(r.Context(), visitID, domain.ProspectVisitStatus(req.Status), req.Notes)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Visit updated successfully", ToProspectVisitResponse(visit))
+}
+
+// MatchUnits godoc
+// @Summary      Unidades compatíveis com o interessado
+// @Description  Lista as unidades disponíveis cujo aluguel cabe no orçamento, começando pelo andar preferido e pelo menor aluguel
+// @Tags         Prospects
+// @Produce      json
+// @Param        id path string true "Prospect ID (UUID)"
+// @Success      200 {array} UnitResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /prospects/{id}/matches [get]
+func (h *ProspectHandler) MatchUnits(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid prospect ID")
+	if !ok {
+		return
+	}
+
+	units, err := h.prospectService.MatchUnits(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Matching units retrieved successfully", ToUnitResponseList(units))
+}
+
+// GetUnitWaitlist godoc
+// @Summary      Lista de espera da unidade
+// @Description  Lista os interessados em aberto cujo orçamento comporta a unidade, priorizando quem prefere o andar e a data de mudança mais próxima
+// @Tags         Units
+// @Produce      json
+// @Param        id path string true "Unit ID (UUID)"
+// @Success      200 {array} ProspectResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /units/{id}/waitlist [get]
+func (h *ProspectHandler) GetUnitWaitlist(w http.ResponseWriter, r *http.Request) {
+	unitID, ok := parseUUIDParam(w, r, "id", "Invalid unit ID")
+	if !ok {
+		return
+	}
+
+	prospects, err := h.prospectService.GetUnitWaitlist(r.Context(), unitID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Unit waitlist retrieved successfully", ToProspectResponseList(prospects))
+}
+
+// ConvertProspect godoc
+// @Summary      Converter interessado em morador
+// @Description  Cria o morador a partir de um interessado aprovado e retorna um rascunho de contrato para a unidade escolhida (que deve estar disponível). O rascunho não é gravado: revise e envie para POST /leases
+// @Tags         Prospects
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Prospect ID (UUID)"
+// @Param        request body ConvertProspectRequest true "Unidade e documentos do morador"
+// @Success      201 {object} ProspectConversionResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /prospects/{id}/convert [post]
+func (h *ProspectHandler) ConvertProspect(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid prospect ID")
+	if !ok {
+		return
+	}
+
+	var req ConvertProspectRequest
+	if !h.decodeAndValidate(w, r, &req) {
+		return
+	}
+
+	conversion, err := h.prospectService.ConvertProspect(r.Context(), id, service.ConvertProspectRequest{
+		UnitID:           req.UnitID,
+		CPF:              req.CPF,
+		IDDocumentType:   req.IDDocumentType,
+		IDDocumentNumber: req.IDDocumentNumber,
+	})
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Prospect converted successfully", ToProspectConversionResponse(conversion))
+}
+
+// decodeAndValidate decodifica o JSON do corpo e valida o payload
+// Retorna false (e já escreve a resposta de erro) se o corpo for inválido
+func (h *ProspectHandler) decodeAndValidate(w http.ResponseWriter, r *http.Request, req any) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return false
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+
+	return true
+}
+
+// handleServiceError mapeia erros do service para respostas HTTP
+func (h *ProspectHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrProspectNotFound),
+		errors.Is(err, service.ErrProspectVisitNotFound),
+		errors.Is(err, service.ErrUnitNotFound):
+		response.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidProspectTransition),
+		errors.Is(err, domain.ErrProspectClosed),
+		errors.Is(err, domain.ErrProspectNotApproved),
+		errors.Is(err, domain.ErrProspectVisitNotScheduled),
+		errors.Is(err, service.ErrUnitNotAvailable),
+		errors.Is(err, service.ErrCPFAlreadyExists):
+		response.Error(w, http.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrProspectCPFRequired),
+		errors.Is(err, service.ErrUseConvertEndpoint),
+		errors.Is(err, domain.ErrInvalidFullName),
+		errors.Is(err, domain.ErrInvalidPhone),
+		errors.Is(err, domain.ErrInvalidEmail),
+		errors.Is(err, domain.ErrInvalidCPF),
+		errors.Is(err, domain.ErrInvalidCPFDigits),
+		errors.Is(err, domain.ErrInvalidCPFCheck),
+		errors.Is(err, domain.ErrInvalidCNPJCheck),
+		errors.Is(err, domain.ErrInvalidProspectSource),
+		errors.Is(err, domain.ErrInvalidProspectStatus),
+		errors.Is(err, domain.ErrInvalidProspectBudget),
+		errors.Is(err, domain.ErrInvalidFloor),
+		errors.Is(err, domain.ErrInvalidVisitDate):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
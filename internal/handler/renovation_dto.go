@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+	"github.com/shopspring/decimal"
+)
+
+// CreateRenovationProjectRequest representa o payload para planejar uma reforma
+type CreateRenovationProjectRequest struct {
+	UnitID           uuid.UUID       `json:"unit_id" validate:"required"`
+	Title            string          `json:"title" validate:"required,min=3,max=200"`
+	Description      string          `json:"description" validate:"max=2000"`
+	PlannedStartDate time.Time       `json:"planned_start_date" validate:"required"`
+	PlannedEndDate   time.Time       `json:"planned_end_date" validate:"required"`
+	Budget           decimal.Decimal `json:"budget"`
+}
+
+// UpdateRenovationProjectRequest representa o payload para replanejar uma reforma
+type UpdateRenovationProjectRequest struct {
+	Title            string          `json:"title" validate:"required,min=3,max=200"`
+	Description      string          `json:"description" validate:"max=2000"`
+	PlannedStartDate time.Time       `json:"planned_start_date" validate:"required"`
+	PlannedEndDate   time.Time       `json:"planned_end_date" validate:"required"`
+	Budget           decimal.Decimal `json:"budget"`
+	Notes            *string         `json:"notes,omitempty" validate:"omitempty,max=2000"`
+}
+
+// CancelRenovationProjectRequest representa o payload para cancelar uma reforma
+type CancelRenovationProjectRequest struct {
+	Reason *string `json:"reason,omitempty" validate:"omitempty,max=2000"`
+}
+
+// AddRenovationExpenseRequest representa o payload para lançar uma despesa de reforma
+type AddRenovationExpenseRequest struct {
+	Description string          `json:"description" validate:"required,min=2,max=255"`
+	Category    string          `json:"category" validate:"required,oneof=materials labor furniture appliances services other"`
+	Amount      decimal.Decimal `json:"amount"`
+	ExpenseDate time.Time       `json:"expense_date" validate:"required"`
+	Supplier    *string         `json:"supplier,omitempty" validate:"omitempty,max=255"`
+	ReceiptURL  *string         `json:"receipt_url,omitempty" validate:"omitempty,url"`
+}
+
+// RenovationProjectResponse representa a resposta com dados de um projeto de reforma
+type RenovationProjectResponse struct {
+	ID                 uuid.UUID       `json:"id"`
+	UnitID             uuid.UUID       `json:"unit_id"`
+	Title              string          `json:"title"`
+	Description        string          `json:"description"`
+	Status             string          `json:"status"`
+	PlannedStartDate   time.Time       `json:"planned_start_date"`
+	PlannedEndDate     time.Time       `json:"planned_end_date"`
+	Budget             decimal.Decimal `json:"budget"`
+	PreviousUnitStatus *string         `json:"previous_unit_status,omitempty"`
+	StartedAt          *time.Time      `json:"started_at,omitempty"`
+	CompletedAt        *time.Time      `json:"completed_at,omitempty"`
+	CancelledAt        *time.Time      `json:"cancelled_at,omitempty"`
+	Notes              *string         `json:"notes,omitempty"`
+	CreatedBy          *uuid.UUID      `json:"created_by,omitempty"`
+	CreatedAt          time.Time       `json:"created_at"`
+	UpdatedAt          time.Time       `json:"updated_at"`
+}
+
+// RenovationExpenseResponse representa uma despesa de reforma na resposta
+type RenovationExpenseResponse struct {
+	ID          uuid.UUID       `json:"id"`
+	ProjectID   uuid.UUID       `json:"project_id"`
+	Description string          `json:"description"`
+	Category    string          `json:"category"`
+	Amount      decimal.Decimal `json:"amount"`
+	ExpenseDate time.Time       `json:"expense_date"`
+	Supplier    *string         `json:"supplier,omitempty"`
+	ReceiptURL  *string         `json:"receipt_url,omitempty"`
+	CreatedBy   *uuid.UUID      `json:"created_by,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+// RenovationSummaryResponse representa o resumo financeiro de uma reforma
+type RenovationSummaryResponse struct {
+	Project              *RenovationProjectResponse   `json:"project"`
+	Expenses             []*RenovationExpenseResponse `json:"expenses"`
+	TotalSpent           decimal.Decimal              `json:"total_spent"`
+	RemainingBudget      decimal.Decimal              `json:"remaining_budget"`
+	BudgetUsagePercent   decimal.Decimal              `json:"budget_usage_percent"`
+	IsOverBudget         bool                         `json:"is_over_budget"`
+	MonthlyRentUplift    decimal.Decimal              `json:"monthly_rent_uplift"`
+	PaybackMonths        *int                         `json:"payback_months,omitempty"`
+	EstimatedPaybackDate *time.Time                   `json:"estimated_payback_date,omitempty"`
+}
+
+// ToRenovationProjectResponse converte domain.RenovationProject para RenovationProjectResponse
+func ToRenovationProjectResponse(project *domain.RenovationProject) *RenovationProjectResponse {
+	resp := &RenovationProjectResponse{
+		ID:               project.ID,
+		UnitID:           project.UnitID,
+		Title:            project.Title,
+		Description:      project.Description,
+		Status:           string(project.Status),
+		PlannedStartDate: project.PlannedStartDate,
+		PlannedEndDate:   project.PlannedEndDate,
+		Budget:           project.Budget,
+		StartedAt:        project.StartedAt,
+		CompletedAt:      project.CompletedAt,
+		CancelledAt:      project.CancelledAt,
+		Notes:            project.Notes,
+		CreatedBy:        project.CreatedBy,
+		CreatedAt:        project.CreatedAt,
+		UpdatedAt:        project.UpdatedAt,
+	}
+
+	if project.PreviousUnitStatus != nil {
+		status := string(*project.PreviousUnitStatus)
+		resp.PreviousUnitStatus = &status
+	}
+
+	return resp
+}
+
+// ToRenovationProjectResponseList converte uma lista de projetos
+func ToRenovationProjectResponseList(projects []*domain.RenovationProject) []*RenovationProjectResponse {
+	result := make([]*RenovationProjectResponse, len(projects))
+	for i, project := range projects {
+		result[i] = ToRenovationProjectResponse(project)
+	}
+	return result
+}
+
+// ToRenovationExpenseResponse converte domain.RenovationExpense para RenovationExpenseResponse
+func ToRenovationExpenseResponse(expense *domain.RenovationExpense) *RenovationExpenseResponse {
+	return &RenovationExpenseResponse{
+		ID:          expense.ID,
+		ProjectID:   expense.ProjectID,
+		Description: expense.Description,
+		Category:    string(expense.Category),
+		Amount:      expense.Amount,
+		ExpenseDate: expense.ExpenseDate,
+		Supplier:    expense.Supplier,
+		ReceiptURL:  expense.ReceiptURL,
+		CreatedBy:   expense.CreatedBy,
+		CreatedAt:   expense.CreatedAt,
+	}
+}
+
+// ToRenovationExpenseResponseList converte uma lista de despesas
+func ToRenovationExpenseResponseList(expenses []*domain.RenovationExpense) []*RenovationExpenseResponse {
+	result := make([]*RenovationExpenseResponse, len(expenses))
+	for i, expense := range expenses {
+		result[i] = ToRenovationExpenseResponse(expense)
+	}
+	return result
+}
+
+// ToRenovationSummaryResponse converte service.RenovationSummary para RenovationSummaryResponse
+func ToRenovationSummaryResponse(summary *service.RenovationSummary) *RenovationSummaryResponse {
+	return &RenovationSummaryResponse{
+		Project:              ToRenovationProjectResponse(summary.Project),
+		Expenses:             ToRenovationExpenseResponseList(summary.Expenses),
+		TotalSpent:           summary.TotalSpent,
+		RemainingBudget:      summary.RemainingBudget,
+		BudgetUsagePercent:   summary.BudgetUsagePercent,
+		IsOverBudget:         summary.IsOverBudget,
+		MonthlyRentUplift:    summary.MonthlyRentUplift,
+		PaybackMonths:        summary.PaybackMonths,
+		EstimatedPaybackDate: summary.EstimatedPaybackDate,
+	}
+}
@@ -0,0 +1,426 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// RenovationHandler lida com requisições HTTP relacionadas a projetos de reforma
+type RenovationHandler struct {
+	renovationService *service.RenovationService
+	validator         *validator.Validate
+}
+
+// NewRenovationHandler cria uma nova instância do handler
+func NewRenovationHandler(renovationService *service.RenovationService) *RenovationHandler {
+	return &RenovationHandler{
+		renovationService: renovationService,
+		validator:         validator.New(),
+	}
+}
+
+// CreateProject godoc
+// @Summary      Planejar reforma
+// @Description  Cria um projeto de reforma planejado para uma unidade. Cada unidade pode ter apenas um projeto ativo
+// @Tags         Renovations
+// @Accept       json
+// @Produce      json
+// @Param        project body CreateRenovationProjectRequest true "Dados do projeto"
+// @Success      201 {object} RenovationProjectResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /renovations [post]
+func (h *RenovationHandler) CreateProject(w http.ResponseWriter, r *http.Request) {
+	var req CreateRenovationProjectRequest
+
+	// Decodificar JSON
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Validar request
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	project, err := h.renovationService.CreateProject(r.Context(), service.CreateRenovationProjectRequest{
+		UnitID:           req.UnitID,
+		Title:            req.Title,
+		Description:      req.Description,
+		PlannedStartDate: req.PlannedStartDate,
+		PlannedEndDate:   req.PlannedEndDate,
+		Budget:           req.Budget,
+		CreatedBy:        currentUserID(r),
+	})
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Renovation project created successfully", ToRenovationProjectResponse(project))
+}
+
+// GetProject godoc
+// @Summary      Buscar reforma por ID
+// @Description  Retorna o projeto com despesas, saldo do orçamento e tempo estimado de retorno do investimento
+// @Tags         Renovations
+// @Produce      json
+// @Param        id path string true "Project ID (UUID)"
+// @Success      200 {object} RenovationSummaryResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /renovations/{id} [get]
+func (h *RenovationHandler) GetProject(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProjectID(w, r)
+	if !ok {
+		return
+	}
+
+	summary, err := h.renovationService.GetProjectSummary(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Renovation project retrieved successfully", ToRenovationSummaryResponse(summary))
+}
+
+// ListProjects godoc
+// @Summary      Listar reformas
+// @Description  Retorna lista de projetos de reforma com filtros opcionais
+// @Tags         Renovations
+// @Produce      json
+// @Param        status query string false "Filter by status" Enums(planned, in_progress, completed, cancelled)
+// @Param        unit_id query string false "Filter by unit ID (UUID)"
+// @Param        property_id query string false "Filter by property ID (UUID)"
+// @Success      200 {array} RenovationProjectResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      500 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /renovations [get]
+func (h *RenovationHandler) ListProjects(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	statusFilter := r.URL.Query().Get("status")
+	unitIDFilter := r.URL.Query().Get("unit_id")
+	propertyID, ok := parseOptionalPropertyID(w, r)
+	if !ok {
+		return
+	}
+
+	var projects []*domain.RenovationProject
+	var err error
+
+	if statusFilter != "" {
+		projects, err = h.renovationService.ListProjectsByStatus(ctx, domain.RenovationStatus(statusFilter))
+	} else if unitIDFilter != "" {
+		unitID, parseErr := uuid.Parse(unitIDFilter)
+		if parseErr != nil {
+			response.Error(w, http.StatusBadRequest, "Invalid unit ID")
+			return
+		}
+		projects, err = h.renovationService.ListProjectsByUnit(ctx, unitID)
+	} else if propertyID != nil {
+		projects, err = h.renovationService.ListProjectsByProperty(ctx, *propertyID)
+	} else {
+		projects, err = h.renovationService.ListProjects(ctx)
+	}
+
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	// Restringir o resultado dos demais filtros aos projetos do imóvel
+	if propertyID != nil && (statusFilter != "" || unitIDFilter != "") {
+		propertyProjects, err := h.renovationService.ListProjectsByProperty(ctx, *propertyID)
+		if err != nil {
+			h.handleServiceError(w, err)
+			return
+		}
+		inProperty := make(map[uuid.UUID]bool, len(propertyProjects))
+		for _, project := range propertyProjects {
+			inProperty[project.ID] = true
+		}
+		scoped := make([]*domain.RenovationProject, 0, len(projects))
+		for _, project := range projects {
+			if inProperty[project.ID] {
+				scoped = append(scoped, project)
+			}
+		}
+		projects = scoped
+	}
+
+	response.Success(w, http.StatusOK, "Renovation projects retrieved successfully", ToRenovationProjectResponseList(projects))
+}
+
+// UpdateProject godoc
+// @Summary      Atualizar reforma
+// @Description  Atualiza título, datas previstas e orçamento de um projeto não finalizado
+// @Tags         Renovations
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Project ID (UUID)"
+// @Param        project body UpdateRenovationProjectRequest true "Dados do projeto"
+// @Success      200 {object} RenovationProjectResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /renovations/{id} [put]
+func (h *RenovationHandler) UpdateProject(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProjectID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateRenovationProjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	project, err := h.renovationService.UpdateProject(r.Context(), id, service.UpdateRenovationProjectRequest{
+		Title:            req.Title,
+		Description:      req.Description,
+		PlannedStartDate: req.PlannedStartDate,
+		PlannedEndDate:   req.PlannedEndDate,
+		Budget:           req.Budget,
+		Notes:            req.Notes,
+	})
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Renovation project updated successfully", ToRenovationProjectResponse(project))
+}
+
+// StartProject godoc
+// @Summary      Iniciar reforma
+// @Description  Inicia a obra e coloca a unidade em status renovation. A unidade não pode estar ocupada
+// @Tags         Renovations
+// @Produce      json
+// @Param        id path string true "Project ID (UUID)"
+// @Success      200 {object} RenovationProjectResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /renovations/{id}/start [post]
+func (h *RenovationHandler) StartProject(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProjectID(w, r)
+	if !ok {
+		return
+	}
+
+	project, err := h.renovationService.StartProject(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Renovation project started successfully", ToRenovationProjectResponse(project))
+}
+
+// CompleteProject godoc
+// @Summary      Concluir reforma
+// @Description  Conclui a obra, marca a unidade como reformada (aluguel de reformada) e a libera para locação
+// @Tags         Renovations
+// @Produce      json
+// @Param        id path string true "Project ID (UUID)"
+// @Success      200 {object} RenovationProjectResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /renovations/{id}/complete [post]
+func (h *RenovationHandler) CompleteProject(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProjectID(w, r)
+	if !ok {
+		return
+	}
+
+	project, err := h.renovationService.CompleteProject(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Renovation project completed successfully", ToRenovationProjectResponse(project))
+}
+
+// CancelProject godoc
+// @Summary      Cancelar reforma
+// @Description  Cancela o projeto. Se a obra estava em andamento, a unidade volta ao status anterior
+// @Tags         Renovations
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Project ID (UUID)"
+// @Param        body body CancelRenovationProjectRequest false "Motivo do cancelamento"
+// @Success      200 {object} RenovationProjectResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /renovations/{id}/cancel [post]
+func (h *RenovationHandler) CancelProject(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProjectID(w, r)
+	if !ok {
+		return
+	}
+
+	// Body opcional
+	var req CancelRenovationProjectRequest
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			response.Error(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+		if err := h.validator.Struct(req); err != nil {
+			response.Error(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	project, err := h.renovationService.CancelProject(r.Context(), id, req.Reason)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Renovation project cancelled successfully", ToRenovationProjectResponse(project))
+}
+
+// AddExpense godoc
+// @Summary      Lançar despesa de reforma
+// @Description  Registra uma despesa (material, mão de obra, mobília etc.) no projeto
+// @Tags         Renovations
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Project ID (UUID)"
+// @Param        expense body AddRenovationExpenseRequest true "Dados da despesa"
+// @Success      201 {object} RenovationExpenseResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /renovations/{id}/expenses [post]
+func (h *RenovationHandler) AddExpense(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProjectID(w, r)
+	if !ok {
+		return
+	}
+
+	var req AddRenovationExpenseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	expense, err := h.renovationService.AddExpense(r.Context(), id, service.AddRenovationExpenseRequest{
+		Description: req.Description,
+		Category:    domain.RenovationExpenseCategory(req.Category),
+		Amount:      req.Amount,
+		ExpenseDate: req.ExpenseDate,
+		Supplier:    req.Supplier,
+		ReceiptURL:  req.ReceiptURL,
+		CreatedBy:   currentUserID(r),
+	})
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Renovation expense added successfully", ToRenovationExpenseResponse(expense))
+}
+
+// DeleteExpense godoc
+// @Summary      Remover despesa de reforma
+// @Description  Remove uma despesa lançada por engano
+// @Tags         Renovations
+// @Produce      json
+// @Param        id path string true "Project ID (UUID)"
+// @Param        expenseId path string true "Expense ID (UUID)"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /renovations/{id}/expenses/{expenseId} [delete]
+func (h *RenovationHandler) DeleteExpense(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.parseProjectID(w, r)
+	if !ok {
+		return
+	}
+
+	expenseID, err := uuid.Parse(chi.URLParam(r, "expenseId"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid expense ID")
+		return
+	}
+
+	if err := h.renovationService.DeleteExpense(r.Context(), id, expenseID); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Renovation expense deleted successfully", nil)
+}
+
+// parseProjectID extrai e valida o ID do projeto da URL
+func (h *RenovationHandler) parseProjectID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid renovation project ID")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// handleServiceError mapeia erros do service para respostas HTTP
+func (h *RenovationHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrRenovationProjectNotFound),
+		errors.Is(err, service.ErrRenovationExpenseNotFound),
+		errors.Is(err, service.ErrUnitNotFound):
+		response.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrUnitHasActiveRenovation),
+		errors.Is(err, service.ErrCannotRenovateOccupiedUnit):
+		response.Error(w, http.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrRenovationProjectCancelled),
+		errors.Is(err, service.ErrExpenseNotInProject),
+		errors.Is(err, domain.ErrInvalidRenovationTitle),
+		errors.Is(err, domain.ErrInvalidRenovationStatus),
+		errors.Is(err, domain.ErrInvalidRenovationTransition),
+		errors.Is(err, domain.ErrInvalidRenovationDates),
+		errors.Is(err, domain.ErrInvalidRenovationBudget),
+		errors.Is(err, domain.ErrRenovationFinished),
+		errors.Is(err, domain.ErrInvalidExpenseDescription),
+		errors.Is(err, domain.ErrInvalidExpenseCategory),
+		errors.Is(err, domain.ErrInvalidExpenseAmount),
+		errors.Is(err, domain.ErrInvalidExpenseDate):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/spreadsheet"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// parseExportFormat identifica o formato pedido pelo parâmetro format (json, csv ou xlsx) ou,
+// na ausência dele, pelo header Accept. Retorna nil quando a resposta deve ser JSON
+func parseExportFormat(w http.ResponseWriter, r *http.Request) (*spreadsheet.Format, bool) {
+	value := r.URL.Query().Get("format")
+	if value == "" {
+		if format, ok := spreadsheet.FormatFromAccept(r.Header.Get("Accept")); ok {
+			return &format, true
+		}
+		return nil, true
+	}
+	if value == "json" {
+		return nil, true
+	}
+
+	format, err := spreadsheet.ParseFormat(value)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid format parameter. Use json, csv or xlsx")
+		return nil, false
+	}
+	return &format, true
+}
+
+// startExport envia os headers do arquivo e retorna o writer que grava direto na resposta
+func startExport(w http.ResponseWriter, format spreadsheet.Format, baseName string) (spreadsheet.Writer, error) {
+	fileName := baseName + "." + format.Extension()
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	return spreadsheet.NewWriter(format, w)
+}
+
+// writePDF envia o documento PDF como anexo
+func writePDF(w http.ResponseWriter, fileName string, content []byte) {
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(content); err != nil {
+		exportFailed(fileName, err)
+	}
+}
+
+// writeFinancialReportExport grava o relatório financeiro nas abas resumo, por tipo, por mês e por unidade
+func writeFinancialReportExport(sw spreadsheet.Writer, report *service.FinancialReportResponse) error {
+	summary := report.Summary
+	if err := sw.Sheet("Resumo", "Indicador", "Valor"); err != nil {
+		return err
+	}
+	rows := [][]spreadsheet.Cell{
+		{spreadsheet.Text("Início do período"), spreadsheet.Date(report.Period.StartDate)},
+		{spreadsheet.Text("Fim do período"), spreadsheet.Date(report.Period.EndDate)},
+		{spreadsheet.Text("Quantidade de pagamentos"), spreadsheet.Int(report.TotalPayments)},
+		{spreadsheet.Text("Receita total"), spreadsheet.Money(summary.TotalRevenue)},
+		{spreadsheet.Text("Recebido"), spreadsheet.Money(summary.PaidAmount)},
+		{spreadsheet.Text("Pendente"), spreadsheet.Money(summary.PendingAmount)},
+		{spreadsheet.Text("Em atraso"), spreadsheet.Money(summary.OverdueAmount)},
+		{spreadsheet.Text("Cancelado"), spreadsheet.Money(summary.CancelledAmount)},
+		{spreadsheet.Text("Cauções recebidas (fora da receita)"), spreadsheet.Money(summary.SecurityDepositsReceived)},
+		{spreadsheet.Text("Gerado em"), spreadsheet.Date(report.GeneratedAt)},
+	}
+	for _, row := range rows {
+		if err := sw.Row(row...); err != nil {
+			return err
+		}
+	}
+
+	if err := sw.Sheet("Por tipo", "Tipo", "Quantidade", "Valor"); err != nil {
+		return err
+	}
+	for _, revenue := range report.SortedByType() {
+		label := domain.PaymentType(revenue.Type).Label()
+		if err := sw.Row(spreadsheet.Text(label), spreadsheet.Int(revenue.Count), spreadsheet.Money(revenue.Amount)); err != nil {
+			return err
+		}
+	}
+
+	if err := sw.Sheet("Por mês", "Mês", "Quantidade", "Valor"); err != nil {
+		return err
+	}
+	for _, revenue := range report.ByMonth {
+		month := revenue.Month
+		if parsed, err := time.Parse("2006-01", revenue.Month); err == nil {
+			month = parsed.Format("01/2006")
+		}
+		if err := sw.Row(spreadsheet.Text(month), spreadsheet.Int(revenue.Count), spreadsheet.Money(revenue.Amount)); err != nil {
+			return err
+		}
+	}
+
+	if err := sw.Sheet("Por unidade", "Unidade", "Quantidade", "Valor"); err != nil {
+		return err
+	}
+	for _, revenue := range report.ByUnit {
+		if err := sw.Row(spreadsheet.Text(revenue.UnitNumber), spreadsheet.Int(revenue.Count), spreadsheet.Money(revenue.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// paymentHistoryExportHeader é o cabeçalho da exportação do histórico de pagamentos
+var paymentHistoryExportHeader = []string{
+	"Unidade", "Morador", "Tipo", "Status", "Vencimento", "Pagamento", "Forma de pagamento", "Valor",
+}
+
+// paymentHistoryExportRow converte um item do histórico em linha da planilha
+func paymentHistoryExportRow(item service.PaymentHistoryItem) []spreadsheet.Cell {
+	method := ""
+	if item.PaymentMethod != nil {
+		method = item.PaymentMethod.Label()
+	}
+	return []spreadsheet.Cell{
+		spreadsheet.Text(item.UnitNumber),
+		spreadsheet.Text(item.TenantName),
+		spreadsheet.Text(item.PaymentType.Label()),
+		spreadsheet.Text(item.Status.Label()),
+		spreadsheet.Date(item.DueDate),
+		spreadsheet.OptionalDate(item.PaymentDate),
+		spreadsheet.Text(method),
+		spreadsheet.Money(item.Amount),
+	}
+}
+
+// exportFileDate formata datas usadas nos nomes dos arquivos exportados
+func exportFileDate(t time.Time) string {
+	return t.Format("2006-01-02")
+}
+
+// exportFailed registra falhas ocorridas depois do início do envio do arquivo,
+// quando já não é possível responder com erro
+func exportFailed(name string, err error) {
+	fmt.Printf("Warning: failed to export %s: %v\n", name, err)
+}
@@ -2,12 +2,14 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/spreadsheet"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
 )
 
@@ -27,19 +29,195 @@ func NewReportHandler(reportService *service.ReportService) *ReportHandler {
 
 // GetFinancialReport godoc
 // @Summary      Obter relatório financeiro
-// @Description  Retorna relatório financeiro consolidado com filtros de período e tipo
+// @Description  Retorna relatório financeiro consolidado com filtros de período e tipo. Com format=csv ou format=xlsx (ou header Accept correspondente) retorna planilha com as abas resumo, por tipo, por mês e por unidade, em formato brasileiro
 // @Tags         Reports
 // @Produce      json
+// @Produce      text/csv
+// @Produce      application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
 // @Param        start_date query string true "Data inicial (YYYY-MM-DD)"
 // @Param        end_date query string true "Data final (YYYY-MM-DD)"
 // @Param        payment_type query string false "Tipo de pagamento" Enums(rent, painting_fee, adjustment)
 // @Param        status query string false "Status do pagamento" Enums(pending, paid, overdue, cancelled)
+// @Param        property_id query string false "Filtrar por imóvel (UUID)"
+// @Param        format query string false "Formato da resposta" Enums(json, csv, xlsx)
 // @Success      200 {object} service.FinancialReportResponse
 // @Failure      400 {object} response.ErrorResponse
 // @Failure      500 {object} response.ErrorResponse
 // @Security     BearerAuth
 // @Router       /reports/financial [get]
 func (h *ReportHandler) GetFinancialReport(w http.ResponseWriter, r *http.Request) {
+	req, ok := parseFinancialReportRequest(w, r)
+	if !ok {
+		return
+	}
+
+	format, ok := parseExportFormat(w, r)
+	if !ok {
+		return
+	}
+
+	// Gerar relatório
+	report, err := h.reportService.GetFinancialReport(r.Context(), req)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	if format == nil {
+		response.Success(w, http.StatusOK, "Financial report generated successfully", report)
+		return
+	}
+
+	// Exportar planilha
+	fileName := "relatorio-financeiro-" + exportFileDate(req.StartDate) + "-a-" + exportFileDate(req.EndDate)
+	sw, err := startExport(w, *format, fileName)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+	if err := writeFinancialReportExport(sw, report); err != nil {
+		exportFailed(fileName, err)
+	}
+	if err := sw.Close(); err != nil {
+		exportFailed(fileName, err)
+	}
+}
+
+// GetPaymentHistoryReport godoc
+// @Summary      Obter histórico de pagamentos
+// @Description  Retorna histórico detalhado de pagamentos com filtros, paginado do vencimento mais recente para o mais antigo. Com format=csv ou format=xlsx (ou header Accept correspondente) retorna planilha gerada linha a linha, em formato brasileiro
+// @Tags         Reports
+// @Produce      json
+// @Produce      text/csv
+// @Produce      application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param        lease_id query string false "Filtrar por ID do contrato (UUID)"
+// @Param        tenant_id query string false "Filtrar por ID do morador (UUID)"
+// @Param        property_id query string false "Filtrar por ID do imóvel (UUID)"
+// @Param        status query string false "Filtrar por status" Enums(pending, paid, overdue, cancelled)
+// @Param        start_date query string false "Data inicial (YYYY-MM-DD)"
+// @Param        end_date query string false "Data final (YYYY-MM-DD)"
+// @Param        page query int false "Página (padrão 1)"
+// @Param        page_size query int false "Pagamentos por página (padrão 50, máximo 500)"
+// @Param        format query string false "Formato da resposta (as exportações trazem todas as páginas)" Enums(json, csv, xlsx)
+// @Success      200 {object} service.PaymentHistoryResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      500 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /reports/payments [get]
+func (h *ReportHandler) GetPaymentHistoryReport(w http.ResponseWriter, r *http.Request) {
+	req, ok := parsePaymentHistoryRequest(w, r)
+	if !ok {
+		return
+	}
+
+	format, ok := parseExportFormat(w, r)
+	if !ok {
+		return
+	}
+	if format != nil {
+		h.exportPaymentHistory(w, r, req, *format)
+		return
+	}
+
+	// Gerar relatório
+	report, err := h.reportService.GetPaymentHistoryReport(r.Context(), req)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Payment history report generated successfully", report)
+}
+
+// GetFinancialReportPDF godoc
+// @Summary      Relatório financeiro em PDF
+// @Description  Gera o relatório financeiro paginado em PDF com período, totais, gráfico de receita mensal e tabelas por tipo, mês e unidade
+// @Tags         Reports
+// @Produce      application/pdf
+// @Param        start_date query string true "Data inicial (YYYY-MM-DD)"
+// @Param        end_date query string true "Data final (YYYY-MM-DD)"
+// @Param        payment_type query string false "Tipo de pagamento" Enums(rent, painting_fee, adjustment)
+// @Param        status query string false "Status do pagamento" Enums(pending, paid, overdue, cancelled)
+// @Param        property_id query string false "Filtrar por imóvel (UUID)"
+// @Success      200 {file} file
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      500 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /reports/financial.pdf [get]
+func (h *ReportHandler) GetFinancialReportPDF(w http.ResponseWriter, r *http.Request) {
+	req, ok := parseFinancialReportRequest(w, r)
+	if !ok {
+		return
+	}
+
+	content, err := h.reportService.FinancialReportPDF(r.Context(), req)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writePDF(w, "relatorio-financeiro-"+exportFileDate(req.StartDate)+"-a-"+exportFileDate(req.EndDate)+".pdf", content)
+}
+
+// GetPaymentHistoryReportPDF godoc
+// @Summary      Histórico de pagamentos em PDF
+// @Description  Gera o histórico de pagamentos paginado em PDF com período, totais e tabela de pagamentos
+// @Tags         Reports
+// @Produce      application/pdf
+// @Param        lease_id query string false "Filtrar por ID do contrato (UUID)"
+// @Param        tenant_id query string false "Filtrar por ID do morador (UUID)"
+// @Param        property_id query string false "Filtrar por ID do imóvel (UUID)"
+// @Param        status query string false "Filtrar por status" Enums(pending, paid, overdue, cancelled)
+// @Param        start_date query string false "Data inicial (YYYY-MM-DD)"
+// @Param        end_date query string false "Data final (YYYY-MM-DD)"
+// @Success      200 {file} file
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      500 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /reports/payments.pdf [get]
+func (h *ReportHandler) GetPaymentHistoryReportPDF(w http.ResponseWriter, r *http.Request) {
+	req, ok := parsePaymentHistoryRequest(w, r)
+	if !ok {
+		return
+	}
+
+	content, err := h.reportService.PaymentHistoryPDF(r.Context(), req)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	writePDF(w, "historico-pagamentos-"+exportFileDate(time.Now())+".pdf", content)
+}
+
+// GetAgingReport godoc
+// @Summary      Relatório de inadimplência por faixa de atraso
+// @Description  Agrupa os pagamentos vencidos e não pagos (pendentes e atrasados) nas faixas de 0-30, 31-60, 61-90 e mais de 90 dias de atraso, por morador, unidade e tipo, com totais e comparação com o mês anterior
+// @Tags         Reports
+// @Produce      json
+// @Param        property_id query string false "Filtrar por imóvel (UUID)"
+// @Success      200 {object} service.AgingReportResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      500 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /reports/aging [get]
+func (h *ReportHandler) GetAgingReport(w http.ResponseWriter, r *http.Request) {
+	propertyID, ok := parseOptionalPropertyID(w, r)
+	if !ok {
+		return
+	}
+
+	report, err := h.reportService.GetAgingReport(r.Context(), service.AgingReportRequest{PropertyID: propertyID})
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Aging report generated successfully", report)
+}
+
+// parseFinancialReportRequest extrai os filtros do relatório financeiro da query string
+func parseFinancialReportRequest(w http.ResponseWriter, r *http.Request) (req service.FinancialReportRequest, ok bool) {
 	// 1. Extrair query params
 	startDateStr := r.URL.Query().Get("start_date")
 	endDateStr := r.URL.Query().Get("end_date")
@@ -49,24 +227,24 @@ func (h *ReportHandler) GetFinancialReport(w http.ResponseWriter, r *http.Reques
 	// 2. Validar parâmetros obrigatórios
 	if startDateStr == "" || endDateStr == "" {
 		response.Error(w, http.StatusBadRequest, "start_date and end_date are required")
-		return
+		return req, false
 	}
 
 	// 3. Parsear datas
 	startDate, err := time.Parse("2006-01-02", startDateStr)
 	if err != nil {
 		response.Error(w, http.StatusBadRequest, "Invalid start_date format. Use YYYY-MM-DD")
-		return
+		return req, false
 	}
 
 	endDate, err := time.Parse("2006-01-02", endDateStr)
 	if err != nil {
 		response.Error(w, http.StatusBadRequest, "Invalid end_date format. Use YYYY-MM-DD")
-		return
+		return req, false
 	}
 
 	// 4. Construir request para o service
-	req := service.FinancialReportRequest{
+	req = service.FinancialReportRequest{
 		StartDate: startDate,
 		EndDate:   endDate,
 	}
@@ -82,32 +260,17 @@ func (h *ReportHandler) GetFinancialReport(w http.ResponseWriter, r *http.Reques
 		req.Status = &status
 	}
 
-	// 6. Gerar relatório
-	report, err := h.reportService.GetFinancialReport(r.Context(), req)
-	if err != nil {
-		h.handleServiceError(w, err)
-		return
+	propertyID, ok := parseOptionalPropertyID(w, r)
+	if !ok {
+		return req, false
 	}
+	req.PropertyID = propertyID
 
-	response.Success(w, http.StatusOK, "Financial report generated successfully", report)
+	return req, true
 }
 
-// GetPaymentHistoryReport godoc
-// @Summary      Obter histórico de pagamentos
-// @Description  Retorna histórico detalhado de pagamentos com filtros
-// @Tags         Reports
-// @Produce      json
-// @Param        lease_id query string false "Filtrar por ID do contrato (UUID)"
-// @Param        tenant_id query string false "Filtrar por ID do morador (UUID)"
-// @Param        status query string false "Filtrar por status" Enums(pending, paid, overdue, cancelled)
-// @Param        start_date query string false "Data inicial (YYYY-MM-DD)"
-// @Param        end_date query string false "Data final (YYYY-MM-DD)"
-// @Success      200 {object} service.PaymentHistoryResponse
-// @Failure      400 {object} response.ErrorResponse
-// @Failure      500 {object} response.ErrorResponse
-// @Security     BearerAuth
-// @Router       /reports/payments [get]
-func (h *ReportHandler) GetPaymentHistoryReport(w http.ResponseWriter, r *http.Request) {
+// parsePaymentHistoryRequest extrai os filtros do histórico de pagamentos da query string
+func parsePaymentHistoryRequest(w http.ResponseWriter, r *http.Request) (req service.PaymentHistoryRequest, ok bool) {
 	// 1. Extrair query params
 	leaseIDStr := r.URL.Query().Get("lease_id")
 	tenantIDStr := r.URL.Query().Get("tenant_id")
@@ -116,14 +279,14 @@ func (h *ReportHandler) GetPaymentHistoryReport(w http.ResponseWriter, r *http.R
 	endDateStr := r.URL.Query().Get("end_date")
 
 	// 2. Construir request
-	req := service.PaymentHistoryRequest{}
+	req = service.PaymentHistoryRequest{}
 
 	// 3. Parsear lease_id se fornecido
 	if leaseIDStr != "" {
 		leaseID, err := uuid.Parse(leaseIDStr)
 		if err != nil {
 			response.Error(w, http.StatusBadRequest, "Invalid lease_id format")
-			return
+			return req, false
 		}
 		req.LeaseID = &leaseID
 	}
@@ -133,7 +296,7 @@ func (h *ReportHandler) GetPaymentHistoryReport(w http.ResponseWriter, r *http.R
 		tenantID, err := uuid.Parse(tenantIDStr)
 		if err != nil {
 			response.Error(w, http.StatusBadRequest, "Invalid tenant_id format")
-			return
+			return req, false
 		}
 		req.TenantID = &tenantID
 	}
@@ -149,7 +312,7 @@ func (h *ReportHandler) GetPaymentHistoryReport(w http.ResponseWriter, r *http.R
 		startDate, err := time.Parse("2006-01-02", startDateStr)
 		if err != nil {
 			response.Error(w, http.StatusBadRequest, "Invalid start_date format. Use YYYY-MM-DD")
-			return
+			return req, false
 		}
 		req.StartDate = &startDate
 	}
@@ -158,19 +321,80 @@ func (h *ReportHandler) GetPaymentHistoryReport(w http.ResponseWriter, r *http.R
 		endDate, err := time.Parse("2006-01-02", endDateStr)
 		if err != nil {
 			response.Error(w, http.StatusBadRequest, "Invalid end_date format. Use YYYY-MM-DD")
-			return
+			return req, false
 		}
 		req.EndDate = &endDate
 	}
 
-	// 7. Gerar relatório
-	report, err := h.reportService.GetPaymentHistoryReport(r.Context(), req)
-	if err != nil {
+	// 7. Parsear property_id se fornecido
+	propertyID, ok := parseOptionalPropertyID(w, r)
+	if !ok {
+		return req, false
+	}
+	req.PropertyID = propertyID
+
+	// 8. Parsear paginação se fornecida
+	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
+		page, err := strconv.Atoi(pageStr)
+		if err != nil || page <= 0 {
+			response.Error(w, http.StatusBadRequest, "Invalid page parameter")
+			return req, false
+		}
+		req.Page = page
+	}
+
+	if pageSizeStr := r.URL.Query().Get("page_size"); pageSizeStr != "" {
+		pageSize, err := strconv.Atoi(pageSizeStr)
+		if err != nil || pageSize <= 0 {
+			response.Error(w, http.StatusBadRequest, "Invalid page_size parameter")
+			return req, false
+		}
+		req.PageSize = pageSize
+	}
+
+	return req, true
+}
+
+// exportPaymentHistory grava o histórico de pagamentos na resposta à medida que os itens são lidos.
+// O arquivo só começa a ser enviado no primeiro item, para que falhas na consulta ainda retornem erro
+func (h *ReportHandler) exportPaymentHistory(w http.ResponseWriter, r *http.Request, req service.PaymentHistoryRequest, format spreadsheet.Format) {
+	fileName := "historico-pagamentos-" + exportFileDate(time.Now())
+
+	var sw spreadsheet.Writer
+	start := func() error {
+		var err error
+		if sw, err = startExport(w, format, fileName); err != nil {
+			return err
+		}
+		return sw.Sheet("Pagamentos", paymentHistoryExportHeader...)
+	}
+
+	err := h.reportService.StreamPaymentHistory(r.Context(), req, func(item service.PaymentHistoryItem) error {
+		if sw == nil {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		return sw.Row(paymentHistoryExportRow(item)...)
+	})
+	if err != nil && sw == nil {
 		h.handleServiceError(w, err)
 		return
 	}
+	if err != nil {
+		exportFailed(fileName, err)
+	}
 
-	response.Success(w, http.StatusOK, "Payment history report generated successfully", report)
+	// Sem pagamentos, o arquivo contém apenas o cabeçalho
+	if sw == nil {
+		if err := start(); err != nil && sw == nil {
+			h.handleServiceError(w, err)
+			return
+		}
+	}
+	if err := sw.Close(); err != nil {
+		exportFailed(fileName, err)
+	}
 }
 
 // handleServiceError mapeia erros do service para respostas HTTP
@@ -9,22 +9,57 @@ import (
 
 // SetupRoutes configura todas as rotas da aplicação
 func SetupRoutes(r chi.Router,
+	propertyService *service.PropertyService,
 	unitService *service.UnitService,
 	tenantService *service.TenantService,
 	leaseService *service.LeaseService,
 	paymentService *service.PaymentService,
 	dashboardService *service.DashboardService,
 	reportService *service.ReportService,
+	maintenanceService *service.MaintenanceService,
+	renovationService *service.RenovationService,
+	inventoryService *service.InventoryService,
+	utilityService *service.UtilityService,
+	depositService *service.DepositService,
+	portalAuthService *service.PortalAuthService,
+	portalService *service.PortalService,
+	documentService *service.TenantDocumentService,
+	tenantProfileService *service.TenantProfileService,
+	tenantScoreService *service.TenantScoreService,
+	tenantPrivacyService *service.TenantPrivacyService,
+	prospectService *service.ProspectService,
+	searchService *service.SearchService,
+	notificationService *service.NotificationService,
+	dunningService *service.DunningService,
+	webhookService *service.WebhookService,
+	calendarService *service.CalendarService,
 	authService *service.AuthService,
 	authMiddleware *middleware.AuthMiddleware,
 	taskScheduler *scheduler.Scheduler) {
 	// Criar handlers
+	propertyHandler := NewPropertyHandler(propertyService)
 	unitHandler := NewUnitHandler(unitService)
 	tenantHandler := NewTenantHandler(tenantService)
 	leaseHandler := NewLeaseHandler(leaseService)
 	paymentHandler := NewPaymentHandler(paymentService)
 	dashboardHandler := NewDashboardHandler(dashboardService)
 	reportHandler := NewReportHandler(reportService)
+	maintenanceHandler := NewMaintenanceHandler(maintenanceService)
+	renovationHandler := NewRenovationHandler(renovationService)
+	inventoryHandler := NewInventoryHandler(inventoryService)
+	utilityHandler := NewUtilityHandler(utilityService)
+	depositHandler := NewDepositHandler(depositService)
+	portalHandler := NewPortalHandler(portalAuthService, portalService)
+	documentHandler := NewTenantDocumentHandler(documentService)
+	tenantProfileHandler := NewTenantProfileHandler(tenantProfileService)
+	tenantScoreHandler := NewTenantScoreHandler(tenantScoreService)
+	tenantPrivacyHandler := NewTenantPrivacyHandler(tenantPrivacyService)
+	prospectHandler := NewProspectHandler(prospectService)
+	searchHandler := NewSearchHandler(searchService)
+	notificationHandler := NewNotificationHandler(notificationService)
+	dunningHandler := NewDunningHandler(dunningService)
+	webhookHandler := NewWebhookHandler(webhookService)
+	calendarHandler := NewCalendarHandler(calendarService)
 	authHandler := NewAuthHandler(authService)
 	adminHandler := NewAdminHandler(taskScheduler)
 
@@ -55,17 +90,80 @@ func SetupRoutes(r chi.Router,
 		})
 	})
 
-	// Rotas protegidas da aplicação (requerem autenticação)
+	// Rotas do portal do morador (somente leitura, limitadas aos dados do próprio morador)
+	r.Route("/api/v1/portal", func(r chi.Router) {
+		// Rota pública de login do morador
+		r.Post("/auth/login", portalHandler.Login)
+
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.Authenticate)
+			r.Use(authMiddleware.RequireTenant)
+
+			r.Get("/me", portalHandler.GetProfile)
+			r.Get("/leases", portalHandler.ListLeases)
+			r.Get("/payments", portalHandler.ListPayments)
+			r.Get("/payments/{id}/receipt", portalHandler.GetPaymentReceipt)
+			r.Get("/payments/{id}/pix", portalHandler.GetPaymentPix)
+			r.Get("/maintenance", portalHandler.ListMaintenanceTickets)
+		})
+	})
+
+	// Webhooks de provedores externos (autenticados por token próprio)
+	r.Route("/api/v1/webhooks", func(r chi.Router) {
+		r.Post("/messaging/status", notificationHandler.RecordDeliveryStatus)
+	})
+
+	// Feeds de calendário da equipe
+	r.Route("/api/v1/calendar", func(r chi.Router) {
+		// Feed público autenticado pelo token da URL (aplicativos de calendário não enviam o header Authorization)
+		r.Get("/feeds/{token}.ics", calendarHandler.ServeFeed)
+
+		r.Group(func(r chi.Router) {
+			r.Use(authMiddleware.Authenticate)
+			r.Use(authMiddleware.RequireStaff)
+
+			r.Get("/feed", calendarHandler.GetFeed)
+			r.Post("/feed", calendarHandler.CreateFeed)
+			r.Delete("/feed", calendarHandler.RevokeFeed)
+		})
+	})
+
+	// Rotas protegidas da aplicação (requerem autenticação da equipe)
 	r.Route("/api/v1", func(r chi.Router) {
 		// Aplicar middleware de autenticação em todas as rotas
 		r.Use(authMiddleware.Authenticate)
+		// Moradores do portal não acessam as rotas de gestão
+		r.Use(authMiddleware.RequireStaff)
+
+		// Busca global (todos autenticados)
+		r.Get("/search", searchHandler.Search)
+
+		// Rotas de imóveis (Admin e Manager podem escrever, todos podem ler)
+		r.Route("/properties", func(r chi.Router) {
+			// Rotas de leitura (todos autenticados)
+			r.Get("/", propertyHandler.ListProperties)
+			r.Get("/{id}", propertyHandler.GetProperty)
+
+			// Rotas de escrita (Admin e Manager apenas)
+			r.Group(func(r chi.Router) {
+				r.Use(authMiddleware.RequireAdminOrManager)
+				r.Post("/", propertyHandler.CreateProperty)
+				r.Put("/{id}", propertyHandler.UpdateProperty)
+				r.Delete("/{id}", propertyHandler.DeleteProperty)
+			})
+		})
 
 		// Rotas de unidades (Admin e Manager podem escrever, todos podem ler)
 		r.Route("/units", func(r chi.Router) {
 			// Rotas de leitura (todos autenticados)
 			r.Get("/", unitHandler.ListUnits)
 			r.Get("/stats/occupancy", unitHandler.GetOccupancyStats)
+			r.Get("/stats/occupancy-history", unitHandler.GetOccupancyHistory)
 			r.Get("/{id}", unitHandler.GetUnit)
+			r.Get("/{id}/history", unitHandler.GetUnitHistory)
+			r.Get("/{id}/inventory", inventoryHandler.ListUnitInventory)
+			r.Get("/{id}/meter-readings", utilityHandler.ListUnitReadings)
+			r.Get("/{id}/waitlist", prospectHandler.GetUnitWaitlist)
 
 			// Rotas de escrita (Admin e Manager apenas)
 			r.Group(func(r chi.Router) {
@@ -74,6 +172,11 @@ func SetupRoutes(r chi.Router,
 				r.Put("/{id}", unitHandler.UpdateUnit)
 				r.Patch("/{id}/status", unitHandler.UpdateUnitStatus)
 				r.Delete("/{id}", unitHandler.DeleteUnit)
+				r.Post("/{id}/inventory", inventoryHandler.AddInventoryItem)
+				r.Put("/{id}/inventory/{itemId}", inventoryHandler.UpdateInventoryItem)
+				r.Delete("/{id}/inventory/{itemId}", inventoryHandler.DeleteInventoryItem)
+				r.Post("/{id}/meter-readings", utilityHandler.RecordReading)
+				r.Delete("/{id}/meter-readings/{readingId}", utilityHandler.DeleteReading)
 			})
 		})
 
@@ -83,13 +186,36 @@ func SetupRoutes(r chi.Router,
 			r.Get("/", tenantHandler.ListTenants)
 			r.Get("/cpf", tenantHandler.GetTenantByCPF)
 			r.Get("/{id}", tenantHandler.GetTenant)
+			r.Get("/{id}/profile", tenantProfileHandler.GetTenantProfile)
+			r.Get("/{id}/payment-score", tenantScoreHandler.GetTenantPaymentScore)
+			r.Get("/{id}/documents", documentHandler.ListTenantDocuments)
+			r.Get("/{id}/documents/{documentId}/download", documentHandler.DownloadTenantDocument)
 
 			// Rotas de escrita
 			r.Group(func(r chi.Router) {
 				r.Use(authMiddleware.RequireAdminOrManager)
 				r.Post("/", tenantHandler.CreateTenant)
 				r.Put("/{id}", tenantHandler.UpdateTenant)
+				r.Put("/{id}/messaging-opt-out", tenantHandler.SetMessagingOptOut)
 				r.Delete("/{id}", tenantHandler.DeleteTenant)
+				r.Post("/{id}/portal-access", portalHandler.EnablePortalAccess)
+				r.Post("/{id}/portal-access/code", portalHandler.GeneratePortalLoginCode)
+				r.Put("/{id}/employer", tenantProfileHandler.UpdateTenantEmployer)
+				r.Delete("/{id}/employer", tenantProfileHandler.DeleteTenantEmployer)
+				r.Put("/{id}/emergency-contacts", tenantProfileHandler.ReplaceEmergencyContacts)
+				r.Put("/{id}/references", tenantProfileHandler.ReplaceReferences)
+				r.Put("/{id}/occupants", tenantProfileHandler.ReplaceOccupants)
+				r.Put("/{id}/pets", tenantProfileHandler.ReplacePets)
+				r.Post("/{id}/documents", documentHandler.UploadTenantDocument)
+				r.Delete("/{id}/documents/{documentId}", documentHandler.DeleteTenantDocument)
+			})
+
+			// Solicitações LGPD (apenas Admin)
+			r.Group(func(r chi.Router) {
+				r.Use(authMiddleware.RequireAdmin)
+				r.Get("/{id}/data-export", tenantPrivacyHandler.ExportTenantData)
+				r.Get("/{id}/data-requests", tenantPrivacyHandler.ListTenantDataRequests)
+				r.Post("/{id}/anonymize", tenantPrivacyHandler.AnonymizeTenant)
 			})
 		})
 
@@ -101,6 +227,10 @@ func SetupRoutes(r chi.Router,
 			r.Get("/expiring-soon", leaseHandler.GetExpiringSoonLeases)
 			r.Get("/{id}", leaseHandler.GetLease)
 			r.Get("/{id}/rent-adjustments", leaseHandler.GetLeaseRentAdjustments)
+			r.Get("/{id}/inventory", inventoryHandler.GetLeaseInventory)
+			r.Get("/{id}/deposit", depositHandler.GetDeposit)
+			r.Get("/{id}/documents", documentHandler.ListLeaseDocuments)
+			r.Get("/{id}/notifications", notificationHandler.ListLeaseNotifications)
 			r.Get("/{lease_id}/payments", paymentHandler.GetPaymentsByLease)
 			r.Get("/{lease_id}/payments/stats", paymentHandler.GetPaymentStatsByLease)
 			r.Get("/{lease_id}/cancellable-payments", paymentHandler.GetCancellablePayments)
@@ -114,6 +244,10 @@ func SetupRoutes(r chi.Router,
 				r.Post("/{id}/cancel-with-payments", leaseHandler.CancelLeaseWithPayments)
 				r.Post("/{id}/change-payment-due-day", leaseHandler.ChangePaymentDueDay)
 				r.Patch("/{id}/painting-fee", leaseHandler.UpdatePaintingFeePaid)
+				r.Post("/{id}/inventory/move-in", inventoryHandler.CreateMoveInChecklist)
+				r.Post("/{id}/inventory/move-out", inventoryHandler.CreateMoveOutChecklist)
+				r.Post("/{id}/deposit/settlement", depositHandler.SettleDeposit)
+				r.Post("/{id}/deposit/refund", depositHandler.RefundDeposit)
 			})
 		})
 
@@ -123,12 +257,89 @@ func SetupRoutes(r chi.Router,
 			r.Get("/overdue", paymentHandler.GetOverduePayments)
 			r.Get("/upcoming", paymentHandler.GetUpcomingPayments)
 			r.Get("/{id}", paymentHandler.GetPayment)
+			r.Get("/{id}/utility-charges", utilityHandler.GetPaymentCharges)
+			r.Get("/{id}/collection-history", dunningHandler.GetCollectionHistory)
 
 			// Rotas de escrita
 			r.Group(func(r chi.Router) {
 				r.Use(authMiddleware.RequireAdminOrManager)
 				r.Put("/{id}/pay", paymentHandler.MarkPaymentAsPaid)
 				r.Post("/{id}/cancel", paymentHandler.CancelPayment)
+				r.Post("/{id}/receipt/send", notificationHandler.SendPaymentReceipt)
+			})
+		})
+
+		// Rotas de manutenção (Admin e Manager podem escrever, todos podem ler)
+		r.Route("/maintenance", func(r chi.Router) {
+			// Rotas de leitura
+			r.Get("/", maintenanceHandler.ListTickets)
+			r.Get("/{id}", maintenanceHandler.GetTicket)
+
+			// Rotas de escrita
+			r.Group(func(r chi.Router) {
+				r.Use(authMiddleware.RequireAdminOrManager)
+				r.Post("/", maintenanceHandler.CreateTicket)
+				r.Put("/{id}", maintenanceHandler.UpdateTicket)
+				r.Post("/{id}/assign", maintenanceHandler.AssignContractor)
+				r.Post("/{id}/start", maintenanceHandler.StartTicket)
+				r.Post("/{id}/resolve", maintenanceHandler.ResolveTicket)
+				r.Post("/{id}/close", maintenanceHandler.CloseTicket)
+				r.Post("/{id}/cancel", maintenanceHandler.CancelTicket)
+				r.Post("/{id}/photos", maintenanceHandler.AddPhoto)
+			})
+		})
+
+		// Rotas de interessados (Admin e Manager podem escrever, todos podem ler)
+		r.Route("/prospects", func(r chi.Router) {
+			// Rotas de leitura
+			r.Get("/", prospectHandler.ListProspects)
+			r.Get("/visits/upcoming", prospectHandler.ListUpcomingVisits)
+			r.Get("/{id}", prospectHandler.GetProspect)
+			r.Get("/{id}/visits", prospectHandler.ListVisits)
+			r.Get("/{id}/matches", prospectHandler.MatchUnits)
+
+			// Rotas de escrita
+			r.Group(func(r chi.Router) {
+				r.Use(authMiddleware.RequireAdminOrManager)
+				r.Post("/", prospectHandler.CreateProspect)
+				r.Put("/{id}", prospectHandler.UpdateProspect)
+				r.Patch("/{id}/status", prospectHandler.ChangeProspectStatus)
+				r.Delete("/{id}", prospectHandler.DeleteProspect)
+				r.Post("/{id}/visits", prospectHandler.ScheduleVisit)
+				r.Patch("/visits/{visitId}", prospectHandler.RecordVisitOutcome)
+				r.Post("/{id}/convert", prospectHandler.ConvertProspect)
+			})
+		})
+
+		// Rotas de reformas (Admin e Manager podem escrever, todos podem ler)
+		r.Route("/renovations", func(r chi.Router) {
+			// Rotas de leitura
+			r.Get("/", renovationHandler.ListProjects)
+			r.Get("/{id}", renovationHandler.GetProject)
+
+			// Rotas de escrita
+			r.Group(func(r chi.Router) {
+				r.Use(authMiddleware.RequireAdminOrManager)
+				r.Post("/", renovationHandler.CreateProject)
+				r.Put("/{id}", renovationHandler.UpdateProject)
+				r.Post("/{id}/start", renovationHandler.StartProject)
+				r.Post("/{id}/complete", renovationHandler.CompleteProject)
+				r.Post("/{id}/cancel", renovationHandler.CancelProject)
+				r.Post("/{id}/expenses", renovationHandler.AddExpense)
+				r.Delete("/{id}/expenses/{expenseId}", renovationHandler.DeleteExpense)
+			})
+		})
+
+		// Rotas de consumo (Admin e Manager podem escrever, todos podem ler)
+		r.Route("/utilities", func(r chi.Router) {
+			// Rotas de leitura
+			r.Get("/tariffs", utilityHandler.ListTariffs)
+
+			// Rotas de escrita
+			r.Group(func(r chi.Router) {
+				r.Use(authMiddleware.RequireAdminOrManager)
+				r.Post("/tariffs", utilityHandler.CreateTariff)
+				r.Post("/billing-runs", utilityHandler.RunBilling)
 			})
 		})
 
@@ -138,7 +349,50 @@ func SetupRoutes(r chi.Router,
 		// Rotas de relatórios (todos podem ler)
 		r.Route("/reports", func(r chi.Router) {
 			r.Get("/financial", reportHandler.GetFinancialReport)
+			r.Get("/financial.pdf", reportHandler.GetFinancialReportPDF)
 			r.Get("/payments", reportHandler.GetPaymentHistoryReport)
+			r.Get("/payments.pdf", reportHandler.GetPaymentHistoryReportPDF)
+			r.Get("/aging", reportHandler.GetAgingReport)
+		})
+
+		// Rotas de notificações (todos podem ler, apenas Admin processa a fila)
+		r.Route("/notifications", func(r chi.Router) {
+			r.Get("/", notificationHandler.ListNotifications)
+
+			r.Group(func(r chi.Router) {
+				r.Use(authMiddleware.RequireAdmin)
+				r.Post("/process", notificationHandler.ProcessNotifications)
+				r.Post("/bounces", notificationHandler.RecordBounce)
+			})
+		})
+
+		// Rotas da régua de cobrança (todos podem ler, apenas Admin altera e executa)
+		r.Route("/dunning", func(r chi.Router) {
+			r.Get("/steps", dunningHandler.ListSteps)
+			r.Get("/eviction-reviews", dunningHandler.ListEvictionReviews)
+
+			r.Group(func(r chi.Router) {
+				r.Use(authMiddleware.RequireAdmin)
+				r.Post("/steps", dunningHandler.CreateStep)
+				r.Put("/steps/{id}", dunningHandler.UpdateStep)
+				r.Post("/run", dunningHandler.RunDunning)
+			})
+		})
+
+		// Rotas de webhooks de saída (apenas Admin)
+		r.Route("/webhook-endpoints", func(r chi.Router) {
+			r.Use(authMiddleware.RequireAdmin)
+			r.Get("/", webhookHandler.ListEndpoints)
+			r.Post("/", webhookHandler.CreateEndpoint)
+			r.Get("/{id}", webhookHandler.GetEndpoint)
+			r.Put("/{id}", webhookHandler.UpdateEndpoint)
+			r.Delete("/{id}", webhookHandler.DeleteEndpoint)
+			r.Post("/{id}/rotate-secret", webhookHandler.RotateSecret)
+			r.Get("/{id}/deliveries", webhookHandler.ListDeliveries)
+		})
+		r.Route("/webhook-deliveries", func(r chi.Router) {
+			r.Use(authMiddleware.RequireAdmin)
+			r.Post("/{id}/replay", webhookHandler.ReplayDelivery)
 		})
 
 		// Rotas administrativas (Admin apenas)
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+)
+
+// SearchResultResponse representa um item da busca global
+type SearchResultResponse struct {
+	Type     string    `json:"type"` // tenant, unit, lease ou payment
+	ID       uuid.UUID `json:"id"`
+	Title    string    `json:"title"`
+	Subtitle string    `json:"subtitle,omitempty"`
+	Status   string    `json:"status,omitempty"`
+	Rank     float64   `json:"rank"`
+}
+
+// ToSearchResultResponseList converte slice de resultados para slice de responses
+func ToSearchResultResponseList(results []*domain.SearchResult) []*SearchResultResponse {
+	responses := make([]*SearchResultResponse, len(results))
+	for i, result := range results {
+		responses[i] = &SearchResultResponse{
+			Type:     string(result.Type),
+			ID:       result.ID,
+			Title:    result.Title,
+			Subtitle: result.Subtitle,
+			Status:   result.Status,
+			Rank:     result.Rank,
+		}
+	}
+	return responses
+}
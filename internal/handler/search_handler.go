@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// SearchHandler lida com requisições HTTP da busca global
+type SearchHandler struct {
+	searchService *service.SearchService
+}
+
+// NewSearchHandler cria uma nova instância do handler
+func NewSearchHandler(searchService *service.SearchService) *SearchHandler {
+	return &SearchHandler{
+		searchService: searchService,
+	}
+}
+
+// Search godoc
+// @Summary      Busca global
+// @Description  Busca moradores (nome, CPF, telefone, e-mail), unidades (número), contratos (morador ou unidade) e pagamentos (valor ou mês de referência MM/AAAA). Retorna resultados tipados ordenados por relevância
+// @Tags         Search
+// @Produce      json
+// @Param        q query string true "Termo de busca (mínimo 2 caracteres)"
+// @Param        types query string false "Tipos separados por vírgula (tenant,unit,lease,payment)"
+// @Param        limit query int false "Máximo de resultados por tipo (padrão 5, máximo 20)"
+// @Success      200 {array} SearchResultResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /search [get]
+func (h *SearchHandler) Search(w http.ResponseWriter, r *http.Request) {
+	var types []domain.SearchResultType
+	if typesFilter := r.URL.Query().Get("types"); typesFilter != "" {
+		for _, value := range strings.Split(typesFilter, ",") {
+			resultType := domain.SearchResultType(strings.TrimSpace(value))
+			if !domain.IsValidSearchResultType(resultType) {
+				response.Error(w, http.StatusBadRequest, domain.ErrInvalidSearchType.Error())
+				return
+			}
+			types = append(types, resultType)
+		}
+	}
+
+	limit := 0
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit <= 0 {
+			response.Error(w, http.StatusBadRequest, "Invalid limit parameter")
+			return
+		}
+		limit = parsedLimit
+	}
+
+	results, err := h.searchService.Search(r.Context(), r.URL.Query().Get("q"), types, limit)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Search completed successfully", ToSearchResultResponseList(results))
+}
+
+// handleServiceError mapeia erros do service para respostas HTTP
+func (h *SearchHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrSearchQueryTooShort),
+		errors.Is(err, domain.ErrSearchQueryTooLong),
+		errors.Is(err, domain.ErrInvalidSearchType):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
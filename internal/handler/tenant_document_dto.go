@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+)
+
+// TenantDocumentUploadForm representa os campos do formulário multipart de envio de documento
+// O arquivo é enviado no campo "file"
+type TenantDocumentUploadForm struct {
+	DocumentType string `validate:"required,oneof=id_rg id_cnh proof_of_income signed_contract move_in_inspection other"`
+	LeaseID      string `validate:"omitempty,uuid"`
+	ExpiresAt    string `validate:"omitempty,datetime=2006-01-02"`
+}
+
+// TenantDocumentResponse representa a resposta com os dados de um documento do morador
+type TenantDocumentResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	TenantID     uuid.UUID  `json:"tenant_id"`
+	LeaseID      *uuid.UUID `json:"lease_id,omitempty"`
+	DocumentType string     `json:"document_type"`
+	ExpiresAt    *string    `json:"expires_at,omitempty"`
+	IsExpired    bool       `json:"is_expired"`
+	FileName     string     `json:"file_name"`
+	ContentType  string     `json:"content_type"`
+	SizeBytes    int64      `json:"size_bytes"`
+	Checksum     string     `json:"checksum"`
+	UploadedBy   *uuid.UUID `json:"uploaded_by,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// ToTenantDocumentResponse converte domain.TenantDocument para TenantDocumentResponse
+func ToTenantDocumentResponse(doc *domain.TenantDocument) *TenantDocumentResponse {
+	return &TenantDocumentResponse{
+		ID:           doc.ID,
+		TenantID:     doc.TenantID,
+		LeaseID:      doc.LeaseID,
+		DocumentType: string(doc.Kind),
+		ExpiresAt:    formatOptionalDate(doc.ExpiresAt),
+		IsExpired:    doc.IsExpired(time.Now()),
+		FileName:     doc.FileName,
+		ContentType:  doc.ContentType,
+		SizeBytes:    doc.SizeBytes,
+		Checksum:     doc.Checksum,
+		UploadedBy:   doc.UploadedBy,
+		CreatedAt:    doc.CreatedAt,
+	}
+}
+
+// ToTenantDocumentResponseList converte uma lista de documentos
+func ToTenantDocumentResponseList(docs []*domain.TenantDocument) []*TenantDocumentResponse {
+	result := make([]*TenantDocumentResponse, len(docs))
+	for i, doc := range docs {
+		result[i] = ToTenantDocumentResponse(doc)
+	}
+	return result
+}
@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+const (
+	// maxDocumentUploadSize limita o tamanho de cada arquivo enviado (10 MB)
+	maxDocumentUploadSize = 10 << 20
+	// documentFormMemory é a parte do formulário mantida em memória; o restante vai para disco
+	documentFormMemory = 1 << 20
+)
+
+// TenantDocumentHandler lida com requisições HTTP dos documentos dos moradores
+type TenantDocumentHandler struct {
+	documentService *service.TenantDocumentService
+	validator       *validator.Validate
+}
+
+// NewTenantDocumentHandler cria uma nova instância do handler
+func NewTenantDocumentHandler(documentService *service.TenantDocumentService) *TenantDocumentHandler {
+	return &TenantDocumentHandler{
+		documentService: documentService,
+		validator:       validator.New(),
+	}
+}
+
+// ListTenantDocuments godoc
+// @Summary      Listar documentos do morador
+// @Description  Retorna os arquivos do morador (documento de identidade, comprovante de renda, contrato assinado, vistoria)
+// @Tags         Tenants
+// @Produce      json
+// @Param        id path string true "Tenant ID (UUID)"
+// @Success      200 {array} TenantDocumentResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/documents [get]
+func (h *TenantDocumentHandler) ListTenantDocuments(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+
+	docs, err := h.documentService.ListByTenant(r.Context(), tenantID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Documents retrieved successfully", ToTenantDocumentResponseList(docs))
+}
+
+// ListLeaseDocuments godoc
+// @Summary      Listar documentos do contrato
+// @Description  Retorna os arquivos vinculados ao contrato (contrato assinado, vistoria de entrada, etc.)
+// @Tags         Leases
+// @Produce      json
+// @Param        id path string true "Lease ID (UUID)"
+// @Success      200 {array} TenantDocumentResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /leases/{id}/documents [get]
+func (h *TenantDocumentHandler) ListLeaseDocuments(w http.ResponseWriter, r *http.Request) {
+	leaseID, ok := parseUUIDParam(w, r, "id", "Invalid lease ID")
+	if !ok {
+		return
+	}
+
+	docs, err := h.documentService.ListByLease(r.Context(), leaseID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Documents retrieved successfully", ToTenantDocumentResponseList(docs))
+}
+
+// UploadTenantDocument godoc
+// @Summary      Enviar documento do morador
+// @Description  Envia um arquivo (até 10 MB) para o morador. Contrato assinado e vistoria de entrada exigem o contrato (lease_id)
+// @Tags         Tenants
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id path string true "Tenant ID (UUID)"
+// @Param        file formData file true "Arquivo"
+// @Param        document_type formData string true "Tipo: id_rg, id_cnh, proof_of_income, signed_contract, move_in_inspection, other"
+// @Param        lease_id formData string false "Contrato vinculado (UUID)"
+// @Param        expires_at formData string false "Validade do documento (YYYY-MM-DD)"
+// @Success      201 {object} TenantDocumentResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      413 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/documents [post]
+func (h *TenantDocumentHandler) UploadTenantDocument(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+
+	// Margem de 1 MB para os demais campos e cabeçalhos do formulário
+	r.Body = http.MaxBytesReader(w, r.Body, maxDocumentUploadSize+documentFormMemory)
+	if err := r.ParseMultipartForm(documentFormMemory); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			response.Error(w, http.StatusRequestEntityTooLarge, "File exceeds the 10 MB limit")
+			return
+		}
+		response.Error(w, http.StatusBadRequest, "Invalid multipart form")
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	form := TenantDocumentUploadForm{
+		DocumentType: r.FormValue("document_type"),
+		LeaseID:      r.FormValue("lease_id"),
+		ExpiresAt:    r.FormValue("expires_at"),
+	}
+	if err := h.validator.Struct(form); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Missing file")
+		return
+	}
+	defer file.Close()
+
+	if header.Size > maxDocumentUploadSize {
+		response.Error(w, http.StatusRequestEntityTooLarge, "File exceeds the 10 MB limit")
+		return
+	}
+
+	req := service.UploadTenantDocumentRequest{
+		Kind:        domain.TenantDocumentKind(form.DocumentType),
+		FileName:    header.Filename,
+		ContentType: header.Header.Get("Content-Type"),
+		Content:     file,
+		ExpiresAt:   parseOptionalDate(form.ExpiresAt),
+		UploadedBy:  currentUserID(r),
+	}
+	if form.LeaseID != "" {
+		leaseID := uuid.MustParse(form.LeaseID)
+		req.LeaseID = &leaseID
+	}
+
+	doc, err := h.documentService.Upload(r.Context(), tenantID, req)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Document uploaded successfully", ToTenantDocumentResponse(doc))
+}
+
+// DownloadTenantDocument godoc
+// @Summary      Baixar documento do morador
+// @Description  Retorna o conteúdo do arquivo. O header ETag traz o checksum SHA-256
+// @Tags         Tenants
+// @Produce      application/octet-stream
+// @Param        id path string true "Tenant ID (UUID)"
+// @Param        documentId path string true "Document ID (UUID)"
+// @Success      200 {file} file
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/documents/{documentId}/download [get]
+func (h *TenantDocumentHandler) DownloadTenantDocument(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+	documentID, ok := parseUUIDParam(w, r, "documentId", "Invalid document ID")
+	if !ok {
+		return
+	}
+
+	doc, content, err := h.documentService.Open(r.Context(), tenantID, documentID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", doc.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(doc.SizeBytes, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": doc.FileName}))
+	w.Header().Set("ETag", strconv.Quote(doc.Checksum))
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := io.Copy(w, content); err != nil {
+		// Cabeçalhos já enviados: apenas registrar a falha
+		log.Printf("Warning: failed to stream document %s: %v", doc.ID, err)
+	}
+}
+
+// DeleteTenantDocument godoc
+// @Summary      Remover documento do morador
+// @Description  Remove o registro e o arquivo armazenado
+// @Tags         Tenants
+// @Produce      json
+// @Param        id path string true "Tenant ID (UUID)"
+// @Param        documentId path string true "Document ID (UUID)"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/documents/{documentId} [delete]
+func (h *TenantDocumentHandler) DeleteTenantDocument(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+	documentID, ok := parseUUIDParam(w, r, "documentId", "Invalid document ID")
+	if !ok {
+		return
+	}
+
+	if err := h.documentService.Delete(r.Context(), tenantID, documentID); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Document deleted successfully", nil)
+}
+
+// handleServiceError mapeia erros do service para respostas HTTP
+func (h *TenantDocumentHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrTenantNotFound),
+		errors.Is(err, service.ErrLeaseNotFound),
+		errors.Is(err, service.ErrTenantDocumentNotFound):
+		response.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrTenantAnonymized):
+		response.Error(w, http.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrDocumentLeaseMismatch),
+		errors.Is(err, domain.ErrInvalidTenantDocumentKind),
+		errors.Is(err, domain.ErrInvalidDocumentFileName),
+		errors.Is(err, domain.ErrEmptyDocumentFile),
+		errors.Is(err, domain.ErrDocumentRequiresLease):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
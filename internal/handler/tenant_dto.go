@@ -8,7 +8,7 @@ import (
 // CreateTenantRequest representa o payload para criar um morador
 type CreateTenantRequest struct {
 	FullName         string `json:"full_name" validate:"required,min=3,max=255"`
-	CPF              string `json:"cpf" validate:"required,len=14"` // Formato: XXX.XXX.XXX-XX
+	CPF              string `json:"cpf" validate:"required,min=11,max=18"` // CPF ou CNPJ, com ou sem formatação
 	Phone            string `json:"phone" validate:"required,min=10,max=20"`
 	Email            string `json:"email" validate:"omitempty,email,max=255"`
 	IDDocumentType   string `json:"id_document_type" validate:"omitempty,max=10"`
@@ -24,31 +24,46 @@ type UpdateTenantRequest struct {
 	IDDocumentNumber string `json:"id_document_number" validate:"omitempty,max=50"`
 }
 
+// SetMessagingOptOutRequest representa o payload para (des)ativar mensagens por WhatsApp/SMS
+type SetMessagingOptOutRequest struct {
+	OptOut *bool `json:"opt_out" validate:"required"`
+}
+
 // TenantResponse representa a resposta com dados de um morador
 type TenantResponse struct {
-	ID               uuid.UUID `json:"id"`
-	FullName         string    `json:"full_name"`
-	CPF              string    `json:"cpf"`
-	Phone            string    `json:"phone"`
-	Email            string    `json:"email,omitempty"`
-	IDDocumentType   string    `json:"id_document_type,omitempty"`
-	IDDocumentNumber string    `json:"id_document_number,omitempty"`
-	CreatedAt        string    `json:"created_at"`
-	UpdatedAt        string    `json:"updated_at"`
+	ID                uuid.UUID               `json:"id"`
+	FullName          string                  `json:"full_name"`
+	CPF               string                  `json:"cpf"`
+	DocumentType      string                  `json:"document_type"` // cpf ou cnpj
+	Phone             string                  `json:"phone"`
+	Email             string                  `json:"email,omitempty"`
+	IDDocumentType    string                  `json:"id_document_type,omitempty"`
+	IDDocumentNumber  string                  `json:"id_document_number,omitempty"`
+	Employer          *TenantEmployerResponse `json:"employer,omitempty"`
+	AnonymizedAt      *string                 `json:"anonymized_at,omitempty"`
+	MessagingOptOut   bool                    `json:"messaging_opt_out"`
+	MessagingOptOutAt *string                 `json:"messaging_opt_out_at,omitempty"`
+	CreatedAt         string                  `json:"created_at"`
+	UpdatedAt         string                  `json:"updated_at"`
 }
 
 // ToTenantResponse converte domain.Tenant para TenantResponse
 func ToTenantResponse(tenant *domain.Tenant) *TenantResponse {
 	return &TenantResponse{
-		ID:               tenant.ID,
-		FullName:         tenant.FullName,
-		CPF:              tenant.CPF,
-		Phone:            tenant.Phone,
-		Email:            tenant.Email,
-		IDDocumentType:   tenant.IDDocumentType,
-		IDDocumentNumber: tenant.IDDocumentNumber,
-		CreatedAt:        tenant.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:        tenant.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:                tenant.ID,
+		FullName:          tenant.FullName,
+		CPF:               tenant.CPF,
+		DocumentType:      string(tenant.DocumentType()),
+		Phone:             tenant.Phone,
+		Email:             tenant.Email,
+		IDDocumentType:    tenant.IDDocumentType,
+		IDDocumentNumber:  tenant.IDDocumentNumber,
+		Employer:          ToTenantEmployerResponse(tenant.Employer),
+		AnonymizedAt:      formatOptionalTimestamp(tenant.AnonymizedAt),
+		MessagingOptOut:   tenant.HasMessagingOptOut(),
+		MessagingOptOutAt: formatOptionalTimestamp(tenant.MessagingOptOutAt),
+		CreatedAt:         tenant.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:         tenant.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
 
@@ -60,3 +75,42 @@ func ToTenantResponseList(tenants []*domain.Tenant) []*TenantResponse {
 	}
 	return responses
 }
+
+// TenantPaymentScoreResponse representa a pontuação de pagamento do morador
+type TenantPaymentScoreResponse struct {
+	TenantID          uuid.UUID `json:"tenant_id"`
+	Score             int       `json:"score"`
+	Rating            string    `json:"rating"`
+	BelowThreshold    bool      `json:"below_threshold"`
+	Threshold         int       `json:"threshold"`
+	EvaluatedPayments int       `json:"evaluated_payments"`
+	OnTimePayments    int       `json:"on_time_payments"`
+	LatePayments      int       `json:"late_payments"`
+	OnTimeRatio       float64   `json:"on_time_ratio"`
+	AverageDaysLate   float64   `json:"average_days_late"`
+	Renegotiations    int       `json:"renegotiations"`
+	CancelledLeases   int       `json:"cancelled_leases"`
+	CalculatedAt      string    `json:"calculated_at"`
+}
+
+// ToTenantPaymentScoreResponse converte domain.TenantPaymentScore (nil se não calculada)
+func ToTenantPaymentScoreResponse(score *domain.TenantPaymentScore) *TenantPaymentScoreResponse {
+	if score == nil {
+		return nil
+	}
+	return &TenantPaymentScoreResponse{
+		TenantID:          score.TenantID,
+		Score:             score.Score,
+		Rating:            string(score.Rating),
+		BelowThreshold:    score.IsBelowThreshold(),
+		Threshold:         domain.LowPaymentScoreThreshold,
+		EvaluatedPayments: score.EvaluatedPayments,
+		OnTimePayments:    score.OnTimePayments,
+		LatePayments:      score.LatePayments,
+		OnTimeRatio:       score.OnTimeRatio,
+		AverageDaysLate:   score.AverageDaysLate,
+		Renegotiations:    score.Renegotiations,
+		CancelledLeases:   score.CancelledLeases,
+		CalculatedAt:      score.CalculatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -106,11 +106,11 @@ func (h *TenantHandler) GetTenant(w http.ResponseWriter, r *http.Request) {
 
 // GetTenantByCPF godoc
 // @Summary      Buscar morador por CPF
-// @Description  Retorna os dados de um morador pelo CPF
+// @Description  Retorna os dados de um morador pelo CPF ou CNPJ (com ou sem formatação)
 // @Tags         Tenants
 // @Produce      json
 // @Security     BearerAuth
-// @Param        cpf query string true "CPF (formato: XXX.XXX.XXX-XX)"
+// @Param        cpf query string true "CPF ou CNPJ (ex: XXX.XXX.XXX-XX ou XX.XXX.XXX/XXXX-XX)"
 // @Success      200 {object} TenantResponse
 // @Failure      400 {object} response.ErrorResponse
 // @Failure      404 {object} response.ErrorResponse
@@ -135,19 +135,25 @@ func (h *TenantHandler) GetTenantByCPF(w http.ResponseWriter, r *http.Request) {
 
 // ListTenants godoc
 // @Summary      Listar moradores
-// @Description  Retorna lista de moradores com opção de busca por nome
+// @Description  Retorna lista de moradores com opção de busca por nome e filtro por imóvel
 // @Tags         Tenants
 // @Produce      json
 // @Security     BearerAuth
 // @Param        name query string false "Search by name (case-insensitive)"
+// @Param        property_id query string false "Filter by property ID (UUID)"
 // @Success      200 {array} TenantResponse
+// @Failure      400 {object} response.ErrorResponse
 // @Failure      500 {object} response.ErrorResponse
 // @Router       /tenants [get]
 func (h *TenantHandler) ListTenants(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// Verificar filtro de nome na query string
+	// Verificar filtros na query string
 	nameFilter := r.URL.Query().Get("name")
+	propertyID, ok := parseOptionalPropertyID(w, r)
+	if !ok {
+		return
+	}
 
 	var tenants []*domain.Tenant
 	var err error
@@ -155,6 +161,8 @@ func (h *TenantHandler) ListTenants(w http.ResponseWriter, r *http.Request) {
 	// Aplicar filtro se fornecido
 	if nameFilter != "" {
 		tenants, err = h.tenantService.SearchTenantsByName(ctx, nameFilter)
+	} else if propertyID != nil {
+		tenants, err = h.tenantService.ListTenantsByProperty(ctx, *propertyID)
 	} else {
 		tenants, err = h.tenantService.ListTenants(ctx)
 	}
@@ -164,6 +172,26 @@ func (h *TenantHandler) ListTenants(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Restringir a busca por nome aos moradores do imóvel
+	if propertyID != nil && nameFilter != "" {
+		propertyTenants, err := h.tenantService.ListTenantsByProperty(ctx, *propertyID)
+		if err != nil {
+			h.handleServiceError(w, err)
+			return
+		}
+		inProperty := make(map[uuid.UUID]bool, len(propertyTenants))
+		for _, tenant := range propertyTenants {
+			inProperty[tenant.ID] = true
+		}
+		scoped := make([]*domain.Tenant, 0, len(tenants))
+		for _, tenant := range tenants {
+			if inProperty[tenant.ID] {
+				scoped = append(scoped, tenant)
+			}
+		}
+		tenants = scoped
+	}
+
 	response.Success(w, http.StatusOK, "Tenants retrieved successfully", ToTenantResponseList(tenants))
 }
 
@@ -220,6 +248,46 @@ func (h *TenantHandler) UpdateTenant(w http.ResponseWriter, r *http.Request) {
 	response.Success(w, http.StatusOK, "Tenant updated successfully", ToTenantResponse(tenant))
 }
 
+// SetMessagingOptOut godoc
+// @Summary      Opt-out de mensagens
+// @Description  Define se o morador deixa de receber notificações por WhatsApp/SMS
+// @Tags         Tenants
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Tenant ID (UUID)"
+// @Param        request body SetMessagingOptOutRequest true "Preferência de mensagens"
+// @Success      200 {object} TenantResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Router       /tenants/{id}/messaging-opt-out [put]
+func (h *TenantHandler) SetMessagingOptOut(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid tenant ID")
+		return
+	}
+
+	var req SetMessagingOptOutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tenant, err := h.tenantService.SetMessagingOptOut(r.Context(), id, *req.OptOut)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Messaging preference updated successfully", ToTenantResponse(tenant))
+}
+
 // DeleteTenant godoc
 // @Summary      Deletar morador
 // @Description  Remove um morador do sistema
@@ -253,10 +321,14 @@ func (h *TenantHandler) handleServiceError(w http.ResponseWriter, err error) {
 	switch {
 	case errors.Is(err, service.ErrTenantNotFound):
 		response.Error(w, http.StatusNotFound, err.Error())
-	case errors.Is(err, service.ErrCPFAlreadyExists):
+	case errors.Is(err, service.ErrCPFAlreadyExists),
+		errors.Is(err, domain.ErrTenantAnonymized):
 		response.Error(w, http.StatusConflict, err.Error())
 	case errors.Is(err, domain.ErrInvalidFullName),
 		errors.Is(err, domain.ErrInvalidCPF),
+		errors.Is(err, domain.ErrInvalidCPFDigits),
+		errors.Is(err, domain.ErrInvalidCPFCheck),
+		errors.Is(err, domain.ErrInvalidCNPJCheck),
 		errors.Is(err, domain.ErrInvalidPhone),
 		errors.Is(err, domain.ErrInvalidEmail):
 		response.Error(w, http.StatusBadRequest, err.Error())
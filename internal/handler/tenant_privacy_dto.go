@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// AnonymizeTenantRequest representa o payload opcional da anonimização
+type AnonymizeTenantRequest struct {
+	Notes *string `json:"notes,omitempty" validate:"omitempty,max=1000"` // ex: protocolo da solicitação do titular
+}
+
+// TenantDataRequestResponse representa um registro de solicitação LGPD
+type TenantDataRequestResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	TenantID    uuid.UUID  `json:"tenant_id"`
+	RequestType string     `json:"request_type"`
+	PerformedBy *uuid.UUID `json:"performed_by,omitempty"`
+	PerformedAt time.Time  `json:"performed_at"`
+	Notes       *string    `json:"notes,omitempty"`
+}
+
+// TenantLeaseExportResponse representa um contrato e seus pagamentos na exportação
+type TenantLeaseExportResponse struct {
+	Lease    *LeaseResponse     `json:"lease"`
+	Payments []*PaymentResponse `json:"payments"`
+}
+
+// TenantDataExportResponse representa a exportação completa dos dados do morador
+type TenantDataExportResponse struct {
+	GeneratedAt        time.Time                    `json:"generated_at"`
+	Tenant             *TenantResponse              `json:"tenant"`
+	Contacts           []*TenantContactResponse     `json:"contacts"`
+	Occupants          []*TenantOccupantResponse    `json:"occupants"`
+	Pets               []*TenantPetResponse         `json:"pets"`
+	Documents          []*TenantDocumentResponse    `json:"documents"`
+	Leases             []*TenantLeaseExportResponse `json:"leases"`
+	MaintenanceTickets []*MaintenanceTicketResponse `json:"maintenance_tickets"`
+	DataRequests       []*TenantDataRequestResponse `json:"data_requests"`
+}
+
+// ToTenantDataRequestResponseList converte uma lista de solicitações LGPD
+func ToTenantDataRequestResponseList(requests []*domain.TenantDataRequest) []*TenantDataRequestResponse {
+	result := make([]*TenantDataRequestResponse, len(requests))
+	for i, req := range requests {
+		result[i] = &TenantDataRequestResponse{
+			ID:          req.ID,
+			TenantID:    req.TenantID,
+			RequestType: string(req.Type),
+			PerformedBy: req.PerformedBy,
+			PerformedAt: req.PerformedAt,
+			Notes:       req.Notes,
+		}
+	}
+	return result
+}
+
+// ToTenantDataExportResponse converte service.TenantDataExport para TenantDataExportResponse
+func ToTenantDataExportResponse(export *service.TenantDataExport) *TenantDataExportResponse {
+	leases := make([]*TenantLeaseExportResponse, len(export.Leases))
+	for i, l := range export.Leases {
+		leases[i] = &TenantLeaseExportResponse{
+			Lease:    ToLeaseResponse(l.Lease),
+			Payments: ToPaymentResponseList(l.Payments),
+		}
+	}
+
+	return &TenantDataExportResponse{
+		GeneratedAt:        export.GeneratedAt,
+		Tenant:             ToTenantResponse(export.Tenant),
+		Contacts:           ToTenantContactResponseList(export.Contacts),
+		Occupants:          ToTenantOccupantResponseList(export.Occupants),
+		Pets:               ToTenantPetResponseList(export.Pets),
+		Documents:          ToTenantDocumentResponseList(export.Documents),
+		Leases:             leases,
+		MaintenanceTickets: ToMaintenanceTicketResponseList(export.MaintenanceTickets),
+		DataRequests:       ToTenantDataRequestResponseList(export.DataRequests),
+	}
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// TenantPrivacyHandler lida com as solicitações LGPD do titular dos dados
+type TenantPrivacyHandler struct {
+	privacyService *service.TenantPrivacyService
+	validator      *validator.Validate
+}
+
+// NewTenantPrivacyHandler cria uma nova instância do handler
+func NewTenantPrivacyHandler(privacyService *service.TenantPrivacyService) *TenantPrivacyHandler {
+	return &TenantPrivacyHandler{
+		privacyService: privacyService,
+		validator:      validator.New(),
+	}
+}
+
+// ExportTenantData godoc
+// @Summary      Exportar dados do morador (LGPD)
+// @Description  Gera um arquivo JSON com todos os dados pessoais do morador, contratos, pagamentos, documentos (metadados), chamados e histórico de solicitações. A exportação fica registrada
+// @Tags         Tenants
+// @Produce      json
+// @Param        id path string true "Tenant ID (UUID)"
+// @Success      200 {object} TenantDataExportResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/data-export [get]
+func (h *TenantPrivacyHandler) ExportTenantData(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+
+	export, err := h.privacyService.ExportTenantData(r.Context(), tenantID, currentUserID(r))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	fileName := "tenant-" + tenantID.String() + "-data.json"
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+	response.JSON(w, http.StatusOK, ToTenantDataExportResponse(export))
+}
+
+// AnonymizeTenant godoc
+// @Summary      Anonimizar ex-morador (LGPD)
+// @Description  Remove nome, CPF/CNPJ, telefone, e-mail, documentos, empregador, contatos, ocupantes, animais e acesso ao portal. Contratos e pagamentos são mantidos para a contabilidade. Exige que o morador não tenha contrato ativo nem pagamentos em aberto
+// @Tags         Tenants
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Tenant ID (UUID)"
+// @Param        request body AnonymizeTenantRequest false "Observações (ex: protocolo da solicitação)"
+// @Success      200 {object} TenantResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/anonymize [post]
+func (h *TenantPrivacyHandler) AnonymizeTenant(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+
+	// O corpo é opcional
+	var req AnonymizeTenantRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tenant, err := h.privacyService.AnonymizeTenant(r.Context(), tenantID, currentUserID(r), req.Notes)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Tenant anonymized successfully", ToTenantResponse(tenant))
+}
+
+// ListTenantDataRequests godoc
+// @Summary      Histórico de solicitações LGPD
+// @Description  Lista quando e por quem os dados do morador foram exportados ou anonimizados
+// @Tags         Tenants
+// @Produce      json
+// @Param        id path string true "Tenant ID (UUID)"
+// @Success      200 {array} TenantDataRequestResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/data-requests [get]
+func (h *TenantPrivacyHandler) ListTenantDataRequests(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+
+	requests, err := h.privacyService.ListDataRequests(r.Context(), tenantID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Data requests retrieved successfully", ToTenantDataRequestResponseList(requests))
+}
+
+// handleServiceError mapeia erros do service para respostas HTTP
+func (h *TenantPrivacyHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrTenantNotFound):
+		response.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrTenantAlreadyAnonymized),
+		errors.Is(err, service.ErrCannotAnonymizeActiveTenant),
+		errors.Is(err, service.ErrCannotAnonymizeOpenBalance):
+		response.Error(w, http.StatusConflict, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
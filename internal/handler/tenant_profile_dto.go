@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+	"github.com/shopspring/decimal"
+)
+
+// TenantEmployerRequest representa o payload com os dados do empregador do morador
+type TenantEmployerRequest struct {
+	CompanyName   string           `json:"company_name" validate:"required,min=2,max=150"`
+	Phone         *string          `json:"phone,omitempty" validate:"omitempty,min=10,max=20"`
+	JobTitle      *string          `json:"job_title,omitempty" validate:"omitempty,max=100"`
+	MonthlyIncome *decimal.Decimal `json:"monthly_income,omitempty"`
+	StartDate     string           `json:"start_date,omitempty" validate:"omitempty,datetime=2006-01-02"` // YYYY-MM-DD
+}
+
+// TenantContactRequest representa um contato de emergência ou referência no payload
+type TenantContactRequest struct {
+	Name         string  `json:"name" validate:"required,min=2,max=150"`
+	Relationship string  `json:"relationship" validate:"required,max=50"`
+	Phone        string  `json:"phone" validate:"required,min=10,max=20"`
+	Email        *string `json:"email,omitempty" validate:"omitempty,email,max=255"`
+	Notes        *string `json:"notes,omitempty" validate:"omitempty,max=1000"`
+}
+
+// ReplaceTenantContactsRequest representa a nova lista de contatos (substitui a atual)
+type ReplaceTenantContactsRequest struct {
+	Contacts []TenantContactRequest `json:"contacts" validate:"max=10,dive"`
+}
+
+// TenantOccupantRequest representa um ocupante da unidade no payload
+type TenantOccupantRequest struct {
+	FullName     string  `json:"full_name" validate:"required,min=3,max=255"`
+	Relationship string  `json:"relationship" validate:"required,max=50"`
+	Document     *string `json:"document,omitempty" validate:"omitempty,max=50"`
+	BirthDate    string  `json:"birth_date,omitempty" validate:"omitempty,datetime=2006-01-02"` // YYYY-MM-DD
+}
+
+// ReplaceTenantOccupantsRequest representa a nova lista de ocupantes (substitui a atual)
+type ReplaceTenantOccupantsRequest struct {
+	Occupants []TenantOccupantRequest `json:"occupants" validate:"max=10,dive"`
+}
+
+// TenantPetRequest representa um animal de estimação no payload
+type TenantPetRequest struct {
+	Name    string  `json:"name" validate:"required,max=100"`
+	Species string  `json:"species" validate:"required,oneof=dog cat bird fish rodent other"`
+	Breed   *string `json:"breed,omitempty" validate:"omitempty,max=100"`
+	Notes   *string `json:"notes,omitempty" validate:"omitempty,max=1000"`
+}
+
+// ReplaceTenantPetsRequest representa a nova lista de animais (substitui a atual)
+type ReplaceTenantPetsRequest struct {
+	Pets []TenantPetRequest `json:"pets" validate:"max=10,dive"`
+}
+
+// TenantEmployerResponse representa os dados do empregador do morador
+type TenantEmployerResponse struct {
+	CompanyName   string           `json:"company_name"`
+	Phone         *string          `json:"phone,omitempty"`
+	JobTitle      *string          `json:"job_title,omitempty"`
+	MonthlyIncome *decimal.Decimal `json:"monthly_income,omitempty"`
+	StartDate     *string          `json:"start_date,omitempty"`
+}
+
+// TenantContactResponse representa um contato de emergência ou referência
+type TenantContactResponse struct {
+	ID           uuid.UUID `json:"id"`
+	ContactType  string    `json:"contact_type"`
+	Name         string    `json:"name"`
+	Relationship string    `json:"relationship"`
+	Phone        string    `json:"phone"`
+	Email        *string   `json:"email,omitempty"`
+	Notes        *string   `json:"notes,omitempty"`
+}
+
+// TenantOccupantResponse representa um ocupante da unidade
+type TenantOccupantResponse struct {
+	ID           uuid.UUID `json:"id"`
+	FullName     string    `json:"full_name"`
+	Relationship string    `json:"relationship"`
+	Document     *string   `json:"document,omitempty"`
+	BirthDate    *string   `json:"birth_date,omitempty"`
+}
+
+// TenantPetResponse representa um animal de estimação
+type TenantPetResponse struct {
+	ID      uuid.UUID `json:"id"`
+	Name    string    `json:"name"`
+	Species string    `json:"species"`
+	Breed   *string   `json:"breed,omitempty"`
+	Notes   *string   `json:"notes,omitempty"`
+}
+
+// TenantProfileResponse representa o cadastro completo do morador
+type TenantProfileResponse struct {
+	Tenant            *TenantResponse           `json:"tenant"`
+	EmergencyContacts []*TenantContactResponse  `json:"emergency_contacts"`
+	References        []*TenantContactResponse  `json:"references"`
+	Occupants         []*TenantOccupantResponse `json:"occupants"`
+	Pets              []*TenantPetResponse      `json:"pets"`
+}
+
+// ToServiceRequest converte o payload para service.TenantEmployerRequest
+func (r TenantEmployerRequest) ToServiceRequest() *service.TenantEmployerRequest {
+	return &service.TenantEmployerRequest{
+		CompanyName:   r.CompanyName,
+		Phone:         r.Phone,
+		JobTitle:      r.JobTitle,
+		MonthlyIncome: r.MonthlyIncome,
+		StartDate:     parseOptionalDate(r.StartDate),
+	}
+}
+
+// ToServiceRequests converte a lista de contatos do payload
+func (r ReplaceTenantContactsRequest) ToServiceRequests() []service.TenantContactRequest {
+	reqs := make([]service.TenantContactRequest, len(r.Contacts))
+	for i, c := range r.Contacts {
+		reqs[i] = service.TenantContactRequest{
+			Name:         c.Name,
+			Relationship: c.Relationship,
+			Phone:        c.Phone,
+			Email:        c.Email,
+			Notes:        c.Notes,
+		}
+	}
+	return reqs
+}
+
+// ToServiceRequests converte a lista de ocupantes do payload
+func (r ReplaceTenantOccupantsRequest) ToServiceRequests() []service.TenantOccupantRequest {
+	reqs := make([]service.TenantOccupantRequest, len(r.Occupants))
+	for i, o := range r.Occupants {
+		reqs[i] = service.TenantOccupantRequest{
+			FullName:     o.FullName,
+			Relationship: o.Relationship,
+			Document:     o.Document,
+			BirthDate:    parseOptionalDate(o.BirthDate),
+		}
+	}
+	return reqs
+}
+
+// ToServiceRequests converte a lista de animais do payload
+func (r ReplaceTenantPetsRequest) ToServiceRequests() []service.TenantPetRequest {
+	reqs := make([]service.TenantPetRequest, len(r.Pets))
+	for i, p := range r.Pets {
+		reqs[i] = service.TenantPetRequest{
+			Name:    p.Name,
+			Species: domain.PetSpecies(p.Species),
+			Breed:   p.Breed,
+			Notes:   p.Notes,
+		}
+	}
+	return reqs
+}
+
+// ToTenantEmployerResponse converte domain.TenantEmployer (nil se não informado)
+func ToTenantEmployerResponse(employer *domain.TenantEmployer) *TenantEmployerResponse {
+	if employer == nil {
+		return nil
+	}
+	return &TenantEmployerResponse{
+		CompanyName:   employer.CompanyName,
+		Phone:         employer.Phone,
+		JobTitle:      employer.JobTitle,
+		MonthlyIncome: employer.MonthlyIncome,
+		StartDate:     formatOptionalDate(employer.StartDate),
+	}
+}
+
+// ToTenantContactResponseList converte uma lista de contatos
+func ToTenantContactResponseList(contacts []*domain.TenantContact) []*TenantContactResponse {
+	result := make([]*TenantContactResponse, len(contacts))
+	for i, c := range contacts {
+		result[i] = &TenantContactResponse{
+			ID:           c.ID,
+			ContactType:  string(c.Type),
+			Name:         c.Name,
+			Relationship: c.Relationship,
+			Phone:        c.Phone,
+			Email:        c.Email,
+			Notes:        c.Notes,
+		}
+	}
+	return result
+}
+
+// ToTenantOccupantResponseList converte uma lista de ocupantes
+func ToTenantOccupantResponseList(occupants []*domain.TenantOccupant) []*TenantOccupantResponse {
+	result := make([]*TenantOccupantResponse, len(occupants))
+	for i, o := range occupants {
+		result[i] = &TenantOccupantResponse{
+			ID:           o.ID,
+			FullName:     o.FullName,
+			Relationship: o.Relationship,
+			Document:     o.Document,
+			BirthDate:    formatOptionalDate(o.BirthDate),
+		}
+	}
+	return result
+}
+
+// ToTenantPetResponseList converte uma lista de animais
+func ToTenantPetResponseList(pets []*domain.TenantPet) []*TenantPetResponse {
+	result := make([]*TenantPetResponse, len(pets))
+	for i, p := range pets {
+		result[i] = &TenantPetResponse{
+			ID:      p.ID,
+			Name:    p.Name,
+			Species: string(p.Species),
+			Breed:   p.Breed,
+			Notes:   p.Notes,
+		}
+	}
+	return result
+}
+
+// ToTenantProfileResponse converte service.TenantProfile para TenantProfileResponse
+func ToTenantProfileResponse(profile *service.TenantProfile) *TenantProfileResponse {
+	return &TenantProfileResponse{
+		Tenant:            ToTenantResponse(profile.Tenant),
+		EmergencyContacts: ToTenantContactResponseList(profile.EmergencyContacts),
+		References:        ToTenantContactResponseList(profile.References),
+		Occupants:         ToTenantOccupantResponseList(profile.Occupants),
+		Pets:              ToTenantPetResponseList(profile.Pets),
+	}
+}
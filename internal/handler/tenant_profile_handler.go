@@ -0,0 +1,286 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// TenantProfileHandler lida com requisições HTTP dos dados complementares do morador
+type TenantProfileHandler struct {
+	profileService *service.TenantProfileService
+	validator      *validator.Validate
+}
+
+// NewTenantProfileHandler cria uma nova instância do handler
+func NewTenantProfileHandler(profileService *service.TenantProfileService) *TenantProfileHandler {
+	return &TenantProfileHandler{
+		profileService: profileService,
+		validator:      validator.New(),
+	}
+}
+
+// GetTenantProfile godoc
+// @Summary      Cadastro completo do morador
+// @Description  Retorna o morador com empregador, contatos de emergência, referências, ocupantes e animais de estimação
+// @Tags         Tenants
+// @Produce      json
+// @Param        id path string true "Tenant ID (UUID)"
+// @Success      200 {object} TenantProfileResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/profile [get]
+func (h *TenantProfileHandler) GetTenantProfile(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+
+	profile, err := h.profileService.GetProfile(r.Context(), tenantID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Tenant profile retrieved successfully", ToTenantProfileResponse(profile))
+}
+
+// UpdateTenantEmployer godoc
+// @Summary      Atualizar empregador do morador
+// @Description  Define a empresa, cargo, renda mensal e data de admissão do morador
+// @Tags         Tenants
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Tenant ID (UUID)"
+// @Param        employer body TenantEmployerRequest true "Dados do empregador"
+// @Success      200 {object} TenantResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/employer [put]
+func (h *TenantProfileHandler) UpdateTenantEmployer(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+
+	var req TenantEmployerRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	tenant, err := h.profileService.UpdateEmployer(r.Context(), tenantID, req.ToServiceRequest())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Tenant employer updated successfully", ToTenantResponse(tenant))
+}
+
+// DeleteTenantEmployer godoc
+// @Summary      Remover empregador do morador
+// @Description  Remove os dados de empregador do morador
+// @Tags         Tenants
+// @Produce      json
+// @Param        id path string true "Tenant ID (UUID)"
+// @Success      200 {object} TenantResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/employer [delete]
+func (h *TenantProfileHandler) DeleteTenantEmployer(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+
+	tenant, err := h.profileService.UpdateEmployer(r.Context(), tenantID, nil)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Tenant employer removed successfully", ToTenantResponse(tenant))
+}
+
+// ReplaceEmergencyContacts godoc
+// @Summary      Atualizar contatos de emergência
+// @Description  Substitui a lista de contatos de emergência do morador (lista vazia remove todos)
+// @Tags         Tenants
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Tenant ID (UUID)"
+// @Param        contacts body ReplaceTenantContactsRequest true "Contatos de emergência"
+// @Success      200 {array} TenantContactResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/emergency-contacts [put]
+func (h *TenantProfileHandler) ReplaceEmergencyContacts(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+
+	var req ReplaceTenantContactsRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	contacts, err := h.profileService.ReplaceEmergencyContacts(r.Context(), tenantID, req.ToServiceRequests())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Emergency contacts updated successfully", ToTenantContactResponseList(contacts))
+}
+
+// ReplaceReferences godoc
+// @Summary      Atualizar referências
+// @Description  Substitui a lista de referências do morador, como antigos locadores (lista vazia remove todas)
+// @Tags         Tenants
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Tenant ID (UUID)"
+// @Param        references body ReplaceTenantContactsRequest true "Referências"
+// @Success      200 {array} TenantContactResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/references [put]
+func (h *TenantProfileHandler) ReplaceReferences(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+
+	var req ReplaceTenantContactsRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	references, err := h.profileService.ReplaceReferences(r.Context(), tenantID, req.ToServiceRequests())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "References updated successfully", ToTenantContactResponseList(references))
+}
+
+// ReplaceOccupants godoc
+// @Summary      Atualizar ocupantes da unidade
+// @Description  Substitui a lista de pessoas que moram com o morador (lista vazia remove todas)
+// @Tags         Tenants
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Tenant ID (UUID)"
+// @Param        occupants body ReplaceTenantOccupantsRequest true "Ocupantes"
+// @Success      200 {array} TenantOccupantResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/occupants [put]
+func (h *TenantProfileHandler) ReplaceOccupants(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+
+	var req ReplaceTenantOccupantsRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	occupants, err := h.profileService.ReplaceOccupants(r.Context(), tenantID, req.ToServiceRequests())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Occupants updated successfully", ToTenantOccupantResponseList(occupants))
+}
+
+// ReplacePets godoc
+// @Summary      Atualizar animais de estimação
+// @Description  Substitui a lista de animais de estimação do morador (lista vazia remove todos)
+// @Tags         Tenants
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Tenant ID (UUID)"
+// @Param        pets body ReplaceTenantPetsRequest true "Animais de estimação"
+// @Success      200 {array} TenantPetResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/pets [put]
+func (h *TenantProfileHandler) ReplacePets(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+
+	var req ReplaceTenantPetsRequest
+	if !h.decode(w, r, &req) {
+		return
+	}
+
+	pets, err := h.profileService.ReplacePets(r.Context(), tenantID, req.ToServiceRequests())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Pets updated successfully", ToTenantPetResponseList(pets))
+}
+
+// decode decodifica e valida o corpo da requisição
+func (h *TenantProfileHandler) decode(w http.ResponseWriter, r *http.Request, req any) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return false
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+
+	return true
+}
+
+// handleServiceError mapeia erros do service para respostas HTTP
+func (h *TenantProfileHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrTenantNotFound):
+		response.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrTenantAnonymized):
+		response.Error(w, http.StatusConflict, err.Error())
+	case errors.Is(err, domain.ErrInvalidEmployerName),
+		errors.Is(err, domain.ErrInvalidMonthlyIncome),
+		errors.Is(err, domain.ErrEmployerStartDateInFuture),
+		errors.Is(err, domain.ErrInvalidContactType),
+		errors.Is(err, domain.ErrInvalidContactName),
+		errors.Is(err, domain.ErrInvalidContactRelation),
+		errors.Is(err, domain.ErrInvalidContactPhone),
+		errors.Is(err, domain.ErrInvalidEmail),
+		errors.Is(err, domain.ErrInvalidOccupantName),
+		errors.Is(err, domain.ErrInvalidOccupantRelation),
+		errors.Is(err, domain.ErrInvalidOccupantDocument),
+		errors.Is(err, domain.ErrOccupantBirthDateInFuture),
+		errors.Is(err, domain.ErrOccupantIsTenant),
+		errors.Is(err, domain.ErrInvalidPetName),
+		errors.Is(err, domain.ErrInvalidPetSpecies):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// TenantScoreHandler lida com requisições HTTP da pontuação de pagamento dos moradores
+type TenantScoreHandler struct {
+	scoreService *service.TenantScoreService
+}
+
+// NewTenantScoreHandler cria uma nova instância do handler
+func NewTenantScoreHandler(scoreService *service.TenantScoreService) *TenantScoreHandler {
+	return &TenantScoreHandler{
+		scoreService: scoreService,
+	}
+}
+
+// GetTenantPaymentScore godoc
+// @Summary      Pontuação de pagamento do morador
+// @Description  Calcula a pontuação (0 a 100) a partir do histórico de pagamentos em todos os contratos: pontualidade, média de dias de atraso, renegociações e contratos cancelados
+// @Tags         Tenants
+// @Produce      json
+// @Param        id path string true "Tenant ID (UUID)"
+// @Success      200 {object} TenantPaymentScoreResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tenants/{id}/payment-score [get]
+func (h *TenantScoreHandler) GetTenantPaymentScore(w http.ResponseWriter, r *http.Request) {
+	tenantID, ok := parseUUIDParam(w, r, "id", "Invalid tenant ID")
+	if !ok {
+		return
+	}
+
+	score, err := h.scoreService.GetPaymentScore(r.Context(), tenantID)
+	if err != nil {
+		if errors.Is(err, service.ErrTenantNotFound) {
+			response.Error(w, http.StatusNotFound, err.Error())
+			return
+		}
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Tenant payment score calculated successfully", ToTenantPaymentScoreResponse(score))
+}
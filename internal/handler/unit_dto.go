@@ -8,6 +8,7 @@ import (
 
 // CreateUnitRequest representa o payload para criar uma unidade
 type CreateUnitRequest struct {
+	PropertyID         uuid.UUID       `json:"property_id" validate:"required"`
 	Number             string          `json:"number" validate:"required,min=1,max=10"`
 	Floor              int             `json:"floor" validate:"required,min=1"`
 	BaseRentValue      decimal.Decimal `json:"base_rent_value" validate:"required"`
@@ -32,6 +33,7 @@ type UpdateUnitStatusRequest struct {
 // UnitResponse representa a resposta com dados de uma unidade
 type UnitResponse struct {
 	ID                 uuid.UUID       `json:"id"`
+	PropertyID         uuid.UUID       `json:"property_id"`
 	Number             string          `json:"number"`
 	Floor              int             `json:"floor"`
 	Status             string          `json:"status"`
@@ -48,6 +50,7 @@ type UnitResponse struct {
 func ToUnitResponse(unit *domain.Unit) *UnitResponse {
 	return &UnitResponse{
 		ID:                 unit.ID,
+		PropertyID:         unit.PropertyID,
 		Number:             unit.Number,
 		Floor:              unit.Floor,
 		Status:             string(unit.Status),
@@ -30,7 +30,7 @@ func NewUnitHandler(unitService *service.UnitService) *UnitHandler {
 
 // CreateUnit godoc
 // @Summary      Criar nova unidade
-// @Description  Cria uma nova unidade no sistema
+// @Description  Cria uma nova unidade em um imóvel
 // @Tags         Units
 // @Accept       json
 // @Produce      json
@@ -38,6 +38,8 @@ func NewUnitHandler(unitService *service.UnitService) *UnitHandler {
 // @Param        unit body CreateUnitRequest true "Dados da unidade"
 // @Success      201 {object} UnitResponse
 // @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
 // @Failure      500 {object} response.ErrorResponse
 // @Router       /units [post]
 func (h *UnitHandler) CreateUnit(w http.ResponseWriter, r *http.Request) {
@@ -58,6 +60,7 @@ func (h *UnitHandler) CreateUnit(w http.ResponseWriter, r *http.Request) {
 	// Chamar service
 	unit, err := h.unitService.CreateUnit(
 		r.Context(),
+		req.PropertyID,
 		req.Number,
 		req.Floor,
 		req.BaseRentValue,
@@ -104,13 +107,15 @@ func (h *UnitHandler) GetUnit(w http.ResponseWriter, r *http.Request) {
 
 // ListUnits godoc
 // @Summary      Listar unidades
-// @Description  Retorna lista de unidades com filtros opcionais
+// @Description  Retorna lista de unidades com filtro opcional por status ou andar; property_id pode ser combinado com os demais filtros
 // @Tags         Units
 // @Produce      json
 // @Security     BearerAuth
 // @Param        status query string false "Filter by status" Enums(available, occupied, maintenance, renovation)
 // @Param        floor query int false "Filter by floor"
+// @Param        property_id query string false "Filter by property ID (UUID)"
 // @Success      200 {array} UnitResponse
+// @Failure      400 {object} response.ErrorResponse
 // @Failure      500 {object} response.ErrorResponse
 // @Router       /units [get]
 func (h *UnitHandler) ListUnits(w http.ResponseWriter, r *http.Request) {
@@ -119,6 +124,10 @@ func (h *UnitHandler) ListUnits(w http.ResponseWriter, r *http.Request) {
 	// Verificar filtros na query string
 	statusFilter := r.URL.Query().Get("status")
 	floorFilter := r.URL.Query().Get("floor")
+	propertyID, ok := parseOptionalPropertyID(w, r)
+	if !ok {
+		return
+	}
 
 	var units []*domain.Unit
 	var err error
@@ -134,6 +143,8 @@ func (h *UnitHandler) ListUnits(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		units, err = h.unitService.ListUnitsByFloor(ctx, floor)
+	} else if propertyID != nil {
+		units, err = h.unitService.ListUnitsByProperty(ctx, *propertyID)
 	} else {
 		units, err = h.unitService.ListUnits(ctx)
 	}
@@ -143,6 +154,17 @@ func (h *UnitHandler) ListUnits(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Restringir o resultado dos demais filtros ao imóvel
+	if propertyID != nil && (statusFilter != "" || floorFilter != "") {
+		scoped := make([]*domain.Unit, 0, len(units))
+		for _, unit := range units {
+			if unit.PropertyID == *propertyID {
+				scoped = append(scoped, unit)
+			}
+		}
+		units = scoped
+	}
+
 	response.Success(w, http.StatusOK, "Units retrieved successfully", ToUnitResponseList(units))
 }
 
@@ -290,10 +312,69 @@ func (h *UnitHandler) GetOccupancyStats(w http.ResponseWriter, r *http.Request)
 	response.Success(w, http.StatusOK, "Occupancy stats retrieved successfully", stats)
 }
 
+// GetUnitHistory godoc
+// @Summary      Histórico de status da unidade
+// @Description  Retorna as mudanças de status da unidade e os períodos em cada status
+// @Tags         Units
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "Unit ID (UUID)"
+// @Success      200 {object} service.UnitTimeline
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Router       /units/{id}/history [get]
+func (h *UnitHandler) GetUnitHistory(w http.ResponseWriter, r *http.Request) {
+	// Extrair ID
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid unit ID")
+		return
+	}
+
+	timeline, err := h.unitService.GetUnitTimeline(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Unit history retrieved successfully", timeline)
+}
+
+// GetOccupancyHistory godoc
+// @Summary      Histórico da taxa de ocupação
+// @Description  Retorna a taxa de ocupação mensal calculada a partir do histórico de status das unidades
+// @Tags         Units
+// @Produce      json
+// @Security     BearerAuth
+// @Param        months query int false "Quantidade de meses (1-36, padrão 12)"
+// @Success      200 {array} service.OccupancyHistoryPoint
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      500 {object} response.ErrorResponse
+// @Router       /units/stats/occupancy-history [get]
+func (h *UnitHandler) GetOccupancyHistory(w http.ResponseWriter, r *http.Request) {
+	months := 12
+	if monthsStr := r.URL.Query().Get("months"); monthsStr != "" {
+		if _, parseErr := fmt.Sscanf(monthsStr, "%d", &months); parseErr != nil || months < 1 || months > 36 {
+			response.Error(w, http.StatusBadRequest, "Invalid months parameter (must be between 1 and 36)")
+			return
+		}
+	}
+
+	history, err := h.unitService.GetOccupancyHistory(r.Context(), months)
+	if err != nil {
+		response.Error(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Occupancy history retrieved successfully", history)
+}
+
 // handleServiceError mapeia erros do service para respostas HTTP
 func (h *UnitHandler) handleServiceError(w http.ResponseWriter, err error) {
 	switch {
-	case errors.Is(err, service.ErrUnitNotFound):
+	case errors.Is(err, service.ErrUnitNotFound),
+		errors.Is(err, service.ErrPropertyNotFound):
 		response.Error(w, http.StatusNotFound, err.Error())
 	case errors.Is(err, service.ErrUnitNumberAlreadyExists):
 		response.Error(w, http.StatusConflict, err.Error())
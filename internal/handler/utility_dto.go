@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+	"github.com/shopspring/decimal"
+)
+
+// CreateUtilityTariffRequest representa o payload para cadastrar uma tarifa
+type CreateUtilityTariffRequest struct {
+	UtilityType   string          `json:"utility_type" validate:"required,oneof=water electricity"`
+	PricePerUnit  decimal.Decimal `json:"price_per_unit"`
+	FixedFee      decimal.Decimal `json:"fixed_fee"`
+	EffectiveFrom time.Time       `json:"effective_from" validate:"required"`
+}
+
+// RecordMeterReadingRequest representa o payload para registrar uma leitura de medidor
+type RecordMeterReadingRequest struct {
+	UtilityType    string          `json:"utility_type" validate:"required,oneof=water electricity"`
+	ReferenceMonth time.Time       `json:"reference_month" validate:"required"`
+	Reading        decimal.Decimal `json:"reading"`
+	ReadingDate    *time.Time      `json:"reading_date,omitempty"`
+	Notes          *string         `json:"notes,omitempty" validate:"omitempty,max=1000"`
+}
+
+// UnitOccupantsRequest representa a quantidade de moradores de uma unidade no rateio
+type UnitOccupantsRequest struct {
+	UnitID    uuid.UUID `json:"unit_id" validate:"required"`
+	Occupants int       `json:"occupants" validate:"required,min=1"`
+}
+
+// BuildingBillRequest representa uma conta do prédio a ser rateada
+type BuildingBillRequest struct {
+	UtilityType string                 `json:"utility_type" validate:"required,oneof=water electricity"`
+	TotalAmount decimal.Decimal        `json:"total_amount"`
+	SplitMethod string                 `json:"split_method" validate:"required,oneof=split_occupants split_equal"`
+	Occupants   []UnitOccupantsRequest `json:"occupants,omitempty" validate:"omitempty,dive"`
+}
+
+// UtilityBillingRunRequest representa o payload para gerar as cobranças de consumo do mês
+type UtilityBillingRunRequest struct {
+	ReferenceMonth time.Time             `json:"reference_month" validate:"required"`
+	DueDate        time.Time             `json:"due_date" validate:"required"`
+	PropertyID     *uuid.UUID            `json:"property_id,omitempty"`
+	BuildingBills  []BuildingBillRequest `json:"building_bills,omitempty" validate:"omitempty,dive"`
+}
+
+// UtilityTariffResponse representa a resposta com dados de uma tarifa
+type UtilityTariffResponse struct {
+	ID            uuid.UUID       `json:"id"`
+	UtilityType   string          `json:"utility_type"`
+	PricePerUnit  decimal.Decimal `json:"price_per_unit"`
+	FixedFee      decimal.Decimal `json:"fixed_fee"`
+	EffectiveFrom time.Time       `json:"effective_from"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// MeterReadingResponse representa a resposta com dados de uma leitura
+type MeterReadingResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	UnitID         uuid.UUID       `json:"unit_id"`
+	UtilityType    string          `json:"utility_type"`
+	ReferenceMonth time.Time       `json:"reference_month"`
+	Reading        decimal.Decimal `json:"reading"`
+	ReadingDate    time.Time       `json:"reading_date"`
+	Notes          *string         `json:"notes,omitempty"`
+	CreatedBy      *uuid.UUID      `json:"created_by,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// UtilityChargeResponse representa uma linha do detalhamento de consumo de um pagamento
+type UtilityChargeResponse struct {
+	ID              uuid.UUID        `json:"id"`
+	PaymentID       uuid.UUID        `json:"payment_id"`
+	LeaseID         uuid.UUID        `json:"lease_id"`
+	UnitID          uuid.UUID        `json:"unit_id"`
+	UtilityType     string           `json:"utility_type"`
+	ReferenceMonth  time.Time        `json:"reference_month"`
+	BillingMethod   string           `json:"billing_method"`
+	PreviousReading *decimal.Decimal `json:"previous_reading,omitempty"`
+	CurrentReading  *decimal.Decimal `json:"current_reading,omitempty"`
+	Consumption     *decimal.Decimal `json:"consumption,omitempty"`
+	PricePerUnit    *decimal.Decimal `json:"price_per_unit,omitempty"`
+	FixedFee        decimal.Decimal  `json:"fixed_fee"`
+	BuildingTotal   *decimal.Decimal `json:"building_total,omitempty"`
+	ShareWeight     *int             `json:"share_weight,omitempty"`
+	TotalShares     *int             `json:"total_shares,omitempty"`
+	Amount          decimal.Decimal  `json:"amount"`
+	Description     string           `json:"description"`
+}
+
+// UtilityBillResponse representa o pagamento de consumo gerado para um contrato
+type UtilityBillResponse struct {
+	Payment *PaymentResponse        `json:"payment"`
+	Charges []UtilityChargeResponse `json:"charges"`
+}
+
+// SkippedUtilityChargeResponse representa um contrato não cobrado na rodada
+type SkippedUtilityChargeResponse struct {
+	LeaseID     uuid.UUID `json:"lease_id"`
+	UnitID      uuid.UUID `json:"unit_id"`
+	UtilityType *string   `json:"utility_type,omitempty"`
+	Reason      string    `json:"reason"`
+}
+
+// UtilityBillingRunResponse representa o resultado da rodada de faturamento
+type UtilityBillingRunResponse struct {
+	ReferenceMonth time.Time                      `json:"reference_month"`
+	Bills          []UtilityBillResponse          `json:"bills"`
+	Skipped        []SkippedUtilityChargeResponse `json:"skipped"`
+	TotalAmount    decimal.Decimal                `json:"total_amount"`
+}
+
+// ToServiceRequest converte o payload da rodada para o request do service
+func (r UtilityBillingRunRequest) ToServiceRequest() service.UtilityBillingRunRequest {
+	bills := make([]service.BuildingBillRequest, len(r.BuildingBills))
+	for i, bill := range r.BuildingBills {
+		occupants := make(map[uuid.UUID]int, len(bill.Occupants))
+		for _, o := range bill.Occupants {
+			occupants[o.UnitID] = o.Occupants
+		}
+		bills[i] = service.BuildingBillRequest{
+			UtilityType: domain.UtilityType(bill.UtilityType),
+			TotalAmount: bill.TotalAmount,
+			SplitMethod: domain.UtilityBillingMethod(bill.SplitMethod),
+			Occupants:   occupants,
+		}
+	}
+
+	return service.UtilityBillingRunRequest{
+		ReferenceMonth: r.ReferenceMonth,
+		DueDate:        r.DueDate,
+		PropertyID:     r.PropertyID,
+		BuildingBills:  bills,
+	}
+}
+
+// ToUtilityTariffResponse converte domain.UtilityTariff para UtilityTariffResponse
+func ToUtilityTariffResponse(tariff *domain.UtilityTariff) UtilityTariffResponse {
+	return UtilityTariffResponse{
+		ID:            tariff.ID,
+		UtilityType:   string(tariff.UtilityType),
+		PricePerUnit:  tariff.PricePerUnit,
+		FixedFee:      tariff.FixedFee,
+		EffectiveFrom: tariff.EffectiveFrom,
+		CreatedAt:     tariff.CreatedAt,
+	}
+}
+
+// ToUtilityTariffResponseList converte uma lista de tarifas
+func ToUtilityTariffResponseList(tariffs []*domain.UtilityTariff) []UtilityTariffResponse {
+	result := make([]UtilityTariffResponse, len(tariffs))
+	for i, tariff := range tariffs {
+		result[i] = ToUtilityTariffResponse(tariff)
+	}
+	return result
+}
+
+// ToMeterReadingResponse converte domain.MeterReading para MeterReadingResponse
+func ToMeterReadingResponse(reading *domain.MeterReading) MeterReadingResponse {
+	return MeterReadingResponse{
+		ID:             reading.ID,
+		UnitID:         reading.UnitID,
+		UtilityType:    string(reading.UtilityType),
+		ReferenceMonth: reading.ReferenceMonth,
+		Reading:        reading.Reading,
+		ReadingDate:    reading.ReadingDate,
+		Notes:          reading.Notes,
+		CreatedBy:      reading.CreatedBy,
+		CreatedAt:      reading.CreatedAt,
+	}
+}
+
+// ToMeterReadingResponseList converte uma lista de leituras
+func ToMeterReadingResponseList(readings []*domain.MeterReading) []MeterReadingResponse {
+	result := make([]MeterReadingResponse, len(readings))
+	for i, reading := range readings {
+		result[i] = ToMeterReadingResponse(reading)
+	}
+	return result
+}
+
+// ToUtilityChargeResponse converte domain.UtilityCharge para UtilityChargeResponse
+func ToUtilityChargeResponse(charge *domain.UtilityCharge) UtilityChargeResponse {
+	return UtilityChargeResponse{
+		ID:              charge.ID,
+		PaymentID:       charge.PaymentID,
+		LeaseID:         charge.LeaseID,
+		UnitID:          charge.UnitID,
+		UtilityType:     string(charge.UtilityType),
+		ReferenceMonth:  charge.ReferenceMonth,
+		BillingMethod:   string(charge.BillingMethod),
+		PreviousReading: charge.PreviousReading,
+		CurrentReading:  charge.CurrentReading,
+		Consumption:     charge.Consumption,
+		PricePerUnit:    charge.PricePerUnit,
+		FixedFee:        charge.FixedFee,
+		BuildingTotal:   charge.BuildingTotal,
+		ShareWeight:     charge.ShareWeight,
+		TotalShares:     charge.TotalShares,
+		Amount:          charge.Amount,
+		Description:     charge.Describe(),
+	}
+}
+
+// ToUtilityChargeResponseList converte uma lista de cobranças de consumo
+func ToUtilityChargeResponseList(charges []*domain.UtilityCharge) []UtilityChargeResponse {
+	result := make([]UtilityChargeResponse, len(charges))
+	for i, charge := range charges {
+		result[i] = ToUtilityChargeResponse(charge)
+	}
+	return result
+}
+
+// ToUtilityBillingRunResponse converte o resultado da rodada de faturamento
+func ToUtilityBillingRunResponse(result *service.UtilityBillingRunResult) UtilityBillingRunResponse {
+	bills := make([]UtilityBillResponse, len(result.Bills))
+	for i, bill := range result.Bills {
+		bills[i] = UtilityBillResponse{
+			Payment: ToPaymentResponse(bill.Payment),
+			Charges: ToUtilityChargeResponseList(bill.Charges),
+		}
+	}
+
+	skipped := make([]SkippedUtilityChargeResponse, len(result.Skipped))
+	for i, s := range result.Skipped {
+		skipped[i] = SkippedUtilityChargeResponse{
+			LeaseID: s.LeaseID,
+			UnitID:  s.UnitID,
+			Reason:  s.Reason,
+		}
+		if s.UtilityType != nil {
+			utilityType := string(*s.UtilityType)
+			skipped[i].UtilityType = &utilityType
+		}
+	}
+
+	return UtilityBillingRunResponse{
+		ReferenceMonth: result.ReferenceMonth,
+		Bills:          bills,
+		Skipped:        skipped,
+		TotalAmount:    result.TotalAmount,
+	}
+}
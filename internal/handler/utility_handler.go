@@ -0,0 +1,266 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// UtilityHandler lida com requisições HTTP de leituras, tarifas e cobrança de consumo
+type UtilityHandler struct {
+	utilityService *service.UtilityService
+	validator      *validator.Validate
+}
+
+// NewUtilityHandler cria uma nova instância do handler
+func NewUtilityHandler(utilityService *service.UtilityService) *UtilityHandler {
+	return &UtilityHandler{
+		utilityService: utilityService,
+		validator:      validator.New(),
+	}
+}
+
+// ListTariffs godoc
+// @Summary      Listar tarifas de consumo
+// @Description  Retorna as tarifas de água e energia cadastradas
+// @Tags         Utilities
+// @Produce      json
+// @Success      200 {array} UtilityTariffResponse
+// @Failure      500 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /utilities/tariffs [get]
+func (h *UtilityHandler) ListTariffs(w http.ResponseWriter, r *http.Request) {
+	tariffs, err := h.utilityService.ListTariffs(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Tariffs retrieved successfully", ToUtilityTariffResponseList(tariffs))
+}
+
+// CreateTariff godoc
+// @Summary      Cadastrar tarifa de consumo
+// @Description  Cadastra o preço por unidade consumida e a taxa fixa, válidos a partir de uma data
+// @Tags         Utilities
+// @Accept       json
+// @Produce      json
+// @Param        tariff body CreateUtilityTariffRequest true "Dados da tarifa"
+// @Success      201 {object} UtilityTariffResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /utilities/tariffs [post]
+func (h *UtilityHandler) CreateTariff(w http.ResponseWriter, r *http.Request) {
+	var req CreateUtilityTariffRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tariff, err := h.utilityService.CreateTariff(r.Context(), service.CreateTariffRequest{
+		UtilityType:   domain.UtilityType(req.UtilityType),
+		PricePerUnit:  req.PricePerUnit,
+		FixedFee:      req.FixedFee,
+		EffectiveFrom: req.EffectiveFrom,
+	})
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Tariff created successfully", ToUtilityTariffResponse(tariff))
+}
+
+// RunBilling godoc
+// @Summary      Gerar cobranças de consumo do mês
+// @Description  Calcula o consumo de cada contrato ativo (por medidor ou rateio da conta do prédio) e gera um pagamento do tipo utility com o detalhamento
+// @Tags         Utilities
+// @Accept       json
+// @Produce      json
+// @Param        run body UtilityBillingRunRequest true "Parâmetros da rodada"
+// @Success      201 {object} UtilityBillingRunResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /utilities/billing-runs [post]
+func (h *UtilityHandler) RunBilling(w http.ResponseWriter, r *http.Request) {
+	var req UtilityBillingRunRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.utilityService.RunBilling(r.Context(), req.ToServiceRequest())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Utility billing completed successfully", ToUtilityBillingRunResponse(result))
+}
+
+// ListUnitReadings godoc
+// @Summary      Listar leituras de medidores da unidade
+// @Description  Retorna as leituras mensais de água e energia da unidade
+// @Tags         Utilities
+// @Produce      json
+// @Param        id path string true "Unit ID (UUID)"
+// @Success      200 {array} MeterReadingResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /units/{id}/meter-readings [get]
+func (h *UtilityHandler) ListUnitReadings(w http.ResponseWriter, r *http.Request) {
+	unitID, ok := parseUUIDParam(w, r, "id", "Invalid unit ID")
+	if !ok {
+		return
+	}
+
+	readings, err := h.utilityService.ListReadings(r.Context(), unitID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Meter readings retrieved successfully", ToMeterReadingResponseList(readings))
+}
+
+// RecordReading godoc
+// @Summary      Registrar leitura de medidor
+// @Description  Registra a leitura mensal do medidor de água ou energia da unidade
+// @Tags         Utilities
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Unit ID (UUID)"
+// @Param        reading body RecordMeterReadingRequest true "Dados da leitura"
+// @Success      201 {object} MeterReadingResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /units/{id}/meter-readings [post]
+func (h *UtilityHandler) RecordReading(w http.ResponseWriter, r *http.Request) {
+	unitID, ok := parseUUIDParam(w, r, "id", "Invalid unit ID")
+	if !ok {
+		return
+	}
+
+	var req RecordMeterReadingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	reading, err := h.utilityService.RecordReading(r.Context(), unitID, service.RecordReadingRequest{
+		UtilityType:    domain.UtilityType(req.UtilityType),
+		ReferenceMonth: req.ReferenceMonth,
+		Reading:        req.Reading,
+		ReadingDate:    req.ReadingDate,
+		Notes:          req.Notes,
+		CreatedBy:      currentUserID(r),
+	})
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Meter reading recorded successfully", ToMeterReadingResponse(reading))
+}
+
+// DeleteReading godoc
+// @Summary      Remover leitura de medidor
+// @Description  Remove uma leitura registrada por engano. Cobranças já geradas não são alteradas
+// @Tags         Utilities
+// @Produce      json
+// @Param        id path string true "Unit ID (UUID)"
+// @Param        readingId path string true "Reading ID (UUID)"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /units/{id}/meter-readings/{readingId} [delete]
+func (h *UtilityHandler) DeleteReading(w http.ResponseWriter, r *http.Request) {
+	unitID, ok := parseUUIDParam(w, r, "id", "Invalid unit ID")
+	if !ok {
+		return
+	}
+	readingID, ok := parseUUIDParam(w, r, "readingId", "Invalid meter reading ID")
+	if !ok {
+		return
+	}
+
+	if err := h.utilityService.DeleteReading(r.Context(), unitID, readingID); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Meter reading deleted successfully", nil)
+}
+
+// GetPaymentCharges godoc
+// @Summary      Detalhamento de consumo do pagamento
+// @Description  Retorna as leituras, tarifas e rateios que compõem um pagamento de consumo
+// @Tags         Utilities
+// @Produce      json
+// @Param        id path string true "Payment ID (UUID)"
+// @Success      200 {array} UtilityChargeResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /payments/{id}/utility-charges [get]
+func (h *UtilityHandler) GetPaymentCharges(w http.ResponseWriter, r *http.Request) {
+	paymentID, ok := parseUUIDParam(w, r, "id", "Invalid payment ID")
+	if !ok {
+		return
+	}
+
+	charges, err := h.utilityService.GetPaymentCharges(r.Context(), paymentID)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Utility charges retrieved successfully", ToUtilityChargeResponseList(charges))
+}
+
+// handleServiceError mapeia erros do service para respostas HTTP
+func (h *UtilityHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrUnitNotFound),
+		errors.Is(err, service.ErrPaymentNotFound),
+		errors.Is(err, service.ErrMeterReadingNotFound):
+		response.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrMeterReadingAlreadyExists):
+		response.Error(w, http.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrInvalidSplitMethod),
+		errors.Is(err, service.ErrDuplicateBuildingBill),
+		errors.Is(err, domain.ErrInvalidUtilityType),
+		errors.Is(err, domain.ErrInvalidTariffPrice),
+		errors.Is(err, domain.ErrInvalidTariffFixedFee),
+		errors.Is(err, domain.ErrInvalidMeterReading),
+		errors.Is(err, domain.ErrInvalidBuildingBill),
+		errors.Is(err, domain.ErrInvalidShareWeight),
+		errors.Is(err, domain.ErrInvalidAmount):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+)
+
+// CreateWebhookEndpointRequest representa a requisição para cadastrar um endpoint de webhook
+type CreateWebhookEndpointRequest struct {
+	URL         string   `json:"url" validate:"required,url,max=500"`
+	Description string   `json:"description" validate:"max=255"`
+	Events      []string `json:"events" validate:"required,min=1,dive,oneof=lease.created lease.renewed lease.cancelled payment.paid payment.overdue unit.status_changed"`
+}
+
+// UpdateWebhookEndpointRequest representa a requisição para alterar um endpoint de webhook
+type UpdateWebhookEndpointRequest struct {
+	URL         string   `json:"url" validate:"required,url,max=500"`
+	Description string   `json:"description" validate:"max=255"`
+	Events      []string `json:"events" validate:"required,min=1,dive,oneof=lease.created lease.renewed lease.cancelled payment.paid payment.overdue unit.status_changed"`
+	Active      *bool    `json:"active" validate:"required"`
+}
+
+// WebhookEndpointResponse representa a resposta com dados de um endpoint (sem o segredo)
+type WebhookEndpointResponse struct {
+	ID          uuid.UUID `json:"id"`
+	URL         string    `json:"url"`
+	Description string    `json:"description"`
+	Events      []string  `json:"events"`
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// WebhookEndpointSecretResponse inclui o segredo de assinatura, exibido apenas no cadastro e na rotação
+type WebhookEndpointSecretResponse struct {
+	WebhookEndpointResponse
+	Secret string `json:"secret"`
+}
+
+// WebhookDeliveryResponse representa uma entrega no registro de entregas do endpoint
+type WebhookDeliveryResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	EndpointID     uuid.UUID       `json:"endpoint_id"`
+	EventID        uuid.UUID       `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	LastError      *string         `json:"last_error,omitempty"`
+	ResponseStatus *int            `json:"response_status,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	ReplayOf       *uuid.UUID      `json:"replay_of,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// toWebhookEventTypes converte os eventos da requisição para o tipo do domínio
+func toWebhookEventTypes(events []string) []domain.WebhookEventType {
+	types := make([]domain.WebhookEventType, len(events))
+	for i, event := range events {
+		types[i] = domain.WebhookEventType(event)
+	}
+	return types
+}
+
+// ToWebhookEndpointResponse converte domain.WebhookEndpoint para WebhookEndpointResponse
+func ToWebhookEndpointResponse(endpoint *domain.WebhookEndpoint) *WebhookEndpointResponse {
+	events := make([]string, len(endpoint.Events))
+	for i, event := range endpoint.Events {
+		events[i] = string(event)
+	}
+
+	return &WebhookEndpointResponse{
+		ID:          endpoint.ID,
+		URL:         endpoint.URL,
+		Description: endpoint.Description,
+		Events:      events,
+		Active:      endpoint.Active,
+		CreatedAt:   endpoint.CreatedAt,
+		UpdatedAt:   endpoint.UpdatedAt,
+	}
+}
+
+// ToWebhookEndpointSecretResponse converte domain.WebhookEndpoint incluindo o segredo de assinatura
+func ToWebhookEndpointSecretResponse(endpoint *domain.WebhookEndpoint) *WebhookEndpointSecretResponse {
+	return &WebhookEndpointSecretResponse{
+		WebhookEndpointResponse: *ToWebhookEndpointResponse(endpoint),
+		Secret:                  endpoint.Secret,
+	}
+}
+
+// ToWebhookEndpointResponseList converte slice de endpoints para slice de responses
+func ToWebhookEndpointResponseList(endpoints []*domain.WebhookEndpoint) []*WebhookEndpointResponse {
+	responses := make([]*WebhookEndpointResponse, len(endpoints))
+	for i, endpoint := range endpoints {
+		responses[i] = ToWebhookEndpointResponse(endpoint)
+	}
+	return responses
+}
+
+// ToWebhookDeliveryResponse converte domain.WebhookDelivery para WebhookDeliveryResponse
+func ToWebhookDeliveryResponse(delivery *domain.WebhookDelivery) *WebhookDeliveryResponse {
+	return &WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		EndpointID:     delivery.EndpointID,
+		EventID:        delivery.EventID,
+		EventType:      string(delivery.EventType),
+		Payload:        delivery.Payload,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastError:      delivery.LastError,
+		ResponseStatus: delivery.ResponseStatus,
+		DeliveredAt:    delivery.DeliveredAt,
+		ReplayOf:       delivery.ReplayOf,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
+
+// ToWebhookDeliveryResponseList converte slice de entregas para slice de responses
+func ToWebhookDeliveryResponseList(deliveries []*domain.WebhookDelivery) []*WebhookDeliveryResponse {
+	responses := make([]*WebhookDeliveryResponse, len(deliveries))
+	for i, delivery := range deliveries {
+		responses[i] = ToWebhookDeliveryResponse(delivery)
+	}
+	return responses
+}
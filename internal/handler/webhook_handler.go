@@ -0,0 +1,274 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
+)
+
+// WebhookHandler lida com requisições HTTP de cadastro de webhooks e registro de entregas
+type WebhookHandler struct {
+	webhookService *service.WebhookService
+	validator      *validator.Validate
+}
+
+// NewWebhookHandler cria uma nova instância do handler
+func NewWebhookHandler(webhookService *service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+		validator:      validator.New(),
+	}
+}
+
+// CreateEndpoint godoc
+// @Summary      Cadastrar endpoint de webhook
+// @Description  Cadastra uma URL para receber os eventos selecionados. As entregas são assinadas com HMAC-SHA256 no header X-Webhook-Signature (t=<timestamp>,v1=<assinatura de "<timestamp>.<corpo>">). O segredo é exibido apenas nesta resposta
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        request body CreateWebhookEndpointRequest true "Dados do endpoint"
+// @Success      201 {object} WebhookEndpointSecretResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /webhook-endpoints [post]
+func (h *WebhookHandler) CreateEndpoint(w http.ResponseWriter, r *http.Request) {
+	var req CreateWebhookEndpointRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	endpoint, err := h.webhookService.CreateEndpoint(r.Context(), req.URL, req.Description, toWebhookEventTypes(req.Events))
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusCreated, "Webhook endpoint created successfully", ToWebhookEndpointSecretResponse(endpoint))
+}
+
+// ListEndpoints godoc
+// @Summary      Listar endpoints de webhook
+// @Description  Retorna os endpoints cadastrados e os eventos inscritos
+// @Tags         Webhooks
+// @Produce      json
+// @Success      200 {array} WebhookEndpointResponse
+// @Failure      500 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /webhook-endpoints [get]
+func (h *WebhookHandler) ListEndpoints(w http.ResponseWriter, r *http.Request) {
+	endpoints, err := h.webhookService.ListEndpoints(r.Context())
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Webhook endpoints retrieved successfully", ToWebhookEndpointResponseList(endpoints))
+}
+
+// GetEndpoint godoc
+// @Summary      Buscar endpoint de webhook
+// @Description  Retorna os dados de um endpoint
+// @Tags         Webhooks
+// @Produce      json
+// @Param        id path string true "Webhook endpoint ID (UUID)"
+// @Success      200 {object} WebhookEndpointResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /webhook-endpoints/{id} [get]
+func (h *WebhookHandler) GetEndpoint(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid webhook endpoint ID")
+	if !ok {
+		return
+	}
+
+	endpoint, err := h.webhookService.GetEndpoint(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Webhook endpoint retrieved successfully", ToWebhookEndpointResponse(endpoint))
+}
+
+// UpdateEndpoint godoc
+// @Summary      Atualizar endpoint de webhook
+// @Description  Altera URL, descrição, eventos inscritos e situação. Endpoints desativados não recebem novos eventos e as entregas pendentes são encerradas
+// @Tags         Webhooks
+// @Accept       json
+// @Produce      json
+// @Param        id path string true "Webhook endpoint ID (UUID)"
+// @Param        request body UpdateWebhookEndpointRequest true "Dados do endpoint"
+// @Success      200 {object} WebhookEndpointResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /webhook-endpoints/{id} [put]
+func (h *WebhookHandler) UpdateEndpoint(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid webhook endpoint ID")
+	if !ok {
+		return
+	}
+
+	var req UpdateWebhookEndpointRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		response.Error(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if err := h.validator.Struct(req); err != nil {
+		response.Error(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	endpoint, err := h.webhookService.UpdateEndpoint(r.Context(), id, req.URL, req.Description, toWebhookEventTypes(req.Events), *req.Active)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Webhook endpoint updated successfully", ToWebhookEndpointResponse(endpoint))
+}
+
+// DeleteEndpoint godoc
+// @Summary      Remover endpoint de webhook
+// @Description  Remove o endpoint e seu registro de entregas
+// @Tags         Webhooks
+// @Produce      json
+// @Param        id path string true "Webhook endpoint ID (UUID)"
+// @Success      200 {object} response.Response
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /webhook-endpoints/{id} [delete]
+func (h *WebhookHandler) DeleteEndpoint(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid webhook endpoint ID")
+	if !ok {
+		return
+	}
+
+	if err := h.webhookService.DeleteEndpoint(r.Context(), id); err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Webhook endpoint deleted successfully", nil)
+}
+
+// RotateSecret godoc
+// @Summary      Rotacionar segredo do webhook
+// @Description  Gera um novo segredo de assinatura. O segredo anterior deixa de ser usado imediatamente
+// @Tags         Webhooks
+// @Produce      json
+// @Param        id path string true "Webhook endpoint ID (UUID)"
+// @Success      200 {object} WebhookEndpointSecretResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /webhook-endpoints/{id}/rotate-secret [post]
+func (h *WebhookHandler) RotateSecret(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid webhook endpoint ID")
+	if !ok {
+		return
+	}
+
+	endpoint, err := h.webhookService.RotateSecret(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Webhook secret rotated successfully", ToWebhookEndpointSecretResponse(endpoint))
+}
+
+// ListDeliveries godoc
+// @Summary      Registro de entregas do webhook
+// @Description  Retorna as entregas mais recentes do endpoint com status, tentativas e resposta
+// @Tags         Webhooks
+// @Produce      json
+// @Param        id path string true "Webhook endpoint ID (UUID)"
+// @Param        limit query int false "Quantidade de entregas (padrão 50)"
+// @Success      200 {array} WebhookDeliveryResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /webhook-endpoints/{id}/deliveries [get]
+func (h *WebhookHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid webhook endpoint ID")
+	if !ok {
+		return
+	}
+
+	limit := 0
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit <= 0 {
+			response.Error(w, http.StatusBadRequest, "Invalid limit parameter")
+			return
+		}
+		limit = parsedLimit
+	}
+
+	deliveries, err := h.webhookService.ListDeliveries(r.Context(), id, limit)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Webhook deliveries retrieved successfully", ToWebhookDeliveryResponseList(deliveries))
+}
+
+// ReplayDelivery godoc
+// @Summary      Reenviar entrega de webhook
+// @Description  Reenvia o evento de uma entrega concluída ou que falhou, criando uma nova entrega com o mesmo event_id (o receptor pode usá-lo para ignorar duplicatas)
+// @Tags         Webhooks
+// @Produce      json
+// @Param        id path string true "Webhook delivery ID (UUID)"
+// @Success      200 {object} WebhookDeliveryResponse
+// @Failure      400 {object} response.ErrorResponse
+// @Failure      404 {object} response.ErrorResponse
+// @Failure      409 {object} response.ErrorResponse
+// @Security     BearerAuth
+// @Router       /webhook-deliveries/{id}/replay [post]
+func (h *WebhookHandler) ReplayDelivery(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseUUIDParam(w, r, "id", "Invalid webhook delivery ID")
+	if !ok {
+		return
+	}
+
+	delivery, err := h.webhookService.ReplayDelivery(r.Context(), id)
+	if err != nil {
+		h.handleServiceError(w, err)
+		return
+	}
+
+	response.Success(w, http.StatusOK, "Webhook delivery replayed successfully", ToWebhookDeliveryResponse(delivery))
+}
+
+// handleServiceError mapeia erros do service para respostas HTTP
+func (h *WebhookHandler) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrWebhookEndpointNotFound),
+		errors.Is(err, service.ErrWebhookDeliveryNotFound):
+		response.Error(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidWebhookURL),
+		errors.Is(err, domain.ErrInvalidWebhookDescription),
+		errors.Is(err, domain.ErrInvalidWebhookEventType),
+		errors.Is(err, domain.ErrWebhookEventsRequired):
+		response.Error(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, domain.ErrWebhookDeliveryPending):
+		response.Error(w, http.StatusConflict, err.Error())
+	default:
+		response.Error(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
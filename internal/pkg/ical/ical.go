@@ -0,0 +1,170 @@
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentType é o tipo de conteúdo dos feeds iCalendar
+const ContentType = "text/calendar; charset=utf-8"
+
+// maxLineOctets é o tamanho máximo de uma linha de conteúdo (RFC 5545, seção 3.1)
+const maxLineOctets = 75
+
+// Calendar representa um calendário iCalendar (VCALENDAR)
+type Calendar struct {
+	ProdID      string        // Identificador do produto (ex: -//Kitnet Manager//Agenda//PT-BR)
+	Name        string        // Nome exibido pelos aplicativos de calendário (X-WR-CALNAME)
+	Description string        // Descrição do calendário (X-WR-CALDESC)
+	RefreshTTL  time.Duration // Intervalo sugerido de atualização do feed (REFRESH-INTERVAL)
+	Events      []Event
+}
+
+// Event representa um evento (VEVENT) de dia inteiro ou com horário
+// O UID deve ser estável: os aplicativos substituem o evento existente em vez de duplicá-lo
+type Event struct {
+	UID          string
+	Summary      string
+	Description  string
+	Location     string
+	Categories   []string
+	Date         time.Time // Apenas a data é considerada (evento de dia inteiro)
+	Start        time.Time // Quando preenchido, o evento tem horário e Date é ignorado
+	Duration     time.Duration
+	LastModified time.Time
+}
+
+// Write gera o calendário no formato iCalendar (RFC 5545)
+func (c *Calendar) Write(w io.Writer, now time.Time) error {
+	lw := &lineWriter{w: bufio.NewWriter(w)}
+
+	lw.line("BEGIN:VCALENDAR")
+	lw.line("VERSION:2.0")
+	lw.line("PRODID:" + c.ProdID)
+	lw.line("CALSCALE:GREGORIAN")
+	lw.line("METHOD:PUBLISH")
+	if c.Name != "" {
+		lw.line("X-WR-CALNAME:" + Escape(c.Name))
+	}
+	if c.Description != "" {
+		lw.line("X-WR-CALDESC:" + Escape(c.Description))
+	}
+	if c.RefreshTTL > 0 {
+		lw.line("REFRESH-INTERVAL;VALUE=DURATION:" + formatDuration(c.RefreshTTL))
+		lw.line("X-PUBLISHED-TTL:" + formatDuration(c.RefreshTTL))
+	}
+
+	stamp := formatDateTime(now)
+	for _, event := range c.Events {
+		lw.line("BEGIN:VEVENT")
+		lw.line("UID:" + event.UID)
+		lw.line("DTSTAMP:" + stamp)
+		if event.Start.IsZero() {
+			lw.line("DTSTART;VALUE=DATE:" + formatDate(event.Date))
+			lw.line("DTEND;VALUE=DATE:" + formatDate(event.Date.AddDate(0, 0, 1)))
+		} else {
+			duration := event.Duration
+			if duration <= 0 {
+				duration = time.Hour
+			}
+			lw.line("DTSTART:" + formatDateTime(event.Start))
+			lw.line("DTEND:" + formatDateTime(event.Start.Add(duration)))
+		}
+		lw.line("SUMMARY:" + Escape(event.Summary))
+		if event.Description != "" {
+			lw.line("DESCRIPTION:" + Escape(event.Description))
+		}
+		if event.Location != "" {
+			lw.line("LOCATION:" + Escape(event.Location))
+		}
+		if len(event.Categories) > 0 {
+			categories := make([]string, len(event.Categories))
+			for i, category := range event.Categories {
+				categories[i] = Escape(category)
+			}
+			lw.line("CATEGORIES:" + strings.Join(categories, ","))
+		}
+		if !event.LastModified.IsZero() {
+			lw.line("LAST-MODIFIED:" + formatDateTime(event.LastModified))
+		}
+		lw.line("TRANSP:TRANSPARENT")
+		lw.line("END:VEVENT")
+	}
+
+	lw.line("END:VCALENDAR")
+	return lw.flush()
+}
+
+// Escape escapa um valor de texto (barra invertida, ponto e vírgula, vírgula e quebras de linha)
+func Escape(value string) string {
+	value = strings.ReplaceAll(value, "\r\n", "\n")
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(value)
+}
+
+// lineWriter escreve linhas de conteúdo terminadas em CRLF, dobrando as que excedem 75 octetos
+type lineWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (lw *lineWriter) line(content string) {
+	if lw.err != nil {
+		return
+	}
+
+	limit := maxLineOctets
+	for len(content) > limit {
+		// Não divide um caractere UTF-8 ao dobrar a linha
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		lw.write(content[:cut] + "\r\n ")
+		content = content[cut:]
+		// Linhas de continuação começam com um espaço, que conta no limite
+		limit = maxLineOctets - 1
+	}
+	lw.write(content + "\r\n")
+}
+
+func (lw *lineWriter) write(s string) {
+	if lw.err == nil {
+		_, lw.err = lw.w.WriteString(s)
+	}
+}
+
+func (lw *lineWriter) flush() error {
+	if lw.err != nil {
+		return lw.err
+	}
+	return lw.w.Flush()
+}
+
+// formatDate formata a data no formato DATE (AAAAMMDD)
+func formatDate(t time.Time) string {
+	return t.Format("20060102")
+}
+
+// formatDateTime formata o instante em UTC no formato DATE-TIME (AAAAMMDDTHHMMSSZ)
+func formatDateTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// formatDuration formata a duração no formato DURATION em horas (ex: PT6H)
+func formatDuration(d time.Duration) string {
+	hours := int(d.Hours())
+	if hours < 1 {
+		hours = 1
+	}
+	return "PT" + strconv.Itoa(hours) + "H"
+}
@@ -0,0 +1,76 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendar_Write(t *testing.T) {
+	now := time.Date(2026, 3, 7, 12, 30, 0, 0, time.UTC)
+	calendar := &Calendar{
+		ProdID:     "-//Kitnet Manager//Agenda//PT-BR",
+		Name:       "Kitnets",
+		RefreshTTL: 6 * time.Hour,
+		Events: []Event{
+			{
+				UID:          "payment-1@kitnet-manager",
+				Summary:      "Aluguel; unidade 101, bloco A",
+				Description:  "Valor: R$ 800,00\nMorador: João",
+				Categories:   []string{"Pagamento"},
+				Date:         time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+				LastModified: now,
+			},
+			{
+				UID:     "visit-1@kitnet-manager",
+				Summary: "Visita",
+				Start:   time.Date(2026, 3, 8, 14, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, calendar.Write(&buf, now))
+	out := buf.String()
+
+	assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+	assert.True(t, strings.HasSuffix(out, "END:VCALENDAR\r\n"))
+	assert.Contains(t, out, "X-WR-CALNAME:Kitnets\r\n")
+	assert.Contains(t, out, "REFRESH-INTERVAL;VALUE=DURATION:PT6H\r\n")
+	assert.Contains(t, out, "UID:payment-1@kitnet-manager\r\nDTSTAMP:20260307T123000Z\r\n")
+	assert.Contains(t, out, "DTSTART;VALUE=DATE:20260310\r\nDTEND;VALUE=DATE:20260311\r\n")
+	assert.Contains(t, out, `SUMMARY:Aluguel\; unidade 101\, bloco A`+"\r\n")
+	assert.Contains(t, out, `DESCRIPTION:Valor: R$ 800\,00\nMorador: João`+"\r\n")
+	assert.Contains(t, out, "DTSTART:20260308T140000Z\r\nDTEND:20260308T150000Z\r\n")
+	assert.Equal(t, 2, strings.Count(out, "BEGIN:VEVENT"))
+}
+
+func TestLineFolding(t *testing.T) {
+	var buf bytes.Buffer
+	calendar := &Calendar{
+		ProdID: "-//Test//PT-BR",
+		Events: []Event{{
+			UID:         "long",
+			Summary:     "x",
+			Description: strings.Repeat("ação ", 40),
+			Date:        time.Now(),
+		}},
+	}
+	require.NoError(t, calendar.Write(&buf, time.Now()))
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n")
+	var unfolded strings.Builder
+	for _, line := range lines {
+		assert.LessOrEqual(t, len(line), 75)
+		if strings.HasPrefix(line, " ") {
+			unfolded.WriteString(line[1:])
+			continue
+		}
+		unfolded.WriteString("\n" + line)
+	}
+	assert.Contains(t, unfolded.String(), "DESCRIPTION:"+strings.Repeat("ação ", 40)+"\n")
+}
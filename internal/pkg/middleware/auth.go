@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/response"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
@@ -16,6 +17,8 @@ type contextKey string
 const (
 	// UserContextKey é a key usada para armazenar o usuário no context
 	UserContextKey contextKey = "user"
+	// TenantIDContextKey é a key usada para armazenar o morador do portal no context
+	TenantIDContextKey contextKey = "tenant_id"
 )
 
 // AuthMiddleware é o middleware de autenticação JWT
@@ -99,6 +102,32 @@ func (m *AuthMiddleware) RequireAdminOrManager(next http.Handler) http.Handler {
 	return m.RequireRole(domain.UserRoleAdmin, domain.UserRoleManager)(next)
 }
 
+// RequireStaff libera o acesso apenas à equipe de gestão (admin, manager e viewer)
+// Moradores autenticados no portal não acessam as rotas de gestão
+func (m *AuthMiddleware) RequireStaff(next http.Handler) http.Handler {
+	return m.RequireRole(domain.StaffRoles...)(next)
+}
+
+// RequireTenant libera o acesso apenas a moradores do portal
+// O morador vinculado ao token é armazenado no context para limitar as consultas aos seus dados
+func (m *AuthMiddleware) RequireTenant(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, ok := r.Context().Value(UserContextKey).(*domain.User)
+		if !ok || user == nil {
+			response.Error(w, http.StatusUnauthorized, "User not authenticated")
+			return
+		}
+
+		if !user.IsTenant() || user.TenantID == nil {
+			response.Error(w, http.StatusForbidden, "Insufficient permissions")
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), TenantIDContextKey, *user.TenantID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 // extractToken extrai o token JWT do header Authorization
 func extractToken(r *http.Request) string {
 	bearerToken := r.Header.Get("Authorization")
@@ -121,3 +150,10 @@ func GetUserFromContext(ctx context.Context) (*domain.User, bool) {
 	user, ok := ctx.Value(UserContextKey).(*domain.User)
 	return user, ok
 }
+
+// GetTenantIDFromContext retorna o morador autenticado no portal
+// Disponível apenas em rotas protegidas por RequireTenant
+func GetTenantIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	tenantID, ok := ctx.Value(TenantIDContextKey).(uuid.UUID)
+	return tenantID, ok
+}
@@ -0,0 +1,166 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Messaging errors
+var (
+	ErrProviderURLRequired = errors.New("messaging provider URL is required")
+	ErrInvalidPhoneNumber  = errors.New("phone number must be in E.164 format")
+	ErrProviderAuth        = errors.New("messaging provider rejected the credentials")
+)
+
+// messagingTimeout limita o tempo de cada requisição ao provedor
+const messagingTimeout = 15 * time.Second
+
+// DeliveryStatus representa o status de entrega informado pelo provedor de mensagens
+type DeliveryStatus string
+
+const (
+	DeliveryStatusQueued      DeliveryStatus = "queued"
+	DeliveryStatusSent        DeliveryStatus = "sent"
+	DeliveryStatusDelivered   DeliveryStatus = "delivered"
+	DeliveryStatusRead        DeliveryStatus = "read"
+	DeliveryStatusFailed      DeliveryStatus = "failed"
+	DeliveryStatusUndelivered DeliveryStatus = "undelivered"
+)
+
+// IsValidDeliveryStatus verifica se o status é conhecido
+func IsValidDeliveryStatus(status DeliveryStatus) bool {
+	switch status {
+	case DeliveryStatusQueued, DeliveryStatusSent, DeliveryStatusDelivered,
+		DeliveryStatusRead, DeliveryStatusFailed, DeliveryStatusUndelivered:
+		return true
+	}
+	return false
+}
+
+// ProviderMessage representa uma mensagem curta (WhatsApp ou SMS) enviada ao provedor
+type ProviderMessage struct {
+	Channel string `json:"channel"` // whatsapp ou sms
+	To      string `json:"to"`      // Telefone em E.164 (ex: +5511987654321)
+	Body    string `json:"body"`
+}
+
+// MessagingProvider define o contrato de um provedor de WhatsApp/SMS
+// Permite trocar o provedor sem alterar o serviço de notificações
+type MessagingProvider interface {
+	// SendMessage envia a mensagem e retorna o identificador atribuído pelo provedor
+	SendMessage(ctx context.Context, msg ProviderMessage) (string, error)
+}
+
+// MessagingSender adapta um MessagingProvider a um canal de envio (Sender)
+type MessagingSender struct {
+	provider MessagingProvider
+	channel  string
+}
+
+// NewMessagingSender cria o canal de envio para o canal do provedor (whatsapp ou sms)
+func NewMessagingSender(provider MessagingProvider, channel string) *MessagingSender {
+	return &MessagingSender{provider: provider, channel: channel}
+}
+
+// Send envia o corpo da mensagem para o telefone do destinatário, que deve estar em E.164
+func (s *MessagingSender) Send(ctx context.Context, msg Message) (string, error) {
+	to := strings.TrimSpace(msg.Recipient)
+	if to == "" {
+		return "", ErrEmptyRecipient
+	}
+	if !strings.HasPrefix(to, "+") {
+		return "", fmt.Errorf("%w: %s", ErrInvalidPhoneNumber, to)
+	}
+
+	return s.provider.SendMessage(ctx, ProviderMessage{Channel: s.channel, To: to, Body: msg.Body})
+}
+
+// HTTPProviderConfig contém as configurações do provedor de mensagens via HTTP
+type HTTPProviderConfig struct {
+	URL   string // Endpoint que recebe as mensagens (POST JSON)
+	Token string // Enviado como "Authorization: Bearer <token>"
+}
+
+// HTTPProvider envia mensagens para um provedor genérico via HTTP
+// O provedor responde com {"id": "..."}; códigos 4xx indicam recusa do destinatário
+type HTTPProvider struct {
+	config HTTPProviderConfig
+	client *http.Client
+}
+
+// NewHTTPProvider cria o provedor validando a URL configurada
+func NewHTTPProvider(config HTTPProviderConfig) (*HTTPProvider, error) {
+	if strings.TrimSpace(config.URL) == "" {
+		return nil, ErrProviderURLRequired
+	}
+	parsed, err := url.Parse(config.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid messaging provider URL: %s", config.URL)
+	}
+
+	return &HTTPProvider{
+		config: config,
+		client: &http.Client{Timeout: messagingTimeout},
+	}, nil
+}
+
+// providerResponse representa a resposta do provedor
+type providerResponse struct {
+	ID        string `json:"id"`
+	MessageID string `json:"message_id"`
+	Error     string `json:"error"`
+}
+
+// SendMessage envia a mensagem ao provedor e retorna o identificador atribuído
+func (p *HTTPProvider) SendMessage(ctx context.Context, msg ProviderMessage) (string, error) {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.URL, bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("failed to build provider request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.config.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+p.config.Token)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to reach messaging provider: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	var parsed providerResponse
+	_ = json.Unmarshal(body, &parsed)
+
+	reason := parsed.Error
+	if reason == "" {
+		reason = strings.TrimSpace(string(body))
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return "", ErrProviderAuth
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return "", fmt.Errorf("messaging provider unavailable: %d %s", resp.StatusCode, reason)
+	case resp.StatusCode >= 400:
+		return "", fmt.Errorf("%w: %d %s", ErrRecipientRejected, resp.StatusCode, reason)
+	}
+
+	if parsed.ID != "" {
+		return parsed.ID, nil
+	}
+	return parsed.MessageID, nil
+}
@@ -0,0 +1,94 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newMockProviderServer sobe um provedor de mensagens falso que responde com o status informado
+func newMockProviderServer(t *testing.T, status int, body string, received *ProviderMessage) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "Bearer secret-token", r.Header.Get("Authorization"))
+		if received != nil {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(received))
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNewHTTPProvider(t *testing.T) {
+	_, err := NewHTTPProvider(HTTPProviderConfig{})
+	assert.Equal(t, ErrProviderURLRequired, err)
+
+	_, err = NewHTTPProvider(HTTPProviderConfig{URL: "ftp://provider"})
+	assert.Error(t, err)
+}
+
+func TestMessagingSender_Send(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should send message through HTTP provider", func(t *testing.T) {
+		var received ProviderMessage
+		server := newMockProviderServer(t, http.StatusAccepted, `{"id":"wamid.123"}`, &received)
+		provider, err := NewHTTPProvider(HTTPProviderConfig{URL: server.URL, Token: "secret-token"})
+		require.NoError(t, err)
+		sender := NewMessagingSender(provider, "whatsapp")
+
+		messageID, err := sender.Send(ctx, Message{Recipient: "+5511987654321", Subject: "Lembrete", Body: "Olá!"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "wamid.123", messageID)
+		assert.Equal(t, ProviderMessage{Channel: "whatsapp", To: "+5511987654321", Body: "Olá!"}, received)
+	})
+
+	t.Run("should classify provider responses", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			status   int
+			rejected bool
+			authErr  bool
+		}{
+			{"invalid number", http.StatusUnprocessableEntity, true, false},
+			{"bad credentials", http.StatusUnauthorized, false, true},
+			{"rate limited", http.StatusTooManyRequests, false, false},
+			{"server error", http.StatusBadGateway, false, false},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				server := newMockProviderServer(t, tt.status, `{"error":"number not on whatsapp"}`, nil)
+				provider, err := NewHTTPProvider(HTTPProviderConfig{URL: server.URL, Token: "secret-token"})
+				require.NoError(t, err)
+
+				_, err = NewMessagingSender(provider, "sms").Send(ctx, Message{Recipient: "+5511987654321", Body: "Olá!"})
+
+				require.Error(t, err)
+				assert.Equal(t, tt.rejected, errors.Is(err, ErrRecipientRejected))
+				assert.Equal(t, tt.authErr, errors.Is(err, ErrProviderAuth))
+			})
+		}
+	})
+
+	t.Run("should require E.164 recipient", func(t *testing.T) {
+		sender := NewMessagingSender(nil, "sms")
+
+		_, err := sender.Send(ctx, Message{Recipient: "(11) 98765-4321", Body: "Olá!"})
+		assert.ErrorIs(t, err, ErrInvalidPhoneNumber)
+
+		_, err = sender.Send(ctx, Message{Recipient: "", Body: "Olá!"})
+		assert.Equal(t, ErrEmptyRecipient, err)
+	})
+}
@@ -0,0 +1,62 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+)
+
+// Notifier errors
+var (
+	ErrEmptyRecipient = errors.New("message recipient is required")
+	// ErrRecipientRejected indica recusa definitiva do destinatário (bounce); o envio não deve ser repetido
+	ErrRecipientRejected = errors.New("recipient rejected by provider")
+)
+
+// Attachment representa um arquivo anexado à mensagem
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+// Message representa uma mensagem pronta para envio por um canal
+type Message struct {
+	Recipient   string // Telefone ou e-mail, conforme o canal
+	Subject     string
+	Body        string // Texto puro, usado por todos os canais
+	HTMLBody    string // Versão HTML, usada apenas por e-mail
+	Attachments []Attachment
+}
+
+// Sender define o contrato de um canal de envio de mensagens
+type Sender interface {
+	// Send entrega a mensagem e retorna o identificador atribuído pelo provedor (pode ser vazio)
+	// Um erro que não seja ErrRecipientRejected indica que o envio pode ser tentado novamente
+	Send(ctx context.Context, msg Message) (string, error)
+}
+
+// LogSender é um canal que apenas registra a mensagem no log da aplicação
+// Usado pelo canal interno, em que a equipe acompanha as notificações pelo sistema
+type LogSender struct {
+	logger *log.Logger
+}
+
+// NewLogSender cria um LogSender; sem logger, usa o logger padrão
+func NewLogSender(logger *log.Logger) *LogSender {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &LogSender{logger: logger}
+}
+
+// Send registra a mensagem no log
+func (s *LogSender) Send(ctx context.Context, msg Message) (string, error) {
+	if strings.TrimSpace(msg.Recipient) == "" {
+		return "", ErrEmptyRecipient
+	}
+
+	s.logger.Printf("🔔 Notificação para %s: %s", msg.Recipient, msg.Subject)
+	return "", nil
+}
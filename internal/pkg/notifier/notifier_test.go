@@ -0,0 +1,30 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogSender_Send(t *testing.T) {
+	var buf bytes.Buffer
+	sender := NewLogSender(log.New(&buf, "", 0))
+
+	t.Run("should log message", func(t *testing.T) {
+		_, err := sender.Send(context.Background(), Message{Recipient: "(11) 99999-0000", Subject: "Lembrete de aluguel", Body: "..."})
+
+		require.NoError(t, err)
+		assert.Contains(t, buf.String(), "(11) 99999-0000")
+		assert.Contains(t, buf.String(), "Lembrete de aluguel")
+	})
+
+	t.Run("should require recipient", func(t *testing.T) {
+		_, err := sender.Send(context.Background(), Message{Recipient: " ", Subject: "Lembrete"})
+
+		assert.Equal(t, ErrEmptyRecipient, err)
+	})
+}
@@ -0,0 +1,313 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SMTP errors
+var (
+	ErrSMTPHostRequired = errors.New("SMTP host is required")
+	ErrInvalidFrom      = errors.New("invalid sender address")
+	ErrInvalidEmail     = errors.New("invalid recipient email")
+)
+
+// smtpDialTimeout limita o tempo de conexão com o servidor SMTP
+const smtpDialTimeout = 15 * time.Second
+
+// SMTPConfig contém as configurações do servidor de e-mail
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string // Vazio desabilita a autenticação
+	Password string
+	From     string // Endereço do remetente
+	FromName string // Nome exibido do remetente
+}
+
+// SMTPSender envia e-mails por um servidor SMTP
+// Usa STARTTLS quando o servidor oferece a extensão
+type SMTPSender struct {
+	config SMTPConfig
+	from   mail.Address
+}
+
+// NewSMTPSender cria um SMTPSender validando a configuração
+func NewSMTPSender(config SMTPConfig) (*SMTPSender, error) {
+	if strings.TrimSpace(config.Host) == "" {
+		return nil, ErrSMTPHostRequired
+	}
+	if config.Port == 0 {
+		config.Port = 587
+	}
+
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, ErrInvalidFrom
+	}
+	if config.FromName != "" {
+		from.Name = config.FromName
+	}
+
+	return &SMTPSender{config: config, from: *from}, nil
+}
+
+// Send entrega o e-mail e retorna o Message-ID gerado
+// Recusas permanentes do servidor (códigos 5xx) são retornadas como ErrRecipientRejected
+func (s *SMTPSender) Send(ctx context.Context, msg Message) (string, error) {
+	to, err := mail.ParseAddress(strings.TrimSpace(msg.Recipient))
+	if err != nil {
+		if strings.TrimSpace(msg.Recipient) == "" {
+			return "", ErrEmptyRecipient
+		}
+		return "", fmt.Errorf("%w: %s", ErrInvalidEmail, msg.Recipient)
+	}
+
+	messageID := s.newMessageID()
+	content, err := BuildMIMEMessage(s.from, *to, messageID, time.Now(), msg)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.deliver(ctx, to.Address, content); err != nil {
+		return "", err
+	}
+
+	return messageID, nil
+}
+
+// deliver executa a conversa SMTP com o servidor
+func (s *SMTPSender) deliver(ctx context.Context, recipient string, content []byte) error {
+	address := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+
+	dialer := &net.Dialer{Timeout: smtpDialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.config.Host}); err != nil {
+			return fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+
+	if s.config.Username != "" {
+		auth := smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)
+		if err := client.Auth(auth); err != nil {
+			return fmt.Errorf("SMTP authentication failed: %w", err)
+		}
+	}
+
+	if err := client.Mail(s.from.Address); err != nil {
+		return classifySMTPError("MAIL FROM", err)
+	}
+	if err := client.Rcpt(recipient); err != nil {
+		return classifySMTPError("RCPT TO", err)
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return classifySMTPError("DATA", err)
+	}
+	if _, err := writer.Write(content); err != nil {
+		_ = writer.Close()
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return classifySMTPError("DATA", err)
+	}
+
+	return client.Quit()
+}
+
+// newMessageID gera um Message-ID no domínio do remetente
+func (s *SMTPSender) newMessageID() string {
+	domain := s.config.Host
+	if at := strings.LastIndex(s.from.Address, "@"); at >= 0 {
+		domain = s.from.Address[at+1:]
+	}
+	return fmt.Sprintf("<%s@%s>", uuid.New(), domain)
+}
+
+// classifySMTPError diferencia recusas permanentes (5xx) de falhas temporárias
+func classifySMTPError(command string, err error) error {
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) && protoErr.Code >= 500 {
+		return fmt.Errorf("%w: %s %d %s", ErrRecipientRejected, command, protoErr.Code, protoErr.Msg)
+	}
+	return fmt.Errorf("SMTP %s failed: %w", command, err)
+}
+
+// BuildMIMEMessage monta o e-mail com texto, HTML opcional e anexos
+func BuildMIMEMessage(from, to mail.Address, messageID string, date time.Time, msg Message) ([]byte, error) {
+	var buf bytes.Buffer
+
+	headers := []string{
+		"From: " + from.String(),
+		"To: " + to.String(),
+		"Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject),
+		"Date: " + date.Format(time.RFC1123Z),
+		"Message-ID: " + messageID,
+		"MIME-Version: 1.0",
+	}
+	for _, header := range headers {
+		buf.WriteString(header + "\r\n")
+	}
+
+	bodyHeader, body, err := buildBody(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.Attachments) == 0 {
+		writeHeader(&buf, bodyHeader)
+		buf.Write(body)
+		return buf.Bytes(), nil
+	}
+
+	mixed := multipart.NewWriter(&buf)
+	buf.WriteString("Content-Type: multipart/mixed; boundary=" + mixed.Boundary() + "\r\n\r\n")
+
+	bodyPart, err := mixed.CreatePart(bodyHeader)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := bodyPart.Write(body); err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range msg.Attachments {
+		contentType := attachment.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		part, err := mixed.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {mime.FormatMediaType(contentType, map[string]string{"name": attachment.Filename})},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename})},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeBase64Lines(part, attachment.Content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mixed.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// buildBody monta o corpo da mensagem (texto ou texto + HTML) e seus cabeçalhos
+func buildBody(msg Message) (textproto.MIMEHeader, []byte, error) {
+	if msg.HTMLBody == "" {
+		body, err := encodeQuotedPrintable(msg.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return textproto.MIMEHeader{
+			"Content-Type":              {"text/plain; charset=utf-8"},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		}, body, nil
+	}
+
+	var buf bytes.Buffer
+	alternative := multipart.NewWriter(&buf)
+
+	parts := []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", msg.Body},
+		{"text/html; charset=utf-8", msg.HTMLBody},
+	}
+	for _, p := range parts {
+		part, err := alternative.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {p.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		encoded, err := encodeQuotedPrintable(p.content)
+		if err != nil {
+			return nil, nil, err
+		}
+		if _, err := part.Write(encoded); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := alternative.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	return textproto.MIMEHeader{
+		"Content-Type": {"multipart/alternative; boundary=" + alternative.Boundary()},
+	}, buf.Bytes(), nil
+}
+
+// writeHeader escreve os cabeçalhos do corpo seguidos da linha em branco
+func writeHeader(buf *bytes.Buffer, header textproto.MIMEHeader) {
+	for _, key := range []string{"Content-Type", "Content-Transfer-Encoding"} {
+		if value := header.Get(key); value != "" {
+			buf.WriteString(key + ": " + value + "\r\n")
+		}
+	}
+	buf.WriteString("\r\n")
+}
+
+// encodeQuotedPrintable codifica o texto em quoted-printable
+func encodeQuotedPrintable(content string) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := quotedprintable.NewWriter(&buf)
+	if _, err := writer.Write([]byte(content)); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeBase64Lines codifica o conteúdo em base64 com linhas de 76 caracteres
+func writeBase64Lines(w io.Writer, content []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(content)
+	for len(encoded) > 76 {
+		if _, err := w.Write([]byte(encoded[:76] + "\r\n")); err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+	_, err := w.Write([]byte(encoded + "\r\n"))
+	return err
+}
@@ -0,0 +1,196 @@
+package notifier
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/emersion/go-smtp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPBackend é um servidor SMTP em memória usado nos testes
+type fakeSMTPBackend struct {
+	mu           sync.Mutex
+	rejectRcpt   bool
+	username     string
+	password     string
+	from         string
+	recipients   []string
+	data         []string
+	authAttempts int
+}
+
+func (b *fakeSMTPBackend) Login(_ *smtp.ConnectionState, username, password string) (smtp.Session, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.authAttempts++
+	if username != b.username || password != b.password {
+		return nil, &smtp.SMTPError{Code: 535, Message: "invalid credentials"}
+	}
+	return &fakeSMTPSession{backend: b}, nil
+}
+
+func (b *fakeSMTPBackend) AnonymousLogin(_ *smtp.ConnectionState) (smtp.Session, error) {
+	return &fakeSMTPSession{backend: b}, nil
+}
+
+type fakeSMTPSession struct {
+	backend *fakeSMTPBackend
+}
+
+func (s *fakeSMTPSession) Reset()        {}
+func (s *fakeSMTPSession) Logout() error { return nil }
+
+func (s *fakeSMTPSession) Mail(from string, _ smtp.MailOptions) error {
+	s.backend.mu.Lock()
+	defer s.backend.mu.Unlock()
+	s.backend.from = from
+	return nil
+}
+
+func (s *fakeSMTPSession) Rcpt(to string) error {
+	s.backend.mu.Lock()
+	defer s.backend.mu.Unlock()
+	if s.backend.rejectRcpt {
+		return &smtp.SMTPError{Code: 550, EnhancedCode: smtp.EnhancedCode{5, 1, 1}, Message: "mailbox unavailable"}
+	}
+	s.backend.recipients = append(s.backend.recipients, to)
+	return nil
+}
+
+func (s *fakeSMTPSession) Data(r io.Reader) error {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	s.backend.mu.Lock()
+	defer s.backend.mu.Unlock()
+	s.backend.data = append(s.backend.data, string(content))
+	return nil
+}
+
+// startFakeSMTPServer sobe o servidor em uma porta livre e retorna a configuração do remetente
+func startFakeSMTPServer(t *testing.T, backend *fakeSMTPBackend) SMTPConfig {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := smtp.NewServer(backend)
+	server.Domain = "localhost"
+	server.AllowInsecureAuth = true
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(func() { _ = server.Close() })
+
+	return SMTPConfig{
+		Host:     "127.0.0.1",
+		Port:     listener.Addr().(*net.TCPAddr).Port,
+		Username: backend.username,
+		Password: backend.password,
+		From:     "cobranca@kitnets.com.br",
+		FromName: "Kitnets Gabriel",
+	}
+}
+
+func TestNewSMTPSender(t *testing.T) {
+	t.Run("should require host", func(t *testing.T) {
+		_, err := NewSMTPSender(SMTPConfig{From: "a@b.com"})
+		assert.Equal(t, ErrSMTPHostRequired, err)
+	})
+
+	t.Run("should validate sender address", func(t *testing.T) {
+		_, err := NewSMTPSender(SMTPConfig{Host: "smtp.example.com", From: "invalido"})
+		assert.Equal(t, ErrInvalidFrom, err)
+	})
+
+	t.Run("should default port to 587", func(t *testing.T) {
+		sender, err := NewSMTPSender(SMTPConfig{Host: "smtp.example.com", From: "a@b.com"})
+		require.NoError(t, err)
+		assert.Equal(t, 587, sender.config.Port)
+	})
+}
+
+func TestSMTPSender_Send(t *testing.T) {
+	t.Run("should deliver message with html and attachment", func(t *testing.T) {
+		backend := &fakeSMTPBackend{username: "user", password: "secret"}
+		sender, err := NewSMTPSender(startFakeSMTPServer(t, backend))
+		require.NoError(t, err)
+
+		messageID, err := sender.Send(context.Background(), Message{
+			Recipient: "Maria Silva <maria@example.com>",
+			Subject:   "Recibo de pagamento - unidade 101",
+			Body:      "Confirmamos o recebimento.",
+			HTMLBody:  "<p>Confirmamos o recebimento.</p>",
+			Attachments: []Attachment{
+				{Filename: "recibo.pdf", ContentType: "application/pdf", Content: []byte("%PDF-1.3 fake")},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(messageID, "<"))
+		assert.True(t, strings.HasSuffix(messageID, "@kitnets.com.br>"))
+
+		backend.mu.Lock()
+		defer backend.mu.Unlock()
+		assert.Equal(t, 1, backend.authAttempts)
+		assert.Equal(t, "cobranca@kitnets.com.br", backend.from)
+		assert.Equal(t, []string{"maria@example.com"}, backend.recipients)
+		require.Len(t, backend.data, 1)
+		assert.Contains(t, backend.data[0], "Message-ID: "+messageID)
+		assert.Contains(t, backend.data[0], "multipart/mixed")
+		assert.Contains(t, backend.data[0], "multipart/alternative")
+		assert.Contains(t, backend.data[0], "filename=recibo.pdf")
+	})
+
+	t.Run("should return ErrRecipientRejected on permanent failure", func(t *testing.T) {
+		backend := &fakeSMTPBackend{rejectRcpt: true}
+		sender, err := NewSMTPSender(startFakeSMTPServer(t, backend))
+		require.NoError(t, err)
+
+		_, err = sender.Send(context.Background(), Message{Recipient: "inexistente@example.com", Subject: "Teste", Body: "..."})
+
+		assert.ErrorIs(t, err, ErrRecipientRejected)
+	})
+
+	t.Run("should validate recipient before connecting", func(t *testing.T) {
+		sender, err := NewSMTPSender(SMTPConfig{Host: "127.0.0.1", Port: 1, From: "a@b.com"})
+		require.NoError(t, err)
+
+		_, err = sender.Send(context.Background(), Message{Recipient: "sem-arroba", Body: "..."})
+		assert.ErrorIs(t, err, ErrInvalidEmail)
+
+		_, err = sender.Send(context.Background(), Message{Recipient: " ", Body: "..."})
+		assert.Equal(t, ErrEmptyRecipient, err)
+	})
+}
+
+func TestBuildMIMEMessage(t *testing.T) {
+	t.Run("should build plain text message", func(t *testing.T) {
+		content, err := BuildMIMEMessage(
+			mailAddress("Kitnets", "a@b.com"), mailAddress("", "c@d.com"), "<id@b.com>", fixedDate(),
+			Message{Subject: "Aviso de atraso", Body: "Olá, João!"},
+		)
+
+		require.NoError(t, err)
+		text := string(content)
+		assert.Contains(t, text, "Content-Type: text/plain; charset=utf-8")
+		assert.Contains(t, text, "Content-Transfer-Encoding: quoted-printable")
+		assert.NotContains(t, text, "multipart")
+		assert.Contains(t, text, "Ol=C3=A1, Jo=C3=A3o!")
+	})
+}
+
+func mailAddress(name, address string) mail.Address {
+	return mail.Address{Name: name, Address: address}
+}
+
+func fixedDate() time.Time {
+	return time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,84 @@
+package notifier
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// Template identifica um modelo de mensagem
+type Template string
+
+const (
+	TemplateRentReminder   Template = "rent_reminder"
+	TemplateOverdueNotice  Template = "overdue_notice"
+	TemplatePaymentReceipt Template = "payment_receipt"
+	TemplateLeaseExpiring  Template = "lease_expiring"
+	TemplateFormalNotice   Template = "formal_notice"
+)
+
+// TemplateData contém os dados já formatados usados nos modelos
+type TemplateData struct {
+	TenantName     string
+	UnitNumber     string
+	PropertyName   string
+	PaymentType    string // Descrição do tipo de pagamento (ex: Aluguel)
+	Amount         string // Valor formatado (ex: R$ 850,00)
+	ReferenceMonth string // MM/AAAA
+	DueDate        string // DD/MM/AAAA
+	PaymentDate    string // DD/MM/AAAA
+	PaymentMethod  string
+	DaysOverdue    int
+	EndDate        string // DD/MM/AAAA
+}
+
+// htmlTemplateData acrescenta o assunto aos dados do modelo HTML
+type htmlTemplateData struct {
+	TemplateData
+	Subject string
+}
+
+var (
+	textTemplates  = texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/*.txt.tmpl"))
+	shortTemplates = texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/*.sms.tmpl"))
+	htmlTemplates  = htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/*.html.tmpl"))
+)
+
+// Render monta assunto, texto e HTML da mensagem a partir do modelo
+// O destinatário e os anexos devem ser preenchidos pelo chamador
+func Render(name Template, data TemplateData) (Message, error) {
+	var subject, text, html bytes.Buffer
+
+	if err := textTemplates.ExecuteTemplate(&subject, string(name)+".subject", data); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s subject: %w", name, err)
+	}
+	if err := textTemplates.ExecuteTemplate(&text, string(name)+".txt.tmpl", data); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s text: %w", name, err)
+	}
+
+	htmlData := htmlTemplateData{TemplateData: data, Subject: subject.String()}
+	if err := htmlTemplates.ExecuteTemplate(&html, string(name)+".html.tmpl", htmlData); err != nil {
+		return Message{}, fmt.Errorf("failed to render %s html: %w", name, err)
+	}
+
+	return Message{
+		Subject:  strings.TrimSpace(subject.String()),
+		Body:     strings.TrimSpace(text.String()),
+		HTMLBody: html.String(),
+	}, nil
+}
+
+// RenderShort monta a versão curta da mensagem, usada por WhatsApp e SMS
+func RenderShort(name Template, data TemplateData) (string, error) {
+	var text bytes.Buffer
+	if err := shortTemplates.ExecuteTemplate(&text, string(name)+".sms.tmpl", data); err != nil {
+		return "", fmt.Errorf("failed to render %s short message: %w", name, err)
+	}
+	return strings.TrimSpace(text.String()), nil
+}
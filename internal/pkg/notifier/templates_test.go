@@ -0,0 +1,65 @@
+package notifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	data := TemplateData{
+		TenantName:     "Maria <Silva>",
+		UnitNumber:     "101",
+		PropertyName:   "Kitnets Gabriel",
+		PaymentType:    "Aluguel",
+		Amount:         "R$ 850,00",
+		ReferenceMonth: "03/2025",
+		DueDate:        "10/03/2025",
+		PaymentDate:    "09/03/2025",
+		PaymentMethod:  "PIX",
+		DaysOverdue:    5,
+		EndDate:        "30/04/2025",
+	}
+
+	t.Run("should render every template", func(t *testing.T) {
+		for _, name := range []Template{TemplateRentReminder, TemplateOverdueNotice, TemplatePaymentReceipt, TemplateLeaseExpiring, TemplateFormalNotice} {
+			msg, err := Render(name, data)
+
+			require.NoError(t, err, name)
+			assert.NotEmpty(t, msg.Subject, name)
+			assert.Contains(t, msg.Subject, "101", name)
+			assert.Contains(t, msg.Body, "Maria <Silva>", name)
+			assert.Contains(t, msg.HTMLBody, "Maria &lt;Silva&gt;", name)
+			assert.Contains(t, msg.HTMLBody, "<title>"+msg.Subject+"</title>", name)
+			assert.Contains(t, msg.HTMLBody, "Kitnets Gabriel", name)
+		}
+	})
+
+	t.Run("should fill payment fields", func(t *testing.T) {
+		msg, err := Render(TemplateOverdueNotice, data)
+
+		require.NoError(t, err)
+		assert.Contains(t, msg.Body, "R$ 850,00")
+		assert.Contains(t, msg.Body, "10/03/2025")
+		assert.Contains(t, msg.Body, "5")
+	})
+
+	t.Run("should fail for unknown template", func(t *testing.T) {
+		_, err := Render(Template("unknown"), data)
+		assert.Error(t, err)
+	})
+}
+
+func TestRenderShort(t *testing.T) {
+	data := TemplateData{TenantName: "Maria", UnitNumber: "101", PaymentType: "Aluguel", Amount: "R$ 850,00", ReferenceMonth: "03/2025", DueDate: "10/03/2025"}
+
+	for _, name := range []Template{TemplateRentReminder, TemplateOverdueNotice, TemplatePaymentReceipt, TemplateLeaseExpiring, TemplateFormalNotice} {
+		text, err := RenderShort(name, data)
+
+		require.NoError(t, err, name)
+		assert.Contains(t, text, "Maria", name)
+		assert.NotContains(t, text, "\n", name)
+		assert.LessOrEqual(t, len([]rune(text)), 320, name)
+	}
+}
@@ -0,0 +1,55 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// document encapsula o gofpdf com a conversão de UTF-8 para a codificação das fontes padrão
+type document struct {
+	pdf *gofpdf.Fpdf
+	tr  func(string) string
+}
+
+// newDocument cria um documento A4 em retrato com margens padrão
+func newDocument(title string) *document {
+	d := newBlankDocument(title)
+	d.pdf.AddPage()
+	return d
+}
+
+// newBlankDocument cria o documento sem páginas, permitindo configurar cabeçalho e rodapé antes da primeira
+func newBlankDocument(title string) *document {
+	p := gofpdf.New("P", "mm", "A4", "")
+	p.SetMargins(15, 15, 15)
+	p.SetAutoPageBreak(true, 15)
+	tr := p.UnicodeTranslatorFromDescriptor("")
+	p.SetTitle(title, true)
+	p.SetCreator("Kitnet Manager", true)
+	return &document{pdf: p, tr: tr}
+}
+
+// text escreve uma linha de texto com a fonte informada
+func (d *document) text(style string, size float64, height float64, content string) {
+	d.pdf.SetFont("Helvetica", style, size)
+	d.pdf.CellFormat(0, height, d.tr(content), "", 1, "L", false, 0, "")
+}
+
+// field escreve um par rótulo/valor em uma linha
+func (d *document) field(label, value string) {
+	d.pdf.SetFont("Helvetica", "B", 10)
+	d.pdf.CellFormat(50, 7, d.tr(label), "", 0, "L", false, 0, "")
+	d.pdf.SetFont("Helvetica", "", 10)
+	d.pdf.CellFormat(0, 7, d.tr(value), "", 1, "L", false, 0, "")
+}
+
+// bytes finaliza o documento e retorna o conteúdo
+func (d *document) bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := d.pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to render PDF: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,59 @@
+package pdf
+
+import (
+	"fmt"
+	"time"
+)
+
+// ReceiptData contém os dados já formatados do recibo de pagamento
+type ReceiptData struct {
+	Number         string // Identificador do pagamento
+	PropertyName   string
+	TenantName     string
+	TenantCPF      string
+	UnitNumber     string
+	PaymentType    string
+	ReferenceMonth string // MM/AAAA
+	DueDate        string // DD/MM/AAAA
+	PaymentDate    string // DD/MM/AAAA
+	PaymentMethod  string
+	Amount         string // Valor formatado (ex: R$ 850,00)
+	IssuedAt       time.Time
+}
+
+// RenderReceipt gera o recibo de pagamento em PDF
+func RenderReceipt(data ReceiptData) ([]byte, error) {
+	doc := newDocument("Recibo de pagamento " + data.Number)
+
+	if data.PropertyName != "" {
+		doc.text("B", 12, 7, data.PropertyName)
+	}
+	doc.text("B", 18, 12, "Recibo de pagamento")
+	doc.text("", 9, 5, "Nº "+data.Number)
+	doc.pdf.Ln(6)
+
+	doc.pdf.SetFont("Helvetica", "", 11)
+	statement := fmt.Sprintf(
+		"Recebemos de %s a quantia de %s referente a %s da unidade %s, competência %s.",
+		data.TenantName, data.Amount, data.PaymentType, data.UnitNumber, data.ReferenceMonth,
+	)
+	doc.pdf.MultiCell(0, 6, doc.tr(statement), "", "L", false)
+	doc.pdf.Ln(4)
+
+	doc.field("Inquilino", data.TenantName)
+	if data.TenantCPF != "" {
+		doc.field("CPF", data.TenantCPF)
+	}
+	doc.field("Unidade", data.UnitNumber)
+	doc.field("Tipo", data.PaymentType)
+	doc.field("Competência", data.ReferenceMonth)
+	doc.field("Vencimento", data.DueDate)
+	doc.field("Data do pagamento", data.PaymentDate)
+	doc.field("Forma de pagamento", data.PaymentMethod)
+	doc.field("Valor", data.Amount)
+
+	doc.pdf.Ln(10)
+	doc.text("I", 8, 5, "Emitido em "+data.IssuedAt.Format("02/01/2006 15:04"))
+
+	return doc.bytes()
+}
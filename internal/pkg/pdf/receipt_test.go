@@ -0,0 +1,33 @@
+package pdf
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderReceipt(t *testing.T) {
+	t.Run("should render receipt PDF", func(t *testing.T) {
+		content, err := RenderReceipt(ReceiptData{
+			Number:         "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+			PropertyName:   "Kitnets Gabriel",
+			TenantName:     "João Araújo",
+			TenantCPF:      "123.456.789-00",
+			UnitNumber:     "101",
+			PaymentType:    "Aluguel",
+			ReferenceMonth: "03/2025",
+			DueDate:        "10/03/2025",
+			PaymentDate:    "09/03/2025",
+			PaymentMethod:  "PIX",
+			Amount:         "R$ 850,00",
+			IssuedAt:       time.Date(2025, 3, 9, 14, 30, 0, 0, time.UTC),
+		})
+
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(content, []byte("%PDF-")))
+		assert.Greater(t, len(content), 500)
+	})
+}
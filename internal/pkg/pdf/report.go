@@ -0,0 +1,268 @@
+package pdf
+
+import (
+	"fmt"
+	"time"
+)
+
+// ReportData contém os dados já formatados de um relatório paginado
+type ReportData struct {
+	Title        string
+	PropertyName string // Opcional: imóvel filtrado
+	Period       string // Ex: 01/03/2025 a 31/03/2025
+	Summary      []ReportField
+	Chart        *BarChart // Opcional
+	Tables       []ReportTable
+	GeneratedAt  time.Time
+}
+
+// ReportField é um par rótulo/valor do resumo do relatório
+type ReportField struct {
+	Label string
+	Value string
+}
+
+// ReportTable é uma tabela do relatório; o cabeçalho é repetido a cada nova página
+type ReportTable struct {
+	Title   string
+	Columns []ReportColumn
+	Rows    [][]string
+	Footer  []string // Opcional: linha de totais
+	Empty   string   // Texto exibido quando não há linhas
+}
+
+// ReportColumn define o cabeçalho, a largura relativa e o alinhamento de uma coluna
+type ReportColumn struct {
+	Header     string
+	Width      float64 // Proporção da largura útil (as larguras são normalizadas)
+	AlignRight bool
+}
+
+// BarChart é um gráfico de barras verticais simples
+type BarChart struct {
+	Title string
+	Bars  []Bar
+}
+
+// Bar é uma barra do gráfico com o valor numérico e o rótulo já formatado
+type Bar struct {
+	Label      string
+	Value      float64
+	ValueLabel string
+}
+
+// Dimensões em milímetros dos elementos do relatório
+const (
+	reportRowHeight      = 6.5
+	reportChartHeight    = 55
+	reportChartLabelSize = 5
+	// reportMaxValueLabels é o número máximo de barras com valor escrito acima (acima disso o texto não cabe)
+	reportMaxValueLabels = 18
+)
+
+// RenderReport gera um relatório em PDF com cabeçalho, resumo, gráfico e tabelas paginadas
+func RenderReport(data ReportData) ([]byte, error) {
+	doc := newBlankDocument(data.Title)
+	p := doc.pdf
+
+	p.AliasNbPages("")
+	p.SetHeaderFuncMode(func() {
+		p.SetFont("Helvetica", "B", 9)
+		p.SetTextColor(90, 90, 90)
+		header := data.Title
+		if data.Period != "" {
+			header += " - " + data.Period
+		}
+		p.CellFormat(0, 5, doc.tr(header), "B", 1, "L", false, 0, "")
+		p.SetTextColor(0, 0, 0)
+		p.Ln(4)
+	}, false)
+	p.SetFooterFunc(func() {
+		p.SetY(-12)
+		p.SetFont("Helvetica", "I", 8)
+		p.SetTextColor(90, 90, 90)
+		p.CellFormat(0, 5, doc.tr("Gerado em "+data.GeneratedAt.Format("02/01/2006 15:04")), "", 0, "L", false, 0, "")
+		p.CellFormat(0, 5, doc.tr(fmt.Sprintf("Página %d de {nb}", p.PageNo())), "", 0, "R", false, 0, "")
+		p.SetTextColor(0, 0, 0)
+	})
+	p.AddPage()
+
+	if data.PropertyName != "" {
+		doc.text("B", 12, 7, data.PropertyName)
+	}
+	doc.text("B", 18, 10, data.Title)
+	if data.Period != "" {
+		doc.text("", 10, 6, "Período: "+data.Period)
+	}
+	p.Ln(4)
+
+	for _, field := range data.Summary {
+		doc.field(field.Label, field.Value)
+	}
+
+	if data.Chart != nil && len(data.Chart.Bars) > 0 {
+		p.Ln(4)
+		doc.barChart(*data.Chart)
+	}
+
+	for _, table := range data.Tables {
+		p.Ln(6)
+		doc.table(table)
+	}
+
+	return doc.bytes()
+}
+
+// ensureSpace inicia uma nova página quando a altura informada não cabe na página atual
+func (d *document) ensureSpace(height float64) bool {
+	_, pageHeight := d.pdf.GetPageSize()
+	_, _, _, bottom := d.pdf.GetMargins()
+	if d.pdf.GetY()+height > pageHeight-bottom {
+		d.pdf.AddPage()
+		return true
+	}
+	return false
+}
+
+// usableWidth retorna a largura da página descontando as margens
+func (d *document) usableWidth() float64 {
+	pageWidth, _ := d.pdf.GetPageSize()
+	left, _, right, _ := d.pdf.GetMargins()
+	return pageWidth - left - right
+}
+
+// fit corta o texto com reticências para caber na largura informada
+func (d *document) fit(content string, width float64) string {
+	text := d.tr(content)
+	if d.pdf.GetStringWidth(text) <= width {
+		return text
+	}
+	for len(text) > 0 && d.pdf.GetStringWidth(text+"...") > width {
+		text = text[:len(text)-1]
+	}
+	return text + "..."
+}
+
+// table desenha a tabela com linhas zebradas, repetindo o cabeçalho nas quebras de página
+func (d *document) table(table ReportTable) {
+	p := d.pdf
+
+	total := 0.0
+	for _, column := range table.Columns {
+		total += column.Width
+	}
+	widths := make([]float64, len(table.Columns))
+	for i, column := range table.Columns {
+		widths[i] = d.usableWidth() * column.Width / total
+	}
+
+	header := func() {
+		p.SetFont("Helvetica", "B", 9)
+		p.SetFillColor(52, 73, 94)
+		p.SetTextColor(255, 255, 255)
+		for i, column := range table.Columns {
+			p.CellFormat(widths[i], reportRowHeight, d.fit(column.Header, widths[i]-2), "", 0, columnAlign(column), true, 0, "")
+		}
+		p.Ln(-1)
+		p.SetTextColor(0, 0, 0)
+	}
+
+	row := func(values []string, style string, fill bool) {
+		// Na quebra de página o cabeçalho é repetido antes da linha
+		if d.ensureSpace(reportRowHeight) {
+			header()
+		}
+		p.SetFont("Helvetica", style, 9)
+		for i, column := range table.Columns {
+			value := ""
+			if i < len(values) {
+				value = values[i]
+			}
+			p.CellFormat(widths[i], reportRowHeight, d.fit(value, widths[i]-2), "", 0, columnAlign(column), fill, 0, "")
+		}
+		p.Ln(-1)
+	}
+
+	// Título, cabeçalho e a primeira linha ficam juntos na mesma página
+	d.ensureSpace(10 + 3*reportRowHeight)
+	if table.Title != "" {
+		d.text("B", 12, 8, table.Title)
+	}
+	header()
+
+	if len(table.Rows) == 0 && table.Empty != "" {
+		p.SetFont("Helvetica", "I", 9)
+		p.CellFormat(0, reportRowHeight, d.tr(table.Empty), "", 1, "L", false, 0, "")
+		return
+	}
+
+	p.SetFillColor(242, 244, 246)
+	for i, values := range table.Rows {
+		row(values, "", i%2 == 1)
+	}
+
+	if len(table.Footer) > 0 {
+		p.SetFillColor(220, 224, 228)
+		row(table.Footer, "B", true)
+	}
+}
+
+// barChart desenha o gráfico de barras com eixo, rótulos e valores
+func (d *document) barChart(chart BarChart) {
+	p := d.pdf
+
+	d.ensureSpace(reportChartHeight + 20)
+	if chart.Title != "" {
+		d.text("B", 12, 8, chart.Title)
+	}
+
+	left, _, _, _ := p.GetMargins()
+	width := d.usableWidth()
+	top := p.GetY() + 4
+	baseline := top + reportChartHeight
+
+	maxValue := 0.0
+	for _, bar := range chart.Bars {
+		if bar.Value > maxValue {
+			maxValue = bar.Value
+		}
+	}
+
+	slot := width / float64(len(chart.Bars))
+	barWidth := slot * 0.6
+
+	p.SetDrawColor(150, 150, 150)
+	p.Line(left, baseline, left+width, baseline)
+	p.SetFillColor(41, 128, 185)
+
+	for i, bar := range chart.Bars {
+		x := left + float64(i)*slot + (slot-barWidth)/2
+
+		height := 0.0
+		if maxValue > 0 && bar.Value > 0 {
+			height = reportChartHeight * bar.Value / maxValue
+			p.Rect(x, baseline-height, barWidth, height, "F")
+		}
+
+		if len(chart.Bars) <= reportMaxValueLabels && bar.ValueLabel != "" {
+			p.SetFont("Helvetica", "", 6)
+			p.SetXY(x-(slot-barWidth)/2, baseline-height-reportChartLabelSize)
+			p.CellFormat(slot, reportChartLabelSize, d.fit(bar.ValueLabel, slot), "", 0, "C", false, 0, "")
+		}
+
+		p.SetFont("Helvetica", "", 7)
+		p.SetXY(x-(slot-barWidth)/2, baseline+1)
+		p.CellFormat(slot, reportChartLabelSize, d.fit(bar.Label, slot), "", 0, "C", false, 0, "")
+	}
+
+	p.SetDrawColor(0, 0, 0)
+	p.SetXY(left, baseline+reportChartLabelSize+3)
+}
+
+// columnAlign retorna o alinhamento do gofpdf para a coluna
+func columnAlign(column ReportColumn) string {
+	if column.AlignRight {
+		return "R"
+	}
+	return "L"
+}
@@ -0,0 +1,57 @@
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderReport(t *testing.T) {
+	t.Run("should render report with chart and paginated table", func(t *testing.T) {
+		rows := make([][]string, 150)
+		for i := range rows {
+			rows[i] = []string{fmt.Sprintf("%03d", i+1), "João Araújo", "R$ 850,00"}
+		}
+
+		content, err := RenderReport(ReportData{
+			Title:        "Relatório financeiro",
+			PropertyName: "Kitnets Gabriel",
+			Period:       "01/01/2025 a 31/12/2025",
+			Summary:      []ReportField{{Label: "Receita total", Value: "R$ 127.500,00"}},
+			Chart: &BarChart{
+				Title: "Receita por mês",
+				Bars: []Bar{
+					{Label: "01/2025", Value: 10200, ValueLabel: "10,2 mil"},
+					{Label: "02/2025", Value: 0},
+					{Label: "03/2025", Value: 9350, ValueLabel: "9,4 mil"},
+				},
+			},
+			Tables: []ReportTable{
+				{
+					Title: "Pagamentos",
+					Columns: []ReportColumn{
+						{Header: "Unidade", Width: 1},
+						{Header: "Morador", Width: 3},
+						{Header: "Valor", Width: 1.5, AlignRight: true},
+					},
+					Rows:   rows,
+					Footer: []string{"Total", "", "R$ 127.500,00"},
+				},
+				{Title: "Vazia", Columns: []ReportColumn{{Header: "Unidade", Width: 1}}, Empty: "Nenhum pagamento"},
+			},
+			GeneratedAt: time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+		})
+
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(content, []byte("%PDF-")))
+
+		// 150 linhas não cabem em uma página A4
+		pages := regexp.MustCompile(`/Type /Page\b`).FindAll(content, -1)
+		assert.Greater(t, len(pages), 1)
+	})
+}
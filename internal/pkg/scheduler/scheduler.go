@@ -8,26 +8,35 @@ import (
 	"github.com/lucianoZgabriel/kitnet-manager/internal/service"
 )
 
+// webhookDispatchInterval é o intervalo de envio das entregas de webhook pendentes
+const webhookDispatchInterval = time.Minute
+
 // Scheduler executa tarefas agendadas periodicamente
 type Scheduler struct {
-	paymentService *service.PaymentService
-	leaseService   *service.LeaseService
-	intervalHours  int
-	stopChan       chan struct{}
+	paymentService      *service.PaymentService
+	leaseService        *service.LeaseService
+	notificationService *service.NotificationService
+	dunningService      *service.DunningService
+	webhookService      *service.WebhookService
+	intervalHours       int
+	stopChan            chan struct{}
 }
 
 // New cria uma nova instância do Scheduler
-func New(paymentService *service.PaymentService, leaseService *service.LeaseService, intervalHours int) *Scheduler {
+func New(paymentService *service.PaymentService, leaseService *service.LeaseService, notificationService *service.NotificationService, dunningService *service.DunningService, webhookService *service.WebhookService, intervalHours int) *Scheduler {
 	// Garantir intervalo mínimo de 1 hora
 	if intervalHours < 1 {
 		intervalHours = 24 // Padrão: 1x ao dia
 	}
 
 	return &Scheduler{
-		paymentService: paymentService,
-		leaseService:   leaseService,
-		intervalHours:  intervalHours,
-		stopChan:       make(chan struct{}),
+		paymentService:      paymentService,
+		leaseService:        leaseService,
+		notificationService: notificationService,
+		dunningService:      dunningService,
+		webhookService:      webhookService,
+		intervalHours:       intervalHours,
+		stopChan:            make(chan struct{}),
 	}
 }
 
@@ -43,10 +52,16 @@ func (s *Scheduler) Start(ctx context.Context) {
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
+	// Webhooks são enviados com mais frequência para que os eventos cheguem quase em tempo real
+	webhookTicker := time.NewTicker(webhookDispatchInterval)
+	defer webhookTicker.Stop()
+
 	for {
 		select {
 		case <-ticker.C:
 			s.runScheduledTasks(ctx)
+		case <-webhookTicker.C:
+			s.dispatchWebhooks(ctx)
 		case <-s.stopChan:
 			log.Println("⏹️ Scheduler parado")
 			return
@@ -81,6 +96,15 @@ func (s *Scheduler) runScheduledTasks(ctx context.Context) {
 	// Tarefa 3: Renovar automaticamente contratos que não precisam de reajuste
 	s.autoRenewLeases(ctx)
 
+	// Tarefa 4: Aplicar a régua de cobrança aos pagamentos em aberto
+	s.runDunning(ctx)
+
+	// Tarefa 5: Gerar avisos e enviar a fila de notificações
+	s.processNotifications(ctx)
+
+	// Tarefa 6: Enviar os eventos gerados pelas tarefas anteriores aos webhooks
+	s.dispatchWebhooks(ctx)
+
 	log.Println("✅ Tarefas agendadas concluídas")
 }
 
@@ -134,3 +158,49 @@ func (s *Scheduler) autoRenewLeases(ctx context.Context) {
 		log.Println("✓ Nenhum contrato renovado automaticamente (contratos com reajuste pendente são renovados manualmente)")
 	}
 }
+
+// runDunning executa as etapas da régua de cobrança alcançadas no dia
+func (s *Scheduler) runDunning(ctx context.Context) {
+	log.Println("💰 Executando régua de cobrança...")
+
+	result, err := s.dunningService.RunDunning(ctx)
+	if err != nil {
+		log.Printf("❌ Erro ao executar régua de cobrança: %v", err)
+		return
+	}
+
+	log.Printf("✅ Régua de cobrança: %d etapa(s) executada(s), %d pulada(s), %d mensagem(ns) criada(s), %d pagamento(s) sinalizado(s) para análise de despejo",
+		result.StepsExecuted, result.StepsSkipped, result.NotificationsCreated, result.EvictionReviews)
+}
+
+// processNotifications gera os avisos do dia e envia as notificações pendentes
+func (s *Scheduler) processNotifications(ctx context.Context) {
+	log.Println("🔔 Processando notificações...")
+
+	result, err := s.notificationService.ProcessDailyNotifications(ctx)
+	if err != nil {
+		log.Printf("❌ Erro ao processar notificações: %v", err)
+		return
+	}
+
+	log.Printf("✅ Notificações: %d aviso(s) de fim de contrato e %d recibo(s) criados; %d enviada(s), %d aguardando nova tentativa, %d com falha, %d devolvida(s), %d cancelada(s)",
+		result.ContractExpiringsCreated, result.ReceiptsCreated,
+		result.Sent, result.Retrying, result.Failed, result.Bounced, result.Cancelled)
+}
+
+// dispatchWebhooks envia as entregas de webhook pendentes, com novas tentativas para falhas anteriores
+func (s *Scheduler) dispatchWebhooks(ctx context.Context) {
+	result, err := s.webhookService.DispatchDue(ctx)
+	if err != nil {
+		log.Printf("❌ Erro ao enviar webhooks: %v", err)
+		return
+	}
+
+	// Sem entregas pendentes, não polui o log a cada minuto
+	if result.Delivered+result.Retrying+result.Failed == 0 {
+		return
+	}
+
+	log.Printf("🔗 Webhooks: %d entregue(s), %d aguardando nova tentativa, %d com falha",
+		result.Delivered, result.Retrying, result.Failed)
+}
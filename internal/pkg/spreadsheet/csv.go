@@ -0,0 +1,101 @@
+package spreadsheet
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+)
+
+// csvFlushRows é a quantidade de linhas gravadas entre cada envio ao destino
+const csvFlushRows = 500
+
+// utf8BOM faz o Excel abrir o arquivo como UTF-8 (acentos)
+const utf8BOM = "\ufeff"
+
+// csvWriter grava CSV no padrão do Excel em português: separador ponto e vírgula,
+// vírgula decimal e datas dd/mm/aaaa. Abas viram seções separadas por uma linha em branco
+type csvWriter struct {
+	out     io.Writer
+	w       *csv.Writer
+	sheets  int
+	pending int
+	err     error
+}
+
+// NewCSVWriter cria um writer de CSV
+func NewCSVWriter(w io.Writer) Writer {
+	cw := csv.NewWriter(w)
+	cw.Comma = ';'
+	cw.UseCRLF = true
+	return &csvWriter{out: w, w: cw}
+}
+
+// Sheet inicia uma nova seção; a partir da segunda, o nome da aba precede o cabeçalho
+func (c *csvWriter) Sheet(name string, header ...string) error {
+	if c.err != nil {
+		return c.err
+	}
+
+	if c.sheets == 0 {
+		_, c.err = io.WriteString(c.out, utf8BOM)
+	} else {
+		c.write([]string{})
+		c.write([]string{name})
+	}
+	c.sheets++
+
+	if len(header) > 0 {
+		c.write(header)
+	}
+	return c.err
+}
+
+// Row grava uma linha da seção atual
+func (c *csvWriter) Row(cells ...Cell) error {
+	record := make([]string, len(cells))
+	for i, cell := range cells {
+		record[i] = formatCSVCell(cell)
+	}
+	c.write(record)
+
+	c.pending++
+	if c.err == nil && c.pending >= csvFlushRows {
+		c.pending = 0
+		c.w.Flush()
+		c.err = c.w.Error()
+	}
+	return c.err
+}
+
+// Close envia as linhas restantes
+func (c *csvWriter) Close() error {
+	if c.err != nil {
+		return c.err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) write(record []string) {
+	if c.err == nil {
+		c.err = c.w.Write(record)
+	}
+}
+
+// formatCSVCell formata o valor da célula no padrão brasileiro
+func formatCSVCell(cell Cell) string {
+	switch cell.kind {
+	case kindText:
+		return cell.text
+	case kindInt:
+		return strconv.Itoa(cell.num)
+	case kindMoney:
+		return domain.FormatDecimalBR(cell.money)
+	case kindDate:
+		return cell.date.Format("02/01/2006")
+	default:
+		return ""
+	}
+}
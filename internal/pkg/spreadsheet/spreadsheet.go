@@ -0,0 +1,135 @@
+package spreadsheet
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrUnsupportedFormat indica um formato de exportação desconhecido
+var ErrUnsupportedFormat = errors.New("unsupported export format")
+
+// Format representa o formato do arquivo exportado
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// Tipos de conteúdo dos formatos suportados
+const (
+	ContentTypeCSV  = "text/csv; charset=utf-8"
+	ContentTypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// ParseFormat converte o valor do parâmetro format (csv ou xlsx)
+func ParseFormat(value string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(value))) {
+	case FormatCSV:
+		return FormatCSV, nil
+	case FormatXLSX:
+		return FormatXLSX, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// FormatFromAccept identifica o formato pelo header Accept; retorna false quando nenhum é aceito
+func FormatFromAccept(accept string) (Format, bool) {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, _ := strings.Cut(part, ";")
+		switch strings.ToLower(strings.TrimSpace(mediaType)) {
+		case "text/csv":
+			return FormatCSV, true
+		case ContentTypeXLSX:
+			return FormatXLSX, true
+		}
+	}
+	return "", false
+}
+
+// ContentType retorna o tipo de conteúdo do formato
+func (f Format) ContentType() string {
+	if f == FormatXLSX {
+		return ContentTypeXLSX
+	}
+	return ContentTypeCSV
+}
+
+// Extension retorna a extensão de arquivo do formato (sem o ponto)
+func (f Format) Extension() string {
+	return string(f)
+}
+
+// Writer grava planilhas linha a linha, sem manter o arquivo inteiro em memória
+type Writer interface {
+	// Sheet inicia uma nova aba com o cabeçalho informado
+	Sheet(name string, header ...string) error
+	// Row grava uma linha na aba atual
+	Row(cells ...Cell) error
+	// Close finaliza o arquivo
+	Close() error
+}
+
+// NewWriter cria o writer do formato informado
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return NewCSVWriter(w), nil
+	case FormatXLSX:
+		return NewXLSXWriter(w), nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+type cellKind int
+
+const (
+	kindEmpty cellKind = iota
+	kindText
+	kindInt
+	kindMoney
+	kindDate
+)
+
+// Cell representa o valor tipado de uma célula
+type Cell struct {
+	kind  cellKind
+	text  string
+	num   int
+	money decimal.Decimal
+	date  time.Time
+}
+
+// Text cria uma célula de texto
+func Text(value string) Cell {
+	return Cell{kind: kindText, text: value}
+}
+
+// Int cria uma célula com número inteiro
+func Int(value int) Cell {
+	return Cell{kind: kindInt, num: value}
+}
+
+// Money cria uma célula com valor monetário (duas casas decimais)
+func Money(value decimal.Decimal) Cell {
+	return Cell{kind: kindMoney, money: value}
+}
+
+// Date cria uma célula de data (dd/mm/aaaa)
+func Date(value time.Time) Cell {
+	return Cell{kind: kindDate, date: value}
+}
+
+// OptionalDate cria uma célula de data, vazia quando a data não foi informada
+func OptionalDate(value *time.Time) Cell {
+	if value == nil || value.IsZero() {
+		return Cell{}
+	}
+	return Date(*value)
+}
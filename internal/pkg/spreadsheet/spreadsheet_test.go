@@ -0,0 +1,89 @@
+package spreadsheet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat(" XLSX ")
+	require.NoError(t, err)
+	assert.Equal(t, FormatXLSX, format)
+
+	_, err = ParseFormat("pdf")
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestFormatFromAccept(t *testing.T) {
+	format, ok := FormatFromAccept("application/json;q=0.9, text/csv;q=1")
+	assert.True(t, ok)
+	assert.Equal(t, FormatCSV, format)
+
+	format, ok = FormatFromAccept(ContentTypeXLSX)
+	assert.True(t, ok)
+	assert.Equal(t, FormatXLSX, format)
+
+	_, ok = FormatFromAccept("application/json, */*")
+	assert.False(t, ok)
+}
+
+func writeSample(t *testing.T, w Writer) {
+	t.Helper()
+	paidAt := time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, w.Sheet("Resumo", "Indicador", "Valor"))
+	require.NoError(t, w.Row(Text("Receita total"), Money(decimal.RequireFromString("12500.5"))))
+	require.NoError(t, w.Sheet("Pagamentos", "Morador", "Vencimento", "Pagamento", "Quantidade"))
+	require.NoError(t, w.Row(Text("João; Silva"), Date(time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)), OptionalDate(&paidAt), Int(2)))
+	require.NoError(t, w.Row(Text("Maria"), Date(time.Date(2024, 4, 5, 0, 0, 0, 0, time.UTC)), OptionalDate(nil), Int(1)))
+	require.NoError(t, w.Close())
+}
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writeSample(t, NewCSVWriter(&buf))
+
+	expected := utf8BOM +
+		"Indicador;Valor\r\n" +
+		"Receita total;12.500,50\r\n" +
+		"\r\n" +
+		"Pagamentos\r\n" +
+		"Morador;Vencimento;Pagamento;Quantidade\r\n" +
+		"\"João; Silva\";05/03/2024;08/03/2024;2\r\n" +
+		"Maria;05/04/2024;;1\r\n"
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestXLSXWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writeSample(t, NewXLSXWriter(&buf))
+
+	f, err := excelize.OpenReader(&buf)
+	require.NoError(t, err)
+	defer f.Close()
+
+	assert.Equal(t, []string{"Resumo", "Pagamentos"}, f.GetSheetList())
+
+	// Valores formatados conforme o formato numérico das células
+	total, err := f.GetCellValue("Resumo", "B2")
+	require.NoError(t, err)
+	assert.Equal(t, "12,500.50", total)
+
+	rows, err := f.GetRows("Pagamentos")
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, []string{"Morador", "Vencimento", "Pagamento", "Quantidade"}, rows[0])
+	assert.Equal(t, []string{"João; Silva", "05/03/2024", "08/03/2024", "2"}, rows[1])
+	assert.Equal(t, "Maria", rows[2][0])
+
+	// Valores brutos continuam numéricos para cálculos na planilha
+	raw, err := f.GetCellValue("Resumo", "B2", excelize.Options{RawCellValue: true})
+	require.NoError(t, err)
+	assert.Equal(t, "12500.5", raw)
+}
@@ -0,0 +1,143 @@
+package spreadsheet
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Formatos numéricos das células; o Excel exibe os separadores conforme o idioma do usuário
+// (em português: 1.250,00 e 31/12/2024)
+const (
+	xlsxMoneyFormat = "#,##0.00"
+	xlsxDateFormat  = "dd/mm/yyyy"
+)
+
+// xlsxColumnWidth é a largura padrão das colunas, suficiente para nomes e valores
+const xlsxColumnWidth = 18
+
+// xlsxWriter grava planilhas XLSX pelo modo streaming do excelize, que mantém em memória
+// apenas a aba atual e descarrega as linhas em arquivo temporário nos relatórios grandes
+type xlsxWriter struct {
+	out    io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	row    int
+	styles struct {
+		header, money, date int
+	}
+	err error
+}
+
+// NewXLSXWriter cria um writer de XLSX
+func NewXLSXWriter(w io.Writer) Writer {
+	x := &xlsxWriter{out: w, file: excelize.NewFile()}
+
+	var err error
+	if x.styles.header, err = x.file.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}}); err != nil {
+		x.err = err
+		return x
+	}
+	money := xlsxMoneyFormat
+	if x.styles.money, err = x.file.NewStyle(&excelize.Style{CustomNumFmt: &money}); err != nil {
+		x.err = err
+		return x
+	}
+	date := xlsxDateFormat
+	if x.styles.date, err = x.file.NewStyle(&excelize.Style{CustomNumFmt: &date}); err != nil {
+		x.err = err
+	}
+	return x
+}
+
+// Sheet finaliza a aba atual e inicia uma nova com o cabeçalho em negrito
+func (x *xlsxWriter) Sheet(name string, header ...string) error {
+	if x.err != nil {
+		return x.err
+	}
+
+	if x.stream == nil {
+		// A primeira aba reaproveita a aba padrão do arquivo novo
+		x.err = x.file.SetSheetName(x.file.GetSheetName(0), name)
+	} else {
+		if x.err = x.stream.Flush(); x.err == nil {
+			_, x.err = x.file.NewSheet(name)
+		}
+	}
+	if x.err != nil {
+		return fmt.Errorf("failed to create sheet %s: %w", name, x.err)
+	}
+
+	if x.stream, x.err = x.file.NewStreamWriter(name); x.err != nil {
+		return x.err
+	}
+	x.row = 0
+
+	if len(header) > 0 {
+		if x.err = x.stream.SetColWidth(1, len(header), xlsxColumnWidth); x.err != nil {
+			return x.err
+		}
+		values := make([]interface{}, len(header))
+		for i, title := range header {
+			values[i] = excelize.Cell{StyleID: x.styles.header, Value: title}
+		}
+		x.setRow(values)
+	}
+	return x.err
+}
+
+// Row grava uma linha na aba atual
+func (x *xlsxWriter) Row(cells ...Cell) error {
+	values := make([]interface{}, len(cells))
+	for i, cell := range cells {
+		values[i] = x.value(cell)
+	}
+	x.setRow(values)
+	return x.err
+}
+
+// Close finaliza a última aba e grava o arquivo no destino
+func (x *xlsxWriter) Close() error {
+	defer x.file.Close()
+
+	if x.err != nil {
+		return x.err
+	}
+	if x.stream != nil {
+		if err := x.stream.Flush(); err != nil {
+			return err
+		}
+	}
+	x.file.SetActiveSheet(0)
+	return x.file.Write(x.out)
+}
+
+func (x *xlsxWriter) setRow(values []interface{}) {
+	if x.err != nil {
+		return
+	}
+	x.row++
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		x.err = err
+		return
+	}
+	x.err = x.stream.SetRow(cell, values)
+}
+
+// value converte a célula para o valor tipado do excelize
+func (x *xlsxWriter) value(cell Cell) interface{} {
+	switch cell.kind {
+	case kindText:
+		return cell.text
+	case kindInt:
+		return cell.num
+	case kindMoney:
+		return excelize.Cell{StyleID: x.styles.money, Value: cell.money.InexactFloat64()}
+	case kindDate:
+		return excelize.Cell{StyleID: x.styles.date, Value: cell.date}
+	default:
+		return nil
+	}
+}
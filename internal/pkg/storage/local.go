@@ -0,0 +1,131 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Compile-time check to ensure LocalStorage implements Storage
+var _ Storage = (*LocalStorage)(nil)
+
+// LocalStorage grava os arquivos em um diretório do sistema de arquivos local
+type LocalStorage struct {
+	basePath string
+}
+
+// NewLocalStorage cria o backend local, criando o diretório base se necessário
+func NewLocalStorage(basePath string) (*LocalStorage, error) {
+	if strings.TrimSpace(basePath) == "" {
+		return nil, errors.New("storage base path cannot be empty")
+	}
+
+	absPath, err := filepath.Abs(basePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve storage path: %w", err)
+	}
+
+	if err := os.MkdirAll(absPath, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	return &LocalStorage{basePath: absPath}, nil
+}
+
+// Save grava o conteúdo em um arquivo temporário e o move para o destino ao final
+// Assim um upload interrompido nunca deixa um arquivo incompleto na chave
+func (s *LocalStorage) Save(ctx context.Context, key string, content io.Reader) (int64, error) {
+	fullPath, err := s.resolve(key)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o750); err != nil {
+		return 0, fmt.Errorf("failed to create storage directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(fullPath), ".upload-*")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // no-op após o rename
+
+	written, err := io.Copy(tmp, &contextReader{ctx: ctx, r: content})
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to write file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), fullPath); err != nil {
+		return 0, fmt.Errorf("failed to move file into place: %w", err)
+	}
+
+	return written, nil
+}
+
+// Open abre o arquivo armazenado na chave
+func (s *LocalStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	fullPath, err := s.resolve(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(fullPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return file, nil
+}
+
+// Delete remove o arquivo armazenado na chave
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	fullPath, err := s.resolve(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(fullPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete file: %w", err)
+	}
+
+	return nil
+}
+
+// resolve converte a chave em um caminho dentro do diretório base
+// Chaves absolutas ou com ".." são rejeitadas para impedir acesso fora do diretório
+func (s *LocalStorage) resolve(key string) (string, error) {
+	if key == "" || strings.Contains(key, "\\") || path.IsAbs(key) {
+		return "", ErrInvalidKey
+	}
+
+	cleaned := path.Clean(key)
+	if cleaned != key || cleaned == "." || strings.HasPrefix(cleaned, "../") || cleaned == ".." {
+		return "", ErrInvalidKey
+	}
+
+	return filepath.Join(s.basePath, filepath.FromSlash(cleaned)), nil
+}
+
+// contextReader interrompe a cópia quando o context é cancelado
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
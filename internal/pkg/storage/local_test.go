@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should save, open and delete a file", func(t *testing.T) {
+		store, err := NewLocalStorage(t.TempDir())
+		require.NoError(t, err)
+
+		written, err := store.Save(ctx, "tenants/abc/doc", strings.NewReader("conteúdo"))
+		require.NoError(t, err)
+		assert.Equal(t, int64(len("conteúdo")), written)
+
+		reader, err := store.Open(ctx, "tenants/abc/doc")
+		require.NoError(t, err)
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		assert.Equal(t, "conteúdo", string(content))
+
+		require.NoError(t, store.Delete(ctx, "tenants/abc/doc"))
+		_, err = store.Open(ctx, "tenants/abc/doc")
+		assert.ErrorIs(t, err, ErrObjectNotFound)
+
+		// Remover de novo não é erro
+		assert.NoError(t, store.Delete(ctx, "tenants/abc/doc"))
+	})
+
+	t.Run("should reject keys outside the base directory", func(t *testing.T) {
+		store, err := NewLocalStorage(t.TempDir())
+		require.NoError(t, err)
+
+		for _, key := range []string{"", "../secret", "/etc/passwd", "tenants/../../secret", "tenants\\doc", "."} {
+			_, err := store.Save(ctx, key, strings.NewReader("x"))
+			assert.ErrorIs(t, err, ErrInvalidKey, key)
+		}
+	})
+
+	t.Run("should stop writing when the context is canceled", func(t *testing.T) {
+		store, err := NewLocalStorage(t.TempDir())
+		require.NoError(t, err)
+
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err = store.Save(canceled, "tenants/abc/doc", strings.NewReader("x"))
+		assert.ErrorIs(t, err, context.Canceled)
+
+		_, err = store.Open(ctx, "tenants/abc/doc")
+		assert.ErrorIs(t, err, ErrObjectNotFound)
+	})
+}
@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// Storage errors
+var (
+	ErrObjectNotFound = errors.New("stored object not found")
+	ErrInvalidKey     = errors.New("invalid storage key")
+)
+
+// Storage define o contrato de um backend de armazenamento de arquivos
+// As chaves usam "/" como separador (ex: tenants/<id>/<arquivo>)
+type Storage interface {
+	// Save grava o conteúdo na chave informada e retorna a quantidade de bytes gravados
+	Save(ctx context.Context, key string, content io.Reader) (int64, error)
+
+	// Open abre o arquivo para leitura; o chamador deve fechar o reader
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete remove o arquivo (remover uma chave inexistente não é erro)
+	Delete(ctx context.Context, key string) error
+}
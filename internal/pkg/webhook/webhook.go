@@ -0,0 +1,155 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers enviados em cada entrega
+const (
+	HeaderSignature  = "X-Webhook-Signature" // t=<unix>,v1=<hex HMAC-SHA256 de "<unix>.<corpo>">
+	HeaderEvent      = "X-Webhook-Event"
+	HeaderEventID    = "X-Webhook-Event-ID"
+	HeaderDeliveryID = "X-Webhook-Delivery"
+)
+
+// DefaultTimeout limita o tempo de cada entrega
+const DefaultTimeout = 10 * time.Second
+
+// userAgent identifica as entregas do sistema
+const userAgent = "kitnet-manager-webhooks/1.0"
+
+// Webhook errors
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrSignatureExpired = errors.New("webhook signature timestamp outside tolerance")
+)
+
+// Request representa uma entrega de evento a um endpoint
+type Request struct {
+	URL        string
+	Secret     string
+	EventType  string
+	EventID    string
+	DeliveryID string
+	Payload    []byte // Corpo JSON enviado sem alterações (é o conteúdo assinado)
+}
+
+// Sender define o contrato de entrega de eventos
+type Sender interface {
+	// Send envia o evento e retorna o código HTTP da resposta (0 quando não houve resposta)
+	// Respostas fora da faixa 2xx retornam erro
+	Send(ctx context.Context, req Request) (int, error)
+}
+
+// Sign calcula a assinatura HMAC-SHA256 (hex) de "<timestamp>.<corpo>"
+// O timestamp na assinatura permite ao receptor rejeitar reenvios antigos de uma mesma requisição
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignatureHeader monta o valor do header X-Webhook-Signature
+func SignatureHeader(secret string, now time.Time, body []byte) string {
+	timestamp := now.Unix()
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, Sign(secret, timestamp, body))
+}
+
+// Verify valida o header X-Webhook-Signature recebido (lado do receptor)
+// tolerance = 0 desativa a verificação do horário
+func Verify(secret, header string, body []byte, tolerance time.Duration, now time.Time) error {
+	var timestamp int64
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return ErrInvalidSignature
+			}
+			timestamp = parsed
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	if timestamp == 0 || len(signatures) == 0 {
+		return ErrInvalidSignature
+	}
+
+	if tolerance > 0 {
+		age := now.Sub(time.Unix(timestamp, 0))
+		if age > tolerance || age < -tolerance {
+			return ErrSignatureExpired
+		}
+	}
+
+	expected := Sign(secret, timestamp, body)
+	for _, signature := range signatures {
+		if hmac.Equal([]byte(signature), []byte(expected)) {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// HTTPSender entrega os eventos via HTTP POST com corpo JSON assinado
+type HTTPSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewHTTPSender cria o sender; timeout <= 0 usa DefaultTimeout
+func NewHTTPSender(timeout time.Duration) *HTTPSender {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &HTTPSender{
+		client: &http.Client{Timeout: timeout},
+		now:    time.Now,
+	}
+}
+
+// Send envia o evento ao endpoint; qualquer resposta 2xx confirma a entrega
+func (s *HTTPSender) Send(ctx context.Context, req Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("User-Agent", userAgent)
+	httpReq.Header.Set(HeaderEvent, req.EventType)
+	httpReq.Header.Set(HeaderEventID, req.EventID)
+	httpReq.Header.Set(HeaderDeliveryID, req.DeliveryID)
+	httpReq.Header.Set(HeaderSignature, SignatureHeader(req.Secret, s.now(), req.Payload))
+
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reach webhook endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+		return resp.StatusCode, nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return resp.StatusCode, fmt.Errorf("webhook endpoint responded %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
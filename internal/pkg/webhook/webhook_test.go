@@ -0,0 +1,83 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "whsec_test"
+
+func TestSignAndVerify(t *testing.T) {
+	now := time.Unix(1767225600, 0)
+	body := []byte(`{"type":"payment.paid"}`)
+	header := SignatureHeader(testSecret, now, body)
+
+	assert.Equal(t, "t=1767225600,v1="+Sign(testSecret, 1767225600, body), header)
+	assert.NoError(t, Verify(testSecret, header, body, 5*time.Minute, now.Add(time.Minute)))
+
+	assert.Equal(t, ErrInvalidSignature, Verify("whsec_other", header, body, 0, now))
+	assert.Equal(t, ErrInvalidSignature, Verify(testSecret, header, []byte(`{"type":"lease.created"}`), 0, now))
+	assert.Equal(t, ErrSignatureExpired, Verify(testSecret, header, body, 5*time.Minute, now.Add(time.Hour)))
+	assert.Equal(t, ErrInvalidSignature, Verify(testSecret, "v1=abc", body, 0, now))
+}
+
+func TestHTTPSender_Send(t *testing.T) {
+	ctx := context.Background()
+	payload := []byte(`{"id":"evt-1","type":"lease.created","data":{}}`)
+	request := func(url string) Request {
+		return Request{URL: url, Secret: testSecret, EventType: "lease.created", EventID: "evt-1", DeliveryID: "dlv-1", Payload: payload}
+	}
+
+	t.Run("should post signed payload", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.Equal(t, "lease.created", r.Header.Get(HeaderEvent))
+			assert.Equal(t, "evt-1", r.Header.Get(HeaderEventID))
+			assert.Equal(t, "dlv-1", r.Header.Get(HeaderDeliveryID))
+			assert.Equal(t, payload, body)
+			assert.NoError(t, Verify(testSecret, r.Header.Get(HeaderSignature), body, time.Minute, time.Now()))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		status, err := NewHTTPSender(0).Send(ctx, request(server.URL))
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, status)
+	})
+
+	t.Run("should return error for non-2xx responses", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte("maintenance"))
+		}))
+		defer server.Close()
+
+		status, err := NewHTTPSender(0).Send(ctx, request(server.URL))
+
+		assert.Equal(t, http.StatusServiceUnavailable, status)
+		assert.ErrorContains(t, err, "503: maintenance")
+	})
+
+	t.Run("should return zero status when endpoint is unreachable", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		url := server.URL
+		server.Close()
+
+		status, err := NewHTTPSender(time.Second).Send(ctx, request(url))
+
+		assert.Zero(t, status)
+		assert.Error(t, err)
+	})
+}
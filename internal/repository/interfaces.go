@@ -17,12 +17,15 @@ type UnitRepository interface {
 	// GetByID busca uma unidade pelo ID
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.Unit, error)
 
-	// GetByNumber busca uma unidade pelo número
-	GetByNumber(ctx context.Context, number string) (*domain.Unit, error)
+	// GetByNumber busca uma unidade pelo número dentro de um imóvel
+	GetByNumber(ctx context.Context, propertyID uuid.UUID, number string) (*domain.Unit, error)
 
 	// List retorna todas as unidades ordenadas por andar e número
 	List(ctx context.Context) ([]*domain.Unit, error)
 
+	// ListByPropertyID retorna as unidades de um imóvel
+	ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.Unit, error)
+
 	// ListByStatus retorna unidades filtradas por status
 	ListByStatus(ctx context.Context, status domain.UnitStatus) ([]*domain.Unit, error)
 
@@ -62,6 +65,9 @@ type TenantRepository interface {
 	// List retorna todos os moradores ordenados por nome
 	List(ctx context.Context) ([]*domain.Tenant, error)
 
+	// ListByPropertyID retorna os moradores com contratos em um imóvel
+	ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.Tenant, error)
+
 	// SearchByName busca moradores por nome (case-insensitive)
 	SearchByName(ctx context.Context, name string) ([]*domain.Tenant, error)
 
@@ -86,12 +92,14 @@ type LeaseRepository interface {
 	ListByStatus(ctx context.Context, status domain.LeaseStatus) ([]*domain.Lease, error)
 	ListByUnitID(ctx context.Context, unitID uuid.UUID) ([]*domain.Lease, error)
 	ListByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*domain.Lease, error)
+	ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.Lease, error)
 	GetActiveByUnitID(ctx context.Context, unitID uuid.UUID) (*domain.Lease, error)
 	GetActiveByTenantID(ctx context.Context, tenantID uuid.UUID) (*domain.Lease, error)
 	GetExpiringSoon(ctx context.Context) ([]*domain.Lease, error)
 	Update(ctx context.Context, lease *domain.Lease) error
 	UpdateStatus(ctx context.Context, id uuid.UUID, status domain.LeaseStatus) error
 	UpdatePaintingFeePaid(ctx context.Context, id uuid.UUID, paintingFeePaid decimal.Decimal) error
+	UpdateDepositReceivedDate(ctx context.Context, id uuid.UUID, receivedDate time.Time) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	Count(ctx context.Context) (int64, error)
 	CountByStatus(ctx context.Context, status domain.LeaseStatus) (int64, error)
@@ -107,12 +115,20 @@ type PaymentRepository interface {
 	List(ctx context.Context) ([]*domain.Payment, error)
 	ListByLeaseID(ctx context.Context, leaseID uuid.UUID) ([]*domain.Payment, error)
 	ListByStatus(ctx context.Context, status domain.PaymentStatus) ([]*domain.Payment, error)
+	// ListPaidSince retorna os pagamentos quitados a partir da data informada
+	ListPaidSince(ctx context.Context, since time.Time) ([]*domain.Payment, error)
 	GetOverdue(ctx context.Context) ([]*domain.Payment, error)
 	GetUpcoming(ctx context.Context, days int) ([]*domain.Payment, error)
+	GetOverdueByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.Payment, error)
+	GetUpcomingByPropertyID(ctx context.Context, propertyID uuid.UUID, days int) ([]*domain.Payment, error)
+	// GetOverdueSettledSince retorna os pagamentos que já estavam vencidos na data informada e foram quitados a partir dela
+	GetOverdueSettledSince(ctx context.Context, since time.Time) ([]*domain.Payment, error)
+	GetOverdueSettledSinceByPropertyID(ctx context.Context, propertyID uuid.UUID, since time.Time) ([]*domain.Payment, error)
 	Update(ctx context.Context, payment *domain.Payment) error
 	UpdateStatus(ctx context.Context, id uuid.UUID, status domain.PaymentStatus) error
 	MarkAsPaid(ctx context.Context, id uuid.UUID, paymentDate time.Time, method domain.PaymentMethod) error
-	MarkOverduePayments(ctx context.Context) error
+	// MarkOverduePayments marca os pagamentos pendentes vencidos como atrasados e retorna os que foram alterados
+	MarkOverduePayments(ctx context.Context) ([]*domain.Payment, error)
 	Cancel(ctx context.Context, id uuid.UUID) error
 	Delete(ctx context.Context, id uuid.UUID) error
 	Count(ctx context.Context) (int64, error)
@@ -130,6 +146,8 @@ type DashboardRepository interface {
 	GetMonthlyRealizedRevenue(ctx context.Context) (decimal.Decimal, error)
 	GetOverdueAmount(ctx context.Context) (decimal.Decimal, error)
 	GetTotalPendingAmount(ctx context.Context) (decimal.Decimal, error)
+	GetOccupancyMetricsByProperty(ctx context.Context) ([]*PropertyOccupancyMetrics, error)
+	GetFinancialMetricsByProperty(ctx context.Context) ([]*PropertyFinancialMetrics, error)
 }
 
 // OccupancyMetrics representa as métricas de ocupação
@@ -141,6 +159,22 @@ type OccupancyMetrics struct {
 	RenovationUnits  int64
 }
 
+// PropertyOccupancyMetrics representa as métricas de ocupação de um imóvel
+type PropertyOccupancyMetrics struct {
+	PropertyID   uuid.UUID
+	PropertyName string
+	OccupancyMetrics
+}
+
+// PropertyFinancialMetrics representa as métricas financeiras de um imóvel
+type PropertyFinancialMetrics struct {
+	PropertyID       uuid.UUID
+	ProjectedRevenue decimal.Decimal
+	RealizedRevenue  decimal.Decimal
+	OverdueAmount    decimal.Decimal
+	PendingAmount    decimal.Decimal
+}
+
 // UserRepository define o contrato para operações de persistência de Users
 type UserRepository interface {
 	// Create cria um novo usuário no banco de dados
@@ -152,6 +186,9 @@ type UserRepository interface {
 	// GetByUsername busca um usuário pelo username
 	GetByUsername(ctx context.Context, username string) (*domain.User, error)
 
+	// GetByTenantID busca o usuário do portal vinculado a um morador
+	GetByTenantID(ctx context.Context, tenantID uuid.UUID) (*domain.User, error)
+
 	// List retorna todos os usuários ordenados por data de criação
 	List(ctx context.Context) ([]*domain.User, error)
 
@@ -195,3 +232,338 @@ type LeaseRentAdjustmentRepository interface {
 	CountByLeaseID(ctx context.Context, leaseID uuid.UUID) (int64, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 }
+
+// MaintenanceTicketRepository define as operações de persistência para chamados de manutenção
+type MaintenanceTicketRepository interface {
+	Create(ctx context.Context, ticket *domain.MaintenanceTicket) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.MaintenanceTicket, error)
+	List(ctx context.Context) ([]*domain.MaintenanceTicket, error)
+	ListByUnitID(ctx context.Context, unitID uuid.UUID) ([]*domain.MaintenanceTicket, error)
+	ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.MaintenanceTicket, error)
+	ListByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*domain.MaintenanceTicket, error)
+	ListByStatus(ctx context.Context, status domain.MaintenanceStatus) ([]*domain.MaintenanceTicket, error)
+	Update(ctx context.Context, ticket *domain.MaintenanceTicket) error
+	// CountActiveVacancyByUnitID retorna quantos chamados ativos exigem a unidade desocupada
+	CountActiveVacancyByUnitID(ctx context.Context, unitID uuid.UUID) (int64, error)
+	AddPhoto(ctx context.Context, photo *domain.MaintenanceTicketPhoto) error
+	ListPhotos(ctx context.Context, ticketID uuid.UUID) ([]*domain.MaintenanceTicketPhoto, error)
+}
+
+// UnitStatusHistoryRepository define as operações de persistência para o histórico de status das unidades
+type UnitStatusHistoryRepository interface {
+	Create(ctx context.Context, change *domain.UnitStatusChange) error
+	// ListByUnitID retorna o histórico de uma unidade em ordem cronológica
+	ListByUnitID(ctx context.Context, unitID uuid.UUID) ([]*domain.UnitStatusChange, error)
+	GetLatestByUnitID(ctx context.Context, unitID uuid.UUID) (*domain.UnitStatusChange, error)
+	// ListUntil retorna todas as mudanças anteriores à data, agrupadas por unidade e em ordem cronológica
+	ListUntil(ctx context.Context, until time.Time) ([]*domain.UnitStatusChange, error)
+}
+
+// PropertyRepository define as operações de persistência para imóveis
+type PropertyRepository interface {
+	Create(ctx context.Context, property *domain.Property) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Property, error)
+	GetByName(ctx context.Context, name string) (*domain.Property, error)
+	List(ctx context.Context) ([]*domain.Property, error)
+	Update(ctx context.Context, property *domain.Property) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// CountUnits retorna quantas unidades pertencem ao imóvel
+	CountUnits(ctx context.Context, propertyID uuid.UUID) (int64, error)
+}
+
+// RenovationProjectRepository define as operações de persistência para projetos de reforma
+type RenovationProjectRepository interface {
+	Create(ctx context.Context, project *domain.RenovationProject) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.RenovationProject, error)
+	List(ctx context.Context) ([]*domain.RenovationProject, error)
+	ListByUnitID(ctx context.Context, unitID uuid.UUID) ([]*domain.RenovationProject, error)
+	ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.RenovationProject, error)
+	ListByStatus(ctx context.Context, status domain.RenovationStatus) ([]*domain.RenovationProject, error)
+	Update(ctx context.Context, project *domain.RenovationProject) error
+	// CountActiveByUnitID retorna quantos projetos planejados ou em andamento a unidade possui
+	CountActiveByUnitID(ctx context.Context, unitID uuid.UUID) (int64, error)
+	AddExpense(ctx context.Context, expense *domain.RenovationExpense) error
+	GetExpenseByID(ctx context.Context, id uuid.UUID) (*domain.RenovationExpense, error)
+	ListExpenses(ctx context.Context, projectID uuid.UUID) ([]*domain.RenovationExpense, error)
+	DeleteExpense(ctx context.Context, id uuid.UUID) error
+	// GetTotalExpenses retorna a soma das despesas lançadas no projeto
+	GetTotalExpenses(ctx context.Context, projectID uuid.UUID) (decimal.Decimal, error)
+}
+
+// InventoryRepository define as operações de persistência para o inventário das unidades
+type InventoryRepository interface {
+	CreateItem(ctx context.Context, item *domain.UnitInventoryItem) error
+	GetItemByID(ctx context.Context, id uuid.UUID) (*domain.UnitInventoryItem, error)
+	ListItemsByUnitID(ctx context.Context, unitID uuid.UUID) ([]*domain.UnitInventoryItem, error)
+	UpdateItem(ctx context.Context, item *domain.UnitInventoryItem) error
+	DeleteItem(ctx context.Context, id uuid.UUID) error
+	// CreateChecklist persiste o checklist e seus itens em uma única transação
+	CreateChecklist(ctx context.Context, checklist *domain.InventoryChecklist) error
+	// GetChecklist retorna o checklist (com itens) de um contrato, ou nil se não existir
+	GetChecklist(ctx context.Context, leaseID uuid.UUID, checklistType domain.ChecklistType) (*domain.InventoryChecklist, error)
+}
+
+// UtilityRepository define as operações de persistência para a cobrança de consumo
+type UtilityRepository interface {
+	CreateTariff(ctx context.Context, tariff *domain.UtilityTariff) error
+	ListTariffs(ctx context.Context) ([]*domain.UtilityTariff, error)
+	// GetEffectiveTariff retorna a tarifa vigente na data informada, ou nil se não houver
+	GetEffectiveTariff(ctx context.Context, utilityType domain.UtilityType, date time.Time) (*domain.UtilityTariff, error)
+	CreateReading(ctx context.Context, reading *domain.MeterReading) error
+	GetReadingByID(ctx context.Context, id uuid.UUID) (*domain.MeterReading, error)
+	GetReading(ctx context.Context, unitID uuid.UUID, utilityType domain.UtilityType, referenceMonth time.Time) (*domain.MeterReading, error)
+	// GetPreviousReading retorna a última leitura anterior ao mês de referência, ou nil se não houver
+	GetPreviousReading(ctx context.Context, unitID uuid.UUID, utilityType domain.UtilityType, referenceMonth time.Time) (*domain.MeterReading, error)
+	ListReadingsByUnitID(ctx context.Context, unitID uuid.UUID) ([]*domain.MeterReading, error)
+	DeleteReading(ctx context.Context, id uuid.UUID) error
+	// CreateBill persiste o pagamento de consumo e seu detalhamento em uma única transação
+	CreateBill(ctx context.Context, payment *domain.Payment, charges []*domain.UtilityCharge) error
+	ListChargesByPaymentID(ctx context.Context, paymentID uuid.UUID) ([]*domain.UtilityCharge, error)
+	CountChargesByLeaseAndMonth(ctx context.Context, leaseID uuid.UUID, referenceMonth time.Time) (int64, error)
+}
+
+// DepositSettlementRepository define as operações de acerto da caução
+type DepositSettlementRepository interface {
+	Create(ctx context.Context, settlement *domain.DepositSettlement) error
+	GetByLeaseID(ctx context.Context, leaseID uuid.UUID) (*domain.DepositSettlement, error)
+	// MarkRefunded registra a devolução do saldo de um acerto já gravado
+	MarkRefunded(ctx context.Context, id uuid.UUID, refundedAt time.Time, method domain.PaymentMethod) error
+}
+
+// TenantLoginCodeRepository define as operações de persistência dos códigos de acesso ao portal
+type TenantLoginCodeRepository interface {
+	Create(ctx context.Context, code *domain.TenantLoginCode) error
+	// GetActiveByUserID retorna o código mais recente ainda não usado e não expirado
+	GetActiveByUserID(ctx context.Context, userID uuid.UUID) (*domain.TenantLoginCode, error)
+	// Update persiste as tentativas e o uso do código
+	Update(ctx context.Context, code *domain.TenantLoginCode) error
+	// InvalidateByUserID marca como usados todos os códigos pendentes do usuário
+	InvalidateByUserID(ctx context.Context, userID uuid.UUID) error
+}
+
+// TenantDocumentRepository define as operações de persistência para os documentos dos moradores
+type TenantDocumentRepository interface {
+	Create(ctx context.Context, doc *domain.TenantDocument) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.TenantDocument, error)
+	ListByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantDocument, error)
+	ListByLeaseID(ctx context.Context, leaseID uuid.UUID) ([]*domain.TenantDocument, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// TenantProfileRepository define as operações de persistência dos dados complementares do morador
+// As listas são substituídas por completo em cada atualização
+type TenantProfileRepository interface {
+	ListContacts(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantContact, error)
+	// ReplaceContacts substitui, em uma transação, os contatos do tipo informado
+	ReplaceContacts(ctx context.Context, tenantID uuid.UUID, contactType domain.TenantContactType, contacts []*domain.TenantContact) error
+	ListOccupants(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantOccupant, error)
+	ReplaceOccupants(ctx context.Context, tenantID uuid.UUID, occupants []*domain.TenantOccupant) error
+	ListPets(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantPet, error)
+	ReplacePets(ctx context.Context, tenantID uuid.UUID, pets []*domain.TenantPet) error
+}
+
+// TenantPrivacyRepository define as operações de persistência das solicitações LGPD do morador
+type TenantPrivacyRepository interface {
+	// Anonymize grava o morador anonimizado, remove contatos, ocupantes, animais, documentos
+	// e o acesso ao portal, e registra a solicitação, tudo em uma transação
+	Anonymize(ctx context.Context, tenant *domain.Tenant, request *domain.TenantDataRequest) error
+	CreateRequest(ctx context.Context, request *domain.TenantDataRequest) error
+	ListRequestsByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantDataRequest, error)
+}
+
+// ProspectRepository define as operações de persistência para interessados e visitas
+type ProspectRepository interface {
+	Create(ctx context.Context, prospect *domain.Prospect) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Prospect, error)
+	List(ctx context.Context) ([]*domain.Prospect, error)
+	ListByStatus(ctx context.Context, status domain.ProspectStatus) ([]*domain.Prospect, error)
+	// ListOpen retorna os interessados ainda no funil (não convertidos nem perdidos)
+	ListOpen(ctx context.Context) ([]*domain.Prospect, error)
+	// ListByConvertedTenantID retorna os interessados convertidos no morador informado
+	ListByConvertedTenantID(ctx context.Context, tenantID uuid.UUID) ([]*domain.Prospect, error)
+	Update(ctx context.Context, prospect *domain.Prospect) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	CreateVisit(ctx context.Context, visit *domain.ProspectVisit) error
+	GetVisitByID(ctx context.Context, id uuid.UUID) (*domain.ProspectVisit, error)
+	ListVisits(ctx context.Context, prospectID uuid.UUID) ([]*domain.ProspectVisit, error)
+	// ListUpcomingVisits retorna as visitas agendadas a partir da data informada
+	ListUpcomingVisits(ctx context.Context, from time.Time) ([]*domain.ProspectVisit, error)
+	UpdateVisit(ctx context.Context, visit *domain.ProspectVisit) error
+}
+
+// SearchRepository define as consultas da busca global
+type SearchRepository interface {
+	// SearchTenants busca moradores por nome, e-mail, ou fragmento de CPF e telefone (digits)
+	SearchTenants(ctx context.Context, query, digits string, limit int) ([]*domain.SearchResult, error)
+	SearchUnits(ctx context.Context, query string, limit int) ([]*domain.SearchResult, error)
+	// SearchLeases busca contratos pelo nome do morador ou número da unidade
+	SearchLeases(ctx context.Context, query string, limit int) ([]*domain.SearchResult, error)
+	SearchPaymentsByAmount(ctx context.Context, amount decimal.Decimal, limit int) ([]*domain.SearchResult, error)
+	SearchPaymentsByReferenceMonth(ctx context.Context, referenceMonth time.Time, limit int) ([]*domain.SearchResult, error)
+}
+
+// ReportRepository define as consultas agregadas dos relatórios financeiro e de histórico de pagamentos
+type ReportRepository interface {
+	// GetFinancialTotals soma os pagamentos do período por status
+	GetFinancialTotals(ctx context.Context, filter FinancialReportFilter) (*FinancialTotals, error)
+	GetRevenueByType(ctx context.Context, filter FinancialReportFilter) ([]*RevenueByType, error)
+	// GetRevenueByMonth retorna apenas os meses com pagamentos, em ordem cronológica
+	GetRevenueByMonth(ctx context.Context, filter FinancialReportFilter) ([]*RevenueByMonth, error)
+	GetRevenueByUnit(ctx context.Context, filter FinancialReportFilter) ([]*RevenueByUnit, error)
+	GetRevenueByProperty(ctx context.Context, filter FinancialReportFilter) ([]*RevenueByProperty, error)
+	// ListPaymentHistory retorna uma página do histórico, do vencimento mais recente para o mais antigo
+	ListPaymentHistory(ctx context.Context, filter PaymentHistoryFilter, limit, offset int) ([]*PaymentHistoryRow, error)
+	// GetPaymentHistoryTotals retorna a quantidade e a soma de todos os pagamentos do filtro
+	GetPaymentHistoryTotals(ctx context.Context, filter PaymentHistoryFilter) (*PaymentHistoryTotals, error)
+}
+
+// FinancialReportFilter representa os filtros do relatório financeiro. O período considera a data
+// do pagamento quando pago e o vencimento nos demais casos
+type FinancialReportFilter struct {
+	StartDate   time.Time
+	EndDate     time.Time
+	PaymentType *domain.PaymentType
+	Status      *domain.PaymentStatus
+	PropertyID  *uuid.UUID
+}
+
+// FinancialTotals representa a soma dos pagamentos do relatório financeiro por status
+// A caução não entra na receita: as recebidas no período ficam em SecurityDepositAmount
+type FinancialTotals struct {
+	PaymentCount          int64
+	TotalAmount           decimal.Decimal
+	PaidAmount            decimal.Decimal
+	PendingAmount         decimal.Decimal
+	OverdueAmount         decimal.Decimal
+	CancelledAmount       decimal.Decimal
+	SecurityDepositAmount decimal.Decimal
+}
+
+// RevenueByType representa a receita agrupada por tipo de pagamento
+type RevenueByType struct {
+	PaymentType  domain.PaymentType
+	PaymentCount int64
+	Amount       decimal.Decimal
+}
+
+// RevenueByMonth representa a receita agrupada por mês (primeiro dia do mês)
+type RevenueByMonth struct {
+	Month        time.Time
+	PaymentCount int64
+	Amount       decimal.Decimal
+}
+
+// RevenueByUnit representa a receita agrupada por unidade
+type RevenueByUnit struct {
+	UnitID       uuid.UUID
+	UnitNumber   string
+	PaymentCount int64
+	Amount       decimal.Decimal
+}
+
+// RevenueByProperty representa a receita agrupada por imóvel
+type RevenueByProperty struct {
+	PropertyID   uuid.UUID
+	PropertyName string
+	PaymentCount int64
+	Amount       decimal.Decimal
+}
+
+// PaymentHistoryFilter representa os filtros do histórico de pagamentos (período pelo vencimento)
+type PaymentHistoryFilter struct {
+	LeaseID    *uuid.UUID
+	TenantID   *uuid.UUID
+	PropertyID *uuid.UUID
+	Status     *domain.PaymentStatus
+	StartDate  *time.Time
+	EndDate    *time.Time
+}
+
+// PaymentHistoryRow representa um pagamento do histórico com a unidade e o morador do contrato
+type PaymentHistoryRow struct {
+	PaymentID     uuid.UUID
+	LeaseID       uuid.UUID
+	UnitNumber    string
+	TenantName    string
+	PaymentType   domain.PaymentType
+	Amount        decimal.Decimal
+	Status        domain.PaymentStatus
+	DueDate       time.Time
+	PaymentDate   *time.Time
+	PaymentMethod *domain.PaymentMethod
+	CreatedAt     time.Time
+}
+
+// PaymentHistoryTotals representa a quantidade e a soma dos pagamentos do histórico
+type PaymentHistoryTotals struct {
+	PaymentCount int64
+	Amount       decimal.Decimal
+}
+
+// NotificationRepository define as operações de persistência da fila de notificações
+type NotificationRepository interface {
+	// Create insere a notificação e retorna false se já existir outra com a mesma dedup_key
+	Create(ctx context.Context, notification *domain.Notification) (bool, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Notification, error)
+	// GetByProviderMessageID busca a notificação pelo identificador devolvido pelo provedor no envio
+	GetByProviderMessageID(ctx context.Context, messageID string) (*domain.Notification, error)
+	List(ctx context.Context) ([]*domain.Notification, error)
+	ListByStatus(ctx context.Context, status domain.NotificationStatus) ([]*domain.Notification, error)
+	ListByLeaseID(ctx context.Context, leaseID uuid.UUID) ([]*domain.Notification, error)
+	// ListByTenantID retorna as notificações do morador e dos seus contratos
+	ListByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*domain.Notification, error)
+	// ListDue retorna as notificações pendentes agendadas até hoje cuja próxima tentativa já chegou
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.Notification, error)
+	// UpdateDelivery grava status, tentativas e erro da última tentativa de envio
+	UpdateDelivery(ctx context.Context, notification *domain.Notification) error
+}
+
+// DunningRepository define as operações de persistência da régua de cobrança e do histórico de cobrança
+type DunningRepository interface {
+	CreateStep(ctx context.Context, step *domain.DunningStep) error
+	GetStepByID(ctx context.Context, id uuid.UUID) (*domain.DunningStep, error)
+	GetStepByCode(ctx context.Context, code string) (*domain.DunningStep, error)
+	// ListSteps retorna as etapas ordenadas pelo prazo em relação ao vencimento
+	ListSteps(ctx context.Context) ([]*domain.DunningStep, error)
+	ListActiveSteps(ctx context.Context) ([]*domain.DunningStep, error)
+	UpdateStep(ctx context.Context, step *domain.DunningStep) error
+
+	// CreateAction registra a etapa no histórico e retorna false se ela já foi registrada para o pagamento
+	CreateAction(ctx context.Context, action *domain.CollectionAction) (bool, error)
+	ListActionsByPaymentID(ctx context.Context, paymentID uuid.UUID) ([]*domain.CollectionAction, error)
+	// ListOpenActionsByAction retorna as etapas executadas de pagamentos ainda em aberto
+	ListOpenActionsByAction(ctx context.Context, action domain.DunningAction) ([]*domain.CollectionAction, error)
+}
+
+// WebhookRepository define as operações de persistência dos webhooks e do registro de entregas
+type WebhookRepository interface {
+	// CreateEndpoint insere o endpoint com os eventos inscritos em uma transação
+	CreateEndpoint(ctx context.Context, endpoint *domain.WebhookEndpoint) error
+	GetEndpointByID(ctx context.Context, id uuid.UUID) (*domain.WebhookEndpoint, error)
+	ListEndpoints(ctx context.Context) ([]*domain.WebhookEndpoint, error)
+	// ListActiveEndpointsByEvent retorna os endpoints ativos inscritos no evento
+	ListActiveEndpointsByEvent(ctx context.Context, eventType domain.WebhookEventType) ([]*domain.WebhookEndpoint, error)
+	// UpdateEndpoint atualiza o endpoint e substitui os eventos inscritos em uma transação
+	UpdateEndpoint(ctx context.Context, endpoint *domain.WebhookEndpoint) error
+	DeleteEndpoint(ctx context.Context, id uuid.UUID) error
+
+	CreateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error
+	GetDeliveryByID(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error)
+	// ListDeliveriesByEndpointID retorna as entregas mais recentes do endpoint
+	ListDeliveriesByEndpointID(ctx context.Context, endpointID uuid.UUID, limit int) ([]*domain.WebhookDelivery, error)
+	// ListDueDeliveries retorna as entregas pendentes cuja próxima tentativa já chegou
+	ListDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*domain.WebhookDelivery, error)
+	// UpdateDeliveryAttempt grava status, tentativas e resposta da última tentativa de entrega
+	UpdateDeliveryAttempt(ctx context.Context, delivery *domain.WebhookDelivery) error
+}
+
+// CalendarFeedRepository define as operações de persistência dos feeds de calendário
+type CalendarFeedRepository interface {
+	// Upsert cria o feed do usuário ou substitui o token existente
+	Upsert(ctx context.Context, feed *domain.CalendarFeed) error
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*domain.CalendarFeed, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*domain.CalendarFeed, error)
+	// Touch registra o último acesso de um aplicativo de calendário ao feed
+	Touch(ctx context.Context, userID uuid.UUID, accessedAt time.Time) error
+	Delete(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+)
+
+// Compile-time check to ensure CalendarFeedRepo implements repository.CalendarFeedRepository
+var _ repository.CalendarFeedRepository = (*CalendarFeedRepo)(nil)
+
+// CalendarFeedRepo implementa o repository dos feeds de calendário usando SQLC
+type CalendarFeedRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewCalendarFeedRepo cria uma nova instância do repository de feeds de calendário
+func NewCalendarFeedRepo(db *sql.DB) *CalendarFeedRepo {
+	return &CalendarFeedRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// Upsert cria o feed do usuário ou substitui o token existente
+func (r *CalendarFeedRepo) Upsert(ctx context.Context, feed *domain.CalendarFeed) error {
+	_, err := r.queries.UpsertCalendarFeed(ctx, sqlc.UpsertCalendarFeedParams{
+		UserID:    feed.UserID,
+		TokenHash: feed.TokenHash,
+		CreatedAt: feed.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save calendar feed: %w", err)
+	}
+	return nil
+}
+
+// GetByUserID busca o feed do usuário
+func (r *CalendarFeedRepo) GetByUserID(ctx context.Context, userID uuid.UUID) (*domain.CalendarFeed, error) {
+	row, err := r.queries.GetCalendarFeedByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get calendar feed: %w", err)
+	}
+	return r.toDomain(row), nil
+}
+
+// GetByTokenHash busca o feed pelo hash do token informado na URL
+func (r *CalendarFeedRepo) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.CalendarFeed, error) {
+	row, err := r.queries.GetCalendarFeedByTokenHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get calendar feed: %w", err)
+	}
+	return r.toDomain(row), nil
+}
+
+// Touch registra o último acesso ao feed
+func (r *CalendarFeedRepo) Touch(ctx context.Context, userID uuid.UUID, accessedAt time.Time) error {
+	err := r.queries.TouchCalendarFeed(ctx, sqlc.TouchCalendarFeedParams{
+		UserID:         userID,
+		LastAccessedAt: toNullTimePtr(&accessedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update calendar feed access: %w", err)
+	}
+	return nil
+}
+
+// Delete remove o feed do usuário, invalidando a URL
+func (r *CalendarFeedRepo) Delete(ctx context.Context, userID uuid.UUID) error {
+	if err := r.queries.DeleteCalendarFeed(ctx, userID); err != nil {
+		return fmt.Errorf("failed to delete calendar feed: %w", err)
+	}
+	return nil
+}
+
+// toDomain converte o modelo do SQLC para o domínio
+func (r *CalendarFeedRepo) toDomain(row sqlc.CalendarFeed) *domain.CalendarFeed {
+	return &domain.CalendarFeed{
+		UserID:         row.UserID,
+		TokenHash:      row.TokenHash,
+		LastAccessedAt: fromNullTimePtr(row.LastAccessedAt),
+		CreatedAt:      row.CreatedAt,
+	}
+}
@@ -76,3 +76,52 @@ func (r *DashboardRepo) GetTotalPendingAmount(ctx context.Context) (decimal.Deci
 
 	return decimal.NewFromString(total)
 }
+
+func (r *DashboardRepo) GetOccupancyMetricsByProperty(ctx context.Context) ([]*repository.PropertyOccupancyMetrics, error) {
+	rows, err := r.queries.GetOccupancyMetricsByProperty(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := make([]*repository.PropertyOccupancyMetrics, len(rows))
+	for i, row := range rows {
+		metrics[i] = &repository.PropertyOccupancyMetrics{
+			PropertyID:   row.PropertyID,
+			PropertyName: row.PropertyName,
+			OccupancyMetrics: repository.OccupancyMetrics{
+				TotalUnits:       row.TotalUnits,
+				OccupiedUnits:    row.OccupiedUnits,
+				AvailableUnits:   row.AvailableUnits,
+				MaintenanceUnits: row.MaintenanceUnits,
+				RenovationUnits:  row.RenovationUnits,
+			},
+		}
+	}
+
+	return metrics, nil
+}
+
+func (r *DashboardRepo) GetFinancialMetricsByProperty(ctx context.Context) ([]*repository.PropertyFinancialMetrics, error) {
+	rows, err := r.queries.GetFinancialMetricsByProperty(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := make([]*repository.PropertyFinancialMetrics, len(rows))
+	for i, row := range rows {
+		projected, _ := decimal.NewFromString(row.ProjectedRevenue)
+		realized, _ := decimal.NewFromString(row.RealizedRevenue)
+		overdue, _ := decimal.NewFromString(row.OverdueAmount)
+		pending, _ := decimal.NewFromString(row.PendingAmount)
+
+		metrics[i] = &repository.PropertyFinancialMetrics{
+			PropertyID:       row.PropertyID,
+			ProjectedRevenue: projected,
+			RealizedRevenue:  realized,
+			OverdueAmount:    overdue,
+			PendingAmount:    pending,
+		}
+	}
+
+	return metrics, nil
+}
@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+	"github.com/shopspring/decimal"
+)
+
+// Compile-time check to ensure DepositSettlementRepo implements repository.DepositSettlementRepository
+var _ repository.DepositSettlementRepository = (*DepositSettlementRepo)(nil)
+
+// DepositSettlementRepo implementa o repository de acerto da caução usando SQLC
+type DepositSettlementRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewDepositSettlementRepo cria uma nova instância do repository de acerto da caução
+func NewDepositSettlementRepo(db *sql.DB) *DepositSettlementRepo {
+	return &DepositSettlementRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// Create insere o acerto da caução de um contrato e quita, na mesma transação,
+// os pagamentos em aberto cobertos pela caução
+func (r *DepositSettlementRepo) Create(ctx context.Context, settlement *domain.DepositSettlement) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	qtx := sqlc.New(tx)
+
+	params := sqlc.CreateDepositSettlementParams{
+		ID:               settlement.ID,
+		LeaseID:          settlement.LeaseID,
+		DepositAmount:    settlement.DepositAmount.String(),
+		CorrectionRate:   settlement.CorrectionRate.String(),
+		CorrectionAmount: settlement.CorrectionAmount.String(),
+		DamageDeductions: settlement.DamageDeductions.String(),
+		DebtDeductions:   settlement.DebtDeductions.String(),
+		OtherDeductions:  settlement.OtherDeductions.String(),
+		RefundAmount:     settlement.RefundAmount.String(),
+		BalanceDue:       settlement.BalanceDue.String(),
+		RefundedAt:       toNullTimePtr(settlement.RefundedAt),
+		RefundMethod:     toNullStringPtr(paymentMethodToStringPtr(settlement.RefundMethod)),
+		Notes:            toNullStringPtr(settlement.Notes),
+		CreatedBy:        toNullUUIDPtr(settlement.CreatedBy),
+		CreatedAt:        settlement.CreatedAt,
+	}
+
+	if _, err := qtx.CreateDepositSettlement(ctx, params); err != nil {
+		return fmt.Errorf("failed to create deposit settlement: %w", err)
+	}
+
+	for _, paymentID := range settlement.SettledPaymentIDs {
+		if err := qtx.SettlePaymentWithDeposit(ctx, sqlc.SettlePaymentWithDepositParams{
+			ID:                  paymentID,
+			PaymentDate:         sql.NullTime{Time: settlement.CreatedAt, Valid: true},
+			DepositSettlementID: uuid.NullUUID{UUID: settlement.ID, Valid: true},
+			UpdatedAt:           settlement.CreatedAt,
+		}); err != nil {
+			return fmt.Errorf("failed to settle payment with deposit: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetByLeaseID busca o acerto da caução de um contrato
+func (r *DepositSettlementRepo) GetByLeaseID(ctx context.Context, leaseID uuid.UUID) (*domain.DepositSettlement, error) {
+	row, err := r.queries.GetDepositSettlementByLeaseID(ctx, leaseID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get deposit settlement: %w", err)
+	}
+
+	settlement := depositSettlementToDomain(row)
+
+	paymentIDs, err := r.queries.ListPaymentIDsByDepositSettlementID(ctx, uuid.NullUUID{UUID: settlement.ID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payments settled with deposit: %w", err)
+	}
+	if len(paymentIDs) > 0 {
+		settlement.SettledPaymentIDs = paymentIDs
+	}
+
+	return settlement, nil
+}
+
+// MarkRefunded registra a devolução do saldo de um acerto da caução
+func (r *DepositSettlementRepo) MarkRefunded(ctx context.Context, id uuid.UUID, refundedAt time.Time, method domain.PaymentMethod) error {
+	params := sqlc.MarkDepositSettlementRefundedParams{
+		ID:           id,
+		RefundedAt:   sql.NullTime{Time: refundedAt, Valid: true},
+		RefundMethod: sql.NullString{String: string(method), Valid: true},
+	}
+
+	if _, err := r.queries.MarkDepositSettlementRefunded(ctx, params); err != nil {
+		return fmt.Errorf("failed to mark deposit settlement as refunded: %w", err)
+	}
+
+	return nil
+}
+
+// depositSettlementToDomain converte um registro do banco para o domain model
+func depositSettlementToDomain(row sqlc.LeaseDepositSettlement) *domain.DepositSettlement {
+	depositAmount, _ := decimal.NewFromString(row.DepositAmount)
+	correctionRate, _ := decimal.NewFromString(row.CorrectionRate)
+	correctionAmount, _ := decimal.NewFromString(row.CorrectionAmount)
+	damageDeductions, _ := decimal.NewFromString(row.DamageDeductions)
+	debtDeductions, _ := decimal.NewFromString(row.DebtDeductions)
+	otherDeductions, _ := decimal.NewFromString(row.OtherDeductions)
+	refundAmount, _ := decimal.NewFromString(row.RefundAmount)
+	balanceDue, _ := decimal.NewFromString(row.BalanceDue)
+
+	return &domain.DepositSettlement{
+		ID:               row.ID,
+		LeaseID:          row.LeaseID,
+		DepositAmount:    depositAmount,
+		CorrectionRate:   correctionRate,
+		CorrectionAmount: correctionAmount,
+		DamageDeductions: damageDeductions,
+		DebtDeductions:   debtDeductions,
+		OtherDeductions:  otherDeductions,
+		RefundAmount:     refundAmount,
+		BalanceDue:       balanceDue,
+		RefundedAt:       fromNullTimePtr(row.RefundedAt),
+		RefundMethod:     stringToPaymentMethodPtr(fromNullStringPtr(row.RefundMethod)),
+		Notes:            fromNullStringPtr(row.Notes),
+		CreatedBy:        fromNullUUIDPtr(row.CreatedBy),
+		CreatedAt:        row.CreatedAt,
+	}
+}
@@ -0,0 +1,209 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+)
+
+// Compile-time check to ensure DunningRepo implements repository.DunningRepository
+var _ repository.DunningRepository = (*DunningRepo)(nil)
+
+// DunningRepo implementa o repository da régua de cobrança usando SQLC
+type DunningRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewDunningRepo cria uma nova instância do repository da régua de cobrança
+func NewDunningRepo(db *sql.DB) *DunningRepo {
+	return &DunningRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// CreateStep insere uma nova etapa da régua
+func (r *DunningRepo) CreateStep(ctx context.Context, step *domain.DunningStep) error {
+	_, err := r.queries.CreateDunningStep(ctx, sqlc.CreateDunningStepParams{
+		ID:         step.ID,
+		Code:       step.Code,
+		Name:       step.Name,
+		OffsetDays: int32(step.OffsetDays),
+		Action:     string(step.Action),
+		Active:     step.Active,
+		CreatedAt:  step.CreatedAt,
+		UpdatedAt:  step.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create dunning step: %w", err)
+	}
+
+	return nil
+}
+
+// GetStepByID busca uma etapa por ID
+func (r *DunningRepo) GetStepByID(ctx context.Context, id uuid.UUID) (*domain.DunningStep, error) {
+	row, err := r.queries.GetDunningStepByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get dunning step: %w", err)
+	}
+
+	return r.stepToDomain(row), nil
+}
+
+// GetStepByCode busca uma etapa pelo código
+func (r *DunningRepo) GetStepByCode(ctx context.Context, code string) (*domain.DunningStep, error) {
+	row, err := r.queries.GetDunningStepByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get dunning step by code: %w", err)
+	}
+
+	return r.stepToDomain(row), nil
+}
+
+// ListSteps lista todas as etapas da régua
+func (r *DunningRepo) ListSteps(ctx context.Context) ([]*domain.DunningStep, error) {
+	rows, err := r.queries.ListDunningSteps(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dunning steps: %w", err)
+	}
+
+	return r.stepToDomainList(rows), nil
+}
+
+// ListActiveSteps lista as etapas ativas da régua
+func (r *DunningRepo) ListActiveSteps(ctx context.Context) ([]*domain.DunningStep, error) {
+	rows, err := r.queries.ListActiveDunningSteps(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active dunning steps: %w", err)
+	}
+
+	return r.stepToDomainList(rows), nil
+}
+
+// UpdateStep atualiza nome, prazo, ação e situação da etapa
+func (r *DunningRepo) UpdateStep(ctx context.Context, step *domain.DunningStep) error {
+	_, err := r.queries.UpdateDunningStep(ctx, sqlc.UpdateDunningStepParams{
+		ID:         step.ID,
+		Name:       step.Name,
+		OffsetDays: int32(step.OffsetDays),
+		Action:     string(step.Action),
+		Active:     step.Active,
+		UpdatedAt:  step.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update dunning step: %w", err)
+	}
+
+	return nil
+}
+
+// CreateAction registra uma etapa no histórico de cobrança; etapas já registradas para o pagamento são ignoradas
+func (r *DunningRepo) CreateAction(ctx context.Context, action *domain.CollectionAction) (bool, error) {
+	_, err := r.queries.CreatePaymentCollectionAction(ctx, sqlc.CreatePaymentCollectionActionParams{
+		ID:                   action.ID,
+		PaymentID:            action.PaymentID,
+		StepID:               action.StepID,
+		StepCode:             action.StepCode,
+		StepName:             action.StepName,
+		OffsetDays:           int32(action.OffsetDays),
+		Action:               string(action.Action),
+		Status:               string(action.Status),
+		NotificationsCreated: int32(action.NotificationsCreated),
+		Notes:                toNullStringPtr(action.Notes),
+		ExecutedAt:           action.ExecutedAt,
+		CreatedAt:            action.CreatedAt,
+	})
+	if err != nil {
+		// ON CONFLICT DO NOTHING não retorna linhas quando a etapa já foi registrada
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to create collection action: %w", err)
+	}
+
+	return true, nil
+}
+
+// ListActionsByPaymentID retorna o histórico de cobrança de um pagamento
+func (r *DunningRepo) ListActionsByPaymentID(ctx context.Context, paymentID uuid.UUID) ([]*domain.CollectionAction, error) {
+	rows, err := r.queries.ListPaymentCollectionActionsByPaymentID(ctx, paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collection actions: %w", err)
+	}
+
+	return r.actionToDomainList(rows), nil
+}
+
+// ListOpenActionsByAction retorna as etapas executadas de pagamentos ainda em aberto
+func (r *DunningRepo) ListOpenActionsByAction(ctx context.Context, action domain.DunningAction) ([]*domain.CollectionAction, error) {
+	rows, err := r.queries.ListOpenPaymentCollectionActionsByAction(ctx, string(action))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list open collection actions: %w", err)
+	}
+
+	return r.actionToDomainList(rows), nil
+}
+
+// stepToDomain converte sqlc.DunningStep para domain.DunningStep
+func (r *DunningRepo) stepToDomain(row sqlc.DunningStep) *domain.DunningStep {
+	return &domain.DunningStep{
+		ID:         row.ID,
+		Code:       row.Code,
+		Name:       row.Name,
+		OffsetDays: int(row.OffsetDays),
+		Action:     domain.DunningAction(row.Action),
+		Active:     row.Active,
+		CreatedAt:  row.CreatedAt,
+		UpdatedAt:  row.UpdatedAt,
+	}
+}
+
+// stepToDomainList converte []sqlc.DunningStep para []*domain.DunningStep
+func (r *DunningRepo) stepToDomainList(rows []sqlc.DunningStep) []*domain.DunningStep {
+	steps := make([]*domain.DunningStep, len(rows))
+	for i, row := range rows {
+		steps[i] = r.stepToDomain(row)
+	}
+	return steps
+}
+
+// actionToDomain converte sqlc.PaymentCollectionAction para domain.CollectionAction
+func (r *DunningRepo) actionToDomain(row sqlc.PaymentCollectionAction) *domain.CollectionAction {
+	return &domain.CollectionAction{
+		ID:                   row.ID,
+		PaymentID:            row.PaymentID,
+		StepID:               row.StepID,
+		StepCode:             row.StepCode,
+		StepName:             row.StepName,
+		OffsetDays:           int(row.OffsetDays),
+		Action:               domain.DunningAction(row.Action),
+		Status:               domain.CollectionActionStatus(row.Status),
+		NotificationsCreated: int(row.NotificationsCreated),
+		Notes:                fromNullStringPtr(row.Notes),
+		ExecutedAt:           row.ExecutedAt,
+		CreatedAt:            row.CreatedAt,
+	}
+}
+
+// actionToDomainList converte []sqlc.PaymentCollectionAction para []*domain.CollectionAction
+func (r *DunningRepo) actionToDomainList(rows []sqlc.PaymentCollectionAction) []*domain.CollectionAction {
+	actions := make([]*domain.CollectionAction, len(rows))
+	for i, row := range rows {
+		actions[i] = r.actionToDomain(row)
+	}
+	return actions
+}
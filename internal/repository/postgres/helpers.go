@@ -6,6 +6,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+	"github.com/shopspring/decimal"
 )
 
 // Helper functions para conversão de tipos nullable
@@ -69,3 +71,85 @@ func fromNullUUIDPtr(nu uuid.NullUUID) *uuid.UUID {
 	}
 	return &nu.UUID
 }
+
+// Helpers para decimal opcional (armazenado como DECIMAL nullable)
+func toNullDecimalPtr(d *decimal.Decimal) sql.NullString {
+	if d == nil {
+		return sql.NullString{Valid: false}
+	}
+	return sql.NullString{String: d.String(), Valid: true}
+}
+
+func fromNullDecimalPtr(ns sql.NullString) *decimal.Decimal {
+	if !ns.Valid {
+		return nil
+	}
+	d, err := decimal.NewFromString(ns.String)
+	if err != nil {
+		return nil
+	}
+	return &d
+}
+
+// Helpers para status de unidade opcional (usado em MaintenanceTicket)
+func toNullUnitStatusPtr(s *domain.UnitStatus) sqlc.NullUnitStatus {
+	if s == nil {
+		return sqlc.NullUnitStatus{Valid: false}
+	}
+	return sqlc.NullUnitStatus{UnitStatus: sqlc.UnitStatus(*s), Valid: true}
+}
+
+func fromNullUnitStatusPtr(ns sqlc.NullUnitStatus) *domain.UnitStatus {
+	if !ns.Valid {
+		return nil
+	}
+	s := domain.UnitStatus(ns.UnitStatus)
+	return &s
+}
+
+// Helpers para inteiro opcional (usado em Property)
+func toNullInt32Ptr(i *int) sql.NullInt32 {
+	if i == nil {
+		return sql.NullInt32{Valid: false}
+	}
+	return sql.NullInt32{Int32: int32(*i), Valid: true}
+}
+
+func fromNullInt32Ptr(ni sql.NullInt32) *int {
+	if !ni.Valid {
+		return nil
+	}
+	i := int(ni.Int32)
+	return &i
+}
+
+// Helpers para dados opcionais da caução (usado em Lease)
+func toNullDepositCustodyPtr(c *domain.DepositCustody) sql.NullString {
+	if c == nil {
+		return sql.NullString{Valid: false}
+	}
+	return sql.NullString{String: string(*c), Valid: true}
+}
+
+func fromNullDepositCustodyPtr(ns sql.NullString) *domain.DepositCustody {
+	if !ns.Valid {
+		return nil
+	}
+	c := domain.DepositCustody(ns.String)
+	return &c
+}
+
+func toNullDepositCorrectionIndexPtr(i *domain.DepositCorrectionIndex) sql.NullString {
+	if i == nil {
+		return sql.NullString{Valid: false}
+	}
+	return sql.NullString{String: string(*i), Valid: true}
+}
+
+func fromNullDepositCorrectionIndexPtr(ns sql.NullString) *domain.DepositCorrectionIndex {
+	if !ns.Valid {
+		return nil
+	}
+	i := domain.DepositCorrectionIndex(ns.String)
+	return &i
+}
@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+)
+
+// Compile-time check to ensure InventoryRepo implements repository.InventoryRepository
+var _ repository.InventoryRepository = (*InventoryRepo)(nil)
+
+// InventoryRepo implementa o repository de inventário das unidades usando SQLC
+type InventoryRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewInventoryRepo cria uma nova instância do repository de inventário
+func NewInventoryRepo(db *sql.DB) *InventoryRepo {
+	return &InventoryRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// CreateItem insere um novo item no inventário
+func (r *InventoryRepo) CreateItem(ctx context.Context, item *domain.UnitInventoryItem) error {
+	params := sqlc.CreateUnitInventoryItemParams{
+		ID:            item.ID,
+		UnitID:        item.UnitID,
+		Name:          item.Name,
+		ItemType:      string(item.Type),
+		Brand:         toNullStringPtr(item.Brand),
+		SerialNumber:  toNullStringPtr(item.SerialNumber),
+		Condition:     string(item.Condition),
+		PurchaseDate:  toNullTimePtr(item.PurchaseDate),
+		PurchaseValue: toNullDecimalPtr(item.PurchaseValue),
+		Notes:         toNullStringPtr(item.Notes),
+		CreatedAt:     item.CreatedAt,
+		UpdatedAt:     item.UpdatedAt,
+	}
+
+	if _, err := r.queries.CreateUnitInventoryItem(ctx, params); err != nil {
+		return fmt.Errorf("failed to create inventory item: %w", err)
+	}
+
+	return nil
+}
+
+// GetItemByID busca um item do inventário pelo ID
+func (r *InventoryRepo) GetItemByID(ctx context.Context, id uuid.UUID) (*domain.UnitInventoryItem, error) {
+	row, err := r.queries.GetUnitInventoryItemByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get inventory item: %w", err)
+	}
+
+	return r.itemToDomain(row), nil
+}
+
+// ListItemsByUnitID retorna o inventário de uma unidade
+func (r *InventoryRepo) ListItemsByUnitID(ctx context.Context, unitID uuid.UUID) ([]*domain.UnitInventoryItem, error) {
+	rows, err := r.queries.ListUnitInventoryItemsByUnitID(ctx, unitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inventory items: %w", err)
+	}
+
+	items := make([]*domain.UnitInventoryItem, len(rows))
+	for i, row := range rows {
+		items[i] = r.itemToDomain(row)
+	}
+
+	return items, nil
+}
+
+// UpdateItem atualiza um item do inventário
+func (r *InventoryRepo) UpdateItem(ctx context.Context, item *domain.UnitInventoryItem) error {
+	item.UpdatedAt = time.Now()
+
+	params := sqlc.UpdateUnitInventoryItemParams{
+		ID:            item.ID,
+		Name:          item.Name,
+		ItemType:      string(item.Type),
+		Brand:         toNullStringPtr(item.Brand),
+		SerialNumber:  toNullStringPtr(item.SerialNumber),
+		Condition:     string(item.Condition),
+		PurchaseDate:  toNullTimePtr(item.PurchaseDate),
+		PurchaseValue: toNullDecimalPtr(item.PurchaseValue),
+		Notes:         toNullStringPtr(item.Notes),
+		UpdatedAt:     item.UpdatedAt,
+	}
+
+	if _, err := r.queries.UpdateUnitInventoryItem(ctx, params); err != nil {
+		return fmt.Errorf("failed to update inventory item: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteItem remove um item do inventário (checklists antigos mantêm a cópia dos dados)
+func (r *InventoryRepo) DeleteItem(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteUnitInventoryItem(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete inventory item: %w", err)
+	}
+	return nil
+}
+
+// CreateChecklist insere o checklist e todos os seus itens em uma transação
+func (r *InventoryRepo) CreateChecklist(ctx context.Context, checklist *domain.InventoryChecklist) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	qtx := sqlc.New(tx)
+
+	if _, err := qtx.CreateInventoryChecklist(ctx, sqlc.CreateInventoryChecklistParams{
+		ID:            checklist.ID,
+		LeaseID:       checklist.LeaseID,
+		UnitID:        checklist.UnitID,
+		ChecklistType: string(checklist.Type),
+		Notes:         toNullStringPtr(checklist.Notes),
+		PerformedBy:   toNullUUIDPtr(checklist.PerformedBy),
+		PerformedAt:   checklist.PerformedAt,
+		CreatedAt:     checklist.CreatedAt,
+	}); err != nil {
+		return fmt.Errorf("failed to create inventory checklist: %w", err)
+	}
+
+	for _, item := range checklist.Items {
+		if _, err := qtx.CreateInventoryChecklistItem(ctx, sqlc.CreateInventoryChecklistItemParams{
+			ID:               item.ID,
+			ChecklistID:      checklist.ID,
+			ItemID:           toNullUUIDPtr(item.ItemID),
+			ItemName:         item.ItemName,
+			ItemType:         string(item.ItemType),
+			Brand:            toNullStringPtr(item.Brand),
+			SerialNumber:     toNullStringPtr(item.SerialNumber),
+			Condition:        string(item.Condition),
+			Present:          item.Present,
+			ReplacementValue: toNullDecimalPtr(item.ReplacementValue),
+			Notes:            toNullStringPtr(item.Notes),
+		}); err != nil {
+			return fmt.Errorf("failed to create inventory checklist item: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetChecklist busca o checklist de um contrato pelo tipo, incluindo os itens
+func (r *InventoryRepo) GetChecklist(ctx context.Context, leaseID uuid.UUID, checklistType domain.ChecklistType) (*domain.InventoryChecklist, error) {
+	row, err := r.queries.GetInventoryChecklistByLeaseAndType(ctx, sqlc.GetInventoryChecklistByLeaseAndTypeParams{
+		LeaseID:       leaseID,
+		ChecklistType: string(checklistType),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get inventory checklist: %w", err)
+	}
+
+	itemRows, err := r.queries.ListInventoryChecklistItemsByChecklistID(ctx, row.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list inventory checklist items: %w", err)
+	}
+
+	checklist := &domain.InventoryChecklist{
+		ID:          row.ID,
+		LeaseID:     row.LeaseID,
+		UnitID:      row.UnitID,
+		Type:        domain.ChecklistType(row.ChecklistType),
+		Notes:       fromNullStringPtr(row.Notes),
+		PerformedBy: fromNullUUIDPtr(row.PerformedBy),
+		PerformedAt: row.PerformedAt,
+		Items:       make([]*domain.InventoryChecklistItem, len(itemRows)),
+		CreatedAt:   row.CreatedAt,
+	}
+
+	for i, item := range itemRows {
+		checklist.Items[i] = &domain.InventoryChecklistItem{
+			ID:               item.ID,
+			ChecklistID:      item.ChecklistID,
+			ItemID:           fromNullUUIDPtr(item.ItemID),
+			ItemName:         item.ItemName,
+			ItemType:         domain.InventoryItemType(item.ItemType),
+			Brand:            fromNullStringPtr(item.Brand),
+			SerialNumber:     fromNullStringPtr(item.SerialNumber),
+			Condition:        domain.ItemCondition(item.Condition),
+			Present:          item.Present,
+			ReplacementValue: fromNullDecimalPtr(item.ReplacementValue),
+			Notes:            fromNullStringPtr(item.Notes),
+		}
+	}
+
+	return checklist, nil
+}
+
+// itemToDomain converte sqlc.UnitInventoryItem para domain.UnitInventoryItem
+func (r *InventoryRepo) itemToDomain(row sqlc.UnitInventoryItem) *domain.UnitInventoryItem {
+	return &domain.UnitInventoryItem{
+		ID:            row.ID,
+		UnitID:        row.UnitID,
+		Name:          row.Name,
+		Type:          domain.InventoryItemType(row.ItemType),
+		Brand:         fromNullStringPtr(row.Brand),
+		SerialNumber:  fromNullStringPtr(row.SerialNumber),
+		Condition:     domain.ItemCondition(row.Condition),
+		PurchaseDate:  fromNullTimePtr(row.PurchaseDate),
+		PurchaseValue: fromNullDecimalPtr(row.PurchaseValue),
+		Notes:         fromNullStringPtr(row.Notes),
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+	}
+}
@@ -45,6 +45,10 @@ func (r *LeaseRepo) Create(ctx context.Context, lease *domain.Lease) error {
 		Status:                  string(lease.Status),
 		ParentLeaseID:           toNullUUIDPtr(lease.ParentLeaseID),
 		Generation:              int32(lease.Generation),
+		SecurityDeposit:         lease.SecurityDeposit.String(),
+		DepositReceivedDate:     toNullTimePtr(lease.DepositReceivedDate),
+		DepositHeldAt:           toNullDepositCustodyPtr(lease.DepositHeldAt),
+		DepositCorrectionIndex:  toNullDepositCorrectionIndexPtr(lease.DepositCorrectionIndex),
 		CreatedAt:               lease.CreatedAt,
 		UpdatedAt:               lease.UpdatedAt,
 	}
@@ -110,6 +114,16 @@ func (r *LeaseRepo) ListByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*
 	return r.toDomainList(rows), nil
 }
 
+// ListByPropertyID lista contratos das unidades de um imóvel
+func (r *LeaseRepo) ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.Lease, error) {
+	rows, err := r.queries.ListLeasesByPropertyID(ctx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leases by property: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
 // GetActiveByUnitID busca contrato ativo de uma unidade
 func (r *LeaseRepo) GetActiveByUnitID(ctx context.Context, unitID uuid.UUID) (*domain.Lease, error) {
 	row, err := r.queries.GetActiveLeaseByUnitID(ctx, unitID)
@@ -163,6 +177,10 @@ func (r *LeaseRepo) Update(ctx context.Context, lease *domain.Lease) error {
 		Status:                  string(lease.Status),
 		ParentLeaseID:           toNullUUIDPtr(lease.ParentLeaseID),
 		Generation:              int32(lease.Generation),
+		SecurityDeposit:         lease.SecurityDeposit.String(),
+		DepositReceivedDate:     toNullTimePtr(lease.DepositReceivedDate),
+		DepositHeldAt:           toNullDepositCustodyPtr(lease.DepositHeldAt),
+		DepositCorrectionIndex:  toNullDepositCorrectionIndexPtr(lease.DepositCorrectionIndex),
 		UpdatedAt:               lease.UpdatedAt,
 	}
 
@@ -206,6 +224,22 @@ func (r *LeaseRepo) UpdatePaintingFeePaid(ctx context.Context, id uuid.UUID, pai
 	return nil
 }
 
+// UpdateDepositReceivedDate registra a data de recebimento da caução
+func (r *LeaseRepo) UpdateDepositReceivedDate(ctx context.Context, id uuid.UUID, receivedDate time.Time) error {
+	params := sqlc.UpdateLeaseDepositReceivedParams{
+		ID:                  id,
+		DepositReceivedDate: sql.NullTime{Time: receivedDate, Valid: true},
+		UpdatedAt:           time.Now(),
+	}
+
+	_, err := r.queries.UpdateLeaseDepositReceived(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to update deposit received date: %w", err)
+	}
+
+	return nil
+}
+
 // Delete remove um contrato
 func (r *LeaseRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	err := r.queries.DeleteLease(ctx, id)
@@ -241,6 +275,7 @@ func (r *LeaseRepo) toDomain(row sqlc.Lease) *domain.Lease {
 	monthlyRentValue, _ := decimal.NewFromString(row.MonthlyRentValue)
 	paintingFeeTotal, _ := decimal.NewFromString(row.PaintingFeeTotal)
 	paintingFeePaid, _ := decimal.NewFromString(row.PaintingFeePaid)
+	securityDeposit, _ := decimal.NewFromString(row.SecurityDeposit)
 
 	return &domain.Lease{
 		ID:                      row.ID,
@@ -257,6 +292,10 @@ func (r *LeaseRepo) toDomain(row sqlc.Lease) *domain.Lease {
 		Status:                  domain.LeaseStatus(row.Status),
 		ParentLeaseID:           fromNullUUIDPtr(row.ParentLeaseID),
 		Generation:              int(row.Generation),
+		SecurityDeposit:         securityDeposit,
+		DepositReceivedDate:     fromNullTimePtr(row.DepositReceivedDate),
+		DepositHeldAt:           fromNullDepositCustodyPtr(row.DepositHeldAt),
+		DepositCorrectionIndex:  fromNullDepositCorrectionIndexPtr(row.DepositCorrectionIndex),
 		CreatedAt:               row.CreatedAt,
 		UpdatedAt:               row.UpdatedAt,
 	}
@@ -313,6 +352,10 @@ func (r *LeaseRepo) UpdateAndCreateAtomic(ctx context.Context, oldLease, newLeas
 			Status:                  string(oldLease.Status),
 			ParentLeaseID:           toNullUUIDPtr(oldLease.ParentLeaseID),
 			Generation:              int32(oldLease.Generation),
+			SecurityDeposit:         oldLease.SecurityDeposit.String(),
+			DepositReceivedDate:     toNullTimePtr(oldLease.DepositReceivedDate),
+			DepositHeldAt:           toNullDepositCustodyPtr(oldLease.DepositHeldAt),
+			DepositCorrectionIndex:  toNullDepositCorrectionIndexPtr(oldLease.DepositCorrectionIndex),
 			UpdatedAt:               time.Now(),
 		}
 
@@ -336,6 +379,10 @@ func (r *LeaseRepo) UpdateAndCreateAtomic(ctx context.Context, oldLease, newLeas
 			Status:                  string(newLease.Status),
 			ParentLeaseID:           toNullUUIDPtr(newLease.ParentLeaseID),
 			Generation:              int32(newLease.Generation),
+			SecurityDeposit:         newLease.SecurityDeposit.String(),
+			DepositReceivedDate:     toNullTimePtr(newLease.DepositReceivedDate),
+			DepositHeldAt:           toNullDepositCustodyPtr(newLease.DepositHeldAt),
+			DepositCorrectionIndex:  toNullDepositCorrectionIndexPtr(newLease.DepositCorrectionIndex),
 			CreatedAt:               newLease.CreatedAt,
 			UpdatedAt:               newLease.UpdatedAt,
 		}
@@ -0,0 +1,246 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+)
+
+// Compile-time check to ensure MaintenanceTicketRepo implements repository.MaintenanceTicketRepository
+var _ repository.MaintenanceTicketRepository = (*MaintenanceTicketRepo)(nil)
+
+// MaintenanceTicketRepo implementa o repository de chamados de manutenção usando SQLC
+type MaintenanceTicketRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewMaintenanceTicketRepo cria uma nova instância do repository de chamados
+func NewMaintenanceTicketRepo(db *sql.DB) *MaintenanceTicketRepo {
+	return &MaintenanceTicketRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// Create insere um novo chamado no banco
+func (r *MaintenanceTicketRepo) Create(ctx context.Context, ticket *domain.MaintenanceTicket) error {
+	params := sqlc.CreateMaintenanceTicketParams{
+		ID:                 ticket.ID,
+		UnitID:             ticket.UnitID,
+		LeaseID:            toNullUUIDPtr(ticket.LeaseID),
+		TenantID:           toNullUUIDPtr(ticket.TenantID),
+		Title:              ticket.Title,
+		Description:        ticket.Description,
+		Category:           string(ticket.Category),
+		Priority:           string(ticket.Priority),
+		Status:             string(ticket.Status),
+		RequiresVacancy:    ticket.RequiresVacancy,
+		PreviousUnitStatus: toNullUnitStatusPtr(ticket.PreviousUnitStatus),
+		AssignedContractor: toNullStringPtr(ticket.AssignedContractor),
+		ContractorPhone:    toNullStringPtr(ticket.ContractorPhone),
+		EstimatedCost:      toNullDecimalPtr(ticket.EstimatedCost),
+		ActualCost:         toNullDecimalPtr(ticket.ActualCost),
+		ResolutionNotes:    toNullStringPtr(ticket.ResolutionNotes),
+		OpenedBy:           toNullUUIDPtr(ticket.OpenedBy),
+		OpenedAt:           ticket.OpenedAt,
+		StartedAt:          toNullTimePtr(ticket.StartedAt),
+		ResolvedAt:         toNullTimePtr(ticket.ResolvedAt),
+		ClosedAt:           toNullTimePtr(ticket.ClosedAt),
+		CreatedAt:          ticket.CreatedAt,
+		UpdatedAt:          ticket.UpdatedAt,
+	}
+
+	if _, err := r.queries.CreateMaintenanceTicket(ctx, params); err != nil {
+		return fmt.Errorf("failed to create maintenance ticket: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID busca um chamado pelo ID
+func (r *MaintenanceTicketRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.MaintenanceTicket, error) {
+	row, err := r.queries.GetMaintenanceTicketByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get maintenance ticket: %w", err)
+	}
+
+	return r.toDomain(row), nil
+}
+
+// List retorna todos os chamados
+func (r *MaintenanceTicketRepo) List(ctx context.Context) ([]*domain.MaintenanceTicket, error) {
+	rows, err := r.queries.ListMaintenanceTickets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance tickets: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// ListByUnitID retorna os chamados de uma unidade
+func (r *MaintenanceTicketRepo) ListByUnitID(ctx context.Context, unitID uuid.UUID) ([]*domain.MaintenanceTicket, error) {
+	rows, err := r.queries.ListMaintenanceTicketsByUnitID(ctx, unitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance tickets by unit: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// ListByPropertyID retorna os chamados das unidades de um imóvel
+func (r *MaintenanceTicketRepo) ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.MaintenanceTicket, error) {
+	rows, err := r.queries.ListMaintenanceTicketsByPropertyID(ctx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance tickets by property: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// ListByTenantID retorna os chamados abertos para um morador
+func (r *MaintenanceTicketRepo) ListByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*domain.MaintenanceTicket, error) {
+	rows, err := r.queries.ListMaintenanceTicketsByTenantID(ctx, uuid.NullUUID{UUID: tenantID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance tickets by tenant: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// ListByStatus retorna os chamados com determinado status
+func (r *MaintenanceTicketRepo) ListByStatus(ctx context.Context, status domain.MaintenanceStatus) ([]*domain.MaintenanceTicket, error) {
+	rows, err := r.queries.ListMaintenanceTicketsByStatus(ctx, string(status))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance tickets by status: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// Update atualiza um chamado existente
+func (r *MaintenanceTicketRepo) Update(ctx context.Context, ticket *domain.MaintenanceTicket) error {
+	params := sqlc.UpdateMaintenanceTicketParams{
+		ID:                 ticket.ID,
+		LeaseID:            toNullUUIDPtr(ticket.LeaseID),
+		TenantID:           toNullUUIDPtr(ticket.TenantID),
+		Title:              ticket.Title,
+		Description:        ticket.Description,
+		Category:           string(ticket.Category),
+		Priority:           string(ticket.Priority),
+		Status:             string(ticket.Status),
+		RequiresVacancy:    ticket.RequiresVacancy,
+		PreviousUnitStatus: toNullUnitStatusPtr(ticket.PreviousUnitStatus),
+		AssignedContractor: toNullStringPtr(ticket.AssignedContractor),
+		ContractorPhone:    toNullStringPtr(ticket.ContractorPhone),
+		EstimatedCost:      toNullDecimalPtr(ticket.EstimatedCost),
+		ActualCost:         toNullDecimalPtr(ticket.ActualCost),
+		ResolutionNotes:    toNullStringPtr(ticket.ResolutionNotes),
+		StartedAt:          toNullTimePtr(ticket.StartedAt),
+		ResolvedAt:         toNullTimePtr(ticket.ResolvedAt),
+		ClosedAt:           toNullTimePtr(ticket.ClosedAt),
+		UpdatedAt:          ticket.UpdatedAt,
+	}
+
+	if _, err := r.queries.UpdateMaintenanceTicket(ctx, params); err != nil {
+		return fmt.Errorf("failed to update maintenance ticket: %w", err)
+	}
+
+	return nil
+}
+
+// CountActiveVacancyByUnitID conta os chamados ativos que exigem a unidade desocupada
+func (r *MaintenanceTicketRepo) CountActiveVacancyByUnitID(ctx context.Context, unitID uuid.UUID) (int64, error) {
+	count, err := r.queries.CountActiveVacancyTicketsByUnitID(ctx, unitID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count active vacancy tickets: %w", err)
+	}
+	return count, nil
+}
+
+// AddPhoto anexa uma foto a um chamado
+func (r *MaintenanceTicketRepo) AddPhoto(ctx context.Context, photo *domain.MaintenanceTicketPhoto) error {
+	params := sqlc.CreateMaintenanceTicketPhotoParams{
+		ID:         photo.ID,
+		TicketID:   photo.TicketID,
+		Url:        photo.URL,
+		Caption:    toNullStringPtr(photo.Caption),
+		UploadedBy: toNullUUIDPtr(photo.UploadedBy),
+		CreatedAt:  photo.CreatedAt,
+	}
+
+	if _, err := r.queries.CreateMaintenanceTicketPhoto(ctx, params); err != nil {
+		return fmt.Errorf("failed to add maintenance ticket photo: %w", err)
+	}
+
+	return nil
+}
+
+// ListPhotos retorna as fotos de um chamado
+func (r *MaintenanceTicketRepo) ListPhotos(ctx context.Context, ticketID uuid.UUID) ([]*domain.MaintenanceTicketPhoto, error) {
+	rows, err := r.queries.ListMaintenanceTicketPhotosByTicketID(ctx, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list maintenance ticket photos: %w", err)
+	}
+
+	photos := make([]*domain.MaintenanceTicketPhoto, len(rows))
+	for i, row := range rows {
+		photos[i] = &domain.MaintenanceTicketPhoto{
+			ID:         row.ID,
+			TicketID:   row.TicketID,
+			URL:        row.Url,
+			Caption:    fromNullStringPtr(row.Caption),
+			UploadedBy: fromNullUUIDPtr(row.UploadedBy),
+			CreatedAt:  row.CreatedAt,
+		}
+	}
+
+	return photos, nil
+}
+
+// toDomain converte sqlc.MaintenanceTicket para domain.MaintenanceTicket
+func (r *MaintenanceTicketRepo) toDomain(row sqlc.MaintenanceTicket) *domain.MaintenanceTicket {
+	return &domain.MaintenanceTicket{
+		ID:                 row.ID,
+		UnitID:             row.UnitID,
+		LeaseID:            fromNullUUIDPtr(row.LeaseID),
+		TenantID:           fromNullUUIDPtr(row.TenantID),
+		Title:              row.Title,
+		Description:        row.Description,
+		Category:           domain.MaintenanceCategory(row.Category),
+		Priority:           domain.MaintenancePriority(row.Priority),
+		Status:             domain.MaintenanceStatus(row.Status),
+		RequiresVacancy:    row.RequiresVacancy,
+		PreviousUnitStatus: fromNullUnitStatusPtr(row.PreviousUnitStatus),
+		AssignedContractor: fromNullStringPtr(row.AssignedContractor),
+		ContractorPhone:    fromNullStringPtr(row.ContractorPhone),
+		EstimatedCost:      fromNullDecimalPtr(row.EstimatedCost),
+		ActualCost:         fromNullDecimalPtr(row.ActualCost),
+		ResolutionNotes:    fromNullStringPtr(row.ResolutionNotes),
+		OpenedBy:           fromNullUUIDPtr(row.OpenedBy),
+		OpenedAt:           row.OpenedAt,
+		StartedAt:          fromNullTimePtr(row.StartedAt),
+		ResolvedAt:         fromNullTimePtr(row.ResolvedAt),
+		ClosedAt:           fromNullTimePtr(row.ClosedAt),
+		CreatedAt:          row.CreatedAt,
+		UpdatedAt:          row.UpdatedAt,
+	}
+}
+
+// toDomainList converte []sqlc.MaintenanceTicket para []*domain.MaintenanceTicket
+func (r *MaintenanceTicketRepo) toDomainList(rows []sqlc.MaintenanceTicket) []*domain.MaintenanceTicket {
+	tickets := make([]*domain.MaintenanceTicket, len(rows))
+	for i, row := range rows {
+		tickets[i] = r.toDomain(row)
+	}
+	return tickets
+}
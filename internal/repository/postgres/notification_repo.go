@@ -0,0 +1,207 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+)
+
+// Compile-time check to ensure NotificationRepo implements repository.NotificationRepository
+var _ repository.NotificationRepository = (*NotificationRepo)(nil)
+
+// NotificationRepo implementa o repository da fila de notificações usando SQLC
+type NotificationRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewNotificationRepo cria uma nova instância do repository de notificações
+func NewNotificationRepo(db *sql.DB) *NotificationRepo {
+	return &NotificationRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// Create insere uma nova notificação; notificações com dedup_key repetida são ignoradas
+func (r *NotificationRepo) Create(ctx context.Context, notification *domain.Notification) (bool, error) {
+	params := sqlc.CreateNotificationParams{
+		ID:             notification.ID,
+		LeaseID:        toNullUUIDPtr(notification.LeaseID),
+		TenantID:       toNullUUIDPtr(notification.TenantID),
+		PaymentID:      toNullUUIDPtr(notification.PaymentID),
+		Type:           string(notification.Type),
+		Channel:        string(notification.Channel),
+		Recipient:      notification.Recipient,
+		Subject:        notification.Subject,
+		MessageContent: notification.Message,
+		ScheduledDate:  notification.ScheduledDate,
+		Status:         string(notification.Status),
+		Attempts:       int32(notification.Attempts),
+		NextAttemptAt:  toNullTimePtr(notification.NextAttemptAt),
+		LastError:      toNullStringPtr(notification.LastError),
+		SentDate:       toNullTimePtr(notification.SentDate),
+		DedupKey:       notification.DedupKey,
+		CreatedAt:      notification.CreatedAt,
+		UpdatedAt:      notification.UpdatedAt,
+		HtmlContent:    toNullStringPtr(notification.HTMLMessage),
+	}
+
+	if _, err := r.queries.CreateNotification(ctx, params); err != nil {
+		// ON CONFLICT DO NOTHING não retorna linhas quando a notificação já existe
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	return true, nil
+}
+
+// GetByID busca uma notificação por ID
+func (r *NotificationRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.Notification, error) {
+	row, err := r.queries.GetNotificationByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get notification: %w", err)
+	}
+
+	return r.toDomain(row), nil
+}
+
+// GetByProviderMessageID busca a notificação pelo identificador retornado pelo provedor
+func (r *NotificationRepo) GetByProviderMessageID(ctx context.Context, messageID string) (*domain.Notification, error) {
+	row, err := r.queries.GetNotificationByProviderMessageID(ctx, toNullStringPtr(&messageID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get notification by provider message id: %w", err)
+	}
+
+	return r.toDomain(row), nil
+}
+
+// List retorna todas as notificações
+func (r *NotificationRepo) List(ctx context.Context) ([]*domain.Notification, error) {
+	rows, err := r.queries.ListNotifications(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// ListByStatus retorna as notificações com o status informado
+func (r *NotificationRepo) ListByStatus(ctx context.Context, status domain.NotificationStatus) ([]*domain.Notification, error) {
+	rows, err := r.queries.ListNotificationsByStatus(ctx, string(status))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications by status: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// ListByLeaseID retorna as notificações de um contrato
+func (r *NotificationRepo) ListByLeaseID(ctx context.Context, leaseID uuid.UUID) ([]*domain.Notification, error) {
+	rows, err := r.queries.ListNotificationsByLeaseID(ctx, toNullUUIDPtr(&leaseID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications by lease: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// ListByTenantID lista as notificações do morador e dos seus contratos
+func (r *NotificationRepo) ListByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*domain.Notification, error) {
+	rows, err := r.queries.ListNotificationsByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications by tenant: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// ListDue retorna as notificações prontas para envio
+func (r *NotificationRepo) ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.Notification, error) {
+	rows, err := r.queries.ListDueNotifications(ctx, sqlc.ListDueNotificationsParams{
+		Today:       time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC),
+		Now:         now,
+		ResultLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due notifications: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// UpdateDelivery grava o resultado da última tentativa de envio
+func (r *NotificationRepo) UpdateDelivery(ctx context.Context, notification *domain.Notification) error {
+	params := sqlc.UpdateNotificationDeliveryParams{
+		ID:                notification.ID,
+		Status:            string(notification.Status),
+		Attempts:          int32(notification.Attempts),
+		NextAttemptAt:     toNullTimePtr(notification.NextAttemptAt),
+		LastError:         toNullStringPtr(notification.LastError),
+		SentDate:          toNullTimePtr(notification.SentDate),
+		ProviderMessageID: toNullStringPtr(notification.ProviderMessageID),
+		BouncedAt:         toNullTimePtr(notification.BouncedAt),
+		BounceReason:      toNullStringPtr(notification.BounceReason),
+		DeliveredAt:       toNullTimePtr(notification.DeliveredAt),
+		UpdatedAt:         notification.UpdatedAt,
+	}
+
+	if _, err := r.queries.UpdateNotificationDelivery(ctx, params); err != nil {
+		return fmt.Errorf("failed to update notification delivery: %w", err)
+	}
+
+	return nil
+}
+
+// toDomain converte sqlc.Notification para domain.Notification
+func (r *NotificationRepo) toDomain(row sqlc.Notification) *domain.Notification {
+	return &domain.Notification{
+		ID:                row.ID,
+		LeaseID:           fromNullUUIDPtr(row.LeaseID),
+		TenantID:          fromNullUUIDPtr(row.TenantID),
+		PaymentID:         fromNullUUIDPtr(row.PaymentID),
+		Type:              domain.NotificationType(row.Type),
+		Channel:           domain.NotificationChannel(row.Channel),
+		Recipient:         row.Recipient,
+		Subject:           row.Subject,
+		Message:           row.MessageContent,
+		HTMLMessage:       fromNullStringPtr(row.HtmlContent),
+		ScheduledDate:     row.ScheduledDate,
+		Status:            domain.NotificationStatus(row.Status),
+		Attempts:          int(row.Attempts),
+		NextAttemptAt:     fromNullTimePtr(row.NextAttemptAt),
+		LastError:         fromNullStringPtr(row.LastError),
+		SentDate:          fromNullTimePtr(row.SentDate),
+		DedupKey:          row.DedupKey,
+		ProviderMessageID: fromNullStringPtr(row.ProviderMessageID),
+		BouncedAt:         fromNullTimePtr(row.BouncedAt),
+		BounceReason:      fromNullStringPtr(row.BounceReason),
+		DeliveredAt:       fromNullTimePtr(row.DeliveredAt),
+		CreatedAt:         row.CreatedAt,
+		UpdatedAt:         row.UpdatedAt,
+	}
+}
+
+// toDomainList converte slice de sqlc.Notification para slice de domain.Notification
+func (r *NotificationRepo) toDomainList(rows []sqlc.Notification) []*domain.Notification {
+	notifications := make([]*domain.Notification, len(rows))
+	for i, row := range rows {
+		notifications[i] = r.toDomain(row)
+	}
+	return notifications
+}
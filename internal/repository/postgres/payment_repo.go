@@ -97,6 +97,16 @@ func (r *PaymentRepo) ListByStatus(ctx context.Context, status domain.PaymentSta
 	return r.toDomainList(rows), nil
 }
 
+// ListPaidSince retorna os pagamentos quitados a partir da data informada
+func (r *PaymentRepo) ListPaidSince(ctx context.Context, since time.Time) ([]*domain.Payment, error) {
+	rows, err := r.queries.ListPaymentsPaidSince(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payments paid since date: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
 // GetOverdue retorna pagamentos atrasados
 func (r *PaymentRepo) GetOverdue(ctx context.Context) ([]*domain.Payment, error) {
 	rows, err := r.queries.GetOverduePayments(ctx)
@@ -117,6 +127,52 @@ func (r *PaymentRepo) GetUpcoming(ctx context.Context, days int) ([]*domain.Paym
 	return r.toDomainList(rows), nil
 }
 
+// GetOverdueByPropertyID retorna pagamentos atrasados das unidades de um imóvel
+func (r *PaymentRepo) GetOverdueByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.Payment, error) {
+	rows, err := r.queries.GetOverduePaymentsByPropertyID(ctx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overdue payments by property: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// GetUpcomingByPropertyID retorna pagamentos a vencer nos próximos X dias de um imóvel
+func (r *PaymentRepo) GetUpcomingByPropertyID(ctx context.Context, propertyID uuid.UUID, days int) ([]*domain.Payment, error) {
+	rows, err := r.queries.GetUpcomingPaymentsByPropertyID(ctx, sqlc.GetUpcomingPaymentsByPropertyIDParams{
+		Days:       int32(days),
+		PropertyID: propertyID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upcoming payments by property: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// GetOverdueSettledSince retorna pagamentos vencidos antes da data informada e quitados a partir dela
+func (r *PaymentRepo) GetOverdueSettledSince(ctx context.Context, since time.Time) ([]*domain.Payment, error) {
+	rows, err := r.queries.GetOverdueSettledSince(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overdue payments settled since date: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// GetOverdueSettledSinceByPropertyID retorna pagamentos vencidos antes da data e quitados a partir dela de um imóvel
+func (r *PaymentRepo) GetOverdueSettledSinceByPropertyID(ctx context.Context, propertyID uuid.UUID, since time.Time) ([]*domain.Payment, error) {
+	rows, err := r.queries.GetOverdueSettledSinceByPropertyID(ctx, sqlc.GetOverdueSettledSinceByPropertyIDParams{
+		Since:      since,
+		PropertyID: propertyID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overdue payments settled since date by property: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
 // Update atualiza um pagamento existente
 func (r *PaymentRepo) Update(ctx context.Context, payment *domain.Payment) error {
 	params := sqlc.UpdatePaymentParams{
@@ -175,14 +231,14 @@ func (r *PaymentRepo) MarkAsPaid(ctx context.Context, id uuid.UUID, paymentDate
 	return nil
 }
 
-// MarkOverduePayments marca todos os pagamentos pendentes vencidos como atrasados
-func (r *PaymentRepo) MarkOverduePayments(ctx context.Context) error {
-	err := r.queries.MarkPaymentsAsOverdue(ctx)
+// MarkOverduePayments marca todos os pagamentos pendentes vencidos como atrasados e retorna os pagamentos alterados
+func (r *PaymentRepo) MarkOverduePayments(ctx context.Context) ([]*domain.Payment, error) {
+	rows, err := r.queries.MarkPaymentsAsOverdue(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to mark payments as overdue: %w", err)
+		return nil, fmt.Errorf("failed to mark payments as overdue: %w", err)
 	}
 
-	return nil
+	return r.toDomainList(rows), nil
 }
 
 // Cancel cancela um pagamento
@@ -301,6 +357,8 @@ func (r *PaymentRepo) toDomain(row sqlc.Payment) *domain.Payment {
 		Notes:          fromNullStringPtr(row.Notes),
 		CreatedAt:      row.CreatedAt,
 		UpdatedAt:      row.UpdatedAt,
+
+		DepositSettlementID: fromNullUUIDPtr(row.DepositSettlementID),
 	}
 }
 
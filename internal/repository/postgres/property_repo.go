@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+	"github.com/shopspring/decimal"
+)
+
+// Compile-time check to ensure PropertyRepo implements repository.PropertyRepository
+var _ repository.PropertyRepository = (*PropertyRepo)(nil)
+
+// PropertyRepo implementa o repository de imóveis usando SQLC
+type PropertyRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewPropertyRepo cria uma nova instância do repository de imóveis
+func NewPropertyRepo(db *sql.DB) *PropertyRepo {
+	return &PropertyRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// Create insere um novo imóvel no banco
+func (r *PropertyRepo) Create(ctx context.Context, property *domain.Property) error {
+	params := sqlc.CreatePropertyParams{
+		ID:                   property.ID,
+		Name:                 property.Name,
+		AddressStreet:        property.Address.Street,
+		AddressNumber:        property.Address.Number,
+		AddressComplement:    toNullStringPtr(property.Address.Complement),
+		AddressNeighborhood:  property.Address.Neighborhood,
+		AddressCity:          property.Address.City,
+		AddressState:         property.Address.State,
+		AddressZipCode:       property.Address.ZipCode,
+		BankName:             toNullStringPtr(property.BankAccount.BankName),
+		BankBranch:           toNullStringPtr(property.BankAccount.Branch),
+		BankAccount:          toNullStringPtr(property.BankAccount.Account),
+		PixKey:               toNullStringPtr(property.BankAccount.PixKey),
+		DefaultPaymentDueDay: toNullInt32Ptr(property.DefaultPaymentDueDay),
+		DefaultPaintingFee:   property.DefaultPaintingFee.String(),
+		Notes:                toNullStringPtr(property.Notes),
+		CreatedAt:            property.CreatedAt,
+		UpdatedAt:            property.UpdatedAt,
+	}
+
+	if _, err := r.queries.CreateProperty(ctx, params); err != nil {
+		return fmt.Errorf("failed to create property: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID busca um imóvel pelo ID
+func (r *PropertyRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.Property, error) {
+	row, err := r.queries.GetPropertyByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get property: %w", err)
+	}
+
+	return r.toDomain(row), nil
+}
+
+// GetByName busca um imóvel pelo nome
+func (r *PropertyRepo) GetByName(ctx context.Context, name string) (*domain.Property, error) {
+	row, err := r.queries.GetPropertyByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get property by name: %w", err)
+	}
+
+	return r.toDomain(row), nil
+}
+
+// List retorna todos os imóveis ordenados por nome
+func (r *PropertyRepo) List(ctx context.Context) ([]*domain.Property, error) {
+	rows, err := r.queries.ListProperties(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list properties: %w", err)
+	}
+
+	properties := make([]*domain.Property, len(rows))
+	for i, row := range rows {
+		properties[i] = r.toDomain(row)
+	}
+	return properties, nil
+}
+
+// Update atualiza um imóvel existente
+func (r *PropertyRepo) Update(ctx context.Context, property *domain.Property) error {
+	property.UpdatedAt = time.Now()
+
+	params := sqlc.UpdatePropertyParams{
+		ID:                   property.ID,
+		Name:                 property.Name,
+		AddressStreet:        property.Address.Street,
+		AddressNumber:        property.Address.Number,
+		AddressComplement:    toNullStringPtr(property.Address.Complement),
+		AddressNeighborhood:  property.Address.Neighborhood,
+		AddressCity:          property.Address.City,
+		AddressState:         property.Address.State,
+		AddressZipCode:       property.Address.ZipCode,
+		BankName:             toNullStringPtr(property.BankAccount.BankName),
+		BankBranch:           toNullStringPtr(property.BankAccount.Branch),
+		BankAccount:          toNullStringPtr(property.BankAccount.Account),
+		PixKey:               toNullStringPtr(property.BankAccount.PixKey),
+		DefaultPaymentDueDay: toNullInt32Ptr(property.DefaultPaymentDueDay),
+		DefaultPaintingFee:   property.DefaultPaintingFee.String(),
+		Notes:                toNullStringPtr(property.Notes),
+		UpdatedAt:            property.UpdatedAt,
+	}
+
+	if _, err := r.queries.UpdateProperty(ctx, params); err != nil {
+		return fmt.Errorf("failed to update property: %w", err)
+	}
+
+	return nil
+}
+
+// Delete remove um imóvel
+func (r *PropertyRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteProperty(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete property: %w", err)
+	}
+	return nil
+}
+
+// CountUnits retorna quantas unidades pertencem ao imóvel
+func (r *PropertyRepo) CountUnits(ctx context.Context, propertyID uuid.UUID) (int64, error) {
+	count, err := r.queries.CountUnitsByPropertyID(ctx, propertyID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count units by property: %w", err)
+	}
+	return count, nil
+}
+
+// toDomain converte sqlc.Property para domain.Property
+func (r *PropertyRepo) toDomain(row sqlc.Property) *domain.Property {
+	paintingFee, _ := decimal.NewFromString(row.DefaultPaintingFee)
+
+	return &domain.Property{
+		ID:   row.ID,
+		Name: row.Name,
+		Address: domain.PropertyAddress{
+			Street:       row.AddressStreet,
+			Number:       row.AddressNumber,
+			Complement:   fromNullStringPtr(row.AddressComplement),
+			Neighborhood: row.AddressNeighborhood,
+			City:         row.AddressCity,
+			State:        row.AddressState,
+			ZipCode:      row.AddressZipCode,
+		},
+		BankAccount: domain.PropertyBankAccount{
+			BankName: fromNullStringPtr(row.BankName),
+			Branch:   fromNullStringPtr(row.BankBranch),
+			Account:  fromNullStringPtr(row.BankAccount),
+			PixKey:   fromNullStringPtr(row.PixKey),
+		},
+		DefaultPaymentDueDay: fromNullInt32Ptr(row.DefaultPaymentDueDay),
+		DefaultPaintingFee:   paintingFee,
+		Notes:                fromNullStringPtr(row.Notes),
+		CreatedAt:            row.CreatedAt,
+		UpdatedAt:            row.UpdatedAt,
+	}
+}
@@ -0,0 +1,277 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+)
+
+// Compile-time check to ensure ProspectRepo implements repository.ProspectRepository
+var _ repository.ProspectRepository = (*ProspectRepo)(nil)
+
+// ProspectRepo implementa o repository de interessados e visitas usando SQLC
+type ProspectRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewProspectRepo cria uma nova instância do repository de interessados
+func NewProspectRepo(db *sql.DB) *ProspectRepo {
+	return &ProspectRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// Create insere um novo interessado no banco
+func (r *ProspectRepo) Create(ctx context.Context, prospect *domain.Prospect) error {
+	params := sqlc.CreateProspectParams{
+		ID:                prospect.ID,
+		FullName:          prospect.FullName,
+		Phone:             prospect.Phone,
+		Email:             toNullStringPtr(prospect.Email),
+		Cpf:               toNullStringPtr(prospect.CPF),
+		Source:            string(prospect.Source),
+		DesiredMoveInDate: toNullTimePtr(prospect.DesiredMoveInDate),
+		MaxBudget:         toNullDecimalPtr(prospect.MaxBudget),
+		PreferredFloor:    toNullInt32Ptr(prospect.PreferredFloor),
+		Status:            string(prospect.Status),
+		LostReason:        toNullStringPtr(prospect.LostReason),
+		Notes:             toNullStringPtr(prospect.Notes),
+		ConvertedTenantID: toNullUUIDPtr(prospect.ConvertedTenantID),
+		ConvertedAt:       toNullTimePtr(prospect.ConvertedAt),
+		CreatedBy:         toNullUUIDPtr(prospect.CreatedBy),
+		CreatedAt:         prospect.CreatedAt,
+		UpdatedAt:         prospect.UpdatedAt,
+	}
+
+	if _, err := r.queries.CreateProspect(ctx, params); err != nil {
+		return fmt.Errorf("failed to create prospect: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID busca um interessado pelo ID
+func (r *ProspectRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.Prospect, error) {
+	row, err := r.queries.GetProspectByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get prospect: %w", err)
+	}
+
+	return r.toDomain(row), nil
+}
+
+// List retorna todos os interessados, mais recentes primeiro
+func (r *ProspectRepo) List(ctx context.Context) ([]*domain.Prospect, error) {
+	rows, err := r.queries.ListProspects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list prospects: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// ListByConvertedTenantID retorna os interessados convertidos no morador informado
+func (r *ProspectRepo) ListByConvertedTenantID(ctx context.Context, tenantID uuid.UUID) ([]*domain.Prospect, error) {
+	rows, err := r.queries.ListProspectsByConvertedTenantID(ctx, uuid.NullUUID{UUID: tenantID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list prospects by converted tenant: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// ListByStatus retorna os interessados em determinada etapa do funil
+func (r *ProspectRepo) ListByStatus(ctx context.Context, status domain.ProspectStatus) ([]*domain.Prospect, error) {
+	rows, err := r.queries.ListProspectsByStatus(ctx, string(status))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list prospects by status: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// ListOpen retorna os interessados ainda no funil, pela data de mudança desejada
+func (r *ProspectRepo) ListOpen(ctx context.Context) ([]*domain.Prospect, error) {
+	rows, err := r.queries.ListOpenProspects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list open prospects: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// Update atualiza um interessado existente
+func (r *ProspectRepo) Update(ctx context.Context, prospect *domain.Prospect) error {
+	params := sqlc.UpdateProspectParams{
+		ID:                prospect.ID,
+		FullName:          prospect.FullName,
+		Phone:             prospect.Phone,
+		Email:             toNullStringPtr(prospect.Email),
+		Cpf:               toNullStringPtr(prospect.CPF),
+		Source:            string(prospect.Source),
+		DesiredMoveInDate: toNullTimePtr(prospect.DesiredMoveInDate),
+		MaxBudget:         toNullDecimalPtr(prospect.MaxBudget),
+		PreferredFloor:    toNullInt32Ptr(prospect.PreferredFloor),
+		Status:            string(prospect.Status),
+		LostReason:        toNullStringPtr(prospect.LostReason),
+		Notes:             toNullStringPtr(prospect.Notes),
+		ConvertedTenantID: toNullUUIDPtr(prospect.ConvertedTenantID),
+		ConvertedAt:       toNullTimePtr(prospect.ConvertedAt),
+		UpdatedAt:         prospect.UpdatedAt,
+	}
+
+	if _, err := r.queries.UpdateProspect(ctx, params); err != nil {
+		return fmt.Errorf("failed to update prospect: %w", err)
+	}
+
+	return nil
+}
+
+// Delete remove um interessado (as visitas são removidas em cascata)
+func (r *ProspectRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteProspect(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete prospect: %w", err)
+	}
+
+	return nil
+}
+
+// CreateVisit agenda uma visita
+func (r *ProspectRepo) CreateVisit(ctx context.Context, visit *domain.ProspectVisit) error {
+	params := sqlc.CreateProspectVisitParams{
+		ID:          visit.ID,
+		ProspectID:  visit.ProspectID,
+		UnitID:      toNullUUIDPtr(visit.UnitID),
+		ScheduledAt: visit.ScheduledAt,
+		Status:      string(visit.Status),
+		Notes:       toNullStringPtr(visit.Notes),
+		CreatedBy:   toNullUUIDPtr(visit.CreatedBy),
+		CreatedAt:   visit.CreatedAt,
+		UpdatedAt:   visit.UpdatedAt,
+	}
+
+	if _, err := r.queries.CreateProspectVisit(ctx, params); err != nil {
+		return fmt.Errorf("failed to create prospect visit: %w", err)
+	}
+
+	return nil
+}
+
+// GetVisitByID busca uma visita pelo ID
+func (r *ProspectRepo) GetVisitByID(ctx context.Context, id uuid.UUID) (*domain.ProspectVisit, error) {
+	row, err := r.queries.GetProspectVisitByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get prospect visit: %w", err)
+	}
+
+	return r.visitToDomain(row), nil
+}
+
+// ListVisits retorna as visitas de um interessado em ordem cronológica
+func (r *ProspectRepo) ListVisits(ctx context.Context, prospectID uuid.UUID) ([]*domain.ProspectVisit, error) {
+	rows, err := r.queries.ListProspectVisitsByProspectID(ctx, prospectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list prospect visits: %w", err)
+	}
+
+	return r.visitToDomainList(rows), nil
+}
+
+// ListUpcomingVisits retorna as visitas agendadas a partir da data informada
+func (r *ProspectRepo) ListUpcomingVisits(ctx context.Context, from time.Time) ([]*domain.ProspectVisit, error) {
+	rows, err := r.queries.ListUpcomingProspectVisits(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list upcoming prospect visits: %w", err)
+	}
+
+	return r.visitToDomainList(rows), nil
+}
+
+// UpdateVisit atualiza uma visita existente
+func (r *ProspectRepo) UpdateVisit(ctx context.Context, visit *domain.ProspectVisit) error {
+	params := sqlc.UpdateProspectVisitParams{
+		ID:          visit.ID,
+		UnitID:      toNullUUIDPtr(visit.UnitID),
+		ScheduledAt: visit.ScheduledAt,
+		Status:      string(visit.Status),
+		Notes:       toNullStringPtr(visit.Notes),
+		UpdatedAt:   visit.UpdatedAt,
+	}
+
+	if _, err := r.queries.UpdateProspectVisit(ctx, params); err != nil {
+		return fmt.Errorf("failed to update prospect visit: %w", err)
+	}
+
+	return nil
+}
+
+// toDomain converte sqlc.Prospect para domain.Prospect
+func (r *ProspectRepo) toDomain(row sqlc.Prospect) *domain.Prospect {
+	return &domain.Prospect{
+		ID:                row.ID,
+		FullName:          row.FullName,
+		Phone:             row.Phone,
+		Email:             fromNullStringPtr(row.Email),
+		CPF:               fromNullStringPtr(row.Cpf),
+		Source:            domain.ProspectSource(row.Source),
+		DesiredMoveInDate: fromNullTimePtr(row.DesiredMoveInDate),
+		MaxBudget:         fromNullDecimalPtr(row.MaxBudget),
+		PreferredFloor:    fromNullInt32Ptr(row.PreferredFloor),
+		Status:            domain.ProspectStatus(row.Status),
+		LostReason:        fromNullStringPtr(row.LostReason),
+		Notes:             fromNullStringPtr(row.Notes),
+		ConvertedTenantID: fromNullUUIDPtr(row.ConvertedTenantID),
+		ConvertedAt:       fromNullTimePtr(row.ConvertedAt),
+		CreatedBy:         fromNullUUIDPtr(row.CreatedBy),
+		CreatedAt:         row.CreatedAt,
+		UpdatedAt:         row.UpdatedAt,
+	}
+}
+
+// toDomainList converte []sqlc.Prospect para []*domain.Prospect
+func (r *ProspectRepo) toDomainList(rows []sqlc.Prospect) []*domain.Prospect {
+	prospects := make([]*domain.Prospect, len(rows))
+	for i, row := range rows {
+		prospects[i] = r.toDomain(row)
+	}
+	return prospects
+}
+
+// visitToDomain converte sqlc.ProspectVisit para domain.ProspectVisit
+func (r *ProspectRepo) visitToDomain(row sqlc.ProspectVisit) *domain.ProspectVisit {
+	return &domain.ProspectVisit{
+		ID:          row.ID,
+		ProspectID:  row.ProspectID,
+		UnitID:      fromNullUUIDPtr(row.UnitID),
+		ScheduledAt: row.ScheduledAt,
+		Status:      domain.ProspectVisitStatus(row.Status),
+		Notes:       fromNullStringPtr(row.Notes),
+		CreatedBy:   fromNullUUIDPtr(row.CreatedBy),
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+// visitToDomainList converte []sqlc.ProspectVisit para []*domain.ProspectVisit
+func (r *ProspectRepo) visitToDomainList(rows []sqlc.ProspectVisit) []*domain.ProspectVisit {
+	visits := make([]*domain.ProspectVisit, len(rows))
+	for i, row := range rows {
+		visits[i] = r.visitToDomain(row)
+	}
+	return visits
+}
@@ -0,0 +1,269 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+	"github.com/shopspring/decimal"
+)
+
+// Compile-time check to ensure RenovationProjectRepo implements repository.RenovationProjectRepository
+var _ repository.RenovationProjectRepository = (*RenovationProjectRepo)(nil)
+
+// RenovationProjectRepo implementa o repository de projetos de reforma usando SQLC
+type RenovationProjectRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewRenovationProjectRepo cria uma nova instância do repository de reformas
+func NewRenovationProjectRepo(db *sql.DB) *RenovationProjectRepo {
+	return &RenovationProjectRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// Create insere um novo projeto de reforma no banco
+func (r *RenovationProjectRepo) Create(ctx context.Context, project *domain.RenovationProject) error {
+	params := sqlc.CreateRenovationProjectParams{
+		ID:                 project.ID,
+		UnitID:             project.UnitID,
+		Title:              project.Title,
+		Description:        project.Description,
+		Status:             string(project.Status),
+		PlannedStartDate:   project.PlannedStartDate,
+		PlannedEndDate:     project.PlannedEndDate,
+		Budget:             project.Budget.String(),
+		PreviousUnitStatus: toNullUnitStatusPtr(project.PreviousUnitStatus),
+		StartedAt:          toNullTimePtr(project.StartedAt),
+		CompletedAt:        toNullTimePtr(project.CompletedAt),
+		CancelledAt:        toNullTimePtr(project.CancelledAt),
+		Notes:              toNullStringPtr(project.Notes),
+		CreatedBy:          toNullUUIDPtr(project.CreatedBy),
+		CreatedAt:          project.CreatedAt,
+		UpdatedAt:          project.UpdatedAt,
+	}
+
+	if _, err := r.queries.CreateRenovationProject(ctx, params); err != nil {
+		return fmt.Errorf("failed to create renovation project: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID busca um projeto de reforma pelo ID
+func (r *RenovationProjectRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.RenovationProject, error) {
+	row, err := r.queries.GetRenovationProjectByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get renovation project: %w", err)
+	}
+
+	return r.toDomain(row), nil
+}
+
+// List retorna todos os projetos de reforma
+func (r *RenovationProjectRepo) List(ctx context.Context) ([]*domain.RenovationProject, error) {
+	rows, err := r.queries.ListRenovationProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list renovation projects: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// ListByUnitID retorna os projetos de reforma de uma unidade
+func (r *RenovationProjectRepo) ListByUnitID(ctx context.Context, unitID uuid.UUID) ([]*domain.RenovationProject, error) {
+	rows, err := r.queries.ListRenovationProjectsByUnitID(ctx, unitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list renovation projects by unit: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// ListByPropertyID retorna os projetos de reforma das unidades de um imóvel
+func (r *RenovationProjectRepo) ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.RenovationProject, error) {
+	rows, err := r.queries.ListRenovationProjectsByPropertyID(ctx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list renovation projects by property: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// ListByStatus retorna os projetos de reforma com determinado status
+func (r *RenovationProjectRepo) ListByStatus(ctx context.Context, status domain.RenovationStatus) ([]*domain.RenovationProject, error) {
+	rows, err := r.queries.ListRenovationProjectsByStatus(ctx, string(status))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list renovation projects by status: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// Update atualiza um projeto de reforma existente
+func (r *RenovationProjectRepo) Update(ctx context.Context, project *domain.RenovationProject) error {
+	params := sqlc.UpdateRenovationProjectParams{
+		ID:                 project.ID,
+		Title:              project.Title,
+		Description:        project.Description,
+		Status:             string(project.Status),
+		PlannedStartDate:   project.PlannedStartDate,
+		PlannedEndDate:     project.PlannedEndDate,
+		Budget:             project.Budget.String(),
+		PreviousUnitStatus: toNullUnitStatusPtr(project.PreviousUnitStatus),
+		StartedAt:          toNullTimePtr(project.StartedAt),
+		CompletedAt:        toNullTimePtr(project.CompletedAt),
+		CancelledAt:        toNullTimePtr(project.CancelledAt),
+		Notes:              toNullStringPtr(project.Notes),
+		UpdatedAt:          project.UpdatedAt,
+	}
+
+	if _, err := r.queries.UpdateRenovationProject(ctx, params); err != nil {
+		return fmt.Errorf("failed to update renovation project: %w", err)
+	}
+
+	return nil
+}
+
+// CountActiveByUnitID conta os projetos planejados ou em andamento de uma unidade
+func (r *RenovationProjectRepo) CountActiveByUnitID(ctx context.Context, unitID uuid.UUID) (int64, error) {
+	count, err := r.queries.CountActiveRenovationProjectsByUnitID(ctx, unitID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count active renovation projects: %w", err)
+	}
+	return count, nil
+}
+
+// AddExpense lança uma despesa em um projeto de reforma
+func (r *RenovationProjectRepo) AddExpense(ctx context.Context, expense *domain.RenovationExpense) error {
+	params := sqlc.CreateRenovationExpenseParams{
+		ID:          expense.ID,
+		ProjectID:   expense.ProjectID,
+		Description: expense.Description,
+		Category:    string(expense.Category),
+		Amount:      expense.Amount.String(),
+		ExpenseDate: expense.ExpenseDate,
+		Supplier:    toNullStringPtr(expense.Supplier),
+		ReceiptUrl:  toNullStringPtr(expense.ReceiptURL),
+		CreatedBy:   toNullUUIDPtr(expense.CreatedBy),
+		CreatedAt:   expense.CreatedAt,
+	}
+
+	if _, err := r.queries.CreateRenovationExpense(ctx, params); err != nil {
+		return fmt.Errorf("failed to add renovation expense: %w", err)
+	}
+
+	return nil
+}
+
+// GetExpenseByID busca uma despesa pelo ID
+func (r *RenovationProjectRepo) GetExpenseByID(ctx context.Context, id uuid.UUID) (*domain.RenovationExpense, error) {
+	row, err := r.queries.GetRenovationExpenseByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get renovation expense: %w", err)
+	}
+
+	return r.expenseToDomain(row), nil
+}
+
+// ListExpenses retorna as despesas de um projeto de reforma
+func (r *RenovationProjectRepo) ListExpenses(ctx context.Context, projectID uuid.UUID) ([]*domain.RenovationExpense, error) {
+	rows, err := r.queries.ListRenovationExpensesByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list renovation expenses: %w", err)
+	}
+
+	expenses := make([]*domain.RenovationExpense, len(rows))
+	for i, row := range rows {
+		expenses[i] = r.expenseToDomain(row)
+	}
+
+	return expenses, nil
+}
+
+// DeleteExpense remove uma despesa
+func (r *RenovationProjectRepo) DeleteExpense(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteRenovationExpense(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete renovation expense: %w", err)
+	}
+	return nil
+}
+
+// GetTotalExpenses retorna a soma das despesas de um projeto
+func (r *RenovationProjectRepo) GetTotalExpenses(ctx context.Context, projectID uuid.UUID) (decimal.Decimal, error) {
+	total, err := r.queries.GetTotalRenovationExpensesByProjectID(ctx, projectID)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to get total renovation expenses: %w", err)
+	}
+
+	result, err := decimal.NewFromString(total)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to parse total: %w", err)
+	}
+	return result, nil
+}
+
+// toDomain converte sqlc.RenovationProject para domain.RenovationProject
+func (r *RenovationProjectRepo) toDomain(row sqlc.RenovationProject) *domain.RenovationProject {
+	budget, _ := decimal.NewFromString(row.Budget)
+
+	return &domain.RenovationProject{
+		ID:                 row.ID,
+		UnitID:             row.UnitID,
+		Title:              row.Title,
+		Description:        row.Description,
+		Status:             domain.RenovationStatus(row.Status),
+		PlannedStartDate:   row.PlannedStartDate,
+		PlannedEndDate:     row.PlannedEndDate,
+		Budget:             budget,
+		PreviousUnitStatus: fromNullUnitStatusPtr(row.PreviousUnitStatus),
+		StartedAt:          fromNullTimePtr(row.StartedAt),
+		CompletedAt:        fromNullTimePtr(row.CompletedAt),
+		CancelledAt:        fromNullTimePtr(row.CancelledAt),
+		Notes:              fromNullStringPtr(row.Notes),
+		CreatedBy:          fromNullUUIDPtr(row.CreatedBy),
+		CreatedAt:          row.CreatedAt,
+		UpdatedAt:          row.UpdatedAt,
+	}
+}
+
+// toDomainList converte []sqlc.RenovationProject para []*domain.RenovationProject
+func (r *RenovationProjectRepo) toDomainList(rows []sqlc.RenovationProject) []*domain.RenovationProject {
+	projects := make([]*domain.RenovationProject, len(rows))
+	for i, row := range rows {
+		projects[i] = r.toDomain(row)
+	}
+	return projects
+}
+
+// expenseToDomain converte sqlc.RenovationExpense para domain.RenovationExpense
+func (r *RenovationProjectRepo) expenseToDomain(row sqlc.RenovationExpense) *domain.RenovationExpense {
+	amount, _ := decimal.NewFromString(row.Amount)
+
+	return &domain.RenovationExpense{
+		ID:          row.ID,
+		ProjectID:   row.ProjectID,
+		Description: row.Description,
+		Category:    domain.RenovationExpenseCategory(row.Category),
+		Amount:      amount,
+		ExpenseDate: row.ExpenseDate,
+		Supplier:    fromNullStringPtr(row.Supplier),
+		ReceiptURL:  fromNullStringPtr(row.ReceiptUrl),
+		CreatedBy:   fromNullUUIDPtr(row.CreatedBy),
+		CreatedAt:   row.CreatedAt,
+	}
+}
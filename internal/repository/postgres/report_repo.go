@@ -0,0 +1,211 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+	"github.com/shopspring/decimal"
+)
+
+// Compile-time check to ensure ReportRepo implements repository.ReportRepository
+var _ repository.ReportRepository = (*ReportRepo)(nil)
+
+// ReportRepo implementa as consultas agregadas dos relatórios usando SQLC
+type ReportRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewReportRepo cria uma nova instância do repository de relatórios
+func NewReportRepo(db *sql.DB) *ReportRepo {
+	return &ReportRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// GetFinancialTotals soma os pagamentos do período por status, com a caução recebida à parte
+func (r *ReportRepo) GetFinancialTotals(ctx context.Context, filter repository.FinancialReportFilter) (*repository.FinancialTotals, error) {
+	row, err := r.queries.GetFinancialReportTotals(ctx, financialReportParams(filter))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get financial report totals: %w", err)
+	}
+
+	return &repository.FinancialTotals{
+		PaymentCount:          row.PaymentCount,
+		TotalAmount:           parseAmount(row.TotalAmount),
+		PaidAmount:            parseAmount(row.PaidAmount),
+		PendingAmount:         parseAmount(row.PendingAmount),
+		OverdueAmount:         parseAmount(row.OverdueAmount),
+		CancelledAmount:       parseAmount(row.CancelledAmount),
+		SecurityDepositAmount: parseAmount(row.SecurityDepositAmount),
+	}, nil
+}
+
+// GetRevenueByType agrupa os pagamentos do período por tipo
+func (r *ReportRepo) GetRevenueByType(ctx context.Context, filter repository.FinancialReportFilter) ([]*repository.RevenueByType, error) {
+	rows, err := r.queries.GetFinancialReportByType(ctx, sqlc.GetFinancialReportByTypeParams(financialReportParams(filter)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revenue by type: %w", err)
+	}
+
+	result := make([]*repository.RevenueByType, len(rows))
+	for i, row := range rows {
+		result[i] = &repository.RevenueByType{
+			PaymentType:  domain.PaymentType(row.PaymentType),
+			PaymentCount: row.PaymentCount,
+			Amount:       parseAmount(row.TotalAmount),
+		}
+	}
+	return result, nil
+}
+
+// GetRevenueByMonth agrupa os pagamentos do período por mês
+func (r *ReportRepo) GetRevenueByMonth(ctx context.Context, filter repository.FinancialReportFilter) ([]*repository.RevenueByMonth, error) {
+	rows, err := r.queries.GetFinancialReportByMonth(ctx, sqlc.GetFinancialReportByMonthParams(financialReportParams(filter)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revenue by month: %w", err)
+	}
+
+	result := make([]*repository.RevenueByMonth, len(rows))
+	for i, row := range rows {
+		result[i] = &repository.RevenueByMonth{
+			Month:        row.Month,
+			PaymentCount: row.PaymentCount,
+			Amount:       parseAmount(row.TotalAmount),
+		}
+	}
+	return result, nil
+}
+
+// GetRevenueByUnit agrupa os pagamentos do período por unidade
+func (r *ReportRepo) GetRevenueByUnit(ctx context.Context, filter repository.FinancialReportFilter) ([]*repository.RevenueByUnit, error) {
+	rows, err := r.queries.GetFinancialReportByUnit(ctx, sqlc.GetFinancialReportByUnitParams(financialReportParams(filter)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revenue by unit: %w", err)
+	}
+
+	result := make([]*repository.RevenueByUnit, len(rows))
+	for i, row := range rows {
+		result[i] = &repository.RevenueByUnit{
+			UnitID:       row.UnitID,
+			UnitNumber:   row.UnitNumber,
+			PaymentCount: row.PaymentCount,
+			Amount:       parseAmount(row.TotalAmount),
+		}
+	}
+	return result, nil
+}
+
+// GetRevenueByProperty agrupa os pagamentos do período por imóvel
+func (r *ReportRepo) GetRevenueByProperty(ctx context.Context, filter repository.FinancialReportFilter) ([]*repository.RevenueByProperty, error) {
+	rows, err := r.queries.GetFinancialReportByProperty(ctx, sqlc.GetFinancialReportByPropertyParams(financialReportParams(filter)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get revenue by property: %w", err)
+	}
+
+	result := make([]*repository.RevenueByProperty, len(rows))
+	for i, row := range rows {
+		result[i] = &repository.RevenueByProperty{
+			PropertyID:   row.PropertyID,
+			PropertyName: row.PropertyName,
+			PaymentCount: row.PaymentCount,
+			Amount:       parseAmount(row.TotalAmount),
+		}
+	}
+	return result, nil
+}
+
+// ListPaymentHistory retorna uma página do histórico de pagamentos
+func (r *ReportRepo) ListPaymentHistory(ctx context.Context, filter repository.PaymentHistoryFilter, limit, offset int) ([]*repository.PaymentHistoryRow, error) {
+	params := paymentHistoryParams(filter)
+	rows, err := r.queries.ListPaymentHistory(ctx, sqlc.ListPaymentHistoryParams{
+		LeaseID:      params.LeaseID,
+		TenantID:     params.TenantID,
+		PropertyID:   params.PropertyID,
+		Status:       params.Status,
+		StartDate:    params.StartDate,
+		EndDate:      params.EndDate,
+		ResultLimit:  int32(limit),
+		ResultOffset: int32(offset),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payment history: %w", err)
+	}
+
+	result := make([]*repository.PaymentHistoryRow, len(rows))
+	for i, row := range rows {
+		result[i] = &repository.PaymentHistoryRow{
+			PaymentID:     row.ID,
+			LeaseID:       row.LeaseID,
+			UnitNumber:    row.UnitNumber,
+			TenantName:    row.TenantName,
+			PaymentType:   domain.PaymentType(row.PaymentType),
+			Amount:        parseAmount(row.Amount),
+			Status:        domain.PaymentStatus(row.Status),
+			DueDate:       row.DueDate,
+			PaymentDate:   fromNullTimePtr(row.PaymentDate),
+			PaymentMethod: stringToPaymentMethodPtr(fromNullStringPtr(row.PaymentMethod)),
+			CreatedAt:     row.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+// GetPaymentHistoryTotals retorna a quantidade e a soma dos pagamentos do histórico
+func (r *ReportRepo) GetPaymentHistoryTotals(ctx context.Context, filter repository.PaymentHistoryFilter) (*repository.PaymentHistoryTotals, error) {
+	row, err := r.queries.GetPaymentHistoryTotals(ctx, paymentHistoryParams(filter))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payment history totals: %w", err)
+	}
+
+	return &repository.PaymentHistoryTotals{
+		PaymentCount: row.PaymentCount,
+		Amount:       parseAmount(row.TotalAmount),
+	}, nil
+}
+
+// financialReportParams converte os filtros do relatório financeiro; as consultas agregadas
+// compartilham os mesmos parâmetros
+func financialReportParams(filter repository.FinancialReportFilter) sqlc.GetFinancialReportTotalsParams {
+	params := sqlc.GetFinancialReportTotalsParams{
+		StartDate:  filter.StartDate,
+		EndDate:    filter.EndDate,
+		PropertyID: toNullUUIDPtr(filter.PropertyID),
+	}
+	if filter.PaymentType != nil {
+		params.PaymentType = sql.NullString{String: string(*filter.PaymentType), Valid: true}
+	}
+	if filter.Status != nil {
+		params.Status = sql.NullString{String: string(*filter.Status), Valid: true}
+	}
+	return params
+}
+
+// paymentHistoryParams converte os filtros do histórico de pagamentos
+func paymentHistoryParams(filter repository.PaymentHistoryFilter) sqlc.GetPaymentHistoryTotalsParams {
+	params := sqlc.GetPaymentHistoryTotalsParams{
+		LeaseID:    toNullUUIDPtr(filter.LeaseID),
+		TenantID:   toNullUUIDPtr(filter.TenantID),
+		PropertyID: toNullUUIDPtr(filter.PropertyID),
+		StartDate:  toNullTimePtr(filter.StartDate),
+		EndDate:    toNullTimePtr(filter.EndDate),
+	}
+	if filter.Status != nil {
+		params.Status = sql.NullString{String: string(*filter.Status), Valid: true}
+	}
+	return params
+}
+
+// parseAmount converte os valores agregados retornados como texto
+func parseAmount(value string) decimal.Decimal {
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero
+	}
+	return amount
+}
@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createTestLease grava imóvel, unidade, morador e contrato para os testes de relatório
+func createTestLease(t *testing.T, db *sql.DB) *domain.Lease {
+	t.Helper()
+	ctx := context.Background()
+
+	property, err := domain.NewProperty("Edifício Aurora", domain.PropertyAddress{
+		Street:       "Rua das Flores",
+		Number:       "100",
+		Neighborhood: "Centro",
+		City:         "São Paulo",
+		State:        "SP",
+		ZipCode:      "01000-000",
+	})
+	require.NoError(t, err)
+	require.NoError(t, NewPropertyRepo(db).Create(ctx, property))
+
+	unit, err := domain.NewUnit("101", 1, decimal.NewFromInt(800), decimal.NewFromInt(900))
+	require.NoError(t, err)
+	unit.PropertyID = property.ID
+	require.NoError(t, NewUnitRepository(db).Create(ctx, unit))
+
+	tenant, err := domain.NewTenant("João da Silva", "123.456.789-00", "(11) 98765-4321", "joao@example.com")
+	require.NoError(t, err)
+	require.NoError(t, NewTenantRepository(db).Create(ctx, tenant))
+
+	now := time.Now()
+	lease, err := domain.NewLease(unit.ID, tenant.ID, now, now, 10, decimal.NewFromInt(800), decimal.Zero, 1)
+	require.NoError(t, err)
+	require.NoError(t, NewLeaseRepo(db).Create(ctx, lease))
+
+	return lease
+}
+
+func TestReportRepo_FinancialTotalsExcludeSecurityDeposit(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	lease := createTestLease(t, db)
+	paymentRepo := NewPaymentRepo(db)
+
+	today := time.Now().Truncate(24 * time.Hour)
+	for _, p := range []struct {
+		paymentType domain.PaymentType
+		amount      int64
+	}{
+		{domain.PaymentTypeRent, 800},
+		{domain.PaymentTypeSecurityDeposit, 1600},
+	} {
+		payment := &domain.Payment{
+			ID:             uuid.New(),
+			LeaseID:        lease.ID,
+			PaymentType:    p.paymentType,
+			ReferenceMonth: today,
+			Amount:         decimal.NewFromInt(p.amount),
+			Status:         domain.PaymentStatusPending,
+			DueDate:        today,
+			CreatedAt:      time.Now(),
+			UpdatedAt:      time.Now(),
+		}
+		require.NoError(t, paymentRepo.Create(ctx, payment))
+		require.NoError(t, paymentRepo.MarkAsPaid(ctx, payment.ID, today, domain.PaymentMethodPix))
+	}
+
+	reportRepo := NewReportRepo(db)
+	filter := repository.FinancialReportFilter{StartDate: today.AddDate(0, 0, -1), EndDate: today.AddDate(0, 0, 1)}
+
+	totals, err := reportRepo.GetFinancialTotals(ctx, filter)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), totals.PaymentCount)
+	assert.True(t, decimal.NewFromInt(800).Equal(totals.TotalAmount))
+	assert.True(t, decimal.NewFromInt(800).Equal(totals.PaidAmount))
+	assert.True(t, decimal.NewFromInt(1600).Equal(totals.SecurityDepositAmount))
+
+	byType, err := reportRepo.GetRevenueByType(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, byType, 1)
+	assert.Equal(t, domain.PaymentTypeRent, byType[0].PaymentType)
+
+	byUnit, err := reportRepo.GetRevenueByUnit(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, byUnit, 1)
+	assert.True(t, decimal.NewFromInt(800).Equal(byUnit[0].Amount))
+}
@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+	"github.com/shopspring/decimal"
+)
+
+// Compile-time check to ensure SearchRepo implements repository.SearchRepository
+var _ repository.SearchRepository = (*SearchRepo)(nil)
+
+// searchSeparator separa as partes de títulos e subtítulos dos resultados
+const searchSeparator = " · "
+
+// SearchRepo implementa a busca global usando os índices full-text e trigram do PostgreSQL
+type SearchRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewSearchRepo cria uma nova instância do repository de busca
+func NewSearchRepo(db *sql.DB) *SearchRepo {
+	return &SearchRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// SearchTenants busca moradores por nome, e-mail, ou fragmento de CPF e telefone
+func (r *SearchRepo) SearchTenants(ctx context.Context, query, digits string, limit int) ([]*domain.SearchResult, error) {
+	rows, err := r.queries.SearchTenants(ctx, sqlc.SearchTenantsParams{
+		Query:       query,
+		Digits:      digits,
+		ResultLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search tenants: %w", err)
+	}
+
+	results := make([]*domain.SearchResult, len(rows))
+	for i, row := range rows {
+		subtitle := []string{row.Cpf, row.Phone}
+		if row.Email.Valid {
+			subtitle = append(subtitle, row.Email.String)
+		}
+
+		results[i] = &domain.SearchResult{
+			Type:     domain.SearchResultTenant,
+			ID:       row.ID,
+			Title:    row.FullName,
+			Subtitle: strings.Join(subtitle, searchSeparator),
+			Rank:     row.Rank,
+		}
+	}
+
+	return results, nil
+}
+
+// SearchUnits busca unidades pelo número
+func (r *SearchRepo) SearchUnits(ctx context.Context, query string, limit int) ([]*domain.SearchResult, error) {
+	rows, err := r.queries.SearchUnits(ctx, sqlc.SearchUnitsParams{
+		Query:       query,
+		ResultLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search units: %w", err)
+	}
+
+	results := make([]*domain.SearchResult, len(rows))
+	for i, row := range rows {
+		results[i] = &domain.SearchResult{
+			Type:     domain.SearchResultUnit,
+			ID:       row.ID,
+			Title:    row.Number,
+			Subtitle: row.PropertyName,
+			Status:   string(row.Status),
+			Rank:     row.Rank,
+		}
+	}
+
+	return results, nil
+}
+
+// SearchLeases busca contratos pelo nome do morador ou número da unidade
+func (r *SearchRepo) SearchLeases(ctx context.Context, query string, limit int) ([]*domain.SearchResult, error) {
+	rows, err := r.queries.SearchLeases(ctx, sqlc.SearchLeasesParams{
+		Query:       query,
+		ResultLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search leases: %w", err)
+	}
+
+	results := make([]*domain.SearchResult, len(rows))
+	for i, row := range rows {
+		results[i] = &domain.SearchResult{
+			Type:     domain.SearchResultLease,
+			ID:       row.ID,
+			Title:    row.TenantName + searchSeparator + row.UnitNumber,
+			Subtitle: row.StartDate.Format("2006-01-02") + " - " + row.EndDate.Format("2006-01-02"),
+			Status:   row.Status,
+			Rank:     row.Rank,
+		}
+	}
+
+	return results, nil
+}
+
+// SearchPaymentsByAmount busca pagamentos com o valor exato informado
+func (r *SearchRepo) SearchPaymentsByAmount(ctx context.Context, amount decimal.Decimal, limit int) ([]*domain.SearchResult, error) {
+	rows, err := r.queries.SearchPaymentsByAmount(ctx, sqlc.SearchPaymentsByAmountParams{
+		Amount:      amount.String(),
+		ResultLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search payments by amount: %w", err)
+	}
+
+	results := make([]*domain.SearchResult, len(rows))
+	for i, row := range rows {
+		results[i] = paymentSearchResult(row.ID, row.TenantName, row.UnitNumber, row.PaymentType, row.ReferenceMonth, row.Amount, row.Status)
+	}
+
+	return results, nil
+}
+
+// SearchPaymentsByReferenceMonth busca pagamentos do mês de referência informado
+func (r *SearchRepo) SearchPaymentsByReferenceMonth(ctx context.Context, referenceMonth time.Time, limit int) ([]*domain.SearchResult, error) {
+	rows, err := r.queries.SearchPaymentsByReferenceMonth(ctx, sqlc.SearchPaymentsByReferenceMonthParams{
+		ReferenceMonth: referenceMonth,
+		ResultLimit:    int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search payments by reference month: %w", err)
+	}
+
+	results := make([]*domain.SearchResult, len(rows))
+	for i, row := range rows {
+		results[i] = paymentSearchResult(row.ID, row.TenantName, row.UnitNumber, row.PaymentType, row.ReferenceMonth, row.Amount, row.Status)
+	}
+
+	return results, nil
+}
+
+// paymentSearchResult monta o resultado de um pagamento encontrado
+// Pagamentos só são encontrados por valor ou mês exatos, por isso o rank é sempre máximo
+func paymentSearchResult(id uuid.UUID, tenantName, unitNumber, paymentType string, referenceMonth time.Time, rawAmount, status string) *domain.SearchResult {
+	amount, _ := decimal.NewFromString(rawAmount)
+
+	return &domain.SearchResult{
+		Type:     domain.SearchResultPayment,
+		ID:       id,
+		Title:    tenantName + searchSeparator + unitNumber,
+		Subtitle: strings.Join([]string{paymentType, referenceMonth.Format("2006-01"), amount.StringFixed(2)}, searchSeparator),
+		Status:   status,
+		Rank:     1,
+	}
+}
@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+)
+
+// Compile-time check to ensure TenantDocumentRepo implements repository.TenantDocumentRepository
+var _ repository.TenantDocumentRepository = (*TenantDocumentRepo)(nil)
+
+// TenantDocumentRepo implementa o repository de documentos dos moradores usando SQLC
+type TenantDocumentRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewTenantDocumentRepo cria uma nova instância do repository de documentos
+func NewTenantDocumentRepo(db *sql.DB) *TenantDocumentRepo {
+	return &TenantDocumentRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// Create insere o registro de um documento
+func (r *TenantDocumentRepo) Create(ctx context.Context, doc *domain.TenantDocument) error {
+	params := sqlc.CreateTenantDocumentParams{
+		ID:           doc.ID,
+		TenantID:     doc.TenantID,
+		LeaseID:      toNullUUIDPtr(doc.LeaseID),
+		DocumentType: string(doc.Kind),
+		ExpiresAt:    toNullTimePtr(doc.ExpiresAt),
+		FileName:     doc.FileName,
+		ContentType:  doc.ContentType,
+		SizeBytes:    doc.SizeBytes,
+		Checksum:     doc.Checksum,
+		StorageKey:   doc.StorageKey,
+		UploadedBy:   toNullUUIDPtr(doc.UploadedBy),
+		CreatedAt:    doc.CreatedAt,
+	}
+
+	if _, err := r.queries.CreateTenantDocument(ctx, params); err != nil {
+		return fmt.Errorf("failed to create tenant document: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID busca um documento pelo ID
+func (r *TenantDocumentRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.TenantDocument, error) {
+	row, err := r.queries.GetTenantDocumentByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get tenant document: %w", err)
+	}
+
+	return r.toDomain(row), nil
+}
+
+// ListByTenantID retorna os documentos de um morador, do mais recente ao mais antigo
+func (r *TenantDocumentRepo) ListByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantDocument, error) {
+	rows, err := r.queries.ListTenantDocumentsByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenant documents: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// ListByLeaseID retorna os documentos vinculados a um contrato
+func (r *TenantDocumentRepo) ListByLeaseID(ctx context.Context, leaseID uuid.UUID) ([]*domain.TenantDocument, error) {
+	rows, err := r.queries.ListTenantDocumentsByLeaseID(ctx, uuid.NullUUID{UUID: leaseID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list lease documents: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// Delete remove o registro de um documento
+func (r *TenantDocumentRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteTenantDocument(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete tenant document: %w", err)
+	}
+
+	return nil
+}
+
+// toDomainList converte uma lista de modelos SQLC para o domínio
+func (r *TenantDocumentRepo) toDomainList(rows []sqlc.TenantDocument) []*domain.TenantDocument {
+	docs := make([]*domain.TenantDocument, len(rows))
+	for i, row := range rows {
+		docs[i] = r.toDomain(row)
+	}
+	return docs
+}
+
+// toDomain converte o modelo SQLC para o domínio
+func (r *TenantDocumentRepo) toDomain(row sqlc.TenantDocument) *domain.TenantDocument {
+	return &domain.TenantDocument{
+		ID:          row.ID,
+		TenantID:    row.TenantID,
+		LeaseID:     fromNullUUIDPtr(row.LeaseID),
+		Kind:        domain.TenantDocumentKind(row.DocumentType),
+		ExpiresAt:   fromNullTimePtr(row.ExpiresAt),
+		FileName:    row.FileName,
+		ContentType: row.ContentType,
+		SizeBytes:   row.SizeBytes,
+		Checksum:    row.Checksum,
+		StorageKey:  row.StorageKey,
+		UploadedBy:  fromNullUUIDPtr(row.UploadedBy),
+		CreatedAt:   row.CreatedAt,
+	}
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+)
+
+// Compile-time check to ensure TenantLoginCodeRepo implements repository.TenantLoginCodeRepository
+var _ repository.TenantLoginCodeRepository = (*TenantLoginCodeRepo)(nil)
+
+// TenantLoginCodeRepo implementa o repository de códigos de acesso ao portal usando SQLC
+type TenantLoginCodeRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewTenantLoginCodeRepo cria uma nova instância do repository de códigos de acesso
+func NewTenantLoginCodeRepo(db *sql.DB) *TenantLoginCodeRepo {
+	return &TenantLoginCodeRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// Create insere um novo código de acesso
+func (r *TenantLoginCodeRepo) Create(ctx context.Context, code *domain.TenantLoginCode) error {
+	params := sqlc.CreateTenantLoginCodeParams{
+		ID:        code.ID,
+		UserID:    code.UserID,
+		CodeHash:  code.CodeHash,
+		Attempts:  int32(code.Attempts),
+		ExpiresAt: code.ExpiresAt,
+		CreatedAt: code.CreatedAt,
+	}
+
+	if _, err := r.queries.CreateTenantLoginCode(ctx, params); err != nil {
+		return fmt.Errorf("failed to create tenant login code: %w", err)
+	}
+
+	return nil
+}
+
+// GetActiveByUserID busca o código pendente mais recente do usuário
+func (r *TenantLoginCodeRepo) GetActiveByUserID(ctx context.Context, userID uuid.UUID) (*domain.TenantLoginCode, error) {
+	row, err := r.queries.GetActiveTenantLoginCode(ctx, sqlc.GetActiveTenantLoginCodeParams{
+		UserID:    userID,
+		ExpiresAt: time.Now(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get tenant login code: %w", err)
+	}
+
+	return &domain.TenantLoginCode{
+		ID:        row.ID,
+		UserID:    row.UserID,
+		CodeHash:  row.CodeHash,
+		Attempts:  int(row.Attempts),
+		ExpiresAt: row.ExpiresAt,
+		UsedAt:    fromNullTimePtr(row.UsedAt),
+		CreatedAt: row.CreatedAt,
+	}, nil
+}
+
+// Update persiste as tentativas e a data de uso do código
+func (r *TenantLoginCodeRepo) Update(ctx context.Context, code *domain.TenantLoginCode) error {
+	err := r.queries.UpdateTenantLoginCode(ctx, sqlc.UpdateTenantLoginCodeParams{
+		ID:       code.ID,
+		Attempts: int32(code.Attempts),
+		UsedAt:   toNullTimePtr(code.UsedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update tenant login code: %w", err)
+	}
+
+	return nil
+}
+
+// InvalidateByUserID encerra os códigos pendentes do usuário
+func (r *TenantLoginCodeRepo) InvalidateByUserID(ctx context.Context, userID uuid.UUID) error {
+	now := time.Now()
+	err := r.queries.InvalidateTenantLoginCodes(ctx, sqlc.InvalidateTenantLoginCodesParams{
+		UserID: userID,
+		UsedAt: toNullTimePtr(&now),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to invalidate tenant login codes: %w", err)
+	}
+
+	return nil
+}
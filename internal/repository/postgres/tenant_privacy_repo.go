@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+)
+
+// Compile-time check to ensure TenantPrivacyRepo implements repository.TenantPrivacyRepository
+var _ repository.TenantPrivacyRepository = (*TenantPrivacyRepo)(nil)
+
+// TenantPrivacyRepo implementa o repository de solicitações LGPD usando SQLC
+type TenantPrivacyRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewTenantPrivacyRepo cria uma nova instância do repository de solicitações LGPD
+func NewTenantPrivacyRepo(db *sql.DB) *TenantPrivacyRepo {
+	return &TenantPrivacyRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// Anonymize grava os dados anonimizados do morador e remove os dados pessoais associados
+// Contratos, pagamentos e chamados de manutenção são mantidos; as notificações perdem destinatário e conteúdo
+// e o cadastro de interessado que originou o morador perde os dados pessoais
+func (r *TenantPrivacyRepo) Anonymize(ctx context.Context, tenant *domain.Tenant, request *domain.TenantDataRequest) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	qtx := sqlc.New(tx)
+
+	if _, err := qtx.AnonymizeTenant(ctx, sqlc.AnonymizeTenantParams{
+		ID:           tenant.ID,
+		FullName:     tenant.FullName,
+		Cpf:          tenant.CPF,
+		Phone:        tenant.Phone,
+		AnonymizedAt: toNullTimePtr(tenant.AnonymizedAt),
+		AnonymizedBy: toNullUUIDPtr(tenant.AnonymizedBy),
+	}); err != nil {
+		return fmt.Errorf("failed to anonymize tenant: %w", err)
+	}
+
+	if err := qtx.DeleteAllTenantContacts(ctx, tenant.ID); err != nil {
+		return fmt.Errorf("failed to delete tenant contacts: %w", err)
+	}
+
+	if err := qtx.DeleteTenantOccupantsByTenantID(ctx, tenant.ID); err != nil {
+		return fmt.Errorf("failed to delete tenant occupants: %w", err)
+	}
+
+	if err := qtx.DeleteTenantPetsByTenantID(ctx, tenant.ID); err != nil {
+		return fmt.Errorf("failed to delete tenant pets: %w", err)
+	}
+
+	if err := qtx.DeleteTenantDocumentsByTenantID(ctx, tenant.ID); err != nil {
+		return fmt.Errorf("failed to delete tenant documents: %w", err)
+	}
+
+	if err := qtx.AnonymizeTenantNotifications(ctx, sqlc.AnonymizeTenantNotificationsParams{
+		Placeholder: domain.AnonymizedContent,
+		UpdatedAt:   *tenant.AnonymizedAt,
+		TenantID:    tenant.ID,
+	}); err != nil {
+		return fmt.Errorf("failed to anonymize tenant notifications: %w", err)
+	}
+
+	if err := qtx.AnonymizeConvertedProspects(ctx, sqlc.AnonymizeConvertedProspectsParams{
+		FullName:  tenant.FullName,
+		UpdatedAt: *tenant.AnonymizedAt,
+		TenantID:  tenant.ID,
+	}); err != nil {
+		return fmt.Errorf("failed to anonymize converted prospect: %w", err)
+	}
+
+	if err := qtx.ClearConvertedProspectVisitNotes(ctx, sqlc.ClearConvertedProspectVisitNotesParams{
+		UpdatedAt: *tenant.AnonymizedAt,
+		TenantID:  tenant.ID,
+	}); err != nil {
+		return fmt.Errorf("failed to clear converted prospect visit notes: %w", err)
+	}
+
+	// Remove o acesso ao portal (os códigos de login são removidos em cascata)
+	if err := qtx.DeleteUserByTenantID(ctx, uuid.NullUUID{UUID: tenant.ID, Valid: true}); err != nil {
+		return fmt.Errorf("failed to delete tenant portal user: %w", err)
+	}
+
+	if err := createTenantDataRequest(ctx, qtx, request); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CreateRequest registra uma solicitação do titular (ex: exportação)
+func (r *TenantPrivacyRepo) CreateRequest(ctx context.Context, request *domain.TenantDataRequest) error {
+	return createTenantDataRequest(ctx, r.queries, request)
+}
+
+// ListRequestsByTenantID retorna o histórico de solicitações do morador, mais recentes primeiro
+func (r *TenantPrivacyRepo) ListRequestsByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantDataRequest, error) {
+	rows, err := r.queries.ListTenantDataRequestsByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenant data requests: %w", err)
+	}
+
+	requests := make([]*domain.TenantDataRequest, len(rows))
+	for i, row := range rows {
+		requests[i] = &domain.TenantDataRequest{
+			ID:          row.ID,
+			TenantID:    row.TenantID,
+			Type:        domain.TenantDataRequestType(row.RequestType),
+			PerformedBy: fromNullUUIDPtr(row.PerformedBy),
+			PerformedAt: row.PerformedAt,
+			Notes:       fromNullStringPtr(row.Notes),
+		}
+	}
+
+	return requests, nil
+}
+
+// createTenantDataRequest insere o registro da solicitação usando as queries informadas
+func createTenantDataRequest(ctx context.Context, q *sqlc.Queries, request *domain.TenantDataRequest) error {
+	if _, err := q.CreateTenantDataRequest(ctx, sqlc.CreateTenantDataRequestParams{
+		ID:          request.ID,
+		TenantID:    request.TenantID,
+		RequestType: string(request.Type),
+		PerformedBy: toNullUUIDPtr(request.PerformedBy),
+		PerformedAt: request.PerformedAt,
+		Notes:       toNullStringPtr(request.Notes),
+	}); err != nil {
+		return fmt.Errorf("failed to create tenant data request: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenantPrivacyRepo_Anonymize(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+	tenantRepo := NewTenantRepository(db)
+	privacyRepo := NewTenantPrivacyRepo(db)
+
+	tenant, err := domain.NewTenant("João da Silva", "123.456.789-00", "(11) 98765-4321", "joao@example.com")
+	require.NoError(t, err)
+	require.NoError(t, tenantRepo.Create(ctx, tenant))
+
+	notificationRepo := NewNotificationRepo(db)
+	notification, err := domain.NewNotification(domain.NotificationTypeRentReminder, domain.NotificationChannelEmail,
+		"joao@example.com", "Lembrete de aluguel - João da Silva", "Olá João, seu aluguel vence amanhã", time.Now(), "test:"+tenant.ID.String())
+	require.NoError(t, err)
+	notification.TenantID = &tenant.ID
+	_, err = notificationRepo.Create(ctx, notification)
+	require.NoError(t, err)
+
+	_, err = db.Exec(`INSERT INTO prospects (full_name, phone, email, cpf, source, status, notes, converted_tenant_id, converted_at)
+		VALUES ('João da Silva', '(11) 98765-4321', 'joao@example.com', '123.456.789-00', 'whatsapp', 'converted', 'Trabalha no centro', $1, NOW())`, tenant.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, tenant.Anonymize(nil))
+	request, err := domain.NewTenantDataRequest(tenant.ID, domain.TenantDataRequestAnonymization, nil, nil)
+	require.NoError(t, err)
+
+	// As restrições da tabela aceitam o morador sem telefone e com o identificador no lugar do CPF
+	require.NoError(t, privacyRepo.Anonymize(ctx, tenant, request))
+
+	stored, err := tenantRepo.GetByID(ctx, tenant.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.AnonymizedTenantName, stored.FullName)
+	assert.Equal(t, tenant.CPF, stored.CPF)
+	assert.Empty(t, stored.Phone)
+	assert.True(t, stored.IsAnonymized())
+
+	// Notificações perdem destinatário e conteúdo; as pendentes são canceladas
+	notifications, err := notificationRepo.ListByTenantID(ctx, tenant.ID)
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, domain.AnonymizedContent, notifications[0].Recipient)
+	assert.Equal(t, domain.AnonymizedContent, notifications[0].Subject)
+	assert.Equal(t, domain.AnonymizedContent, notifications[0].Message)
+	assert.Equal(t, domain.NotificationStatusCancelled, notifications[0].Status)
+
+	// O interessado que originou o morador perde os dados pessoais
+	prospects, err := NewProspectRepo(db).ListByConvertedTenantID(ctx, tenant.ID)
+	require.NoError(t, err)
+	require.Len(t, prospects, 1)
+	assert.Equal(t, domain.AnonymizedTenantName, prospects[0].FullName)
+	assert.Empty(t, prospects[0].Phone)
+	assert.Nil(t, prospects[0].Email)
+	assert.Nil(t, prospects[0].CPF)
+	assert.Nil(t, prospects[0].Notes)
+
+	requests, err := privacyRepo.ListRequestsByTenantID(ctx, tenant.ID)
+	require.NoError(t, err)
+	assert.Len(t, requests, 1)
+}
+
+func TestTenantPrivacyRepo_PhoneRequiredForActiveTenants(t *testing.T) {
+	db := openTestDB(t)
+
+	_, err := db.Exec(`INSERT INTO tenants (full_name, cpf, phone) VALUES ('Maria', '987.654.321-00', ' ')`)
+	assert.Error(t, err)
+}
@@ -0,0 +1,199 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+)
+
+// Compile-time check to ensure TenantProfileRepo implements repository.TenantProfileRepository
+var _ repository.TenantProfileRepository = (*TenantProfileRepo)(nil)
+
+// TenantProfileRepo implementa o repository de dados complementares do morador usando SQLC
+type TenantProfileRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewTenantProfileRepo cria uma nova instância do repository de dados complementares
+func NewTenantProfileRepo(db *sql.DB) *TenantProfileRepo {
+	return &TenantProfileRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// ListContacts retorna os contatos de emergência e referências do morador
+func (r *TenantProfileRepo) ListContacts(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantContact, error) {
+	rows, err := r.queries.ListTenantContactsByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenant contacts: %w", err)
+	}
+
+	contacts := make([]*domain.TenantContact, len(rows))
+	for i, row := range rows {
+		contacts[i] = &domain.TenantContact{
+			ID:           row.ID,
+			TenantID:     row.TenantID,
+			Type:         domain.TenantContactType(row.ContactType),
+			Name:         row.Name,
+			Relationship: row.Relationship,
+			Phone:        row.Phone,
+			Email:        fromNullStringPtr(row.Email),
+			Notes:        fromNullStringPtr(row.Notes),
+			CreatedAt:    row.CreatedAt,
+		}
+	}
+
+	return contacts, nil
+}
+
+// ReplaceContacts remove os contatos do tipo informado e insere a nova lista em uma transação
+func (r *TenantProfileRepo) ReplaceContacts(ctx context.Context, tenantID uuid.UUID, contactType domain.TenantContactType, contacts []*domain.TenantContact) error {
+	return r.withTx(ctx, func(qtx *sqlc.Queries) error {
+		if err := qtx.DeleteTenantContactsByType(ctx, sqlc.DeleteTenantContactsByTypeParams{
+			TenantID:    tenantID,
+			ContactType: string(contactType),
+		}); err != nil {
+			return fmt.Errorf("failed to delete tenant contacts: %w", err)
+		}
+
+		for _, contact := range contacts {
+			if _, err := qtx.CreateTenantContact(ctx, sqlc.CreateTenantContactParams{
+				ID:           contact.ID,
+				TenantID:     tenantID,
+				ContactType:  string(contact.Type),
+				Name:         contact.Name,
+				Relationship: contact.Relationship,
+				Phone:        contact.Phone,
+				Email:        toNullStringPtr(contact.Email),
+				Notes:        toNullStringPtr(contact.Notes),
+				CreatedAt:    contact.CreatedAt,
+			}); err != nil {
+				return fmt.Errorf("failed to create tenant contact: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// ListOccupants retorna os ocupantes da unidade cadastrados pelo morador
+func (r *TenantProfileRepo) ListOccupants(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantOccupant, error) {
+	rows, err := r.queries.ListTenantOccupantsByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenant occupants: %w", err)
+	}
+
+	occupants := make([]*domain.TenantOccupant, len(rows))
+	for i, row := range rows {
+		occupants[i] = &domain.TenantOccupant{
+			ID:           row.ID,
+			TenantID:     row.TenantID,
+			FullName:     row.FullName,
+			Relationship: row.Relationship,
+			Document:     fromNullStringPtr(row.Document),
+			BirthDate:    fromNullTimePtr(row.BirthDate),
+			CreatedAt:    row.CreatedAt,
+		}
+	}
+
+	return occupants, nil
+}
+
+// ReplaceOccupants substitui os ocupantes do morador em uma transação
+func (r *TenantProfileRepo) ReplaceOccupants(ctx context.Context, tenantID uuid.UUID, occupants []*domain.TenantOccupant) error {
+	return r.withTx(ctx, func(qtx *sqlc.Queries) error {
+		if err := qtx.DeleteTenantOccupantsByTenantID(ctx, tenantID); err != nil {
+			return fmt.Errorf("failed to delete tenant occupants: %w", err)
+		}
+
+		for _, occupant := range occupants {
+			if _, err := qtx.CreateTenantOccupant(ctx, sqlc.CreateTenantOccupantParams{
+				ID:           occupant.ID,
+				TenantID:     tenantID,
+				FullName:     occupant.FullName,
+				Relationship: occupant.Relationship,
+				Document:     toNullStringPtr(occupant.Document),
+				BirthDate:    toNullTimePtr(occupant.BirthDate),
+				CreatedAt:    occupant.CreatedAt,
+			}); err != nil {
+				return fmt.Errorf("failed to create tenant occupant: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// ListPets retorna os animais de estimação do morador
+func (r *TenantProfileRepo) ListPets(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantPet, error) {
+	rows, err := r.queries.ListTenantPetsByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tenant pets: %w", err)
+	}
+
+	pets := make([]*domain.TenantPet, len(rows))
+	for i, row := range rows {
+		pets[i] = &domain.TenantPet{
+			ID:        row.ID,
+			TenantID:  row.TenantID,
+			Name:      row.Name,
+			Species:   domain.PetSpecies(row.Species),
+			Breed:     fromNullStringPtr(row.Breed),
+			Notes:     fromNullStringPtr(row.Notes),
+			CreatedAt: row.CreatedAt,
+		}
+	}
+
+	return pets, nil
+}
+
+// ReplacePets substitui os animais de estimação do morador em uma transação
+func (r *TenantProfileRepo) ReplacePets(ctx context.Context, tenantID uuid.UUID, pets []*domain.TenantPet) error {
+	return r.withTx(ctx, func(qtx *sqlc.Queries) error {
+		if err := qtx.DeleteTenantPetsByTenantID(ctx, tenantID); err != nil {
+			return fmt.Errorf("failed to delete tenant pets: %w", err)
+		}
+
+		for _, pet := range pets {
+			if _, err := qtx.CreateTenantPet(ctx, sqlc.CreateTenantPetParams{
+				ID:        pet.ID,
+				TenantID:  tenantID,
+				Name:      pet.Name,
+				Species:   string(pet.Species),
+				Breed:     toNullStringPtr(pet.Breed),
+				Notes:     toNullStringPtr(pet.Notes),
+				CreatedAt: pet.CreatedAt,
+			}); err != nil {
+				return fmt.Errorf("failed to create tenant pet: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// withTx executa as operações em uma transação
+func (r *TenantProfileRepo) withTx(ctx context.Context, fn func(qtx *sqlc.Queries) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := fn(sqlc.New(tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -91,6 +91,16 @@ func (r *TenantRepository) List(ctx context.Context) ([]*domain.Tenant, error) {
 	return r.toDomainSlice(dbTenants), nil
 }
 
+// ListByPropertyID retorna os moradores com contratos em um imóvel
+func (r *TenantRepository) ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.Tenant, error) {
+	dbTenants, err := r.queries.ListTenantsByPropertyID(ctx, propertyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.toDomainSlice(dbTenants), nil
+}
+
 // SearchByName busca moradores por nome (case-insensitive)
 func (r *TenantRepository) SearchByName(ctx context.Context, name string) ([]*domain.Tenant, error) {
 	// SQLC gerou o parâmetro como sql.NullString, então precisamos converter
@@ -107,13 +117,22 @@ func (r *TenantRepository) Update(ctx context.Context, tenant *domain.Tenant) er
 	tenant.UpdatedAt = time.Now()
 
 	params := sqlc.UpdateTenantParams{
-		ID:               tenant.ID,
-		FullName:         tenant.FullName,
-		Phone:            tenant.Phone,
-		Email:            toNullString(tenant.Email),
-		IDDocumentType:   toNullString(tenant.IDDocumentType),
-		IDDocumentNumber: toNullString(tenant.IDDocumentNumber),
-		UpdatedAt:        tenant.UpdatedAt,
+		ID:                tenant.ID,
+		FullName:          tenant.FullName,
+		Phone:             tenant.Phone,
+		Email:             toNullString(tenant.Email),
+		IDDocumentType:    toNullString(tenant.IDDocumentType),
+		IDDocumentNumber:  toNullString(tenant.IDDocumentNumber),
+		MessagingOptOutAt: toNullTimePtr(tenant.MessagingOptOutAt),
+		UpdatedAt:         tenant.UpdatedAt,
+	}
+
+	if tenant.Employer != nil {
+		params.EmployerName = toNullString(tenant.Employer.CompanyName)
+		params.EmployerPhone = toNullStringPtr(tenant.Employer.Phone)
+		params.EmployerJobTitle = toNullStringPtr(tenant.Employer.JobTitle)
+		params.EmployerMonthlyIncome = toNullDecimalPtr(tenant.Employer.MonthlyIncome)
+		params.EmployerStartDate = toNullTimePtr(tenant.Employer.StartDate)
 	}
 
 	updated, err := r.queries.UpdateTenant(ctx, params)
@@ -142,17 +161,32 @@ func (r *TenantRepository) ExistsByCPF(ctx context.Context, cpf string) (bool, e
 
 // toDomain converte sqlc.Tenant para domain.Tenant
 func (r *TenantRepository) toDomain(dbTenant sqlc.Tenant) *domain.Tenant {
-	return &domain.Tenant{
-		ID:               dbTenant.ID,
-		FullName:         dbTenant.FullName,
-		CPF:              dbTenant.Cpf,
-		Phone:            dbTenant.Phone,
-		Email:            dbTenant.Email.String,
-		IDDocumentType:   dbTenant.IDDocumentType.String,
-		IDDocumentNumber: dbTenant.IDDocumentNumber.String,
-		CreatedAt:        dbTenant.CreatedAt,
-		UpdatedAt:        dbTenant.UpdatedAt,
+	tenant := &domain.Tenant{
+		ID:                dbTenant.ID,
+		FullName:          dbTenant.FullName,
+		CPF:               dbTenant.Cpf,
+		Phone:             dbTenant.Phone,
+		Email:             dbTenant.Email.String,
+		IDDocumentType:    dbTenant.IDDocumentType.String,
+		IDDocumentNumber:  dbTenant.IDDocumentNumber.String,
+		AnonymizedAt:      fromNullTimePtr(dbTenant.AnonymizedAt),
+		AnonymizedBy:      fromNullUUIDPtr(dbTenant.AnonymizedBy),
+		MessagingOptOutAt: fromNullTimePtr(dbTenant.MessagingOptOutAt),
+		CreatedAt:         dbTenant.CreatedAt,
+		UpdatedAt:         dbTenant.UpdatedAt,
 	}
+
+	if dbTenant.EmployerName.Valid {
+		tenant.Employer = &domain.TenantEmployer{
+			CompanyName:   dbTenant.EmployerName.String,
+			Phone:         fromNullStringPtr(dbTenant.EmployerPhone),
+			JobTitle:      fromNullStringPtr(dbTenant.EmployerJobTitle),
+			MonthlyIncome: fromNullDecimalPtr(dbTenant.EmployerMonthlyIncome),
+			StartDate:     fromNullTimePtr(dbTenant.EmployerStartDate),
+		}
+	}
+
+	return tenant
 }
 
 // toDomainSlice converte []sqlc.Tenant para []*domain.Tenant
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+// openTestDB conecta no banco de TEST_DATABASE_URL e aplica todas as migrations em um schema
+// temporário, removido ao final do teste. Sem a variável, o teste é ignorado
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	require.NoError(t, err)
+	// Uma única conexão para que o search_path valha para todas as consultas
+	db.SetMaxOpenConns(1)
+
+	schema := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")[:12]
+	_, err = db.Exec(fmt.Sprintf("CREATE SCHEMA %s; SET search_path TO %s, public", schema, schema))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, _ = db.Exec(fmt.Sprintf("DROP SCHEMA %s CASCADE", schema))
+		_ = db.Close()
+	})
+
+	files, err := filepath.Glob(filepath.Join("..", "..", "..", "migrations", "*.up.sql"))
+	require.NoError(t, err)
+	sort.Strings(files)
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		require.NoError(t, err)
+		_, err = db.Exec(string(content))
+		require.NoError(t, err, filepath.Base(file))
+	}
+
+	return db
+}
@@ -33,6 +33,7 @@ func NewUnitRepository(db *sql.DB) *UnitRepository {
 func (r *UnitRepository) Create(ctx context.Context, unit *domain.Unit) error {
 	params := sqlc.CreateUnitParams{
 		ID:                 unit.ID,
+		PropertyID:         unit.PropertyID,
 		Number:             unit.Number,
 		Floor:              int32(unit.Floor),
 		Status:             sqlc.UnitStatus(unit.Status),
@@ -70,9 +71,12 @@ func (r *UnitRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Uni
 	return r.toDomain(dbUnit), nil
 }
 
-// GetByNumber busca uma unidade pelo número
-func (r *UnitRepository) GetByNumber(ctx context.Context, number string) (*domain.Unit, error) {
-	dbUnit, err := r.queries.GetUnitByNumber(ctx, number)
+// GetByNumber busca uma unidade pelo número dentro de um imóvel
+func (r *UnitRepository) GetByNumber(ctx context.Context, propertyID uuid.UUID, number string) (*domain.Unit, error) {
+	dbUnit, err := r.queries.GetUnitByNumber(ctx, sqlc.GetUnitByNumberParams{
+		PropertyID: propertyID,
+		Number:     number,
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -93,6 +97,16 @@ func (r *UnitRepository) List(ctx context.Context) ([]*domain.Unit, error) {
 	return r.toDomainSlice(dbUnits), nil
 }
 
+// ListByPropertyID retorna as unidades de um imóvel
+func (r *UnitRepository) ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.Unit, error) {
+	dbUnits, err := r.queries.ListUnitsByPropertyID(ctx, propertyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.toDomainSlice(dbUnits), nil
+}
+
 // ListByStatus retorna unidades filtradas por status
 func (r *UnitRepository) ListByStatus(ctx context.Context, status domain.UnitStatus) ([]*domain.Unit, error) {
 	dbUnits, err := r.queries.ListUnitsByStatus(ctx, sqlc.UnitStatus(status))
@@ -180,6 +194,7 @@ func (r *UnitRepository) CountByStatus(ctx context.Context, status domain.UnitSt
 func (r *UnitRepository) toDomain(dbUnit sqlc.Unit) *domain.Unit {
 	return &domain.Unit{
 		ID:                 dbUnit.ID,
+		PropertyID:         dbUnit.PropertyID,
 		Number:             dbUnit.Number,
 		Floor:              int(dbUnit.Floor),
 		Status:             domain.UnitStatus(dbUnit.Status),
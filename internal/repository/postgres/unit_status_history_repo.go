@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+)
+
+// Compile-time check to ensure UnitStatusHistoryRepo implements repository.UnitStatusHistoryRepository
+var _ repository.UnitStatusHistoryRepository = (*UnitStatusHistoryRepo)(nil)
+
+// UnitStatusHistoryRepo implementa o repository de histórico de status das unidades usando SQLC
+type UnitStatusHistoryRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewUnitStatusHistoryRepo cria uma nova instância do repository de histórico de status
+func NewUnitStatusHistoryRepo(db *sql.DB) *UnitStatusHistoryRepo {
+	return &UnitStatusHistoryRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// Create registra uma mudança de status
+func (r *UnitStatusHistoryRepo) Create(ctx context.Context, change *domain.UnitStatusChange) error {
+	params := sqlc.CreateUnitStatusChangeParams{
+		ID:          change.ID,
+		UnitID:      change.UnitID,
+		FromStatus:  toNullUnitStatusPtr(change.FromStatus),
+		ToStatus:    sqlc.UnitStatus(change.ToStatus),
+		Reason:      string(change.Reason),
+		ReferenceID: toNullUUIDPtr(change.ReferenceID),
+		ChangedAt:   change.ChangedAt,
+		CreatedAt:   change.CreatedAt,
+	}
+
+	if _, err := r.queries.CreateUnitStatusChange(ctx, params); err != nil {
+		return fmt.Errorf("failed to create unit status change: %w", err)
+	}
+
+	return nil
+}
+
+// ListByUnitID retorna o histórico de uma unidade em ordem cronológica
+func (r *UnitStatusHistoryRepo) ListByUnitID(ctx context.Context, unitID uuid.UUID) ([]*domain.UnitStatusChange, error) {
+	rows, err := r.queries.ListUnitStatusChangesByUnitID(ctx, unitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unit status changes: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// GetLatestByUnitID retorna a mudança de status mais recente de uma unidade
+func (r *UnitStatusHistoryRepo) GetLatestByUnitID(ctx context.Context, unitID uuid.UUID) (*domain.UnitStatusChange, error) {
+	row, err := r.queries.GetLatestUnitStatusChangeByUnitID(ctx, unitID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get latest unit status change: %w", err)
+	}
+
+	return r.toDomain(row), nil
+}
+
+// ListUntil retorna todas as mudanças anteriores à data informada
+func (r *UnitStatusHistoryRepo) ListUntil(ctx context.Context, until time.Time) ([]*domain.UnitStatusChange, error) {
+	rows, err := r.queries.ListUnitStatusChangesUntil(ctx, until)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unit status changes: %w", err)
+	}
+
+	return r.toDomainList(rows), nil
+}
+
+// toDomain converte sqlc.UnitStatusHistory para domain.UnitStatusChange
+func (r *UnitStatusHistoryRepo) toDomain(row sqlc.UnitStatusHistory) *domain.UnitStatusChange {
+	return &domain.UnitStatusChange{
+		ID:          row.ID,
+		UnitID:      row.UnitID,
+		FromStatus:  fromNullUnitStatusPtr(row.FromStatus),
+		ToStatus:    domain.UnitStatus(row.ToStatus),
+		Reason:      domain.UnitStatusChangeReason(row.Reason),
+		ReferenceID: fromNullUUIDPtr(row.ReferenceID),
+		ChangedAt:   row.ChangedAt,
+		CreatedAt:   row.CreatedAt,
+	}
+}
+
+// toDomainList converte []sqlc.UnitStatusHistory para []*domain.UnitStatusChange
+func (r *UnitStatusHistoryRepo) toDomainList(rows []sqlc.UnitStatusHistory) []*domain.UnitStatusChange {
+	changes := make([]*domain.UnitStatusChange, len(rows))
+	for i, row := range rows {
+		changes[i] = r.toDomain(row)
+	}
+	return changes
+}
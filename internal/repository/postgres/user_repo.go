@@ -39,6 +39,7 @@ func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
 		IsActive:     user.IsActive,
 		CreatedAt:    user.CreatedAt,
 		UpdatedAt:    user.UpdatedAt,
+		TenantID:     toNullUUIDPtr(user.TenantID),
 	}
 
 	created, err := r.queries.CreateUser(ctx, params)
@@ -79,6 +80,19 @@ func (r *UserRepository) GetByUsername(ctx context.Context, username string) (*d
 	return r.toDomain(dbUser), nil
 }
 
+// GetByTenantID busca o usuário do portal vinculado a um morador
+func (r *UserRepository) GetByTenantID(ctx context.Context, tenantID uuid.UUID) (*domain.User, error) {
+	dbUser, err := r.queries.GetUserByTenantID(ctx, uuid.NullUUID{UUID: tenantID, Valid: true})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return r.toDomain(dbUser), nil
+}
+
 // List retorna todos os usuários
 func (r *UserRepository) List(ctx context.Context) ([]*domain.User, error) {
 	dbUsers, err := r.queries.ListUsers(ctx)
@@ -198,6 +212,7 @@ func (r *UserRepository) toDomain(dbUser sqlc.User) *domain.User {
 		Username:     dbUser.Username,
 		PasswordHash: dbUser.PasswordHash,
 		Role:         domain.UserRole(dbUser.Role),
+		TenantID:     fromNullUUIDPtr(dbUser.TenantID),
 		IsActive:     dbUser.IsActive,
 		LastLoginAt:  lastLogin,
 		CreatedAt:    dbUser.CreatedAt,
@@ -0,0 +1,305 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+	"github.com/shopspring/decimal"
+)
+
+// Compile-time check to ensure UtilityRepo implements repository.UtilityRepository
+var _ repository.UtilityRepository = (*UtilityRepo)(nil)
+
+// UtilityRepo implementa o repository de cobrança de consumo usando SQLC
+type UtilityRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewUtilityRepo cria uma nova instância do repository de cobrança de consumo
+func NewUtilityRepo(db *sql.DB) *UtilityRepo {
+	return &UtilityRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// CreateTariff insere uma nova tarifa
+func (r *UtilityRepo) CreateTariff(ctx context.Context, tariff *domain.UtilityTariff) error {
+	_, err := r.queries.CreateUtilityTariff(ctx, sqlc.CreateUtilityTariffParams{
+		ID:            tariff.ID,
+		UtilityType:   string(tariff.UtilityType),
+		PricePerUnit:  tariff.PricePerUnit.String(),
+		FixedFee:      tariff.FixedFee.String(),
+		EffectiveFrom: tariff.EffectiveFrom,
+		CreatedAt:     tariff.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create utility tariff: %w", err)
+	}
+	return nil
+}
+
+// ListTariffs lista todas as tarifas cadastradas
+func (r *UtilityRepo) ListTariffs(ctx context.Context) ([]*domain.UtilityTariff, error) {
+	rows, err := r.queries.ListUtilityTariffs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list utility tariffs: %w", err)
+	}
+
+	tariffs := make([]*domain.UtilityTariff, len(rows))
+	for i, row := range rows {
+		tariffs[i] = tariffToDomain(row)
+	}
+	return tariffs, nil
+}
+
+// GetEffectiveTariff busca a tarifa vigente na data informada
+func (r *UtilityRepo) GetEffectiveTariff(ctx context.Context, utilityType domain.UtilityType, date time.Time) (*domain.UtilityTariff, error) {
+	row, err := r.queries.GetEffectiveUtilityTariff(ctx, sqlc.GetEffectiveUtilityTariffParams{
+		UtilityType:   string(utilityType),
+		ReferenceDate: date,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get effective utility tariff: %w", err)
+	}
+	return tariffToDomain(row), nil
+}
+
+// CreateReading insere uma nova leitura de medidor
+func (r *UtilityRepo) CreateReading(ctx context.Context, reading *domain.MeterReading) error {
+	_, err := r.queries.CreateMeterReading(ctx, sqlc.CreateMeterReadingParams{
+		ID:             reading.ID,
+		UnitID:         reading.UnitID,
+		UtilityType:    string(reading.UtilityType),
+		ReferenceMonth: reading.ReferenceMonth,
+		Reading:        reading.Reading.String(),
+		ReadingDate:    reading.ReadingDate,
+		Notes:          toNullStringPtr(reading.Notes),
+		CreatedBy:      toNullUUIDPtr(reading.CreatedBy),
+		CreatedAt:      reading.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create meter reading: %w", err)
+	}
+	return nil
+}
+
+// GetReadingByID busca uma leitura pelo ID
+func (r *UtilityRepo) GetReadingByID(ctx context.Context, id uuid.UUID) (*domain.MeterReading, error) {
+	row, err := r.queries.GetMeterReadingByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get meter reading: %w", err)
+	}
+	return meterReadingToDomain(row), nil
+}
+
+// GetReading busca a leitura de uma unidade no mês de referência
+func (r *UtilityRepo) GetReading(ctx context.Context, unitID uuid.UUID, utilityType domain.UtilityType, referenceMonth time.Time) (*domain.MeterReading, error) {
+	row, err := r.queries.GetMeterReading(ctx, sqlc.GetMeterReadingParams{
+		UnitID:         unitID,
+		UtilityType:    string(utilityType),
+		ReferenceMonth: referenceMonth,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get meter reading: %w", err)
+	}
+	return meterReadingToDomain(row), nil
+}
+
+// GetPreviousReading busca a última leitura anterior ao mês de referência
+func (r *UtilityRepo) GetPreviousReading(ctx context.Context, unitID uuid.UUID, utilityType domain.UtilityType, referenceMonth time.Time) (*domain.MeterReading, error) {
+	row, err := r.queries.GetPreviousMeterReading(ctx, sqlc.GetPreviousMeterReadingParams{
+		UnitID:         unitID,
+		UtilityType:    string(utilityType),
+		ReferenceMonth: referenceMonth,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get previous meter reading: %w", err)
+	}
+	return meterReadingToDomain(row), nil
+}
+
+// ListReadingsByUnitID lista as leituras de uma unidade
+func (r *UtilityRepo) ListReadingsByUnitID(ctx context.Context, unitID uuid.UUID) ([]*domain.MeterReading, error) {
+	rows, err := r.queries.ListMeterReadingsByUnitID(ctx, unitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list meter readings: %w", err)
+	}
+
+	readings := make([]*domain.MeterReading, len(rows))
+	for i, row := range rows {
+		readings[i] = meterReadingToDomain(row)
+	}
+	return readings, nil
+}
+
+// DeleteReading remove uma leitura
+func (r *UtilityRepo) DeleteReading(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteMeterReading(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete meter reading: %w", err)
+	}
+	return nil
+}
+
+// CreateBill insere o pagamento de consumo e seu detalhamento em uma transação
+func (r *UtilityRepo) CreateBill(ctx context.Context, payment *domain.Payment, charges []*domain.UtilityCharge) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	qtx := sqlc.New(tx)
+
+	if _, err := qtx.CreatePayment(ctx, sqlc.CreatePaymentParams{
+		ID:             payment.ID,
+		LeaseID:        payment.LeaseID,
+		PaymentType:    string(payment.PaymentType),
+		ReferenceMonth: payment.ReferenceMonth,
+		Amount:         payment.Amount.String(),
+		Status:         string(payment.Status),
+		DueDate:        payment.DueDate,
+		PaymentDate:    toNullTimePtr(payment.PaymentDate),
+		PaymentMethod:  toNullStringPtr(paymentMethodToStringPtr(payment.PaymentMethod)),
+		ProofUrl:       toNullStringPtr(payment.ProofURL),
+		Notes:          toNullStringPtr(payment.Notes),
+		CreatedAt:      payment.CreatedAt,
+		UpdatedAt:      payment.UpdatedAt,
+	}); err != nil {
+		return fmt.Errorf("failed to create utility payment: %w", err)
+	}
+
+	for _, charge := range charges {
+		if _, err := qtx.CreateUtilityCharge(ctx, sqlc.CreateUtilityChargeParams{
+			ID:              charge.ID,
+			PaymentID:       payment.ID,
+			LeaseID:         charge.LeaseID,
+			UnitID:          charge.UnitID,
+			UtilityType:     string(charge.UtilityType),
+			ReferenceMonth:  charge.ReferenceMonth,
+			BillingMethod:   string(charge.BillingMethod),
+			PreviousReading: toNullDecimalPtr(charge.PreviousReading),
+			CurrentReading:  toNullDecimalPtr(charge.CurrentReading),
+			Consumption:     toNullDecimalPtr(charge.Consumption),
+			PricePerUnit:    toNullDecimalPtr(charge.PricePerUnit),
+			FixedFee:        charge.FixedFee.String(),
+			BuildingTotal:   toNullDecimalPtr(charge.BuildingTotal),
+			ShareWeight:     toNullInt32Ptr(charge.ShareWeight),
+			TotalShares:     toNullInt32Ptr(charge.TotalShares),
+			Amount:          charge.Amount.String(),
+			CreatedAt:       charge.CreatedAt,
+		}); err != nil {
+			return fmt.Errorf("failed to create utility charge: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListChargesByPaymentID lista o detalhamento de consumo de um pagamento
+func (r *UtilityRepo) ListChargesByPaymentID(ctx context.Context, paymentID uuid.UUID) ([]*domain.UtilityCharge, error) {
+	rows, err := r.queries.ListUtilityChargesByPaymentID(ctx, paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list utility charges: %w", err)
+	}
+
+	charges := make([]*domain.UtilityCharge, len(rows))
+	for i, row := range rows {
+		charges[i] = utilityChargeToDomain(row)
+	}
+	return charges, nil
+}
+
+// CountChargesByLeaseAndMonth conta as cobranças de consumo de um contrato no mês
+func (r *UtilityRepo) CountChargesByLeaseAndMonth(ctx context.Context, leaseID uuid.UUID, referenceMonth time.Time) (int64, error) {
+	count, err := r.queries.CountUtilityChargesByLeaseAndMonth(ctx, sqlc.CountUtilityChargesByLeaseAndMonthParams{
+		LeaseID:        leaseID,
+		ReferenceMonth: referenceMonth,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count utility charges: %w", err)
+	}
+	return count, nil
+}
+
+// tariffToDomain converte sqlc.UtilityTariff para domain.UtilityTariff
+func tariffToDomain(row sqlc.UtilityTariff) *domain.UtilityTariff {
+	price, _ := decimal.NewFromString(row.PricePerUnit)
+	fixedFee, _ := decimal.NewFromString(row.FixedFee)
+
+	return &domain.UtilityTariff{
+		ID:            row.ID,
+		UtilityType:   domain.UtilityType(row.UtilityType),
+		PricePerUnit:  price,
+		FixedFee:      fixedFee,
+		EffectiveFrom: row.EffectiveFrom,
+		CreatedAt:     row.CreatedAt,
+	}
+}
+
+// meterReadingToDomain converte sqlc.MeterReading para domain.MeterReading
+func meterReadingToDomain(row sqlc.MeterReading) *domain.MeterReading {
+	reading, _ := decimal.NewFromString(row.Reading)
+
+	return &domain.MeterReading{
+		ID:             row.ID,
+		UnitID:         row.UnitID,
+		UtilityType:    domain.UtilityType(row.UtilityType),
+		ReferenceMonth: row.ReferenceMonth,
+		Reading:        reading,
+		ReadingDate:    row.ReadingDate,
+		Notes:          fromNullStringPtr(row.Notes),
+		CreatedBy:      fromNullUUIDPtr(row.CreatedBy),
+		CreatedAt:      row.CreatedAt,
+	}
+}
+
+// utilityChargeToDomain converte sqlc.UtilityCharge para domain.UtilityCharge
+func utilityChargeToDomain(row sqlc.UtilityCharge) *domain.UtilityCharge {
+	fixedFee, _ := decimal.NewFromString(row.FixedFee)
+	amount, _ := decimal.NewFromString(row.Amount)
+
+	return &domain.UtilityCharge{
+		ID:              row.ID,
+		PaymentID:       row.PaymentID,
+		LeaseID:         row.LeaseID,
+		UnitID:          row.UnitID,
+		UtilityType:     domain.UtilityType(row.UtilityType),
+		ReferenceMonth:  row.ReferenceMonth,
+		BillingMethod:   domain.UtilityBillingMethod(row.BillingMethod),
+		PreviousReading: fromNullDecimalPtr(row.PreviousReading),
+		CurrentReading:  fromNullDecimalPtr(row.CurrentReading),
+		Consumption:     fromNullDecimalPtr(row.Consumption),
+		PricePerUnit:    fromNullDecimalPtr(row.PricePerUnit),
+		FixedFee:        fixedFee,
+		BuildingTotal:   fromNullDecimalPtr(row.BuildingTotal),
+		ShareWeight:     fromNullInt32Ptr(row.ShareWeight),
+		TotalShares:     fromNullInt32Ptr(row.TotalShares),
+		Amount:          amount,
+		CreatedAt:       row.CreatedAt,
+	}
+}
@@ -0,0 +1,299 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository/sqlc"
+)
+
+// Compile-time check to ensure WebhookRepo implements repository.WebhookRepository
+var _ repository.WebhookRepository = (*WebhookRepo)(nil)
+
+// WebhookRepo implementa o repository de webhooks usando SQLC
+type WebhookRepo struct {
+	db      *sql.DB
+	queries *sqlc.Queries
+}
+
+// NewWebhookRepo cria uma nova instância do repository de webhooks
+func NewWebhookRepo(db *sql.DB) *WebhookRepo {
+	return &WebhookRepo{
+		db:      db,
+		queries: sqlc.New(db),
+	}
+}
+
+// CreateEndpoint insere o endpoint e os eventos inscritos em uma transação
+func (r *WebhookRepo) CreateEndpoint(ctx context.Context, endpoint *domain.WebhookEndpoint) error {
+	return r.withTx(ctx, func(qtx *sqlc.Queries) error {
+		if _, err := qtx.CreateWebhookEndpoint(ctx, sqlc.CreateWebhookEndpointParams{
+			ID:          endpoint.ID,
+			Url:         endpoint.URL,
+			Description: endpoint.Description,
+			Secret:      endpoint.Secret,
+			Active:      endpoint.Active,
+			CreatedAt:   endpoint.CreatedAt,
+			UpdatedAt:   endpoint.UpdatedAt,
+		}); err != nil {
+			return fmt.Errorf("failed to create webhook endpoint: %w", err)
+		}
+
+		return r.createSubscriptions(ctx, qtx, endpoint)
+	})
+}
+
+// GetEndpointByID busca um endpoint por ID com os eventos inscritos
+func (r *WebhookRepo) GetEndpointByID(ctx context.Context, id uuid.UUID) (*domain.WebhookEndpoint, error) {
+	row, err := r.queries.GetWebhookEndpointByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get webhook endpoint: %w", err)
+	}
+
+	subscriptions, err := r.queries.ListWebhookSubscriptionsByEndpointID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+
+	return r.endpointToDomain(row, subscriptions), nil
+}
+
+// ListEndpoints lista todos os endpoints com os eventos inscritos
+func (r *WebhookRepo) ListEndpoints(ctx context.Context) ([]*domain.WebhookEndpoint, error) {
+	rows, err := r.queries.ListWebhookEndpoints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints: %w", err)
+	}
+
+	return r.endpointToDomainList(ctx, rows)
+}
+
+// ListActiveEndpointsByEvent lista os endpoints ativos inscritos no evento
+func (r *WebhookRepo) ListActiveEndpointsByEvent(ctx context.Context, eventType domain.WebhookEventType) ([]*domain.WebhookEndpoint, error) {
+	rows, err := r.queries.ListActiveWebhookEndpointsByEvent(ctx, string(eventType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook endpoints by event: %w", err)
+	}
+
+	return r.endpointToDomainList(ctx, rows)
+}
+
+// UpdateEndpoint atualiza o endpoint e substitui os eventos inscritos em uma transação
+func (r *WebhookRepo) UpdateEndpoint(ctx context.Context, endpoint *domain.WebhookEndpoint) error {
+	return r.withTx(ctx, func(qtx *sqlc.Queries) error {
+		if _, err := qtx.UpdateWebhookEndpoint(ctx, sqlc.UpdateWebhookEndpointParams{
+			ID:          endpoint.ID,
+			Url:         endpoint.URL,
+			Description: endpoint.Description,
+			Secret:      endpoint.Secret,
+			Active:      endpoint.Active,
+			UpdatedAt:   endpoint.UpdatedAt,
+		}); err != nil {
+			return fmt.Errorf("failed to update webhook endpoint: %w", err)
+		}
+
+		if err := qtx.DeleteWebhookSubscriptionsByEndpointID(ctx, endpoint.ID); err != nil {
+			return fmt.Errorf("failed to delete webhook subscriptions: %w", err)
+		}
+
+		return r.createSubscriptions(ctx, qtx, endpoint)
+	})
+}
+
+// DeleteEndpoint remove o endpoint (inscrições e entregas são removidas em cascata)
+func (r *WebhookRepo) DeleteEndpoint(ctx context.Context, id uuid.UUID) error {
+	if err := r.queries.DeleteWebhookEndpoint(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+	}
+
+	return nil
+}
+
+// CreateDelivery insere uma entrega no registro
+func (r *WebhookRepo) CreateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	_, err := r.queries.CreateWebhookDelivery(ctx, sqlc.CreateWebhookDeliveryParams{
+		ID:            delivery.ID,
+		EndpointID:    delivery.EndpointID,
+		EventID:       delivery.EventID,
+		EventType:     string(delivery.EventType),
+		Payload:       delivery.Payload,
+		Status:        string(delivery.Status),
+		Attempts:      int32(delivery.Attempts),
+		NextAttemptAt: toNullTimePtr(delivery.NextAttemptAt),
+		ReplayOf:      toNullUUIDPtr(delivery.ReplayOf),
+		CreatedAt:     delivery.CreatedAt,
+		UpdatedAt:     delivery.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// GetDeliveryByID busca uma entrega por ID
+func (r *WebhookRepo) GetDeliveryByID(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error) {
+	row, err := r.queries.GetWebhookDeliveryByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+
+	return r.deliveryToDomain(row), nil
+}
+
+// ListDeliveriesByEndpointID lista as entregas mais recentes do endpoint
+func (r *WebhookRepo) ListDeliveriesByEndpointID(ctx context.Context, endpointID uuid.UUID, limit int) ([]*domain.WebhookDelivery, error) {
+	rows, err := r.queries.ListWebhookDeliveriesByEndpointID(ctx, sqlc.ListWebhookDeliveriesByEndpointIDParams{
+		EndpointID:  endpointID,
+		ResultLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	return r.deliveryToDomainList(rows), nil
+}
+
+// ListDueDeliveries lista as entregas pendentes cuja próxima tentativa já chegou
+func (r *WebhookRepo) ListDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*domain.WebhookDelivery, error) {
+	rows, err := r.queries.ListDueWebhookDeliveries(ctx, sqlc.ListDueWebhookDeliveriesParams{
+		Now:         now,
+		ResultLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due webhook deliveries: %w", err)
+	}
+
+	return r.deliveryToDomainList(rows), nil
+}
+
+// UpdateDeliveryAttempt grava o resultado da última tentativa de entrega
+func (r *WebhookRepo) UpdateDeliveryAttempt(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	_, err := r.queries.UpdateWebhookDeliveryAttempt(ctx, sqlc.UpdateWebhookDeliveryAttemptParams{
+		ID:             delivery.ID,
+		Status:         string(delivery.Status),
+		Attempts:       int32(delivery.Attempts),
+		NextAttemptAt:  toNullTimePtr(delivery.NextAttemptAt),
+		LastError:      toNullStringPtr(delivery.LastError),
+		ResponseStatus: toNullInt32Ptr(delivery.ResponseStatus),
+		DeliveredAt:    toNullTimePtr(delivery.DeliveredAt),
+		UpdatedAt:      delivery.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// createSubscriptions insere os eventos inscritos do endpoint
+func (r *WebhookRepo) createSubscriptions(ctx context.Context, qtx *sqlc.Queries, endpoint *domain.WebhookEndpoint) error {
+	for _, event := range endpoint.Events {
+		if err := qtx.CreateWebhookSubscription(ctx, sqlc.CreateWebhookSubscriptionParams{
+			EndpointID: endpoint.ID,
+			EventType:  string(event),
+		}); err != nil {
+			return fmt.Errorf("failed to create webhook subscription: %w", err)
+		}
+	}
+	return nil
+}
+
+// withTx executa as operações em uma transação
+func (r *WebhookRepo) withTx(ctx context.Context, fn func(qtx *sqlc.Queries) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := fn(sqlc.New(tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// endpointToDomain converte sqlc.WebhookEndpoint e suas inscrições para domain.WebhookEndpoint
+func (r *WebhookRepo) endpointToDomain(row sqlc.WebhookEndpoint, subscriptions []sqlc.WebhookSubscription) *domain.WebhookEndpoint {
+	events := make([]domain.WebhookEventType, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if subscription.EndpointID == row.ID {
+			events = append(events, domain.WebhookEventType(subscription.EventType))
+		}
+	}
+
+	return &domain.WebhookEndpoint{
+		ID:          row.ID,
+		URL:         row.Url,
+		Description: row.Description,
+		Secret:      row.Secret,
+		Events:      events,
+		Active:      row.Active,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+// endpointToDomainList converte []sqlc.WebhookEndpoint para []*domain.WebhookEndpoint, carregando as inscrições
+func (r *WebhookRepo) endpointToDomainList(ctx context.Context, rows []sqlc.WebhookEndpoint) ([]*domain.WebhookEndpoint, error) {
+	endpoints := make([]*domain.WebhookEndpoint, len(rows))
+	if len(rows) == 0 {
+		return endpoints, nil
+	}
+
+	subscriptions, err := r.queries.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+
+	for i, row := range rows {
+		endpoints[i] = r.endpointToDomain(row, subscriptions)
+	}
+	return endpoints, nil
+}
+
+// deliveryToDomain converte sqlc.WebhookDelivery para domain.WebhookDelivery
+func (r *WebhookRepo) deliveryToDomain(row sqlc.WebhookDelivery) *domain.WebhookDelivery {
+	return &domain.WebhookDelivery{
+		ID:             row.ID,
+		EndpointID:     row.EndpointID,
+		EventID:        row.EventID,
+		EventType:      domain.WebhookEventType(row.EventType),
+		Payload:        row.Payload,
+		Status:         domain.WebhookDeliveryStatus(row.Status),
+		Attempts:       int(row.Attempts),
+		NextAttemptAt:  fromNullTimePtr(row.NextAttemptAt),
+		LastError:      fromNullStringPtr(row.LastError),
+		ResponseStatus: fromNullInt32Ptr(row.ResponseStatus),
+		DeliveredAt:    fromNullTimePtr(row.DeliveredAt),
+		ReplayOf:       fromNullUUIDPtr(row.ReplayOf),
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+}
+
+// deliveryToDomainList converte []sqlc.WebhookDelivery para []*domain.WebhookDelivery
+func (r *WebhookRepo) deliveryToDomainList(rows []sqlc.WebhookDelivery) []*domain.WebhookDelivery {
+	deliveries := make([]*domain.WebhookDelivery, len(rows))
+	for i, row := range rows {
+		deliveries[i] = r.deliveryToDomain(row)
+	}
+	return deliveries
+}
@@ -0,0 +1,25 @@
+-- name: UpsertCalendarFeed :one
+INSERT INTO calendar_feeds (user_id, token_hash, last_accessed_at, created_at)
+VALUES ($1, $2, NULL, $3)
+ON CONFLICT (user_id) DO UPDATE
+SET token_hash = EXCLUDED.token_hash,
+    last_accessed_at = NULL,
+    created_at = EXCLUDED.created_at
+RETURNING *;
+
+-- name: GetCalendarFeedByUserID :one
+SELECT * FROM calendar_feeds
+WHERE user_id = $1;
+
+-- name: GetCalendarFeedByTokenHash :one
+SELECT * FROM calendar_feeds
+WHERE token_hash = $1;
+
+-- name: TouchCalendarFeed :exec
+UPDATE calendar_feeds
+SET last_accessed_at = $2
+WHERE user_id = $1;
+
+-- name: DeleteCalendarFeed :exec
+DELETE FROM calendar_feeds
+WHERE user_id = $1;
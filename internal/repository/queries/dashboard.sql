@@ -27,4 +27,49 @@ WHERE status = 'overdue';
 -- name: GetTotalPendingAmount :one
 SELECT COALESCE(SUM(amount), 0)::TEXT as total
 FROM payments
-WHERE status IN ('pending', 'overdue');
\ No newline at end of file
+WHERE status IN ('pending', 'overdue');
+-- name: GetOccupancyMetricsByProperty :many
+SELECT
+    p.id as property_id,
+    p.name as property_name,
+    COUNT(u.id)::BIGINT as total_units,
+    COUNT(u.id) FILTER (WHERE u.status = 'occupied')::BIGINT as occupied_units,
+    COUNT(u.id) FILTER (WHERE u.status = 'available')::BIGINT as available_units,
+    COUNT(u.id) FILTER (WHERE u.status = 'maintenance')::BIGINT as maintenance_units,
+    COUNT(u.id) FILTER (WHERE u.status = 'renovation')::BIGINT as renovation_units
+FROM properties p
+LEFT JOIN units u ON u.property_id = p.id
+GROUP BY p.id, p.name
+ORDER BY p.name ASC;
+
+-- name: GetFinancialMetricsByProperty :many
+SELECT
+    p.id as property_id,
+    COALESCE((
+        SELECT SUM(l.monthly_rent_value) FROM leases l
+        INNER JOIN units u ON l.unit_id = u.id
+        WHERE u.property_id = p.id AND l.status = 'active'
+    ), 0)::TEXT as projected_revenue,
+    COALESCE((
+        SELECT SUM(pay.amount) FROM payments pay
+        INNER JOIN leases l ON pay.lease_id = l.id
+        INNER JOIN units u ON l.unit_id = u.id
+        WHERE u.property_id = p.id
+          AND pay.status = 'paid'
+          AND pay.payment_type = 'rent'
+          AND DATE_TRUNC('month', pay.payment_date) = DATE_TRUNC('month', CURRENT_DATE)
+    ), 0)::TEXT as realized_revenue,
+    COALESCE((
+        SELECT SUM(pay.amount) FROM payments pay
+        INNER JOIN leases l ON pay.lease_id = l.id
+        INNER JOIN units u ON l.unit_id = u.id
+        WHERE u.property_id = p.id AND pay.status = 'overdue'
+    ), 0)::TEXT as overdue_amount,
+    COALESCE((
+        SELECT SUM(pay.amount) FROM payments pay
+        INNER JOIN leases l ON pay.lease_id = l.id
+        INNER JOIN units u ON l.unit_id = u.id
+        WHERE u.property_id = p.id AND pay.status IN ('pending', 'overdue')
+    ), 0)::TEXT as pending_amount
+FROM properties p
+ORDER BY p.name ASC;
@@ -0,0 +1,75 @@
+-- name: CreateDunningStep :one
+INSERT INTO dunning_steps (
+    id,
+    code,
+    name,
+    offset_days,
+    action,
+    active,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetDunningStepByID :one
+SELECT * FROM dunning_steps
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetDunningStepByCode :one
+SELECT * FROM dunning_steps
+WHERE code = $1
+LIMIT 1;
+
+-- name: ListDunningSteps :many
+SELECT * FROM dunning_steps
+ORDER BY offset_days ASC, code ASC;
+
+-- name: ListActiveDunningSteps :many
+SELECT * FROM dunning_steps
+WHERE active = TRUE
+ORDER BY offset_days ASC, code ASC;
+
+-- name: UpdateDunningStep :one
+UPDATE dunning_steps
+SET name = $2,
+    offset_days = $3,
+    action = $4,
+    active = $5,
+    updated_at = $6
+WHERE id = $1
+RETURNING *;
+
+-- name: CreatePaymentCollectionAction :one
+INSERT INTO payment_collection_actions (
+    id,
+    payment_id,
+    step_id,
+    step_code,
+    step_name,
+    offset_days,
+    action,
+    status,
+    notifications_created,
+    notes,
+    executed_at,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+)
+ON CONFLICT (payment_id, step_id) DO NOTHING
+RETURNING *;
+
+-- name: ListPaymentCollectionActionsByPaymentID :many
+SELECT * FROM payment_collection_actions
+WHERE payment_id = $1
+ORDER BY executed_at ASC, offset_days ASC;
+
+-- name: ListOpenPaymentCollectionActionsByAction :many
+SELECT a.* FROM payment_collection_actions a
+INNER JOIN payments p ON a.payment_id = p.id
+WHERE a.action = $1
+  AND a.status = 'executed'
+  AND p.status IN ('pending', 'overdue')
+ORDER BY a.executed_at ASC;
@@ -0,0 +1,49 @@
+-- name: CreateDepositSettlement :one
+INSERT INTO lease_deposit_settlements (
+    id,
+    lease_id,
+    deposit_amount,
+    correction_rate,
+    correction_amount,
+    damage_deductions,
+    debt_deductions,
+    other_deductions,
+    refund_amount,
+    balance_due,
+    refunded_at,
+    refund_method,
+    notes,
+    created_by,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+) RETURNING *;
+
+-- name: GetDepositSettlementByLeaseID :one
+SELECT * FROM lease_deposit_settlements
+WHERE lease_id = $1
+LIMIT 1;
+
+-- name: MarkDepositSettlementRefunded :one
+UPDATE lease_deposit_settlements
+SET
+    refunded_at = $2,
+    refund_method = $3
+WHERE id = $1
+RETURNING *;
+
+-- name: SettlePaymentWithDeposit :exec
+UPDATE payments
+SET
+    status = 'paid',
+    payment_date = $2,
+    payment_method = 'security_deposit',
+    deposit_settlement_id = $3,
+    updated_at = $4
+WHERE id = $1
+  AND status IN ('pending', 'overdue');
+
+-- name: ListPaymentIDsByDepositSettlementID :many
+SELECT id FROM payments
+WHERE deposit_settlement_id = $1
+ORDER BY due_date ASC;
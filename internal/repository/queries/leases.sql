@@ -15,9 +15,13 @@ INSERT INTO leases (
     parent_lease_id,
     generation,
     created_at,
-    updated_at
+    updated_at,
+    security_deposit,
+    deposit_received_date,
+    deposit_held_at,
+    deposit_correction_index
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20
 ) RETURNING *;
 
 -- name: GetLeaseByID :one
@@ -29,6 +33,12 @@ LIMIT 1;
 SELECT * FROM leases
 ORDER BY created_at DESC;
 
+-- name: ListLeasesByPropertyID :many
+SELECT l.* FROM leases l
+INNER JOIN units u ON l.unit_id = u.id
+WHERE u.property_id = $1
+ORDER BY l.created_at DESC;
+
 -- name: ListLeasesByStatus :many
 SELECT * FROM leases
 WHERE status = $1
@@ -77,7 +87,11 @@ SET
     status = $12,
     parent_lease_id = $13,
     generation = $14,
-    updated_at = $15
+    updated_at = $15,
+    security_deposit = $16,
+    deposit_received_date = $17,
+    deposit_held_at = $18,
+    deposit_correction_index = $19
 WHERE id = $1
 RETURNING *;
 
@@ -97,6 +111,14 @@ SET
 WHERE id = $1
 RETURNING *;
 
+-- name: UpdateLeaseDepositReceived :one
+UPDATE leases
+SET
+    deposit_received_date = $2,
+    updated_at = $3
+WHERE id = $1
+RETURNING *;
+
 -- name: DeleteLease :exec
 DELETE FROM leases
 WHERE id = $1;
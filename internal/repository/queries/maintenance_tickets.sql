@@ -0,0 +1,105 @@
+-- name: CreateMaintenanceTicket :one
+INSERT INTO maintenance_tickets (
+    id,
+    unit_id,
+    lease_id,
+    tenant_id,
+    title,
+    description,
+    category,
+    priority,
+    status,
+    requires_vacancy,
+    previous_unit_status,
+    assigned_contractor,
+    contractor_phone,
+    estimated_cost,
+    actual_cost,
+    resolution_notes,
+    opened_by,
+    opened_at,
+    started_at,
+    resolved_at,
+    closed_at,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23
+) RETURNING *;
+
+-- name: GetMaintenanceTicketByID :one
+SELECT * FROM maintenance_tickets
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListMaintenanceTickets :many
+SELECT * FROM maintenance_tickets
+ORDER BY opened_at DESC;
+
+-- name: ListMaintenanceTicketsByPropertyID :many
+SELECT t.* FROM maintenance_tickets t
+INNER JOIN units u ON t.unit_id = u.id
+WHERE u.property_id = $1
+ORDER BY t.opened_at DESC;
+
+-- name: ListMaintenanceTicketsByUnitID :many
+SELECT * FROM maintenance_tickets
+WHERE unit_id = $1
+ORDER BY opened_at DESC;
+
+-- name: ListMaintenanceTicketsByTenantID :many
+SELECT * FROM maintenance_tickets
+WHERE tenant_id = $1
+ORDER BY opened_at DESC;
+
+-- name: ListMaintenanceTicketsByStatus :many
+SELECT * FROM maintenance_tickets
+WHERE status = $1
+ORDER BY opened_at ASC;
+
+-- name: UpdateMaintenanceTicket :one
+UPDATE maintenance_tickets
+SET
+    lease_id = $2,
+    tenant_id = $3,
+    title = $4,
+    description = $5,
+    category = $6,
+    priority = $7,
+    status = $8,
+    requires_vacancy = $9,
+    previous_unit_status = $10,
+    assigned_contractor = $11,
+    contractor_phone = $12,
+    estimated_cost = $13,
+    actual_cost = $14,
+    resolution_notes = $15,
+    started_at = $16,
+    resolved_at = $17,
+    closed_at = $18,
+    updated_at = $19
+WHERE id = $1
+RETURNING *;
+
+-- name: CountActiveVacancyTicketsByUnitID :one
+SELECT COUNT(*) FROM maintenance_tickets
+WHERE unit_id = $1
+  AND requires_vacancy = TRUE
+  AND status IN ('open', 'in_progress');
+
+-- name: CreateMaintenanceTicketPhoto :one
+INSERT INTO maintenance_ticket_photos (
+    id,
+    ticket_id,
+    url,
+    caption,
+    uploaded_by,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: ListMaintenanceTicketPhotosByTicketID :many
+SELECT * FROM maintenance_ticket_photos
+WHERE ticket_id = $1
+ORDER BY created_at ASC;
@@ -0,0 +1,81 @@
+-- name: CreateNotification :one
+INSERT INTO notifications (
+    id,
+    lease_id,
+    tenant_id,
+    payment_id,
+    type,
+    channel,
+    recipient,
+    subject,
+    message_content,
+    scheduled_date,
+    status,
+    attempts,
+    next_attempt_at,
+    last_error,
+    sent_date,
+    dedup_key,
+    created_at,
+    updated_at,
+    html_content
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19
+)
+ON CONFLICT (dedup_key) DO NOTHING
+RETURNING *;
+
+-- name: GetNotificationByID :one
+SELECT * FROM notifications
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetNotificationByProviderMessageID :one
+SELECT * FROM notifications
+WHERE provider_message_id = $1
+LIMIT 1;
+
+-- name: ListNotifications :many
+SELECT * FROM notifications
+ORDER BY scheduled_date DESC, created_at DESC;
+
+-- name: ListNotificationsByStatus :many
+SELECT * FROM notifications
+WHERE status = $1
+ORDER BY scheduled_date DESC, created_at DESC;
+
+-- name: ListNotificationsByLeaseID :many
+SELECT * FROM notifications
+WHERE lease_id = $1
+ORDER BY scheduled_date DESC, created_at DESC;
+
+-- Notificações enviadas ao morador ou vinculadas aos seus contratos
+-- name: ListNotificationsByTenantID :many
+SELECT * FROM notifications
+WHERE tenant_id = sqlc.arg(tenant_id)::UUID
+   OR lease_id IN (SELECT l.id FROM leases l WHERE l.tenant_id = sqlc.arg(tenant_id)::UUID)
+ORDER BY scheduled_date DESC, created_at DESC;
+
+-- name: ListDueNotifications :many
+SELECT * FROM notifications
+WHERE status = 'pending'
+  AND scheduled_date <= sqlc.arg(today)::DATE
+  AND (next_attempt_at IS NULL OR next_attempt_at <= sqlc.arg(now)::TIMESTAMP)
+ORDER BY scheduled_date ASC, created_at ASC
+LIMIT sqlc.arg(result_limit)::INTEGER;
+
+-- name: UpdateNotificationDelivery :one
+UPDATE notifications
+SET
+    status = $2,
+    attempts = $3,
+    next_attempt_at = $4,
+    last_error = $5,
+    sent_date = $6,
+    provider_message_id = $7,
+    bounced_at = $8,
+    bounce_reason = $9,
+    delivered_at = $10,
+    updated_at = $11
+WHERE id = $1
+RETURNING *;
@@ -36,6 +36,13 @@ SELECT * FROM payments
 WHERE status = $1
 ORDER BY due_date ASC;
 
+-- Pagamentos quitados a partir da data informada
+-- name: ListPaymentsPaidSince :many
+SELECT * FROM payments
+WHERE status = 'paid'
+  AND payment_date >= sqlc.arg(since)::DATE
+ORDER BY payment_date ASC;
+
 -- name: GetOverduePayments :many
 SELECT p.* FROM payments p
 INNER JOIN leases l ON p.lease_id = l.id
@@ -51,6 +58,47 @@ WHERE status = 'pending'
   AND due_date <= CURRENT_DATE + $1::INTEGER
 ORDER BY due_date ASC;
 
+-- name: GetOverduePaymentsByPropertyID :many
+SELECT p.* FROM payments p
+INNER JOIN leases l ON p.lease_id = l.id
+INNER JOIN units u ON l.unit_id = u.id
+WHERE p.status IN ('pending', 'overdue')
+  AND p.due_date < CURRENT_DATE
+  AND l.status IN ('active', 'expiring_soon')
+  AND u.property_id = $1
+ORDER BY p.due_date ASC;
+
+-- Pagamentos que já estavam vencidos na data informada e foram quitados a partir dela
+-- name: GetOverdueSettledSince :many
+SELECT p.* FROM payments p
+INNER JOIN leases l ON p.lease_id = l.id
+WHERE p.status = 'paid'
+  AND p.payment_date >= sqlc.arg(since)::DATE
+  AND p.due_date < sqlc.arg(since)::DATE
+  AND l.status IN ('active', 'expiring_soon')
+ORDER BY p.due_date ASC;
+
+-- name: GetOverdueSettledSinceByPropertyID :many
+SELECT p.* FROM payments p
+INNER JOIN leases l ON p.lease_id = l.id
+INNER JOIN units u ON l.unit_id = u.id
+WHERE p.status = 'paid'
+  AND p.payment_date >= sqlc.arg(since)::DATE
+  AND p.due_date < sqlc.arg(since)::DATE
+  AND l.status IN ('active', 'expiring_soon')
+  AND u.property_id = sqlc.arg(property_id)::UUID
+ORDER BY p.due_date ASC;
+
+-- name: GetUpcomingPaymentsByPropertyID :many
+SELECT p.* FROM payments p
+INNER JOIN leases l ON p.lease_id = l.id
+INNER JOIN units u ON l.unit_id = u.id
+WHERE p.status = 'pending'
+  AND p.due_date >= CURRENT_DATE
+  AND p.due_date <= CURRENT_DATE + sqlc.arg(days)::INTEGER
+  AND u.property_id = sqlc.arg(property_id)
+ORDER BY p.due_date ASC;
+
 -- name: UpdatePayment :one
 UPDATE payments
 SET
@@ -86,13 +134,14 @@ SET
 WHERE id = $1
 RETURNING *;
 
--- name: MarkPaymentsAsOverdue :exec
+-- name: MarkPaymentsAsOverdue :many
 UPDATE payments
 SET
     status = 'overdue',
     updated_at = NOW()
 WHERE status = 'pending'
-  AND due_date < CURRENT_DATE;
+  AND due_date < CURRENT_DATE
+RETURNING *;
 
 -- name: CancelPayment :one
 UPDATE payments
@@ -0,0 +1,67 @@
+-- name: CreateProperty :one
+INSERT INTO properties (
+    id,
+    name,
+    address_street,
+    address_number,
+    address_complement,
+    address_neighborhood,
+    address_city,
+    address_state,
+    address_zip_code,
+    bank_name,
+    bank_branch,
+    bank_account,
+    pix_key,
+    default_payment_due_day,
+    default_painting_fee,
+    notes,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18
+) RETURNING *;
+
+-- name: GetPropertyByID :one
+SELECT * FROM properties
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetPropertyByName :one
+SELECT * FROM properties
+WHERE name = $1
+LIMIT 1;
+
+-- name: ListProperties :many
+SELECT * FROM properties
+ORDER BY name ASC;
+
+-- name: UpdateProperty :one
+UPDATE properties
+SET
+    name = $2,
+    address_street = $3,
+    address_number = $4,
+    address_complement = $5,
+    address_neighborhood = $6,
+    address_city = $7,
+    address_state = $8,
+    address_zip_code = $9,
+    bank_name = $10,
+    bank_branch = $11,
+    bank_account = $12,
+    pix_key = $13,
+    default_payment_due_day = $14,
+    default_painting_fee = $15,
+    notes = $16,
+    updated_at = $17
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteProperty :exec
+DELETE FROM properties
+WHERE id = $1;
+
+-- name: CountUnitsByPropertyID :one
+SELECT COUNT(*) FROM units
+WHERE property_id = $1;
@@ -0,0 +1,111 @@
+-- name: CreateProspect :one
+INSERT INTO prospects (
+    id,
+    full_name,
+    phone,
+    email,
+    cpf,
+    source,
+    desired_move_in_date,
+    max_budget,
+    preferred_floor,
+    status,
+    lost_reason,
+    notes,
+    converted_tenant_id,
+    converted_at,
+    created_by,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
+) RETURNING *;
+
+-- name: GetProspectByID :one
+SELECT * FROM prospects
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListProspects :many
+SELECT * FROM prospects
+ORDER BY created_at DESC;
+
+-- name: ListProspectsByStatus :many
+SELECT * FROM prospects
+WHERE status = $1
+ORDER BY created_at DESC;
+
+-- name: ListOpenProspects :many
+SELECT * FROM prospects
+WHERE status IN ('new', 'visited', 'applied', 'approved')
+ORDER BY desired_move_in_date ASC NULLS LAST, created_at ASC;
+
+-- name: ListProspectsByConvertedTenantID :many
+SELECT * FROM prospects
+WHERE converted_tenant_id = $1
+ORDER BY created_at DESC;
+
+-- name: UpdateProspect :one
+UPDATE prospects
+SET
+    full_name = $2,
+    phone = $3,
+    email = $4,
+    cpf = $5,
+    source = $6,
+    desired_move_in_date = $7,
+    max_budget = $8,
+    preferred_floor = $9,
+    status = $10,
+    lost_reason = $11,
+    notes = $12,
+    converted_tenant_id = $13,
+    converted_at = $14,
+    updated_at = $15
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteProspect :exec
+DELETE FROM prospects
+WHERE id = $1;
+
+-- name: CreateProspectVisit :one
+INSERT INTO prospect_visits (
+    id,
+    prospect_id,
+    unit_id,
+    scheduled_at,
+    status,
+    notes,
+    created_by,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: GetProspectVisitByID :one
+SELECT * FROM prospect_visits
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListProspectVisitsByProspectID :many
+SELECT * FROM prospect_visits
+WHERE prospect_id = $1
+ORDER BY scheduled_at ASC;
+
+-- name: ListUpcomingProspectVisits :many
+SELECT * FROM prospect_visits
+WHERE status = 'scheduled' AND scheduled_at >= $1
+ORDER BY scheduled_at ASC;
+
+-- name: UpdateProspectVisit :one
+UPDATE prospect_visits
+SET
+    unit_id = $2,
+    scheduled_at = $3,
+    status = $4,
+    notes = $5,
+    updated_at = $6
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,104 @@
+-- name: CreateRenovationProject :one
+INSERT INTO renovation_projects (
+    id,
+    unit_id,
+    title,
+    description,
+    status,
+    planned_start_date,
+    planned_end_date,
+    budget,
+    previous_unit_status,
+    started_at,
+    completed_at,
+    cancelled_at,
+    notes,
+    created_by,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+) RETURNING *;
+
+-- name: GetRenovationProjectByID :one
+SELECT * FROM renovation_projects
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListRenovationProjects :many
+SELECT * FROM renovation_projects
+ORDER BY planned_start_date DESC;
+
+-- name: ListRenovationProjectsByUnitID :many
+SELECT * FROM renovation_projects
+WHERE unit_id = $1
+ORDER BY planned_start_date DESC;
+
+-- name: ListRenovationProjectsByPropertyID :many
+SELECT p.* FROM renovation_projects p
+INNER JOIN units u ON p.unit_id = u.id
+WHERE u.property_id = $1
+ORDER BY p.planned_start_date DESC;
+
+-- name: ListRenovationProjectsByStatus :many
+SELECT * FROM renovation_projects
+WHERE status = $1
+ORDER BY planned_start_date ASC;
+
+-- name: UpdateRenovationProject :one
+UPDATE renovation_projects
+SET
+    title = $2,
+    description = $3,
+    status = $4,
+    planned_start_date = $5,
+    planned_end_date = $6,
+    budget = $7,
+    previous_unit_status = $8,
+    started_at = $9,
+    completed_at = $10,
+    cancelled_at = $11,
+    notes = $12,
+    updated_at = $13
+WHERE id = $1
+RETURNING *;
+
+-- name: CountActiveRenovationProjectsByUnitID :one
+SELECT COUNT(*) FROM renovation_projects
+WHERE unit_id = $1
+  AND status IN ('planned', 'in_progress');
+
+-- name: CreateRenovationExpense :one
+INSERT INTO renovation_expenses (
+    id,
+    project_id,
+    description,
+    category,
+    amount,
+    expense_date,
+    supplier,
+    receipt_url,
+    created_by,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING *;
+
+-- name: GetRenovationExpenseByID :one
+SELECT * FROM renovation_expenses
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListRenovationExpensesByProjectID :many
+SELECT * FROM renovation_expenses
+WHERE project_id = $1
+ORDER BY expense_date ASC, created_at ASC;
+
+-- name: DeleteRenovationExpense :exec
+DELETE FROM renovation_expenses
+WHERE id = $1;
+
+-- name: GetTotalRenovationExpensesByProjectID :one
+SELECT COALESCE(SUM(amount), 0)::TEXT AS total
+FROM renovation_expenses
+WHERE project_id = $1;
@@ -0,0 +1,135 @@
+-- Filtros comuns do relatório financeiro: período pela data de competência
+-- (payment_date se pago, senão due_date), tipo, status e imóvel opcionais
+
+-- A caução é valor de terceiro guardado até a saída do morador: fica fora da receita e é
+-- somada à parte (apenas as recebidas) em security_deposit_amount
+
+-- name: GetFinancialReportTotals :one
+SELECT
+    COUNT(*) FILTER (WHERE p.payment_type <> 'security_deposit')::BIGINT as payment_count,
+    COALESCE(SUM(p.amount) FILTER (WHERE p.payment_type <> 'security_deposit'), 0)::TEXT as total_amount,
+    COALESCE(SUM(p.amount) FILTER (WHERE p.payment_type <> 'security_deposit' AND p.status = 'paid'), 0)::TEXT as paid_amount,
+    COALESCE(SUM(p.amount) FILTER (WHERE p.payment_type <> 'security_deposit' AND p.status = 'pending'), 0)::TEXT as pending_amount,
+    COALESCE(SUM(p.amount) FILTER (WHERE p.payment_type <> 'security_deposit' AND p.status = 'overdue'), 0)::TEXT as overdue_amount,
+    COALESCE(SUM(p.amount) FILTER (WHERE p.payment_type <> 'security_deposit' AND p.status = 'cancelled'), 0)::TEXT as cancelled_amount,
+    COALESCE(SUM(p.amount) FILTER (WHERE p.payment_type = 'security_deposit' AND p.status = 'paid'), 0)::TEXT as security_deposit_amount
+FROM payments p
+INNER JOIN leases l ON p.lease_id = l.id
+INNER JOIN units u ON l.unit_id = u.id
+WHERE (CASE WHEN p.status = 'paid' AND p.payment_date IS NOT NULL THEN p.payment_date ELSE p.due_date END)
+      BETWEEN sqlc.arg(start_date)::DATE AND sqlc.arg(end_date)::DATE
+  AND (sqlc.narg(payment_type)::TEXT IS NULL OR p.payment_type = sqlc.narg(payment_type)::TEXT)
+  AND (sqlc.narg(status)::TEXT IS NULL OR p.status = sqlc.narg(status)::TEXT)
+  AND (sqlc.narg(property_id)::UUID IS NULL OR u.property_id = sqlc.narg(property_id)::UUID);
+
+-- name: GetFinancialReportByType :many
+SELECT
+    p.payment_type,
+    COUNT(*)::BIGINT as payment_count,
+    COALESCE(SUM(p.amount), 0)::TEXT as total_amount
+FROM payments p
+INNER JOIN leases l ON p.lease_id = l.id
+INNER JOIN units u ON l.unit_id = u.id
+WHERE (CASE WHEN p.status = 'paid' AND p.payment_date IS NOT NULL THEN p.payment_date ELSE p.due_date END)
+      BETWEEN sqlc.arg(start_date)::DATE AND sqlc.arg(end_date)::DATE
+  AND (sqlc.narg(payment_type)::TEXT IS NULL OR p.payment_type = sqlc.narg(payment_type)::TEXT)
+  AND (sqlc.narg(status)::TEXT IS NULL OR p.status = sqlc.narg(status)::TEXT)
+  AND (sqlc.narg(property_id)::UUID IS NULL OR u.property_id = sqlc.narg(property_id)::UUID)
+  AND p.payment_type <> 'security_deposit'
+GROUP BY p.payment_type
+ORDER BY p.payment_type ASC;
+
+-- name: GetFinancialReportByMonth :many
+SELECT
+    DATE_TRUNC('month', CASE WHEN p.status = 'paid' AND p.payment_date IS NOT NULL THEN p.payment_date ELSE p.due_date END)::DATE as month,
+    COUNT(*)::BIGINT as payment_count,
+    COALESCE(SUM(p.amount), 0)::TEXT as total_amount
+FROM payments p
+INNER JOIN leases l ON p.lease_id = l.id
+INNER JOIN units u ON l.unit_id = u.id
+WHERE (CASE WHEN p.status = 'paid' AND p.payment_date IS NOT NULL THEN p.payment_date ELSE p.due_date END)
+      BETWEEN sqlc.arg(start_date)::DATE AND sqlc.arg(end_date)::DATE
+  AND (sqlc.narg(payment_type)::TEXT IS NULL OR p.payment_type = sqlc.narg(payment_type)::TEXT)
+  AND (sqlc.narg(status)::TEXT IS NULL OR p.status = sqlc.narg(status)::TEXT)
+  AND (sqlc.narg(property_id)::UUID IS NULL OR u.property_id = sqlc.narg(property_id)::UUID)
+  AND p.payment_type <> 'security_deposit'
+GROUP BY 1
+ORDER BY 1 ASC;
+
+-- name: GetFinancialReportByUnit :many
+SELECT
+    u.id as unit_id,
+    u.number as unit_number,
+    COUNT(*)::BIGINT as payment_count,
+    COALESCE(SUM(p.amount), 0)::TEXT as total_amount
+FROM payments p
+INNER JOIN leases l ON p.lease_id = l.id
+INNER JOIN units u ON l.unit_id = u.id
+WHERE (CASE WHEN p.status = 'paid' AND p.payment_date IS NOT NULL THEN p.payment_date ELSE p.due_date END)
+      BETWEEN sqlc.arg(start_date)::DATE AND sqlc.arg(end_date)::DATE
+  AND (sqlc.narg(payment_type)::TEXT IS NULL OR p.payment_type = sqlc.narg(payment_type)::TEXT)
+  AND (sqlc.narg(status)::TEXT IS NULL OR p.status = sqlc.narg(status)::TEXT)
+  AND (sqlc.narg(property_id)::UUID IS NULL OR u.property_id = sqlc.narg(property_id)::UUID)
+  AND p.payment_type <> 'security_deposit'
+GROUP BY u.id, u.number
+ORDER BY u.number ASC;
+
+-- name: GetFinancialReportByProperty :many
+SELECT
+    pr.id as property_id,
+    pr.name as property_name,
+    COUNT(*)::BIGINT as payment_count,
+    COALESCE(SUM(p.amount), 0)::TEXT as total_amount
+FROM payments p
+INNER JOIN leases l ON p.lease_id = l.id
+INNER JOIN units u ON l.unit_id = u.id
+INNER JOIN properties pr ON u.property_id = pr.id
+WHERE (CASE WHEN p.status = 'paid' AND p.payment_date IS NOT NULL THEN p.payment_date ELSE p.due_date END)
+      BETWEEN sqlc.arg(start_date)::DATE AND sqlc.arg(end_date)::DATE
+  AND (sqlc.narg(payment_type)::TEXT IS NULL OR p.payment_type = sqlc.narg(payment_type)::TEXT)
+  AND (sqlc.narg(status)::TEXT IS NULL OR p.status = sqlc.narg(status)::TEXT)
+  AND (sqlc.narg(property_id)::UUID IS NULL OR u.property_id = sqlc.narg(property_id)::UUID)
+  AND p.payment_type <> 'security_deposit'
+GROUP BY pr.id, pr.name
+ORDER BY pr.name ASC;
+
+-- name: ListPaymentHistory :many
+SELECT
+    p.id,
+    p.lease_id,
+    u.number as unit_number,
+    t.full_name as tenant_name,
+    p.payment_type,
+    p.amount,
+    p.status,
+    p.due_date,
+    p.payment_date,
+    p.payment_method,
+    p.created_at
+FROM payments p
+INNER JOIN leases l ON p.lease_id = l.id
+INNER JOIN units u ON l.unit_id = u.id
+INNER JOIN tenants t ON l.tenant_id = t.id
+WHERE (sqlc.narg(lease_id)::UUID IS NULL OR p.lease_id = sqlc.narg(lease_id)::UUID)
+  AND (sqlc.narg(tenant_id)::UUID IS NULL OR l.tenant_id = sqlc.narg(tenant_id)::UUID)
+  AND (sqlc.narg(property_id)::UUID IS NULL OR u.property_id = sqlc.narg(property_id)::UUID)
+  AND (sqlc.narg(status)::TEXT IS NULL OR p.status = sqlc.narg(status)::TEXT)
+  AND (sqlc.narg(start_date)::DATE IS NULL OR p.due_date >= sqlc.narg(start_date)::DATE)
+  AND (sqlc.narg(end_date)::DATE IS NULL OR p.due_date <= sqlc.narg(end_date)::DATE)
+ORDER BY p.due_date DESC, p.id ASC
+LIMIT sqlc.arg(result_limit)::INTEGER
+OFFSET sqlc.arg(result_offset)::INTEGER;
+
+-- name: GetPaymentHistoryTotals :one
+SELECT
+    COUNT(*)::BIGINT as payment_count,
+    COALESCE(SUM(p.amount), 0)::TEXT as total_amount
+FROM payments p
+INNER JOIN leases l ON p.lease_id = l.id
+INNER JOIN units u ON l.unit_id = u.id
+WHERE (sqlc.narg(lease_id)::UUID IS NULL OR p.lease_id = sqlc.narg(lease_id)::UUID)
+  AND (sqlc.narg(tenant_id)::UUID IS NULL OR l.tenant_id = sqlc.narg(tenant_id)::UUID)
+  AND (sqlc.narg(property_id)::UUID IS NULL OR u.property_id = sqlc.narg(property_id)::UUID)
+  AND (sqlc.narg(status)::TEXT IS NULL OR p.status = sqlc.narg(status)::TEXT)
+  AND (sqlc.narg(start_date)::DATE IS NULL OR p.due_date >= sqlc.narg(start_date)::DATE)
+  AND (sqlc.narg(end_date)::DATE IS NULL OR p.due_date <= sqlc.narg(end_date)::DATE);
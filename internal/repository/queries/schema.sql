@@ -8,9 +8,31 @@ CREATE TYPE unit_status AS ENUM (
     'renovation'
 );
 
+CREATE TABLE properties (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL UNIQUE,
+    address_street VARCHAR(255) NOT NULL,
+    address_number VARCHAR(20) NOT NULL,
+    address_complement VARCHAR(100),
+    address_neighborhood VARCHAR(100) NOT NULL,
+    address_city VARCHAR(100) NOT NULL,
+    address_state VARCHAR(2) NOT NULL,
+    address_zip_code VARCHAR(9) NOT NULL,
+    bank_name VARCHAR(100),
+    bank_branch VARCHAR(10),
+    bank_account VARCHAR(20),
+    pix_key VARCHAR(100),
+    default_payment_due_day INTEGER CHECK (default_payment_due_day BETWEEN 1 AND 31),
+    default_painting_fee DECIMAL(10,2) NOT NULL DEFAULT 250.00 CHECK (default_painting_fee >= 0),
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
 CREATE TABLE units (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
-    number VARCHAR(10) NOT NULL UNIQUE,
+    property_id UUID NOT NULL REFERENCES properties(id) ON DELETE RESTRICT,
+    number VARCHAR(10) NOT NULL,
     floor INTEGER NOT NULL CHECK (floor >= 1),
     status unit_status NOT NULL DEFAULT 'available',
     is_renovated BOOLEAN NOT NULL DEFAULT FALSE,
@@ -19,24 +41,34 @@ CREATE TABLE units (
     current_rent_value DECIMAL(10,2) NOT NULL CHECK (current_rent_value >= 0),
     notes TEXT,
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
-    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_units_property_number UNIQUE (property_id, number)
 );
 
 CREATE INDEX idx_units_status ON units(status);
 CREATE INDEX idx_units_floor ON units(floor);
 CREATE INDEX idx_units_is_renovated ON units(is_renovated);
+CREATE INDEX idx_units_property_id ON units(property_id);
 
 -- Tenants table
 CREATE TABLE tenants (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     full_name VARCHAR(255) NOT NULL,
-    cpf VARCHAR(14) NOT NULL UNIQUE,
+    cpf VARCHAR(18) NOT NULL UNIQUE,
     phone VARCHAR(20) NOT NULL,
     email VARCHAR(255),
     id_document_type VARCHAR(10),
     id_document_number VARCHAR(50),
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
-    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    employer_name VARCHAR(150),
+    employer_phone VARCHAR(20),
+    employer_job_title VARCHAR(100),
+    employer_monthly_income DECIMAL(10,2) CHECK (employer_monthly_income >= 0),
+    employer_start_date DATE,
+    anonymized_at TIMESTAMP,
+    anonymized_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    messaging_opt_out_at TIMESTAMP
 );
 
 CREATE INDEX idx_tenants_cpf ON tenants(cpf);
@@ -61,6 +93,10 @@ CREATE TABLE leases (
     generation INTEGER NOT NULL DEFAULT 1,
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    security_deposit DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (security_deposit >= 0),
+    deposit_received_date DATE,
+    deposit_held_at VARCHAR(20) CHECK (deposit_held_at IN ('savings_account', 'bank_account', 'cash', 'other')),
+    deposit_correction_index VARCHAR(20) CHECK (deposit_correction_index IN ('savings', 'ipca', 'igpm', 'none')),
     CONSTRAINT chk_dates CHECK (start_date < end_date),
     CONSTRAINT chk_painting_fee_paid CHECK (painting_fee_paid <= painting_fee_total)
 );
@@ -78,7 +114,7 @@ CREATE INDEX idx_leases_generation ON leases(generation);
 CREATE TABLE payments (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     lease_id UUID NOT NULL REFERENCES leases(id) ON DELETE RESTRICT,
-    payment_type VARCHAR(20) NOT NULL CHECK (payment_type IN ('rent', 'painting_fee', 'adjustment')),
+    payment_type VARCHAR(20) NOT NULL CHECK (payment_type IN ('rent', 'painting_fee', 'adjustment', 'utility', 'security_deposit')),
     reference_month DATE NOT NULL,
     amount DECIMAL(10,2) NOT NULL CHECK (amount > 0),
     status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'paid', 'overdue', 'cancelled')),
@@ -97,12 +133,15 @@ CREATE INDEX idx_payments_due_date ON payments(due_date);
 CREATE INDEX idx_payments_payment_type ON payments(payment_type);
 CREATE INDEX idx_payments_status_due_date ON payments(status, due_date);
 CREATE INDEX idx_payments_lease_status ON payments(lease_id, status);
+CREATE INDEX idx_payments_report_date ON payments ((CASE WHEN status = 'paid' AND payment_date IS NOT NULL THEN payment_date ELSE due_date END));
+CREATE INDEX idx_payments_lease_due_date ON payments(lease_id, due_date);
 
 -- User roles enum
 CREATE TYPE user_role AS ENUM (
     'admin',
     'manager',
-    'viewer'
+    'viewer',
+    'tenant'
 );
 
 -- Users table
@@ -114,7 +153,8 @@ CREATE TABLE users (
     is_active BOOLEAN NOT NULL DEFAULT TRUE,
     last_login_at TIMESTAMP,
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
-    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    tenant_id UUID UNIQUE REFERENCES tenants(id) ON DELETE CASCADE
 );
 
 CREATE INDEX idx_users_username ON users(username);
@@ -136,3 +176,466 @@ CREATE TABLE lease_rent_adjustments (
 
 CREATE INDEX idx_lease_rent_adjustments_lease_id ON lease_rent_adjustments(lease_id);
 CREATE INDEX idx_lease_rent_adjustments_applied_at ON lease_rent_adjustments(applied_at);
+
+-- Maintenance tickets table
+CREATE TABLE maintenance_tickets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE RESTRICT,
+    lease_id UUID REFERENCES leases(id) ON DELETE SET NULL,
+    tenant_id UUID REFERENCES tenants(id) ON DELETE SET NULL,
+    title VARCHAR(200) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    category VARCHAR(20) NOT NULL CHECK (category IN ('plumbing', 'electrical', 'appliance', 'structural', 'painting', 'pest_control', 'other')),
+    priority VARCHAR(10) NOT NULL CHECK (priority IN ('low', 'medium', 'high', 'urgent')),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('open', 'in_progress', 'resolved', 'closed', 'cancelled')),
+    requires_vacancy BOOLEAN NOT NULL DEFAULT FALSE,
+    previous_unit_status unit_status,
+    assigned_contractor VARCHAR(255),
+    contractor_phone VARCHAR(20),
+    estimated_cost DECIMAL(10,2) CHECK (estimated_cost >= 0),
+    actual_cost DECIMAL(10,2) CHECK (actual_cost >= 0),
+    resolution_notes TEXT,
+    opened_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    opened_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    started_at TIMESTAMP,
+    resolved_at TIMESTAMP,
+    closed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_maintenance_tickets_unit_id ON maintenance_tickets(unit_id);
+CREATE INDEX idx_maintenance_tickets_status ON maintenance_tickets(status);
+CREATE INDEX idx_maintenance_tickets_priority ON maintenance_tickets(priority);
+CREATE INDEX idx_maintenance_tickets_unit_status ON maintenance_tickets(unit_id, status);
+
+CREATE TABLE maintenance_ticket_photos (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    ticket_id UUID NOT NULL REFERENCES maintenance_tickets(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    caption TEXT,
+    uploaded_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_maintenance_ticket_photos_ticket_id ON maintenance_ticket_photos(ticket_id);
+
+CREATE TABLE unit_status_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE CASCADE,
+    from_status unit_status,
+    to_status unit_status NOT NULL,
+    reason VARCHAR(30) NOT NULL CHECK (reason IN ('initial', 'unit_created', 'manual', 'lease_created', 'lease_cancelled', 'lease_expired', 'maintenance_started', 'maintenance_finished', 'renovation_started', 'renovation_finished')),
+    reference_id UUID,
+    changed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_unit_status_history_unit_changed ON unit_status_history(unit_id, changed_at);
+CREATE INDEX idx_unit_status_history_changed_at ON unit_status_history(changed_at);
+
+CREATE TABLE renovation_projects (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE RESTRICT,
+    title VARCHAR(200) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL CHECK (status IN ('planned', 'in_progress', 'completed', 'cancelled')),
+    planned_start_date DATE NOT NULL,
+    planned_end_date DATE NOT NULL,
+    budget DECIMAL(10,2) NOT NULL CHECK (budget >= 0),
+    previous_unit_status unit_status,
+    started_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    cancelled_at TIMESTAMP,
+    notes TEXT,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_renovation_planned_dates CHECK (planned_start_date <= planned_end_date)
+);
+
+CREATE INDEX idx_renovation_projects_unit_id ON renovation_projects(unit_id);
+CREATE INDEX idx_renovation_projects_status ON renovation_projects(status);
+CREATE INDEX idx_renovation_projects_unit_status ON renovation_projects(unit_id, status);
+
+CREATE TABLE renovation_expenses (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES renovation_projects(id) ON DELETE CASCADE,
+    description VARCHAR(255) NOT NULL,
+    category VARCHAR(20) NOT NULL CHECK (category IN ('materials', 'labor', 'furniture', 'appliances', 'services', 'other')),
+    amount DECIMAL(10,2) NOT NULL CHECK (amount > 0),
+    expense_date DATE NOT NULL,
+    supplier VARCHAR(255),
+    receipt_url TEXT,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_renovation_expenses_project_id ON renovation_expenses(project_id);
+
+CREATE TABLE unit_inventory_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE CASCADE,
+    name VARCHAR(150) NOT NULL,
+    item_type VARCHAR(20) NOT NULL CHECK (item_type IN ('furniture', 'appliance', 'electronics', 'fixture', 'other')),
+    brand VARCHAR(100),
+    serial_number VARCHAR(100),
+    condition VARCHAR(20) NOT NULL CHECK (condition IN ('new', 'good', 'fair', 'damaged', 'broken')),
+    purchase_date DATE,
+    purchase_value DECIMAL(10,2) CHECK (purchase_value >= 0),
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_unit_inventory_items_unit_id ON unit_inventory_items(unit_id);
+
+CREATE TABLE inventory_checklists (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    lease_id UUID NOT NULL REFERENCES leases(id) ON DELETE CASCADE,
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE CASCADE,
+    checklist_type VARCHAR(10) NOT NULL CHECK (checklist_type IN ('move_in', 'move_out')),
+    notes TEXT,
+    performed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    performed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_inventory_checklists_lease_type UNIQUE (lease_id, checklist_type)
+);
+
+CREATE INDEX idx_inventory_checklists_lease_id ON inventory_checklists(lease_id);
+
+CREATE TABLE inventory_checklist_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    checklist_id UUID NOT NULL REFERENCES inventory_checklists(id) ON DELETE CASCADE,
+    item_id UUID REFERENCES unit_inventory_items(id) ON DELETE SET NULL,
+    item_name VARCHAR(150) NOT NULL,
+    item_type VARCHAR(20) NOT NULL,
+    brand VARCHAR(100),
+    serial_number VARCHAR(100),
+    condition VARCHAR(20) NOT NULL CHECK (condition IN ('new', 'good', 'fair', 'damaged', 'broken')),
+    present BOOLEAN NOT NULL DEFAULT TRUE,
+    replacement_value DECIMAL(10,2) CHECK (replacement_value >= 0),
+    notes TEXT
+);
+
+CREATE INDEX idx_inventory_checklist_items_checklist_id ON inventory_checklist_items(checklist_id);
+
+CREATE TABLE utility_tariffs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    utility_type VARCHAR(20) NOT NULL CHECK (utility_type IN ('water', 'electricity')),
+    price_per_unit DECIMAL(10,4) NOT NULL CHECK (price_per_unit > 0),
+    fixed_fee DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (fixed_fee >= 0),
+    effective_from DATE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_utility_tariffs_type_effective UNIQUE (utility_type, effective_from)
+);
+
+CREATE TABLE meter_readings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE CASCADE,
+    utility_type VARCHAR(20) NOT NULL CHECK (utility_type IN ('water', 'electricity')),
+    reference_month DATE NOT NULL,
+    reading DECIMAL(12,3) NOT NULL CHECK (reading >= 0),
+    reading_date DATE NOT NULL,
+    notes TEXT,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_meter_readings_unit_type_month UNIQUE (unit_id, utility_type, reference_month)
+);
+
+CREATE INDEX idx_meter_readings_unit_id ON meter_readings(unit_id);
+
+CREATE TABLE utility_charges (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    payment_id UUID NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+    lease_id UUID NOT NULL REFERENCES leases(id) ON DELETE CASCADE,
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE CASCADE,
+    utility_type VARCHAR(20) NOT NULL CHECK (utility_type IN ('water', 'electricity')),
+    reference_month DATE NOT NULL,
+    billing_method VARCHAR(20) NOT NULL CHECK (billing_method IN ('metered', 'split_occupants', 'split_equal')),
+
+    -- Cobrança por medidor
+    previous_reading DECIMAL(12,3),
+    current_reading DECIMAL(12,3),
+    consumption DECIMAL(12,3),
+    price_per_unit DECIMAL(10,4),
+    fixed_fee DECIMAL(10,2) NOT NULL DEFAULT 0,
+
+    -- Rateio da conta do prédio
+    building_total DECIMAL(10,2),
+    share_weight INTEGER,
+    total_shares INTEGER,
+
+    amount DECIMAL(10,2) NOT NULL CHECK (amount >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_utility_charges_lease_type_month UNIQUE (lease_id, utility_type, reference_month)
+);
+
+CREATE INDEX idx_utility_charges_payment_id ON utility_charges(payment_id);
+CREATE INDEX idx_utility_charges_lease_month ON utility_charges(lease_id, reference_month);
+
+CREATE TABLE lease_deposit_settlements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    lease_id UUID NOT NULL UNIQUE REFERENCES leases(id) ON DELETE CASCADE,
+
+    -- Valores do acerto
+    deposit_amount DECIMAL(10,2) NOT NULL CHECK (deposit_amount >= 0),
+    correction_rate DECIMAL(7,4) NOT NULL DEFAULT 0,
+    correction_amount DECIMAL(10,2) NOT NULL DEFAULT 0,
+    damage_deductions DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (damage_deductions >= 0),
+    debt_deductions DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (debt_deductions >= 0),
+    other_deductions DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (other_deductions >= 0),
+    refund_amount DECIMAL(10,2) NOT NULL CHECK (refund_amount >= 0),
+    balance_due DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (balance_due >= 0),
+
+    -- Devolução
+    refunded_at DATE,
+    refund_method VARCHAR(20),
+    notes TEXT,
+
+    -- Auditoria
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE payments
+    ADD COLUMN deposit_settlement_id UUID REFERENCES lease_deposit_settlements(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_payments_deposit_settlement_id ON payments(deposit_settlement_id);
+
+CREATE TABLE tenant_login_codes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash VARCHAR(255) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0 CHECK (attempts >= 0),
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_tenant_login_codes_user_id ON tenant_login_codes(user_id);
+
+CREATE TABLE tenant_documents (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    lease_id UUID REFERENCES leases(id) ON DELETE SET NULL,
+    document_type VARCHAR(30) NOT NULL CHECK (document_type IN ('id_rg', 'id_cnh', 'proof_of_income', 'signed_contract', 'move_in_inspection', 'other')),
+    expires_at DATE,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    checksum VARCHAR(64) NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    uploaded_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_tenant_documents_tenant_id ON tenant_documents(tenant_id);
+CREATE INDEX idx_tenant_documents_lease_id ON tenant_documents(lease_id);
+
+CREATE TABLE tenant_contacts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    contact_type VARCHAR(20) NOT NULL CHECK (contact_type IN ('emergency', 'reference')),
+    name VARCHAR(150) NOT NULL,
+    relationship VARCHAR(50) NOT NULL,
+    phone VARCHAR(20) NOT NULL,
+    email VARCHAR(255),
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_tenant_contacts_tenant_id ON tenant_contacts(tenant_id);
+
+CREATE TABLE tenant_occupants (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    full_name VARCHAR(255) NOT NULL,
+    relationship VARCHAR(50) NOT NULL,
+    document VARCHAR(50),
+    birth_date DATE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_tenant_occupants_tenant_id ON tenant_occupants(tenant_id);
+
+CREATE TABLE tenant_pets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    species VARCHAR(20) NOT NULL CHECK (species IN ('dog', 'cat', 'bird', 'fish', 'rodent', 'other')),
+    breed VARCHAR(100),
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_tenant_pets_tenant_id ON tenant_pets(tenant_id);
+
+CREATE TABLE tenant_data_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    request_type VARCHAR(20) NOT NULL CHECK (request_type IN ('export', 'anonymization')),
+    performed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    performed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    notes TEXT
+);
+
+CREATE INDEX idx_tenant_data_requests_tenant_id ON tenant_data_requests(tenant_id);
+
+CREATE TABLE prospects (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    full_name VARCHAR(255) NOT NULL,
+    phone VARCHAR(20) NOT NULL,
+    email VARCHAR(255),
+    cpf VARCHAR(18),
+    source VARCHAR(20) NOT NULL CHECK (source IN ('whatsapp', 'phone', 'walk_in', 'referral', 'website', 'other')),
+    desired_move_in_date DATE,
+    max_budget DECIMAL(10,2) CHECK (max_budget > 0),
+    preferred_floor INTEGER CHECK (preferred_floor >= 1),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('new', 'visited', 'applied', 'approved', 'converted', 'lost')),
+    lost_reason TEXT,
+    notes TEXT,
+    converted_tenant_id UUID REFERENCES tenants(id) ON DELETE SET NULL,
+    converted_at TIMESTAMP,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_prospects_status ON prospects(status);
+CREATE INDEX idx_prospects_desired_move_in_date ON prospects(desired_move_in_date);
+
+CREATE TABLE prospect_visits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    prospect_id UUID NOT NULL REFERENCES prospects(id) ON DELETE CASCADE,
+    unit_id UUID REFERENCES units(id) ON DELETE SET NULL,
+    scheduled_at TIMESTAMP NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('scheduled', 'completed', 'cancelled', 'no_show')),
+    notes TEXT,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_prospect_visits_prospect_id ON prospect_visits(prospect_id);
+CREATE INDEX idx_prospect_visits_scheduled_at ON prospect_visits(scheduled_at);
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX idx_tenants_full_name_fts ON tenants USING GIN (to_tsvector('simple', full_name));
+CREATE INDEX idx_tenants_full_name_trgm ON tenants USING GIN (full_name gin_trgm_ops);
+CREATE INDEX idx_tenants_cpf_digits_trgm ON tenants USING GIN ((regexp_replace(cpf, '[^0-9A-Za-z]', '', 'g')) gin_trgm_ops);
+CREATE INDEX idx_tenants_phone_digits_trgm ON tenants USING GIN ((regexp_replace(phone, '[^0-9]', '', 'g')) gin_trgm_ops);
+CREATE INDEX idx_tenants_email_trgm ON tenants USING GIN (email gin_trgm_ops);
+CREATE INDEX idx_units_number_trgm ON units USING GIN (number gin_trgm_ops);
+CREATE INDEX idx_payments_amount ON payments(amount);
+CREATE INDEX idx_payments_reference_month ON payments(reference_month);
+
+CREATE TABLE notifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    lease_id UUID REFERENCES leases(id) ON DELETE CASCADE,
+    tenant_id UUID REFERENCES tenants(id) ON DELETE CASCADE,
+    payment_id UUID REFERENCES payments(id) ON DELETE CASCADE,
+    type VARCHAR(50) NOT NULL CHECK (type IN ('rent_reminder', 'contract_expiring', 'overdue_notice', 'payment_receipt', 'formal_notice')),
+    channel VARCHAR(20) NOT NULL CHECK (channel IN ('internal', 'email', 'whatsapp', 'sms')),
+    recipient VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    message_content TEXT NOT NULL,
+    scheduled_date DATE NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'sent', 'delivered', 'failed', 'cancelled', 'bounced')),
+    attempts INTEGER NOT NULL DEFAULT 0 CHECK (attempts >= 0),
+    next_attempt_at TIMESTAMP,
+    last_error TEXT,
+    sent_date TIMESTAMP,
+    dedup_key VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    html_content TEXT,
+    provider_message_id VARCHAR(255),
+    bounced_at TIMESTAMP,
+    bounce_reason TEXT,
+    delivered_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX idx_notifications_dedup_key ON notifications(dedup_key);
+CREATE INDEX idx_notifications_status_scheduled_date ON notifications(status, scheduled_date);
+CREATE INDEX idx_notifications_lease_id ON notifications(lease_id);
+CREATE INDEX idx_notifications_provider_message_id ON notifications(provider_message_id);
+
+CREATE TABLE dunning_steps (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(50) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    offset_days INTEGER NOT NULL CHECK (offset_days BETWEEN -30 AND 365),
+    action VARCHAR(30) NOT NULL CHECK (action IN ('reminder', 'notice', 'formal_notice', 'eviction_review')),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE payment_collection_actions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    payment_id UUID NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+    step_id UUID NOT NULL REFERENCES dunning_steps(id) ON DELETE RESTRICT,
+    step_code VARCHAR(50) NOT NULL,
+    step_name VARCHAR(100) NOT NULL,
+    offset_days INTEGER NOT NULL,
+    action VARCHAR(30) NOT NULL CHECK (action IN ('reminder', 'notice', 'formal_notice', 'eviction_review')),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('executed', 'skipped')),
+    notifications_created INTEGER NOT NULL DEFAULT 0 CHECK (notifications_created >= 0),
+    notes TEXT,
+    executed_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (payment_id, step_id)
+);
+
+CREATE INDEX idx_payment_collection_actions_payment_id ON payment_collection_actions(payment_id);
+CREATE INDEX idx_payment_collection_actions_action ON payment_collection_actions(action);
+
+CREATE TABLE webhook_endpoints (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    url VARCHAR(500) NOT NULL,
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    secret VARCHAR(100) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE webhook_subscriptions (
+    endpoint_id UUID NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+    event_type VARCHAR(50) NOT NULL CHECK (event_type IN ('lease.created', 'lease.renewed', 'lease.cancelled', 'payment.paid', 'payment.overdue', 'unit.status_changed')),
+    PRIMARY KEY (endpoint_id, event_type)
+);
+
+CREATE INDEX idx_webhook_subscriptions_event_type ON webhook_subscriptions(event_type);
+
+CREATE TABLE webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    endpoint_id UUID NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'failed')),
+    attempts INTEGER NOT NULL DEFAULT 0 CHECK (attempts >= 0),
+    next_attempt_at TIMESTAMP,
+    last_error TEXT,
+    response_status INTEGER,
+    delivered_at TIMESTAMP,
+    replay_of UUID REFERENCES webhook_deliveries(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_webhook_deliveries_pending ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_endpoint_id ON webhook_deliveries(endpoint_id, created_at DESC);
+CREATE INDEX idx_webhook_deliveries_event_id ON webhook_deliveries(event_id);
+
+CREATE TABLE calendar_feeds (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    last_accessed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_payments_status_payment_date ON payments(status, payment_date);
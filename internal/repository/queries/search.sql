@@ -0,0 +1,100 @@
+-- name: SearchTenants :many
+SELECT
+    t.id,
+    t.full_name,
+    t.cpf,
+    t.phone,
+    t.email,
+    GREATEST(
+        similarity(t.full_name, sqlc.arg(query)::TEXT),
+        ts_rank(to_tsvector('simple', t.full_name), plainto_tsquery('simple', sqlc.arg(query)::TEXT)),
+        CASE WHEN sqlc.arg(digits)::TEXT <> '' AND regexp_replace(t.cpf, '[^0-9A-Za-z]', '', 'g') LIKE '%' || sqlc.arg(digits)::TEXT || '%' THEN 0.9 ELSE 0 END,
+        CASE WHEN sqlc.arg(digits)::TEXT <> '' AND regexp_replace(t.phone, '[^0-9]', '', 'g') LIKE '%' || sqlc.arg(digits)::TEXT || '%' THEN 0.8 ELSE 0 END,
+        CASE WHEN t.email ILIKE '%' || sqlc.arg(query)::TEXT || '%' THEN 0.7 ELSE 0 END
+    )::DOUBLE PRECISION AS rank
+FROM tenants t
+WHERE t.anonymized_at IS NULL
+  AND (
+    t.full_name % sqlc.arg(query)::TEXT
+    OR to_tsvector('simple', t.full_name) @@ plainto_tsquery('simple', sqlc.arg(query)::TEXT)
+    OR t.full_name ILIKE '%' || sqlc.arg(query)::TEXT || '%'
+    OR t.email ILIKE '%' || sqlc.arg(query)::TEXT || '%'
+    OR (sqlc.arg(digits)::TEXT <> '' AND regexp_replace(t.cpf, '[^0-9A-Za-z]', '', 'g') LIKE '%' || sqlc.arg(digits)::TEXT || '%')
+    OR (sqlc.arg(digits)::TEXT <> '' AND regexp_replace(t.phone, '[^0-9]', '', 'g') LIKE '%' || sqlc.arg(digits)::TEXT || '%')
+  )
+ORDER BY rank DESC, t.full_name ASC
+LIMIT sqlc.arg(result_limit)::INTEGER;
+
+-- name: SearchUnits :many
+SELECT
+    u.id,
+    u.number,
+    u.floor,
+    u.status,
+    p.name AS property_name,
+    (CASE WHEN LOWER(u.number) = LOWER(sqlc.arg(query)::TEXT) THEN 1 ELSE similarity(u.number, sqlc.arg(query)::TEXT) END)::DOUBLE PRECISION AS rank
+FROM units u
+INNER JOIN properties p ON u.property_id = p.id
+WHERE u.number ILIKE '%' || sqlc.arg(query)::TEXT || '%'
+   OR u.number % sqlc.arg(query)::TEXT
+ORDER BY rank DESC, u.number ASC
+LIMIT sqlc.arg(result_limit)::INTEGER;
+
+-- name: SearchLeases :many
+SELECT
+    l.id,
+    l.status,
+    l.start_date,
+    l.end_date,
+    t.full_name AS tenant_name,
+    u.number AS unit_number,
+    GREATEST(
+        similarity(t.full_name, sqlc.arg(query)::TEXT),
+        ts_rank(to_tsvector('simple', t.full_name), plainto_tsquery('simple', sqlc.arg(query)::TEXT)),
+        CASE WHEN LOWER(u.number) = LOWER(sqlc.arg(query)::TEXT) THEN 1 ELSE 0 END
+    )::DOUBLE PRECISION AS rank
+FROM leases l
+INNER JOIN tenants t ON l.tenant_id = t.id
+INNER JOIN units u ON l.unit_id = u.id
+WHERE t.full_name % sqlc.arg(query)::TEXT
+   OR to_tsvector('simple', t.full_name) @@ plainto_tsquery('simple', sqlc.arg(query)::TEXT)
+   OR t.full_name ILIKE '%' || sqlc.arg(query)::TEXT || '%'
+   OR LOWER(u.number) = LOWER(sqlc.arg(query)::TEXT)
+ORDER BY rank DESC, l.start_date DESC
+LIMIT sqlc.arg(result_limit)::INTEGER;
+
+-- name: SearchPaymentsByAmount :many
+SELECT
+    pay.id,
+    pay.payment_type,
+    pay.reference_month,
+    pay.amount,
+    pay.status,
+    pay.due_date,
+    t.full_name AS tenant_name,
+    u.number AS unit_number
+FROM payments pay
+INNER JOIN leases l ON pay.lease_id = l.id
+INNER JOIN tenants t ON l.tenant_id = t.id
+INNER JOIN units u ON l.unit_id = u.id
+WHERE pay.amount = sqlc.arg(amount)::DECIMAL
+ORDER BY pay.due_date DESC
+LIMIT sqlc.arg(result_limit)::INTEGER;
+
+-- name: SearchPaymentsByReferenceMonth :many
+SELECT
+    pay.id,
+    pay.payment_type,
+    pay.reference_month,
+    pay.amount,
+    pay.status,
+    pay.due_date,
+    t.full_name AS tenant_name,
+    u.number AS unit_number
+FROM payments pay
+INNER JOIN leases l ON pay.lease_id = l.id
+INNER JOIN tenants t ON l.tenant_id = t.id
+INNER JOIN units u ON l.unit_id = u.id
+WHERE pay.reference_month = sqlc.arg(reference_month)::DATE
+ORDER BY u.number ASC, pay.due_date ASC
+LIMIT sqlc.arg(result_limit)::INTEGER;
@@ -0,0 +1,36 @@
+-- name: CreateTenantDocument :one
+INSERT INTO tenant_documents (
+    id,
+    tenant_id,
+    lease_id,
+    document_type,
+    expires_at,
+    file_name,
+    content_type,
+    size_bytes,
+    checksum,
+    storage_key,
+    uploaded_by,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+) RETURNING *;
+
+-- name: GetTenantDocumentByID :one
+SELECT * FROM tenant_documents
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListTenantDocumentsByTenantID :many
+SELECT * FROM tenant_documents
+WHERE tenant_id = $1
+ORDER BY created_at DESC;
+
+-- name: ListTenantDocumentsByLeaseID :many
+SELECT * FROM tenant_documents
+WHERE lease_id = $1
+ORDER BY created_at DESC;
+
+-- name: DeleteTenantDocument :exec
+DELETE FROM tenant_documents
+WHERE id = $1;
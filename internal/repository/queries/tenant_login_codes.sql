@@ -0,0 +1,32 @@
+-- name: CreateTenantLoginCode :one
+INSERT INTO tenant_login_codes (
+    id,
+    user_id,
+    code_hash,
+    attempts,
+    expires_at,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetActiveTenantLoginCode :one
+SELECT * FROM tenant_login_codes
+WHERE user_id = $1
+  AND used_at IS NULL
+  AND expires_at > $2
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: UpdateTenantLoginCode :exec
+UPDATE tenant_login_codes
+SET
+    attempts = $2,
+    used_at = $3
+WHERE id = $1;
+
+-- name: InvalidateTenantLoginCodes :exec
+UPDATE tenant_login_codes
+SET used_at = $2
+WHERE user_id = $1
+  AND used_at IS NULL;
@@ -0,0 +1,89 @@
+-- name: AnonymizeTenant :one
+UPDATE tenants
+SET
+    full_name = $2,
+    cpf = $3,
+    phone = $4,
+    email = NULL,
+    id_document_type = NULL,
+    id_document_number = NULL,
+    employer_name = NULL,
+    employer_phone = NULL,
+    employer_job_title = NULL,
+    employer_monthly_income = NULL,
+    employer_start_date = NULL,
+    anonymized_at = $5,
+    anonymized_by = $6,
+    updated_at = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteAllTenantContacts :exec
+DELETE FROM tenant_contacts
+WHERE tenant_id = $1;
+
+-- name: DeleteTenantDocumentsByTenantID :exec
+DELETE FROM tenant_documents
+WHERE tenant_id = $1;
+
+-- name: DeleteUserByTenantID :exec
+DELETE FROM users
+WHERE tenant_id = $1;
+
+-- Remove destinatário e conteúdo das notificações do morador, dos seus contratos e pagamentos
+-- As pendentes são canceladas para não serem enviadas
+-- name: AnonymizeTenantNotifications :exec
+UPDATE notifications
+SET
+    recipient = sqlc.arg(placeholder)::TEXT,
+    subject = sqlc.arg(placeholder)::TEXT,
+    message_content = sqlc.arg(placeholder)::TEXT,
+    html_content = NULL,
+    last_error = NULL,
+    bounce_reason = NULL,
+    status = CASE WHEN status = 'pending' THEN 'cancelled' ELSE status END,
+    updated_at = sqlc.arg(updated_at)::TIMESTAMP
+WHERE tenant_id = sqlc.arg(tenant_id)::UUID
+   OR lease_id IN (SELECT l.id FROM leases l WHERE l.tenant_id = sqlc.arg(tenant_id)::UUID)
+   OR payment_id IN (
+       SELECT p.id FROM payments p
+       INNER JOIN leases l ON p.lease_id = l.id
+       WHERE l.tenant_id = sqlc.arg(tenant_id)::UUID
+   );
+
+-- Remove os dados pessoais do interessado convertido no morador e as observações das visitas
+-- name: AnonymizeConvertedProspects :exec
+UPDATE prospects
+SET
+    full_name = sqlc.arg(full_name)::TEXT,
+    phone = '',
+    email = NULL,
+    cpf = NULL,
+    lost_reason = NULL,
+    notes = NULL,
+    updated_at = sqlc.arg(updated_at)::TIMESTAMP
+WHERE converted_tenant_id = sqlc.arg(tenant_id)::UUID;
+
+-- name: ClearConvertedProspectVisitNotes :exec
+UPDATE prospect_visits
+SET
+    notes = NULL,
+    updated_at = sqlc.arg(updated_at)::TIMESTAMP
+WHERE prospect_id IN (SELECT p.id FROM prospects p WHERE p.converted_tenant_id = sqlc.arg(tenant_id)::UUID);
+
+-- name: CreateTenantDataRequest :one
+INSERT INTO tenant_data_requests (
+    id,
+    tenant_id,
+    request_type,
+    performed_by,
+    performed_at,
+    notes
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: ListTenantDataRequestsByTenantID :many
+SELECT * FROM tenant_data_requests
+WHERE tenant_id = $1
+ORDER BY performed_at DESC;
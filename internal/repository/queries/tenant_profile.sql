@@ -0,0 +1,67 @@
+-- name: CreateTenantContact :one
+INSERT INTO tenant_contacts (
+    id,
+    tenant_id,
+    contact_type,
+    name,
+    relationship,
+    phone,
+    email,
+    notes,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: ListTenantContactsByTenantID :many
+SELECT * FROM tenant_contacts
+WHERE tenant_id = $1
+ORDER BY contact_type ASC, created_at ASC;
+
+-- name: DeleteTenantContactsByType :exec
+DELETE FROM tenant_contacts
+WHERE tenant_id = $1 AND contact_type = $2;
+
+-- name: CreateTenantOccupant :one
+INSERT INTO tenant_occupants (
+    id,
+    tenant_id,
+    full_name,
+    relationship,
+    document,
+    birth_date,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: ListTenantOccupantsByTenantID :many
+SELECT * FROM tenant_occupants
+WHERE tenant_id = $1
+ORDER BY created_at ASC;
+
+-- name: DeleteTenantOccupantsByTenantID :exec
+DELETE FROM tenant_occupants
+WHERE tenant_id = $1;
+
+-- name: CreateTenantPet :one
+INSERT INTO tenant_pets (
+    id,
+    tenant_id,
+    name,
+    species,
+    breed,
+    notes,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: ListTenantPetsByTenantID :many
+SELECT * FROM tenant_pets
+WHERE tenant_id = $1
+ORDER BY created_at ASC;
+
+-- name: DeleteTenantPetsByTenantID :exec
+DELETE FROM tenant_pets
+WHERE tenant_id = $1;
@@ -27,6 +27,15 @@ LIMIT 1;
 SELECT * FROM tenants
 ORDER BY full_name ASC;
 
+-- name: ListTenantsByPropertyID :many
+SELECT * FROM tenants
+WHERE id IN (
+    SELECT l.tenant_id FROM leases l
+    INNER JOIN units u ON l.unit_id = u.id
+    WHERE u.property_id = $1
+)
+ORDER BY full_name ASC;
+
 -- name: SearchTenantsByName :many
 SELECT * FROM tenants
 WHERE full_name ILIKE '%' || $1 || '%'
@@ -40,7 +49,13 @@ SET
     email = $4,
     id_document_type = $5,
     id_document_number = $6,
-    updated_at = $7
+    employer_name = $7,
+    employer_phone = $8,
+    employer_job_title = $9,
+    employer_monthly_income = $10,
+    employer_start_date = $11,
+    messaging_opt_out_at = $12,
+    updated_at = $13
 WHERE id = $1
 RETURNING *;
 
@@ -0,0 +1,87 @@
+-- name: CreateUnitInventoryItem :one
+INSERT INTO unit_inventory_items (
+    id,
+    unit_id,
+    name,
+    item_type,
+    brand,
+    serial_number,
+    condition,
+    purchase_date,
+    purchase_value,
+    notes,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+) RETURNING *;
+
+-- name: GetUnitInventoryItemByID :one
+SELECT * FROM unit_inventory_items
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListUnitInventoryItemsByUnitID :many
+SELECT * FROM unit_inventory_items
+WHERE unit_id = $1
+ORDER BY item_type ASC, name ASC;
+
+-- name: UpdateUnitInventoryItem :one
+UPDATE unit_inventory_items
+SET
+    name = $2,
+    item_type = $3,
+    brand = $4,
+    serial_number = $5,
+    condition = $6,
+    purchase_date = $7,
+    purchase_value = $8,
+    notes = $9,
+    updated_at = $10
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteUnitInventoryItem :exec
+DELETE FROM unit_inventory_items
+WHERE id = $1;
+
+-- name: CreateInventoryChecklist :one
+INSERT INTO inventory_checklists (
+    id,
+    lease_id,
+    unit_id,
+    checklist_type,
+    notes,
+    performed_by,
+    performed_at,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: CreateInventoryChecklistItem :one
+INSERT INTO inventory_checklist_items (
+    id,
+    checklist_id,
+    item_id,
+    item_name,
+    item_type,
+    brand,
+    serial_number,
+    condition,
+    present,
+    replacement_value,
+    notes
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+) RETURNING *;
+
+-- name: GetInventoryChecklistByLeaseAndType :one
+SELECT * FROM inventory_checklists
+WHERE lease_id = $1 AND checklist_type = $2
+LIMIT 1;
+
+-- name: ListInventoryChecklistItemsByChecklistID :many
+SELECT * FROM inventory_checklist_items
+WHERE checklist_id = $1
+ORDER BY item_type ASC, item_name ASC;
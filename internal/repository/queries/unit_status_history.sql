@@ -0,0 +1,29 @@
+-- name: CreateUnitStatusChange :one
+INSERT INTO unit_status_history (
+    id,
+    unit_id,
+    from_status,
+    to_status,
+    reason,
+    reference_id,
+    changed_at,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: ListUnitStatusChangesByUnitID :many
+SELECT * FROM unit_status_history
+WHERE unit_id = $1
+ORDER BY changed_at ASC;
+
+-- name: GetLatestUnitStatusChangeByUnitID :one
+SELECT * FROM unit_status_history
+WHERE unit_id = $1
+ORDER BY changed_at DESC
+LIMIT 1;
+
+-- name: ListUnitStatusChangesUntil :many
+SELECT * FROM unit_status_history
+WHERE changed_at < $1
+ORDER BY unit_id, changed_at ASC;
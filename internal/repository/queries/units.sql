@@ -1,6 +1,7 @@
 -- name: CreateUnit :one
 INSERT INTO units (
     id,
+    property_id,
     number,
     floor,
     status,
@@ -12,7 +13,7 @@ INSERT INTO units (
     created_at,
     updated_at
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 ) RETURNING *;
 
 -- name: GetUnitByID :one
@@ -22,13 +23,18 @@ LIMIT 1;
 
 -- name: GetUnitByNumber :one
 SELECT * FROM units
-WHERE number = $1
+WHERE property_id = $1 AND number = $2
 LIMIT 1;
 
 -- name: ListUnits :many
 SELECT * FROM units
 ORDER BY floor ASC, number ASC;
 
+-- name: ListUnitsByPropertyID :many
+SELECT * FROM units
+WHERE property_id = $1
+ORDER BY floor ASC, number ASC;
+
 -- name: ListUnitsByStatus :many
 SELECT * FROM units
 WHERE status = $1
@@ -6,9 +6,10 @@ INSERT INTO users (
     role,
     is_active,
     created_at,
-    updated_at
+    updated_at,
+    tenant_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
+    $1, $2, $3, $4, $5, $6, $7, $8
 ) RETURNING *;
 
 -- name: GetUserByID :one
@@ -21,6 +22,11 @@ SELECT * FROM users
 WHERE username = $1
 LIMIT 1;
 
+-- name: GetUserByTenantID :one
+SELECT * FROM users
+WHERE tenant_id = $1
+LIMIT 1;
+
 -- name: ListUsers :many
 SELECT * FROM users
 ORDER BY created_at DESC;
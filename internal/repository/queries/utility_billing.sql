@@ -0,0 +1,99 @@
+-- name: CreateUtilityTariff :one
+INSERT INTO utility_tariffs (
+    id,
+    utility_type,
+    price_per_unit,
+    fixed_fee,
+    effective_from,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: ListUtilityTariffs :many
+SELECT * FROM utility_tariffs
+ORDER BY utility_type ASC, effective_from DESC;
+
+-- name: GetEffectiveUtilityTariff :one
+SELECT * FROM utility_tariffs
+WHERE utility_type = sqlc.arg(utility_type)
+  AND effective_from <= sqlc.arg(reference_date)
+ORDER BY effective_from DESC
+LIMIT 1;
+
+-- name: CreateMeterReading :one
+INSERT INTO meter_readings (
+    id,
+    unit_id,
+    utility_type,
+    reference_month,
+    reading,
+    reading_date,
+    notes,
+    created_by,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING *;
+
+-- name: GetMeterReadingByID :one
+SELECT * FROM meter_readings
+WHERE id = $1
+LIMIT 1;
+
+-- name: GetMeterReading :one
+SELECT * FROM meter_readings
+WHERE unit_id = $1
+  AND utility_type = $2
+  AND reference_month = $3
+LIMIT 1;
+
+-- name: GetPreviousMeterReading :one
+SELECT * FROM meter_readings
+WHERE unit_id = $1
+  AND utility_type = $2
+  AND reference_month < $3
+ORDER BY reference_month DESC
+LIMIT 1;
+
+-- name: ListMeterReadingsByUnitID :many
+SELECT * FROM meter_readings
+WHERE unit_id = $1
+ORDER BY reference_month DESC, utility_type ASC;
+
+-- name: DeleteMeterReading :exec
+DELETE FROM meter_readings
+WHERE id = $1;
+
+-- name: CreateUtilityCharge :one
+INSERT INTO utility_charges (
+    id,
+    payment_id,
+    lease_id,
+    unit_id,
+    utility_type,
+    reference_month,
+    billing_method,
+    previous_reading,
+    current_reading,
+    consumption,
+    price_per_unit,
+    fixed_fee,
+    building_total,
+    share_weight,
+    total_shares,
+    amount,
+    created_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17
+) RETURNING *;
+
+-- name: ListUtilityChargesByPaymentID :many
+SELECT * FROM utility_charges
+WHERE payment_id = $1
+ORDER BY utility_type ASC;
+
+-- name: CountUtilityChargesByLeaseAndMonth :one
+SELECT COUNT(*) FROM utility_charges
+WHERE lease_id = $1
+  AND reference_month = $2;
@@ -0,0 +1,111 @@
+-- name: CreateWebhookEndpoint :one
+INSERT INTO webhook_endpoints (
+    id,
+    url,
+    description,
+    secret,
+    active,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetWebhookEndpointByID :one
+SELECT * FROM webhook_endpoints
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListWebhookEndpoints :many
+SELECT * FROM webhook_endpoints
+ORDER BY created_at ASC;
+
+-- name: ListActiveWebhookEndpointsByEvent :many
+SELECT e.* FROM webhook_endpoints e
+INNER JOIN webhook_subscriptions s ON s.endpoint_id = e.id
+WHERE s.event_type = $1
+  AND e.active = TRUE
+ORDER BY e.created_at ASC;
+
+-- name: UpdateWebhookEndpoint :one
+UPDATE webhook_endpoints
+SET url = $2,
+    description = $3,
+    secret = $4,
+    active = $5,
+    updated_at = $6
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteWebhookEndpoint :exec
+DELETE FROM webhook_endpoints
+WHERE id = $1;
+
+-- name: CreateWebhookSubscription :exec
+INSERT INTO webhook_subscriptions (
+    endpoint_id,
+    event_type
+) VALUES (
+    $1, $2
+);
+
+-- name: DeleteWebhookSubscriptionsByEndpointID :exec
+DELETE FROM webhook_subscriptions
+WHERE endpoint_id = $1;
+
+-- name: ListWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+ORDER BY endpoint_id, event_type;
+
+-- name: ListWebhookSubscriptionsByEndpointID :many
+SELECT * FROM webhook_subscriptions
+WHERE endpoint_id = $1
+ORDER BY event_type;
+
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (
+    id,
+    endpoint_id,
+    event_id,
+    event_type,
+    payload,
+    status,
+    attempts,
+    next_attempt_at,
+    replay_of,
+    created_at,
+    updated_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+) RETURNING *;
+
+-- name: GetWebhookDeliveryByID :one
+SELECT * FROM webhook_deliveries
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListWebhookDeliveriesByEndpointID :many
+SELECT * FROM webhook_deliveries
+WHERE endpoint_id = sqlc.arg(endpoint_id)
+ORDER BY created_at DESC
+LIMIT sqlc.arg(result_limit)::INTEGER;
+
+-- name: ListDueWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE status = 'pending'
+  AND (next_attempt_at IS NULL OR next_attempt_at <= sqlc.arg(now)::TIMESTAMP)
+ORDER BY created_at ASC
+LIMIT sqlc.arg(result_limit)::INTEGER;
+
+-- name: UpdateWebhookDeliveryAttempt :one
+UPDATE webhook_deliveries
+SET
+    status = $2,
+    attempts = $3,
+    next_attempt_at = $4,
+    last_error = $5,
+    response_status = $6,
+    delivered_at = $7,
+    updated_at = $8
+WHERE id = $1
+RETURNING *;
@@ -7,8 +7,80 @@ package sqlc
 
 import (
 	"context"
+
+	"github.com/google/uuid"
 )
 
+const getFinancialMetricsByProperty = `-- name: GetFinancialMetricsByProperty :many
+SELECT
+    p.id as property_id,
+    COALESCE((
+        SELECT SUM(l.monthly_rent_value) FROM leases l
+        INNER JOIN units u ON l.unit_id = u.id
+        WHERE u.property_id = p.id AND l.status = 'active'
+    ), 0)::TEXT as projected_revenue,
+    COALESCE((
+        SELECT SUM(pay.amount) FROM payments pay
+        INNER JOIN leases l ON pay.lease_id = l.id
+        INNER JOIN units u ON l.unit_id = u.id
+        WHERE u.property_id = p.id
+          AND pay.status = 'paid'
+          AND pay.payment_type = 'rent'
+          AND DATE_TRUNC('month', pay.payment_date) = DATE_TRUNC('month', CURRENT_DATE)
+    ), 0)::TEXT as realized_revenue,
+    COALESCE((
+        SELECT SUM(pay.amount) FROM payments pay
+        INNER JOIN leases l ON pay.lease_id = l.id
+        INNER JOIN units u ON l.unit_id = u.id
+        WHERE u.property_id = p.id AND pay.status = 'overdue'
+    ), 0)::TEXT as overdue_amount,
+    COALESCE((
+        SELECT SUM(pay.amount) FROM payments pay
+        INNER JOIN leases l ON pay.lease_id = l.id
+        INNER JOIN units u ON l.unit_id = u.id
+        WHERE u.property_id = p.id AND pay.status IN ('pending', 'overdue')
+    ), 0)::TEXT as pending_amount
+FROM properties p
+ORDER BY p.name ASC
+`
+
+type GetFinancialMetricsByPropertyRow struct {
+	PropertyID       uuid.UUID `json:"property_id"`
+	ProjectedRevenue string    `json:"projected_revenue"`
+	RealizedRevenue  string    `json:"realized_revenue"`
+	OverdueAmount    string    `json:"overdue_amount"`
+	PendingAmount    string    `json:"pending_amount"`
+}
+
+func (q *Queries) GetFinancialMetricsByProperty(ctx context.Context) ([]GetFinancialMetricsByPropertyRow, error) {
+	rows, err := q.db.QueryContext(ctx, getFinancialMetricsByProperty)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetFinancialMetricsByPropertyRow{}
+	for rows.Next() {
+		var i GetFinancialMetricsByPropertyRow
+		if err := rows.Scan(
+			&i.PropertyID,
+			&i.ProjectedRevenue,
+			&i.RealizedRevenue,
+			&i.OverdueAmount,
+			&i.PendingAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getMonthlyProjectedRevenue = `-- name: GetMonthlyProjectedRevenue :one
 SELECT COALESCE(SUM(monthly_rent_value), 0)::TEXT as total
 FROM leases
@@ -68,6 +140,62 @@ func (q *Queries) GetOccupancyMetrics(ctx context.Context) (GetOccupancyMetricsR
 	return i, err
 }
 
+const getOccupancyMetricsByProperty = `-- name: GetOccupancyMetricsByProperty :many
+SELECT
+    p.id as property_id,
+    p.name as property_name,
+    COUNT(u.id)::BIGINT as total_units,
+    COUNT(u.id) FILTER (WHERE u.status = 'occupied')::BIGINT as occupied_units,
+    COUNT(u.id) FILTER (WHERE u.status = 'available')::BIGINT as available_units,
+    COUNT(u.id) FILTER (WHERE u.status = 'maintenance')::BIGINT as maintenance_units,
+    COUNT(u.id) FILTER (WHERE u.status = 'renovation')::BIGINT as renovation_units
+FROM properties p
+LEFT JOIN units u ON u.property_id = p.id
+GROUP BY p.id, p.name
+ORDER BY p.name ASC
+`
+
+type GetOccupancyMetricsByPropertyRow struct {
+	PropertyID       uuid.UUID `json:"property_id"`
+	PropertyName     string    `json:"property_name"`
+	TotalUnits       int64     `json:"total_units"`
+	OccupiedUnits    int64     `json:"occupied_units"`
+	AvailableUnits   int64     `json:"available_units"`
+	MaintenanceUnits int64     `json:"maintenance_units"`
+	RenovationUnits  int64     `json:"renovation_units"`
+}
+
+func (q *Queries) GetOccupancyMetricsByProperty(ctx context.Context) ([]GetOccupancyMetricsByPropertyRow, error) {
+	rows, err := q.db.QueryContext(ctx, getOccupancyMetricsByProperty)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetOccupancyMetricsByPropertyRow{}
+	for rows.Next() {
+		var i GetOccupancyMetricsByPropertyRow
+		if err := rows.Scan(
+			&i.PropertyID,
+			&i.PropertyName,
+			&i.TotalUnits,
+			&i.OccupiedUnits,
+			&i.AvailableUnits,
+			&i.MaintenanceUnits,
+			&i.RenovationUnits,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getOverdueAmount = `-- name: GetOverdueAmount :one
 SELECT COALESCE(SUM(amount), 0)::TEXT as total
 FROM payments
@@ -7,6 +7,7 @@ package sqlc
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
@@ -52,29 +53,37 @@ INSERT INTO leases (
     parent_lease_id,
     generation,
     created_at,
-    updated_at
+    updated_at,
+    security_deposit,
+    deposit_received_date,
+    deposit_held_at,
+    deposit_correction_index
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
-) RETURNING id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20
+) RETURNING id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at, security_deposit, deposit_received_date, deposit_held_at, deposit_correction_index
 `
 
 type CreateLeaseParams struct {
-	ID                      uuid.UUID     `json:"id"`
-	UnitID                  uuid.UUID     `json:"unit_id"`
-	TenantID                uuid.UUID     `json:"tenant_id"`
-	ContractSignedDate      time.Time     `json:"contract_signed_date"`
-	StartDate               time.Time     `json:"start_date"`
-	EndDate                 time.Time     `json:"end_date"`
-	PaymentDueDay           int32         `json:"payment_due_day"`
-	MonthlyRentValue        string        `json:"monthly_rent_value"`
-	PaintingFeeTotal        string        `json:"painting_fee_total"`
-	PaintingFeeInstallments int32         `json:"painting_fee_installments"`
-	PaintingFeePaid         string        `json:"painting_fee_paid"`
-	Status                  string        `json:"status"`
-	ParentLeaseID           uuid.NullUUID `json:"parent_lease_id"`
-	Generation              int32         `json:"generation"`
-	CreatedAt               time.Time     `json:"created_at"`
-	UpdatedAt               time.Time     `json:"updated_at"`
+	ID                      uuid.UUID      `json:"id"`
+	UnitID                  uuid.UUID      `json:"unit_id"`
+	TenantID                uuid.UUID      `json:"tenant_id"`
+	ContractSignedDate      time.Time      `json:"contract_signed_date"`
+	StartDate               time.Time      `json:"start_date"`
+	EndDate                 time.Time      `json:"end_date"`
+	PaymentDueDay           int32          `json:"payment_due_day"`
+	MonthlyRentValue        string         `json:"monthly_rent_value"`
+	PaintingFeeTotal        string         `json:"painting_fee_total"`
+	PaintingFeeInstallments int32          `json:"painting_fee_installments"`
+	PaintingFeePaid         string         `json:"painting_fee_paid"`
+	Status                  string         `json:"status"`
+	ParentLeaseID           uuid.NullUUID  `json:"parent_lease_id"`
+	Generation              int32          `json:"generation"`
+	CreatedAt               time.Time      `json:"created_at"`
+	UpdatedAt               time.Time      `json:"updated_at"`
+	SecurityDeposit         string         `json:"security_deposit"`
+	DepositReceivedDate     sql.NullTime   `json:"deposit_received_date"`
+	DepositHeldAt           sql.NullString `json:"deposit_held_at"`
+	DepositCorrectionIndex  sql.NullString `json:"deposit_correction_index"`
 }
 
 func (q *Queries) CreateLease(ctx context.Context, arg CreateLeaseParams) (Lease, error) {
@@ -95,6 +104,10 @@ func (q *Queries) CreateLease(ctx context.Context, arg CreateLeaseParams) (Lease
 		arg.Generation,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.SecurityDeposit,
+		arg.DepositReceivedDate,
+		arg.DepositHeldAt,
+		arg.DepositCorrectionIndex,
 	)
 	var i Lease
 	err := row.Scan(
@@ -114,6 +127,10 @@ func (q *Queries) CreateLease(ctx context.Context, arg CreateLeaseParams) (Lease
 		&i.Generation,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SecurityDeposit,
+		&i.DepositReceivedDate,
+		&i.DepositHeldAt,
+		&i.DepositCorrectionIndex,
 	)
 	return i, err
 }
@@ -129,7 +146,7 @@ func (q *Queries) DeleteLease(ctx context.Context, id uuid.UUID) error {
 }
 
 const getActiveLeaseByTenantID = `-- name: GetActiveLeaseByTenantID :one
-SELECT id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at FROM leases
+SELECT id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at, security_deposit, deposit_received_date, deposit_held_at, deposit_correction_index FROM leases
 WHERE tenant_id = $1 AND status = 'active'
 LIMIT 1
 `
@@ -154,12 +171,16 @@ func (q *Queries) GetActiveLeaseByTenantID(ctx context.Context, tenantID uuid.UU
 		&i.Generation,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SecurityDeposit,
+		&i.DepositReceivedDate,
+		&i.DepositHeldAt,
+		&i.DepositCorrectionIndex,
 	)
 	return i, err
 }
 
 const getActiveLeaseByUnitID = `-- name: GetActiveLeaseByUnitID :one
-SELECT id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at FROM leases
+SELECT id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at, security_deposit, deposit_received_date, deposit_held_at, deposit_correction_index FROM leases
 WHERE unit_id = $1 AND status = 'active'
 LIMIT 1
 `
@@ -184,12 +205,16 @@ func (q *Queries) GetActiveLeaseByUnitID(ctx context.Context, unitID uuid.UUID)
 		&i.Generation,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SecurityDeposit,
+		&i.DepositReceivedDate,
+		&i.DepositHeldAt,
+		&i.DepositCorrectionIndex,
 	)
 	return i, err
 }
 
 const getExpiringSoonLeases = `-- name: GetExpiringSoonLeases :many
-SELECT id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at FROM leases
+SELECT id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at, security_deposit, deposit_received_date, deposit_held_at, deposit_correction_index FROM leases
 WHERE status = 'active' 
   AND end_date <= CURRENT_DATE + INTERVAL '45 days'
   AND end_date > CURRENT_DATE
@@ -222,6 +247,10 @@ func (q *Queries) GetExpiringSoonLeases(ctx context.Context) ([]Lease, error) {
 			&i.Generation,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SecurityDeposit,
+			&i.DepositReceivedDate,
+			&i.DepositHeldAt,
+			&i.DepositCorrectionIndex,
 		); err != nil {
 			return nil, err
 		}
@@ -237,7 +266,7 @@ func (q *Queries) GetExpiringSoonLeases(ctx context.Context) ([]Lease, error) {
 }
 
 const getLeaseByID = `-- name: GetLeaseByID :one
-SELECT id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at FROM leases
+SELECT id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at, security_deposit, deposit_received_date, deposit_held_at, deposit_correction_index FROM leases
 WHERE id = $1
 LIMIT 1
 `
@@ -262,13 +291,17 @@ func (q *Queries) GetLeaseByID(ctx context.Context, id uuid.UUID) (Lease, error)
 		&i.Generation,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SecurityDeposit,
+		&i.DepositReceivedDate,
+		&i.DepositHeldAt,
+		&i.DepositCorrectionIndex,
 	)
 	return i, err
 }
 
 const getLeaseWithDetails = `-- name: GetLeaseWithDetails :one
 SELECT 
-    l.id, l.unit_id, l.tenant_id, l.contract_signed_date, l.start_date, l.end_date, l.payment_due_day, l.monthly_rent_value, l.painting_fee_total, l.painting_fee_installments, l.painting_fee_paid, l.status, l.parent_lease_id, l.generation, l.created_at, l.updated_at,
+    l.id, l.unit_id, l.tenant_id, l.contract_signed_date, l.start_date, l.end_date, l.payment_due_day, l.monthly_rent_value, l.painting_fee_total, l.painting_fee_installments, l.painting_fee_paid, l.status, l.parent_lease_id, l.generation, l.created_at, l.updated_at, l.security_deposit, l.deposit_received_date, l.deposit_held_at, l.deposit_correction_index,
     u.number as unit_number,
     u.floor as unit_floor,
     t.full_name as tenant_name,
@@ -282,27 +315,31 @@ LIMIT 1
 `
 
 type GetLeaseWithDetailsRow struct {
-	ID                      uuid.UUID     `json:"id"`
-	UnitID                  uuid.UUID     `json:"unit_id"`
-	TenantID                uuid.UUID     `json:"tenant_id"`
-	ContractSignedDate      time.Time     `json:"contract_signed_date"`
-	StartDate               time.Time     `json:"start_date"`
-	EndDate                 time.Time     `json:"end_date"`
-	PaymentDueDay           int32         `json:"payment_due_day"`
-	MonthlyRentValue        string        `json:"monthly_rent_value"`
-	PaintingFeeTotal        string        `json:"painting_fee_total"`
-	PaintingFeeInstallments int32         `json:"painting_fee_installments"`
-	PaintingFeePaid         string        `json:"painting_fee_paid"`
-	Status                  string        `json:"status"`
-	ParentLeaseID           uuid.NullUUID `json:"parent_lease_id"`
-	Generation              int32         `json:"generation"`
-	CreatedAt               time.Time     `json:"created_at"`
-	UpdatedAt               time.Time     `json:"updated_at"`
-	UnitNumber              string        `json:"unit_number"`
-	UnitFloor               int32         `json:"unit_floor"`
-	TenantName              string        `json:"tenant_name"`
-	TenantCpf               string        `json:"tenant_cpf"`
-	TenantPhone             string        `json:"tenant_phone"`
+	ID                      uuid.UUID      `json:"id"`
+	UnitID                  uuid.UUID      `json:"unit_id"`
+	TenantID                uuid.UUID      `json:"tenant_id"`
+	ContractSignedDate      time.Time      `json:"contract_signed_date"`
+	StartDate               time.Time      `json:"start_date"`
+	EndDate                 time.Time      `json:"end_date"`
+	PaymentDueDay           int32          `json:"payment_due_day"`
+	MonthlyRentValue        string         `json:"monthly_rent_value"`
+	PaintingFeeTotal        string         `json:"painting_fee_total"`
+	PaintingFeeInstallments int32          `json:"painting_fee_installments"`
+	PaintingFeePaid         string         `json:"painting_fee_paid"`
+	Status                  string         `json:"status"`
+	ParentLeaseID           uuid.NullUUID  `json:"parent_lease_id"`
+	Generation              int32          `json:"generation"`
+	CreatedAt               time.Time      `json:"created_at"`
+	UpdatedAt               time.Time      `json:"updated_at"`
+	SecurityDeposit         string         `json:"security_deposit"`
+	DepositReceivedDate     sql.NullTime   `json:"deposit_received_date"`
+	DepositHeldAt           sql.NullString `json:"deposit_held_at"`
+	DepositCorrectionIndex  sql.NullString `json:"deposit_correction_index"`
+	UnitNumber              string         `json:"unit_number"`
+	UnitFloor               int32          `json:"unit_floor"`
+	TenantName              string         `json:"tenant_name"`
+	TenantCpf               string         `json:"tenant_cpf"`
+	TenantPhone             string         `json:"tenant_phone"`
 }
 
 func (q *Queries) GetLeaseWithDetails(ctx context.Context, id uuid.UUID) (GetLeaseWithDetailsRow, error) {
@@ -325,6 +362,10 @@ func (q *Queries) GetLeaseWithDetails(ctx context.Context, id uuid.UUID) (GetLea
 		&i.Generation,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SecurityDeposit,
+		&i.DepositReceivedDate,
+		&i.DepositHeldAt,
+		&i.DepositCorrectionIndex,
 		&i.UnitNumber,
 		&i.UnitFloor,
 		&i.TenantName,
@@ -335,7 +376,7 @@ func (q *Queries) GetLeaseWithDetails(ctx context.Context, id uuid.UUID) (GetLea
 }
 
 const listLeases = `-- name: ListLeases :many
-SELECT id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at FROM leases
+SELECT id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at, security_deposit, deposit_received_date, deposit_held_at, deposit_correction_index FROM leases
 ORDER BY created_at DESC
 `
 
@@ -365,6 +406,61 @@ func (q *Queries) ListLeases(ctx context.Context) ([]Lease, error) {
 			&i.Generation,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SecurityDeposit,
+			&i.DepositReceivedDate,
+			&i.DepositHeldAt,
+			&i.DepositCorrectionIndex,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listLeasesByPropertyID = `-- name: ListLeasesByPropertyID :many
+SELECT l.id, l.unit_id, l.tenant_id, l.contract_signed_date, l.start_date, l.end_date, l.payment_due_day, l.monthly_rent_value, l.painting_fee_total, l.painting_fee_installments, l.painting_fee_paid, l.status, l.parent_lease_id, l.generation, l.created_at, l.updated_at, l.security_deposit, l.deposit_received_date, l.deposit_held_at, l.deposit_correction_index FROM leases l
+INNER JOIN units u ON l.unit_id = u.id
+WHERE u.property_id = $1
+ORDER BY l.created_at DESC
+`
+
+func (q *Queries) ListLeasesByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]Lease, error) {
+	rows, err := q.db.QueryContext(ctx, listLeasesByPropertyID, propertyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Lease{}
+	for rows.Next() {
+		var i Lease
+		if err := rows.Scan(
+			&i.ID,
+			&i.UnitID,
+			&i.TenantID,
+			&i.ContractSignedDate,
+			&i.StartDate,
+			&i.EndDate,
+			&i.PaymentDueDay,
+			&i.MonthlyRentValue,
+			&i.PaintingFeeTotal,
+			&i.PaintingFeeInstallments,
+			&i.PaintingFeePaid,
+			&i.Status,
+			&i.ParentLeaseID,
+			&i.Generation,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SecurityDeposit,
+			&i.DepositReceivedDate,
+			&i.DepositHeldAt,
+			&i.DepositCorrectionIndex,
 		); err != nil {
 			return nil, err
 		}
@@ -380,7 +476,7 @@ func (q *Queries) ListLeases(ctx context.Context) ([]Lease, error) {
 }
 
 const listLeasesByStatus = `-- name: ListLeasesByStatus :many
-SELECT id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at FROM leases
+SELECT id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at, security_deposit, deposit_received_date, deposit_held_at, deposit_correction_index FROM leases
 WHERE status = $1
 ORDER BY created_at DESC
 `
@@ -411,6 +507,10 @@ func (q *Queries) ListLeasesByStatus(ctx context.Context, status string) ([]Leas
 			&i.Generation,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SecurityDeposit,
+			&i.DepositReceivedDate,
+			&i.DepositHeldAt,
+			&i.DepositCorrectionIndex,
 		); err != nil {
 			return nil, err
 		}
@@ -426,7 +526,7 @@ func (q *Queries) ListLeasesByStatus(ctx context.Context, status string) ([]Leas
 }
 
 const listLeasesByTenantID = `-- name: ListLeasesByTenantID :many
-SELECT id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at FROM leases
+SELECT id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at, security_deposit, deposit_received_date, deposit_held_at, deposit_correction_index FROM leases
 WHERE tenant_id = $1
 ORDER BY created_at DESC
 `
@@ -457,6 +557,10 @@ func (q *Queries) ListLeasesByTenantID(ctx context.Context, tenantID uuid.UUID)
 			&i.Generation,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SecurityDeposit,
+			&i.DepositReceivedDate,
+			&i.DepositHeldAt,
+			&i.DepositCorrectionIndex,
 		); err != nil {
 			return nil, err
 		}
@@ -472,7 +576,7 @@ func (q *Queries) ListLeasesByTenantID(ctx context.Context, tenantID uuid.UUID)
 }
 
 const listLeasesByUnitID = `-- name: ListLeasesByUnitID :many
-SELECT id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at FROM leases
+SELECT id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at, security_deposit, deposit_received_date, deposit_held_at, deposit_correction_index FROM leases
 WHERE unit_id = $1
 ORDER BY created_at DESC
 `
@@ -503,6 +607,10 @@ func (q *Queries) ListLeasesByUnitID(ctx context.Context, unitID uuid.UUID) ([]L
 			&i.Generation,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SecurityDeposit,
+			&i.DepositReceivedDate,
+			&i.DepositHeldAt,
+			&i.DepositCorrectionIndex,
 		); err != nil {
 			return nil, err
 		}
@@ -519,7 +627,7 @@ func (q *Queries) ListLeasesByUnitID(ctx context.Context, unitID uuid.UUID) ([]L
 
 const listLeasesWithDetails = `-- name: ListLeasesWithDetails :many
 SELECT 
-    l.id, l.unit_id, l.tenant_id, l.contract_signed_date, l.start_date, l.end_date, l.payment_due_day, l.monthly_rent_value, l.painting_fee_total, l.painting_fee_installments, l.painting_fee_paid, l.status, l.parent_lease_id, l.generation, l.created_at, l.updated_at,
+    l.id, l.unit_id, l.tenant_id, l.contract_signed_date, l.start_date, l.end_date, l.payment_due_day, l.monthly_rent_value, l.painting_fee_total, l.painting_fee_installments, l.painting_fee_paid, l.status, l.parent_lease_id, l.generation, l.created_at, l.updated_at, l.security_deposit, l.deposit_received_date, l.deposit_held_at, l.deposit_correction_index,
     u.number as unit_number,
     u.floor as unit_floor,
     t.full_name as tenant_name,
@@ -532,27 +640,31 @@ ORDER BY l.created_at DESC
 `
 
 type ListLeasesWithDetailsRow struct {
-	ID                      uuid.UUID     `json:"id"`
-	UnitID                  uuid.UUID     `json:"unit_id"`
-	TenantID                uuid.UUID     `json:"tenant_id"`
-	ContractSignedDate      time.Time     `json:"contract_signed_date"`
-	StartDate               time.Time     `json:"start_date"`
-	EndDate                 time.Time     `json:"end_date"`
-	PaymentDueDay           int32         `json:"payment_due_day"`
-	MonthlyRentValue        string        `json:"monthly_rent_value"`
-	PaintingFeeTotal        string        `json:"painting_fee_total"`
-	PaintingFeeInstallments int32         `json:"painting_fee_installments"`
-	PaintingFeePaid         string        `json:"painting_fee_paid"`
-	Status                  string        `json:"status"`
-	ParentLeaseID           uuid.NullUUID `json:"parent_lease_id"`
-	Generation              int32         `json:"generation"`
-	CreatedAt               time.Time     `json:"created_at"`
-	UpdatedAt               time.Time     `json:"updated_at"`
-	UnitNumber              string        `json:"unit_number"`
-	UnitFloor               int32         `json:"unit_floor"`
-	TenantName              string        `json:"tenant_name"`
-	TenantCpf               string        `json:"tenant_cpf"`
-	TenantPhone             string        `json:"tenant_phone"`
+	ID                      uuid.UUID      `json:"id"`
+	UnitID                  uuid.UUID      `json:"unit_id"`
+	TenantID                uuid.UUID      `json:"tenant_id"`
+	ContractSignedDate      time.Time      `json:"contract_signed_date"`
+	StartDate               time.Time      `json:"start_date"`
+	EndDate                 time.Time      `json:"end_date"`
+	PaymentDueDay           int32          `json:"payment_due_day"`
+	MonthlyRentValue        string         `json:"monthly_rent_value"`
+	PaintingFeeTotal        string         `json:"painting_fee_total"`
+	PaintingFeeInstallments int32          `json:"painting_fee_installments"`
+	PaintingFeePaid         string         `json:"painting_fee_paid"`
+	Status                  string         `json:"status"`
+	ParentLeaseID           uuid.NullUUID  `json:"parent_lease_id"`
+	Generation              int32          `json:"generation"`
+	CreatedAt               time.Time      `json:"created_at"`
+	UpdatedAt               time.Time      `json:"updated_at"`
+	SecurityDeposit         string         `json:"security_deposit"`
+	DepositReceivedDate     sql.NullTime   `json:"deposit_received_date"`
+	DepositHeldAt           sql.NullString `json:"deposit_held_at"`
+	DepositCorrectionIndex  sql.NullString `json:"deposit_correction_index"`
+	UnitNumber              string         `json:"unit_number"`
+	UnitFloor               int32          `json:"unit_floor"`
+	TenantName              string         `json:"tenant_name"`
+	TenantCpf               string         `json:"tenant_cpf"`
+	TenantPhone             string         `json:"tenant_phone"`
 }
 
 func (q *Queries) ListLeasesWithDetails(ctx context.Context) ([]ListLeasesWithDetailsRow, error) {
@@ -581,6 +693,10 @@ func (q *Queries) ListLeasesWithDetails(ctx context.Context) ([]ListLeasesWithDe
 			&i.Generation,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SecurityDeposit,
+			&i.DepositReceivedDate,
+			&i.DepositHeldAt,
+			&i.DepositCorrectionIndex,
 			&i.UnitNumber,
 			&i.UnitFloor,
 			&i.TenantName,
@@ -616,27 +732,35 @@ SET
     status = $12,
     parent_lease_id = $13,
     generation = $14,
-    updated_at = $15
+    updated_at = $15,
+    security_deposit = $16,
+    deposit_received_date = $17,
+    deposit_held_at = $18,
+    deposit_correction_index = $19
 WHERE id = $1
-RETURNING id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at
+RETURNING id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at, security_deposit, deposit_received_date, deposit_held_at, deposit_correction_index
 `
 
 type UpdateLeaseParams struct {
-	ID                      uuid.UUID     `json:"id"`
-	UnitID                  uuid.UUID     `json:"unit_id"`
-	TenantID                uuid.UUID     `json:"tenant_id"`
-	ContractSignedDate      time.Time     `json:"contract_signed_date"`
-	StartDate               time.Time     `json:"start_date"`
-	EndDate                 time.Time     `json:"end_date"`
-	PaymentDueDay           int32         `json:"payment_due_day"`
-	MonthlyRentValue        string        `json:"monthly_rent_value"`
-	PaintingFeeTotal        string        `json:"painting_fee_total"`
-	PaintingFeeInstallments int32         `json:"painting_fee_installments"`
-	PaintingFeePaid         string        `json:"painting_fee_paid"`
-	Status                  string        `json:"status"`
-	ParentLeaseID           uuid.NullUUID `json:"parent_lease_id"`
-	Generation              int32         `json:"generation"`
-	UpdatedAt               time.Time     `json:"updated_at"`
+	ID                      uuid.UUID      `json:"id"`
+	UnitID                  uuid.UUID      `json:"unit_id"`
+	TenantID                uuid.UUID      `json:"tenant_id"`
+	ContractSignedDate      time.Time      `json:"contract_signed_date"`
+	StartDate               time.Time      `json:"start_date"`
+	EndDate                 time.Time      `json:"end_date"`
+	PaymentDueDay           int32          `json:"payment_due_day"`
+	MonthlyRentValue        string         `json:"monthly_rent_value"`
+	PaintingFeeTotal        string         `json:"painting_fee_total"`
+	PaintingFeeInstallments int32          `json:"painting_fee_installments"`
+	PaintingFeePaid         string         `json:"painting_fee_paid"`
+	Status                  string         `json:"status"`
+	ParentLeaseID           uuid.NullUUID  `json:"parent_lease_id"`
+	Generation              int32          `json:"generation"`
+	UpdatedAt               time.Time      `json:"updated_at"`
+	SecurityDeposit         string         `json:"security_deposit"`
+	DepositReceivedDate     sql.NullTime   `json:"deposit_received_date"`
+	DepositHeldAt           sql.NullString `json:"deposit_held_at"`
+	DepositCorrectionIndex  sql.NullString `json:"deposit_correction_index"`
 }
 
 func (q *Queries) UpdateLease(ctx context.Context, arg UpdateLeaseParams) (Lease, error) {
@@ -656,7 +780,54 @@ func (q *Queries) UpdateLease(ctx context.Context, arg UpdateLeaseParams) (Lease
 		arg.ParentLeaseID,
 		arg.Generation,
 		arg.UpdatedAt,
+		arg.SecurityDeposit,
+		arg.DepositReceivedDate,
+		arg.DepositHeldAt,
+		arg.DepositCorrectionIndex,
+	)
+	var i Lease
+	err := row.Scan(
+		&i.ID,
+		&i.UnitID,
+		&i.TenantID,
+		&i.ContractSignedDate,
+		&i.StartDate,
+		&i.EndDate,
+		&i.PaymentDueDay,
+		&i.MonthlyRentValue,
+		&i.PaintingFeeTotal,
+		&i.PaintingFeeInstallments,
+		&i.PaintingFeePaid,
+		&i.Status,
+		&i.ParentLeaseID,
+		&i.Generation,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.SecurityDeposit,
+		&i.DepositReceivedDate,
+		&i.DepositHeldAt,
+		&i.DepositCorrectionIndex,
 	)
+	return i, err
+}
+
+const updateLeaseDepositReceived = `-- name: UpdateLeaseDepositReceived :one
+UPDATE leases
+SET
+    deposit_received_date = $2,
+    updated_at = $3
+WHERE id = $1
+RETURNING id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at, security_deposit, deposit_received_date, deposit_held_at, deposit_correction_index
+`
+
+type UpdateLeaseDepositReceivedParams struct {
+	ID                  uuid.UUID    `json:"id"`
+	DepositReceivedDate sql.NullTime `json:"deposit_received_date"`
+	UpdatedAt           time.Time    `json:"updated_at"`
+}
+
+func (q *Queries) UpdateLeaseDepositReceived(ctx context.Context, arg UpdateLeaseDepositReceivedParams) (Lease, error) {
+	row := q.db.QueryRowContext(ctx, updateLeaseDepositReceived, arg.ID, arg.DepositReceivedDate, arg.UpdatedAt)
 	var i Lease
 	err := row.Scan(
 		&i.ID,
@@ -675,6 +846,10 @@ func (q *Queries) UpdateLease(ctx context.Context, arg UpdateLeaseParams) (Lease
 		&i.Generation,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SecurityDeposit,
+		&i.DepositReceivedDate,
+		&i.DepositHeldAt,
+		&i.DepositCorrectionIndex,
 	)
 	return i, err
 }
@@ -685,7 +860,7 @@ SET
     status = $2,
     updated_at = $3
 WHERE id = $1
-RETURNING id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at
+RETURNING id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at, security_deposit, deposit_received_date, deposit_held_at, deposit_correction_index
 `
 
 type UpdateLeaseStatusParams struct {
@@ -714,6 +889,10 @@ func (q *Queries) UpdateLeaseStatus(ctx context.Context, arg UpdateLeaseStatusPa
 		&i.Generation,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SecurityDeposit,
+		&i.DepositReceivedDate,
+		&i.DepositHeldAt,
+		&i.DepositCorrectionIndex,
 	)
 	return i, err
 }
@@ -724,7 +903,7 @@ SET
     painting_fee_paid = $2,
     updated_at = $3
 WHERE id = $1
-RETURNING id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at
+RETURNING id, unit_id, tenant_id, contract_signed_date, start_date, end_date, payment_due_day, monthly_rent_value, painting_fee_total, painting_fee_installments, painting_fee_paid, status, parent_lease_id, generation, created_at, updated_at, security_deposit, deposit_received_date, deposit_held_at, deposit_correction_index
 `
 
 type UpdatePaintingFeePaidParams struct {
@@ -753,6 +932,10 @@ func (q *Queries) UpdatePaintingFeePaid(ctx context.Context, arg UpdatePaintingF
 		&i.Generation,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SecurityDeposit,
+		&i.DepositReceivedDate,
+		&i.DepositHeldAt,
+		&i.DepositCorrectionIndex,
 	)
 	return i, err
 }
@@ -7,6 +7,7 @@ package sqlc
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -64,6 +65,7 @@ const (
 	UserRoleAdmin   UserRole = "admin"
 	UserRoleManager UserRole = "manager"
 	UserRoleViewer  UserRole = "viewer"
+	UserRoleTenant  UserRole = "tenant"
 )
 
 func (e *UserRole) Scan(src interface{}) error {
@@ -101,23 +103,88 @@ func (ns NullUserRole) Value() (driver.Value, error) {
 	return string(ns.UserRole), nil
 }
 
+type CalendarFeed struct {
+	UserID         uuid.UUID    `json:"user_id"`
+	TokenHash      string       `json:"token_hash"`
+	LastAccessedAt sql.NullTime `json:"last_accessed_at"`
+	CreatedAt      time.Time    `json:"created_at"`
+}
+
+type DunningStep struct {
+	ID         uuid.UUID `json:"id"`
+	Code       string    `json:"code"`
+	Name       string    `json:"name"`
+	OffsetDays int32     `json:"offset_days"`
+	Action     string    `json:"action"`
+	Active     bool      `json:"active"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+type InventoryChecklist struct {
+	ID            uuid.UUID      `json:"id"`
+	LeaseID       uuid.UUID      `json:"lease_id"`
+	UnitID        uuid.UUID      `json:"unit_id"`
+	ChecklistType string         `json:"checklist_type"`
+	Notes         sql.NullString `json:"notes"`
+	PerformedBy   uuid.NullUUID  `json:"performed_by"`
+	PerformedAt   time.Time      `json:"performed_at"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+type InventoryChecklistItem struct {
+	ID               uuid.UUID      `json:"id"`
+	ChecklistID      uuid.UUID      `json:"checklist_id"`
+	ItemID           uuid.NullUUID  `json:"item_id"`
+	ItemName         string         `json:"item_name"`
+	ItemType         string         `json:"item_type"`
+	Brand            sql.NullString `json:"brand"`
+	SerialNumber     sql.NullString `json:"serial_number"`
+	Condition        string         `json:"condition"`
+	Present          bool           `json:"present"`
+	ReplacementValue sql.NullString `json:"replacement_value"`
+	Notes            sql.NullString `json:"notes"`
+}
+
 type Lease struct {
-	ID                      uuid.UUID     `json:"id"`
-	UnitID                  uuid.UUID     `json:"unit_id"`
-	TenantID                uuid.UUID     `json:"tenant_id"`
-	ContractSignedDate      time.Time     `json:"contract_signed_date"`
-	StartDate               time.Time     `json:"start_date"`
-	EndDate                 time.Time     `json:"end_date"`
-	PaymentDueDay           int32         `json:"payment_due_day"`
-	MonthlyRentValue        string        `json:"monthly_rent_value"`
-	PaintingFeeTotal        string        `json:"painting_fee_total"`
-	PaintingFeeInstallments int32         `json:"painting_fee_installments"`
-	PaintingFeePaid         string        `json:"painting_fee_paid"`
-	Status                  string        `json:"status"`
-	ParentLeaseID           uuid.NullUUID `json:"parent_lease_id"`
-	Generation              int32         `json:"generation"`
-	CreatedAt               time.Time     `json:"created_at"`
-	UpdatedAt               time.Time     `json:"updated_at"`
+	ID                      uuid.UUID      `json:"id"`
+	UnitID                  uuid.UUID      `json:"unit_id"`
+	TenantID                uuid.UUID      `json:"tenant_id"`
+	ContractSignedDate      time.Time      `json:"contract_signed_date"`
+	StartDate               time.Time      `json:"start_date"`
+	EndDate                 time.Time      `json:"end_date"`
+	PaymentDueDay           int32          `json:"payment_due_day"`
+	MonthlyRentValue        string         `json:"monthly_rent_value"`
+	PaintingFeeTotal        string         `json:"painting_fee_total"`
+	PaintingFeeInstallments int32          `json:"painting_fee_installments"`
+	PaintingFeePaid         string         `json:"painting_fee_paid"`
+	Status                  string         `json:"status"`
+	ParentLeaseID           uuid.NullUUID  `json:"parent_lease_id"`
+	Generation              int32          `json:"generation"`
+	CreatedAt               time.Time      `json:"created_at"`
+	UpdatedAt               time.Time      `json:"updated_at"`
+	SecurityDeposit         string         `json:"security_deposit"`
+	DepositReceivedDate     sql.NullTime   `json:"deposit_received_date"`
+	DepositHeldAt           sql.NullString `json:"deposit_held_at"`
+	DepositCorrectionIndex  sql.NullString `json:"deposit_correction_index"`
+}
+
+type LeaseDepositSettlement struct {
+	ID               uuid.UUID      `json:"id"`
+	LeaseID          uuid.UUID      `json:"lease_id"`
+	DepositAmount    string         `json:"deposit_amount"`
+	CorrectionRate   string         `json:"correction_rate"`
+	CorrectionAmount string         `json:"correction_amount"`
+	DamageDeductions string         `json:"damage_deductions"`
+	DebtDeductions   string         `json:"debt_deductions"`
+	OtherDeductions  string         `json:"other_deductions"`
+	RefundAmount     string         `json:"refund_amount"`
+	BalanceDue       string         `json:"balance_due"`
+	RefundedAt       sql.NullTime   `json:"refunded_at"`
+	RefundMethod     sql.NullString `json:"refund_method"`
+	Notes            sql.NullString `json:"notes"`
+	CreatedBy        uuid.NullUUID  `json:"created_by"`
+	CreatedAt        time.Time      `json:"created_at"`
 }
 
 type LeaseRentAdjustment struct {
@@ -132,36 +199,285 @@ type LeaseRentAdjustment struct {
 	CreatedAt            time.Time      `json:"created_at"`
 }
 
-type Payment struct {
+type MaintenanceTicket struct {
+	ID                 uuid.UUID      `json:"id"`
+	UnitID             uuid.UUID      `json:"unit_id"`
+	LeaseID            uuid.NullUUID  `json:"lease_id"`
+	TenantID           uuid.NullUUID  `json:"tenant_id"`
+	Title              string         `json:"title"`
+	Description        string         `json:"description"`
+	Category           string         `json:"category"`
+	Priority           string         `json:"priority"`
+	Status             string         `json:"status"`
+	RequiresVacancy    bool           `json:"requires_vacancy"`
+	PreviousUnitStatus NullUnitStatus `json:"previous_unit_status"`
+	AssignedContractor sql.NullString `json:"assigned_contractor"`
+	ContractorPhone    sql.NullString `json:"contractor_phone"`
+	EstimatedCost      sql.NullString `json:"estimated_cost"`
+	ActualCost         sql.NullString `json:"actual_cost"`
+	ResolutionNotes    sql.NullString `json:"resolution_notes"`
+	OpenedBy           uuid.NullUUID  `json:"opened_by"`
+	OpenedAt           time.Time      `json:"opened_at"`
+	StartedAt          sql.NullTime   `json:"started_at"`
+	ResolvedAt         sql.NullTime   `json:"resolved_at"`
+	ClosedAt           sql.NullTime   `json:"closed_at"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+}
+
+type MaintenanceTicketPhoto struct {
+	ID         uuid.UUID      `json:"id"`
+	TicketID   uuid.UUID      `json:"ticket_id"`
+	Url        string         `json:"url"`
+	Caption    sql.NullString `json:"caption"`
+	UploadedBy uuid.NullUUID  `json:"uploaded_by"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+type MeterReading struct {
 	ID             uuid.UUID      `json:"id"`
-	LeaseID        uuid.UUID      `json:"lease_id"`
-	PaymentType    string         `json:"payment_type"`
+	UnitID         uuid.UUID      `json:"unit_id"`
+	UtilityType    string         `json:"utility_type"`
 	ReferenceMonth time.Time      `json:"reference_month"`
-	Amount         string         `json:"amount"`
-	Status         string         `json:"status"`
-	DueDate        time.Time      `json:"due_date"`
-	PaymentDate    sql.NullTime   `json:"payment_date"`
-	PaymentMethod  sql.NullString `json:"payment_method"`
-	ProofUrl       sql.NullString `json:"proof_url"`
+	Reading        string         `json:"reading"`
+	ReadingDate    time.Time      `json:"reading_date"`
 	Notes          sql.NullString `json:"notes"`
+	CreatedBy      uuid.NullUUID  `json:"created_by"`
 	CreatedAt      time.Time      `json:"created_at"`
-	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+type Notification struct {
+	ID                uuid.UUID      `json:"id"`
+	LeaseID           uuid.NullUUID  `json:"lease_id"`
+	TenantID          uuid.NullUUID  `json:"tenant_id"`
+	PaymentID         uuid.NullUUID  `json:"payment_id"`
+	Type              string         `json:"type"`
+	Channel           string         `json:"channel"`
+	Recipient         string         `json:"recipient"`
+	Subject           string         `json:"subject"`
+	MessageContent    string         `json:"message_content"`
+	ScheduledDate     time.Time      `json:"scheduled_date"`
+	Status            string         `json:"status"`
+	Attempts          int32          `json:"attempts"`
+	NextAttemptAt     sql.NullTime   `json:"next_attempt_at"`
+	LastError         sql.NullString `json:"last_error"`
+	SentDate          sql.NullTime   `json:"sent_date"`
+	DedupKey          string         `json:"dedup_key"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	HtmlContent       sql.NullString `json:"html_content"`
+	ProviderMessageID sql.NullString `json:"provider_message_id"`
+	BouncedAt         sql.NullTime   `json:"bounced_at"`
+	BounceReason      sql.NullString `json:"bounce_reason"`
+	DeliveredAt       sql.NullTime   `json:"delivered_at"`
+}
+
+type Payment struct {
+	ID                  uuid.UUID      `json:"id"`
+	LeaseID             uuid.UUID      `json:"lease_id"`
+	PaymentType         string         `json:"payment_type"`
+	ReferenceMonth      time.Time      `json:"reference_month"`
+	Amount              string         `json:"amount"`
+	Status              string         `json:"status"`
+	DueDate             time.Time      `json:"due_date"`
+	PaymentDate         sql.NullTime   `json:"payment_date"`
+	PaymentMethod       sql.NullString `json:"payment_method"`
+	ProofUrl            sql.NullString `json:"proof_url"`
+	Notes               sql.NullString `json:"notes"`
+	CreatedAt           time.Time      `json:"created_at"`
+	UpdatedAt           time.Time      `json:"updated_at"`
+	DepositSettlementID uuid.NullUUID  `json:"deposit_settlement_id"`
+}
+
+type PaymentCollectionAction struct {
+	ID                   uuid.UUID      `json:"id"`
+	PaymentID            uuid.UUID      `json:"payment_id"`
+	StepID               uuid.UUID      `json:"step_id"`
+	StepCode             string         `json:"step_code"`
+	StepName             string         `json:"step_name"`
+	OffsetDays           int32          `json:"offset_days"`
+	Action               string         `json:"action"`
+	Status               string         `json:"status"`
+	NotificationsCreated int32          `json:"notifications_created"`
+	Notes                sql.NullString `json:"notes"`
+	ExecutedAt           time.Time      `json:"executed_at"`
+	CreatedAt            time.Time      `json:"created_at"`
+}
+
+type Property struct {
+	ID                   uuid.UUID      `json:"id"`
+	Name                 string         `json:"name"`
+	AddressStreet        string         `json:"address_street"`
+	AddressNumber        string         `json:"address_number"`
+	AddressComplement    sql.NullString `json:"address_complement"`
+	AddressNeighborhood  string         `json:"address_neighborhood"`
+	AddressCity          string         `json:"address_city"`
+	AddressState         string         `json:"address_state"`
+	AddressZipCode       string         `json:"address_zip_code"`
+	BankName             sql.NullString `json:"bank_name"`
+	BankBranch           sql.NullString `json:"bank_branch"`
+	BankAccount          sql.NullString `json:"bank_account"`
+	PixKey               sql.NullString `json:"pix_key"`
+	DefaultPaymentDueDay sql.NullInt32  `json:"default_payment_due_day"`
+	DefaultPaintingFee   string         `json:"default_painting_fee"`
+	Notes                sql.NullString `json:"notes"`
+	CreatedAt            time.Time      `json:"created_at"`
+	UpdatedAt            time.Time      `json:"updated_at"`
+}
+
+type Prospect struct {
+	ID                uuid.UUID      `json:"id"`
+	FullName          string         `json:"full_name"`
+	Phone             string         `json:"phone"`
+	Email             sql.NullString `json:"email"`
+	Cpf               sql.NullString `json:"cpf"`
+	Source            string         `json:"source"`
+	DesiredMoveInDate sql.NullTime   `json:"desired_move_in_date"`
+	MaxBudget         sql.NullString `json:"max_budget"`
+	PreferredFloor    sql.NullInt32  `json:"preferred_floor"`
+	Status            string         `json:"status"`
+	LostReason        sql.NullString `json:"lost_reason"`
+	Notes             sql.NullString `json:"notes"`
+	ConvertedTenantID uuid.NullUUID  `json:"converted_tenant_id"`
+	ConvertedAt       sql.NullTime   `json:"converted_at"`
+	CreatedBy         uuid.NullUUID  `json:"created_by"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+}
+
+type ProspectVisit struct {
+	ID          uuid.UUID      `json:"id"`
+	ProspectID  uuid.UUID      `json:"prospect_id"`
+	UnitID      uuid.NullUUID  `json:"unit_id"`
+	ScheduledAt time.Time      `json:"scheduled_at"`
+	Status      string         `json:"status"`
+	Notes       sql.NullString `json:"notes"`
+	CreatedBy   uuid.NullUUID  `json:"created_by"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+type RenovationExpense struct {
+	ID          uuid.UUID      `json:"id"`
+	ProjectID   uuid.UUID      `json:"project_id"`
+	Description string         `json:"description"`
+	Category    string         `json:"category"`
+	Amount      string         `json:"amount"`
+	ExpenseDate time.Time      `json:"expense_date"`
+	Supplier    sql.NullString `json:"supplier"`
+	ReceiptUrl  sql.NullString `json:"receipt_url"`
+	CreatedBy   uuid.NullUUID  `json:"created_by"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
+type RenovationProject struct {
+	ID                 uuid.UUID      `json:"id"`
+	UnitID             uuid.UUID      `json:"unit_id"`
+	Title              string         `json:"title"`
+	Description        string         `json:"description"`
+	Status             string         `json:"status"`
+	PlannedStartDate   time.Time      `json:"planned_start_date"`
+	PlannedEndDate     time.Time      `json:"planned_end_date"`
+	Budget             string         `json:"budget"`
+	PreviousUnitStatus NullUnitStatus `json:"previous_unit_status"`
+	StartedAt          sql.NullTime   `json:"started_at"`
+	CompletedAt        sql.NullTime   `json:"completed_at"`
+	CancelledAt        sql.NullTime   `json:"cancelled_at"`
+	Notes              sql.NullString `json:"notes"`
+	CreatedBy          uuid.NullUUID  `json:"created_by"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
 }
 
 type Tenant struct {
-	ID               uuid.UUID      `json:"id"`
-	FullName         string         `json:"full_name"`
-	Cpf              string         `json:"cpf"`
-	Phone            string         `json:"phone"`
-	Email            sql.NullString `json:"email"`
-	IDDocumentType   sql.NullString `json:"id_document_type"`
-	IDDocumentNumber sql.NullString `json:"id_document_number"`
-	CreatedAt        time.Time      `json:"created_at"`
-	UpdatedAt        time.Time      `json:"updated_at"`
+	ID                    uuid.UUID      `json:"id"`
+	FullName              string         `json:"full_name"`
+	Cpf                   string         `json:"cpf"`
+	Phone                 string         `json:"phone"`
+	Email                 sql.NullString `json:"email"`
+	IDDocumentType        sql.NullString `json:"id_document_type"`
+	IDDocumentNumber      sql.NullString `json:"id_document_number"`
+	CreatedAt             time.Time      `json:"created_at"`
+	UpdatedAt             time.Time      `json:"updated_at"`
+	EmployerName          sql.NullString `json:"employer_name"`
+	EmployerPhone         sql.NullString `json:"employer_phone"`
+	EmployerJobTitle      sql.NullString `json:"employer_job_title"`
+	EmployerMonthlyIncome sql.NullString `json:"employer_monthly_income"`
+	EmployerStartDate     sql.NullTime   `json:"employer_start_date"`
+	AnonymizedAt          sql.NullTime   `json:"anonymized_at"`
+	AnonymizedBy          uuid.NullUUID  `json:"anonymized_by"`
+	MessagingOptOutAt     sql.NullTime   `json:"messaging_opt_out_at"`
+}
+
+type TenantContact struct {
+	ID           uuid.UUID      `json:"id"`
+	TenantID     uuid.UUID      `json:"tenant_id"`
+	ContactType  string         `json:"contact_type"`
+	Name         string         `json:"name"`
+	Relationship string         `json:"relationship"`
+	Phone        string         `json:"phone"`
+	Email        sql.NullString `json:"email"`
+	Notes        sql.NullString `json:"notes"`
+	CreatedAt    time.Time      `json:"created_at"`
+}
+
+type TenantDataRequest struct {
+	ID          uuid.UUID      `json:"id"`
+	TenantID    uuid.UUID      `json:"tenant_id"`
+	RequestType string         `json:"request_type"`
+	PerformedBy uuid.NullUUID  `json:"performed_by"`
+	PerformedAt time.Time      `json:"performed_at"`
+	Notes       sql.NullString `json:"notes"`
+}
+
+type TenantDocument struct {
+	ID           uuid.UUID     `json:"id"`
+	TenantID     uuid.UUID     `json:"tenant_id"`
+	LeaseID      uuid.NullUUID `json:"lease_id"`
+	DocumentType string        `json:"document_type"`
+	ExpiresAt    sql.NullTime  `json:"expires_at"`
+	FileName     string        `json:"file_name"`
+	ContentType  string        `json:"content_type"`
+	SizeBytes    int64         `json:"size_bytes"`
+	Checksum     string        `json:"checksum"`
+	StorageKey   string        `json:"storage_key"`
+	UploadedBy   uuid.NullUUID `json:"uploaded_by"`
+	CreatedAt    time.Time     `json:"created_at"`
+}
+
+type TenantLoginCode struct {
+	ID        uuid.UUID    `json:"id"`
+	UserID    uuid.UUID    `json:"user_id"`
+	CodeHash  string       `json:"code_hash"`
+	Attempts  int32        `json:"attempts"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	UsedAt    sql.NullTime `json:"used_at"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+type TenantOccupant struct {
+	ID           uuid.UUID      `json:"id"`
+	TenantID     uuid.UUID      `json:"tenant_id"`
+	FullName     string         `json:"full_name"`
+	Relationship string         `json:"relationship"`
+	Document     sql.NullString `json:"document"`
+	BirthDate    sql.NullTime   `json:"birth_date"`
+	CreatedAt    time.Time      `json:"created_at"`
+}
+
+type TenantPet struct {
+	ID        uuid.UUID      `json:"id"`
+	TenantID  uuid.UUID      `json:"tenant_id"`
+	Name      string         `json:"name"`
+	Species   string         `json:"species"`
+	Breed     sql.NullString `json:"breed"`
+	Notes     sql.NullString `json:"notes"`
+	CreatedAt time.Time      `json:"created_at"`
 }
 
 type Unit struct {
 	ID                 uuid.UUID       `json:"id"`
+	PropertyID         uuid.UUID       `json:"property_id"`
 	Number             string          `json:"number"`
 	Floor              int32           `json:"floor"`
 	Status             UnitStatus      `json:"status"`
@@ -174,13 +490,101 @@ type Unit struct {
 	UpdatedAt          time.Time       `json:"updated_at"`
 }
 
+type UnitInventoryItem struct {
+	ID            uuid.UUID      `json:"id"`
+	UnitID        uuid.UUID      `json:"unit_id"`
+	Name          string         `json:"name"`
+	ItemType      string         `json:"item_type"`
+	Brand         sql.NullString `json:"brand"`
+	SerialNumber  sql.NullString `json:"serial_number"`
+	Condition     string         `json:"condition"`
+	PurchaseDate  sql.NullTime   `json:"purchase_date"`
+	PurchaseValue sql.NullString `json:"purchase_value"`
+	Notes         sql.NullString `json:"notes"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+}
+
+type UnitStatusHistory struct {
+	ID          uuid.UUID      `json:"id"`
+	UnitID      uuid.UUID      `json:"unit_id"`
+	FromStatus  NullUnitStatus `json:"from_status"`
+	ToStatus    UnitStatus     `json:"to_status"`
+	Reason      string         `json:"reason"`
+	ReferenceID uuid.NullUUID  `json:"reference_id"`
+	ChangedAt   time.Time      `json:"changed_at"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
 type User struct {
-	ID           uuid.UUID    `json:"id"`
-	Username     string       `json:"username"`
-	PasswordHash string       `json:"password_hash"`
-	Role         UserRole     `json:"role"`
-	IsActive     bool         `json:"is_active"`
-	LastLoginAt  sql.NullTime `json:"last_login_at"`
-	CreatedAt    time.Time    `json:"created_at"`
-	UpdatedAt    time.Time    `json:"updated_at"`
+	ID           uuid.UUID     `json:"id"`
+	Username     string        `json:"username"`
+	PasswordHash string        `json:"password_hash"`
+	Role         UserRole      `json:"role"`
+	IsActive     bool          `json:"is_active"`
+	LastLoginAt  sql.NullTime  `json:"last_login_at"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+	TenantID     uuid.NullUUID `json:"tenant_id"`
+}
+
+type UtilityCharge struct {
+	ID              uuid.UUID      `json:"id"`
+	PaymentID       uuid.UUID      `json:"payment_id"`
+	LeaseID         uuid.UUID      `json:"lease_id"`
+	UnitID          uuid.UUID      `json:"unit_id"`
+	UtilityType     string         `json:"utility_type"`
+	ReferenceMonth  time.Time      `json:"reference_month"`
+	BillingMethod   string         `json:"billing_method"`
+	PreviousReading sql.NullString `json:"previous_reading"`
+	CurrentReading  sql.NullString `json:"current_reading"`
+	Consumption     sql.NullString `json:"consumption"`
+	PricePerUnit    sql.NullString `json:"price_per_unit"`
+	FixedFee        string         `json:"fixed_fee"`
+	BuildingTotal   sql.NullString `json:"building_total"`
+	ShareWeight     sql.NullInt32  `json:"share_weight"`
+	TotalShares     sql.NullInt32  `json:"total_shares"`
+	Amount          string         `json:"amount"`
+	CreatedAt       time.Time      `json:"created_at"`
+}
+
+type UtilityTariff struct {
+	ID            uuid.UUID `json:"id"`
+	UtilityType   string    `json:"utility_type"`
+	PricePerUnit  string    `json:"price_per_unit"`
+	FixedFee      string    `json:"fixed_fee"`
+	EffectiveFrom time.Time `json:"effective_from"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID       `json:"id"`
+	EndpointID     uuid.UUID       `json:"endpoint_id"`
+	EventID        uuid.UUID       `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int32           `json:"attempts"`
+	NextAttemptAt  sql.NullTime    `json:"next_attempt_at"`
+	LastError      sql.NullString  `json:"last_error"`
+	ResponseStatus sql.NullInt32   `json:"response_status"`
+	DeliveredAt    sql.NullTime    `json:"delivered_at"`
+	ReplayOf       uuid.NullUUID   `json:"replay_of"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+type WebhookEndpoint struct {
+	ID          uuid.UUID `json:"id"`
+	Url         string    `json:"url"`
+	Description string    `json:"description"`
+	Secret      string    `json:"secret"`
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type WebhookSubscription struct {
+	EndpointID uuid.UUID `json:"endpoint_id"`
+	EventType  string    `json:"event_type"`
 }
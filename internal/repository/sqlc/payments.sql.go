@@ -19,7 +19,7 @@ SET
     status = 'cancelled',
     updated_at = $2
 WHERE id = $1
-RETURNING id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at
+RETURNING id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at, deposit_settlement_id
 `
 
 type CancelPaymentParams struct {
@@ -44,6 +44,7 @@ func (q *Queries) CancelPayment(ctx context.Context, arg CancelPaymentParams) (P
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DepositSettlementID,
 	)
 	return i, err
 }
@@ -100,7 +101,7 @@ INSERT INTO payments (
     updated_at
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
-) RETURNING id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at
+) RETURNING id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at, deposit_settlement_id
 `
 
 type CreatePaymentParams struct {
@@ -150,6 +151,7 @@ func (q *Queries) CreatePayment(ctx context.Context, arg CreatePaymentParams) (P
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DepositSettlementID,
 	)
 	return i, err
 }
@@ -165,7 +167,7 @@ func (q *Queries) DeletePayment(ctx context.Context, id uuid.UUID) error {
 }
 
 const getOverduePayments = `-- name: GetOverduePayments :many
-SELECT p.id, p.lease_id, p.payment_type, p.reference_month, p.amount, p.status, p.due_date, p.payment_date, p.payment_method, p.proof_url, p.notes, p.created_at, p.updated_at FROM payments p
+SELECT p.id, p.lease_id, p.payment_type, p.reference_month, p.amount, p.status, p.due_date, p.payment_date, p.payment_method, p.proof_url, p.notes, p.created_at, p.updated_at, p.deposit_settlement_id FROM payments p
 INNER JOIN leases l ON p.lease_id = l.id
 WHERE p.status IN ('pending', 'overdue')
   AND p.due_date < CURRENT_DATE
@@ -196,6 +198,159 @@ func (q *Queries) GetOverduePayments(ctx context.Context) ([]Payment, error) {
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DepositSettlementID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOverduePaymentsByPropertyID = `-- name: GetOverduePaymentsByPropertyID :many
+SELECT p.id, p.lease_id, p.payment_type, p.reference_month, p.amount, p.status, p.due_date, p.payment_date, p.payment_method, p.proof_url, p.notes, p.created_at, p.updated_at, p.deposit_settlement_id FROM payments p
+INNER JOIN leases l ON p.lease_id = l.id
+INNER JOIN units u ON l.unit_id = u.id
+WHERE p.status IN ('pending', 'overdue')
+  AND p.due_date < CURRENT_DATE
+  AND l.status IN ('active', 'expiring_soon')
+  AND u.property_id = $1
+ORDER BY p.due_date ASC
+`
+
+func (q *Queries) GetOverduePaymentsByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]Payment, error) {
+	rows, err := q.db.QueryContext(ctx, getOverduePaymentsByPropertyID, propertyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Payment{}
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.ID,
+			&i.LeaseID,
+			&i.PaymentType,
+			&i.ReferenceMonth,
+			&i.Amount,
+			&i.Status,
+			&i.DueDate,
+			&i.PaymentDate,
+			&i.PaymentMethod,
+			&i.ProofUrl,
+			&i.Notes,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DepositSettlementID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOverdueSettledSince = `-- name: GetOverdueSettledSince :many
+SELECT p.id, p.lease_id, p.payment_type, p.reference_month, p.amount, p.status, p.due_date, p.payment_date, p.payment_method, p.proof_url, p.notes, p.created_at, p.updated_at, p.deposit_settlement_id FROM payments p
+INNER JOIN leases l ON p.lease_id = l.id
+WHERE p.status = 'paid'
+  AND p.payment_date >= $1::DATE
+  AND p.due_date < $1::DATE
+  AND l.status IN ('active', 'expiring_soon')
+ORDER BY p.due_date ASC
+`
+
+func (q *Queries) GetOverdueSettledSince(ctx context.Context, since time.Time) ([]Payment, error) {
+	rows, err := q.db.QueryContext(ctx, getOverdueSettledSince, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Payment{}
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.ID,
+			&i.LeaseID,
+			&i.PaymentType,
+			&i.ReferenceMonth,
+			&i.Amount,
+			&i.Status,
+			&i.DueDate,
+			&i.PaymentDate,
+			&i.PaymentMethod,
+			&i.ProofUrl,
+			&i.Notes,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DepositSettlementID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOverdueSettledSinceByPropertyID = `-- name: GetOverdueSettledSinceByPropertyID :many
+SELECT p.id, p.lease_id, p.payment_type, p.reference_month, p.amount, p.status, p.due_date, p.payment_date, p.payment_method, p.proof_url, p.notes, p.created_at, p.updated_at, p.deposit_settlement_id FROM payments p
+INNER JOIN leases l ON p.lease_id = l.id
+INNER JOIN units u ON l.unit_id = u.id
+WHERE p.status = 'paid'
+  AND p.payment_date >= $1::DATE
+  AND p.due_date < $1::DATE
+  AND l.status IN ('active', 'expiring_soon')
+  AND u.property_id = $2::UUID
+ORDER BY p.due_date ASC
+`
+
+type GetOverdueSettledSinceByPropertyIDParams struct {
+	Since      time.Time `json:"since"`
+	PropertyID uuid.UUID `json:"property_id"`
+}
+
+func (q *Queries) GetOverdueSettledSinceByPropertyID(ctx context.Context, arg GetOverdueSettledSinceByPropertyIDParams) ([]Payment, error) {
+	rows, err := q.db.QueryContext(ctx, getOverdueSettledSinceByPropertyID, arg.Since, arg.PropertyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Payment{}
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.ID,
+			&i.LeaseID,
+			&i.PaymentType,
+			&i.ReferenceMonth,
+			&i.Amount,
+			&i.Status,
+			&i.DueDate,
+			&i.PaymentDate,
+			&i.PaymentMethod,
+			&i.ProofUrl,
+			&i.Notes,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DepositSettlementID,
 		); err != nil {
 			return nil, err
 		}
@@ -211,7 +366,7 @@ func (q *Queries) GetOverduePayments(ctx context.Context) ([]Payment, error) {
 }
 
 const getPaymentByID = `-- name: GetPaymentByID :one
-SELECT id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at FROM payments
+SELECT id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at, deposit_settlement_id FROM payments
 WHERE id = $1
 LIMIT 1
 `
@@ -233,13 +388,14 @@ func (q *Queries) GetPaymentByID(ctx context.Context, id uuid.UUID) (Payment, er
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DepositSettlementID,
 	)
 	return i, err
 }
 
 const getPaymentWithLeaseDetails = `-- name: GetPaymentWithLeaseDetails :one
 SELECT 
-    p.id, p.lease_id, p.payment_type, p.reference_month, p.amount, p.status, p.due_date, p.payment_date, p.payment_method, p.proof_url, p.notes, p.created_at, p.updated_at,
+    p.id, p.lease_id, p.payment_type, p.reference_month, p.amount, p.status, p.due_date, p.payment_date, p.payment_method, p.proof_url, p.notes, p.created_at, p.updated_at, p.deposit_settlement_id,
     l.monthly_rent_value,
     l.payment_due_day,
     u.number as unit_number,
@@ -254,24 +410,25 @@ LIMIT 1
 `
 
 type GetPaymentWithLeaseDetailsRow struct {
-	ID               uuid.UUID      `json:"id"`
-	LeaseID          uuid.UUID      `json:"lease_id"`
-	PaymentType      string         `json:"payment_type"`
-	ReferenceMonth   time.Time      `json:"reference_month"`
-	Amount           string         `json:"amount"`
-	Status           string         `json:"status"`
-	DueDate          time.Time      `json:"due_date"`
-	PaymentDate      sql.NullTime   `json:"payment_date"`
-	PaymentMethod    sql.NullString `json:"payment_method"`
-	ProofUrl         sql.NullString `json:"proof_url"`
-	Notes            sql.NullString `json:"notes"`
-	CreatedAt        time.Time      `json:"created_at"`
-	UpdatedAt        time.Time      `json:"updated_at"`
-	MonthlyRentValue string         `json:"monthly_rent_value"`
-	PaymentDueDay    int32          `json:"payment_due_day"`
-	UnitNumber       string         `json:"unit_number"`
-	TenantName       string         `json:"tenant_name"`
-	TenantPhone      string         `json:"tenant_phone"`
+	ID                  uuid.UUID      `json:"id"`
+	LeaseID             uuid.UUID      `json:"lease_id"`
+	PaymentType         string         `json:"payment_type"`
+	ReferenceMonth      time.Time      `json:"reference_month"`
+	Amount              string         `json:"amount"`
+	Status              string         `json:"status"`
+	DueDate             time.Time      `json:"due_date"`
+	PaymentDate         sql.NullTime   `json:"payment_date"`
+	PaymentMethod       sql.NullString `json:"payment_method"`
+	ProofUrl            sql.NullString `json:"proof_url"`
+	Notes               sql.NullString `json:"notes"`
+	CreatedAt           time.Time      `json:"created_at"`
+	UpdatedAt           time.Time      `json:"updated_at"`
+	DepositSettlementID uuid.NullUUID  `json:"deposit_settlement_id"`
+	MonthlyRentValue    string         `json:"monthly_rent_value"`
+	PaymentDueDay       int32          `json:"payment_due_day"`
+	UnitNumber          string         `json:"unit_number"`
+	TenantName          string         `json:"tenant_name"`
+	TenantPhone         string         `json:"tenant_phone"`
 }
 
 func (q *Queries) GetPaymentWithLeaseDetails(ctx context.Context, id uuid.UUID) (GetPaymentWithLeaseDetailsRow, error) {
@@ -291,6 +448,7 @@ func (q *Queries) GetPaymentWithLeaseDetails(ctx context.Context, id uuid.UUID)
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DepositSettlementID,
 		&i.MonthlyRentValue,
 		&i.PaymentDueDay,
 		&i.UnitNumber,
@@ -329,7 +487,7 @@ func (q *Queries) GetTotalPaidByLease(ctx context.Context, leaseID uuid.UUID) (s
 }
 
 const getUpcomingPayments = `-- name: GetUpcomingPayments :many
-SELECT id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at FROM payments
+SELECT id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at, deposit_settlement_id FROM payments
 WHERE status = 'pending'
   AND due_date >= CURRENT_DATE
   AND due_date <= CURRENT_DATE + $1::INTEGER
@@ -359,6 +517,61 @@ func (q *Queries) GetUpcomingPayments(ctx context.Context, dollar_1 int32) ([]Pa
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DepositSettlementID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUpcomingPaymentsByPropertyID = `-- name: GetUpcomingPaymentsByPropertyID :many
+SELECT p.id, p.lease_id, p.payment_type, p.reference_month, p.amount, p.status, p.due_date, p.payment_date, p.payment_method, p.proof_url, p.notes, p.created_at, p.updated_at, p.deposit_settlement_id FROM payments p
+INNER JOIN leases l ON p.lease_id = l.id
+INNER JOIN units u ON l.unit_id = u.id
+WHERE p.status = 'pending'
+  AND p.due_date >= CURRENT_DATE
+  AND p.due_date <= CURRENT_DATE + $1::INTEGER
+  AND u.property_id = $2
+ORDER BY p.due_date ASC
+`
+
+type GetUpcomingPaymentsByPropertyIDParams struct {
+	Days       int32     `json:"days"`
+	PropertyID uuid.UUID `json:"property_id"`
+}
+
+func (q *Queries) GetUpcomingPaymentsByPropertyID(ctx context.Context, arg GetUpcomingPaymentsByPropertyIDParams) ([]Payment, error) {
+	rows, err := q.db.QueryContext(ctx, getUpcomingPaymentsByPropertyID, arg.Days, arg.PropertyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Payment{}
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.ID,
+			&i.LeaseID,
+			&i.PaymentType,
+			&i.ReferenceMonth,
+			&i.Amount,
+			&i.Status,
+			&i.DueDate,
+			&i.PaymentDate,
+			&i.PaymentMethod,
+			&i.ProofUrl,
+			&i.Notes,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DepositSettlementID,
 		); err != nil {
 			return nil, err
 		}
@@ -374,7 +587,7 @@ func (q *Queries) GetUpcomingPayments(ctx context.Context, dollar_1 int32) ([]Pa
 }
 
 const listPayments = `-- name: ListPayments :many
-SELECT id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at FROM payments
+SELECT id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at, deposit_settlement_id FROM payments
 ORDER BY due_date DESC
 `
 
@@ -401,6 +614,7 @@ func (q *Queries) ListPayments(ctx context.Context) ([]Payment, error) {
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DepositSettlementID,
 		); err != nil {
 			return nil, err
 		}
@@ -416,7 +630,7 @@ func (q *Queries) ListPayments(ctx context.Context) ([]Payment, error) {
 }
 
 const listPaymentsByLeaseID = `-- name: ListPaymentsByLeaseID :many
-SELECT id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at FROM payments
+SELECT id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at, deposit_settlement_id FROM payments
 WHERE lease_id = $1
 ORDER BY due_date ASC
 `
@@ -444,6 +658,7 @@ func (q *Queries) ListPaymentsByLeaseID(ctx context.Context, leaseID uuid.UUID)
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DepositSettlementID,
 		); err != nil {
 			return nil, err
 		}
@@ -459,7 +674,7 @@ func (q *Queries) ListPaymentsByLeaseID(ctx context.Context, leaseID uuid.UUID)
 }
 
 const listPaymentsByStatus = `-- name: ListPaymentsByStatus :many
-SELECT id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at FROM payments
+SELECT id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at, deposit_settlement_id FROM payments
 WHERE status = $1
 ORDER BY due_date ASC
 `
@@ -487,6 +702,52 @@ func (q *Queries) ListPaymentsByStatus(ctx context.Context, status string) ([]Pa
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DepositSettlementID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPaymentsPaidSince = `-- name: ListPaymentsPaidSince :many
+SELECT id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at, deposit_settlement_id FROM payments
+WHERE status = 'paid'
+  AND payment_date >= $1::DATE
+ORDER BY payment_date ASC
+`
+
+func (q *Queries) ListPaymentsPaidSince(ctx context.Context, since time.Time) ([]Payment, error) {
+	rows, err := q.db.QueryContext(ctx, listPaymentsPaidSince, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Payment{}
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.ID,
+			&i.LeaseID,
+			&i.PaymentType,
+			&i.ReferenceMonth,
+			&i.Amount,
+			&i.Status,
+			&i.DueDate,
+			&i.PaymentDate,
+			&i.PaymentMethod,
+			&i.ProofUrl,
+			&i.Notes,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DepositSettlementID,
 		); err != nil {
 			return nil, err
 		}
@@ -503,7 +764,7 @@ func (q *Queries) ListPaymentsByStatus(ctx context.Context, status string) ([]Pa
 
 const listPaymentsWithLeaseDetails = `-- name: ListPaymentsWithLeaseDetails :many
 SELECT 
-    p.id, p.lease_id, p.payment_type, p.reference_month, p.amount, p.status, p.due_date, p.payment_date, p.payment_method, p.proof_url, p.notes, p.created_at, p.updated_at,
+    p.id, p.lease_id, p.payment_type, p.reference_month, p.amount, p.status, p.due_date, p.payment_date, p.payment_method, p.proof_url, p.notes, p.created_at, p.updated_at, p.deposit_settlement_id,
     l.monthly_rent_value,
     l.payment_due_day,
     u.number as unit_number,
@@ -517,24 +778,25 @@ ORDER BY p.due_date DESC
 `
 
 type ListPaymentsWithLeaseDetailsRow struct {
-	ID               uuid.UUID      `json:"id"`
-	LeaseID          uuid.UUID      `json:"lease_id"`
-	PaymentType      string         `json:"payment_type"`
-	ReferenceMonth   time.Time      `json:"reference_month"`
-	Amount           string         `json:"amount"`
-	Status           string         `json:"status"`
-	DueDate          time.Time      `json:"due_date"`
-	PaymentDate      sql.NullTime   `json:"payment_date"`
-	PaymentMethod    sql.NullString `json:"payment_method"`
-	ProofUrl         sql.NullString `json:"proof_url"`
-	Notes            sql.NullString `json:"notes"`
-	CreatedAt        time.Time      `json:"created_at"`
-	UpdatedAt        time.Time      `json:"updated_at"`
-	MonthlyRentValue string         `json:"monthly_rent_value"`
-	PaymentDueDay    int32          `json:"payment_due_day"`
-	UnitNumber       string         `json:"unit_number"`
-	TenantName       string         `json:"tenant_name"`
-	TenantPhone      string         `json:"tenant_phone"`
+	ID                  uuid.UUID      `json:"id"`
+	LeaseID             uuid.UUID      `json:"lease_id"`
+	PaymentType         string         `json:"payment_type"`
+	ReferenceMonth      time.Time      `json:"reference_month"`
+	Amount              string         `json:"amount"`
+	Status              string         `json:"status"`
+	DueDate             time.Time      `json:"due_date"`
+	PaymentDate         sql.NullTime   `json:"payment_date"`
+	PaymentMethod       sql.NullString `json:"payment_method"`
+	ProofUrl            sql.NullString `json:"proof_url"`
+	Notes               sql.NullString `json:"notes"`
+	CreatedAt           time.Time      `json:"created_at"`
+	UpdatedAt           time.Time      `json:"updated_at"`
+	DepositSettlementID uuid.NullUUID  `json:"deposit_settlement_id"`
+	MonthlyRentValue    string         `json:"monthly_rent_value"`
+	PaymentDueDay       int32          `json:"payment_due_day"`
+	UnitNumber          string         `json:"unit_number"`
+	TenantName          string         `json:"tenant_name"`
+	TenantPhone         string         `json:"tenant_phone"`
 }
 
 func (q *Queries) ListPaymentsWithLeaseDetails(ctx context.Context) ([]ListPaymentsWithLeaseDetailsRow, error) {
@@ -560,6 +822,7 @@ func (q *Queries) ListPaymentsWithLeaseDetails(ctx context.Context) ([]ListPayme
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.DepositSettlementID,
 			&i.MonthlyRentValue,
 			&i.PaymentDueDay,
 			&i.UnitNumber,
@@ -587,7 +850,7 @@ SET
     payment_method = $3,
     updated_at = $4
 WHERE id = $1
-RETURNING id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at
+RETURNING id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at, deposit_settlement_id
 `
 
 type MarkPaymentAsPaidParams struct {
@@ -619,22 +882,57 @@ func (q *Queries) MarkPaymentAsPaid(ctx context.Context, arg MarkPaymentAsPaidPa
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DepositSettlementID,
 	)
 	return i, err
 }
 
-const markPaymentsAsOverdue = `-- name: MarkPaymentsAsOverdue :exec
+const markPaymentsAsOverdue = `-- name: MarkPaymentsAsOverdue :many
 UPDATE payments
 SET
     status = 'overdue',
     updated_at = NOW()
 WHERE status = 'pending'
   AND due_date < CURRENT_DATE
+RETURNING id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at, deposit_settlement_id
 `
 
-func (q *Queries) MarkPaymentsAsOverdue(ctx context.Context) error {
-	_, err := q.db.ExecContext(ctx, markPaymentsAsOverdue)
-	return err
+func (q *Queries) MarkPaymentsAsOverdue(ctx context.Context) ([]Payment, error) {
+	rows, err := q.db.QueryContext(ctx, markPaymentsAsOverdue)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Payment{}
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.ID,
+			&i.LeaseID,
+			&i.PaymentType,
+			&i.ReferenceMonth,
+			&i.Amount,
+			&i.Status,
+			&i.DueDate,
+			&i.PaymentDate,
+			&i.PaymentMethod,
+			&i.ProofUrl,
+			&i.Notes,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DepositSettlementID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const updatePayment = `-- name: UpdatePayment :one
@@ -652,7 +950,7 @@ SET
     notes = $11,
     updated_at = $12
 WHERE id = $1
-RETURNING id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at
+RETURNING id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at, deposit_settlement_id
 `
 
 type UpdatePaymentParams struct {
@@ -700,6 +998,7 @@ func (q *Queries) UpdatePayment(ctx context.Context, arg UpdatePaymentParams) (P
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DepositSettlementID,
 	)
 	return i, err
 }
@@ -710,7 +1009,7 @@ SET
     status = $2,
     updated_at = $3
 WHERE id = $1
-RETURNING id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at
+RETURNING id, lease_id, payment_type, reference_month, amount, status, due_date, payment_date, payment_method, proof_url, notes, created_at, updated_at, deposit_settlement_id
 `
 
 type UpdatePaymentStatusParams struct {
@@ -736,6 +1035,7 @@ func (q *Queries) UpdatePaymentStatus(ctx context.Context, arg UpdatePaymentStat
 		&i.Notes,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DepositSettlementID,
 	)
 	return i, err
 }
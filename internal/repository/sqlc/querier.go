@@ -7,14 +7,21 @@ package sqlc
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
 	ActivateUser(ctx context.Context, arg ActivateUserParams) error
+	AnonymizeConvertedProspects(ctx context.Context, arg AnonymizeConvertedProspectsParams) error
+	AnonymizeTenant(ctx context.Context, arg AnonymizeTenantParams) (Tenant, error)
+	AnonymizeTenantNotifications(ctx context.Context, arg AnonymizeTenantNotificationsParams) error
 	CancelPayment(ctx context.Context, arg CancelPaymentParams) (Payment, error)
+	ClearConvertedProspectVisitNotes(ctx context.Context, arg ClearConvertedProspectVisitNotesParams) error
+	CountActiveRenovationProjectsByUnitID(ctx context.Context, unitID uuid.UUID) (int64, error)
 	CountActiveUsers(ctx context.Context) (int64, error)
+	CountActiveVacancyTicketsByUnitID(ctx context.Context, unitID uuid.UUID) (int64, error)
 	CountAdjustmentsByLeaseID(ctx context.Context, leaseID uuid.UUID) (int64, error)
 	CountLeases(ctx context.Context) (int64, error)
 	CountLeasesByStatus(ctx context.Context, status string) (int64, error)
@@ -23,77 +30,234 @@ type Querier interface {
 	CountPaymentsByStatus(ctx context.Context, status string) (int64, error)
 	CountTenants(ctx context.Context) (int64, error)
 	CountUnits(ctx context.Context) (int64, error)
+	CountUnitsByPropertyID(ctx context.Context, propertyID uuid.UUID) (int64, error)
 	CountUnitsByStatus(ctx context.Context, status UnitStatus) (int64, error)
 	CountUsers(ctx context.Context) (int64, error)
+	CountUtilityChargesByLeaseAndMonth(ctx context.Context, arg CountUtilityChargesByLeaseAndMonthParams) (int64, error)
+	CreateDepositSettlement(ctx context.Context, arg CreateDepositSettlementParams) (LeaseDepositSettlement, error)
+	CreateDunningStep(ctx context.Context, arg CreateDunningStepParams) (DunningStep, error)
+	CreateInventoryChecklist(ctx context.Context, arg CreateInventoryChecklistParams) (InventoryChecklist, error)
+	CreateInventoryChecklistItem(ctx context.Context, arg CreateInventoryChecklistItemParams) (InventoryChecklistItem, error)
 	CreateLease(ctx context.Context, arg CreateLeaseParams) (Lease, error)
 	CreateLeaseRentAdjustment(ctx context.Context, arg CreateLeaseRentAdjustmentParams) (LeaseRentAdjustment, error)
+	CreateMaintenanceTicket(ctx context.Context, arg CreateMaintenanceTicketParams) (MaintenanceTicket, error)
+	CreateMaintenanceTicketPhoto(ctx context.Context, arg CreateMaintenanceTicketPhotoParams) (MaintenanceTicketPhoto, error)
+	CreateMeterReading(ctx context.Context, arg CreateMeterReadingParams) (MeterReading, error)
+	CreateNotification(ctx context.Context, arg CreateNotificationParams) (Notification, error)
 	CreatePayment(ctx context.Context, arg CreatePaymentParams) (Payment, error)
+	CreatePaymentCollectionAction(ctx context.Context, arg CreatePaymentCollectionActionParams) (PaymentCollectionAction, error)
+	CreateProperty(ctx context.Context, arg CreatePropertyParams) (Property, error)
+	CreateProspect(ctx context.Context, arg CreateProspectParams) (Prospect, error)
+	CreateProspectVisit(ctx context.Context, arg CreateProspectVisitParams) (ProspectVisit, error)
+	CreateRenovationExpense(ctx context.Context, arg CreateRenovationExpenseParams) (RenovationExpense, error)
+	CreateRenovationProject(ctx context.Context, arg CreateRenovationProjectParams) (RenovationProject, error)
 	CreateTenant(ctx context.Context, arg CreateTenantParams) (Tenant, error)
+	CreateTenantContact(ctx context.Context, arg CreateTenantContactParams) (TenantContact, error)
+	CreateTenantDataRequest(ctx context.Context, arg CreateTenantDataRequestParams) (TenantDataRequest, error)
+	CreateTenantDocument(ctx context.Context, arg CreateTenantDocumentParams) (TenantDocument, error)
+	CreateTenantLoginCode(ctx context.Context, arg CreateTenantLoginCodeParams) (TenantLoginCode, error)
+	CreateTenantOccupant(ctx context.Context, arg CreateTenantOccupantParams) (TenantOccupant, error)
+	CreateTenantPet(ctx context.Context, arg CreateTenantPetParams) (TenantPet, error)
 	CreateUnit(ctx context.Context, arg CreateUnitParams) (Unit, error)
+	CreateUnitInventoryItem(ctx context.Context, arg CreateUnitInventoryItemParams) (UnitInventoryItem, error)
+	CreateUnitStatusChange(ctx context.Context, arg CreateUnitStatusChangeParams) (UnitStatusHistory, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateUtilityCharge(ctx context.Context, arg CreateUtilityChargeParams) (UtilityCharge, error)
+	CreateUtilityTariff(ctx context.Context, arg CreateUtilityTariffParams) (UtilityTariff, error)
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error)
+	CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (WebhookEndpoint, error)
+	CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) error
 	DeactivateUser(ctx context.Context, arg DeactivateUserParams) error
+	DeleteAllTenantContacts(ctx context.Context, tenantID uuid.UUID) error
+	DeleteCalendarFeed(ctx context.Context, userID uuid.UUID) error
 	DeleteLease(ctx context.Context, id uuid.UUID) error
 	DeleteLeaseRentAdjustment(ctx context.Context, id uuid.UUID) error
+	DeleteMeterReading(ctx context.Context, id uuid.UUID) error
 	DeletePayment(ctx context.Context, id uuid.UUID) error
+	DeleteProperty(ctx context.Context, id uuid.UUID) error
+	DeleteProspect(ctx context.Context, id uuid.UUID) error
+	DeleteRenovationExpense(ctx context.Context, id uuid.UUID) error
 	DeleteTenant(ctx context.Context, id uuid.UUID) error
+	DeleteTenantContactsByType(ctx context.Context, arg DeleteTenantContactsByTypeParams) error
+	DeleteTenantDocument(ctx context.Context, id uuid.UUID) error
+	DeleteTenantDocumentsByTenantID(ctx context.Context, tenantID uuid.UUID) error
+	DeleteTenantOccupantsByTenantID(ctx context.Context, tenantID uuid.UUID) error
+	DeleteTenantPetsByTenantID(ctx context.Context, tenantID uuid.UUID) error
 	DeleteUnit(ctx context.Context, id uuid.UUID) error
+	DeleteUnitInventoryItem(ctx context.Context, id uuid.UUID) error
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	DeleteUserByTenantID(ctx context.Context, tenantID uuid.NullUUID) error
+	DeleteWebhookEndpoint(ctx context.Context, id uuid.UUID) error
+	DeleteWebhookSubscriptionsByEndpointID(ctx context.Context, endpointID uuid.UUID) error
 	GetActiveLeaseByTenantID(ctx context.Context, tenantID uuid.UUID) (Lease, error)
 	GetActiveLeaseByUnitID(ctx context.Context, unitID uuid.UUID) (Lease, error)
+	GetActiveTenantLoginCode(ctx context.Context, arg GetActiveTenantLoginCodeParams) (TenantLoginCode, error)
+	GetCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (CalendarFeed, error)
+	GetCalendarFeedByUserID(ctx context.Context, userID uuid.UUID) (CalendarFeed, error)
+	GetDepositSettlementByLeaseID(ctx context.Context, leaseID uuid.UUID) (LeaseDepositSettlement, error)
+	GetDunningStepByCode(ctx context.Context, code string) (DunningStep, error)
+	GetDunningStepByID(ctx context.Context, id uuid.UUID) (DunningStep, error)
+	GetEffectiveUtilityTariff(ctx context.Context, arg GetEffectiveUtilityTariffParams) (UtilityTariff, error)
 	GetExpiringSoonLeases(ctx context.Context) ([]Lease, error)
+	GetFinancialMetricsByProperty(ctx context.Context) ([]GetFinancialMetricsByPropertyRow, error)
+	GetFinancialReportByMonth(ctx context.Context, arg GetFinancialReportByMonthParams) ([]GetFinancialReportByMonthRow, error)
+	GetFinancialReportByProperty(ctx context.Context, arg GetFinancialReportByPropertyParams) ([]GetFinancialReportByPropertyRow, error)
+	GetFinancialReportByType(ctx context.Context, arg GetFinancialReportByTypeParams) ([]GetFinancialReportByTypeRow, error)
+	GetFinancialReportByUnit(ctx context.Context, arg GetFinancialReportByUnitParams) ([]GetFinancialReportByUnitRow, error)
+	GetFinancialReportTotals(ctx context.Context, arg GetFinancialReportTotalsParams) (GetFinancialReportTotalsRow, error)
+	GetInventoryChecklistByLeaseAndType(ctx context.Context, arg GetInventoryChecklistByLeaseAndTypeParams) (InventoryChecklist, error)
 	GetLatestAdjustmentByLeaseID(ctx context.Context, leaseID uuid.UUID) (LeaseRentAdjustment, error)
+	GetLatestUnitStatusChangeByUnitID(ctx context.Context, unitID uuid.UUID) (UnitStatusHistory, error)
 	GetLeaseByID(ctx context.Context, id uuid.UUID) (Lease, error)
 	GetLeaseRentAdjustmentByID(ctx context.Context, id uuid.UUID) (LeaseRentAdjustment, error)
 	GetLeaseWithDetails(ctx context.Context, id uuid.UUID) (GetLeaseWithDetailsRow, error)
+	GetMaintenanceTicketByID(ctx context.Context, id uuid.UUID) (MaintenanceTicket, error)
+	GetMeterReading(ctx context.Context, arg GetMeterReadingParams) (MeterReading, error)
+	GetMeterReadingByID(ctx context.Context, id uuid.UUID) (MeterReading, error)
 	GetMonthlyProjectedRevenue(ctx context.Context) (string, error)
 	GetMonthlyRealizedRevenue(ctx context.Context) (string, error)
+	GetNotificationByID(ctx context.Context, id uuid.UUID) (Notification, error)
+	GetNotificationByProviderMessageID(ctx context.Context, providerMessageID sql.NullString) (Notification, error)
 	GetOccupancyMetrics(ctx context.Context) (GetOccupancyMetricsRow, error)
+	GetOccupancyMetricsByProperty(ctx context.Context) ([]GetOccupancyMetricsByPropertyRow, error)
 	GetOverdueAmount(ctx context.Context) (string, error)
 	GetOverduePayments(ctx context.Context) ([]Payment, error)
+	GetOverduePaymentsByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]Payment, error)
+	GetOverdueSettledSince(ctx context.Context, since time.Time) ([]Payment, error)
+	GetOverdueSettledSinceByPropertyID(ctx context.Context, arg GetOverdueSettledSinceByPropertyIDParams) ([]Payment, error)
 	GetPaymentByID(ctx context.Context, id uuid.UUID) (Payment, error)
+	GetPaymentHistoryTotals(ctx context.Context, arg GetPaymentHistoryTotalsParams) (GetPaymentHistoryTotalsRow, error)
 	GetPaymentWithLeaseDetails(ctx context.Context, id uuid.UUID) (GetPaymentWithLeaseDetailsRow, error)
 	GetPendingAmountByLease(ctx context.Context, leaseID uuid.UUID) (string, error)
+	GetPreviousMeterReading(ctx context.Context, arg GetPreviousMeterReadingParams) (MeterReading, error)
+	GetPropertyByID(ctx context.Context, id uuid.UUID) (Property, error)
+	GetPropertyByName(ctx context.Context, name string) (Property, error)
+	GetProspectByID(ctx context.Context, id uuid.UUID) (Prospect, error)
+	GetProspectVisitByID(ctx context.Context, id uuid.UUID) (ProspectVisit, error)
+	GetRenovationExpenseByID(ctx context.Context, id uuid.UUID) (RenovationExpense, error)
+	GetRenovationProjectByID(ctx context.Context, id uuid.UUID) (RenovationProject, error)
 	GetTenantByCPF(ctx context.Context, cpf string) (Tenant, error)
 	GetTenantByID(ctx context.Context, id uuid.UUID) (Tenant, error)
+	GetTenantDocumentByID(ctx context.Context, id uuid.UUID) (TenantDocument, error)
 	GetTotalPaidByLease(ctx context.Context, leaseID uuid.UUID) (string, error)
 	GetTotalPendingAmount(ctx context.Context) (string, error)
+	GetTotalRenovationExpensesByProjectID(ctx context.Context, projectID uuid.UUID) (string, error)
 	GetUnitByID(ctx context.Context, id uuid.UUID) (Unit, error)
-	GetUnitByNumber(ctx context.Context, number string) (Unit, error)
+	GetUnitByNumber(ctx context.Context, arg GetUnitByNumberParams) (Unit, error)
+	GetUnitInventoryItemByID(ctx context.Context, id uuid.UUID) (UnitInventoryItem, error)
 	GetUpcomingPayments(ctx context.Context, dollar_1 int32) ([]Payment, error)
+	GetUpcomingPaymentsByPropertyID(ctx context.Context, arg GetUpcomingPaymentsByPropertyIDParams) ([]Payment, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (User, error)
+	GetUserByTenantID(ctx context.Context, tenantID uuid.NullUUID) (User, error)
 	GetUserByUsername(ctx context.Context, username string) (User, error)
+	GetWebhookDeliveryByID(ctx context.Context, id uuid.UUID) (WebhookDelivery, error)
+	GetWebhookEndpointByID(ctx context.Context, id uuid.UUID) (WebhookEndpoint, error)
+	InvalidateTenantLoginCodes(ctx context.Context, arg InvalidateTenantLoginCodesParams) error
+	ListActiveDunningSteps(ctx context.Context) ([]DunningStep, error)
+	ListActiveWebhookEndpointsByEvent(ctx context.Context, eventType string) ([]WebhookEndpoint, error)
 	ListAvailableUnits(ctx context.Context) ([]Unit, error)
+	ListDueNotifications(ctx context.Context, arg ListDueNotificationsParams) ([]Notification, error)
+	ListDueWebhookDeliveries(ctx context.Context, arg ListDueWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListDunningSteps(ctx context.Context) ([]DunningStep, error)
+	ListInventoryChecklistItemsByChecklistID(ctx context.Context, checklistID uuid.UUID) ([]InventoryChecklistItem, error)
 	ListLeaseRentAdjustmentsByLeaseID(ctx context.Context, leaseID uuid.UUID) ([]LeaseRentAdjustment, error)
 	ListLeases(ctx context.Context) ([]Lease, error)
+	ListLeasesByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]Lease, error)
 	ListLeasesByStatus(ctx context.Context, status string) ([]Lease, error)
 	ListLeasesByTenantID(ctx context.Context, tenantID uuid.UUID) ([]Lease, error)
 	ListLeasesByUnitID(ctx context.Context, unitID uuid.UUID) ([]Lease, error)
 	ListLeasesWithDetails(ctx context.Context) ([]ListLeasesWithDetailsRow, error)
+	ListMaintenanceTicketPhotosByTicketID(ctx context.Context, ticketID uuid.UUID) ([]MaintenanceTicketPhoto, error)
+	ListMaintenanceTickets(ctx context.Context) ([]MaintenanceTicket, error)
+	ListMaintenanceTicketsByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]MaintenanceTicket, error)
+	ListMaintenanceTicketsByStatus(ctx context.Context, status string) ([]MaintenanceTicket, error)
+	ListMaintenanceTicketsByTenantID(ctx context.Context, tenantID uuid.NullUUID) ([]MaintenanceTicket, error)
+	ListMaintenanceTicketsByUnitID(ctx context.Context, unitID uuid.UUID) ([]MaintenanceTicket, error)
+	ListMeterReadingsByUnitID(ctx context.Context, unitID uuid.UUID) ([]MeterReading, error)
+	ListNotifications(ctx context.Context) ([]Notification, error)
+	ListNotificationsByLeaseID(ctx context.Context, leaseID uuid.NullUUID) ([]Notification, error)
+	ListNotificationsByStatus(ctx context.Context, status string) ([]Notification, error)
+	ListNotificationsByTenantID(ctx context.Context, tenantID uuid.UUID) ([]Notification, error)
+	ListOpenPaymentCollectionActionsByAction(ctx context.Context, action string) ([]PaymentCollectionAction, error)
+	ListOpenProspects(ctx context.Context) ([]Prospect, error)
+	ListPaymentCollectionActionsByPaymentID(ctx context.Context, paymentID uuid.UUID) ([]PaymentCollectionAction, error)
+	ListPaymentHistory(ctx context.Context, arg ListPaymentHistoryParams) ([]ListPaymentHistoryRow, error)
+	ListPaymentIDsByDepositSettlementID(ctx context.Context, depositSettlementID uuid.NullUUID) ([]uuid.UUID, error)
 	ListPayments(ctx context.Context) ([]Payment, error)
 	ListPaymentsByLeaseID(ctx context.Context, leaseID uuid.UUID) ([]Payment, error)
 	ListPaymentsByStatus(ctx context.Context, status string) ([]Payment, error)
+	ListPaymentsPaidSince(ctx context.Context, since time.Time) ([]Payment, error)
 	ListPaymentsWithLeaseDetails(ctx context.Context) ([]ListPaymentsWithLeaseDetailsRow, error)
+	ListProperties(ctx context.Context) ([]Property, error)
+	ListProspectVisitsByProspectID(ctx context.Context, prospectID uuid.UUID) ([]ProspectVisit, error)
+	ListProspects(ctx context.Context) ([]Prospect, error)
+	ListProspectsByConvertedTenantID(ctx context.Context, convertedTenantID uuid.NullUUID) ([]Prospect, error)
+	ListProspectsByStatus(ctx context.Context, status string) ([]Prospect, error)
+	ListRenovationExpensesByProjectID(ctx context.Context, projectID uuid.UUID) ([]RenovationExpense, error)
+	ListRenovationProjects(ctx context.Context) ([]RenovationProject, error)
+	ListRenovationProjectsByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]RenovationProject, error)
+	ListRenovationProjectsByStatus(ctx context.Context, status string) ([]RenovationProject, error)
+	ListRenovationProjectsByUnitID(ctx context.Context, unitID uuid.UUID) ([]RenovationProject, error)
+	ListTenantContactsByTenantID(ctx context.Context, tenantID uuid.UUID) ([]TenantContact, error)
+	ListTenantDataRequestsByTenantID(ctx context.Context, tenantID uuid.UUID) ([]TenantDataRequest, error)
+	ListTenantDocumentsByLeaseID(ctx context.Context, leaseID uuid.NullUUID) ([]TenantDocument, error)
+	ListTenantDocumentsByTenantID(ctx context.Context, tenantID uuid.UUID) ([]TenantDocument, error)
+	ListTenantOccupantsByTenantID(ctx context.Context, tenantID uuid.UUID) ([]TenantOccupant, error)
+	ListTenantPetsByTenantID(ctx context.Context, tenantID uuid.UUID) ([]TenantPet, error)
 	ListTenants(ctx context.Context) ([]Tenant, error)
+	ListTenantsByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]Tenant, error)
+	ListUnitInventoryItemsByUnitID(ctx context.Context, unitID uuid.UUID) ([]UnitInventoryItem, error)
+	ListUnitStatusChangesByUnitID(ctx context.Context, unitID uuid.UUID) ([]UnitStatusHistory, error)
+	ListUnitStatusChangesUntil(ctx context.Context, changedAt time.Time) ([]UnitStatusHistory, error)
 	ListUnits(ctx context.Context) ([]Unit, error)
 	ListUnitsByFloor(ctx context.Context, floor int32) ([]Unit, error)
+	ListUnitsByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]Unit, error)
 	ListUnitsByStatus(ctx context.Context, status UnitStatus) ([]Unit, error)
+	ListUpcomingProspectVisits(ctx context.Context, scheduledAt time.Time) ([]ProspectVisit, error)
 	ListUsers(ctx context.Context) ([]User, error)
 	ListUsersByRole(ctx context.Context, role UserRole) ([]User, error)
+	ListUtilityChargesByPaymentID(ctx context.Context, paymentID uuid.UUID) ([]UtilityCharge, error)
+	ListUtilityTariffs(ctx context.Context) ([]UtilityTariff, error)
+	ListWebhookDeliveriesByEndpointID(ctx context.Context, arg ListWebhookDeliveriesByEndpointIDParams) ([]WebhookDelivery, error)
+	ListWebhookEndpoints(ctx context.Context) ([]WebhookEndpoint, error)
+	ListWebhookSubscriptions(ctx context.Context) ([]WebhookSubscription, error)
+	ListWebhookSubscriptionsByEndpointID(ctx context.Context, endpointID uuid.UUID) ([]WebhookSubscription, error)
+	MarkDepositSettlementRefunded(ctx context.Context, arg MarkDepositSettlementRefundedParams) (LeaseDepositSettlement, error)
 	MarkPaymentAsPaid(ctx context.Context, arg MarkPaymentAsPaidParams) (Payment, error)
-	MarkPaymentsAsOverdue(ctx context.Context) error
+	MarkPaymentsAsOverdue(ctx context.Context) ([]Payment, error)
+	SearchLeases(ctx context.Context, arg SearchLeasesParams) ([]SearchLeasesRow, error)
+	SearchPaymentsByAmount(ctx context.Context, arg SearchPaymentsByAmountParams) ([]SearchPaymentsByAmountRow, error)
+	SearchPaymentsByReferenceMonth(ctx context.Context, arg SearchPaymentsByReferenceMonthParams) ([]SearchPaymentsByReferenceMonthRow, error)
+	SearchTenants(ctx context.Context, arg SearchTenantsParams) ([]SearchTenantsRow, error)
 	SearchTenantsByName(ctx context.Context, dollar_1 sql.NullString) ([]Tenant, error)
+	SearchUnits(ctx context.Context, arg SearchUnitsParams) ([]SearchUnitsRow, error)
+	SettlePaymentWithDeposit(ctx context.Context, arg SettlePaymentWithDepositParams) error
 	TenantExistsByCPF(ctx context.Context, cpf string) (bool, error)
+	TouchCalendarFeed(ctx context.Context, arg TouchCalendarFeedParams) error
+	UpdateDunningStep(ctx context.Context, arg UpdateDunningStepParams) (DunningStep, error)
 	UpdateLastLogin(ctx context.Context, arg UpdateLastLoginParams) (User, error)
 	UpdateLease(ctx context.Context, arg UpdateLeaseParams) (Lease, error)
+	UpdateLeaseDepositReceived(ctx context.Context, arg UpdateLeaseDepositReceivedParams) (Lease, error)
 	UpdateLeaseStatus(ctx context.Context, arg UpdateLeaseStatusParams) (Lease, error)
+	UpdateMaintenanceTicket(ctx context.Context, arg UpdateMaintenanceTicketParams) (MaintenanceTicket, error)
+	UpdateNotificationDelivery(ctx context.Context, arg UpdateNotificationDeliveryParams) (Notification, error)
 	UpdatePaintingFeePaid(ctx context.Context, arg UpdatePaintingFeePaidParams) (Lease, error)
 	UpdatePayment(ctx context.Context, arg UpdatePaymentParams) (Payment, error)
 	UpdatePaymentStatus(ctx context.Context, arg UpdatePaymentStatusParams) (Payment, error)
+	UpdateProperty(ctx context.Context, arg UpdatePropertyParams) (Property, error)
+	UpdateProspect(ctx context.Context, arg UpdateProspectParams) (Prospect, error)
+	UpdateProspectVisit(ctx context.Context, arg UpdateProspectVisitParams) (ProspectVisit, error)
+	UpdateRenovationProject(ctx context.Context, arg UpdateRenovationProjectParams) (RenovationProject, error)
 	UpdateTenant(ctx context.Context, arg UpdateTenantParams) (Tenant, error)
+	UpdateTenantLoginCode(ctx context.Context, arg UpdateTenantLoginCodeParams) error
 	UpdateUnit(ctx context.Context, arg UpdateUnitParams) (Unit, error)
+	UpdateUnitInventoryItem(ctx context.Context, arg UpdateUnitInventoryItemParams) (UnitInventoryItem, error)
 	UpdateUnitStatus(ctx context.Context, arg UpdateUnitStatusParams) (Unit, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
 	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) (User, error)
+	UpdateWebhookDeliveryAttempt(ctx context.Context, arg UpdateWebhookDeliveryAttemptParams) (WebhookDelivery, error)
+	UpdateWebhookEndpoint(ctx context.Context, arg UpdateWebhookEndpointParams) (WebhookEndpoint, error)
+	UpsertCalendarFeed(ctx context.Context, arg UpsertCalendarFeedParams) (CalendarFeed, error)
 	UserExistsByUsername(ctx context.Context, username string) (bool, error)
 }
 
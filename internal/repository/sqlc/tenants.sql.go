@@ -37,7 +37,7 @@ INSERT INTO tenants (
     updated_at
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7, $8, $9
-) RETURNING id, full_name, cpf, phone, email, id_document_type, id_document_number, created_at, updated_at
+) RETURNING id, full_name, cpf, phone, email, id_document_type, id_document_number, created_at, updated_at, employer_name, employer_phone, employer_job_title, employer_monthly_income, employer_start_date, anonymized_at, anonymized_by, messaging_opt_out_at
 `
 
 type CreateTenantParams struct {
@@ -75,6 +75,14 @@ func (q *Queries) CreateTenant(ctx context.Context, arg CreateTenantParams) (Ten
 		&i.IDDocumentNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.EmployerName,
+		&i.EmployerPhone,
+		&i.EmployerJobTitle,
+		&i.EmployerMonthlyIncome,
+		&i.EmployerStartDate,
+		&i.AnonymizedAt,
+		&i.AnonymizedBy,
+		&i.MessagingOptOutAt,
 	)
 	return i, err
 }
@@ -90,7 +98,7 @@ func (q *Queries) DeleteTenant(ctx context.Context, id uuid.UUID) error {
 }
 
 const getTenantByCPF = `-- name: GetTenantByCPF :one
-SELECT id, full_name, cpf, phone, email, id_document_type, id_document_number, created_at, updated_at FROM tenants
+SELECT id, full_name, cpf, phone, email, id_document_type, id_document_number, created_at, updated_at, employer_name, employer_phone, employer_job_title, employer_monthly_income, employer_start_date, anonymized_at, anonymized_by, messaging_opt_out_at FROM tenants
 WHERE cpf = $1
 LIMIT 1
 `
@@ -108,12 +116,20 @@ func (q *Queries) GetTenantByCPF(ctx context.Context, cpf string) (Tenant, error
 		&i.IDDocumentNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.EmployerName,
+		&i.EmployerPhone,
+		&i.EmployerJobTitle,
+		&i.EmployerMonthlyIncome,
+		&i.EmployerStartDate,
+		&i.AnonymizedAt,
+		&i.AnonymizedBy,
+		&i.MessagingOptOutAt,
 	)
 	return i, err
 }
 
 const getTenantByID = `-- name: GetTenantByID :one
-SELECT id, full_name, cpf, phone, email, id_document_type, id_document_number, created_at, updated_at FROM tenants
+SELECT id, full_name, cpf, phone, email, id_document_type, id_document_number, created_at, updated_at, employer_name, employer_phone, employer_job_title, employer_monthly_income, employer_start_date, anonymized_at, anonymized_by, messaging_opt_out_at FROM tenants
 WHERE id = $1
 LIMIT 1
 `
@@ -131,12 +147,20 @@ func (q *Queries) GetTenantByID(ctx context.Context, id uuid.UUID) (Tenant, erro
 		&i.IDDocumentNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.EmployerName,
+		&i.EmployerPhone,
+		&i.EmployerJobTitle,
+		&i.EmployerMonthlyIncome,
+		&i.EmployerStartDate,
+		&i.AnonymizedAt,
+		&i.AnonymizedBy,
+		&i.MessagingOptOutAt,
 	)
 	return i, err
 }
 
 const listTenants = `-- name: ListTenants :many
-SELECT id, full_name, cpf, phone, email, id_document_type, id_document_number, created_at, updated_at FROM tenants
+SELECT id, full_name, cpf, phone, email, id_document_type, id_document_number, created_at, updated_at, employer_name, employer_phone, employer_job_title, employer_monthly_income, employer_start_date, anonymized_at, anonymized_by, messaging_opt_out_at FROM tenants
 ORDER BY full_name ASC
 `
 
@@ -159,6 +183,65 @@ func (q *Queries) ListTenants(ctx context.Context) ([]Tenant, error) {
 			&i.IDDocumentNumber,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.EmployerName,
+			&i.EmployerPhone,
+			&i.EmployerJobTitle,
+			&i.EmployerMonthlyIncome,
+			&i.EmployerStartDate,
+			&i.AnonymizedAt,
+			&i.AnonymizedBy,
+			&i.MessagingOptOutAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTenantsByPropertyID = `-- name: ListTenantsByPropertyID :many
+SELECT id, full_name, cpf, phone, email, id_document_type, id_document_number, created_at, updated_at, employer_name, employer_phone, employer_job_title, employer_monthly_income, employer_start_date, anonymized_at, anonymized_by, messaging_opt_out_at FROM tenants
+WHERE id IN (
+    SELECT l.tenant_id FROM leases l
+    INNER JOIN units u ON l.unit_id = u.id
+    WHERE u.property_id = $1
+)
+ORDER BY full_name ASC
+`
+
+func (q *Queries) ListTenantsByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]Tenant, error) {
+	rows, err := q.db.QueryContext(ctx, listTenantsByPropertyID, propertyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Tenant{}
+	for rows.Next() {
+		var i Tenant
+		if err := rows.Scan(
+			&i.ID,
+			&i.FullName,
+			&i.Cpf,
+			&i.Phone,
+			&i.Email,
+			&i.IDDocumentType,
+			&i.IDDocumentNumber,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.EmployerName,
+			&i.EmployerPhone,
+			&i.EmployerJobTitle,
+			&i.EmployerMonthlyIncome,
+			&i.EmployerStartDate,
+			&i.AnonymizedAt,
+			&i.AnonymizedBy,
+			&i.MessagingOptOutAt,
 		); err != nil {
 			return nil, err
 		}
@@ -174,7 +257,7 @@ func (q *Queries) ListTenants(ctx context.Context) ([]Tenant, error) {
 }
 
 const searchTenantsByName = `-- name: SearchTenantsByName :many
-SELECT id, full_name, cpf, phone, email, id_document_type, id_document_number, created_at, updated_at FROM tenants
+SELECT id, full_name, cpf, phone, email, id_document_type, id_document_number, created_at, updated_at, employer_name, employer_phone, employer_job_title, employer_monthly_income, employer_start_date, anonymized_at, anonymized_by, messaging_opt_out_at FROM tenants
 WHERE full_name ILIKE '%' || $1 || '%'
 ORDER BY full_name ASC
 `
@@ -198,6 +281,14 @@ func (q *Queries) SearchTenantsByName(ctx context.Context, dollar_1 sql.NullStri
 			&i.IDDocumentNumber,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.EmployerName,
+			&i.EmployerPhone,
+			&i.EmployerJobTitle,
+			&i.EmployerMonthlyIncome,
+			&i.EmployerStartDate,
+			&i.AnonymizedAt,
+			&i.AnonymizedBy,
+			&i.MessagingOptOutAt,
 		); err != nil {
 			return nil, err
 		}
@@ -233,19 +324,31 @@ SET
     email = $4,
     id_document_type = $5,
     id_document_number = $6,
-    updated_at = $7
+    employer_name = $7,
+    employer_phone = $8,
+    employer_job_title = $9,
+    employer_monthly_income = $10,
+    employer_start_date = $11,
+    messaging_opt_out_at = $12,
+    updated_at = $13
 WHERE id = $1
-RETURNING id, full_name, cpf, phone, email, id_document_type, id_document_number, created_at, updated_at
+RETURNING id, full_name, cpf, phone, email, id_document_type, id_document_number, created_at, updated_at, employer_name, employer_phone, employer_job_title, employer_monthly_income, employer_start_date, anonymized_at, anonymized_by, messaging_opt_out_at
 `
 
 type UpdateTenantParams struct {
-	ID               uuid.UUID      `json:"id"`
-	FullName         string         `json:"full_name"`
-	Phone            string         `json:"phone"`
-	Email            sql.NullString `json:"email"`
-	IDDocumentType   sql.NullString `json:"id_document_type"`
-	IDDocumentNumber sql.NullString `json:"id_document_number"`
-	UpdatedAt        time.Time      `json:"updated_at"`
+	ID                    uuid.UUID      `json:"id"`
+	FullName              string         `json:"full_name"`
+	Phone                 string         `json:"phone"`
+	Email                 sql.NullString `json:"email"`
+	IDDocumentType        sql.NullString `json:"id_document_type"`
+	IDDocumentNumber      sql.NullString `json:"id_document_number"`
+	EmployerName          sql.NullString `json:"employer_name"`
+	EmployerPhone         sql.NullString `json:"employer_phone"`
+	EmployerJobTitle      sql.NullString `json:"employer_job_title"`
+	EmployerMonthlyIncome sql.NullString `json:"employer_monthly_income"`
+	EmployerStartDate     sql.NullTime   `json:"employer_start_date"`
+	MessagingOptOutAt     sql.NullTime   `json:"messaging_opt_out_at"`
+	UpdatedAt             time.Time      `json:"updated_at"`
 }
 
 func (q *Queries) UpdateTenant(ctx context.Context, arg UpdateTenantParams) (Tenant, error) {
@@ -256,6 +359,12 @@ func (q *Queries) UpdateTenant(ctx context.Context, arg UpdateTenantParams) (Ten
 		arg.Email,
 		arg.IDDocumentType,
 		arg.IDDocumentNumber,
+		arg.EmployerName,
+		arg.EmployerPhone,
+		arg.EmployerJobTitle,
+		arg.EmployerMonthlyIncome,
+		arg.EmployerStartDate,
+		arg.MessagingOptOutAt,
 		arg.UpdatedAt,
 	)
 	var i Tenant
@@ -269,6 +378,14 @@ func (q *Queries) UpdateTenant(ctx context.Context, arg UpdateTenantParams) (Ten
 		&i.IDDocumentNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.EmployerName,
+		&i.EmployerPhone,
+		&i.EmployerJobTitle,
+		&i.EmployerMonthlyIncome,
+		&i.EmployerStartDate,
+		&i.AnonymizedAt,
+		&i.AnonymizedBy,
+		&i.MessagingOptOutAt,
 	)
 	return i, err
 }
@@ -40,6 +40,7 @@ func (q *Queries) CountUnitsByStatus(ctx context.Context, status UnitStatus) (in
 const createUnit = `-- name: CreateUnit :one
 INSERT INTO units (
     id,
+    property_id,
     number,
     floor,
     status,
@@ -51,12 +52,13 @@ INSERT INTO units (
     created_at,
     updated_at
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
-) RETURNING id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+) RETURNING id, property_id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at
 `
 
 type CreateUnitParams struct {
 	ID                 uuid.UUID       `json:"id"`
+	PropertyID         uuid.UUID       `json:"property_id"`
 	Number             string          `json:"number"`
 	Floor              int32           `json:"floor"`
 	Status             UnitStatus      `json:"status"`
@@ -72,6 +74,7 @@ type CreateUnitParams struct {
 func (q *Queries) CreateUnit(ctx context.Context, arg CreateUnitParams) (Unit, error) {
 	row := q.db.QueryRowContext(ctx, createUnit,
 		arg.ID,
+		arg.PropertyID,
 		arg.Number,
 		arg.Floor,
 		arg.Status,
@@ -86,6 +89,7 @@ func (q *Queries) CreateUnit(ctx context.Context, arg CreateUnitParams) (Unit, e
 	var i Unit
 	err := row.Scan(
 		&i.ID,
+		&i.PropertyID,
 		&i.Number,
 		&i.Floor,
 		&i.Status,
@@ -111,7 +115,7 @@ func (q *Queries) DeleteUnit(ctx context.Context, id uuid.UUID) error {
 }
 
 const getUnitByID = `-- name: GetUnitByID :one
-SELECT id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at FROM units
+SELECT id, property_id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at FROM units
 WHERE id = $1
 LIMIT 1
 `
@@ -121,6 +125,7 @@ func (q *Queries) GetUnitByID(ctx context.Context, id uuid.UUID) (Unit, error) {
 	var i Unit
 	err := row.Scan(
 		&i.ID,
+		&i.PropertyID,
 		&i.Number,
 		&i.Floor,
 		&i.Status,
@@ -136,16 +141,22 @@ func (q *Queries) GetUnitByID(ctx context.Context, id uuid.UUID) (Unit, error) {
 }
 
 const getUnitByNumber = `-- name: GetUnitByNumber :one
-SELECT id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at FROM units
-WHERE number = $1
+SELECT id, property_id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at FROM units
+WHERE property_id = $1 AND number = $2
 LIMIT 1
 `
 
-func (q *Queries) GetUnitByNumber(ctx context.Context, number string) (Unit, error) {
-	row := q.db.QueryRowContext(ctx, getUnitByNumber, number)
+type GetUnitByNumberParams struct {
+	PropertyID uuid.UUID `json:"property_id"`
+	Number     string    `json:"number"`
+}
+
+func (q *Queries) GetUnitByNumber(ctx context.Context, arg GetUnitByNumberParams) (Unit, error) {
+	row := q.db.QueryRowContext(ctx, getUnitByNumber, arg.PropertyID, arg.Number)
 	var i Unit
 	err := row.Scan(
 		&i.ID,
+		&i.PropertyID,
 		&i.Number,
 		&i.Floor,
 		&i.Status,
@@ -161,7 +172,7 @@ func (q *Queries) GetUnitByNumber(ctx context.Context, number string) (Unit, err
 }
 
 const listAvailableUnits = `-- name: ListAvailableUnits :many
-SELECT id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at FROM units
+SELECT id, property_id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at FROM units
 WHERE status = 'available'
 ORDER BY floor ASC, number ASC
 `
@@ -177,6 +188,7 @@ func (q *Queries) ListAvailableUnits(ctx context.Context) ([]Unit, error) {
 		var i Unit
 		if err := rows.Scan(
 			&i.ID,
+			&i.PropertyID,
 			&i.Number,
 			&i.Floor,
 			&i.Status,
@@ -202,7 +214,7 @@ func (q *Queries) ListAvailableUnits(ctx context.Context) ([]Unit, error) {
 }
 
 const listUnits = `-- name: ListUnits :many
-SELECT id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at FROM units
+SELECT id, property_id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at FROM units
 ORDER BY floor ASC, number ASC
 `
 
@@ -217,6 +229,7 @@ func (q *Queries) ListUnits(ctx context.Context) ([]Unit, error) {
 		var i Unit
 		if err := rows.Scan(
 			&i.ID,
+			&i.PropertyID,
 			&i.Number,
 			&i.Floor,
 			&i.Status,
@@ -242,7 +255,7 @@ func (q *Queries) ListUnits(ctx context.Context) ([]Unit, error) {
 }
 
 const listUnitsByFloor = `-- name: ListUnitsByFloor :many
-SELECT id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at FROM units
+SELECT id, property_id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at FROM units
 WHERE floor = $1
 ORDER BY number ASC
 `
@@ -258,6 +271,49 @@ func (q *Queries) ListUnitsByFloor(ctx context.Context, floor int32) ([]Unit, er
 		var i Unit
 		if err := rows.Scan(
 			&i.ID,
+			&i.PropertyID,
+			&i.Number,
+			&i.Floor,
+			&i.Status,
+			&i.IsRenovated,
+			&i.BaseRentValue,
+			&i.RenovatedRentValue,
+			&i.CurrentRentValue,
+			&i.Notes,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnitsByPropertyID = `-- name: ListUnitsByPropertyID :many
+SELECT id, property_id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at FROM units
+WHERE property_id = $1
+ORDER BY floor ASC, number ASC
+`
+
+func (q *Queries) ListUnitsByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]Unit, error) {
+	rows, err := q.db.QueryContext(ctx, listUnitsByPropertyID, propertyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Unit{}
+	for rows.Next() {
+		var i Unit
+		if err := rows.Scan(
+			&i.ID,
+			&i.PropertyID,
 			&i.Number,
 			&i.Floor,
 			&i.Status,
@@ -283,7 +339,7 @@ func (q *Queries) ListUnitsByFloor(ctx context.Context, floor int32) ([]Unit, er
 }
 
 const listUnitsByStatus = `-- name: ListUnitsByStatus :many
-SELECT id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at FROM units
+SELECT id, property_id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at FROM units
 WHERE status = $1
 ORDER BY floor ASC, number ASC
 `
@@ -299,6 +355,7 @@ func (q *Queries) ListUnitsByStatus(ctx context.Context, status UnitStatus) ([]U
 		var i Unit
 		if err := rows.Scan(
 			&i.ID,
+			&i.PropertyID,
 			&i.Number,
 			&i.Floor,
 			&i.Status,
@@ -336,7 +393,7 @@ SET
     notes = $9,
     updated_at = $10
 WHERE id = $1
-RETURNING id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at
+RETURNING id, property_id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at
 `
 
 type UpdateUnitParams struct {
@@ -368,6 +425,7 @@ func (q *Queries) UpdateUnit(ctx context.Context, arg UpdateUnitParams) (Unit, e
 	var i Unit
 	err := row.Scan(
 		&i.ID,
+		&i.PropertyID,
 		&i.Number,
 		&i.Floor,
 		&i.Status,
@@ -388,7 +446,7 @@ SET
     status = $2,
     updated_at = $3
 WHERE id = $1
-RETURNING id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at
+RETURNING id, property_id, number, floor, status, is_renovated, base_rent_value, renovated_rent_value, current_rent_value, notes, created_at, updated_at
 `
 
 type UpdateUnitStatusParams struct {
@@ -402,6 +460,7 @@ func (q *Queries) UpdateUnitStatus(ctx context.Context, arg UpdateUnitStatusPara
 	var i Unit
 	err := row.Scan(
 		&i.ID,
+		&i.PropertyID,
 		&i.Number,
 		&i.Floor,
 		&i.Status,
@@ -62,20 +62,22 @@ INSERT INTO users (
     role,
     is_active,
     created_at,
-    updated_at
+    updated_at,
+    tenant_id
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7
-) RETURNING id, username, password_hash, role, is_active, last_login_at, created_at, updated_at
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING id, username, password_hash, role, is_active, last_login_at, created_at, updated_at, tenant_id
 `
 
 type CreateUserParams struct {
-	ID           uuid.UUID `json:"id"`
-	Username     string    `json:"username"`
-	PasswordHash string    `json:"password_hash"`
-	Role         UserRole  `json:"role"`
-	IsActive     bool      `json:"is_active"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID           uuid.UUID     `json:"id"`
+	Username     string        `json:"username"`
+	PasswordHash string        `json:"password_hash"`
+	Role         UserRole      `json:"role"`
+	IsActive     bool          `json:"is_active"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+	TenantID     uuid.NullUUID `json:"tenant_id"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
@@ -87,6 +89,7 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		arg.IsActive,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.TenantID,
 	)
 	var i User
 	err := row.Scan(
@@ -98,6 +101,7 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.LastLoginAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TenantID,
 	)
 	return i, err
 }
@@ -131,7 +135,7 @@ func (q *Queries) DeleteUser(ctx context.Context, id uuid.UUID) error {
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, username, password_hash, role, is_active, last_login_at, created_at, updated_at FROM users
+SELECT id, username, password_hash, role, is_active, last_login_at, created_at, updated_at, tenant_id FROM users
 WHERE id = $1
 LIMIT 1
 `
@@ -148,12 +152,36 @@ func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
 		&i.LastLoginAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TenantID,
+	)
+	return i, err
+}
+
+const getUserByTenantID = `-- name: GetUserByTenantID :one
+SELECT id, username, password_hash, role, is_active, last_login_at, created_at, updated_at, tenant_id FROM users
+WHERE tenant_id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetUserByTenantID(ctx context.Context, tenantID uuid.NullUUID) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByTenantID, tenantID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Username,
+		&i.PasswordHash,
+		&i.Role,
+		&i.IsActive,
+		&i.LastLoginAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TenantID,
 	)
 	return i, err
 }
 
 const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT id, username, password_hash, role, is_active, last_login_at, created_at, updated_at FROM users
+SELECT id, username, password_hash, role, is_active, last_login_at, created_at, updated_at, tenant_id FROM users
 WHERE username = $1
 LIMIT 1
 `
@@ -170,12 +198,13 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User,
 		&i.LastLoginAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TenantID,
 	)
 	return i, err
 }
 
 const listUsers = `-- name: ListUsers :many
-SELECT id, username, password_hash, role, is_active, last_login_at, created_at, updated_at FROM users
+SELECT id, username, password_hash, role, is_active, last_login_at, created_at, updated_at, tenant_id FROM users
 ORDER BY created_at DESC
 `
 
@@ -197,6 +226,7 @@ func (q *Queries) ListUsers(ctx context.Context) ([]User, error) {
 			&i.LastLoginAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TenantID,
 		); err != nil {
 			return nil, err
 		}
@@ -212,7 +242,7 @@ func (q *Queries) ListUsers(ctx context.Context) ([]User, error) {
 }
 
 const listUsersByRole = `-- name: ListUsersByRole :many
-SELECT id, username, password_hash, role, is_active, last_login_at, created_at, updated_at FROM users
+SELECT id, username, password_hash, role, is_active, last_login_at, created_at, updated_at, tenant_id FROM users
 WHERE role = $1
 ORDER BY username ASC
 `
@@ -235,6 +265,7 @@ func (q *Queries) ListUsersByRole(ctx context.Context, role UserRole) ([]User, e
 			&i.LastLoginAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TenantID,
 		); err != nil {
 			return nil, err
 		}
@@ -255,7 +286,7 @@ SET
     last_login_at = $2,
     updated_at = $3
 WHERE id = $1
-RETURNING id, username, password_hash, role, is_active, last_login_at, created_at, updated_at
+RETURNING id, username, password_hash, role, is_active, last_login_at, created_at, updated_at, tenant_id
 `
 
 type UpdateLastLoginParams struct {
@@ -276,6 +307,7 @@ func (q *Queries) UpdateLastLogin(ctx context.Context, arg UpdateLastLoginParams
 		&i.LastLoginAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TenantID,
 	)
 	return i, err
 }
@@ -287,7 +319,7 @@ SET
     is_active = $3,
     updated_at = $4
 WHERE id = $1
-RETURNING id, username, password_hash, role, is_active, last_login_at, created_at, updated_at
+RETURNING id, username, password_hash, role, is_active, last_login_at, created_at, updated_at, tenant_id
 `
 
 type UpdateUserParams struct {
@@ -314,6 +346,7 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.LastLoginAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TenantID,
 	)
 	return i, err
 }
@@ -324,7 +357,7 @@ SET
     password_hash = $2,
     updated_at = $3
 WHERE id = $1
-RETURNING id, username, password_hash, role, is_active, last_login_at, created_at, updated_at
+RETURNING id, username, password_hash, role, is_active, last_login_at, created_at, updated_at, tenant_id
 `
 
 type UpdateUserPasswordParams struct {
@@ -345,6 +378,7 @@ func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPassword
 		&i.LastLoginAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TenantID,
 	)
 	return i, err
 }
@@ -28,6 +28,7 @@ type JWTClaims struct {
 	UserID   string `json:"user_id"`
 	Username string `json:"username"`
 	Role     string `json:"role"`
+	TenantID string `json:"tenant_id,omitempty"` // apenas para usuários do portal
 	jwt.RegisteredClaims
 }
 
@@ -101,6 +102,10 @@ func (s *AuthService) GenerateToken(user *domain.User) (string, error) {
 		},
 	}
 
+	if user.TenantID != nil {
+		claims.TenantID = user.TenantID.String()
+	}
+
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
 	tokenString, err := token.SignedString(s.jwtSecret)
@@ -189,6 +194,14 @@ func (s *AuthService) GetUserFromTokenClaims(ctx context.Context, tokenString st
 		IsActive: true, // Assumimos ativo se token é válido
 	}
 
+	if claims.TenantID != "" {
+		tenantID, err := uuid.Parse(claims.TenantID)
+		if err != nil {
+			return nil, ErrInvalidTokenClaims
+		}
+		user.TenantID = &tenantID
+	}
+
 	return user, nil
 }
 
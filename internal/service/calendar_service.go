@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/ical"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+)
+
+// Service layer errors específicos dos feeds de calendário
+var (
+	ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+)
+
+const (
+	// CalendarPaymentHorizonDays é a janela de vencimentos incluída no feed
+	CalendarPaymentHorizonDays = 120
+	// calendarRefreshInterval é o intervalo de atualização sugerido aos aplicativos de calendário
+	calendarRefreshInterval = 6 * time.Hour
+	// calendarUIDDomain completa os UIDs dos eventos (ex: payment-<id>@kitnet-manager)
+	calendarUIDDomain = "kitnet-manager"
+)
+
+// Categorias dos eventos do feed
+const (
+	calendarCategoryPayment = "Vencimento"
+	calendarCategoryLease   = "Contrato"
+	calendarCategoryMoveIn  = "Entrada"
+	calendarCategoryVisit   = "Visita"
+)
+
+// CalendarService contém a lógica dos feeds iCalendar da equipe
+type CalendarService struct {
+	feedRepo     repository.CalendarFeedRepository
+	userRepo     repository.UserRepository
+	paymentRepo  repository.PaymentRepository
+	leaseRepo    repository.LeaseRepository
+	unitRepo     repository.UnitRepository
+	tenantRepo   repository.TenantRepository
+	prospectRepo repository.ProspectRepository
+}
+
+// NewCalendarService cria uma nova instância do serviço de calendário
+func NewCalendarService(
+	feedRepo repository.CalendarFeedRepository,
+	userRepo repository.UserRepository,
+	paymentRepo repository.PaymentRepository,
+	leaseRepo repository.LeaseRepository,
+	unitRepo repository.UnitRepository,
+	tenantRepo repository.TenantRepository,
+	prospectRepo repository.ProspectRepository,
+) *CalendarService {
+	return &CalendarService{
+		feedRepo:     feedRepo,
+		userRepo:     userRepo,
+		paymentRepo:  paymentRepo,
+		leaseRepo:    leaseRepo,
+		unitRepo:     unitRepo,
+		tenantRepo:   tenantRepo,
+		prospectRepo: prospectRepo,
+	}
+}
+
+// CreateFeed gera um novo token de feed para o usuário, invalidando a URL anterior
+// O token em texto plano é retornado apenas aqui
+func (s *CalendarService) CreateFeed(ctx context.Context, userID uuid.UUID) (*domain.CalendarFeed, string, error) {
+	feed, token, err := domain.NewCalendarFeed(userID)
+	if err != nil {
+		return nil, "", fmt.Errorf("error generating calendar feed token: %w", err)
+	}
+
+	if err := s.feedRepo.Upsert(ctx, feed); err != nil {
+		return nil, "", fmt.Errorf("error saving calendar feed: %w", err)
+	}
+
+	return feed, token, nil
+}
+
+// GetFeed retorna o feed do usuário (sem o token)
+func (s *CalendarService) GetFeed(ctx context.Context, userID uuid.UUID) (*domain.CalendarFeed, error) {
+	feed, err := s.feedRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching calendar feed: %w", err)
+	}
+	if feed == nil {
+		return nil, ErrCalendarFeedNotFound
+	}
+	return feed, nil
+}
+
+// RevokeFeed remove o feed do usuário; a URL deixa de funcionar imediatamente
+func (s *CalendarService) RevokeFeed(ctx context.Context, userID uuid.UUID) error {
+	if _, err := s.GetFeed(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := s.feedRepo.Delete(ctx, userID); err != nil {
+		return fmt.Errorf("error deleting calendar feed: %w", err)
+	}
+	return nil
+}
+
+// BuildFeed autentica o token da URL e monta o calendário com vencimentos,
+// fins de contrato, entradas de moradores e visitas agendadas
+func (s *CalendarService) BuildFeed(ctx context.Context, token string) (*ical.Calendar, error) {
+	if strings.TrimSpace(token) == "" {
+		return nil, domain.ErrInvalidCalendarFeedToken
+	}
+
+	feed, err := s.feedRepo.GetByTokenHash(ctx, domain.HashCalendarFeedToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching calendar feed: %w", err)
+	}
+	if feed == nil {
+		return nil, domain.ErrInvalidCalendarFeedToken
+	}
+
+	// Usuários desativados ou sem acesso de gestão perdem o feed
+	user, err := s.userRepo.GetByID(ctx, feed.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching user: %w", err)
+	}
+	if user == nil || !user.IsActive || user.IsTenant() {
+		return nil, domain.ErrInvalidCalendarFeedToken
+	}
+
+	events, err := s.buildEvents(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.feedRepo.Touch(ctx, feed.UserID, time.Now()); err != nil {
+		fmt.Printf("Warning: failed to record calendar feed access for user %s: %v\n", feed.UserID, err)
+	}
+
+	return &ical.Calendar{
+		ProdID:      "-//Kitnet Manager//Agenda//PT-BR",
+		Name:        "Kitnet Manager",
+		Description: "Vencimentos, fins de contrato, entradas de moradores e visitas agendadas",
+		RefreshTTL:  calendarRefreshInterval,
+		Events:      events,
+	}, nil
+}
+
+// buildEvents monta os eventos do feed ordenados por data
+func (s *CalendarService) buildEvents(ctx context.Context, now time.Time) ([]ical.Event, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	units, err := s.unitRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing units: %w", err)
+	}
+	unitNumbers := make(map[uuid.UUID]string, len(units))
+	for _, unit := range units {
+		unitNumbers[unit.ID] = unit.Number
+	}
+
+	tenants, err := s.tenantRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tenants: %w", err)
+	}
+	tenantNames := make(map[uuid.UUID]string, len(tenants))
+	for _, tenant := range tenants {
+		tenantNames[tenant.ID] = tenant.FullName
+	}
+
+	leases, err := s.leaseRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing leases: %w", err)
+	}
+	leasesByID := make(map[uuid.UUID]*domain.Lease, len(leases))
+	for _, lease := range leases {
+		leasesByID[lease.ID] = lease
+	}
+
+	// leaseLabel descreve a unidade e o morador do contrato (ex: Unidade 101 - Maria Silva)
+	leaseLabel := func(lease *domain.Lease) string {
+		return fmt.Sprintf("Unidade %s - %s", unitNumbers[lease.UnitID], tenantNames[lease.TenantID])
+	}
+
+	var events []ical.Event
+
+	// Vencimentos: o UID é o do pagamento, então mudanças de data (ex: troca do dia de vencimento)
+	// atualizam o evento existente
+	payments, err := s.paymentRepo.GetUpcoming(ctx, CalendarPaymentHorizonDays)
+	if err != nil {
+		return nil, fmt.Errorf("error listing upcoming payments: %w", err)
+	}
+	for _, payment := range payments {
+		label := "Contrato removido"
+		if lease, ok := leasesByID[payment.LeaseID]; ok {
+			label = leaseLabel(lease)
+		}
+		events = append(events, ical.Event{
+			UID:     calendarUID("payment", payment.ID),
+			Summary: fmt.Sprintf("%s %s - %s", payment.PaymentType.Label(), domain.FormatBRL(payment.Amount), label),
+			Description: fmt.Sprintf("Vencimento de %s\nReferência: %s\nValor: %s",
+				strings.ToLower(payment.PaymentType.Label()), payment.ReferenceMonth.Format("01/2006"), domain.FormatBRL(payment.Amount)),
+			Categories:   []string{calendarCategoryPayment},
+			Date:         payment.DueDate,
+			LastModified: payment.UpdatedAt,
+		})
+	}
+
+	for _, lease := range leases {
+		if lease.Status == domain.LeaseStatusCancelled || lease.Status == domain.LeaseStatusExpired {
+			continue
+		}
+
+		// Entrada de morador: apenas contratos novos (renovações não são mudança) que ainda vão começar
+		if lease.ParentLeaseID == nil && lease.StartDate.After(today) {
+			events = append(events, ical.Event{
+				UID:          calendarUID("lease-move-in", lease.ID),
+				Summary:      "Entrada de morador - " + leaseLabel(lease),
+				Description:  fmt.Sprintf("Início do contrato em %s\nAluguel: %s", lease.StartDate.Format("02/01/2006"), domain.FormatBRL(lease.MonthlyRentValue)),
+				Categories:   []string{calendarCategoryMoveIn},
+				Date:         lease.StartDate,
+				LastModified: lease.UpdatedAt,
+			})
+		}
+
+		if !lease.EndDate.Before(today) {
+			events = append(events, ical.Event{
+				UID:          calendarUID("lease-end", lease.ID),
+				Summary:      "Fim de contrato - " + leaseLabel(lease),
+				Description:  fmt.Sprintf("Contrato de %s a %s", lease.StartDate.Format("02/01/2006"), lease.EndDate.Format("02/01/2006")),
+				Categories:   []string{calendarCategoryLease},
+				Date:         lease.EndDate,
+				LastModified: lease.UpdatedAt,
+			})
+		}
+	}
+
+	visits, err := s.prospectRepo.ListUpcomingVisits(ctx, today)
+	if err != nil {
+		return nil, fmt.Errorf("error listing upcoming visits: %w", err)
+	}
+	prospectNames := make(map[uuid.UUID]string)
+	for _, visit := range visits {
+		name, ok := prospectNames[visit.ProspectID]
+		if !ok {
+			prospect, err := s.prospectRepo.GetByID(ctx, visit.ProspectID)
+			if err != nil {
+				return nil, fmt.Errorf("error getting prospect: %w", err)
+			}
+			if prospect != nil {
+				name = prospect.FullName
+			}
+			prospectNames[visit.ProspectID] = name
+		}
+
+		summary := "Visita - " + name
+		if visit.UnitID != nil {
+			summary = fmt.Sprintf("Visita - %s (unidade %s)", name, unitNumbers[*visit.UnitID])
+		}
+		var description string
+		if visit.Notes != nil {
+			description = *visit.Notes
+		}
+		events = append(events, ical.Event{
+			UID:          calendarUID("prospect-visit", visit.ID),
+			Summary:      summary,
+			Description:  description,
+			Categories:   []string{calendarCategoryVisit},
+			Start:        visit.ScheduledAt,
+			Duration:     time.Hour,
+			LastModified: visit.UpdatedAt,
+		})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return calendarEventTime(events[i]).Before(calendarEventTime(events[j]))
+	})
+
+	return events, nil
+}
+
+// calendarUID monta o UID estável do evento a partir do tipo e do ID do registro de origem
+func calendarUID(kind string, id uuid.UUID) string {
+	return fmt.Sprintf("%s-%s@%s", kind, id, calendarUIDDomain)
+}
+
+// calendarEventTime retorna o início do evento para ordenação
+func calendarEventTime(event ical.Event) time.Time {
+	if event.Start.IsZero() {
+		return event.Date
+	}
+	return event.Start
+}
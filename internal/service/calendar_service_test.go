@@ -0,0 +1,204 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockCalendarFeedRepo é um mock do repository de feeds de calendário
+type MockCalendarFeedRepo struct {
+	mock.Mock
+}
+
+func (m *MockCalendarFeedRepo) Upsert(ctx context.Context, feed *domain.CalendarFeed) error {
+	args := m.Called(ctx, feed)
+	return args.Error(0)
+}
+
+func (m *MockCalendarFeedRepo) GetByUserID(ctx context.Context, userID uuid.UUID) (*domain.CalendarFeed, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CalendarFeed), args.Error(1)
+}
+
+func (m *MockCalendarFeedRepo) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.CalendarFeed, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CalendarFeed), args.Error(1)
+}
+
+func (m *MockCalendarFeedRepo) Touch(ctx context.Context, userID uuid.UUID, accessedAt time.Time) error {
+	args := m.Called(ctx, userID, accessedAt)
+	return args.Error(0)
+}
+
+func (m *MockCalendarFeedRepo) Delete(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+type calendarServiceMocks struct {
+	feedRepo     *MockCalendarFeedRepo
+	userRepo     *MockUserRepository
+	paymentRepo  *MockPaymentRepo
+	leaseRepo    *MockLeaseRepo
+	unitRepo     *MockUnitRepository
+	tenantRepo   *MockTenantRepository
+	prospectRepo *MockProspectRepo
+}
+
+func newTestCalendarService() (*CalendarService, *calendarServiceMocks) {
+	mocks := &calendarServiceMocks{
+		feedRepo:     new(MockCalendarFeedRepo),
+		userRepo:     new(MockUserRepository),
+		paymentRepo:  new(MockPaymentRepo),
+		leaseRepo:    new(MockLeaseRepo),
+		unitRepo:     new(MockUnitRepository),
+		tenantRepo:   new(MockTenantRepository),
+		prospectRepo: new(MockProspectRepo),
+	}
+	service := NewCalendarService(mocks.feedRepo, mocks.userRepo, mocks.paymentRepo, mocks.leaseRepo, mocks.unitRepo, mocks.tenantRepo, mocks.prospectRepo)
+	return service, mocks
+}
+
+func TestCalendarService_CreateFeed(t *testing.T) {
+	ctx := context.Background()
+	service, mocks := newTestCalendarService()
+	userID := uuid.New()
+
+	mocks.feedRepo.On("Upsert", ctx, mock.AnythingOfType("*domain.CalendarFeed")).Return(nil)
+
+	feed, token, err := service.CreateFeed(ctx, userID)
+
+	require.NoError(t, err)
+	assert.Equal(t, userID, feed.UserID)
+	assert.Equal(t, domain.HashCalendarFeedToken(token), feed.TokenHash)
+	mocks.feedRepo.AssertExpectations(t)
+}
+
+func TestCalendarService_BuildFeed(t *testing.T) {
+	ctx := context.Background()
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	t.Run("should reject unknown token", func(t *testing.T) {
+		service, mocks := newTestCalendarService()
+		mocks.feedRepo.On("GetByTokenHash", ctx, domain.HashCalendarFeedToken("unknown")).Return(nil, nil)
+
+		_, err := service.BuildFeed(ctx, "unknown")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidCalendarFeedToken)
+	})
+
+	t.Run("should reject feed of inactive user", func(t *testing.T) {
+		service, mocks := newTestCalendarService()
+		user, err := domain.NewUser("gerente", "senha123", domain.UserRoleManager)
+		require.NoError(t, err)
+		user.Deactivate()
+		mocks.feedRepo.On("GetByTokenHash", ctx, domain.HashCalendarFeedToken("token")).
+			Return(&domain.CalendarFeed{UserID: user.ID}, nil)
+		mocks.userRepo.On("GetByID", ctx, user.ID).Return(user, nil)
+
+		_, err = service.BuildFeed(ctx, "token")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidCalendarFeedToken)
+	})
+
+	t.Run("should build events with stable UIDs", func(t *testing.T) {
+		service, mocks := newTestCalendarService()
+		user, err := domain.NewUser("gerente", "senha123", domain.UserRoleManager)
+		require.NoError(t, err)
+
+		unit := createTestUnit(uuid.New(), domain.UnitStatusOccupied)
+		tenant := createTestTenant(uuid.New())
+
+		current := createTestLease()
+		current.UnitID, current.TenantID = unit.ID, tenant.ID
+		current.StartDate = today.AddDate(0, -5, 0)
+		current.EndDate = today.AddDate(0, 1, 0)
+
+		parentID := current.ID
+		renewal := createTestLease()
+		renewal.UnitID, renewal.TenantID = unit.ID, tenant.ID
+		renewal.ParentLeaseID = &parentID
+		renewal.StartDate = today.AddDate(0, 1, 1)
+		renewal.EndDate = today.AddDate(0, 7, 0)
+
+		moveIn := createTestLease()
+		moveIn.UnitID, moveIn.TenantID = unit.ID, tenant.ID
+		moveIn.StartDate = today.AddDate(0, 0, 10)
+		moveIn.EndDate = today.AddDate(0, 6, 10)
+
+		expired := createTestLease()
+		expired.Status = domain.LeaseStatusExpired
+		expired.EndDate = today.AddDate(0, 0, 5)
+
+		payment := &domain.Payment{
+			ID:             uuid.New(),
+			LeaseID:        current.ID,
+			PaymentType:    domain.PaymentTypeRent,
+			ReferenceMonth: today,
+			Amount:         decimal.NewFromInt(1250),
+			Status:         domain.PaymentStatusPending,
+			DueDate:        today.AddDate(0, 0, 3),
+		}
+
+		prospect := &domain.Prospect{ID: uuid.New(), FullName: "Ana Souza"}
+		visit := &domain.ProspectVisit{
+			ID:          uuid.New(),
+			ProspectID:  prospect.ID,
+			UnitID:      &unit.ID,
+			ScheduledAt: today.AddDate(0, 0, 1).Add(15 * time.Hour),
+			Status:      domain.ProspectVisitStatusScheduled,
+		}
+
+		mocks.feedRepo.On("GetByTokenHash", ctx, domain.HashCalendarFeedToken("token")).
+			Return(&domain.CalendarFeed{UserID: user.ID}, nil)
+		mocks.userRepo.On("GetByID", ctx, user.ID).Return(user, nil)
+		mocks.unitRepo.On("List", ctx).Return([]*domain.Unit{unit}, nil)
+		mocks.tenantRepo.On("List", ctx).Return([]*domain.Tenant{tenant}, nil)
+		mocks.leaseRepo.On("List", ctx).Return([]*domain.Lease{current, renewal, moveIn, expired}, nil)
+		mocks.paymentRepo.On("GetUpcoming", ctx, CalendarPaymentHorizonDays).Return([]*domain.Payment{payment}, nil)
+		mocks.prospectRepo.On("ListUpcomingVisits", ctx, mock.AnythingOfType("time.Time")).Return([]*domain.ProspectVisit{visit}, nil)
+		mocks.prospectRepo.On("GetByID", ctx, prospect.ID).Return(prospect, nil)
+		mocks.feedRepo.On("Touch", ctx, user.ID, mock.AnythingOfType("time.Time")).Return(nil)
+
+		calendar, err := service.BuildFeed(ctx, "token")
+
+		require.NoError(t, err)
+		uids := make([]string, len(calendar.Events))
+		for i, event := range calendar.Events {
+			uids[i] = event.UID
+		}
+		// Ordenados por data; renovação não gera entrada e contrato expirado fica fora
+		assert.Equal(t, []string{
+			"prospect-visit-" + visit.ID.String() + "@kitnet-manager",
+			"payment-" + payment.ID.String() + "@kitnet-manager",
+			"lease-move-in-" + moveIn.ID.String() + "@kitnet-manager",
+			"lease-end-" + current.ID.String() + "@kitnet-manager",
+			"lease-end-" + moveIn.ID.String() + "@kitnet-manager",
+			"lease-end-" + renewal.ID.String() + "@kitnet-manager",
+		}, uids)
+
+		assert.Equal(t, "Aluguel R$ 1.250,00 - Unidade 101 - João Silva", calendar.Events[1].Summary)
+		assert.Equal(t, "Visita - Ana Souza (unidade 101)", calendar.Events[0].Summary)
+		assert.Equal(t, visit.ScheduledAt, calendar.Events[0].Start)
+
+		var buf bytes.Buffer
+		require.NoError(t, calendar.Write(&buf, time.Now()))
+		assert.Contains(t, buf.String(), "UID:payment-"+payment.ID.String()+"@kitnet-manager\r\n")
+		mocks.feedRepo.AssertExpectations(t)
+	})
+}
@@ -17,6 +17,7 @@ type DashboardService struct {
 	leaseRepo     repository.LeaseRepository
 	paymentRepo   repository.PaymentRepository
 	unitRepo      repository.UnitRepository
+	historyRepo   repository.UnitStatusHistoryRepository
 }
 
 // NewDashboardService cria uma nova instância do serviço de dashboard
@@ -25,12 +26,14 @@ func NewDashboardService(
 	leaseRepo repository.LeaseRepository,
 	paymentRepo repository.PaymentRepository,
 	unitRepo repository.UnitRepository,
+	historyRepo repository.UnitStatusHistoryRepository,
 ) *DashboardService {
 	return &DashboardService{
 		dashboardRepo: dashboardRepo,
 		leaseRepo:     leaseRepo,
 		paymentRepo:   paymentRepo,
 		unitRepo:      unitRepo,
+		historyRepo:   historyRepo,
 	}
 }
 
@@ -81,6 +84,14 @@ type DashboardAlerts struct {
 	TotalAlerts     int     `json:"total_alerts"`
 }
 
+// PropertyDashboard representa as métricas consolidadas de um imóvel
+type PropertyDashboard struct {
+	PropertyID   uuid.UUID         `json:"property_id"`
+	PropertyName string            `json:"property_name"`
+	Occupancy    *OccupancyMetrics `json:"occupancy"`
+	Financial    *FinancialMetrics `json:"financial"`
+}
+
 // GetOccupancyMetrics retorna as métricas de ocupação das unidades
 func (s *DashboardService) GetOccupancyMetrics(ctx context.Context) (*OccupancyMetrics, error) {
 	metrics, err := s.dashboardRepo.GetOccupancyMetrics(ctx)
@@ -155,6 +166,65 @@ func (s *DashboardService) GetFinancialMetrics(ctx context.Context) (*FinancialM
 	}, nil
 }
 
+// GetPropertyBreakdown retorna as métricas de ocupação e financeiras separadas por imóvel
+func (s *DashboardService) GetPropertyBreakdown(ctx context.Context) ([]*PropertyDashboard, error) {
+	occupancyByProperty, err := s.dashboardRepo.GetOccupancyMetricsByProperty(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	financialByProperty, err := s.dashboardRepo.GetFinancialMetricsByProperty(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	financialMap := make(map[uuid.UUID]*repository.PropertyFinancialMetrics, len(financialByProperty))
+	for _, f := range financialByProperty {
+		financialMap[f.PropertyID] = f
+	}
+
+	breakdown := make([]*PropertyDashboard, 0, len(occupancyByProperty))
+	for _, o := range occupancyByProperty {
+		occupancy := &OccupancyMetrics{
+			TotalUnits:       o.TotalUnits,
+			OccupiedUnits:    o.OccupiedUnits,
+			AvailableUnits:   o.AvailableUnits,
+			MaintenanceUnits: o.MaintenanceUnits,
+			RenovationUnits:  o.RenovationUnits,
+		}
+		if o.TotalUnits > 0 {
+			occupancy.OccupancyRate = (float64(o.OccupiedUnits) / float64(o.TotalUnits)) * 100
+			occupancy.AvailabilityRate = (float64(o.AvailableUnits) / float64(o.TotalUnits)) * 100
+		}
+
+		financial := &FinancialMetrics{
+			MonthlyProjectedRevenue: decimal.Zero,
+			MonthlyRealizedRevenue:  decimal.Zero,
+			OverdueAmount:           decimal.Zero,
+			TotalPendingAmount:      decimal.Zero,
+		}
+		if f, ok := financialMap[o.PropertyID]; ok {
+			financial.MonthlyProjectedRevenue = f.ProjectedRevenue
+			financial.MonthlyRealizedRevenue = f.RealizedRevenue
+			financial.OverdueAmount = f.OverdueAmount
+			financial.TotalPendingAmount = f.PendingAmount
+			if !f.ProjectedRevenue.IsZero() {
+				financial.DefaultRate, _ = f.OverdueAmount.Div(f.ProjectedRevenue).Mul(decimal.NewFromInt(100)).Float64()
+				financial.CollectionRate, _ = f.RealizedRevenue.Div(f.ProjectedRevenue).Mul(decimal.NewFromInt(100)).Float64()
+			}
+		}
+
+		breakdown = append(breakdown, &PropertyDashboard{
+			PropertyID:   o.PropertyID,
+			PropertyName: o.PropertyName,
+			Occupancy:    occupancy,
+			Financial:    financial,
+		})
+	}
+
+	return breakdown, nil
+}
+
 // GetContractMetrics retorna métricas sobre os contratos
 func (s *DashboardService) GetContractMetrics(ctx context.Context) (*ContractMetrics, error) {
 	// 1. Contar contratos ativos
@@ -262,7 +332,7 @@ func (s *DashboardService) GetAlerts(ctx context.Context) (*DashboardAlerts, err
 	// Considerar apenas unidades disponíveis há mais de 30 dias como alerta
 	for _, unit := range availableUnits {
 		// Calcular quantos dias a unidade está vaga
-		daysVacant := int(time.Since(unit.UpdatedAt).Hours() / 24)
+		daysVacant := int(time.Since(s.vacantSince(ctx, unit)).Hours() / 24)
 
 		// Só alerta se estiver vaga há mais de 30 dias
 		if daysVacant > 30 {
@@ -289,3 +359,18 @@ func (s *DashboardService) GetAlerts(ctx context.Context) (*DashboardAlerts, err
 
 	return alerts, nil
 }
+
+// vacantSince retorna desde quando a unidade está disponível segundo o histórico de status
+// Sem histórico, usa a última atualização da unidade como estimativa
+func (s *DashboardService) vacantSince(ctx context.Context, unit *domain.Unit) time.Time {
+	if s.historyRepo == nil {
+		return unit.UpdatedAt
+	}
+
+	latest, err := s.historyRepo.GetLatestByUnitID(ctx, unit.ID)
+	if err != nil || latest == nil || latest.ToStatus != domain.UnitStatusAvailable {
+		return unit.UpdatedAt
+	}
+
+	return latest.ChangedAt
+}
@@ -46,6 +46,22 @@ func (m *MockDashboardRepo) GetTotalPendingAmount(ctx context.Context) (decimal.
 	return args.Get(0).(decimal.Decimal), args.Error(1)
 }
 
+func (m *MockDashboardRepo) GetOccupancyMetricsByProperty(ctx context.Context) ([]*repository.PropertyOccupancyMetrics, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.PropertyOccupancyMetrics), args.Error(1)
+}
+
+func (m *MockDashboardRepo) GetFinancialMetricsByProperty(ctx context.Context) ([]*repository.PropertyFinancialMetrics, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.PropertyFinancialMetrics), args.Error(1)
+}
+
 // Test GetOccupancyMetrics - Success with data
 func TestGetOccupancyMetrics_Success(t *testing.T) {
 	// Arrange
@@ -53,7 +69,7 @@ func TestGetOccupancyMetrics_Success(t *testing.T) {
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockUnitRepo := new(MockUnitRepo)
-	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo)
+	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, nil)
 
 	ctx := context.Background()
 
@@ -96,7 +112,7 @@ func TestGetOccupancyMetrics_ZeroUnits(t *testing.T) {
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockUnitRepo := new(MockUnitRepo)
-	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo)
+	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, nil)
 
 	ctx := context.Background()
 
@@ -130,7 +146,7 @@ func TestGetOccupancyMetrics_RepositoryError(t *testing.T) {
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockUnitRepo := new(MockUnitRepo)
-	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo)
+	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, nil)
 
 	ctx := context.Background()
 
@@ -153,7 +169,7 @@ func TestGetFinancialMetrics_Success(t *testing.T) {
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockUnitRepo := new(MockUnitRepo)
-	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo)
+	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, nil)
 
 	ctx := context.Background()
 
@@ -196,7 +212,7 @@ func TestGetFinancialMetrics_ZeroProjectedRevenue(t *testing.T) {
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockUnitRepo := new(MockUnitRepo)
-	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo)
+	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, nil)
 
 	ctx := context.Background()
 
@@ -231,7 +247,7 @@ func TestGetFinancialMetrics_ErrorOnProjectedRevenue(t *testing.T) {
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockUnitRepo := new(MockUnitRepo)
-	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo)
+	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, nil)
 
 	ctx := context.Background()
 
@@ -254,7 +270,7 @@ func TestGetFinancialMetrics_ErrorOnRealizedRevenue(t *testing.T) {
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockUnitRepo := new(MockUnitRepo)
-	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo)
+	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, nil)
 
 	ctx := context.Background()
 
@@ -278,7 +294,7 @@ func TestGetFinancialMetrics_ErrorOnOverdueAmount(t *testing.T) {
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockUnitRepo := new(MockUnitRepo)
-	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo)
+	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, nil)
 
 	ctx := context.Background()
 
@@ -303,7 +319,7 @@ func TestGetFinancialMetrics_ErrorOnPendingAmount(t *testing.T) {
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockUnitRepo := new(MockUnitRepo)
-	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo)
+	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, nil)
 
 	ctx := context.Background()
 
@@ -329,7 +345,7 @@ func TestGetContractMetrics_Success(t *testing.T) {
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockUnitRepo := new(MockUnitRepo)
-	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo)
+	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, nil)
 
 	ctx := context.Background()
 
@@ -359,7 +375,7 @@ func TestGetContractMetrics_ErrorOnActiveCount(t *testing.T) {
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockUnitRepo := new(MockUnitRepo)
-	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo)
+	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, nil)
 
 	ctx := context.Background()
 
@@ -382,7 +398,7 @@ func TestGetAlerts_Success(t *testing.T) {
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockUnitRepo := new(MockUnitRepo)
-	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo)
+	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, nil)
 
 	ctx := context.Background()
 
@@ -438,7 +454,7 @@ func TestGetAlerts_VacantUnitAlert(t *testing.T) {
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockUnitRepo := new(MockUnitRepo)
-	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo)
+	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, nil)
 
 	ctx := context.Background()
 
@@ -486,7 +502,7 @@ func TestGetAlerts_NoAlerts(t *testing.T) {
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockUnitRepo := new(MockUnitRepo)
-	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo)
+	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, nil)
 
 	ctx := context.Background()
 
@@ -517,7 +533,7 @@ func TestGetAlerts_HighSeverityOverduePayment(t *testing.T) {
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockUnitRepo := new(MockUnitRepo)
-	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo)
+	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, nil)
 
 	ctx := context.Background()
 
@@ -554,7 +570,7 @@ func TestGetAlerts_ErrorOnGetOverdue(t *testing.T) {
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockUnitRepo := new(MockUnitRepo)
-	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo)
+	service := NewDashboardService(mockDashboardRepo, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, nil)
 
 	ctx := context.Background()
 
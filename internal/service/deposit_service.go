@@ -0,0 +1,342 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/shopspring/decimal"
+)
+
+// Service layer errors específicos de caução
+var (
+	ErrLeaseHasNoDeposit      = errors.New("lease has no security deposit")
+	ErrDepositNotReceived     = errors.New("security deposit has not been received")
+	ErrDepositAlreadySettled  = errors.New("security deposit already settled")
+	ErrLeaseNotEnded          = errors.New("security deposit can only be settled after the lease ends")
+	ErrLeaseRenewedForDeposit = errors.New("lease was renewed, settle the deposit on the latest contract")
+	ErrDepositNotSettled      = errors.New("security deposit has not been settled")
+	ErrDepositAlreadyRefunded = errors.New("security deposit already refunded")
+)
+
+// DepositService contém a lógica de negócio da caução e do acerto na saída do morador
+type DepositService struct {
+	leaseRepo      repository.LeaseRepository
+	paymentRepo    repository.PaymentRepository
+	settlementRepo repository.DepositSettlementRepository
+	inventory      *InventoryService
+	events         EventPublisher
+}
+
+// NewDepositService cria uma nova instância do serviço de caução
+func NewDepositService(
+	leaseRepo repository.LeaseRepository,
+	paymentRepo repository.PaymentRepository,
+	settlementRepo repository.DepositSettlementRepository,
+	inventory *InventoryService,
+) *DepositService {
+	return &DepositService{
+		leaseRepo:      leaseRepo,
+		paymentRepo:    paymentRepo,
+		settlementRepo: settlementRepo,
+		inventory:      inventory,
+	}
+}
+
+// SetEventPublisher habilita a publicação de eventos para integrações externas (webhooks)
+func (s *DepositService) SetEventPublisher(events EventPublisher) {
+	s.events = events
+}
+
+// DepositSummary representa a situação da caução de um contrato
+type DepositSummary struct {
+	Lease               *domain.Lease             `json:"lease"`
+	DepositReceivedDate *time.Time                `json:"deposit_received_date,omitempty"`
+	Settlement          *domain.DepositSettlement `json:"settlement,omitempty"` // Acerto registrado
+	Preview             *domain.DepositSettlement `json:"preview,omitempty"`    // Simulação com os descontos atuais, sem correção
+}
+
+// SettleDepositRequest representa os dados do acerto da caução
+type SettleDepositRequest struct {
+	CorrectionRate    decimal.Decimal       // Percentual acumulado do índice no período (ignorado sem índice de correção)
+	AdditionalDamages decimal.Decimal       // Danos não cobertos pelo checklist de saída
+	OtherDeductions   decimal.Decimal       // Outros descontos (ex: limpeza)
+	RefundedAt        *time.Time            // Data da devolução (padrão: hoje)
+	RefundMethod      *domain.PaymentMethod // Método da devolução, se já devolvida
+	Notes             *string
+	CreatedBy         *uuid.UUID
+}
+
+// GetDepositSummary retorna a caução do contrato com o acerto ou uma simulação dele
+func (s *DepositService) GetDepositSummary(ctx context.Context, leaseID uuid.UUID) (*DepositSummary, error) {
+	lease, chain, err := s.getLeaseChain(ctx, leaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &DepositSummary{
+		Lease:               lease,
+		DepositReceivedDate: depositReceivedDate(chain),
+	}
+
+	settlement, err := s.settlementRepo.GetByLeaseID(ctx, lease.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting deposit settlement: %w", err)
+	}
+	if settlement != nil {
+		summary.Settlement = settlement
+		return summary, nil
+	}
+
+	if lease.HasSecurityDeposit() {
+		preview, err := s.calculateSettlement(ctx, lease, chain, decimal.Zero, decimal.Zero, decimal.Zero)
+		if err != nil {
+			return nil, err
+		}
+		summary.Preview = preview
+	}
+
+	return summary, nil
+}
+
+// SettleDeposit calcula e registra o acerto da caução após a saída do morador
+// Desconta os danos apurados no checklist de saída e os pagamentos em aberto de toda a cadeia de renovações
+// Os pagamentos em aberto cobertos pela caução são quitados junto com o acerto
+func (s *DepositService) SettleDeposit(ctx context.Context, leaseID uuid.UUID, req SettleDepositRequest) (*domain.DepositSettlement, error) {
+	// 1. Buscar o contrato e a cadeia de renovações
+	lease, chain, err := s.getLeaseChain(ctx, leaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Validar que há caução recebida
+	if !lease.HasSecurityDeposit() {
+		return nil, ErrLeaseHasNoDeposit
+	}
+	if depositReceivedDate(chain) == nil {
+		return nil, ErrDepositNotReceived
+	}
+
+	// 3. Validar que o contrato terminou e não foi renovado
+	if lease.Status == domain.LeaseStatusActive || lease.Status == domain.LeaseStatusExpiringSoon {
+		return nil, ErrLeaseNotEnded
+	}
+	renewed, err := s.hasRenewal(ctx, lease)
+	if err != nil {
+		return nil, err
+	}
+	if renewed {
+		return nil, ErrLeaseRenewedForDeposit
+	}
+
+	// 4. Validar que ainda não houve acerto
+	existing, err := s.settlementRepo.GetByLeaseID(ctx, lease.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting deposit settlement: %w", err)
+	}
+	if existing != nil {
+		return nil, ErrDepositAlreadySettled
+	}
+
+	// 5. Calcular o acerto
+	correctionRate := decimal.Zero
+	if lease.IsDepositCorrected() {
+		correctionRate = req.CorrectionRate
+	}
+
+	settlement, err := s.calculateSettlement(ctx, lease, chain, correctionRate, req.AdditionalDamages, req.OtherDeductions)
+	if err != nil {
+		return nil, err
+	}
+	settlement.Notes = req.Notes
+	settlement.CreatedBy = req.CreatedBy
+
+	// 6. Registrar a devolução, se informada
+	if req.RefundMethod != nil {
+		refundedAt := time.Now()
+		if req.RefundedAt != nil {
+			refundedAt = *req.RefundedAt
+		}
+		if err := settlement.MarkRefunded(refundedAt, *req.RefundMethod); err != nil {
+			return nil, err
+		}
+	}
+
+	// 7. Persistir
+	if err := s.settlementRepo.Create(ctx, settlement); err != nil {
+		return nil, fmt.Errorf("error saving deposit settlement: %w", err)
+	}
+
+	// 8. Notificar a quitação dos pagamentos cobertos pela caução
+	s.publishSettledPayments(ctx, settlement)
+
+	return settlement, nil
+}
+
+// publishSettledPayments publica payment.paid para cada pagamento quitado pelo acerto
+// O acerto já foi gravado, então falhas ao buscar o pagamento apenas geram aviso
+func (s *DepositService) publishSettledPayments(ctx context.Context, settlement *domain.DepositSettlement) {
+	if s.events == nil {
+		return
+	}
+	for _, paymentID := range settlement.SettledPaymentIDs {
+		payment, err := s.paymentRepo.GetByID(ctx, paymentID)
+		if err != nil {
+			fmt.Printf("Warning: failed to load payment %s settled by deposit: %v\n", paymentID, err)
+			continue
+		}
+		publishEvent(ctx, s.events, domain.WebhookEventPaymentPaid, payment)
+	}
+}
+
+// RefundDeposit registra a devolução do saldo de uma caução já acertada
+// Usado quando o acerto foi registrado antes de o valor ser devolvido ao morador
+func (s *DepositService) RefundDeposit(ctx context.Context, leaseID uuid.UUID, refundedAt *time.Time, method domain.PaymentMethod) (*domain.DepositSettlement, error) {
+	// 1. Buscar o acerto do contrato
+	settlement, err := s.settlementRepo.GetByLeaseID(ctx, leaseID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting deposit settlement: %w", err)
+	}
+	if settlement == nil {
+		return nil, ErrDepositNotSettled
+	}
+	if settlement.RefundedAt != nil {
+		return nil, ErrDepositAlreadyRefunded
+	}
+
+	// 2. Registrar a devolução
+	date := time.Now()
+	if refundedAt != nil {
+		date = *refundedAt
+	}
+	if err := settlement.MarkRefunded(date, method); err != nil {
+		return nil, err
+	}
+
+	// 3. Persistir
+	if err := s.settlementRepo.MarkRefunded(ctx, settlement.ID, *settlement.RefundedAt, *settlement.RefundMethod); err != nil {
+		return nil, fmt.Errorf("error saving deposit refund: %w", err)
+	}
+
+	return settlement, nil
+}
+
+// calculateSettlement soma os descontos de danos e dívidas e calcula o saldo a devolver
+func (s *DepositService) calculateSettlement(ctx context.Context, lease *domain.Lease, chain []*domain.Lease, correctionRate, additionalDamages, otherDeductions decimal.Decimal) (*domain.DepositSettlement, error) {
+	damages, err := s.inventoryDamages(ctx, lease.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	openPayments, err := s.openDebts(ctx, chain)
+	if err != nil {
+		return nil, err
+	}
+
+	if additionalDamages.LessThan(decimal.Zero) {
+		return nil, domain.ErrInvalidDepositDeduction
+	}
+
+	settlement, err := domain.NewDepositSettlement(lease.ID, lease.SecurityDeposit, correctionRate, damages.Add(additionalDamages), decimal.Zero, otherDeductions)
+	if err != nil {
+		return nil, fmt.Errorf("error calculating deposit settlement: %w", err)
+	}
+	// Descontar as dívidas quitadas com a caução antes dos danos e outros descontos
+	settlement.CoverDebts(openPayments)
+
+	return settlement, nil
+}
+
+// inventoryDamages retorna o custo de reposição apurado no checklist de saída
+func (s *DepositService) inventoryDamages(ctx context.Context, leaseID uuid.UUID) (decimal.Decimal, error) {
+	if s.inventory == nil {
+		return decimal.Zero, nil
+	}
+
+	report, err := s.inventory.GetLeaseInventoryReport(ctx, leaseID)
+	if err != nil {
+		if errors.Is(err, ErrMoveInChecklistNotFound) {
+			return decimal.Zero, nil
+		}
+		return decimal.Zero, fmt.Errorf("error getting inventory report: %w", err)
+	}
+
+	return report.TotalReplacementCost, nil
+}
+
+// openDebts retorna os pagamentos pendentes e atrasados de todos os contratos da cadeia
+func (s *DepositService) openDebts(ctx context.Context, chain []*domain.Lease) ([]*domain.Payment, error) {
+	var open []*domain.Payment
+	for _, lease := range chain {
+		payments, err := s.paymentRepo.ListByLeaseID(ctx, lease.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error listing payments by lease: %w", err)
+		}
+
+		for _, p := range payments {
+			if p.PaymentType == domain.PaymentTypeSecurityDeposit {
+				continue
+			}
+			if p.Status == domain.PaymentStatusPending || p.Status == domain.PaymentStatusOverdue {
+				open = append(open, p)
+			}
+		}
+	}
+	return open, nil
+}
+
+// hasRenewal verifica se existe um contrato renovado a partir deste
+func (s *DepositService) hasRenewal(ctx context.Context, lease *domain.Lease) (bool, error) {
+	leases, err := s.leaseRepo.ListByUnitID(ctx, lease.UnitID)
+	if err != nil {
+		return false, fmt.Errorf("error listing leases by unit: %w", err)
+	}
+
+	for _, l := range leases {
+		if l.ParentLeaseID != nil && *l.ParentLeaseID == lease.ID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getLeaseChain busca o contrato e todos os anteriores da cadeia de renovações (do mais recente ao original)
+func (s *DepositService) getLeaseChain(ctx context.Context, leaseID uuid.UUID) (*domain.Lease, []*domain.Lease, error) {
+	lease, err := s.leaseRepo.GetByID(ctx, leaseID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting lease: %w", err)
+	}
+	if lease == nil {
+		return nil, nil, ErrLeaseNotFound
+	}
+
+	chain := []*domain.Lease{lease}
+	current := lease
+	for current.ParentLeaseID != nil {
+		parent, err := s.leaseRepo.GetByID(ctx, *current.ParentLeaseID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting parent lease: %w", err)
+		}
+		if parent == nil {
+			break
+		}
+		chain = append(chain, parent)
+		current = parent
+	}
+
+	return lease, chain, nil
+}
+
+// depositReceivedDate retorna a data de recebimento da caução em qualquer contrato da cadeia
+func depositReceivedDate(chain []*domain.Lease) *time.Time {
+	for _, lease := range chain {
+		if lease.DepositReceivedDate != nil {
+			return lease.DepositReceivedDate
+		}
+	}
+	return nil
+}
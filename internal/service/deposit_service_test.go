@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockDepositSettlementRepo é um mock do repository de acerto da caução
+type MockDepositSettlementRepo struct {
+	mock.Mock
+}
+
+func (m *MockDepositSettlementRepo) Create(ctx context.Context, settlement *domain.DepositSettlement) error {
+	args := m.Called(ctx, settlement)
+	return args.Error(0)
+}
+
+func (m *MockDepositSettlementRepo) GetByLeaseID(ctx context.Context, leaseID uuid.UUID) (*domain.DepositSettlement, error) {
+	args := m.Called(ctx, leaseID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DepositSettlement), args.Error(1)
+}
+
+func (m *MockDepositSettlementRepo) MarkRefunded(ctx context.Context, id uuid.UUID, refundedAt time.Time, method domain.PaymentMethod) error {
+	args := m.Called(ctx, id, refundedAt, method)
+	return args.Error(0)
+}
+
+func TestDepositService_SettleDeposit(t *testing.T) {
+	ctx := context.Background()
+	receivedDate := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	newChain := func() (*domain.Lease, *domain.Lease) {
+		original := createTestLease()
+		original.Status = domain.LeaseStatusExpired
+		original.SecurityDeposit = decimal.NewFromInt(1600)
+		original.DepositReceivedDate = &receivedDate
+
+		renewal := createTestLease()
+		renewal.UnitID = original.UnitID
+		renewal.Status = domain.LeaseStatusExpired
+		renewal.ParentLeaseID = &original.ID
+		renewal.Generation = 2
+		renewal.SecurityDeposit = original.SecurityDeposit
+		return original, renewal
+	}
+
+	t.Run("should deduct open debts across the renewal chain", func(t *testing.T) {
+		original, renewal := newChain()
+		mockLeaseRepo := new(MockLeaseRepo)
+		mockPaymentRepo := new(MockPaymentRepo)
+		mockSettlementRepo := new(MockDepositSettlementRepo)
+		service := NewDepositService(mockLeaseRepo, mockPaymentRepo, mockSettlementRepo, nil)
+
+		mockLeaseRepo.On("GetByID", ctx, renewal.ID).Return(renewal, nil)
+		mockLeaseRepo.On("GetByID", ctx, original.ID).Return(original, nil)
+		mockLeaseRepo.On("ListByUnitID", ctx, renewal.UnitID).Return([]*domain.Lease{renewal, original}, nil)
+		mockSettlementRepo.On("GetByLeaseID", ctx, renewal.ID).Return(nil, nil)
+		overdueRent := &domain.Payment{ID: uuid.New(), PaymentType: domain.PaymentTypeRent, Status: domain.PaymentStatusOverdue, Amount: decimal.NewFromInt(800), DueDate: receivedDate.AddDate(1, 0, 0)}
+		paintingFee := &domain.Payment{ID: uuid.New(), PaymentType: domain.PaymentTypePaintingFee, Status: domain.PaymentStatusPending, Amount: decimal.NewFromInt(100), DueDate: receivedDate.AddDate(0, 11, 0)}
+		mockPaymentRepo.On("ListByLeaseID", ctx, renewal.ID).Return([]*domain.Payment{
+			overdueRent,
+			{PaymentType: domain.PaymentTypeRent, Status: domain.PaymentStatusPaid, Amount: decimal.NewFromInt(800)},
+		}, nil)
+		mockPaymentRepo.On("ListByLeaseID", ctx, original.ID).Return([]*domain.Payment{
+			{PaymentType: domain.PaymentTypeSecurityDeposit, Status: domain.PaymentStatusPaid, Amount: decimal.NewFromInt(1600)},
+			paintingFee,
+		}, nil)
+		mockSettlementRepo.On("Create", ctx, mock.AnythingOfType("*domain.DepositSettlement")).Return(nil)
+
+		method := domain.PaymentMethodPix
+		settlement, err := service.SettleDeposit(ctx, renewal.ID, SettleDepositRequest{
+			CorrectionRate:    decimal.NewFromInt(5), // Ignorado: contrato sem índice de correção
+			AdditionalDamages: decimal.NewFromInt(200),
+			RefundMethod:      &method,
+		})
+
+		require.NoError(t, err)
+		assert.True(t, settlement.CorrectionAmount.IsZero())
+		assert.True(t, decimal.NewFromInt(900).Equal(settlement.DebtDeductions))
+		assert.True(t, decimal.NewFromInt(200).Equal(settlement.DamageDeductions))
+		assert.True(t, decimal.NewFromInt(500).Equal(settlement.RefundAmount))
+		assert.NotNil(t, settlement.RefundedAt)
+		// Os pagamentos descontados são quitados com o acerto, do vencimento mais antigo ao mais recente
+		assert.Equal(t, []uuid.UUID{paintingFee.ID, overdueRent.ID}, settlement.SettledPaymentIDs)
+		mockSettlementRepo.AssertExpectations(t)
+	})
+
+	t.Run("should publish payment.paid for the settled payments", func(t *testing.T) {
+		original, renewal := newChain()
+		mockLeaseRepo := new(MockLeaseRepo)
+		mockPaymentRepo := new(MockPaymentRepo)
+		mockSettlementRepo := new(MockDepositSettlementRepo)
+		publisher := &recordingPublisher{}
+		service := NewDepositService(mockLeaseRepo, mockPaymentRepo, mockSettlementRepo, nil)
+		service.SetEventPublisher(publisher)
+
+		mockLeaseRepo.On("GetByID", ctx, renewal.ID).Return(renewal, nil)
+		mockLeaseRepo.On("GetByID", ctx, original.ID).Return(original, nil)
+		mockLeaseRepo.On("ListByUnitID", ctx, renewal.UnitID).Return([]*domain.Lease{renewal, original}, nil)
+		mockSettlementRepo.On("GetByLeaseID", ctx, renewal.ID).Return(nil, nil)
+		overdueRent := &domain.Payment{ID: uuid.New(), PaymentType: domain.PaymentTypeRent, Status: domain.PaymentStatusOverdue, Amount: decimal.NewFromInt(800), DueDate: receivedDate.AddDate(1, 0, 0)}
+		mockPaymentRepo.On("ListByLeaseID", ctx, renewal.ID).Return([]*domain.Payment{overdueRent}, nil)
+		mockPaymentRepo.On("ListByLeaseID", ctx, original.ID).Return([]*domain.Payment{}, nil)
+		mockSettlementRepo.On("Create", ctx, mock.AnythingOfType("*domain.DepositSettlement")).Return(nil)
+		paidRent := *overdueRent
+		paidRent.Status = domain.PaymentStatusPaid
+		mockPaymentRepo.On("GetByID", ctx, overdueRent.ID).Return(&paidRent, nil)
+
+		_, err := service.SettleDeposit(ctx, renewal.ID, SettleDepositRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, []domain.WebhookEventType{domain.WebhookEventPaymentPaid}, publisher.events)
+		assert.Equal(t, &paidRent, publisher.data[0])
+	})
+
+	t.Run("should fail when the lease was renewed", func(t *testing.T) {
+		original, renewal := newChain()
+		mockLeaseRepo := new(MockLeaseRepo)
+		mockSettlementRepo := new(MockDepositSettlementRepo)
+		service := NewDepositService(mockLeaseRepo, new(MockPaymentRepo), mockSettlementRepo, nil)
+
+		mockLeaseRepo.On("GetByID", ctx, original.ID).Return(original, nil)
+		mockLeaseRepo.On("ListByUnitID", ctx, original.UnitID).Return([]*domain.Lease{renewal, original}, nil)
+
+		settlement, err := service.SettleDeposit(ctx, original.ID, SettleDepositRequest{})
+
+		assert.Nil(t, settlement)
+		assert.ErrorIs(t, err, ErrLeaseRenewedForDeposit)
+		mockSettlementRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should fail when deposit was not received", func(t *testing.T) {
+		lease := createTestLease()
+		lease.Status = domain.LeaseStatusCancelled
+		lease.SecurityDeposit = decimal.NewFromInt(800)
+		mockLeaseRepo := new(MockLeaseRepo)
+		service := NewDepositService(mockLeaseRepo, new(MockPaymentRepo), new(MockDepositSettlementRepo), nil)
+
+		mockLeaseRepo.On("GetByID", ctx, lease.ID).Return(lease, nil)
+
+		settlement, err := service.SettleDeposit(ctx, lease.ID, SettleDepositRequest{})
+
+		assert.Nil(t, settlement)
+		assert.ErrorIs(t, err, ErrDepositNotReceived)
+	})
+}
+
+func TestDepositService_RefundDeposit(t *testing.T) {
+	ctx := context.Background()
+	leaseID := uuid.New()
+	refundedAt := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should record refund of a settled deposit", func(t *testing.T) {
+		mockSettlementRepo := new(MockDepositSettlementRepo)
+		service := NewDepositService(new(MockLeaseRepo), new(MockPaymentRepo), mockSettlementRepo, nil)
+
+		settlement, err := domain.NewDepositSettlement(leaseID, decimal.NewFromInt(1600), decimal.Zero, decimal.Zero, decimal.Zero, decimal.Zero)
+		require.NoError(t, err)
+		mockSettlementRepo.On("GetByLeaseID", ctx, leaseID).Return(settlement, nil)
+		mockSettlementRepo.On("MarkRefunded", ctx, settlement.ID, refundedAt, domain.PaymentMethodPix).Return(nil)
+
+		result, err := service.RefundDeposit(ctx, leaseID, &refundedAt, domain.PaymentMethodPix)
+
+		require.NoError(t, err)
+		assert.Equal(t, refundedAt, *result.RefundedAt)
+		assert.Equal(t, domain.PaymentMethodPix, *result.RefundMethod)
+		mockSettlementRepo.AssertExpectations(t)
+	})
+
+	t.Run("should fail when deposit was not settled", func(t *testing.T) {
+		mockSettlementRepo := new(MockDepositSettlementRepo)
+		service := NewDepositService(new(MockLeaseRepo), new(MockPaymentRepo), mockSettlementRepo, nil)
+
+		mockSettlementRepo.On("GetByLeaseID", ctx, leaseID).Return(nil, nil)
+
+		result, err := service.RefundDeposit(ctx, leaseID, nil, domain.PaymentMethodPix)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrDepositNotSettled)
+	})
+
+	t.Run("should fail when deposit was already refunded", func(t *testing.T) {
+		mockSettlementRepo := new(MockDepositSettlementRepo)
+		service := NewDepositService(new(MockLeaseRepo), new(MockPaymentRepo), mockSettlementRepo, nil)
+
+		settlement, err := domain.NewDepositSettlement(leaseID, decimal.NewFromInt(1600), decimal.Zero, decimal.Zero, decimal.Zero, decimal.Zero)
+		require.NoError(t, err)
+		require.NoError(t, settlement.MarkRefunded(refundedAt, domain.PaymentMethodCash))
+		mockSettlementRepo.On("GetByLeaseID", ctx, leaseID).Return(settlement, nil)
+
+		result, err := service.RefundDeposit(ctx, leaseID, nil, domain.PaymentMethodPix)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrDepositAlreadyRefunded)
+		mockSettlementRepo.AssertNotCalled(t, "MarkRefunded", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
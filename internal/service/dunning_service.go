@@ -0,0 +1,255 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+)
+
+// Service layer errors específicos da régua de cobrança
+var (
+	ErrDunningStepNotFound   = errors.New("dunning step not found")
+	ErrDunningStepCodeExists = errors.New("dunning step code already exists")
+)
+
+// DunningService contém a lógica da régua de cobrança
+type DunningService struct {
+	dunningRepo         repository.DunningRepository
+	paymentRepo         repository.PaymentRepository
+	notificationService *NotificationService
+}
+
+// NewDunningService cria uma nova instância do serviço da régua de cobrança
+func NewDunningService(
+	dunningRepo repository.DunningRepository,
+	paymentRepo repository.PaymentRepository,
+	notificationService *NotificationService,
+) *DunningService {
+	return &DunningService{
+		dunningRepo:         dunningRepo,
+		paymentRepo:         paymentRepo,
+		notificationService: notificationService,
+	}
+}
+
+// DunningRunResult representa o resultado de uma execução da régua de cobrança
+type DunningRunResult struct {
+	StepsExecuted        int       `json:"steps_executed"`
+	StepsSkipped         int       `json:"steps_skipped"`
+	NotificationsCreated int       `json:"notifications_created"`
+	EvictionReviews      int       `json:"eviction_reviews"`
+	ProcessedAt          time.Time `json:"processed_at"`
+}
+
+// ListSteps lista todas as etapas da régua
+func (s *DunningService) ListSteps(ctx context.Context) ([]*domain.DunningStep, error) {
+	steps, err := s.dunningRepo.ListSteps(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing dunning steps: %w", err)
+	}
+	return steps, nil
+}
+
+// CreateStep adiciona uma etapa à régua
+func (s *DunningService) CreateStep(ctx context.Context, code, name string, offsetDays int, action domain.DunningAction) (*domain.DunningStep, error) {
+	step, err := domain.NewDunningStep(code, name, offsetDays, action)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.dunningRepo.GetStepByCode(ctx, step.Code)
+	if err != nil {
+		return nil, fmt.Errorf("error checking dunning step code: %w", err)
+	}
+	if existing != nil {
+		return nil, ErrDunningStepCodeExists
+	}
+
+	if err := s.dunningRepo.CreateStep(ctx, step); err != nil {
+		return nil, fmt.Errorf("error creating dunning step: %w", err)
+	}
+
+	return step, nil
+}
+
+// UpdateStep altera uma etapa da régua; etapas já registradas no histórico não são reexecutadas
+func (s *DunningService) UpdateStep(ctx context.Context, id uuid.UUID, name string, offsetDays int, action domain.DunningAction, active bool) (*domain.DunningStep, error) {
+	step, err := s.dunningRepo.GetStepByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching dunning step: %w", err)
+	}
+	if step == nil {
+		return nil, ErrDunningStepNotFound
+	}
+
+	if err := step.Update(name, offsetDays, action, active); err != nil {
+		return nil, err
+	}
+
+	if err := s.dunningRepo.UpdateStep(ctx, step); err != nil {
+		return nil, fmt.Errorf("error updating dunning step: %w", err)
+	}
+
+	return step, nil
+}
+
+// GetCollectionHistory retorna as etapas da régua registradas para um pagamento
+func (s *DunningService) GetCollectionHistory(ctx context.Context, paymentID uuid.UUID) ([]*domain.CollectionAction, error) {
+	payment, err := s.paymentRepo.GetByID(ctx, paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching payment: %w", err)
+	}
+	if payment == nil {
+		return nil, ErrPaymentNotFound
+	}
+
+	actions, err := s.dunningRepo.ListActionsByPaymentID(ctx, paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing collection history: %w", err)
+	}
+	return actions, nil
+}
+
+// ListEvictionReviews lista os pagamentos em aberto sinalizados para análise de despejo
+func (s *DunningService) ListEvictionReviews(ctx context.Context) ([]*domain.CollectionAction, error) {
+	actions, err := s.dunningRepo.ListOpenActionsByAction(ctx, domain.DunningActionEvictionReview)
+	if err != nil {
+		return nil, fmt.Errorf("error listing eviction reviews: %w", err)
+	}
+	return actions, nil
+}
+
+// RunDunning aplica a régua de cobrança aos pagamentos em aberto
+// Cada etapa é executada uma única vez por pagamento; pagamentos quitados saem da régua
+// Este método deve ser executado diariamente pelo scheduler, antes do envio das notificações
+func (s *DunningService) RunDunning(ctx context.Context) (*DunningRunResult, error) {
+	result := &DunningRunResult{ProcessedAt: time.Now()}
+
+	steps, err := s.dunningRepo.ListActiveSteps(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing active dunning steps: %w", err)
+	}
+	if len(steps) == 0 {
+		return result, nil
+	}
+
+	payments, err := s.openPayments(ctx, steps)
+	if err != nil {
+		return nil, err
+	}
+
+	today := startOfDay(time.Now())
+	for _, payment := range payments {
+		history, err := s.dunningRepo.ListActionsByPaymentID(ctx, payment.ID)
+		if err != nil {
+			return result, fmt.Errorf("error listing collection history: %w", err)
+		}
+
+		run, skipped := domain.PlanDunning(steps, history, payment, today)
+		for _, step := range skipped {
+			note := "Etapa anterior a uma etapa já registrada"
+			if run != nil {
+				note = fmt.Sprintf("Etapa superada por %s (%s)", run.Name, run.Label())
+			}
+			if err := s.record(ctx, payment, step, domain.CollectionActionSkipped, 0, note, result); err != nil {
+				return result, err
+			}
+		}
+		if run == nil {
+			continue
+		}
+
+		// As mensagens são criadas antes do registro: em caso de falha a etapa é refeita na próxima execução
+		// e a dedup_key das notificações impede mensagens duplicadas
+		notifications, err := s.notificationService.EnqueueDunningNotice(ctx, payment, run)
+		result.NotificationsCreated += len(notifications)
+		if err != nil {
+			if errors.Is(err, ErrLeaseNotFoundForPayment) || errors.Is(err, ErrTenantNotFound) || errors.Is(err, ErrUnitNotFound) {
+				fmt.Printf("Warning: skipping dunning step %s for payment %s: %v\n", run.Code, payment.ID, err)
+				continue
+			}
+			return result, err
+		}
+
+		note := ""
+		switch {
+		case run.Action == domain.DunningActionEvictionReview:
+			note = "Pagamento sinalizado para análise de despejo"
+		case len(notifications) == 0:
+			note = "Nenhuma mensagem criada: morador sem contato nos canais habilitados"
+		}
+		if err := s.record(ctx, payment, run, domain.CollectionActionExecuted, len(notifications), note, result); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// openPayments busca os pagamentos em aberto alcançados pela régua (a vencer na antecedência da primeira etapa ou vencidos)
+func (s *DunningService) openPayments(ctx context.Context, steps []*domain.DunningStep) ([]*domain.Payment, error) {
+	leadDays := 0
+	for _, step := range steps {
+		if -step.OffsetDays > leadDays {
+			leadDays = -step.OffsetDays
+		}
+	}
+
+	upcoming, err := s.paymentRepo.GetUpcoming(ctx, leadDays)
+	if err != nil {
+		return nil, fmt.Errorf("error getting upcoming payments: %w", err)
+	}
+	overdue, err := s.paymentRepo.GetOverdue(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting overdue payments: %w", err)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(upcoming)+len(overdue))
+	payments := make([]*domain.Payment, 0, len(upcoming)+len(overdue))
+	for _, payment := range append(upcoming, overdue...) {
+		if seen[payment.ID] {
+			continue
+		}
+		seen[payment.ID] = true
+		payments = append(payments, payment)
+	}
+
+	return payments, nil
+}
+
+// record grava a etapa no histórico de cobrança do pagamento e atualiza os contadores
+func (s *DunningService) record(
+	ctx context.Context,
+	payment *domain.Payment,
+	step *domain.DunningStep,
+	status domain.CollectionActionStatus,
+	notificationsCreated int,
+	note string,
+	result *DunningRunResult,
+) error {
+	action := domain.NewCollectionAction(payment.ID, step, status, notificationsCreated, note, time.Now())
+
+	created, err := s.dunningRepo.CreateAction(ctx, action)
+	if err != nil {
+		return fmt.Errorf("error recording collection action: %w", err)
+	}
+	if !created {
+		return nil
+	}
+
+	switch {
+	case status == domain.CollectionActionSkipped:
+		result.StepsSkipped++
+	case step.Action == domain.DunningActionEvictionReview:
+		result.StepsExecuted++
+		result.EvictionReviews++
+	default:
+		result.StepsExecuted++
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockDunningRepo é um mock do repository da régua de cobrança
+type MockDunningRepo struct {
+	mock.Mock
+}
+
+func (m *MockDunningRepo) CreateStep(ctx context.Context, step *domain.DunningStep) error {
+	args := m.Called(ctx, step)
+	return args.Error(0)
+}
+
+func (m *MockDunningRepo) GetStepByID(ctx context.Context, id uuid.UUID) (*domain.DunningStep, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DunningStep), args.Error(1)
+}
+
+func (m *MockDunningRepo) GetStepByCode(ctx context.Context, code string) (*domain.DunningStep, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DunningStep), args.Error(1)
+}
+
+func (m *MockDunningRepo) ListSteps(ctx context.Context) ([]*domain.DunningStep, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*domain.DunningStep), args.Error(1)
+}
+
+func (m *MockDunningRepo) ListActiveSteps(ctx context.Context) ([]*domain.DunningStep, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*domain.DunningStep), args.Error(1)
+}
+
+func (m *MockDunningRepo) UpdateStep(ctx context.Context, step *domain.DunningStep) error {
+	args := m.Called(ctx, step)
+	return args.Error(0)
+}
+
+func (m *MockDunningRepo) CreateAction(ctx context.Context, action *domain.CollectionAction) (bool, error) {
+	args := m.Called(ctx, action)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDunningRepo) ListActionsByPaymentID(ctx context.Context, paymentID uuid.UUID) ([]*domain.CollectionAction, error) {
+	args := m.Called(ctx, paymentID)
+	return args.Get(0).([]*domain.CollectionAction), args.Error(1)
+}
+
+func (m *MockDunningRepo) ListOpenActionsByAction(ctx context.Context, action domain.DunningAction) ([]*domain.CollectionAction, error) {
+	args := m.Called(ctx, action)
+	return args.Get(0).([]*domain.CollectionAction), args.Error(1)
+}
+
+func newTestDunningStep(t *testing.T, code string, offsetDays int, action domain.DunningAction) *domain.DunningStep {
+	step, err := domain.NewDunningStep(code, "Etapa "+code, offsetDays, action)
+	require.NoError(t, err)
+	return step
+}
+
+func newTestDunningService() (*DunningService, *MockDunningRepo, *notificationServiceMocks) {
+	notificationService, mocks := newTestNotificationService()
+	dunningRepo := new(MockDunningRepo)
+	return NewDunningService(dunningRepo, mocks.paymentRepo, notificationService), dunningRepo, mocks
+}
+
+func TestDunningService_RunDunning(t *testing.T) {
+	ctx := context.Background()
+	today := startOfDay(time.Now())
+
+	reminder := newTestDunningStep(t, "friendly_reminder", -3, domain.DunningActionReminder)
+	firstNotice := newTestDunningStep(t, "first_notice", 1, domain.DunningActionNotice)
+	secondNotice := newTestDunningStep(t, "second_notice", 5, domain.DunningActionNotice)
+	formalNotice := newTestDunningStep(t, "formal_notice", 15, domain.DunningActionFormalNotice)
+	evictionReview := newTestDunningStep(t, "eviction_review", 30, domain.DunningActionEvictionReview)
+	ladder := []*domain.DunningStep{reminder, firstNotice, secondNotice, formalNotice, evictionReview}
+
+	t.Run("should execute the most advanced step once per payment", func(t *testing.T) {
+		service, dunningRepo, mocks := newTestDunningService()
+		service.notificationService.RegisterSender(domain.NotificationChannelInternal, new(MockSender))
+
+		lease := createTestLease()
+		newPayment := func(status domain.PaymentStatus, daysFromDue int) *domain.Payment {
+			return &domain.Payment{
+				ID: uuid.New(), LeaseID: lease.ID, PaymentType: domain.PaymentTypeRent, Status: status,
+				Amount: decimal.NewFromInt(800), DueDate: today.AddDate(0, 0, -daysFromDue),
+			}
+		}
+		upcoming := newPayment(domain.PaymentStatusPending, -3)
+		formal := newPayment(domain.PaymentStatusOverdue, 16)
+		eviction := newPayment(domain.PaymentStatusOverdue, 31)
+		done := newPayment(domain.PaymentStatusOverdue, 2)
+
+		dunningRepo.On("ListActiveSteps", ctx).Return(ladder, nil)
+		mocks.paymentRepo.On("GetUpcoming", ctx, 3).Return([]*domain.Payment{upcoming}, nil)
+		mocks.paymentRepo.On("GetOverdue", ctx).Return([]*domain.Payment{formal, eviction, done}, nil)
+
+		dunningRepo.On("ListActionsByPaymentID", ctx, upcoming.ID).Return([]*domain.CollectionAction{}, nil)
+		dunningRepo.On("ListActionsByPaymentID", ctx, formal.ID).Return([]*domain.CollectionAction{
+			domain.NewCollectionAction(formal.ID, reminder, domain.CollectionActionExecuted, 1, "", today.AddDate(0, 0, -19)),
+		}, nil)
+		evictionHistory := make([]*domain.CollectionAction, 0, 4)
+		for _, step := range ladder[:4] {
+			evictionHistory = append(evictionHistory, domain.NewCollectionAction(eviction.ID, step, domain.CollectionActionExecuted, 1, "", today.AddDate(0, 0, step.OffsetDays-31)))
+		}
+		dunningRepo.On("ListActionsByPaymentID", ctx, eviction.ID).Return(evictionHistory, nil)
+		dunningRepo.On("ListActionsByPaymentID", ctx, done.ID).Return([]*domain.CollectionAction{
+			domain.NewCollectionAction(done.ID, reminder, domain.CollectionActionExecuted, 1, "", today.AddDate(0, 0, -5)),
+			domain.NewCollectionAction(done.ID, firstNotice, domain.CollectionActionExecuted, 1, "", today.AddDate(0, 0, -1)),
+		}, nil)
+
+		mocks.leaseRepo.On("GetByID", ctx, lease.ID).Return(lease, nil)
+		mocks.tenantRepo.On("GetByID", ctx, lease.TenantID).Return(createTestTenant(lease.TenantID), nil)
+		mocks.unitRepo.On("GetByID", ctx, lease.UnitID).Return(createTestUnit(lease.UnitID, domain.UnitStatusOccupied), nil)
+		mocks.notificationRepo.On("Create", ctx, mock.Anything).Return(true, nil)
+		dunningRepo.On("CreateAction", ctx, mock.Anything).Return(true, nil)
+
+		result, err := service.RunDunning(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.StepsExecuted)
+		assert.Equal(t, 2, result.StepsSkipped)
+		assert.Equal(t, 2, result.NotificationsCreated)
+		assert.Equal(t, 1, result.EvictionReviews)
+
+		executed := map[uuid.UUID]string{}
+		for _, call := range dunningRepo.Calls {
+			if call.Method != "CreateAction" {
+				continue
+			}
+			action := call.Arguments.Get(1).(*domain.CollectionAction)
+			if action.Status == domain.CollectionActionExecuted {
+				executed[action.PaymentID] = action.StepCode
+			} else {
+				assert.Equal(t, formal.ID, action.PaymentID)
+				assert.Contains(t, *action.Notes, "Etapa formal_notice (D+15)")
+			}
+		}
+		assert.Equal(t, map[uuid.UUID]string{
+			upcoming.ID: "friendly_reminder",
+			formal.ID:   "formal_notice",
+			eviction.ID: "eviction_review",
+		}, executed)
+		mocks.notificationRepo.AssertNotCalled(t, "Create", ctx, mock.MatchedBy(func(n *domain.Notification) bool {
+			return *n.PaymentID == eviction.ID || *n.PaymentID == done.ID
+		}))
+	})
+
+	t.Run("should not record step when payment parties are missing", func(t *testing.T) {
+		service, dunningRepo, mocks := newTestDunningService()
+		overdue := &domain.Payment{ID: uuid.New(), LeaseID: uuid.New(), Status: domain.PaymentStatusOverdue, DueDate: today.AddDate(0, 0, -2)}
+
+		dunningRepo.On("ListActiveSteps", ctx).Return([]*domain.DunningStep{firstNotice}, nil)
+		mocks.paymentRepo.On("GetUpcoming", ctx, 0).Return([]*domain.Payment{}, nil)
+		mocks.paymentRepo.On("GetOverdue", ctx).Return([]*domain.Payment{overdue}, nil)
+		dunningRepo.On("ListActionsByPaymentID", ctx, overdue.ID).Return([]*domain.CollectionAction{}, nil)
+		mocks.leaseRepo.On("GetByID", ctx, overdue.LeaseID).Return(nil, nil)
+
+		result, err := service.RunDunning(ctx)
+
+		require.NoError(t, err)
+		assert.Zero(t, result.StepsExecuted)
+		dunningRepo.AssertNotCalled(t, "CreateAction", mock.Anything, mock.Anything)
+	})
+}
+
+func TestDunningService_Steps(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should reject duplicate code", func(t *testing.T) {
+		service, dunningRepo, _ := newTestDunningService()
+		dunningRepo.On("GetStepByCode", ctx, "first_notice").Return(newTestDunningStep(t, "first_notice", 1, domain.DunningActionNotice), nil)
+
+		_, err := service.CreateStep(ctx, "first_notice", "Aviso", 2, domain.DunningActionNotice)
+
+		assert.Equal(t, ErrDunningStepCodeExists, err)
+		dunningRepo.AssertNotCalled(t, "CreateStep", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should update step", func(t *testing.T) {
+		service, dunningRepo, _ := newTestDunningService()
+		step := newTestDunningStep(t, "second_notice", 5, domain.DunningActionNotice)
+		dunningRepo.On("GetStepByID", ctx, step.ID).Return(step, nil)
+		dunningRepo.On("UpdateStep", ctx, step).Return(nil)
+
+		updated, err := service.UpdateStep(ctx, step.ID, "Segundo aviso", 7, domain.DunningActionNotice, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, 7, updated.OffsetDays)
+		assert.False(t, updated.Active)
+	})
+
+	t.Run("should return not found for unknown step", func(t *testing.T) {
+		service, dunningRepo, _ := newTestDunningService()
+		id := uuid.New()
+		dunningRepo.On("GetStepByID", ctx, id).Return(nil, nil)
+
+		_, err := service.UpdateStep(ctx, id, "Aviso", 1, domain.DunningActionNotice, true)
+
+		assert.Equal(t, ErrDunningStepNotFound, err)
+	})
+}
+
+func TestDunningService_GetCollectionHistory(t *testing.T) {
+	ctx := context.Background()
+	service, _, mocks := newTestDunningService()
+	id := uuid.New()
+	mocks.paymentRepo.On("GetByID", ctx, id).Return(nil, nil)
+
+	_, err := service.GetCollectionHistory(ctx, id)
+
+	assert.Equal(t, ErrPaymentNotFound, err)
+}
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/shopspring/decimal"
+)
+
+// Service layer errors específicos de inventário
+var (
+	ErrInventoryItemNotFound   = errors.New("inventory item not found")
+	ErrChecklistAlreadyExists  = errors.New("inventory checklist already exists for this lease")
+	ErrMoveInChecklistNotFound = errors.New("move-in checklist not found for this lease")
+)
+
+// InventoryService contém a lógica de negócio para o inventário das unidades e checklists
+type InventoryService struct {
+	inventoryRepo repository.InventoryRepository
+	unitRepo      repository.UnitRepository
+	leaseRepo     repository.LeaseRepository
+}
+
+// NewInventoryService cria uma nova instância do serviço de inventário
+func NewInventoryService(
+	inventoryRepo repository.InventoryRepository,
+	unitRepo repository.UnitRepository,
+	leaseRepo repository.LeaseRepository,
+) *InventoryService {
+	return &InventoryService{
+		inventoryRepo: inventoryRepo,
+		unitRepo:      unitRepo,
+		leaseRepo:     leaseRepo,
+	}
+}
+
+// InventoryItemRequest representa os dados para cadastrar ou atualizar um item
+type InventoryItemRequest struct {
+	Name          string
+	Type          domain.InventoryItemType
+	Brand         *string
+	SerialNumber  *string
+	Condition     domain.ItemCondition
+	PurchaseDate  *time.Time
+	PurchaseValue *decimal.Decimal
+	Notes         *string
+}
+
+// MoveOutChecklistRequest representa a conferência do inventário na saída do morador
+type MoveOutChecklistRequest struct {
+	Items       []domain.MoveOutItemCheck
+	Notes       *string
+	PerformedBy *uuid.UUID
+}
+
+// LeaseInventoryReport compara a entrada e a saída do morador
+type LeaseInventoryReport struct {
+	LeaseID              uuid.UUID                      `json:"lease_id"`
+	MoveIn               *domain.InventoryChecklist     `json:"move_in"`
+	MoveOut              *domain.InventoryChecklist     `json:"move_out,omitempty"`
+	Discrepancies        []*domain.InventoryDiscrepancy `json:"discrepancies"`
+	TotalReplacementCost decimal.Decimal                `json:"total_replacement_cost"`
+}
+
+// AddItem cadastra um item no inventário da unidade
+func (s *InventoryService) AddItem(ctx context.Context, unitID uuid.UUID, req InventoryItemRequest) (*domain.UnitInventoryItem, error) {
+	unit, err := s.unitRepo.GetByID(ctx, unitID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting unit: %w", err)
+	}
+	if unit == nil {
+		return nil, ErrUnitNotFound
+	}
+
+	item, err := domain.NewUnitInventoryItem(unitID, req.Name, req.Type, req.Condition)
+	if err != nil {
+		return nil, fmt.Errorf("error creating inventory item: %w", err)
+	}
+
+	applyInventoryItemDetails(item, req)
+
+	if err := item.Validate(); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := s.inventoryRepo.CreateItem(ctx, item); err != nil {
+		return nil, fmt.Errorf("error saving inventory item: %w", err)
+	}
+
+	return item, nil
+}
+
+// ListItems retorna o inventário de uma unidade
+func (s *InventoryService) ListItems(ctx context.Context, unitID uuid.UUID) ([]*domain.UnitInventoryItem, error) {
+	items, err := s.inventoryRepo.ListItemsByUnitID(ctx, unitID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing inventory items: %w", err)
+	}
+	return items, nil
+}
+
+// UpdateItem atualiza um item do inventário da unidade
+func (s *InventoryService) UpdateItem(ctx context.Context, unitID, itemID uuid.UUID, req InventoryItemRequest) (*domain.UnitInventoryItem, error) {
+	item, err := s.getUnitItem(ctx, unitID, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	item.Name = strings.TrimSpace(req.Name)
+	item.Type = req.Type
+	item.Condition = req.Condition
+	applyInventoryItemDetails(item, req)
+
+	if err := item.Validate(); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := s.inventoryRepo.UpdateItem(ctx, item); err != nil {
+		return nil, fmt.Errorf("error updating inventory item: %w", err)
+	}
+
+	return item, nil
+}
+
+// DeleteItem remove um item do inventário da unidade
+func (s *InventoryService) DeleteItem(ctx context.Context, unitID, itemID uuid.UUID) error {
+	if _, err := s.getUnitItem(ctx, unitID, itemID); err != nil {
+		return err
+	}
+
+	if err := s.inventoryRepo.DeleteItem(ctx, itemID); err != nil {
+		return fmt.Errorf("error deleting inventory item: %w", err)
+	}
+
+	return nil
+}
+
+// SnapshotMoveIn registra o checklist de entrada com o inventário atual da unidade do contrato
+func (s *InventoryService) SnapshotMoveIn(ctx context.Context, lease *domain.Lease, performedBy *uuid.UUID) (*domain.InventoryChecklist, error) {
+	existing, err := s.inventoryRepo.GetChecklist(ctx, lease.ID, domain.ChecklistTypeMoveIn)
+	if err != nil {
+		return nil, fmt.Errorf("error getting move-in checklist: %w", err)
+	}
+	if existing != nil {
+		return nil, ErrChecklistAlreadyExists
+	}
+
+	items, err := s.inventoryRepo.ListItemsByUnitID(ctx, lease.UnitID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing inventory items: %w", err)
+	}
+
+	checklist := domain.NewMoveInChecklist(lease.ID, lease.UnitID, items, performedBy)
+	if err := s.inventoryRepo.CreateChecklist(ctx, checklist); err != nil {
+		return nil, fmt.Errorf("error saving move-in checklist: %w", err)
+	}
+
+	return checklist, nil
+}
+
+// CreateMoveInChecklist registra manualmente o checklist de entrada de um contrato
+func (s *InventoryService) CreateMoveInChecklist(ctx context.Context, leaseID uuid.UUID, performedBy *uuid.UUID) (*domain.InventoryChecklist, error) {
+	lease, err := s.getLease(ctx, leaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.SnapshotMoveIn(ctx, lease, performedBy)
+}
+
+// CreateMoveOutChecklist registra a conferência de saída do morador
+func (s *InventoryService) CreateMoveOutChecklist(ctx context.Context, leaseID uuid.UUID, req MoveOutChecklistRequest) (*LeaseInventoryReport, error) {
+	lease, err := s.getLease(ctx, leaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.inventoryRepo.GetChecklist(ctx, lease.ID, domain.ChecklistTypeMoveOut)
+	if err != nil {
+		return nil, fmt.Errorf("error getting move-out checklist: %w", err)
+	}
+	if existing != nil {
+		return nil, ErrChecklistAlreadyExists
+	}
+
+	moveIn, err := s.findMoveInChecklist(ctx, lease)
+	if err != nil {
+		return nil, err
+	}
+
+	moveOut, err := domain.NewMoveOutChecklist(lease.ID, moveIn, req.Items, req.Notes, req.PerformedBy)
+	if err != nil {
+		return nil, fmt.Errorf("error creating move-out checklist: %w", err)
+	}
+
+	if err := s.inventoryRepo.CreateChecklist(ctx, moveOut); err != nil {
+		return nil, fmt.Errorf("error saving move-out checklist: %w", err)
+	}
+
+	return buildLeaseInventoryReport(lease.ID, moveIn, moveOut), nil
+}
+
+// GetLeaseInventoryReport retorna os checklists do contrato e os itens faltantes ou danificados
+func (s *InventoryService) GetLeaseInventoryReport(ctx context.Context, leaseID uuid.UUID) (*LeaseInventoryReport, error) {
+	lease, err := s.getLease(ctx, leaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	moveIn, err := s.findMoveInChecklist(ctx, lease)
+	if err != nil {
+		return nil, err
+	}
+
+	moveOut, err := s.inventoryRepo.GetChecklist(ctx, lease.ID, domain.ChecklistTypeMoveOut)
+	if err != nil {
+		return nil, fmt.Errorf("error getting move-out checklist: %w", err)
+	}
+
+	return buildLeaseInventoryReport(lease.ID, moveIn, moveOut), nil
+}
+
+// findMoveInChecklist busca o checklist de entrada subindo pela cadeia de renovações do contrato
+func (s *InventoryService) findMoveInChecklist(ctx context.Context, lease *domain.Lease) (*domain.InventoryChecklist, error) {
+	current := lease
+	for current != nil {
+		checklist, err := s.inventoryRepo.GetChecklist(ctx, current.ID, domain.ChecklistTypeMoveIn)
+		if err != nil {
+			return nil, fmt.Errorf("error getting move-in checklist: %w", err)
+		}
+		if checklist != nil {
+			return checklist, nil
+		}
+		if current.ParentLeaseID == nil {
+			break
+		}
+
+		current, err = s.leaseRepo.GetByID(ctx, *current.ParentLeaseID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting parent lease: %w", err)
+		}
+	}
+
+	return nil, ErrMoveInChecklistNotFound
+}
+
+// getLease busca um contrato pelo ID
+func (s *InventoryService) getLease(ctx context.Context, leaseID uuid.UUID) (*domain.Lease, error) {
+	lease, err := s.leaseRepo.GetByID(ctx, leaseID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting lease: %w", err)
+	}
+	if lease == nil {
+		return nil, ErrLeaseNotFound
+	}
+	return lease, nil
+}
+
+// getUnitItem busca um item garantindo que pertence à unidade informada
+func (s *InventoryService) getUnitItem(ctx context.Context, unitID, itemID uuid.UUID) (*domain.UnitInventoryItem, error) {
+	item, err := s.inventoryRepo.GetItemByID(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting inventory item: %w", err)
+	}
+	if item == nil || item.UnitID != unitID {
+		return nil, ErrInventoryItemNotFound
+	}
+	return item, nil
+}
+
+// buildLeaseInventoryReport monta o relatório comparando entrada e saída (se houver)
+func buildLeaseInventoryReport(leaseID uuid.UUID, moveIn, moveOut *domain.InventoryChecklist) *LeaseInventoryReport {
+	report := &LeaseInventoryReport{
+		LeaseID:              leaseID,
+		MoveIn:               moveIn,
+		MoveOut:              moveOut,
+		Discrepancies:        []*domain.InventoryDiscrepancy{},
+		TotalReplacementCost: decimal.Zero,
+	}
+
+	if moveOut != nil {
+		report.Discrepancies = domain.CompareChecklists(moveIn, moveOut)
+		report.TotalReplacementCost = domain.TotalReplacementCost(report.Discrepancies)
+	}
+
+	return report
+}
+
+// applyInventoryItemDetails copia os dados opcionais da requisição para o item
+func applyInventoryItemDetails(item *domain.UnitInventoryItem, req InventoryItemRequest) {
+	item.Brand = req.Brand
+	item.SerialNumber = req.SerialNumber
+	item.PurchaseDate = req.PurchaseDate
+	item.PurchaseValue = req.PurchaseValue
+	item.Notes = req.Notes
+}
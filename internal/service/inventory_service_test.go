@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockInventoryRepo é um mock do repository de inventário
+type MockInventoryRepo struct {
+	mock.Mock
+}
+
+func (m *MockInventoryRepo) CreateItem(ctx context.Context, item *domain.UnitInventoryItem) error {
+	args := m.Called(ctx, item)
+	return args.Error(0)
+}
+
+func (m *MockInventoryRepo) GetItemByID(ctx context.Context, id uuid.UUID) (*domain.UnitInventoryItem, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UnitInventoryItem), args.Error(1)
+}
+
+func (m *MockInventoryRepo) ListItemsByUnitID(ctx context.Context, unitID uuid.UUID) ([]*domain.UnitInventoryItem, error) {
+	args := m.Called(ctx, unitID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.UnitInventoryItem), args.Error(1)
+}
+
+func (m *MockInventoryRepo) UpdateItem(ctx context.Context, item *domain.UnitInventoryItem) error {
+	args := m.Called(ctx, item)
+	return args.Error(0)
+}
+
+func (m *MockInventoryRepo) DeleteItem(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockInventoryRepo) CreateChecklist(ctx context.Context, checklist *domain.InventoryChecklist) error {
+	args := m.Called(ctx, checklist)
+	return args.Error(0)
+}
+
+func (m *MockInventoryRepo) GetChecklist(ctx context.Context, leaseID uuid.UUID, checklistType domain.ChecklistType) (*domain.InventoryChecklist, error) {
+	args := m.Called(ctx, leaseID, checklistType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.InventoryChecklist), args.Error(1)
+}
+
+func TestInventoryService_SnapshotMoveIn(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should copy current unit inventory into move-in checklist", func(t *testing.T) {
+		unitID := uuid.New()
+		lease := &domain.Lease{ID: uuid.New(), UnitID: unitID}
+		item, err := domain.NewUnitInventoryItem(unitID, "Geladeira", domain.InventoryItemTypeAppliance, domain.ItemConditionGood)
+		require.NoError(t, err)
+
+		mockInventoryRepo := new(MockInventoryRepo)
+		service := NewInventoryService(mockInventoryRepo, nil, nil)
+
+		mockInventoryRepo.On("GetChecklist", ctx, lease.ID, domain.ChecklistTypeMoveIn).Return(nil, nil)
+		mockInventoryRepo.On("ListItemsByUnitID", ctx, unitID).Return([]*domain.UnitInventoryItem{item}, nil)
+		mockInventoryRepo.On("CreateChecklist", ctx, mock.AnythingOfType("*domain.InventoryChecklist")).Return(nil)
+
+		checklist, err := service.SnapshotMoveIn(ctx, lease, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.ChecklistTypeMoveIn, checklist.Type)
+		require.Len(t, checklist.Items, 1)
+		assert.Equal(t, item.ID, *checklist.Items[0].ItemID)
+		mockInventoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("should not duplicate move-in checklist", func(t *testing.T) {
+		lease := &domain.Lease{ID: uuid.New(), UnitID: uuid.New()}
+		mockInventoryRepo := new(MockInventoryRepo)
+		service := NewInventoryService(mockInventoryRepo, nil, nil)
+
+		existing := domain.NewMoveInChecklist(lease.ID, lease.UnitID, nil, nil)
+		mockInventoryRepo.On("GetChecklist", ctx, lease.ID, domain.ChecklistTypeMoveIn).Return(existing, nil)
+
+		_, err := service.SnapshotMoveIn(ctx, lease, nil)
+
+		assert.ErrorIs(t, err, ErrChecklistAlreadyExists)
+		mockInventoryRepo.AssertNotCalled(t, "CreateChecklist", mock.Anything, mock.Anything)
+	})
+}
+
+func TestInventoryService_CreateMoveOutChecklist(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should use move-in checklist of the original lease after renewal", func(t *testing.T) {
+		unitID := uuid.New()
+		original := &domain.Lease{ID: uuid.New(), UnitID: unitID}
+		renewed := &domain.Lease{ID: uuid.New(), UnitID: unitID, ParentLeaseID: &original.ID}
+
+		tv, err := domain.NewUnitInventoryItem(unitID, "TV", domain.InventoryItemTypeElectronics, domain.ItemConditionGood)
+		require.NoError(t, err)
+		value := decimal.NewFromInt(1500)
+		tv.PurchaseValue = &value
+		moveIn := domain.NewMoveInChecklist(original.ID, unitID, []*domain.UnitInventoryItem{tv}, nil)
+
+		mockInventoryRepo := new(MockInventoryRepo)
+		mockLeaseRepo := new(MockLeaseRepo)
+		service := NewInventoryService(mockInventoryRepo, nil, mockLeaseRepo)
+
+		mockLeaseRepo.On("GetByID", ctx, renewed.ID).Return(renewed, nil)
+		mockLeaseRepo.On("GetByID", ctx, original.ID).Return(original, nil)
+		mockInventoryRepo.On("GetChecklist", ctx, renewed.ID, domain.ChecklistTypeMoveOut).Return(nil, nil)
+		mockInventoryRepo.On("GetChecklist", ctx, renewed.ID, domain.ChecklistTypeMoveIn).Return(nil, nil)
+		mockInventoryRepo.On("GetChecklist", ctx, original.ID, domain.ChecklistTypeMoveIn).Return(moveIn, nil)
+		mockInventoryRepo.On("CreateChecklist", ctx, mock.AnythingOfType("*domain.InventoryChecklist")).Return(nil)
+
+		report, err := service.CreateMoveOutChecklist(ctx, renewed.ID, MoveOutChecklistRequest{
+			Items: []domain.MoveOutItemCheck{{ItemID: tv.ID, Present: false, Condition: domain.ItemConditionGood}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, renewed.ID, report.MoveOut.LeaseID)
+		require.Len(t, report.Discrepancies, 1)
+		assert.Equal(t, domain.InventoryIssueMissing, report.Discrepancies[0].Issue)
+		assert.True(t, report.TotalReplacementCost.Equal(value))
+	})
+
+	t.Run("should fail without move-in checklist", func(t *testing.T) {
+		lease := &domain.Lease{ID: uuid.New(), UnitID: uuid.New()}
+		mockInventoryRepo := new(MockInventoryRepo)
+		mockLeaseRepo := new(MockLeaseRepo)
+		service := NewInventoryService(mockInventoryRepo, nil, mockLeaseRepo)
+
+		mockLeaseRepo.On("GetByID", ctx, lease.ID).Return(lease, nil)
+		mockInventoryRepo.On("GetChecklist", ctx, lease.ID, domain.ChecklistTypeMoveOut).Return(nil, nil)
+		mockInventoryRepo.On("GetChecklist", ctx, lease.ID, domain.ChecklistTypeMoveIn).Return(nil, nil)
+
+		_, err := service.CreateMoveOutChecklist(ctx, lease.ID, MoveOutChecklistRequest{})
+
+		assert.ErrorIs(t, err, ErrMoveInChecklistNotFound)
+	})
+}
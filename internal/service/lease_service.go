@@ -30,6 +30,9 @@ type LeaseService struct {
 	tenantRepo     repository.TenantRepository
 	paymentService *PaymentService
 	adjustmentRepo repository.LeaseRentAdjustmentRepository
+	historyRepo    repository.UnitStatusHistoryRepository
+	inventory      *InventoryService
+	events         EventPublisher
 }
 
 // NewLeaseService cria uma nova instância do serviço de contratos
@@ -39,6 +42,8 @@ func NewLeaseService(
 	tenantRepo repository.TenantRepository,
 	paymentService *PaymentService,
 	adjustmentRepo repository.LeaseRentAdjustmentRepository,
+	historyRepo repository.UnitStatusHistoryRepository,
+	inventory *InventoryService,
 ) *LeaseService {
 	return &LeaseService{
 		leaseRepo:      leaseRepo,
@@ -46,25 +51,37 @@ func NewLeaseService(
 		tenantRepo:     tenantRepo,
 		paymentService: paymentService,
 		adjustmentRepo: adjustmentRepo,
+		historyRepo:    historyRepo,
+		inventory:      inventory,
 	}
 }
 
+// SetEventPublisher habilita a publicação de eventos para integrações externas (webhooks)
+func (s *LeaseService) SetEventPublisher(events EventPublisher) {
+	s.events = events
+}
+
 // CreateLeaseRequest representa os dados necessários para criar um contrato
 type CreateLeaseRequest struct {
-	UnitID                  uuid.UUID       `json:"unit_id" validate:"required"`
-	TenantID                uuid.UUID       `json:"tenant_id" validate:"required"`
-	ContractSignedDate      time.Time       `json:"contract_signed_date" validate:"required"`
-	StartDate               time.Time       `json:"start_date" validate:"required"`
-	PaymentDueDay           int             `json:"payment_due_day" validate:"required,min=1,max=31"`
-	MonthlyRentValue        decimal.Decimal `json:"monthly_rent_value" validate:"required"`
-	PaintingFeeTotal        decimal.Decimal `json:"painting_fee_total" validate:"required"`
-	PaintingFeeInstallments int             `json:"painting_fee_installments" validate:"required,min=1,max=4"`
+	UnitID                  uuid.UUID                      `json:"unit_id" validate:"required"`
+	TenantID                uuid.UUID                      `json:"tenant_id" validate:"required"`
+	ContractSignedDate      time.Time                      `json:"contract_signed_date" validate:"required"`
+	StartDate               time.Time                      `json:"start_date" validate:"required"`
+	PaymentDueDay           int                            `json:"payment_due_day" validate:"required,min=1,max=31"`
+	MonthlyRentValue        decimal.Decimal                `json:"monthly_rent_value" validate:"required"`
+	PaintingFeeTotal        decimal.Decimal                `json:"painting_fee_total" validate:"required"`
+	PaintingFeeInstallments int                            `json:"painting_fee_installments" validate:"required,min=1,max=4"`
+	SecurityDeposit         decimal.Decimal                `json:"security_deposit"`
+	DepositHeldAt           *domain.DepositCustody         `json:"deposit_held_at,omitempty"`
+	DepositCorrectionIndex  *domain.DepositCorrectionIndex `json:"deposit_correction_index,omitempty"`
 }
 
 // CreateLeaseResponse representa o resultado da criação de um contrato com pagamentos
 type CreateLeaseResponse struct {
-	Lease    *domain.Lease     `json:"lease"`
-	Payments []*domain.Payment `json:"payments"`
+	Lease              *domain.Lease              `json:"lease"`
+	Payments           []*domain.Payment          `json:"payments"`
+	TenantPaymentScore *domain.TenantPaymentScore `json:"tenant_payment_score,omitempty"`
+	Warnings           []string                   `json:"warnings,omitempty"`
 }
 
 // CreateLease cria um novo contrato de locação com todas as validações de negócio
@@ -110,11 +127,17 @@ func (s *LeaseService) CreateLease(ctx context.Context, req CreateLeaseRequest)
 		return nil, ErrTenantAlreadyHasActiveLease
 	}
 
+	// Avaliar o histórico de pagamento do morador (apenas alerta, não bloqueia)
+	paymentScore, warnings := s.checkTenantPaymentScore(ctx, req.TenantID)
+
 	// 6. Criar o contrato usando o domain model
 	lease, err := domain.NewLease(req.UnitID, req.TenantID, req.ContractSignedDate, req.StartDate, req.PaymentDueDay, req.MonthlyRentValue, req.PaintingFeeTotal, req.PaintingFeeInstallments)
 	if err != nil {
 		return nil, fmt.Errorf("error creating lease: %w", err)
 	}
+	if err := lease.SetSecurityDeposit(req.SecurityDeposit, req.DepositHeldAt, req.DepositCorrectionIndex); err != nil {
+		return nil, fmt.Errorf("error setting security deposit: %w", err)
+	}
 
 	// 7. Persistir o contrato no banco
 	if err := s.leaseRepo.Create(ctx, lease); err != nil {
@@ -126,6 +149,15 @@ func (s *LeaseService) CreateLease(ctx context.Context, req CreateLeaseRequest)
 		// TODO: Rollback do lease criado (em um cenário ideal, seria uma transação)
 		return nil, fmt.Errorf("error updating unit status: %w", err)
 	}
+	recordUnitStatusChange(ctx, s.historyRepo, s.events, req.UnitID, domain.UnitStatusOccupied, domain.UnitStatusChangeReasonLeaseCreated, &lease.ID)
+
+	// Registrar o checklist de entrada com o inventário atual da unidade
+	if s.inventory != nil {
+		if _, err := s.inventory.SnapshotMoveIn(ctx, lease, nil); err != nil {
+			// Erro no checklist não deve impedir criação de contrato
+			fmt.Printf("Warning: failed to create move-in checklist for lease %s: %v\n", lease.ID, err)
+		}
+	}
 
 	// 9. Gerar pagamentos automaticamente se paymentService estiver disponível
 	var payments []*domain.Payment
@@ -157,11 +189,25 @@ func (s *LeaseService) CreateLease(ctx context.Context, req CreateLeaseRequest)
 		} else {
 			payments = append(payments, paintingFeePayments...)
 		}
+
+		// Gerar pagamento da caução, se o contrato exigir
+		if lease.HasSecurityDeposit() {
+			depositPayment, err := s.paymentService.GenerateSecurityDepositPayment(ctx, lease.ID)
+			if err != nil {
+				fmt.Printf("Warning: failed to generate security deposit payment: %v\n", err)
+			} else {
+				payments = append(payments, depositPayment)
+			}
+		}
 	}
 
+	publishEvent(ctx, s.events, domain.WebhookEventLeaseCreated, lease)
+
 	return &CreateLeaseResponse{
-		Lease:    lease,
-		Payments: payments,
+		Lease:              lease,
+		Payments:           payments,
+		TenantPaymentScore: paymentScore,
+		Warnings:           warnings,
 	}, nil
 }
 
@@ -214,6 +260,15 @@ func (s *LeaseService) ListLeasesByTenantID(ctx context.Context, tenantID uuid.U
 	return leases, nil
 }
 
+// ListLeasesByPropertyID lista contratos das unidades de um imóvel
+func (s *LeaseService) ListLeasesByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.Lease, error) {
+	leases, err := s.leaseRepo.ListByPropertyID(ctx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing leases by property: %w", err)
+	}
+	return leases, nil
+}
+
 // GetExpiringSoonLeases retorna contratos que expiram nos próximos 45 dias
 func (s *LeaseService) GetExpiringSoonLeases(ctx context.Context) ([]*domain.Lease, error) {
 	leases, err := s.leaseRepo.GetExpiringSoon(ctx)
@@ -254,6 +309,9 @@ func (s *LeaseService) CancelLease(ctx context.Context, id uuid.UUID) error {
 	if err := s.unitRepo.UpdateStatus(ctx, lease.UnitID, domain.UnitStatusAvailable); err != nil {
 		return fmt.Errorf("error updating unit status: %w", err)
 	}
+	recordUnitStatusChange(ctx, s.historyRepo, s.events, lease.UnitID, domain.UnitStatusAvailable, domain.UnitStatusChangeReasonLeaseCancelled, &lease.ID)
+
+	publishEvent(ctx, s.events, domain.WebhookEventLeaseCancelled, lease)
 
 	return nil
 }
@@ -301,6 +359,9 @@ func (s *LeaseService) CancelLeaseWithPayments(ctx context.Context, id uuid.UUID
 	if err := s.unitRepo.UpdateStatus(ctx, lease.UnitID, domain.UnitStatusAvailable); err != nil {
 		return fmt.Errorf("error updating unit status: %w", err)
 	}
+	recordUnitStatusChange(ctx, s.historyRepo, s.events, lease.UnitID, domain.UnitStatusAvailable, domain.UnitStatusChangeReasonLeaseCancelled, &lease.ID)
+
+	publishEvent(ctx, s.events, domain.WebhookEventLeaseCancelled, lease)
 
 	return nil
 }
@@ -387,6 +448,7 @@ func (s *LeaseService) MarkLeaseAsExpired(ctx context.Context, id uuid.UUID) err
 	if err := s.unitRepo.UpdateStatus(ctx, lease.UnitID, domain.UnitStatusAvailable); err != nil {
 		return fmt.Errorf("error updating unit status: %w", err)
 	}
+	recordUnitStatusChange(ctx, s.historyRepo, s.events, lease.UnitID, domain.UnitStatusAvailable, domain.UnitStatusChangeReasonLeaseExpired, &lease.ID)
 
 	return nil
 }
@@ -452,6 +514,9 @@ func (s *LeaseService) RenewLease(ctx context.Context, oldLeaseID uuid.UUID, req
 		return nil, ErrCannotRenewLease
 	}
 
+	// Avaliar o histórico de pagamento do morador (apenas alerta, não bloqueia)
+	paymentScore, warnings := s.checkTenantPaymentScore(ctx, oldLease.TenantID)
+
 	// 3. Buscar dados atualizados da unidade
 	unit, err := s.unitRepo.GetByID(ctx, oldLease.UnitID)
 	if err != nil {
@@ -500,6 +565,12 @@ func (s *LeaseService) RenewLease(ctx context.Context, oldLeaseID uuid.UUID, req
 	newLease.ParentLeaseID = &oldLeaseID
 	newLease.Generation = newGeneration
 
+	// A caução continua retida e acompanha o contrato renovado
+	newLease.SecurityDeposit = oldLease.SecurityDeposit
+	newLease.DepositReceivedDate = oldLease.DepositReceivedDate
+	newLease.DepositHeldAt = oldLease.DepositHeldAt
+	newLease.DepositCorrectionIndex = oldLease.DepositCorrectionIndex
+
 	// 5. Marcar contrato antigo como expirado
 	oldLease.MarkAsExpired()
 
@@ -536,12 +607,36 @@ func (s *LeaseService) RenewLease(ctx context.Context, oldLeaseID uuid.UUID, req
 		// O inquilino paga adiantado para que quando sair não precise pagar novamente
 	}
 
+	publishEvent(ctx, s.events, domain.WebhookEventLeaseRenewed, newLease)
+
 	return &CreateLeaseResponse{
-		Lease:    newLease,
-		Payments: payments,
+		Lease:              newLease,
+		Payments:           payments,
+		TenantPaymentScore: paymentScore,
+		Warnings:           warnings,
 	}, nil
 }
 
+// checkTenantPaymentScore calcula a pontuação do morador e gera alerta se estiver abaixo do limite
+// Falhas no cálculo não devem impedir a criação ou renovação do contrato
+func (s *LeaseService) checkTenantPaymentScore(ctx context.Context, tenantID uuid.UUID) (*domain.TenantPaymentScore, []string) {
+	if s.paymentService == nil {
+		return nil, nil
+	}
+
+	score, err := calculateTenantPaymentScore(ctx, s.leaseRepo, s.paymentService.paymentRepo, tenantID)
+	if err != nil {
+		fmt.Printf("Warning: failed to calculate payment score for tenant %s: %v\n", tenantID, err)
+		return nil, nil
+	}
+
+	if !score.IsBelowThreshold() {
+		return score, nil
+	}
+
+	return score, []string{lowPaymentScoreWarning(score)}
+}
+
 // RenewLeaseRequest representa os dados para renovação de contrato
 type RenewLeaseRequest struct {
 	PaintingFeeTotal        decimal.Decimal  `json:"painting_fee_total" validate:"required"`
@@ -50,6 +50,11 @@ func (m *MockLeaseRepo) ListByTenantID(ctx context.Context, tenantID uuid.UUID)
 	return args.Get(0).([]*domain.Lease), args.Error(1)
 }
 
+func (m *MockLeaseRepo) ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.Lease, error) {
+	args := m.Called(ctx, propertyID)
+	return args.Get(0).([]*domain.Lease), args.Error(1)
+}
+
 func (m *MockLeaseRepo) GetActiveByUnitID(ctx context.Context, unitID uuid.UUID) (*domain.Lease, error) {
 	args := m.Called(ctx, unitID)
 	if args.Get(0) == nil {
@@ -86,6 +91,11 @@ func (m *MockLeaseRepo) UpdatePaintingFeePaid(ctx context.Context, id uuid.UUID,
 	return args.Error(0)
 }
 
+func (m *MockLeaseRepo) UpdateDepositReceivedDate(ctx context.Context, id uuid.UUID, receivedDate time.Time) error {
+	args := m.Called(ctx, id, receivedDate)
+	return args.Error(0)
+}
+
 func (m *MockLeaseRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -127,12 +137,15 @@ func (m *MockUnitRepo) UpdateStatus(ctx context.Context, id uuid.UUID, status do
 func (m *MockUnitRepo) Create(ctx context.Context, unit *domain.Unit) error {
 	return nil
 }
-func (m *MockUnitRepo) GetByNumber(ctx context.Context, number string) (*domain.Unit, error) {
+func (m *MockUnitRepo) GetByNumber(ctx context.Context, propertyID uuid.UUID, number string) (*domain.Unit, error) {
 	return nil, nil
 }
 func (m *MockUnitRepo) List(ctx context.Context) ([]*domain.Unit, error) {
 	return nil, nil
 }
+func (m *MockUnitRepo) ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.Unit, error) {
+	return nil, nil
+}
 func (m *MockUnitRepo) ListByStatus(ctx context.Context, status domain.UnitStatus) ([]*domain.Unit, error) {
 	args := m.Called(ctx, status)
 	if args.Get(0) == nil {
@@ -194,6 +207,9 @@ func (m *MockTenantRepo) GetByCPF(ctx context.Context, cpf string) (*domain.Tena
 func (m *MockTenantRepo) List(ctx context.Context) ([]*domain.Tenant, error) {
 	return nil, nil
 }
+func (m *MockTenantRepo) ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.Tenant, error) {
+	return nil, nil
+}
 func (m *MockTenantRepo) Update(ctx context.Context, tenant *domain.Tenant) error {
 	return nil
 }
@@ -224,7 +240,7 @@ func createTestTenant(id uuid.UUID) *domain.Tenant {
 	return &domain.Tenant{
 		ID:        id,
 		FullName:  "João Silva",
-		CPF:       "123.456.789-00",
+		CPF:       "123.456.789-09",
 		Phone:     "(11) 98765-4321",
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
@@ -243,7 +259,7 @@ func TestCreateLease_Success(t *testing.T) {
 	mockUnitRepo := new(MockUnitRepo)
 	mockTenantRepo := new(MockTenantRepo)
 
-	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil)
+	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil, nil, nil)
 
 	unit := createTestUnit(unitID, domain.UnitStatusAvailable)
 	tenant := createTestTenant(tenantID)
@@ -294,7 +310,7 @@ func TestCreateLease_UnitNotAvailable(t *testing.T) {
 	mockUnitRepo := new(MockUnitRepo)
 	mockTenantRepo := new(MockTenantRepo)
 
-	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil)
+	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil, nil, nil)
 
 	// Unidade ocupada
 	unit := createTestUnit(unitID, domain.UnitStatusOccupied)
@@ -332,7 +348,7 @@ func TestCreateLease_UnitAlreadyHasActiveLease(t *testing.T) {
 	mockUnitRepo := new(MockUnitRepo)
 	mockTenantRepo := new(MockTenantRepo)
 
-	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil)
+	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil, nil, nil)
 
 	unit := createTestUnit(unitID, domain.UnitStatusAvailable)
 	existingLease, _ := domain.NewLease(
@@ -382,7 +398,7 @@ func TestCancelLease_Success(t *testing.T) {
 	mockUnitRepo := new(MockUnitRepo)
 	mockTenantRepo := new(MockTenantRepo)
 
-	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil)
+	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil, nil, nil)
 
 	lease, _ := domain.NewLease(
 		unitID,
@@ -419,7 +435,7 @@ func TestCancelLease_AlreadyExpired(t *testing.T) {
 	mockUnitRepo := new(MockUnitRepo)
 	mockTenantRepo := new(MockTenantRepo)
 
-	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil)
+	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil, nil, nil)
 
 	lease, _ := domain.NewLease(
 		uuid.New(),
@@ -454,7 +470,7 @@ func TestUpdatePaintingFeePaid_Success(t *testing.T) {
 	mockUnitRepo := new(MockUnitRepo)
 	mockTenantRepo := new(MockTenantRepo)
 
-	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil)
+	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil, nil, nil)
 
 	lease, _ := domain.NewLease(
 		uuid.New(),
@@ -493,7 +509,7 @@ func TestGetLeaseStats_Success(t *testing.T) {
 	mockUnitRepo := new(MockUnitRepo)
 	mockTenantRepo := new(MockTenantRepo)
 
-	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil)
+	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil, nil, nil)
 
 	mockLeaseRepo.On("Count", ctx).Return(int64(10), nil)
 	mockLeaseRepo.On("CountByStatus", ctx, domain.LeaseStatusActive).Return(int64(7), nil)
@@ -526,7 +542,7 @@ func TestRenewLease_Success(t *testing.T) {
 	mockUnitRepo := new(MockUnitRepo)
 	mockTenantRepo := new(MockTenantRepo)
 
-	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil)
+	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil, nil, nil)
 
 	// Contrato antigo que está expirando em breve
 	oldLease, _ := domain.NewLease(
@@ -583,7 +599,7 @@ func TestRenewLease_CannotRenewCancelled(t *testing.T) {
 	mockUnitRepo := new(MockUnitRepo)
 	mockTenantRepo := new(MockTenantRepo)
 
-	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil)
+	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, nil, nil, nil)
 
 	// Contrato cancelado
 	oldLease, _ := domain.NewLease(
@@ -631,7 +647,7 @@ func TestRenewLease_WithRentAdjustment(t *testing.T) {
 	mockTenantRepo := new(MockTenantRepo)
 	mockAdjustmentRepo := new(MockLeaseRentAdjustmentRepo)
 
-	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, mockAdjustmentRepo)
+	service := NewLeaseService(mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil, mockAdjustmentRepo, nil, nil)
 
 	// Contrato original (generation 1)
 	oldLease, _ := domain.NewLease(
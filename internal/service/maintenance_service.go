@@ -0,0 +1,365 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/shopspring/decimal"
+)
+
+// Service layer errors específicos de manutenção
+var (
+	ErrMaintenanceTicketNotFound = errors.New("maintenance ticket not found")
+)
+
+// MaintenanceService contém a lógica de negócio para chamados de manutenção
+type MaintenanceService struct {
+	ticketRepo  repository.MaintenanceTicketRepository
+	unitRepo    repository.UnitRepository
+	leaseRepo   repository.LeaseRepository
+	historyRepo repository.UnitStatusHistoryRepository
+	events      EventPublisher
+}
+
+// NewMaintenanceService cria uma nova instância do serviço de manutenção
+func NewMaintenanceService(
+	ticketRepo repository.MaintenanceTicketRepository,
+	unitRepo repository.UnitRepository,
+	leaseRepo repository.LeaseRepository,
+	historyRepo repository.UnitStatusHistoryRepository,
+) *MaintenanceService {
+	return &MaintenanceService{
+		ticketRepo:  ticketRepo,
+		unitRepo:    unitRepo,
+		leaseRepo:   leaseRepo,
+		historyRepo: historyRepo,
+	}
+}
+
+// SetEventPublisher habilita a publicação de eventos para integrações externas (webhooks)
+func (s *MaintenanceService) SetEventPublisher(events EventPublisher) {
+	s.events = events
+}
+
+// CreateMaintenanceTicketRequest representa os dados para abrir um chamado
+type CreateMaintenanceTicketRequest struct {
+	UnitID             uuid.UUID
+	Title              string
+	Description        string
+	Category           domain.MaintenanceCategory
+	Priority           domain.MaintenancePriority
+	RequiresVacancy    bool
+	LinkToCurrentLease bool
+	OpenedBy           *uuid.UUID
+}
+
+// CreateTicket abre um novo chamado de manutenção para uma unidade
+// Se o chamado exigir a desocupação, a unidade passa para o status maintenance
+func (s *MaintenanceService) CreateTicket(ctx context.Context, req CreateMaintenanceTicketRequest) (*domain.MaintenanceTicket, error) {
+	unit, err := s.unitRepo.GetByID(ctx, req.UnitID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting unit: %w", err)
+	}
+	if unit == nil {
+		return nil, ErrUnitNotFound
+	}
+
+	ticket, err := domain.NewMaintenanceTicket(req.UnitID, req.Title, req.Description, req.Category, req.Priority, req.RequiresVacancy, req.OpenedBy)
+	if err != nil {
+		return nil, fmt.Errorf("error creating maintenance ticket: %w", err)
+	}
+
+	// Vincular ao contrato/morador atual da unidade (opcional)
+	if req.LinkToCurrentLease {
+		lease, err := s.leaseRepo.GetActiveByUnitID(ctx, req.UnitID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting active lease by unit: %w", err)
+		}
+		ticket.LinkToLease(lease)
+	}
+
+	// Guardar o status anterior para restaurar ao final do chamado
+	if ticket.RequiresVacancy && unit.Status != domain.UnitStatusMaintenance {
+		previous := unit.Status
+		ticket.PreviousUnitStatus = &previous
+	}
+
+	if err := s.ticketRepo.Create(ctx, ticket); err != nil {
+		return nil, fmt.Errorf("error saving maintenance ticket: %w", err)
+	}
+
+	if ticket.RequiresVacancy && unit.Status != domain.UnitStatusMaintenance {
+		if err := s.unitRepo.UpdateStatus(ctx, unit.ID, domain.UnitStatusMaintenance); err != nil {
+			return nil, fmt.Errorf("error updating unit status: %w", err)
+		}
+		recordUnitStatusChange(ctx, s.historyRepo, s.events, unit.ID, domain.UnitStatusMaintenance, domain.UnitStatusChangeReasonMaintenanceStarted, &ticket.ID)
+	}
+
+	return ticket, nil
+}
+
+// GetTicketByID busca um chamado pelo ID
+func (s *MaintenanceService) GetTicketByID(ctx context.Context, id uuid.UUID) (*domain.MaintenanceTicket, error) {
+	ticket, err := s.ticketRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting maintenance ticket: %w", err)
+	}
+	if ticket == nil {
+		return nil, ErrMaintenanceTicketNotFound
+	}
+	return ticket, nil
+}
+
+// ListTickets retorna todos os chamados
+func (s *MaintenanceService) ListTickets(ctx context.Context) ([]*domain.MaintenanceTicket, error) {
+	tickets, err := s.ticketRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing maintenance tickets: %w", err)
+	}
+	return tickets, nil
+}
+
+// ListTicketsByUnit retorna os chamados de uma unidade
+func (s *MaintenanceService) ListTicketsByUnit(ctx context.Context, unitID uuid.UUID) ([]*domain.MaintenanceTicket, error) {
+	tickets, err := s.ticketRepo.ListByUnitID(ctx, unitID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing maintenance tickets by unit: %w", err)
+	}
+	return tickets, nil
+}
+
+// ListTicketsByProperty retorna os chamados das unidades de um imóvel
+func (s *MaintenanceService) ListTicketsByProperty(ctx context.Context, propertyID uuid.UUID) ([]*domain.MaintenanceTicket, error) {
+	tickets, err := s.ticketRepo.ListByPropertyID(ctx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing maintenance tickets by property: %w", err)
+	}
+	return tickets, nil
+}
+
+// ListTicketsByStatus retorna os chamados com determinado status
+func (s *MaintenanceService) ListTicketsByStatus(ctx context.Context, status domain.MaintenanceStatus) ([]*domain.MaintenanceTicket, error) {
+	tickets, err := s.ticketRepo.ListByStatus(ctx, status)
+	if err != nil {
+		return nil, fmt.Errorf("error listing maintenance tickets by status: %w", err)
+	}
+	return tickets, nil
+}
+
+// UpdateTicketDetails atualiza título, descrição, categoria e prioridade de um chamado
+func (s *MaintenanceService) UpdateTicketDetails(ctx context.Context, id uuid.UUID, title, description string, category domain.MaintenanceCategory, priority domain.MaintenancePriority) (*domain.MaintenanceTicket, error) {
+	ticket, err := s.GetTicketByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ticket.UpdateDetails(title, description, category, priority); err != nil {
+		return nil, fmt.Errorf("error updating maintenance ticket: %w", err)
+	}
+
+	if err := s.ticketRepo.Update(ctx, ticket); err != nil {
+		return nil, fmt.Errorf("error saving maintenance ticket: %w", err)
+	}
+
+	return ticket, nil
+}
+
+// AssignContractor atribui um prestador de serviço ao chamado
+func (s *MaintenanceService) AssignContractor(ctx context.Context, id uuid.UUID, contractor string, phone *string, estimatedCost *decimal.Decimal) (*domain.MaintenanceTicket, error) {
+	ticket, err := s.GetTicketByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ticket.AssignContractor(contractor, phone, estimatedCost); err != nil {
+		return nil, fmt.Errorf("error assigning contractor: %w", err)
+	}
+
+	if err := s.ticketRepo.Update(ctx, ticket); err != nil {
+		return nil, fmt.Errorf("error saving maintenance ticket: %w", err)
+	}
+
+	return ticket, nil
+}
+
+// StartTicket marca o chamado como em andamento
+// Ao reabrir um chamado resolvido que exige desocupação, a unidade volta para o status maintenance
+func (s *MaintenanceService) StartTicket(ctx context.Context, id uuid.UUID) (*domain.MaintenanceTicket, error) {
+	ticket, err := s.GetTicketByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	reopened := ticket.Status == domain.MaintenanceStatusResolved
+
+	if err := ticket.Start(); err != nil {
+		return nil, fmt.Errorf("error starting maintenance ticket: %w", err)
+	}
+
+	// Guardar o status atual da unidade para restaurar ao resolver novamente
+	var unit *domain.Unit
+	if reopened && ticket.RequiresVacancy {
+		unit, err = s.unitRepo.GetByID(ctx, ticket.UnitID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting unit: %w", err)
+		}
+		if unit == nil {
+			return nil, ErrUnitNotFound
+		}
+		if unit.Status != domain.UnitStatusMaintenance {
+			previous := unit.Status
+			ticket.PreviousUnitStatus = &previous
+		}
+	}
+
+	if err := s.ticketRepo.Update(ctx, ticket); err != nil {
+		return nil, fmt.Errorf("error saving maintenance ticket: %w", err)
+	}
+
+	if unit != nil && unit.Status != domain.UnitStatusMaintenance {
+		if err := s.unitRepo.UpdateStatus(ctx, unit.ID, domain.UnitStatusMaintenance); err != nil {
+			return nil, fmt.Errorf("error updating unit status: %w", err)
+		}
+		recordUnitStatusChange(ctx, s.historyRepo, s.events, unit.ID, domain.UnitStatusMaintenance, domain.UnitStatusChangeReasonMaintenanceStarted, &ticket.ID)
+	}
+
+	return ticket, nil
+}
+
+// ResolveTicket marca o chamado como resolvido e libera a unidade se necessário
+func (s *MaintenanceService) ResolveTicket(ctx context.Context, id uuid.UUID, actualCost *decimal.Decimal, notes *string) (*domain.MaintenanceTicket, error) {
+	ticket, err := s.GetTicketByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ticket.Resolve(actualCost, notes); err != nil {
+		return nil, fmt.Errorf("error resolving maintenance ticket: %w", err)
+	}
+
+	if err := s.ticketRepo.Update(ctx, ticket); err != nil {
+		return nil, fmt.Errorf("error saving maintenance ticket: %w", err)
+	}
+
+	if err := s.releaseUnit(ctx, ticket); err != nil {
+		return nil, err
+	}
+
+	return ticket, nil
+}
+
+// CloseTicket encerra definitivamente um chamado resolvido
+func (s *MaintenanceService) CloseTicket(ctx context.Context, id uuid.UUID) (*domain.MaintenanceTicket, error) {
+	ticket, err := s.GetTicketByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ticket.Close(); err != nil {
+		return nil, fmt.Errorf("error closing maintenance ticket: %w", err)
+	}
+
+	if err := s.ticketRepo.Update(ctx, ticket); err != nil {
+		return nil, fmt.Errorf("error saving maintenance ticket: %w", err)
+	}
+
+	return ticket, nil
+}
+
+// CancelTicket cancela um chamado e libera a unidade se necessário
+func (s *MaintenanceService) CancelTicket(ctx context.Context, id uuid.UUID, reason *string) (*domain.MaintenanceTicket, error) {
+	ticket, err := s.GetTicketByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ticket.Cancel(reason); err != nil {
+		return nil, fmt.Errorf("error cancelling maintenance ticket: %w", err)
+	}
+
+	if err := s.ticketRepo.Update(ctx, ticket); err != nil {
+		return nil, fmt.Errorf("error saving maintenance ticket: %w", err)
+	}
+
+	if err := s.releaseUnit(ctx, ticket); err != nil {
+		return nil, err
+	}
+
+	return ticket, nil
+}
+
+// AddPhoto anexa uma foto ao chamado
+func (s *MaintenanceService) AddPhoto(ctx context.Context, ticketID uuid.UUID, url string, caption *string, uploadedBy *uuid.UUID) (*domain.MaintenanceTicketPhoto, error) {
+	if _, err := s.GetTicketByID(ctx, ticketID); err != nil {
+		return nil, err
+	}
+
+	photo, err := domain.NewMaintenanceTicketPhoto(ticketID, url, caption, uploadedBy)
+	if err != nil {
+		return nil, fmt.Errorf("error creating photo: %w", err)
+	}
+
+	if err := s.ticketRepo.AddPhoto(ctx, photo); err != nil {
+		return nil, fmt.Errorf("error saving photo: %w", err)
+	}
+
+	return photo, nil
+}
+
+// ListPhotos retorna as fotos de um chamado
+func (s *MaintenanceService) ListPhotos(ctx context.Context, ticketID uuid.UUID) ([]*domain.MaintenanceTicketPhoto, error) {
+	photos, err := s.ticketRepo.ListPhotos(ctx, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing photos: %w", err)
+	}
+	return photos, nil
+}
+
+// releaseUnit devolve a unidade ao status anterior quando não há mais chamados exigindo desocupação
+func (s *MaintenanceService) releaseUnit(ctx context.Context, ticket *domain.MaintenanceTicket) error {
+	if !ticket.RequiresVacancy {
+		return nil
+	}
+
+	remaining, err := s.ticketRepo.CountActiveVacancyByUnitID(ctx, ticket.UnitID)
+	if err != nil {
+		return fmt.Errorf("error counting active maintenance tickets: %w", err)
+	}
+	if remaining > 0 {
+		return nil
+	}
+
+	unit, err := s.unitRepo.GetByID(ctx, ticket.UnitID)
+	if err != nil {
+		return fmt.Errorf("error getting unit: %w", err)
+	}
+	if unit == nil || unit.Status != domain.UnitStatusMaintenance {
+		// Status alterado manualmente durante o chamado: não sobrescrever
+		return nil
+	}
+
+	// Restaurar o status anterior; sem registro, decidir pelo contrato ativo
+	newStatus := domain.UnitStatusAvailable
+	if ticket.PreviousUnitStatus != nil {
+		newStatus = *ticket.PreviousUnitStatus
+	} else {
+		lease, err := s.leaseRepo.GetActiveByUnitID(ctx, ticket.UnitID)
+		if err != nil {
+			return fmt.Errorf("error getting active lease by unit: %w", err)
+		}
+		if lease != nil {
+			newStatus = domain.UnitStatusOccupied
+		}
+	}
+
+	if err := s.unitRepo.UpdateStatus(ctx, ticket.UnitID, newStatus); err != nil {
+		return fmt.Errorf("error updating unit status: %w", err)
+	}
+	recordUnitStatusChange(ctx, s.historyRepo, s.events, ticket.UnitID, newStatus, domain.UnitStatusChangeReasonMaintenanceFinished, &ticket.ID)
+
+	return nil
+}
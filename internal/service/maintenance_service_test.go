@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockMaintenanceTicketRepo é um mock do repository de chamados
+type MockMaintenanceTicketRepo struct {
+	mock.Mock
+}
+
+func (m *MockMaintenanceTicketRepo) Create(ctx context.Context, ticket *domain.MaintenanceTicket) error {
+	args := m.Called(ctx, ticket)
+	return args.Error(0)
+}
+
+func (m *MockMaintenanceTicketRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.MaintenanceTicket, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MaintenanceTicket), args.Error(1)
+}
+
+func (m *MockMaintenanceTicketRepo) List(ctx context.Context) ([]*domain.MaintenanceTicket, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MaintenanceTicket), args.Error(1)
+}
+
+func (m *MockMaintenanceTicketRepo) ListByUnitID(ctx context.Context, unitID uuid.UUID) ([]*domain.MaintenanceTicket, error) {
+	args := m.Called(ctx, unitID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MaintenanceTicket), args.Error(1)
+}
+
+func (m *MockMaintenanceTicketRepo) ListByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*domain.MaintenanceTicket, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MaintenanceTicket), args.Error(1)
+}
+
+func (m *MockMaintenanceTicketRepo) ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.MaintenanceTicket, error) {
+	args := m.Called(ctx, propertyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MaintenanceTicket), args.Error(1)
+}
+
+func (m *MockMaintenanceTicketRepo) ListByStatus(ctx context.Context, status domain.MaintenanceStatus) ([]*domain.MaintenanceTicket, error) {
+	args := m.Called(ctx, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MaintenanceTicket), args.Error(1)
+}
+
+func (m *MockMaintenanceTicketRepo) Update(ctx context.Context, ticket *domain.MaintenanceTicket) error {
+	args := m.Called(ctx, ticket)
+	return args.Error(0)
+}
+
+func (m *MockMaintenanceTicketRepo) CountActiveVacancyByUnitID(ctx context.Context, unitID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, unitID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockMaintenanceTicketRepo) AddPhoto(ctx context.Context, photo *domain.MaintenanceTicketPhoto) error {
+	args := m.Called(ctx, photo)
+	return args.Error(0)
+}
+
+func (m *MockMaintenanceTicketRepo) ListPhotos(ctx context.Context, ticketID uuid.UUID) ([]*domain.MaintenanceTicketPhoto, error) {
+	args := m.Called(ctx, ticketID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MaintenanceTicketPhoto), args.Error(1)
+}
+
+// TESTES
+
+func TestMaintenanceService_CreateTicket(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should move occupied unit to maintenance when vacancy is required", func(t *testing.T) {
+		unitID := uuid.New()
+		mockTicketRepo := new(MockMaintenanceTicketRepo)
+		mockUnitRepo := new(MockUnitRepository)
+		mockLeaseRepo := new(MockLeaseRepo)
+		service := NewMaintenanceService(mockTicketRepo, mockUnitRepo, mockLeaseRepo, nil)
+
+		lease := &domain.Lease{ID: uuid.New(), UnitID: unitID, TenantID: uuid.New()}
+
+		mockUnitRepo.On("GetByID", ctx, unitID).Return(createTestUnit(unitID, domain.UnitStatusOccupied), nil)
+		mockLeaseRepo.On("GetActiveByUnitID", ctx, unitID).Return(lease, nil)
+		mockTicketRepo.On("Create", ctx, mock.AnythingOfType("*domain.MaintenanceTicket")).Return(nil)
+		mockUnitRepo.On("UpdateStatus", ctx, unitID, domain.UnitStatusMaintenance).Return(nil)
+
+		ticket, err := service.CreateTicket(ctx, CreateMaintenanceTicketRequest{
+			UnitID:             unitID,
+			Title:              "Troca do piso",
+			Category:           domain.MaintenanceCategoryStructural,
+			Priority:           domain.MaintenancePriorityHigh,
+			RequiresVacancy:    true,
+			LinkToCurrentLease: true,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, ticket.PreviousUnitStatus)
+		assert.Equal(t, domain.UnitStatusOccupied, *ticket.PreviousUnitStatus)
+		assert.Equal(t, lease.ID, *ticket.LeaseID)
+		assert.Equal(t, lease.TenantID, *ticket.TenantID)
+		mockTicketRepo.AssertExpectations(t)
+		mockUnitRepo.AssertExpectations(t)
+		mockLeaseRepo.AssertExpectations(t)
+	})
+
+	t.Run("should keep unit status when vacancy is not required", func(t *testing.T) {
+		unitID := uuid.New()
+		mockTicketRepo := new(MockMaintenanceTicketRepo)
+		mockUnitRepo := new(MockUnitRepository)
+		service := NewMaintenanceService(mockTicketRepo, mockUnitRepo, nil, nil)
+
+		mockUnitRepo.On("GetByID", ctx, unitID).Return(createTestUnit(unitID, domain.UnitStatusOccupied), nil)
+		mockTicketRepo.On("Create", ctx, mock.AnythingOfType("*domain.MaintenanceTicket")).Return(nil)
+
+		ticket, err := service.CreateTicket(ctx, CreateMaintenanceTicketRequest{
+			UnitID:   unitID,
+			Title:    "Tomada solta",
+			Category: domain.MaintenanceCategoryElectrical,
+			Priority: domain.MaintenancePriorityLow,
+		})
+
+		require.NoError(t, err)
+		assert.Nil(t, ticket.PreviousUnitStatus)
+		mockUnitRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should fail when unit does not exist", func(t *testing.T) {
+		unitID := uuid.New()
+		mockUnitRepo := new(MockUnitRepository)
+		service := NewMaintenanceService(new(MockMaintenanceTicketRepo), mockUnitRepo, nil, nil)
+
+		mockUnitRepo.On("GetByID", ctx, unitID).Return(nil, nil)
+
+		ticket, err := service.CreateTicket(ctx, CreateMaintenanceTicketRequest{UnitID: unitID, Title: "Teste"})
+
+		assert.Nil(t, ticket)
+		assert.ErrorIs(t, err, ErrUnitNotFound)
+	})
+}
+
+func TestMaintenanceService_StartTicket(t *testing.T) {
+	ctx := context.Background()
+
+	newResolvedTicket := func(unitID uuid.UUID, requiresVacancy bool) *domain.MaintenanceTicket {
+		ticket, _ := domain.NewMaintenanceTicket(unitID, "Vazamento no banheiro", "", domain.MaintenanceCategoryPlumbing, domain.MaintenancePriorityHigh, requiresVacancy, nil)
+		_ = ticket.Start()
+		_ = ticket.Resolve(nil, nil)
+		return ticket
+	}
+
+	t.Run("should move unit back to maintenance when vacancy ticket is reopened", func(t *testing.T) {
+		unitID := uuid.New()
+		ticket := newResolvedTicket(unitID, true)
+		mockTicketRepo := new(MockMaintenanceTicketRepo)
+		mockUnitRepo := new(MockUnitRepository)
+		service := NewMaintenanceService(mockTicketRepo, mockUnitRepo, nil, nil)
+
+		mockTicketRepo.On("GetByID", ctx, ticket.ID).Return(ticket, nil)
+		mockUnitRepo.On("GetByID", ctx, unitID).Return(createTestUnit(unitID, domain.UnitStatusOccupied), nil)
+		mockTicketRepo.On("Update", ctx, ticket).Return(nil)
+		mockUnitRepo.On("UpdateStatus", ctx, unitID, domain.UnitStatusMaintenance).Return(nil)
+
+		result, err := service.StartTicket(ctx, ticket.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.MaintenanceStatusInProgress, result.Status)
+		require.NotNil(t, result.PreviousUnitStatus)
+		assert.Equal(t, domain.UnitStatusOccupied, *result.PreviousUnitStatus)
+		mockUnitRepo.AssertExpectations(t)
+	})
+
+	t.Run("should keep unit status when reopened ticket does not require vacancy", func(t *testing.T) {
+		unitID := uuid.New()
+		ticket := newResolvedTicket(unitID, false)
+		mockTicketRepo := new(MockMaintenanceTicketRepo)
+		mockUnitRepo := new(MockUnitRepository)
+		service := NewMaintenanceService(mockTicketRepo, mockUnitRepo, nil, nil)
+
+		mockTicketRepo.On("GetByID", ctx, ticket.ID).Return(ticket, nil)
+		mockTicketRepo.On("Update", ctx, ticket).Return(nil)
+
+		_, err := service.StartTicket(ctx, ticket.ID)
+
+		require.NoError(t, err)
+		mockUnitRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestMaintenanceService_ResolveTicket(t *testing.T) {
+	ctx := context.Background()
+
+	newVacancyTicket := func(unitID uuid.UUID, previous domain.UnitStatus) *domain.MaintenanceTicket {
+		ticket, _ := domain.NewMaintenanceTicket(unitID, "Dedetização", "", domain.MaintenanceCategoryPestControl, domain.MaintenancePriorityMedium, true, nil)
+		ticket.PreviousUnitStatus = &previous
+		return ticket
+	}
+
+	t.Run("should restore previous unit status when last vacancy ticket is resolved", func(t *testing.T) {
+		unitID := uuid.New()
+		ticket := newVacancyTicket(unitID, domain.UnitStatusOccupied)
+		mockTicketRepo := new(MockMaintenanceTicketRepo)
+		mockUnitRepo := new(MockUnitRepository)
+		service := NewMaintenanceService(mockTicketRepo, mockUnitRepo, nil, nil)
+
+		mockTicketRepo.On("GetByID", ctx, ticket.ID).Return(ticket, nil)
+		mockTicketRepo.On("Update", ctx, ticket).Return(nil)
+		mockTicketRepo.On("CountActiveVacancyByUnitID", ctx, unitID).Return(int64(0), nil)
+		mockUnitRepo.On("GetByID", ctx, unitID).Return(createTestUnit(unitID, domain.UnitStatusMaintenance), nil)
+		mockUnitRepo.On("UpdateStatus", ctx, unitID, domain.UnitStatusOccupied).Return(nil)
+
+		result, err := service.ResolveTicket(ctx, ticket.ID, nil, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.MaintenanceStatusResolved, result.Status)
+		mockUnitRepo.AssertExpectations(t)
+	})
+
+	t.Run("should keep unit in maintenance while other vacancy tickets are active", func(t *testing.T) {
+		unitID := uuid.New()
+		ticket := newVacancyTicket(unitID, domain.UnitStatusAvailable)
+		mockTicketRepo := new(MockMaintenanceTicketRepo)
+		mockUnitRepo := new(MockUnitRepository)
+		service := NewMaintenanceService(mockTicketRepo, mockUnitRepo, nil, nil)
+
+		mockTicketRepo.On("GetByID", ctx, ticket.ID).Return(ticket, nil)
+		mockTicketRepo.On("Update", ctx, ticket).Return(nil)
+		mockTicketRepo.On("CountActiveVacancyByUnitID", ctx, unitID).Return(int64(1), nil)
+
+		_, err := service.ResolveTicket(ctx, ticket.ID, nil, nil)
+
+		require.NoError(t, err)
+		mockUnitRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should fail when ticket does not exist", func(t *testing.T) {
+		id := uuid.New()
+		mockTicketRepo := new(MockMaintenanceTicketRepo)
+		service := NewMaintenanceService(mockTicketRepo, nil, nil, nil)
+
+		mockTicketRepo.On("GetByID", ctx, id).Return(nil, nil)
+
+		_, err := service.ResolveTicket(ctx, id, nil, nil)
+
+		assert.ErrorIs(t, err, ErrMaintenanceTicketNotFound)
+	})
+}
@@ -0,0 +1,763 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/notifier"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/pdf"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+)
+
+// Service layer errors específicos de notificações
+var (
+	ErrNoSenderForChannel      = errors.New("no sender configured for notification channel")
+	ErrNotificationNotFound    = errors.New("notification not found")
+	ErrNoNotificationRecipient = errors.New("tenant has no contact for the enabled channels")
+	ErrInvalidWebhookToken     = errors.New("invalid webhook token")
+)
+
+// notificationDispatchBatch limita quantas notificações são enviadas por execução
+const notificationDispatchBatch = 200
+
+// NotificationService contém a lógica de geração e envio da fila de notificações
+type NotificationService struct {
+	notificationRepo repository.NotificationRepository
+	paymentRepo      repository.PaymentRepository
+	leaseRepo        repository.LeaseRepository
+	tenantRepo       repository.TenantRepository
+	unitRepo         repository.UnitRepository
+	senders          map[domain.NotificationChannel]notifier.Sender
+	webhookToken     string
+}
+
+// NewNotificationService cria uma nova instância do serviço de notificações
+func NewNotificationService(
+	notificationRepo repository.NotificationRepository,
+	paymentRepo repository.PaymentRepository,
+	leaseRepo repository.LeaseRepository,
+	tenantRepo repository.TenantRepository,
+	unitRepo repository.UnitRepository,
+) *NotificationService {
+	return &NotificationService{
+		notificationRepo: notificationRepo,
+		paymentRepo:      paymentRepo,
+		leaseRepo:        leaseRepo,
+		tenantRepo:       tenantRepo,
+		unitRepo:         unitRepo,
+		senders:          make(map[domain.NotificationChannel]notifier.Sender),
+	}
+}
+
+// RegisterSender habilita um canal de envio
+// Notificações só são geradas para os canais registrados
+func (s *NotificationService) RegisterSender(channel domain.NotificationChannel, sender notifier.Sender) {
+	s.senders[channel] = sender
+}
+
+// ConfigureDeliveryWebhook define o token exigido nos webhooks de status de entrega
+// Sem token configurado, os webhooks são recusados
+func (s *NotificationService) ConfigureDeliveryWebhook(token string) {
+	s.webhookToken = token
+}
+
+// NotificationProcessResult representa o resultado do processamento diário
+type NotificationProcessResult struct {
+	ContractExpiringsCreated int       `json:"contract_expirings_created"`
+	ReceiptsCreated          int       `json:"receipts_created"`
+	Sent                     int       `json:"sent"`
+	Retrying                 int       `json:"retrying"`
+	Failed                   int       `json:"failed"`
+	Bounced                  int       `json:"bounced"`
+	Cancelled                int       `json:"cancelled"`
+	ProcessedAt              time.Time `json:"processed_at"`
+}
+
+// notificationDraft reúne os dados de uma notificação antes da escolha do canal
+type notificationDraft struct {
+	notificationType domain.NotificationType
+	template         notifier.Template
+	data             notifier.TemplateData
+	scheduledDate    time.Time
+	dedupKey         func(channel domain.NotificationChannel) string
+	lease            *domain.Lease
+	tenant           *domain.Tenant
+	paymentID        *uuid.UUID
+}
+
+// ListNotifications lista as notificações, opcionalmente filtradas por status
+func (s *NotificationService) ListNotifications(ctx context.Context, status *domain.NotificationStatus) ([]*domain.Notification, error) {
+	var (
+		notifications []*domain.Notification
+		err           error
+	)
+
+	if status != nil {
+		notifications, err = s.notificationRepo.ListByStatus(ctx, *status)
+	} else {
+		notifications, err = s.notificationRepo.List(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error listing notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// ListLeaseNotifications lista as notificações de um contrato
+func (s *NotificationService) ListLeaseNotifications(ctx context.Context, leaseID uuid.UUID) ([]*domain.Notification, error) {
+	lease, err := s.leaseRepo.GetByID(ctx, leaseID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching lease: %w", err)
+	}
+	if lease == nil {
+		return nil, ErrLeaseNotFound
+	}
+
+	notifications, err := s.notificationRepo.ListByLeaseID(ctx, leaseID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing lease notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// ProcessDailyNotifications gera os avisos do dia e envia a fila pendente
+// Lembretes e avisos de atraso são gerados pela régua de cobrança (DunningService)
+// Este método deve ser executado diariamente por um scheduler
+func (s *NotificationService) ProcessDailyNotifications(ctx context.Context) (*NotificationProcessResult, error) {
+	result := &NotificationProcessResult{ProcessedAt: time.Now()}
+
+	created, err := s.GenerateContractExpiringNotifications(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.ContractExpiringsCreated = created
+
+	created, err = s.GeneratePaymentReceipts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.ReceiptsCreated = created
+
+	if err := s.dispatchDue(ctx, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GenerateContractExpiringNotifications cria avisos para contratos que terminam nos próximos 45 dias
+func (s *NotificationService) GenerateContractExpiringNotifications(ctx context.Context) (int, error) {
+	active, err := s.leaseRepo.GetExpiringSoon(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error getting expiring soon leases: %w", err)
+	}
+	// O scheduler move os contratos para expiring_soon, que não são retornados por GetExpiringSoon
+	expiringSoon, err := s.leaseRepo.ListByStatus(ctx, domain.LeaseStatusExpiringSoon)
+	if err != nil {
+		return 0, fmt.Errorf("error listing expiring soon leases: %w", err)
+	}
+
+	today := startOfDay(time.Now())
+	seen := make(map[uuid.UUID]bool)
+	created := 0
+
+	for _, lease := range append(active, expiringSoon...) {
+		if seen[lease.ID] || !lease.IsExpiringSoon() {
+			continue
+		}
+		seen[lease.ID] = true
+
+		tenant, unit, err := s.loadLeaseParties(ctx, lease)
+		if err != nil {
+			fmt.Printf("Warning: skipping contract expiring notification for lease %s: %v\n", lease.ID, err)
+			continue
+		}
+
+		scheduledDate := lease.EndDate.AddDate(0, 0, -domain.ContractExpiringDaysBefore)
+		if scheduledDate.Before(today) {
+			scheduledDate = today
+		}
+
+		leaseID, endDate := lease.ID, lease.EndDate
+		notifications, err := s.enqueue(ctx, notificationDraft{
+			notificationType: domain.NotificationTypeContractExpiring,
+			template:         notifier.TemplateLeaseExpiring,
+			data: notifier.TemplateData{
+				TenantName: tenant.FullName,
+				UnitNumber: unit.Number,
+				EndDate:    lease.EndDate.Format("02/01/2006"),
+			},
+			scheduledDate: scheduledDate,
+			dedupKey: func(channel domain.NotificationChannel) string {
+				return domain.ContractExpiringDedupKey(leaseID, endDate, channel)
+			},
+			lease:  lease,
+			tenant: tenant,
+		})
+		created += len(notifications)
+		if err != nil {
+			return created, fmt.Errorf("error creating contract expiring notification: %w", err)
+		}
+	}
+
+	return created, nil
+}
+
+// EnqueueDunningNotice cria as mensagens de uma etapa da régua de cobrança para o pagamento
+// Etapas sem mensagem ao morador (análise de despejo) não geram notificações
+func (s *NotificationService) EnqueueDunningNotice(ctx context.Context, payment *domain.Payment, step *domain.DunningStep) ([]*domain.Notification, error) {
+	var (
+		notificationType domain.NotificationType
+		template         notifier.Template
+	)
+	switch step.Action {
+	case domain.DunningActionReminder:
+		notificationType, template = domain.NotificationTypeRentReminder, notifier.TemplateRentReminder
+	case domain.DunningActionNotice:
+		notificationType, template = domain.NotificationTypeOverdueNotice, notifier.TemplateOverdueNotice
+	case domain.DunningActionFormalNotice:
+		notificationType, template = domain.NotificationTypeFormalNotice, notifier.TemplateFormalNotice
+	default:
+		return nil, nil
+	}
+
+	lease, tenant, unit, err := s.loadPaymentParties(ctx, payment)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentID, stepCode := payment.ID, step.Code
+	notifications, err := s.enqueue(ctx, notificationDraft{
+		notificationType: notificationType,
+		template:         template,
+		data:             paymentTemplateData(tenant, unit, payment),
+		scheduledDate:    startOfDay(time.Now()),
+		dedupKey: func(channel domain.NotificationChannel) string {
+			return domain.DunningDedupKey(stepCode, paymentID, channel)
+		},
+		lease:     lease,
+		tenant:    tenant,
+		paymentID: &paymentID,
+	})
+	if err != nil {
+		return notifications, fmt.Errorf("error creating %s notification: %w", notificationType, err)
+	}
+
+	return notifications, nil
+}
+
+// GeneratePaymentReceipts cria recibos para os pagamentos quitados nos últimos dias
+func (s *NotificationService) GeneratePaymentReceipts(ctx context.Context) (int, error) {
+	since := startOfDay(time.Now()).AddDate(0, 0, -domain.PaymentReceiptLookbackDays)
+	payments, err := s.paymentRepo.ListPaidSince(ctx, since)
+	if err != nil {
+		return 0, fmt.Errorf("error listing paid payments: %w", err)
+	}
+
+	created := 0
+
+	for _, payment := range payments {
+		lease, tenant, unit, err := s.loadPaymentParties(ctx, payment)
+		if err != nil {
+			fmt.Printf("Warning: skipping receipt for payment %s: %v\n", payment.ID, err)
+			continue
+		}
+
+		notifications, err := s.enqueueReceipt(ctx, payment, lease, tenant, unit)
+		created += len(notifications)
+		if err != nil {
+			return created, err
+		}
+	}
+
+	return created, nil
+}
+
+// SendPaymentReceipt gera e envia imediatamente o recibo de um pagamento quitado
+// Recibos já gerados para o pagamento não são reenviados
+func (s *NotificationService) SendPaymentReceipt(ctx context.Context, paymentID uuid.UUID) ([]*domain.Notification, error) {
+	payment, err := s.paymentRepo.GetByID(ctx, paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching payment: %w", err)
+	}
+	if payment == nil {
+		return nil, ErrPaymentNotFound
+	}
+	if !payment.IsPaid() {
+		return nil, ErrPaymentNotPaidForReceipt
+	}
+
+	lease, tenant, unit, err := s.loadPaymentParties(ctx, payment)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications, err := s.enqueueReceipt(ctx, payment, lease, tenant, unit)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := &NotificationProcessResult{ProcessedAt: now}
+	for _, notification := range notifications {
+		if err := s.deliver(ctx, notification, now, result); err != nil {
+			return nil, err
+		}
+	}
+
+	// Retorna também os recibos gerados anteriormente para o pagamento
+	existing, err := s.notificationRepo.ListByLeaseID(ctx, payment.LeaseID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing lease notifications: %w", err)
+	}
+	receipts := make([]*domain.Notification, 0, len(existing))
+	for _, notification := range existing {
+		if notification.Type == domain.NotificationTypePaymentReceipt &&
+			notification.PaymentID != nil && *notification.PaymentID == payment.ID {
+			receipts = append(receipts, notification)
+		}
+	}
+	if len(receipts) == 0 {
+		return nil, ErrNoNotificationRecipient
+	}
+
+	return receipts, nil
+}
+
+// RecordBounce registra a devolução de uma mensagem informada pelo provedor
+func (s *NotificationService) RecordBounce(ctx context.Context, providerMessageID, reason string) (*domain.Notification, error) {
+	notification, err := s.notificationRepo.GetByProviderMessageID(ctx, providerMessageID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching notification: %w", err)
+	}
+	if notification == nil {
+		return nil, ErrNotificationNotFound
+	}
+
+	if err := notification.MarkAsBounced(reason, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.notificationRepo.UpdateDelivery(ctx, notification); err != nil {
+		return nil, fmt.Errorf("error updating notification delivery: %w", err)
+	}
+
+	return notification, nil
+}
+
+// RecordDeliveryStatus registra o status de entrega informado pelo webhook do provedor de mensagens
+// Status intermediários (na fila, enviado) são ignorados; falhas marcam a notificação como devolvida
+func (s *NotificationService) RecordDeliveryStatus(
+	ctx context.Context,
+	token, providerMessageID string,
+	status notifier.DeliveryStatus,
+	reason string,
+) (*domain.Notification, error) {
+	if s.webhookToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.webhookToken)) != 1 {
+		return nil, ErrInvalidWebhookToken
+	}
+
+	notification, err := s.notificationRepo.GetByProviderMessageID(ctx, providerMessageID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching notification: %w", err)
+	}
+	if notification == nil {
+		return nil, ErrNotificationNotFound
+	}
+
+	now := time.Now()
+	switch status {
+	case notifier.DeliveryStatusDelivered, notifier.DeliveryStatusRead:
+		if notification.Status == domain.NotificationStatusDelivered {
+			return notification, nil
+		}
+		err = notification.MarkAsDelivered(now)
+	case notifier.DeliveryStatusFailed, notifier.DeliveryStatusUndelivered:
+		if reason == "" {
+			reason = string(status)
+		}
+		err = notification.MarkAsBounced(reason, now)
+	default:
+		return notification, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.notificationRepo.UpdateDelivery(ctx, notification); err != nil {
+		return nil, fmt.Errorf("error updating notification delivery: %w", err)
+	}
+
+	return notification, nil
+}
+
+// enqueueReceipt cria o recibo de um pagamento em cada canal habilitado
+func (s *NotificationService) enqueueReceipt(
+	ctx context.Context,
+	payment *domain.Payment,
+	lease *domain.Lease,
+	tenant *domain.Tenant,
+	unit *domain.Unit,
+) ([]*domain.Notification, error) {
+	paymentID := payment.ID
+	notifications, err := s.enqueue(ctx, notificationDraft{
+		notificationType: domain.NotificationTypePaymentReceipt,
+		template:         notifier.TemplatePaymentReceipt,
+		data:             paymentTemplateData(tenant, unit, payment),
+		scheduledDate:    startOfDay(time.Now()),
+		dedupKey: func(channel domain.NotificationChannel) string {
+			return domain.PaymentReceiptDedupKey(paymentID, channel)
+		},
+		lease:     lease,
+		tenant:    tenant,
+		paymentID: &paymentID,
+	})
+	if err != nil {
+		return notifications, fmt.Errorf("error creating payment receipt: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// enqueue renderiza o modelo e cria a notificação em cada canal habilitado com destinatário
+// Retorna apenas as notificações efetivamente criadas (duplicadas são ignoradas)
+func (s *NotificationService) enqueue(ctx context.Context, draft notificationDraft) ([]*domain.Notification, error) {
+	msg, err := notifier.Render(draft.template, draft.data)
+	if err != nil {
+		return nil, err
+	}
+	shortMessage, err := notifier.RenderShort(draft.template, draft.data)
+	if err != nil {
+		return nil, err
+	}
+
+	var created []*domain.Notification
+	for _, channel := range s.enabledChannels() {
+		recipient, ok := notificationRecipient(draft.tenant, channel)
+		if !ok {
+			continue
+		}
+
+		body := msg.Body
+		if isMessagingChannel(channel) {
+			body = shortMessage
+		}
+
+		notification, err := domain.NewNotification(
+			draft.notificationType, channel, recipient, msg.Subject, body,
+			draft.scheduledDate, draft.dedupKey(channel),
+		)
+		if err != nil {
+			fmt.Printf("Warning: invalid %s notification for tenant %s: %v\n", draft.notificationType, draft.tenant.ID, err)
+			continue
+		}
+		if channel == domain.NotificationChannelEmail {
+			html := msg.HTMLBody
+			notification.HTMLMessage = &html
+		}
+		notification.LeaseID = &draft.lease.ID
+		notification.TenantID = &draft.tenant.ID
+		notification.PaymentID = draft.paymentID
+
+		ok, err = s.notificationRepo.Create(ctx, notification)
+		if err != nil {
+			return created, err
+		}
+		if ok {
+			created = append(created, notification)
+		}
+	}
+
+	return created, nil
+}
+
+// DispatchDueNotifications envia as notificações pendentes cuja data e próxima tentativa já chegaram
+func (s *NotificationService) DispatchDueNotifications(ctx context.Context) (*NotificationProcessResult, error) {
+	result := &NotificationProcessResult{ProcessedAt: time.Now()}
+	if err := s.dispatchDue(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// dispatchDue envia a fila pendente e acumula os contadores no resultado
+func (s *NotificationService) dispatchDue(ctx context.Context, result *NotificationProcessResult) error {
+	now := time.Now()
+
+	notifications, err := s.notificationRepo.ListDue(ctx, now, notificationDispatchBatch)
+	if err != nil {
+		return fmt.Errorf("error listing due notifications: %w", err)
+	}
+
+	for _, notification := range notifications {
+		if !notification.IsReadyToSend(now) {
+			continue
+		}
+
+		if err := s.deliver(ctx, notification, now, result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deliver envia uma notificação e grava o resultado da tentativa
+// Destinatários recusados pelo provedor são marcados como devolvidos, sem novas tentativas
+func (s *NotificationService) deliver(ctx context.Context, notification *domain.Notification, now time.Time, result *NotificationProcessResult) error {
+	// O morador pode ter pedido para não receber mensagens, ou quitado o pagamento, depois que a notificação foi gerada
+	cancel, err := s.shouldCancel(ctx, notification)
+	if err != nil {
+		return err
+	}
+	if cancel {
+		_ = notification.Cancel()
+		result.Cancelled++
+		if err := s.notificationRepo.UpdateDelivery(ctx, notification); err != nil {
+			return fmt.Errorf("error updating notification delivery: %w", err)
+		}
+		return nil
+	}
+
+	messageID, sendErr := s.send(ctx, notification)
+	switch {
+	case sendErr == nil:
+		_ = notification.MarkAsSent(messageID, now)
+		result.Sent++
+	case errors.Is(sendErr, notifier.ErrRecipientRejected):
+		_ = notification.MarkAsBounced(sendErr.Error(), now)
+		result.Bounced++
+	default:
+		_ = notification.RegisterFailure(sendErr.Error(), now)
+		if notification.Status == domain.NotificationStatusFailed {
+			result.Failed++
+		} else {
+			result.Retrying++
+		}
+	}
+
+	if err := s.notificationRepo.UpdateDelivery(ctx, notification); err != nil {
+		return fmt.Errorf("error updating notification delivery: %w", err)
+	}
+	return nil
+}
+
+// shouldCancel verifica se a notificação deixou de fazer sentido antes do envio:
+// WhatsApp/SMS para um morador que não deseja recebê-las, ou cobrança de um pagamento já quitado ou cancelado
+func (s *NotificationService) shouldCancel(ctx context.Context, notification *domain.Notification) (bool, error) {
+	if isMessagingChannel(notification.Channel) && notification.TenantID != nil {
+		tenant, err := s.tenantRepo.GetByID(ctx, *notification.TenantID)
+		if err != nil {
+			return false, fmt.Errorf("error fetching tenant: %w", err)
+		}
+		if tenant != nil && tenant.HasMessagingOptOut() {
+			return true, nil
+		}
+	}
+
+	if isCollectionNotice(notification.Type) && notification.PaymentID != nil {
+		payment, err := s.paymentRepo.GetByID(ctx, *notification.PaymentID)
+		if err != nil {
+			return false, fmt.Errorf("error fetching payment: %w", err)
+		}
+		if payment != nil && !payment.CanBePaid() {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// send entrega a notificação pelo canal configurado e retorna o identificador do provedor
+func (s *NotificationService) send(ctx context.Context, notification *domain.Notification) (string, error) {
+	sender, ok := s.senders[notification.Channel]
+	if !ok {
+		return "", ErrNoSenderForChannel
+	}
+
+	msg := notifier.Message{
+		Recipient: notification.Recipient,
+		Subject:   notification.Subject,
+		Body:      notification.Message,
+	}
+	if notification.HTMLMessage != nil {
+		msg.HTMLBody = *notification.HTMLMessage
+	}
+
+	// O recibo por e-mail segue com o PDF anexado
+	if notification.Type == domain.NotificationTypePaymentReceipt &&
+		notification.Channel == domain.NotificationChannelEmail && notification.PaymentID != nil {
+		attachment, err := s.receiptAttachment(ctx, *notification.PaymentID)
+		if err != nil {
+			return "", err
+		}
+		msg.Attachments = append(msg.Attachments, *attachment)
+	}
+
+	return sender.Send(ctx, msg)
+}
+
+// receiptAttachment gera o PDF do recibo do pagamento
+func (s *NotificationService) receiptAttachment(ctx context.Context, paymentID uuid.UUID) (*notifier.Attachment, error) {
+	payment, err := s.paymentRepo.GetByID(ctx, paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching payment: %w", err)
+	}
+	if payment == nil {
+		return nil, ErrPaymentNotFound
+	}
+
+	_, tenant, unit, err := s.loadPaymentParties(ctx, payment)
+	if err != nil {
+		return nil, err
+	}
+
+	data := paymentTemplateData(tenant, unit, payment)
+	content, err := pdf.RenderReceipt(pdf.ReceiptData{
+		Number:         payment.ID.String(),
+		TenantName:     data.TenantName,
+		TenantCPF:      tenant.CPF,
+		UnitNumber:     data.UnitNumber,
+		PaymentType:    data.PaymentType,
+		ReferenceMonth: data.ReferenceMonth,
+		DueDate:        data.DueDate,
+		PaymentDate:    data.PaymentDate,
+		PaymentMethod:  data.PaymentMethod,
+		Amount:         data.Amount,
+		IssuedAt:       time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error rendering receipt: %w", err)
+	}
+
+	return &notifier.Attachment{
+		Filename:    fmt.Sprintf("recibo-%s.pdf", payment.ReferenceMonth.Format("2006-01")),
+		ContentType: "application/pdf",
+		Content:     content,
+	}, nil
+}
+
+// enabledChannels retorna os canais registrados na ordem de preferência
+func (s *NotificationService) enabledChannels() []domain.NotificationChannel {
+	channels := make([]domain.NotificationChannel, 0, len(s.senders))
+	for _, channel := range domain.ValidNotificationChannels {
+		if _, ok := s.senders[channel]; ok {
+			channels = append(channels, channel)
+		}
+	}
+	return channels
+}
+
+// loadLeaseParties busca morador e unidade do contrato usados para montar a mensagem
+func (s *NotificationService) loadLeaseParties(ctx context.Context, lease *domain.Lease) (*domain.Tenant, *domain.Unit, error) {
+	tenant, err := s.tenantRepo.GetByID(ctx, lease.TenantID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error fetching tenant: %w", err)
+	}
+	if tenant == nil {
+		return nil, nil, ErrTenantNotFound
+	}
+
+	unit, err := s.unitRepo.GetByID(ctx, lease.UnitID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error fetching unit: %w", err)
+	}
+	if unit == nil {
+		return nil, nil, ErrUnitNotFound
+	}
+
+	return tenant, unit, nil
+}
+
+// loadPaymentParties busca contrato, morador e unidade de um pagamento
+func (s *NotificationService) loadPaymentParties(ctx context.Context, payment *domain.Payment) (*domain.Lease, *domain.Tenant, *domain.Unit, error) {
+	lease, err := s.leaseRepo.GetByID(ctx, payment.LeaseID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error fetching lease: %w", err)
+	}
+	if lease == nil {
+		return nil, nil, nil, ErrLeaseNotFoundForPayment
+	}
+
+	tenant, unit, err := s.loadLeaseParties(ctx, lease)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return lease, tenant, unit, nil
+}
+
+// paymentTemplateData formata os dados do pagamento usados nos modelos de mensagem
+func paymentTemplateData(tenant *domain.Tenant, unit *domain.Unit, payment *domain.Payment) notifier.TemplateData {
+	data := notifier.TemplateData{
+		TenantName:     tenant.FullName,
+		UnitNumber:     unit.Number,
+		PaymentType:    payment.PaymentType.Label(),
+		Amount:         domain.FormatBRL(payment.Amount),
+		ReferenceMonth: payment.ReferenceMonth.Format("01/2006"),
+		DueDate:        payment.DueDate.Format("02/01/2006"),
+		DaysOverdue:    payment.DaysOverdue(),
+	}
+	if payment.PaymentDate != nil {
+		data.PaymentDate = payment.PaymentDate.Format("02/01/2006")
+	}
+	if payment.PaymentMethod != nil {
+		data.PaymentMethod = payment.PaymentMethod.Label()
+	}
+	return data
+}
+
+// notificationRecipient retorna o destinatário do morador no canal informado
+func notificationRecipient(tenant *domain.Tenant, channel domain.NotificationChannel) (string, bool) {
+	if tenant.IsAnonymized() {
+		return "", false
+	}
+
+	switch channel {
+	case domain.NotificationChannelEmail:
+		if tenant.Email == "" {
+			return "", false
+		}
+		return tenant.Email, true
+	case domain.NotificationChannelWhatsApp, domain.NotificationChannelSMS:
+		if tenant.HasMessagingOptOut() {
+			return "", false
+		}
+		phone, err := tenant.PhoneE164()
+		if err != nil {
+			return "", false
+		}
+		return phone, true
+	default:
+		if tenant.Phone == "" {
+			return "", false
+		}
+		return tenant.Phone, true
+	}
+}
+
+// isCollectionNotice indica se a notificação cobra um pagamento em aberto (mensagens da régua de cobrança)
+func isCollectionNotice(notificationType domain.NotificationType) bool {
+	switch notificationType {
+	case domain.NotificationTypeRentReminder, domain.NotificationTypeOverdueNotice, domain.NotificationTypeFormalNotice:
+		return true
+	}
+	return false
+}
+
+// isMessagingChannel indica se o canal é de mensagens curtas (WhatsApp ou SMS)
+func isMessagingChannel(channel domain.NotificationChannel) bool {
+	return channel == domain.NotificationChannelWhatsApp || channel == domain.NotificationChannelSMS
+}
+
+// startOfDay retorna a data informada à meia-noite (UTC)
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,595 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/notifier"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockNotificationRepo é um mock do repository de notificações
+type MockNotificationRepo struct {
+	mock.Mock
+}
+
+func (m *MockNotificationRepo) Create(ctx context.Context, notification *domain.Notification) (bool, error) {
+	args := m.Called(ctx, notification)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockNotificationRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.Notification, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepo) GetByProviderMessageID(ctx context.Context, messageID string) (*domain.Notification, error) {
+	args := m.Called(ctx, messageID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepo) List(ctx context.Context) ([]*domain.Notification, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*domain.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepo) ListByStatus(ctx context.Context, status domain.NotificationStatus) ([]*domain.Notification, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).([]*domain.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepo) ListByLeaseID(ctx context.Context, leaseID uuid.UUID) ([]*domain.Notification, error) {
+	args := m.Called(ctx, leaseID)
+	return args.Get(0).([]*domain.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepo) ListByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*domain.Notification, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Get(0).([]*domain.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepo) ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.Notification, error) {
+	args := m.Called(ctx, now, limit)
+	return args.Get(0).([]*domain.Notification), args.Error(1)
+}
+
+func (m *MockNotificationRepo) UpdateDelivery(ctx context.Context, notification *domain.Notification) error {
+	args := m.Called(ctx, notification)
+	return args.Error(0)
+}
+
+// MockSender é um mock de um canal de envio
+type MockSender struct {
+	mock.Mock
+}
+
+func (m *MockSender) Send(ctx context.Context, msg notifier.Message) (string, error) {
+	args := m.Called(ctx, msg)
+	return args.String(0), args.Error(1)
+}
+
+type notificationServiceMocks struct {
+	notificationRepo *MockNotificationRepo
+	paymentRepo      *MockPaymentRepo
+	leaseRepo        *MockLeaseRepo
+	tenantRepo       *MockTenantRepo
+	unitRepo         *MockUnitRepo
+}
+
+func newTestNotificationService() (*NotificationService, *notificationServiceMocks) {
+	mocks := &notificationServiceMocks{
+		notificationRepo: new(MockNotificationRepo),
+		paymentRepo:      new(MockPaymentRepo),
+		leaseRepo:        new(MockLeaseRepo),
+		tenantRepo:       new(MockTenantRepo),
+		unitRepo:         new(MockUnitRepo),
+	}
+	service := NewNotificationService(mocks.notificationRepo, mocks.paymentRepo, mocks.leaseRepo, mocks.tenantRepo, mocks.unitRepo)
+	return service, mocks
+}
+
+func TestNotificationService_EnqueueDunningNotice(t *testing.T) {
+	ctx := context.Background()
+	reminder := newTestDunningStep(t, "friendly_reminder", -3, domain.DunningActionReminder)
+
+	t.Run("should create one reminder per enabled channel with recipient", func(t *testing.T) {
+		service, mocks := newTestNotificationService()
+		service.RegisterSender(domain.NotificationChannelInternal, new(MockSender))
+		service.RegisterSender(domain.NotificationChannelEmail, new(MockSender))
+
+		lease := createTestLease()
+		tenant := createTestTenant(lease.TenantID) // sem e-mail
+		unit := createTestUnit(lease.UnitID, domain.UnitStatusOccupied)
+		rent := &domain.Payment{ID: uuid.New(), LeaseID: lease.ID, PaymentType: domain.PaymentTypeRent, Amount: decimal.NewFromInt(800), DueDate: startOfDay(time.Now()).AddDate(0, 0, 3)}
+
+		mocks.leaseRepo.On("GetByID", ctx, lease.ID).Return(lease, nil)
+		mocks.tenantRepo.On("GetByID", ctx, tenant.ID).Return(tenant, nil)
+		mocks.unitRepo.On("GetByID", ctx, unit.ID).Return(unit, nil)
+		mocks.notificationRepo.On("Create", ctx, mock.MatchedBy(func(n *domain.Notification) bool {
+			return n.Type == domain.NotificationTypeRentReminder &&
+				n.Channel == domain.NotificationChannelInternal &&
+				n.Recipient == tenant.Phone &&
+				*n.PaymentID == rent.ID &&
+				n.ScheduledDate.Equal(startOfDay(time.Now())) &&
+				n.DedupKey == domain.DunningDedupKey("friendly_reminder", rent.ID, domain.NotificationChannelInternal)
+		})).Return(true, nil).Once()
+
+		created, err := service.EnqueueDunningNotice(ctx, rent, reminder)
+
+		require.NoError(t, err)
+		assert.Len(t, created, 1)
+		mocks.notificationRepo.AssertExpectations(t)
+	})
+
+	t.Run("should send short message to E.164 phone unless tenant opted out", func(t *testing.T) {
+		service, mocks := newTestNotificationService()
+		service.RegisterSender(domain.NotificationChannelWhatsApp, new(MockSender))
+
+		lease := createTestLease()
+		tenant := createTestTenant(lease.TenantID)
+		optedOutLease := createTestLease()
+		optedOut := createTestTenant(optedOutLease.TenantID)
+		require.NoError(t, optedOut.SetMessagingOptOut(true))
+		dueDate := startOfDay(time.Now()).AddDate(0, 0, 3)
+		rent := &domain.Payment{ID: uuid.New(), LeaseID: lease.ID, PaymentType: domain.PaymentTypeRent, Amount: decimal.NewFromInt(800), DueDate: dueDate}
+		optedOutRent := &domain.Payment{ID: uuid.New(), LeaseID: optedOutLease.ID, PaymentType: domain.PaymentTypeRent, Amount: decimal.NewFromInt(800), DueDate: dueDate}
+
+		for _, l := range []*domain.Lease{lease, optedOutLease} {
+			mocks.leaseRepo.On("GetByID", ctx, l.ID).Return(l, nil)
+			mocks.unitRepo.On("GetByID", ctx, l.UnitID).Return(createTestUnit(l.UnitID, domain.UnitStatusOccupied), nil)
+		}
+		mocks.tenantRepo.On("GetByID", ctx, tenant.ID).Return(tenant, nil)
+		mocks.tenantRepo.On("GetByID", ctx, optedOut.ID).Return(optedOut, nil)
+		mocks.notificationRepo.On("Create", ctx, mock.MatchedBy(func(n *domain.Notification) bool {
+			return n.Channel == domain.NotificationChannelWhatsApp &&
+				n.Recipient == "+5511987654321" &&
+				*n.PaymentID == rent.ID &&
+				!strings.Contains(n.Message, "\n")
+		})).Return(true, nil).Once()
+
+		created, err := service.EnqueueDunningNotice(ctx, rent, reminder)
+		require.NoError(t, err)
+		assert.Len(t, created, 1)
+
+		created, err = service.EnqueueDunningNotice(ctx, optedOutRent, reminder)
+		require.NoError(t, err)
+		assert.Empty(t, created)
+		mocks.notificationRepo.AssertExpectations(t)
+	})
+
+	t.Run("should not count duplicates", func(t *testing.T) {
+		service, mocks := newTestNotificationService()
+		service.RegisterSender(domain.NotificationChannelInternal, new(MockSender))
+
+		lease := createTestLease()
+		rent := &domain.Payment{ID: uuid.New(), LeaseID: lease.ID, PaymentType: domain.PaymentTypeRent, Amount: decimal.NewFromInt(800), DueDate: time.Now().AddDate(0, 0, 2)}
+
+		mocks.leaseRepo.On("GetByID", ctx, lease.ID).Return(lease, nil)
+		mocks.tenantRepo.On("GetByID", ctx, lease.TenantID).Return(createTestTenant(lease.TenantID), nil)
+		mocks.unitRepo.On("GetByID", ctx, lease.UnitID).Return(createTestUnit(lease.UnitID, domain.UnitStatusOccupied), nil)
+		mocks.notificationRepo.On("Create", ctx, mock.Anything).Return(false, nil)
+
+		created, err := service.EnqueueDunningNotice(ctx, rent, reminder)
+
+		require.NoError(t, err)
+		assert.Empty(t, created)
+	})
+
+	t.Run("should create overdue notices with HTML email", func(t *testing.T) {
+		service, mocks := newTestNotificationService()
+		service.RegisterSender(domain.NotificationChannelInternal, new(MockSender))
+		service.RegisterSender(domain.NotificationChannelEmail, new(MockSender))
+		notice := newTestDunningStep(t, "second_notice", 5, domain.DunningActionNotice)
+
+		lease := createTestLease()
+		tenant := createTestTenant(lease.TenantID)
+		tenant.Email = "joao@example.com"
+		overdue := &domain.Payment{
+			ID: uuid.New(), LeaseID: lease.ID, PaymentType: domain.PaymentTypeRent, Status: domain.PaymentStatusOverdue,
+			Amount: decimal.NewFromInt(850), ReferenceMonth: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			DueDate: startOfDay(time.Now()).AddDate(0, 0, -5),
+		}
+
+		mocks.leaseRepo.On("GetByID", ctx, lease.ID).Return(lease, nil)
+		mocks.tenantRepo.On("GetByID", ctx, tenant.ID).Return(tenant, nil)
+		mocks.unitRepo.On("GetByID", ctx, lease.UnitID).Return(createTestUnit(lease.UnitID, domain.UnitStatusOccupied), nil)
+		mocks.notificationRepo.On("Create", ctx, mock.MatchedBy(func(n *domain.Notification) bool {
+			return n.Type == domain.NotificationTypeOverdueNotice &&
+				n.Channel == domain.NotificationChannelInternal && n.HTMLMessage == nil &&
+				n.DedupKey == domain.DunningDedupKey("second_notice", overdue.ID, domain.NotificationChannelInternal)
+		})).Return(true, nil).Once()
+		mocks.notificationRepo.On("Create", ctx, mock.MatchedBy(func(n *domain.Notification) bool {
+			return n.Channel == domain.NotificationChannelEmail && n.Recipient == tenant.Email &&
+				n.HTMLMessage != nil && strings.Contains(n.Message, "R$ 850,00") &&
+				n.DedupKey == domain.DunningDedupKey("second_notice", overdue.ID, domain.NotificationChannelEmail)
+		})).Return(true, nil).Once()
+
+		created, err := service.EnqueueDunningNotice(ctx, overdue, notice)
+
+		require.NoError(t, err)
+		assert.Len(t, created, 2)
+		mocks.notificationRepo.AssertExpectations(t)
+	})
+
+	t.Run("should use formal notice template and skip eviction review", func(t *testing.T) {
+		service, mocks := newTestNotificationService()
+		service.RegisterSender(domain.NotificationChannelInternal, new(MockSender))
+
+		lease := createTestLease()
+		overdue := &domain.Payment{ID: uuid.New(), LeaseID: lease.ID, PaymentType: domain.PaymentTypeRent, Status: domain.PaymentStatusOverdue, Amount: decimal.NewFromInt(850), DueDate: time.Now().AddDate(0, 0, -15)}
+
+		mocks.leaseRepo.On("GetByID", ctx, lease.ID).Return(lease, nil)
+		mocks.tenantRepo.On("GetByID", ctx, lease.TenantID).Return(createTestTenant(lease.TenantID), nil)
+		mocks.unitRepo.On("GetByID", ctx, lease.UnitID).Return(createTestUnit(lease.UnitID, domain.UnitStatusOccupied), nil)
+		mocks.notificationRepo.On("Create", ctx, mock.MatchedBy(func(n *domain.Notification) bool {
+			return n.Type == domain.NotificationTypeFormalNotice && strings.Contains(n.Subject, "Notificação formal")
+		})).Return(true, nil).Once()
+
+		created, err := service.EnqueueDunningNotice(ctx, overdue, newTestDunningStep(t, "formal_notice", 15, domain.DunningActionFormalNotice))
+		require.NoError(t, err)
+		assert.Len(t, created, 1)
+
+		created, err = service.EnqueueDunningNotice(ctx, overdue, newTestDunningStep(t, "eviction_review", 30, domain.DunningActionEvictionReview))
+		require.NoError(t, err)
+		assert.Empty(t, created)
+		mocks.notificationRepo.AssertNumberOfCalls(t, "Create", 1)
+	})
+}
+
+func TestNotificationService_GenerateContractExpiringNotifications(t *testing.T) {
+	ctx := context.Background()
+	service, mocks := newTestNotificationService()
+	service.RegisterSender(domain.NotificationChannelInternal, new(MockSender))
+
+	lease := createTestLease()
+	lease.Status = domain.LeaseStatusExpiringSoon
+	lease.EndDate = startOfDay(time.Now()).AddDate(0, 0, 30)
+
+	mocks.leaseRepo.On("GetExpiringSoon", ctx).Return([]*domain.Lease{}, nil)
+	mocks.leaseRepo.On("ListByStatus", ctx, domain.LeaseStatusExpiringSoon).Return([]*domain.Lease{lease}, nil)
+	mocks.tenantRepo.On("GetByID", ctx, lease.TenantID).Return(createTestTenant(lease.TenantID), nil)
+	mocks.unitRepo.On("GetByID", ctx, lease.UnitID).Return(createTestUnit(lease.UnitID, domain.UnitStatusOccupied), nil)
+	mocks.notificationRepo.On("Create", ctx, mock.MatchedBy(func(n *domain.Notification) bool {
+		return n.Type == domain.NotificationTypeContractExpiring &&
+			n.DedupKey == domain.ContractExpiringDedupKey(lease.ID, lease.EndDate, domain.NotificationChannelInternal)
+	})).Return(true, nil).Once()
+
+	created, err := service.GenerateContractExpiringNotifications(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, created)
+	mocks.notificationRepo.AssertExpectations(t)
+}
+
+func TestNotificationService_DispatchDueNotifications(t *testing.T) {
+	ctx := context.Background()
+
+	newPending := func(channel domain.NotificationChannel) *domain.Notification {
+		notification, err := domain.NewNotification(
+			domain.NotificationTypeRentReminder, channel, "(11) 98765-4321", "Lembrete", "Mensagem",
+			time.Now(), domain.DunningDedupKey("friendly_reminder", uuid.New(), channel),
+		)
+		require.NoError(t, err)
+		return notification
+	}
+
+	t.Run("should send, retry and fail notifications", func(t *testing.T) {
+		service, mocks := newTestNotificationService()
+		sender := new(MockSender)
+		service.RegisterSender(domain.NotificationChannelInternal, sender)
+
+		delivered := newPending(domain.NotificationChannelInternal)
+		retrying := newPending(domain.NotificationChannelInternal)
+		retrying.Recipient = "(11) 90000-0000"
+		exhausted := newPending(domain.NotificationChannelInternal)
+		exhausted.Recipient = "(11) 91111-1111"
+		exhausted.Attempts = domain.NotificationMaxAttempts - 1
+		noSender := newPending(domain.NotificationChannelSMS)
+
+		mocks.notificationRepo.On("ListDue", ctx, mock.Anything, notificationDispatchBatch).
+			Return([]*domain.Notification{delivered, retrying, exhausted, noSender}, nil)
+		sender.On("Send", ctx, mock.MatchedBy(func(msg notifier.Message) bool { return msg.Recipient == delivered.Recipient })).Return("", nil)
+		sender.On("Send", ctx, mock.Anything).Return("", errors.New("gateway timeout"))
+		mocks.notificationRepo.On("UpdateDelivery", ctx, mock.Anything).Return(nil)
+
+		result, err := service.DispatchDueNotifications(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Sent)
+		assert.Equal(t, 2, result.Retrying)
+		assert.Equal(t, 1, result.Failed)
+
+		assert.Equal(t, domain.NotificationStatusSent, delivered.Status)
+		assert.Equal(t, domain.NotificationStatusPending, retrying.Status)
+		assert.NotNil(t, retrying.NextAttemptAt)
+		assert.Equal(t, "gateway timeout", *retrying.LastError)
+		assert.Equal(t, domain.NotificationStatusFailed, exhausted.Status)
+		assert.Equal(t, ErrNoSenderForChannel.Error(), *noSender.LastError)
+		mocks.notificationRepo.AssertNumberOfCalls(t, "UpdateDelivery", 4)
+	})
+
+	t.Run("should mark rejected recipients as bounced", func(t *testing.T) {
+		service, mocks := newTestNotificationService()
+		sender := new(MockSender)
+		service.RegisterSender(domain.NotificationChannelEmail, sender)
+
+		rejected := newPending(domain.NotificationChannelEmail)
+
+		mocks.notificationRepo.On("ListDue", ctx, mock.Anything, notificationDispatchBatch).Return([]*domain.Notification{rejected}, nil)
+		sender.On("Send", ctx, mock.Anything).Return("", fmt.Errorf("%w: RCPT TO 550 mailbox unavailable", notifier.ErrRecipientRejected))
+		mocks.notificationRepo.On("UpdateDelivery", ctx, rejected).Return(nil)
+
+		result, err := service.DispatchDueNotifications(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Bounced)
+		assert.Zero(t, result.Retrying)
+		assert.Equal(t, domain.NotificationStatusBounced, rejected.Status)
+		assert.Contains(t, *rejected.BounceReason, "550")
+	})
+}
+
+func TestNotificationService_DispatchCancelsOptedOutMessages(t *testing.T) {
+	ctx := context.Background()
+	service, mocks := newTestNotificationService()
+	sender := new(MockSender)
+	service.RegisterSender(domain.NotificationChannelWhatsApp, sender)
+
+	tenant := createTestTenant(uuid.New())
+	require.NoError(t, tenant.SetMessagingOptOut(true))
+	notification, err := domain.NewNotification(
+		domain.NotificationTypeRentReminder, domain.NotificationChannelWhatsApp, "+5511987654321", "Lembrete", "Mensagem",
+		time.Now(), domain.DunningDedupKey("friendly_reminder", uuid.New(), domain.NotificationChannelWhatsApp),
+	)
+	require.NoError(t, err)
+	notification.TenantID = &tenant.ID
+
+	mocks.notificationRepo.On("ListDue", ctx, mock.Anything, notificationDispatchBatch).Return([]*domain.Notification{notification}, nil)
+	mocks.tenantRepo.On("GetByID", ctx, tenant.ID).Return(tenant, nil)
+	mocks.notificationRepo.On("UpdateDelivery", ctx, notification).Return(nil)
+
+	result, err := service.DispatchDueNotifications(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Cancelled)
+	assert.Equal(t, domain.NotificationStatusCancelled, notification.Status)
+	sender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestNotificationService_DispatchCancelsNoticesOfSettledPayments(t *testing.T) {
+	ctx := context.Background()
+	service, mocks := newTestNotificationService()
+	sender := new(MockSender)
+	service.RegisterSender(domain.NotificationChannelInternal, sender)
+
+	paid := &domain.Payment{ID: uuid.New(), Status: domain.PaymentStatusPaid}
+	notification, err := domain.NewNotification(
+		domain.NotificationTypeOverdueNotice, domain.NotificationChannelInternal, "(11) 98765-4321", "Atraso", "Mensagem",
+		time.Now(), domain.DunningDedupKey("first_notice", paid.ID, domain.NotificationChannelInternal),
+	)
+	require.NoError(t, err)
+	notification.PaymentID = &paid.ID
+
+	mocks.notificationRepo.On("ListDue", ctx, mock.Anything, notificationDispatchBatch).Return([]*domain.Notification{notification}, nil)
+	mocks.paymentRepo.On("GetByID", ctx, paid.ID).Return(paid, nil)
+	mocks.notificationRepo.On("UpdateDelivery", ctx, notification).Return(nil)
+
+	result, err := service.DispatchDueNotifications(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Cancelled)
+	assert.Equal(t, domain.NotificationStatusCancelled, notification.Status)
+	sender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+}
+
+func TestNotificationService_SendPaymentReceipt(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should send receipt with PDF attached", func(t *testing.T) {
+		service, mocks := newTestNotificationService()
+		sender := new(MockSender)
+		service.RegisterSender(domain.NotificationChannelEmail, sender)
+
+		lease := createTestLease()
+		tenant := createTestTenant(lease.TenantID)
+		tenant.Email = "joao@example.com"
+		paidAt := time.Now()
+		method := domain.PaymentMethodPix
+		payment := &domain.Payment{
+			ID: uuid.New(), LeaseID: lease.ID, PaymentType: domain.PaymentTypeRent, Status: domain.PaymentStatusPaid,
+			Amount: decimal.NewFromInt(850), ReferenceMonth: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			DueDate: paidAt, PaymentDate: &paidAt, PaymentMethod: &method,
+		}
+
+		stored := make([]*domain.Notification, 1) // preenchido pelo Create
+		mocks.paymentRepo.On("GetByID", ctx, payment.ID).Return(payment, nil)
+		mocks.leaseRepo.On("GetByID", ctx, lease.ID).Return(lease, nil)
+		mocks.tenantRepo.On("GetByID", ctx, tenant.ID).Return(tenant, nil)
+		mocks.unitRepo.On("GetByID", ctx, lease.UnitID).Return(createTestUnit(lease.UnitID, domain.UnitStatusOccupied), nil)
+		mocks.notificationRepo.On("Create", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			stored[0] = args.Get(1).(*domain.Notification)
+		}).Return(true, nil).Once()
+		sender.On("Send", ctx, mock.MatchedBy(func(msg notifier.Message) bool {
+			return msg.Recipient == tenant.Email && msg.HTMLBody != "" &&
+				len(msg.Attachments) == 1 && msg.Attachments[0].Filename == "recibo-2025-03.pdf" &&
+				bytes.HasPrefix(msg.Attachments[0].Content, []byte("%PDF-"))
+		})).Return("<id@kitnets.com.br>", nil).Once()
+		mocks.notificationRepo.On("UpdateDelivery", ctx, mock.Anything).Return(nil).Once()
+		mocks.notificationRepo.On("ListByLeaseID", ctx, lease.ID).Return(stored, nil)
+
+		receipts, err := service.SendPaymentReceipt(ctx, payment.ID)
+
+		require.NoError(t, err)
+		require.Len(t, receipts, 1)
+		assert.Equal(t, domain.NotificationStatusSent, receipts[0].Status)
+		assert.Equal(t, "<id@kitnets.com.br>", *receipts[0].ProviderMessageID)
+		sender.AssertExpectations(t)
+	})
+
+	t.Run("should reject unpaid payment", func(t *testing.T) {
+		service, mocks := newTestNotificationService()
+		payment := &domain.Payment{ID: uuid.New(), Status: domain.PaymentStatusPending}
+		mocks.paymentRepo.On("GetByID", ctx, payment.ID).Return(payment, nil)
+
+		_, err := service.SendPaymentReceipt(ctx, payment.ID)
+
+		assert.Equal(t, ErrPaymentNotPaidForReceipt, err)
+	})
+}
+
+func TestNotificationService_GeneratePaymentReceipts(t *testing.T) {
+	ctx := context.Background()
+	service, mocks := newTestNotificationService()
+	service.RegisterSender(domain.NotificationChannelEmail, new(MockSender))
+
+	lease := createTestLease()
+	tenant := createTestTenant(lease.TenantID)
+	tenant.Email = "joao@example.com"
+	paidAt := time.Now()
+	method := domain.PaymentMethodPix
+	payment := &domain.Payment{
+		ID: uuid.New(), LeaseID: lease.ID, PaymentType: domain.PaymentTypeRent, Status: domain.PaymentStatusPaid,
+		Amount: decimal.NewFromInt(850), ReferenceMonth: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+		DueDate: paidAt, PaymentDate: &paidAt, PaymentMethod: &method,
+	}
+
+	// Busca apenas os pagamentos quitados dentro da janela, sem carregar todo o histórico
+	since := startOfDay(time.Now()).AddDate(0, 0, -domain.PaymentReceiptLookbackDays)
+	mocks.paymentRepo.On("ListPaidSince", ctx, since).Return([]*domain.Payment{payment}, nil)
+	mocks.leaseRepo.On("GetByID", ctx, lease.ID).Return(lease, nil)
+	mocks.tenantRepo.On("GetByID", ctx, tenant.ID).Return(tenant, nil)
+	mocks.unitRepo.On("GetByID", ctx, lease.UnitID).Return(createTestUnit(lease.UnitID, domain.UnitStatusOccupied), nil)
+	mocks.notificationRepo.On("Create", ctx, mock.MatchedBy(func(n *domain.Notification) bool {
+		return n.Type == domain.NotificationTypePaymentReceipt && n.PaymentID != nil && *n.PaymentID == payment.ID
+	})).Return(true, nil)
+
+	created, err := service.GeneratePaymentReceipts(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, created)
+	mocks.paymentRepo.AssertNotCalled(t, "ListByStatus", mock.Anything, mock.Anything)
+}
+
+func TestNotificationService_RecordBounce(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should mark sent notification as bounced", func(t *testing.T) {
+		service, mocks := newTestNotificationService()
+		notification, err := domain.NewNotification(
+			domain.NotificationTypeRentReminder, domain.NotificationChannelEmail, "joao@example.com", "Lembrete", "Mensagem",
+			time.Now(), domain.DunningDedupKey("friendly_reminder", uuid.New(), domain.NotificationChannelEmail),
+		)
+		require.NoError(t, err)
+		require.NoError(t, notification.MarkAsSent("<id@kitnets.com.br>", time.Now()))
+
+		mocks.notificationRepo.On("GetByProviderMessageID", ctx, "<id@kitnets.com.br>").Return(notification, nil)
+		mocks.notificationRepo.On("UpdateDelivery", ctx, notification).Return(nil)
+
+		result, err := service.RecordBounce(ctx, "<id@kitnets.com.br>", "mailbox full")
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.NotificationStatusBounced, result.Status)
+		assert.Equal(t, "mailbox full", *result.BounceReason)
+	})
+
+	t.Run("should return not found for unknown message", func(t *testing.T) {
+		service, mocks := newTestNotificationService()
+		mocks.notificationRepo.On("GetByProviderMessageID", ctx, "<unknown>").Return(nil, nil)
+
+		_, err := service.RecordBounce(ctx, "<unknown>", "mailbox full")
+
+		assert.Equal(t, ErrNotificationNotFound, err)
+	})
+}
+
+func TestNotificationService_RecordDeliveryStatus(t *testing.T) {
+	ctx := context.Background()
+
+	newSent := func(t *testing.T) *domain.Notification {
+		notification, err := domain.NewNotification(
+			domain.NotificationTypeRentReminder, domain.NotificationChannelWhatsApp, "+5511987654321", "Lembrete", "Mensagem",
+			time.Now(), domain.DunningDedupKey("friendly_reminder", uuid.New(), domain.NotificationChannelWhatsApp),
+		)
+		require.NoError(t, err)
+		require.NoError(t, notification.MarkAsSent("wamid.1", time.Now()))
+		return notification
+	}
+
+	t.Run("should reject invalid or unconfigured token", func(t *testing.T) {
+		service, _ := newTestNotificationService()
+
+		_, err := service.RecordDeliveryStatus(ctx, "", "wamid.1", notifier.DeliveryStatusDelivered, "")
+		assert.Equal(t, ErrInvalidWebhookToken, err)
+
+		service.ConfigureDeliveryWebhook("secret")
+		_, err = service.RecordDeliveryStatus(ctx, "wrong", "wamid.1", notifier.DeliveryStatusDelivered, "")
+		assert.Equal(t, ErrInvalidWebhookToken, err)
+	})
+
+	t.Run("should mark delivered and failed messages", func(t *testing.T) {
+		service, mocks := newTestNotificationService()
+		service.ConfigureDeliveryWebhook("secret")
+		delivered := newSent(t)
+		failed := newSent(t)
+
+		mocks.notificationRepo.On("GetByProviderMessageID", ctx, "wamid.1").Return(delivered, nil)
+		mocks.notificationRepo.On("GetByProviderMessageID", ctx, "wamid.2").Return(failed, nil)
+		mocks.notificationRepo.On("UpdateDelivery", ctx, mock.Anything).Return(nil)
+
+		result, err := service.RecordDeliveryStatus(ctx, "secret", "wamid.1", notifier.DeliveryStatusRead, "")
+		require.NoError(t, err)
+		assert.Equal(t, domain.NotificationStatusDelivered, result.Status)
+		assert.NotNil(t, result.DeliveredAt)
+
+		result, err = service.RecordDeliveryStatus(ctx, "secret", "wamid.2", notifier.DeliveryStatusUndelivered, "")
+		require.NoError(t, err)
+		assert.Equal(t, domain.NotificationStatusBounced, result.Status)
+		assert.Equal(t, "undelivered", *result.BounceReason)
+	})
+
+	t.Run("should ignore intermediate statuses and unknown messages", func(t *testing.T) {
+		service, mocks := newTestNotificationService()
+		service.ConfigureDeliveryWebhook("secret")
+		notification := newSent(t)
+
+		mocks.notificationRepo.On("GetByProviderMessageID", ctx, "wamid.1").Return(notification, nil)
+		mocks.notificationRepo.On("GetByProviderMessageID", ctx, "unknown").Return(nil, nil)
+
+		result, err := service.RecordDeliveryStatus(ctx, "secret", "wamid.1", notifier.DeliveryStatusQueued, "")
+		require.NoError(t, err)
+		assert.Equal(t, domain.NotificationStatusSent, result.Status)
+		mocks.notificationRepo.AssertNotCalled(t, "UpdateDelivery", mock.Anything, mock.Anything)
+
+		_, err = service.RecordDeliveryStatus(ctx, "secret", "unknown", notifier.DeliveryStatusDelivered, "")
+		assert.Equal(t, ErrNotificationNotFound, err)
+	})
+}
+
+func TestNotificationService_ListLeaseNotifications(t *testing.T) {
+	ctx := context.Background()
+	service, mocks := newTestNotificationService()
+	leaseID := uuid.New()
+
+	mocks.leaseRepo.On("GetByID", ctx, leaseID).Return(nil, nil)
+
+	notifications, err := service.ListLeaseNotifications(ctx, leaseID)
+
+	assert.Nil(t, notifications)
+	assert.Equal(t, ErrLeaseNotFound, err)
+}
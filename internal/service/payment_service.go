@@ -26,6 +26,7 @@ var (
 type PaymentService struct {
 	paymentRepo repository.PaymentRepository
 	leaseRepo   repository.LeaseRepository
+	events      EventPublisher
 }
 
 // NewPaymentService cria uma nova instância do serviço de pagamentos
@@ -36,6 +37,11 @@ func NewPaymentService(paymentRepo repository.PaymentRepository, leaseRepo repos
 	}
 }
 
+// SetEventPublisher habilita a publicação de eventos para integrações externas (webhooks)
+func (s *PaymentService) SetEventPublisher(events EventPublisher) {
+	s.events = events
+}
+
 // GenerateMonthlyRentPaymentRequest representa os dados para gerar um pagamento de aluguel
 type GenerateMonthlyRentPaymentRequest struct {
 	LeaseID        uuid.UUID `json:"lease_id" validaate:"required"`
@@ -148,6 +154,43 @@ func (s *PaymentService) GeneratePaintingFeePayments(ctx context.Context, req Ge
 	return payments, nil
 }
 
+// GenerateSecurityDepositPayment gera o pagamento da caução com vencimento no início do contrato
+func (s *PaymentService) GenerateSecurityDepositPayment(ctx context.Context, leaseID uuid.UUID) (*domain.Payment, error) {
+	// 1. Buscar o contrato
+	lease, err := s.leaseRepo.GetByID(ctx, leaseID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting lease: %w", err)
+	}
+	if lease == nil {
+		return nil, ErrLeaseNotFoundForPayment
+	}
+
+	// 2. Validar que o contrato exige caução
+	if !lease.HasSecurityDeposit() {
+		return nil, ErrInvalidPaymentAmount
+	}
+
+	// 3. Criar o pagamento (mês de referência = mês de início do contrato)
+	referenceMonth := time.Date(lease.StartDate.Year(), lease.StartDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+	payment, err := domain.NewPayment(
+		lease.ID,
+		domain.PaymentTypeSecurityDeposit,
+		referenceMonth,
+		lease.SecurityDeposit,
+		lease.StartDate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating security deposit payment: %w", err)
+	}
+
+	// 4. Salvar no banco
+	if err := s.paymentRepo.Create(ctx, payment); err != nil {
+		return nil, fmt.Errorf("error saving security deposit payment: %w", err)
+	}
+
+	return payment, nil
+}
+
 // GenerateAdjustmentPaymentRequest representa os dados para gerar um pagamento de ajuste
 type GenerateAdjustmentPaymentRequest struct {
 	LeaseID        uuid.UUID       `json:"lease_id" validate:"required"`
@@ -279,6 +322,28 @@ func (s *PaymentService) GetUpcomingPayments(ctx context.Context, days int) ([]*
 	return payments, nil
 }
 
+// GetOverduePaymentsByProperty retorna pagamentos atrasados de um imóvel
+func (s *PaymentService) GetOverduePaymentsByProperty(ctx context.Context, propertyID uuid.UUID) ([]*domain.Payment, error) {
+	payments, err := s.paymentRepo.GetOverdueByPropertyID(ctx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting overdue payments by property: %w", err)
+	}
+	return payments, nil
+}
+
+// GetUpcomingPaymentsByProperty retorna pagamentos a vencer de um imóvel
+func (s *PaymentService) GetUpcomingPaymentsByProperty(ctx context.Context, propertyID uuid.UUID, days int) ([]*domain.Payment, error) {
+	if days <= 0 {
+		days = 7 // Default: próximos 7 dias
+	}
+
+	payments, err := s.paymentRepo.GetUpcomingByPropertyID(ctx, propertyID, days)
+	if err != nil {
+		return nil, fmt.Errorf("error getting upcoming payments by property: %w", err)
+	}
+	return payments, nil
+}
+
 // MarkPaymentAsPaidRequest representa os dados para marcar um pagamento como pago
 type MarkPaymentAsPaidRequest struct {
 	PaymentID     uuid.UUID            `json:"payment_id" validate:"required"`
@@ -286,7 +351,7 @@ type MarkPaymentAsPaidRequest struct {
 	PaymentMethod domain.PaymentMethod `json:"payment_method" validate:"required"`
 }
 
-// MarkPaymentAsPaid marca um pagamento como pago e atualiza o lease se for taxa de pintura
+// MarkPaymentAsPaid marca um pagamento como pago e atualiza o lease se for taxa de pintura ou caução
 func (s *PaymentService) MarkPaymentAsPaid(ctx context.Context, req MarkPaymentAsPaidRequest) (*domain.Payment, error) {
 	// 1. Buscar o pagamento
 	payment, err := s.paymentRepo.GetByID(ctx, req.PaymentID)
@@ -322,12 +387,21 @@ func (s *PaymentService) MarkPaymentAsPaid(ctx context.Context, req MarkPaymentA
 		}
 	}
 
-	// 7. Buscar o pagamento atualizado para retornar
+	// 7. Se for caução, registrar a data de recebimento no lease
+	if payment.PaymentType == domain.PaymentTypeSecurityDeposit {
+		if err := s.leaseRepo.UpdateDepositReceivedDate(ctx, payment.LeaseID, req.PaymentDate); err != nil {
+			return nil, fmt.Errorf("error updating lease deposit received date: %w", err)
+		}
+	}
+
+	// 8. Buscar o pagamento atualizado para retornar
 	updatedPayment, err := s.paymentRepo.GetByID(ctx, payment.ID)
 	if err != nil {
 		return nil, fmt.Errorf("error getting updated payment: %w", err)
 	}
 
+	publishEvent(ctx, s.events, domain.WebhookEventPaymentPaid, updatedPayment)
+
 	return updatedPayment, nil
 }
 
@@ -502,9 +576,13 @@ type CheckOverduePaymentsResult struct {
 func (s *PaymentService) CheckOverduePayments(ctx context.Context) (*CheckOverduePaymentsResult, error) {
 	// Marcar todos pagamentos vencidos como overdue
 	// Query do repository filtra status=pending E due_date < current date
-	if err := s.paymentRepo.MarkOverduePayments(ctx); err != nil {
+	marked, err := s.paymentRepo.MarkOverduePayments(ctx)
+	if err != nil {
 		return nil, fmt.Errorf("error marking overdue payments: %w", err)
 	}
+	for _, payment := range marked {
+		publishEvent(ctx, s.events, domain.WebhookEventPaymentOverdue, payment)
+	}
 
 	// Buscar quantos pagamentos estão atrasados agora
 	overduePayments, err := s.paymentRepo.GetOverdue(ctx)
@@ -46,6 +46,11 @@ func (m *MockPaymentRepo) ListByStatus(ctx context.Context, status domain.Paymen
 	return args.Get(0).([]*domain.Payment), args.Error(1)
 }
 
+func (m *MockPaymentRepo) ListPaidSince(ctx context.Context, since time.Time) ([]*domain.Payment, error) {
+	args := m.Called(ctx, since)
+	return args.Get(0).([]*domain.Payment), args.Error(1)
+}
+
 func (m *MockPaymentRepo) GetOverdue(ctx context.Context) ([]*domain.Payment, error) {
 	args := m.Called(ctx)
 	return args.Get(0).([]*domain.Payment), args.Error(1)
@@ -56,6 +61,26 @@ func (m *MockPaymentRepo) GetUpcoming(ctx context.Context, days int) ([]*domain.
 	return args.Get(0).([]*domain.Payment), args.Error(1)
 }
 
+func (m *MockPaymentRepo) GetOverdueByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.Payment, error) {
+	args := m.Called(ctx, propertyID)
+	return args.Get(0).([]*domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepo) GetOverdueSettledSince(ctx context.Context, since time.Time) ([]*domain.Payment, error) {
+	args := m.Called(ctx, since)
+	return args.Get(0).([]*domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepo) GetOverdueSettledSinceByPropertyID(ctx context.Context, propertyID uuid.UUID, since time.Time) ([]*domain.Payment, error) {
+	args := m.Called(ctx, propertyID, since)
+	return args.Get(0).([]*domain.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepo) GetUpcomingByPropertyID(ctx context.Context, propertyID uuid.UUID, days int) ([]*domain.Payment, error) {
+	args := m.Called(ctx, propertyID, days)
+	return args.Get(0).([]*domain.Payment), args.Error(1)
+}
+
 func (m *MockPaymentRepo) Update(ctx context.Context, payment *domain.Payment) error {
 	args := m.Called(ctx, payment)
 	return args.Error(0)
@@ -71,9 +96,12 @@ func (m *MockPaymentRepo) MarkAsPaid(ctx context.Context, id uuid.UUID, paymentD
 	return args.Error(0)
 }
 
-func (m *MockPaymentRepo) MarkOverduePayments(ctx context.Context) error {
+func (m *MockPaymentRepo) MarkOverduePayments(ctx context.Context) ([]*domain.Payment, error) {
 	args := m.Called(ctx)
-	return args.Error(0)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Payment), args.Error(1)
 }
 
 func (m *MockPaymentRepo) Cancel(ctx context.Context, id uuid.UUID) error {
@@ -662,6 +690,50 @@ func TestMarkPaymentAsPaid_Success_PaintingFee(t *testing.T) {
 	mockLeaseRepo.AssertExpectations(t)
 }
 
+// Test MarkPaymentAsPaid - Security Deposit
+func TestMarkPaymentAsPaid_Success_SecurityDeposit(t *testing.T) {
+	// Arrange
+	mockPaymentRepo := new(MockPaymentRepo)
+	mockLeaseRepo := new(MockLeaseRepo)
+	service := NewPaymentService(mockPaymentRepo, mockLeaseRepo)
+
+	ctx := context.Background()
+	paymentID := uuid.New()
+	leaseID := uuid.New()
+
+	existingPayment := &domain.Payment{
+		ID:             paymentID,
+		LeaseID:        leaseID,
+		PaymentType:    domain.PaymentTypeSecurityDeposit,
+		ReferenceMonth: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		Amount:         decimal.NewFromInt(1600),
+		Status:         domain.PaymentStatusPending,
+		DueDate:        time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	req := MarkPaymentAsPaidRequest{
+		PaymentID:     paymentID,
+		PaymentDate:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		PaymentMethod: domain.PaymentMethodPix,
+	}
+
+	mockPaymentRepo.On("GetByID", ctx, paymentID).Return(existingPayment, nil)
+	mockPaymentRepo.On("MarkAsPaid", ctx, paymentID, req.PaymentDate, req.PaymentMethod).Return(nil)
+	mockLeaseRepo.On("UpdateDepositReceivedDate", ctx, leaseID, req.PaymentDate).Return(nil)
+
+	// Act
+	payment, err := service.MarkPaymentAsPaid(ctx, req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, payment)
+
+	mockPaymentRepo.AssertExpectations(t)
+	mockLeaseRepo.AssertExpectations(t)
+}
+
 // Test MarkPaymentAsPaid - Payment Not Found
 func TestMarkPaymentAsPaid_PaymentNotFound(t *testing.T) {
 	// Arrange
@@ -915,7 +987,7 @@ func TestCheckOverduePayments_Success(t *testing.T) {
 		},
 	}
 
-	mockPaymentRepo.On("MarkOverduePayments", ctx).Return(nil)
+	mockPaymentRepo.On("MarkOverduePayments", ctx).Return([]*domain.Payment{}, nil)
 	mockPaymentRepo.On("GetOverdue", ctx).Return(overduePayments, nil)
 
 	// Act
@@ -939,7 +1011,7 @@ func TestCheckOverduePayments_NoOverduePayments(t *testing.T) {
 
 	ctx := context.Background()
 
-	mockPaymentRepo.On("MarkOverduePayments", ctx).Return(nil)
+	mockPaymentRepo.On("MarkOverduePayments", ctx).Return([]*domain.Payment{}, nil)
 	mockPaymentRepo.On("GetOverdue", ctx).Return([]*domain.Payment{}, nil)
 
 	// Act
@@ -962,7 +1034,7 @@ func TestCheckOverduePayments_ErrorMarking(t *testing.T) {
 
 	ctx := context.Background()
 
-	mockPaymentRepo.On("MarkOverduePayments", ctx).Return(errors.New("database error"))
+	mockPaymentRepo.On("MarkOverduePayments", ctx).Return(nil, errors.New("database error"))
 
 	// Act
 	result, err := service.CheckOverduePayments(ctx)
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+)
+
+// Service layer errors específicos do acesso ao portal
+var (
+	ErrPortalAccessExists      = errors.New("tenant already has portal access")
+	ErrPortalAccessNotEnabled  = errors.New("tenant does not have portal access")
+	ErrPortalCredentialMissing = errors.New("password or login code is required")
+)
+
+// PortalAuthService contém a lógica de acesso dos moradores ao portal
+type PortalAuthService struct {
+	authService   *AuthService
+	userRepo      repository.UserRepository
+	tenantRepo    repository.TenantRepository
+	loginCodeRepo repository.TenantLoginCodeRepository
+}
+
+// NewPortalAuthService cria uma nova instância do serviço de acesso ao portal
+func NewPortalAuthService(
+	authService *AuthService,
+	userRepo repository.UserRepository,
+	tenantRepo repository.TenantRepository,
+	loginCodeRepo repository.TenantLoginCodeRepository,
+) *PortalAuthService {
+	return &PortalAuthService{
+		authService:   authService,
+		userRepo:      userRepo,
+		tenantRepo:    tenantRepo,
+		loginCodeRepo: loginCodeRepo,
+	}
+}
+
+// EnablePortalAccess cria o usuário do portal para um morador
+// Sem senha, o morador acessa apenas com códigos de uso único gerados pela equipe
+func (s *PortalAuthService) EnablePortalAccess(ctx context.Context, tenantID uuid.UUID, password string) (*domain.User, error) {
+	tenant, err := s.tenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tenant: %w", err)
+	}
+	if tenant == nil {
+		return nil, ErrTenantNotFound
+	}
+
+	existing, err := s.userRepo.GetByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting portal user: %w", err)
+	}
+	if existing != nil {
+		return nil, ErrPortalAccessExists
+	}
+
+	user, err := domain.NewTenantUser(tenant.ID, tenant.CPF, password)
+	if err != nil {
+		return nil, fmt.Errorf("error creating portal user: %w", err)
+	}
+
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, fmt.Errorf("error saving portal user: %w", err)
+	}
+
+	return user, nil
+}
+
+// GenerateLoginCode gera um código de acesso de uso único para o morador
+// Códigos anteriores ainda não usados são invalidados
+func (s *PortalAuthService) GenerateLoginCode(ctx context.Context, tenantID uuid.UUID) (string, *domain.TenantLoginCode, error) {
+	user, err := s.getPortalUser(ctx, tenantID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err := s.loginCodeRepo.InvalidateByUserID(ctx, user.ID); err != nil {
+		return "", nil, fmt.Errorf("error invalidating login codes: %w", err)
+	}
+
+	loginCode, code, err := domain.NewTenantLoginCode(user.ID)
+	if err != nil {
+		return "", nil, fmt.Errorf("error generating login code: %w", err)
+	}
+
+	if err := s.loginCodeRepo.Create(ctx, loginCode); err != nil {
+		return "", nil, fmt.Errorf("error saving login code: %w", err)
+	}
+
+	return code, loginCode, nil
+}
+
+// Login autentica o morador pelo CPF/CNPJ com senha ou código de uso único
+func (s *PortalAuthService) Login(ctx context.Context, document, password, code string) (string, *domain.User, error) {
+	if password == "" && code == "" {
+		return "", nil, ErrPortalCredentialMissing
+	}
+
+	// 1. Buscar o morador e seu usuário do portal
+	tenant, err := s.tenantRepo.GetByCPF(ctx, domain.NormalizeDocument(document))
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting tenant: %w", err)
+	}
+	if tenant == nil {
+		return "", nil, ErrInvalidCredentials
+	}
+
+	user, err := s.userRepo.GetByTenantID(ctx, tenant.ID)
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting portal user: %w", err)
+	}
+	if user == nil {
+		return "", nil, ErrInvalidCredentials
+	}
+	if !user.IsActive {
+		return "", nil, ErrUserInactive
+	}
+
+	// 2. Validar a credencial
+	if code != "" {
+		if err := s.verifyLoginCode(ctx, user.ID, code); err != nil {
+			return "", nil, err
+		}
+	} else if err := user.ValidatePassword(password); err != nil {
+		return "", nil, ErrInvalidCredentials
+	}
+
+	// 3. Atualizar último login
+	if err := s.userRepo.UpdateLastLogin(ctx, user.ID, time.Now()); err != nil {
+		fmt.Printf("Warning: failed to update last login: %v\n", err)
+	}
+
+	// 4. Gerar token JWT com o vínculo do morador
+	token, err := s.authService.GenerateToken(user)
+	if err != nil {
+		return "", nil, fmt.Errorf("error generating token: %w", err)
+	}
+
+	return token, user, nil
+}
+
+// verifyLoginCode valida o código pendente do usuário e persiste a tentativa
+func (s *PortalAuthService) verifyLoginCode(ctx context.Context, userID uuid.UUID, code string) error {
+	loginCode, err := s.loginCodeRepo.GetActiveByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("error getting login code: %w", err)
+	}
+	if loginCode == nil {
+		return domain.ErrInvalidLoginCode
+	}
+
+	verifyErr := loginCode.Verify(code)
+	if err := s.loginCodeRepo.Update(ctx, loginCode); err != nil {
+		return fmt.Errorf("error updating login code: %w", err)
+	}
+
+	return verifyErr
+}
+
+// getPortalUser busca o usuário do portal de um morador
+func (s *PortalAuthService) getPortalUser(ctx context.Context, tenantID uuid.UUID) (*domain.User, error) {
+	user, err := s.userRepo.GetByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting portal user: %w", err)
+	}
+	if user == nil {
+		return nil, ErrPortalAccessNotEnabled
+	}
+	if !user.IsActive {
+		return nil, ErrUserInactive
+	}
+
+	return user, nil
+}
@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTenantLoginCodeRepo é um mock do repository de códigos de acesso ao portal
+type MockTenantLoginCodeRepo struct {
+	mock.Mock
+}
+
+func (m *MockTenantLoginCodeRepo) Create(ctx context.Context, code *domain.TenantLoginCode) error {
+	args := m.Called(ctx, code)
+	return args.Error(0)
+}
+
+func (m *MockTenantLoginCodeRepo) GetActiveByUserID(ctx context.Context, userID uuid.UUID) (*domain.TenantLoginCode, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TenantLoginCode), args.Error(1)
+}
+
+func (m *MockTenantLoginCodeRepo) Update(ctx context.Context, code *domain.TenantLoginCode) error {
+	args := m.Called(ctx, code)
+	return args.Error(0)
+}
+
+func (m *MockTenantLoginCodeRepo) InvalidateByUserID(ctx context.Context, userID uuid.UUID) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func TestPortalAuthService_Login(t *testing.T) {
+	ctx := context.Background()
+	authService := NewAuthService(nil, "test-secret", time.Hour)
+
+	tenant, err := domain.NewTenant("João da Silva", "123.456.789-09", "11987654321", "")
+	require.NoError(t, err)
+
+	t.Run("should login with one-time code and scope token to tenant", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		mockTenantRepo := new(MockTenantRepository)
+		mockCodeRepo := new(MockTenantLoginCodeRepo)
+		service := NewPortalAuthService(authService, mockUserRepo, mockTenantRepo, mockCodeRepo)
+
+		user, _ := domain.NewTenantUser(tenant.ID, tenant.CPF, "")
+		loginCode, code, _ := domain.NewTenantLoginCode(user.ID)
+
+		mockTenantRepo.On("GetByCPF", ctx, "123.456.789-09").Return(tenant, nil)
+		mockUserRepo.On("GetByTenantID", ctx, tenant.ID).Return(user, nil)
+		mockCodeRepo.On("GetActiveByUserID", ctx, user.ID).Return(loginCode, nil)
+		mockCodeRepo.On("Update", ctx, loginCode).Return(nil)
+		mockUserRepo.On("UpdateLastLogin", ctx, user.ID, mock.AnythingOfType("time.Time")).Return(nil)
+
+		token, loggedUser, err := service.Login(ctx, "12345678909", "", code)
+
+		require.NoError(t, err)
+		assert.Equal(t, user, loggedUser)
+		assert.NotNil(t, loginCode.UsedAt)
+
+		tokenUser, err := authService.GetUserFromTokenClaims(ctx, token)
+		require.NoError(t, err)
+		assert.Equal(t, domain.UserRoleTenant, tokenUser.Role)
+		assert.Equal(t, tenant.ID, *tokenUser.TenantID)
+		mockCodeRepo.AssertExpectations(t)
+	})
+
+	t.Run("should persist failed code attempt", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		mockTenantRepo := new(MockTenantRepository)
+		mockCodeRepo := new(MockTenantLoginCodeRepo)
+		service := NewPortalAuthService(authService, mockUserRepo, mockTenantRepo, mockCodeRepo)
+
+		user, _ := domain.NewTenantUser(tenant.ID, tenant.CPF, "")
+		loginCode, _, _ := domain.NewTenantLoginCode(user.ID)
+
+		mockTenantRepo.On("GetByCPF", ctx, "123.456.789-09").Return(tenant, nil)
+		mockUserRepo.On("GetByTenantID", ctx, tenant.ID).Return(user, nil)
+		mockCodeRepo.On("GetActiveByUserID", ctx, user.ID).Return(loginCode, nil)
+		mockCodeRepo.On("Update", ctx, loginCode).Return(nil)
+
+		token, _, err := service.Login(ctx, "123.456.789-09", "", "000000x")
+
+		assert.Empty(t, token)
+		assert.ErrorIs(t, err, domain.ErrInvalidLoginCode)
+		assert.Equal(t, 1, loginCode.Attempts)
+		mockUserRepo.AssertNotCalled(t, "UpdateLastLogin", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should reject wrong password", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		mockTenantRepo := new(MockTenantRepository)
+		service := NewPortalAuthService(authService, mockUserRepo, mockTenantRepo, new(MockTenantLoginCodeRepo))
+
+		user, _ := domain.NewTenantUser(tenant.ID, tenant.CPF, "password123")
+
+		mockTenantRepo.On("GetByCPF", ctx, "123.456.789-09").Return(tenant, nil)
+		mockUserRepo.On("GetByTenantID", ctx, tenant.ID).Return(user, nil)
+
+		_, _, err := service.Login(ctx, "123.456.789-09", "wrongpassword", "")
+
+		assert.ErrorIs(t, err, ErrInvalidCredentials)
+	})
+}
+
+func TestPortalAuthService_EnablePortalAccess(t *testing.T) {
+	ctx := context.Background()
+	tenant, err := domain.NewTenant("João da Silva", "123.456.789-09", "11987654321", "")
+	require.NoError(t, err)
+
+	t.Run("should create tenant user", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		mockTenantRepo := new(MockTenantRepository)
+		service := NewPortalAuthService(nil, mockUserRepo, mockTenantRepo, new(MockTenantLoginCodeRepo))
+
+		mockTenantRepo.On("GetByID", ctx, tenant.ID).Return(tenant, nil)
+		mockUserRepo.On("GetByTenantID", ctx, tenant.ID).Return(nil, nil)
+		mockUserRepo.On("Create", ctx, mock.AnythingOfType("*domain.User")).Return(nil)
+
+		user, err := service.EnablePortalAccess(ctx, tenant.ID, "")
+
+		require.NoError(t, err)
+		assert.True(t, user.IsTenant())
+		assert.Equal(t, tenant.ID, *user.TenantID)
+		mockUserRepo.AssertExpectations(t)
+	})
+
+	t.Run("should fail when access already exists", func(t *testing.T) {
+		mockUserRepo := new(MockUserRepository)
+		mockTenantRepo := new(MockTenantRepository)
+		service := NewPortalAuthService(nil, mockUserRepo, mockTenantRepo, new(MockTenantLoginCodeRepo))
+
+		existing, _ := domain.NewTenantUser(tenant.ID, tenant.CPF, "")
+		mockTenantRepo.On("GetByID", ctx, tenant.ID).Return(tenant, nil)
+		mockUserRepo.On("GetByTenantID", ctx, tenant.ID).Return(existing, nil)
+
+		user, err := service.EnablePortalAccess(ctx, tenant.ID, "")
+
+		assert.Nil(t, user)
+		assert.ErrorIs(t, err, ErrPortalAccessExists)
+		mockUserRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/shopspring/decimal"
+)
+
+// Service layer errors específicos do portal do morador
+var (
+	ErrPaymentNotPaidForReceipt = errors.New("receipt is only available for paid payments")
+	ErrPaymentNotPayable        = errors.New("PIX code is only available for pending or overdue payments")
+)
+
+// PortalService contém as consultas do portal do morador
+// Todas as operações são limitadas aos dados do morador informado
+type PortalService struct {
+	tenantRepo      repository.TenantRepository
+	leaseRepo       repository.LeaseRepository
+	paymentRepo     repository.PaymentRepository
+	unitRepo        repository.UnitRepository
+	propertyRepo    repository.PropertyRepository
+	maintenanceRepo repository.MaintenanceTicketRepository
+}
+
+// NewPortalService cria uma nova instância do serviço do portal
+func NewPortalService(
+	tenantRepo repository.TenantRepository,
+	leaseRepo repository.LeaseRepository,
+	paymentRepo repository.PaymentRepository,
+	unitRepo repository.UnitRepository,
+	propertyRepo repository.PropertyRepository,
+	maintenanceRepo repository.MaintenanceTicketRepository,
+) *PortalService {
+	return &PortalService{
+		tenantRepo:      tenantRepo,
+		leaseRepo:       leaseRepo,
+		paymentRepo:     paymentRepo,
+		unitRepo:        unitRepo,
+		propertyRepo:    propertyRepo,
+		maintenanceRepo: maintenanceRepo,
+	}
+}
+
+// PaymentReceipt representa o recibo de um pagamento quitado
+type PaymentReceipt struct {
+	Payment        *domain.Payment `json:"payment"`
+	TenantName     string          `json:"tenant_name"`
+	TenantDocument string          `json:"tenant_document"`
+	UnitNumber     string          `json:"unit_number"`
+	PropertyName   string          `json:"property_name,omitempty"`
+	IssuedAt       time.Time       `json:"issued_at"`
+}
+
+// PaymentPix representa o código PIX "copia e cola" de um pagamento em aberto
+type PaymentPix struct {
+	PaymentID uuid.UUID       `json:"payment_id"`
+	Amount    decimal.Decimal `json:"amount"`
+	DueDate   time.Time       `json:"due_date"`
+	PixKey    string          `json:"pix_key"`
+	Code      string          `json:"code"`
+}
+
+// GetProfile retorna os dados cadastrais do morador
+func (s *PortalService) GetProfile(ctx context.Context, tenantID uuid.UUID) (*domain.Tenant, error) {
+	tenant, err := s.tenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tenant: %w", err)
+	}
+	if tenant == nil {
+		return nil, ErrTenantNotFound
+	}
+
+	return tenant, nil
+}
+
+// ListLeases retorna os contratos do morador
+func (s *PortalService) ListLeases(ctx context.Context, tenantID uuid.UUID) ([]*domain.Lease, error) {
+	leases, err := s.leaseRepo.ListByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing leases by tenant: %w", err)
+	}
+
+	return leases, nil
+}
+
+// ListPayments retorna o cronograma de pagamentos de todos os contratos do morador, por vencimento
+func (s *PortalService) ListPayments(ctx context.Context, tenantID uuid.UUID) ([]*domain.Payment, error) {
+	leases, err := s.ListLeases(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	payments := []*domain.Payment{}
+	for _, lease := range leases {
+		leasePayments, err := s.paymentRepo.ListByLeaseID(ctx, lease.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error listing payments by lease: %w", err)
+		}
+		payments = append(payments, leasePayments...)
+	}
+
+	sort.SliceStable(payments, func(i, j int) bool {
+		return payments[i].DueDate.Before(payments[j].DueDate)
+	})
+
+	return payments, nil
+}
+
+// GetPaymentReceipt retorna o recibo de um pagamento quitado do morador
+func (s *PortalService) GetPaymentReceipt(ctx context.Context, tenantID, paymentID uuid.UUID) (*PaymentReceipt, error) {
+	payment, lease, err := s.getTenantPayment(ctx, tenantID, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	if !payment.IsPaid() {
+		return nil, ErrPaymentNotPaidForReceipt
+	}
+
+	tenant, err := s.GetProfile(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	unit, property, err := s.getUnitAndProperty(ctx, lease.UnitID)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt := &PaymentReceipt{
+		Payment:        payment,
+		TenantName:     tenant.FullName,
+		TenantDocument: tenant.CPF,
+		UnitNumber:     unit.Number,
+		IssuedAt:       time.Now(),
+	}
+	if property != nil {
+		receipt.PropertyName = property.Name
+	}
+
+	return receipt, nil
+}
+
+// GetPaymentPix gera o código PIX de um pagamento em aberto com a chave do imóvel
+func (s *PortalService) GetPaymentPix(ctx context.Context, tenantID, paymentID uuid.UUID) (*PaymentPix, error) {
+	payment, lease, err := s.getTenantPayment(ctx, tenantID, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	if !payment.CanBePaid() {
+		return nil, ErrPaymentNotPayable
+	}
+
+	_, property, err := s.getUnitAndProperty(ctx, lease.UnitID)
+	if err != nil {
+		return nil, err
+	}
+	if property == nil || property.BankAccount.PixKey == nil {
+		return nil, domain.ErrPixKeyNotConfigured
+	}
+
+	code, err := domain.BuildPixCode(domain.PixCharge{
+		Key:          *property.BankAccount.PixKey,
+		MerchantName: property.Name,
+		MerchantCity: property.Address.City,
+		Amount:       payment.Amount,
+		TxID:         strings.ReplaceAll(payment.ID.String(), "-", ""),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &PaymentPix{
+		PaymentID: payment.ID,
+		Amount:    payment.Amount,
+		DueDate:   payment.DueDate,
+		PixKey:    *property.BankAccount.PixKey,
+		Code:      code,
+	}, nil
+}
+
+// ListMaintenanceTickets retorna os chamados de manutenção do morador
+func (s *PortalService) ListMaintenanceTickets(ctx context.Context, tenantID uuid.UUID) ([]*domain.MaintenanceTicket, error) {
+	tickets, err := s.maintenanceRepo.ListByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing maintenance tickets by tenant: %w", err)
+	}
+
+	return tickets, nil
+}
+
+// getTenantPayment busca um pagamento garantindo que pertence a um contrato do morador
+// Pagamentos de outros moradores são tratados como inexistentes
+func (s *PortalService) getTenantPayment(ctx context.Context, tenantID, paymentID uuid.UUID) (*domain.Payment, *domain.Lease, error) {
+	payment, err := s.paymentRepo.GetByID(ctx, paymentID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting payment: %w", err)
+	}
+	if payment == nil {
+		return nil, nil, ErrPaymentNotFound
+	}
+
+	lease, err := s.leaseRepo.GetByID(ctx, payment.LeaseID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting lease: %w", err)
+	}
+	if lease == nil || lease.TenantID != tenantID {
+		return nil, nil, ErrPaymentNotFound
+	}
+
+	return payment, lease, nil
+}
+
+// getUnitAndProperty busca a unidade do contrato e o imóvel a que pertence
+func (s *PortalService) getUnitAndProperty(ctx context.Context, unitID uuid.UUID) (*domain.Unit, *domain.Property, error) {
+	unit, err := s.unitRepo.GetByID(ctx, unitID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting unit: %w", err)
+	}
+	if unit == nil {
+		return nil, nil, ErrUnitNotFound
+	}
+
+	property, err := s.propertyRepo.GetByID(ctx, unit.PropertyID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting property: %w", err)
+	}
+
+	return unit, property, nil
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortalService_ListPayments(t *testing.T) {
+	ctx := context.Background()
+	mockLeaseRepo := new(MockLeaseRepo)
+	mockPaymentRepo := new(MockPaymentRepo)
+	service := NewPortalService(nil, mockLeaseRepo, mockPaymentRepo, nil, nil, nil)
+
+	original := createTestLease()
+	renewal := createTestLease()
+	renewal.TenantID = original.TenantID
+
+	mockLeaseRepo.On("ListByTenantID", ctx, original.TenantID).Return([]*domain.Lease{renewal, original}, nil)
+	mockPaymentRepo.On("ListByLeaseID", ctx, renewal.ID).Return([]*domain.Payment{
+		{LeaseID: renewal.ID, DueDate: time.Date(2024, 10, 10, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+	mockPaymentRepo.On("ListByLeaseID", ctx, original.ID).Return([]*domain.Payment{
+		{LeaseID: original.ID, DueDate: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+
+	payments, err := service.ListPayments(ctx, original.TenantID)
+
+	require.NoError(t, err)
+	require.Len(t, payments, 2)
+	assert.Equal(t, original.ID, payments[0].LeaseID)
+	assert.Equal(t, renewal.ID, payments[1].LeaseID)
+}
+
+func TestPortalService_GetPaymentPix(t *testing.T) {
+	ctx := context.Background()
+	pixKey := "kitnets@example.com"
+
+	newFixture := func() (*domain.Lease, *domain.Payment, *domain.Unit, *domain.Property) {
+		lease := createTestLease()
+		payment := &domain.Payment{
+			ID:      uuid.New(),
+			LeaseID: lease.ID,
+			Amount:  decimal.NewFromInt(800),
+			Status:  domain.PaymentStatusPending,
+			DueDate: time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC),
+		}
+		property := &domain.Property{
+			ID:          uuid.New(),
+			Name:        "Residencial Central",
+			Address:     domain.PropertyAddress{City: "Campinas"},
+			BankAccount: domain.PropertyBankAccount{PixKey: &pixKey},
+		}
+		unit := createTestUnit(lease.UnitID, domain.UnitStatusOccupied)
+		unit.PropertyID = property.ID
+		return lease, payment, unit, property
+	}
+
+	t.Run("should generate PIX code with property key", func(t *testing.T) {
+		lease, payment, unit, property := newFixture()
+		mockLeaseRepo := new(MockLeaseRepo)
+		mockPaymentRepo := new(MockPaymentRepo)
+		mockUnitRepo := new(MockUnitRepo)
+		mockPropertyRepo := new(MockPropertyRepo)
+		service := NewPortalService(nil, mockLeaseRepo, mockPaymentRepo, mockUnitRepo, mockPropertyRepo, nil)
+
+		mockPaymentRepo.On("GetByID", ctx, payment.ID).Return(payment, nil)
+		mockLeaseRepo.On("GetByID", ctx, lease.ID).Return(lease, nil)
+		mockUnitRepo.On("GetByID", ctx, unit.ID).Return(unit, nil)
+		mockPropertyRepo.On("GetByID", ctx, property.ID).Return(property, nil)
+
+		pix, err := service.GetPaymentPix(ctx, lease.TenantID, payment.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, pixKey, pix.PixKey)
+		assert.Contains(t, pix.Code, "br.gov.bcb.pix")
+		assert.Contains(t, pix.Code, "5406800.00")
+	})
+
+	t.Run("should hide payments of other tenants", func(t *testing.T) {
+		lease, payment, _, _ := newFixture()
+		mockLeaseRepo := new(MockLeaseRepo)
+		mockPaymentRepo := new(MockPaymentRepo)
+		service := NewPortalService(nil, mockLeaseRepo, mockPaymentRepo, nil, nil, nil)
+
+		mockPaymentRepo.On("GetByID", ctx, payment.ID).Return(payment, nil)
+		mockLeaseRepo.On("GetByID", ctx, lease.ID).Return(lease, nil)
+
+		pix, err := service.GetPaymentPix(ctx, uuid.New(), payment.ID)
+
+		assert.Nil(t, pix)
+		assert.ErrorIs(t, err, ErrPaymentNotFound)
+	})
+
+	t.Run("should fail for paid payment", func(t *testing.T) {
+		lease, payment, _, _ := newFixture()
+		payment.Status = domain.PaymentStatusPaid
+		mockLeaseRepo := new(MockLeaseRepo)
+		mockPaymentRepo := new(MockPaymentRepo)
+		service := NewPortalService(nil, mockLeaseRepo, mockPaymentRepo, nil, nil, nil)
+
+		mockPaymentRepo.On("GetByID", ctx, payment.ID).Return(payment, nil)
+		mockLeaseRepo.On("GetByID", ctx, lease.ID).Return(lease, nil)
+
+		pix, err := service.GetPaymentPix(ctx, lease.TenantID, payment.ID)
+
+		assert.Nil(t, pix)
+		assert.ErrorIs(t, err, ErrPaymentNotPayable)
+	})
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/shopspring/decimal"
+)
+
+// Service layer errors específicos de imóveis
+var (
+	ErrPropertyNotFound              = errors.New("property not found")
+	ErrPropertyNameAlreadyExists     = errors.New("property name already exists")
+	ErrCannotDeletePropertyWithUnits = errors.New("cannot delete property with units")
+)
+
+// PropertyService contém a lógica de negócio para gestão de imóveis
+type PropertyService struct {
+	propertyRepo repository.PropertyRepository
+}
+
+// NewPropertyService cria uma nova instância do serviço de imóveis
+func NewPropertyService(propertyRepo repository.PropertyRepository) *PropertyService {
+	return &PropertyService{
+		propertyRepo: propertyRepo,
+	}
+}
+
+// PropertyRequest representa os dados para criar ou atualizar um imóvel
+type PropertyRequest struct {
+	Name                 string
+	Address              domain.PropertyAddress
+	BankAccount          domain.PropertyBankAccount
+	DefaultPaymentDueDay *int
+	DefaultPaintingFee   *decimal.Decimal
+	Notes                *string
+}
+
+// CreateProperty cadastra um novo imóvel
+func (s *PropertyService) CreateProperty(ctx context.Context, req PropertyRequest) (*domain.Property, error) {
+	if err := s.ensureUniqueName(ctx, req.Name, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	property, err := domain.NewProperty(req.Name, req.Address)
+	if err != nil {
+		return nil, fmt.Errorf("error creating property: %w", err)
+	}
+
+	applyPropertySettings(property, req)
+
+	if err := property.Validate(); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := s.propertyRepo.Create(ctx, property); err != nil {
+		return nil, fmt.Errorf("error saving property: %w", err)
+	}
+
+	return property, nil
+}
+
+// GetPropertyByID busca um imóvel pelo ID
+func (s *PropertyService) GetPropertyByID(ctx context.Context, id uuid.UUID) (*domain.Property, error) {
+	property, err := s.propertyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting property: %w", err)
+	}
+	if property == nil {
+		return nil, ErrPropertyNotFound
+	}
+
+	return property, nil
+}
+
+// ListProperties retorna todos os imóveis
+func (s *PropertyService) ListProperties(ctx context.Context) ([]*domain.Property, error) {
+	properties, err := s.propertyRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing properties: %w", err)
+	}
+	return properties, nil
+}
+
+// UpdateProperty atualiza os dados de um imóvel
+func (s *PropertyService) UpdateProperty(ctx context.Context, id uuid.UUID, req PropertyRequest) (*domain.Property, error) {
+	property, err := s.GetPropertyByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureUniqueName(ctx, req.Name, id); err != nil {
+		return nil, err
+	}
+
+	property.Name = strings.TrimSpace(req.Name)
+	property.Address = req.Address
+	property.Address.State = strings.ToUpper(strings.TrimSpace(req.Address.State))
+	applyPropertySettings(property, req)
+
+	if err := property.Validate(); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := s.propertyRepo.Update(ctx, property); err != nil {
+		return nil, fmt.Errorf("error updating property: %w", err)
+	}
+
+	return property, nil
+}
+
+// DeleteProperty remove um imóvel sem unidades vinculadas
+func (s *PropertyService) DeleteProperty(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.GetPropertyByID(ctx, id); err != nil {
+		return err
+	}
+
+	count, err := s.propertyRepo.CountUnits(ctx, id)
+	if err != nil {
+		return fmt.Errorf("error counting property units: %w", err)
+	}
+	if count > 0 {
+		return ErrCannotDeletePropertyWithUnits
+	}
+
+	if err := s.propertyRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("error deleting property: %w", err)
+	}
+
+	return nil
+}
+
+// ensureUniqueName verifica se o nome já está em uso por outro imóvel
+func (s *PropertyService) ensureUniqueName(ctx context.Context, name string, currentID uuid.UUID) error {
+	existing, err := s.propertyRepo.GetByName(ctx, strings.TrimSpace(name))
+	if err != nil {
+		return fmt.Errorf("error checking property name: %w", err)
+	}
+	if existing != nil && existing.ID != currentID {
+		return ErrPropertyNameAlreadyExists
+	}
+	return nil
+}
+
+// applyPropertySettings copia os dados bancários e configurações padrão da requisição
+func applyPropertySettings(property *domain.Property, req PropertyRequest) {
+	property.BankAccount = req.BankAccount
+	property.DefaultPaymentDueDay = req.DefaultPaymentDueDay
+	property.Notes = req.Notes
+	if req.DefaultPaintingFee != nil {
+		property.DefaultPaintingFee = *req.DefaultPaintingFee
+	}
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockPropertyRepo é um mock do repository de imóveis
+type MockPropertyRepo struct {
+	mock.Mock
+}
+
+func (m *MockPropertyRepo) Create(ctx context.Context, property *domain.Property) error {
+	args := m.Called(ctx, property)
+	return args.Error(0)
+}
+
+func (m *MockPropertyRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.Property, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Property), args.Error(1)
+}
+
+func (m *MockPropertyRepo) GetByName(ctx context.Context, name string) (*domain.Property, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Property), args.Error(1)
+}
+
+func (m *MockPropertyRepo) List(ctx context.Context) ([]*domain.Property, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Property), args.Error(1)
+}
+
+func (m *MockPropertyRepo) Update(ctx context.Context, property *domain.Property) error {
+	args := m.Called(ctx, property)
+	return args.Error(0)
+}
+
+func (m *MockPropertyRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockPropertyRepo) CountUnits(ctx context.Context, propertyID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, propertyID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func createTestPropertyAddress() domain.PropertyAddress {
+	return domain.PropertyAddress{
+		Street:       "Rua das Flores",
+		Number:       "100",
+		Neighborhood: "Centro",
+		City:         "São Paulo",
+		State:        "sp",
+		ZipCode:      "01000-000",
+	}
+}
+
+// TESTES
+
+func TestPropertyService_CreateProperty(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should create property with default painting fee", func(t *testing.T) {
+		mockRepo := new(MockPropertyRepo)
+		service := NewPropertyService(mockRepo)
+
+		mockRepo.On("GetByName", ctx, "Edifício Aurora").Return(nil, nil)
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Property")).Return(nil)
+
+		property, err := service.CreateProperty(ctx, PropertyRequest{
+			Name:    "Edifício Aurora",
+			Address: createTestPropertyAddress(),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "SP", property.Address.State)
+		assert.True(t, property.DefaultPaintingFee.Equal(decimal.NewFromInt(250)))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should fail when name already exists", func(t *testing.T) {
+		mockRepo := new(MockPropertyRepo)
+		service := NewPropertyService(mockRepo)
+
+		existing, _ := domain.NewProperty("Edifício Aurora", createTestPropertyAddress())
+		mockRepo.On("GetByName", ctx, "Edifício Aurora").Return(existing, nil)
+
+		property, err := service.CreateProperty(ctx, PropertyRequest{
+			Name:    "Edifício Aurora",
+			Address: createTestPropertyAddress(),
+		})
+
+		assert.Nil(t, property)
+		assert.ErrorIs(t, err, ErrPropertyNameAlreadyExists)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should fail with invalid default due day", func(t *testing.T) {
+		mockRepo := new(MockPropertyRepo)
+		service := NewPropertyService(mockRepo)
+
+		dueDay := 40
+		mockRepo.On("GetByName", ctx, "Edifício Aurora").Return(nil, nil)
+
+		property, err := service.CreateProperty(ctx, PropertyRequest{
+			Name:                 "Edifício Aurora",
+			Address:              createTestPropertyAddress(),
+			DefaultPaymentDueDay: &dueDay,
+		})
+
+		assert.Nil(t, property)
+		assert.ErrorIs(t, err, domain.ErrInvalidDefaultDueDay)
+	})
+}
+
+func TestPropertyService_DeleteProperty(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should not delete property with units", func(t *testing.T) {
+		mockRepo := new(MockPropertyRepo)
+		service := NewPropertyService(mockRepo)
+
+		property, _ := domain.NewProperty("Edifício Aurora", createTestPropertyAddress())
+		mockRepo.On("GetByID", ctx, property.ID).Return(property, nil)
+		mockRepo.On("CountUnits", ctx, property.ID).Return(int64(3), nil)
+
+		err := service.DeleteProperty(ctx, property.ID)
+
+		assert.ErrorIs(t, err, ErrCannotDeletePropertyWithUnits)
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should delete empty property", func(t *testing.T) {
+		mockRepo := new(MockPropertyRepo)
+		service := NewPropertyService(mockRepo)
+
+		property, _ := domain.NewProperty("Edifício Aurora", createTestPropertyAddress())
+		mockRepo.On("GetByID", ctx, property.ID).Return(property, nil)
+		mockRepo.On("CountUnits", ctx, property.ID).Return(int64(0), nil)
+		mockRepo.On("Delete", ctx, property.ID).Return(nil)
+
+		err := service.DeleteProperty(ctx, property.ID)
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,406 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/shopspring/decimal"
+)
+
+// Service layer errors específicos de interessados
+var (
+	ErrProspectNotFound      = errors.New("prospect not found")
+	ErrProspectVisitNotFound = errors.New("prospect visit not found")
+	ErrProspectCPFRequired   = errors.New("CPF or CNPJ is required to convert prospect")
+	ErrUseConvertEndpoint    = errors.New("use the conversion endpoint to convert a prospect")
+)
+
+// ProspectService contém a lógica de negócio do funil de interessados e da lista de espera
+type ProspectService struct {
+	prospectRepo  repository.ProspectRepository
+	unitService   *UnitService
+	tenantService *TenantService
+}
+
+// NewProspectService cria uma nova instância do serviço de interessados
+func NewProspectService(
+	prospectRepo repository.ProspectRepository,
+	unitService *UnitService,
+	tenantService *TenantService,
+) *ProspectService {
+	return &ProspectService{
+		prospectRepo:  prospectRepo,
+		unitService:   unitService,
+		tenantService: tenantService,
+	}
+}
+
+// ProspectInput representa os dados de contato e as preferências de um interessado
+type ProspectInput struct {
+	FullName          string
+	Phone             string
+	Email             *string
+	CPF               *string
+	Source            domain.ProspectSource
+	DesiredMoveInDate *time.Time
+	MaxBudget         *decimal.Decimal
+	PreferredFloor    *int
+	Notes             *string
+}
+
+// CreateProspect cadastra um novo interessado no início do funil
+func (s *ProspectService) CreateProspect(ctx context.Context, input ProspectInput, createdBy *uuid.UUID) (*domain.Prospect, error) {
+	prospect, err := domain.NewProspect(input.FullName, input.Phone, input.Source, createdBy)
+	if err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := prospect.UpdateDetails(
+		input.FullName, input.Phone, input.Email, normalizeProspectCPF(input.CPF), input.Source,
+		input.DesiredMoveInDate, input.MaxBudget, input.PreferredFloor, input.Notes,
+	); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := s.prospectRepo.Create(ctx, prospect); err != nil {
+		return nil, fmt.Errorf("error saving prospect: %w", err)
+	}
+
+	return prospect, nil
+}
+
+// GetProspect busca um interessado pelo ID
+func (s *ProspectService) GetProspect(ctx context.Context, id uuid.UUID) (*domain.Prospect, error) {
+	prospect, err := s.prospectRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting prospect: %w", err)
+	}
+	if prospect == nil {
+		return nil, ErrProspectNotFound
+	}
+
+	return prospect, nil
+}
+
+// ListProspects retorna os interessados, opcionalmente filtrados por etapa do funil
+func (s *ProspectService) ListProspects(ctx context.Context, status *domain.ProspectStatus) ([]*domain.Prospect, error) {
+	if status != nil {
+		prospects, err := s.prospectRepo.ListByStatus(ctx, *status)
+		if err != nil {
+			return nil, fmt.Errorf("error listing prospects by status: %w", err)
+		}
+		return prospects, nil
+	}
+
+	prospects, err := s.prospectRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing prospects: %w", err)
+	}
+	return prospects, nil
+}
+
+// UpdateProspect atualiza os dados de contato e as preferências do interessado
+func (s *ProspectService) UpdateProspect(ctx context.Context, id uuid.UUID, input ProspectInput) (*domain.Prospect, error) {
+	prospect, err := s.GetProspect(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := prospect.UpdateDetails(
+		input.FullName, input.Phone, input.Email, normalizeProspectCPF(input.CPF), input.Source,
+		input.DesiredMoveInDate, input.MaxBudget, input.PreferredFloor, input.Notes,
+	); err != nil {
+		if errors.Is(err, domain.ErrProspectClosed) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := s.prospectRepo.Update(ctx, prospect); err != nil {
+		return nil, fmt.Errorf("error updating prospect: %w", err)
+	}
+
+	return prospect, nil
+}
+
+// ChangeStatus move o interessado no funil
+// lost registra o motivo e new reabre um interessado perdido; a conversão tem fluxo próprio
+func (s *ProspectService) ChangeStatus(ctx context.Context, id uuid.UUID, status domain.ProspectStatus, lostReason *string) (*domain.Prospect, error) {
+	prospect, err := s.GetProspect(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch status {
+	case domain.ProspectStatusLost:
+		err = prospect.MarkAsLost(lostReason)
+	case domain.ProspectStatusNew:
+		err = prospect.Reopen()
+	case domain.ProspectStatusConverted:
+		return nil, ErrUseConvertEndpoint
+	default:
+		err = prospect.Advance(status)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.prospectRepo.Update(ctx, prospect); err != nil {
+		return nil, fmt.Errorf("error updating prospect: %w", err)
+	}
+
+	return prospect, nil
+}
+
+// DeleteProspect remove um interessado e suas visitas
+func (s *ProspectService) DeleteProspect(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.GetProspect(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.prospectRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("error deleting prospect: %w", err)
+	}
+
+	return nil
+}
+
+// ScheduleVisit agenda uma visita do interessado, opcionalmente a uma unidade específica
+func (s *ProspectService) ScheduleVisit(ctx context.Context, prospectID uuid.UUID, unitID *uuid.UUID, scheduledAt time.Time, notes *string, createdBy *uuid.UUID) (*domain.ProspectVisit, error) {
+	prospect, err := s.GetProspect(ctx, prospectID)
+	if err != nil {
+		return nil, err
+	}
+	if !prospect.IsOpen() {
+		return nil, domain.ErrProspectClosed
+	}
+
+	if unitID != nil {
+		if _, err := s.unitService.GetUnitByID(ctx, *unitID); err != nil {
+			return nil, err
+		}
+	}
+
+	visit, err := domain.NewProspectVisit(prospectID, unitID, scheduledAt, notes, createdBy)
+	if err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := s.prospectRepo.CreateVisit(ctx, visit); err != nil {
+		return nil, fmt.Errorf("error saving prospect visit: %w", err)
+	}
+
+	return visit, nil
+}
+
+// ListVisits retorna as visitas de um interessado
+func (s *ProspectService) ListVisits(ctx context.Context, prospectID uuid.UUID) ([]*domain.ProspectVisit, error) {
+	if _, err := s.GetProspect(ctx, prospectID); err != nil {
+		return nil, err
+	}
+
+	visits, err := s.prospectRepo.ListVisits(ctx, prospectID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing prospect visits: %w", err)
+	}
+
+	return visits, nil
+}
+
+// ListUpcomingVisits retorna a agenda de visitas a partir de agora
+func (s *ProspectService) ListUpcomingVisits(ctx context.Context) ([]*domain.ProspectVisit, error) {
+	visits, err := s.prospectRepo.ListUpcomingVisits(ctx, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("error listing upcoming visits: %w", err)
+	}
+
+	return visits, nil
+}
+
+// RecordVisitOutcome registra o resultado de uma visita agendada (completed, cancelled ou no_show)
+// Uma visita realizada move o interessado de new para visited
+func (s *ProspectService) RecordVisitOutcome(ctx context.Context, visitID uuid.UUID, outcome domain.ProspectVisitStatus, notes *string) (*domain.ProspectVisit, error) {
+	visit, err := s.prospectRepo.GetVisitByID(ctx, visitID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting prospect visit: %w", err)
+	}
+	if visit == nil {
+		return nil, ErrProspectVisitNotFound
+	}
+
+	switch outcome {
+	case domain.ProspectVisitStatusCompleted:
+		err = visit.Complete(notes)
+	case domain.ProspectVisitStatusCancelled:
+		err = visit.Cancel(notes)
+	case domain.ProspectVisitStatusNoShow:
+		err = visit.MarkAsNoShow(notes)
+	default:
+		return nil, fmt.Errorf("validation error: invalid visit outcome %q", outcome)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.prospectRepo.UpdateVisit(ctx, visit); err != nil {
+		return nil, fmt.Errorf("error updating prospect visit: %w", err)
+	}
+
+	if outcome == domain.ProspectVisitStatusCompleted {
+		s.advanceAfterVisit(ctx, visit.ProspectID)
+	}
+
+	return visit, nil
+}
+
+// advanceAfterVisit move o interessado para visited após a primeira visita realizada
+func (s *ProspectService) advanceAfterVisit(ctx context.Context, prospectID uuid.UUID) {
+	prospect, err := s.prospectRepo.GetByID(ctx, prospectID)
+	if err != nil || prospect == nil || prospect.Status != domain.ProspectStatusNew {
+		return
+	}
+
+	if err := prospect.Advance(domain.ProspectStatusVisited); err != nil {
+		return
+	}
+	if err := s.prospectRepo.Update(ctx, prospect); err != nil {
+		// Log do erro, mas não falha o registro da visita
+		fmt.Printf("Warning: failed to move prospect %s to visited: %v\n", prospectID, err)
+	}
+}
+
+// MatchUnits retorna as unidades vagas que atendem ao interessado, começando pelo andar preferido
+func (s *ProspectService) MatchUnits(ctx context.Context, prospectID uuid.UUID) ([]*domain.Unit, error) {
+	prospect, err := s.GetProspect(ctx, prospectID)
+	if err != nil {
+		return nil, err
+	}
+
+	units, err := s.unitService.ListAvailableUnits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return prospect.MatchUnits(units), nil
+}
+
+// GetUnitWaitlist retorna a fila de interessados em aberto cujo orçamento comporta a unidade
+func (s *ProspectService) GetUnitWaitlist(ctx context.Context, unitID uuid.UUID) ([]*domain.Prospect, error) {
+	unit, err := s.unitService.GetUnitByID(ctx, unitID)
+	if err != nil {
+		return nil, err
+	}
+
+	prospects, err := s.prospectRepo.ListOpen(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing open prospects: %w", err)
+	}
+
+	return domain.BuildWaitlist(unit, prospects), nil
+}
+
+// ConvertProspectRequest representa os dados complementares para converter o interessado em morador
+type ConvertProspectRequest struct {
+	UnitID           uuid.UUID
+	CPF              *string // Sobrescreve o CPF/CNPJ informado no cadastro do interessado
+	IDDocumentType   string
+	IDDocumentNumber string
+}
+
+// ProspectConversion representa o resultado da conversão: o morador criado e um rascunho de contrato
+// O rascunho não é gravado; deve ser revisado e enviado para a criação de contrato
+type ProspectConversion struct {
+	Prospect   *domain.Prospect    `json:"prospect"`
+	Tenant     *domain.Tenant      `json:"tenant"`
+	LeaseDraft *CreateLeaseRequest `json:"lease_draft"`
+}
+
+// ConvertProspect cria o morador a partir de um interessado aprovado e monta o rascunho do contrato
+// para a unidade escolhida, que precisa estar disponível
+func (s *ProspectService) ConvertProspect(ctx context.Context, id uuid.UUID, req ConvertProspectRequest) (*ProspectConversion, error) {
+	prospect, err := s.GetProspect(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if prospect.Status != domain.ProspectStatusApproved {
+		return nil, domain.ErrProspectNotApproved
+	}
+
+	cpf := normalizeProspectCPF(req.CPF)
+	if cpf == nil {
+		cpf = prospect.CPF
+	}
+	if cpf == nil {
+		return nil, ErrProspectCPFRequired
+	}
+
+	unit, err := s.unitService.GetUnitByID(ctx, req.UnitID)
+	if err != nil {
+		return nil, err
+	}
+	if !unit.IsAvailable() {
+		return nil, ErrUnitNotAvailable
+	}
+
+	email := ""
+	if prospect.Email != nil {
+		email = *prospect.Email
+	}
+
+	tenant, err := s.tenantService.CreateTenant(ctx, prospect.FullName, *cpf, prospect.Phone, email, req.IDDocumentType, req.IDDocumentNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	prospect.CPF = &tenant.CPF
+	if err := prospect.MarkAsConverted(tenant.ID); err != nil {
+		return nil, err
+	}
+	if err := s.prospectRepo.Update(ctx, prospect); err != nil {
+		return nil, fmt.Errorf("error updating prospect: %w", err)
+	}
+
+	return &ProspectConversion{
+		Prospect:   prospect,
+		Tenant:     tenant,
+		LeaseDraft: buildLeaseDraft(prospect, tenant, unit),
+	}, nil
+}
+
+// buildLeaseDraft monta o contrato sugerido: início na data de mudança desejada (ou hoje),
+// vencimento no mesmo dia do início e aluguel atual da unidade, sem taxa de pintura
+func buildLeaseDraft(prospect *domain.Prospect, tenant *domain.Tenant, unit *domain.Unit) *CreateLeaseRequest {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	startDate := today
+	if prospect.DesiredMoveInDate != nil && prospect.DesiredMoveInDate.After(today) {
+		startDate = *prospect.DesiredMoveInDate
+	}
+
+	return &CreateLeaseRequest{
+		UnitID:                  unit.ID,
+		TenantID:                tenant.ID,
+		ContractSignedDate:      today,
+		StartDate:               startDate,
+		PaymentDueDay:           startDate.Day(),
+		MonthlyRentValue:        unit.CurrentRentValue,
+		PaintingFeeTotal:        decimal.Zero,
+		PaintingFeeInstallments: 1,
+	}
+}
+
+// normalizeProspectCPF trata documento vazio como não informado e normaliza o formato
+func normalizeProspectCPF(cpf *string) *string {
+	if cpf == nil || strings.TrimSpace(*cpf) == "" {
+		return nil
+	}
+	normalized := domain.NormalizeDocument(*cpf)
+	return &normalized
+}
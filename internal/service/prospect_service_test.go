@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockProspectRepo é um mock do repository de interessados
+type MockProspectRepo struct {
+	mock.Mock
+}
+
+func (m *MockProspectRepo) Create(ctx context.Context, prospect *domain.Prospect) error {
+	args := m.Called(ctx, prospect)
+	return args.Error(0)
+}
+
+func (m *MockProspectRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.Prospect, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Prospect), args.Error(1)
+}
+
+func (m *MockProspectRepo) List(ctx context.Context) ([]*domain.Prospect, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*domain.Prospect), args.Error(1)
+}
+
+func (m *MockProspectRepo) ListByStatus(ctx context.Context, status domain.ProspectStatus) ([]*domain.Prospect, error) {
+	args := m.Called(ctx, status)
+	return args.Get(0).([]*domain.Prospect), args.Error(1)
+}
+
+func (m *MockProspectRepo) ListOpen(ctx context.Context) ([]*domain.Prospect, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*domain.Prospect), args.Error(1)
+}
+
+func (m *MockProspectRepo) ListByConvertedTenantID(ctx context.Context, tenantID uuid.UUID) ([]*domain.Prospect, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Get(0).([]*domain.Prospect), args.Error(1)
+}
+
+func (m *MockProspectRepo) Update(ctx context.Context, prospect *domain.Prospect) error {
+	args := m.Called(ctx, prospect)
+	return args.Error(0)
+}
+
+func (m *MockProspectRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockProspectRepo) CreateVisit(ctx context.Context, visit *domain.ProspectVisit) error {
+	args := m.Called(ctx, visit)
+	return args.Error(0)
+}
+
+func (m *MockProspectRepo) GetVisitByID(ctx context.Context, id uuid.UUID) (*domain.ProspectVisit, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ProspectVisit), args.Error(1)
+}
+
+func (m *MockProspectRepo) ListVisits(ctx context.Context, prospectID uuid.UUID) ([]*domain.ProspectVisit, error) {
+	args := m.Called(ctx, prospectID)
+	return args.Get(0).([]*domain.ProspectVisit), args.Error(1)
+}
+
+func (m *MockProspectRepo) ListUpcomingVisits(ctx context.Context, from time.Time) ([]*domain.ProspectVisit, error) {
+	args := m.Called(ctx, from)
+	return args.Get(0).([]*domain.ProspectVisit), args.Error(1)
+}
+
+func (m *MockProspectRepo) UpdateVisit(ctx context.Context, visit *domain.ProspectVisit) error {
+	args := m.Called(ctx, visit)
+	return args.Error(0)
+}
+
+type prospectServiceMocks struct {
+	prospectRepo *MockProspectRepo
+	unitRepo     *MockUnitRepository
+	tenantRepo   *MockTenantRepository
+}
+
+func newTestProspectService() (*ProspectService, *prospectServiceMocks) {
+	m := &prospectServiceMocks{
+		prospectRepo: new(MockProspectRepo),
+		unitRepo:     new(MockUnitRepository),
+		tenantRepo:   new(MockTenantRepository),
+	}
+	svc := NewProspectService(m.prospectRepo, NewUnitService(m.unitRepo, nil, nil), NewTenantService(m.tenantRepo))
+	return svc, m
+}
+
+func createTestProspect(status domain.ProspectStatus) *domain.Prospect {
+	return &domain.Prospect{
+		ID:        uuid.New(),
+		FullName:  "Maria Souza",
+		Phone:     "(11) 99999-0000",
+		Source:    domain.ProspectSourceWhatsApp,
+		Status:    status,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+func TestProspectService_CreateProspect(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should normalize CPF and save prospect", func(t *testing.T) {
+		svc, m := newTestProspectService()
+		cpf := "12345678909"
+		budget := decimal.NewFromInt(900)
+
+		m.prospectRepo.On("Create", ctx, mock.AnythingOfType("*domain.Prospect")).Return(nil)
+
+		prospect, err := svc.CreateProspect(ctx, ProspectInput{
+			FullName:  "Maria Souza",
+			Phone:     "11999990000",
+			CPF:       &cpf,
+			Source:    domain.ProspectSourceWhatsApp,
+			MaxBudget: &budget,
+		}, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.ProspectStatusNew, prospect.Status)
+		assert.Equal(t, "123.456.789-09", *prospect.CPF)
+		m.prospectRepo.AssertExpectations(t)
+	})
+
+	t.Run("should return validation error", func(t *testing.T) {
+		svc, m := newTestProspectService()
+
+		prospect, err := svc.CreateProspect(ctx, ProspectInput{FullName: "Maria", Source: domain.ProspectSourceWhatsApp}, nil)
+
+		assert.Nil(t, prospect)
+		assert.ErrorIs(t, err, domain.ErrInvalidPhone)
+		m.prospectRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestProspectService_ChangeStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should mark as lost with reason", func(t *testing.T) {
+		svc, m := newTestProspectService()
+		prospect := createTestProspect(domain.ProspectStatusVisited)
+		reason := "Achou caro"
+
+		m.prospectRepo.On("GetByID", ctx, prospect.ID).Return(prospect, nil)
+		m.prospectRepo.On("Update", ctx, prospect).Return(nil)
+
+		updated, err := svc.ChangeStatus(ctx, prospect.ID, domain.ProspectStatusLost, &reason)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.ProspectStatusLost, updated.Status)
+		assert.Equal(t, reason, *updated.LostReason)
+	})
+
+	t.Run("should reject conversion through status change", func(t *testing.T) {
+		svc, m := newTestProspectService()
+		prospect := createTestProspect(domain.ProspectStatusApproved)
+
+		m.prospectRepo.On("GetByID", ctx, prospect.ID).Return(prospect, nil)
+
+		_, err := svc.ChangeStatus(ctx, prospect.ID, domain.ProspectStatusConverted, nil)
+
+		assert.ErrorIs(t, err, ErrUseConvertEndpoint)
+		m.prospectRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return not found", func(t *testing.T) {
+		svc, m := newTestProspectService()
+		id := uuid.New()
+
+		m.prospectRepo.On("GetByID", ctx, id).Return(nil, nil)
+
+		_, err := svc.ChangeStatus(ctx, id, domain.ProspectStatusVisited, nil)
+
+		assert.ErrorIs(t, err, ErrProspectNotFound)
+	})
+}
+
+func TestProspectService_Visits(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should not schedule visit for lost prospect", func(t *testing.T) {
+		svc, m := newTestProspectService()
+		prospect := createTestProspect(domain.ProspectStatusLost)
+
+		m.prospectRepo.On("GetByID", ctx, prospect.ID).Return(prospect, nil)
+
+		_, err := svc.ScheduleVisit(ctx, prospect.ID, nil, time.Now().Add(24*time.Hour), nil, nil)
+
+		assert.ErrorIs(t, err, domain.ErrProspectClosed)
+	})
+
+	t.Run("should validate visited unit", func(t *testing.T) {
+		svc, m := newTestProspectService()
+		prospect := createTestProspect(domain.ProspectStatusNew)
+		unitID := uuid.New()
+
+		m.prospectRepo.On("GetByID", ctx, prospect.ID).Return(prospect, nil)
+		m.unitRepo.On("GetByID", ctx, unitID).Return(nil, nil)
+
+		_, err := svc.ScheduleVisit(ctx, prospect.ID, &unitID, time.Now().Add(24*time.Hour), nil, nil)
+
+		assert.ErrorIs(t, err, ErrUnitNotFound)
+	})
+
+	t.Run("should move new prospect to visited when visit is completed", func(t *testing.T) {
+		svc, m := newTestProspectService()
+		prospect := createTestProspect(domain.ProspectStatusNew)
+		visit, err := domain.NewProspectVisit(prospect.ID, nil, time.Now(), nil, nil)
+		require.NoError(t, err)
+
+		m.prospectRepo.On("GetVisitByID", ctx, visit.ID).Return(visit, nil)
+		m.prospectRepo.On("UpdateVisit", ctx, visit).Return(nil)
+		m.prospectRepo.On("GetByID", ctx, prospect.ID).Return(prospect, nil)
+		m.prospectRepo.On("Update", ctx, prospect).Return(nil)
+
+		updated, err := svc.RecordVisitOutcome(ctx, visit.ID, domain.ProspectVisitStatusCompleted, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.ProspectVisitStatusCompleted, updated.Status)
+		assert.Equal(t, domain.ProspectStatusVisited, prospect.Status)
+		m.prospectRepo.AssertExpectations(t)
+	})
+
+	t.Run("should keep prospect status on no show", func(t *testing.T) {
+		svc, m := newTestProspectService()
+		visit, err := domain.NewProspectVisit(uuid.New(), nil, time.Now(), nil, nil)
+		require.NoError(t, err)
+
+		m.prospectRepo.On("GetVisitByID", ctx, visit.ID).Return(visit, nil)
+		m.prospectRepo.On("UpdateVisit", ctx, visit).Return(nil)
+
+		updated, err := svc.RecordVisitOutcome(ctx, visit.ID, domain.ProspectVisitStatusNoShow, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.ProspectVisitStatusNoShow, updated.Status)
+		m.prospectRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestProspectService_MatchUnits(t *testing.T) {
+	ctx := context.Background()
+	svc, m := newTestProspectService()
+
+	budget := decimal.NewFromInt(800)
+	prospect := createTestProspect(domain.ProspectStatusNew)
+	prospect.MaxBudget = &budget
+
+	affordable := createTestUnit(uuid.New(), domain.UnitStatusAvailable)
+	expensive := createTestUnit(uuid.New(), domain.UnitStatusAvailable)
+	expensive.CurrentRentValue = decimal.NewFromInt(1200)
+
+	m.prospectRepo.On("GetByID", ctx, prospect.ID).Return(prospect, nil)
+	m.unitRepo.On("ListAvailable", ctx).Return([]*domain.Unit{expensive, affordable}, nil)
+
+	units, err := svc.MatchUnits(ctx, prospect.ID)
+
+	require.NoError(t, err)
+	require.Len(t, units, 1)
+	assert.Equal(t, affordable.ID, units[0].ID)
+}
+
+func TestProspectService_GetUnitWaitlist(t *testing.T) {
+	ctx := context.Background()
+	svc, m := newTestProspectService()
+
+	unit := createTestUnit(uuid.New(), domain.UnitStatusOccupied)
+	lowBudget := decimal.NewFromInt(500)
+	fits := createTestProspect(domain.ProspectStatusApplied)
+	overBudget := createTestProspect(domain.ProspectStatusNew)
+	overBudget.MaxBudget = &lowBudget
+
+	m.unitRepo.On("GetByID", ctx, unit.ID).Return(unit, nil)
+	m.prospectRepo.On("ListOpen", ctx).Return([]*domain.Prospect{overBudget, fits}, nil)
+
+	waitlist, err := svc.GetUnitWaitlist(ctx, unit.ID)
+
+	require.NoError(t, err)
+	require.Len(t, waitlist, 1)
+	assert.Equal(t, fits.ID, waitlist[0].ID)
+}
+
+func TestProspectService_ConvertProspect(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should create tenant and lease draft", func(t *testing.T) {
+		svc, m := newTestProspectService()
+		moveIn := time.Now().AddDate(0, 0, 15)
+		prospect := createTestProspect(domain.ProspectStatusApproved)
+		prospect.DesiredMoveInDate = &moveIn
+		unit := createTestUnit(uuid.New(), domain.UnitStatusAvailable)
+		cpf := "123.456.789-09"
+
+		m.prospectRepo.On("GetByID", ctx, prospect.ID).Return(prospect, nil)
+		m.unitRepo.On("GetByID", ctx, unit.ID).Return(unit, nil)
+		m.tenantRepo.On("ExistsByCPF", ctx, cpf).Return(false, nil)
+		m.tenantRepo.On("Create", ctx, mock.AnythingOfType("*domain.Tenant")).Return(nil)
+		m.prospectRepo.On("Update", ctx, prospect).Return(nil)
+
+		conversion, err := svc.ConvertProspect(ctx, prospect.ID, ConvertProspectRequest{UnitID: unit.ID, CPF: &cpf})
+
+		require.NoError(t, err)
+		assert.Equal(t, prospect.FullName, conversion.Tenant.FullName)
+		assert.Equal(t, domain.ProspectStatusConverted, conversion.Prospect.Status)
+		assert.Equal(t, conversion.Tenant.ID, *conversion.Prospect.ConvertedTenantID)
+
+		draft := conversion.LeaseDraft
+		assert.Equal(t, unit.ID, draft.UnitID)
+		assert.Equal(t, conversion.Tenant.ID, draft.TenantID)
+		assert.True(t, draft.MonthlyRentValue.Equal(unit.CurrentRentValue))
+		assert.Equal(t, moveIn, draft.StartDate)
+		assert.Equal(t, moveIn.Day(), draft.PaymentDueDay)
+		m.tenantRepo.AssertExpectations(t)
+		m.prospectRepo.AssertExpectations(t)
+	})
+
+	t.Run("should require approved prospect", func(t *testing.T) {
+		svc, m := newTestProspectService()
+		prospect := createTestProspect(domain.ProspectStatusApplied)
+
+		m.prospectRepo.On("GetByID", ctx, prospect.ID).Return(prospect, nil)
+
+		_, err := svc.ConvertProspect(ctx, prospect.ID, ConvertProspectRequest{UnitID: uuid.New()})
+
+		assert.ErrorIs(t, err, domain.ErrProspectNotApproved)
+	})
+
+	t.Run("should require CPF", func(t *testing.T) {
+		svc, m := newTestProspectService()
+		prospect := createTestProspect(domain.ProspectStatusApproved)
+
+		m.prospectRepo.On("GetByID", ctx, prospect.ID).Return(prospect, nil)
+
+		_, err := svc.ConvertProspect(ctx, prospect.ID, ConvertProspectRequest{UnitID: uuid.New()})
+
+		assert.ErrorIs(t, err, ErrProspectCPFRequired)
+	})
+
+	t.Run("should require available unit", func(t *testing.T) {
+		svc, m := newTestProspectService()
+		cpf := "123.456.789-09"
+		prospect := createTestProspect(domain.ProspectStatusApproved)
+		prospect.CPF = &cpf
+		unit := createTestUnit(uuid.New(), domain.UnitStatusOccupied)
+
+		m.prospectRepo.On("GetByID", ctx, prospect.ID).Return(prospect, nil)
+		m.unitRepo.On("GetByID", ctx, unit.ID).Return(unit, nil)
+
+		_, err := svc.ConvertProspect(ctx, prospect.ID, ConvertProspectRequest{UnitID: unit.ID})
+
+		assert.ErrorIs(t, err, ErrUnitNotAvailable)
+		m.tenantRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
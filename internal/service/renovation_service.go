@@ -0,0 +1,428 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/shopspring/decimal"
+)
+
+// Service layer errors específicos de reformas
+var (
+	ErrRenovationProjectNotFound  = errors.New("renovation project not found")
+	ErrRenovationExpenseNotFound  = errors.New("renovation expense not found")
+	ErrUnitHasActiveRenovation    = errors.New("unit already has an active renovation project")
+	ErrCannotRenovateOccupiedUnit = errors.New("cannot start renovation on an occupied unit")
+	ErrRenovationProjectCancelled = errors.New("renovation project is cancelled")
+	ErrExpenseNotInProject        = errors.New("expense does not belong to this renovation project")
+)
+
+// RenovationService contém a lógica de negócio para projetos de reforma
+type RenovationService struct {
+	projectRepo repository.RenovationProjectRepository
+	unitRepo    repository.UnitRepository
+	historyRepo repository.UnitStatusHistoryRepository
+	events      EventPublisher
+}
+
+// NewRenovationService cria uma nova instância do serviço de reformas
+func NewRenovationService(
+	projectRepo repository.RenovationProjectRepository,
+	unitRepo repository.UnitRepository,
+	historyRepo repository.UnitStatusHistoryRepository,
+) *RenovationService {
+	return &RenovationService{
+		projectRepo: projectRepo,
+		unitRepo:    unitRepo,
+		historyRepo: historyRepo,
+	}
+}
+
+// SetEventPublisher habilita a publicação de eventos para integrações externas (webhooks)
+func (s *RenovationService) SetEventPublisher(events EventPublisher) {
+	s.events = events
+}
+
+// CreateRenovationProjectRequest representa os dados para planejar uma reforma
+type CreateRenovationProjectRequest struct {
+	UnitID           uuid.UUID
+	Title            string
+	Description      string
+	PlannedStartDate time.Time
+	PlannedEndDate   time.Time
+	Budget           decimal.Decimal
+	CreatedBy        *uuid.UUID
+}
+
+// UpdateRenovationProjectRequest representa os dados para replanejar uma reforma
+type UpdateRenovationProjectRequest struct {
+	Title            string
+	Description      string
+	PlannedStartDate time.Time
+	PlannedEndDate   time.Time
+	Budget           decimal.Decimal
+	Notes            *string
+}
+
+// AddRenovationExpenseRequest representa os dados para lançar uma despesa de reforma
+type AddRenovationExpenseRequest struct {
+	Description string
+	Category    domain.RenovationExpenseCategory
+	Amount      decimal.Decimal
+	ExpenseDate time.Time
+	Supplier    *string
+	ReceiptURL  *string
+	CreatedBy   *uuid.UUID
+}
+
+// RenovationSummary consolida orçamento, gastos e retorno do investimento de uma reforma
+type RenovationSummary struct {
+	Project              *domain.RenovationProject   `json:"project"`
+	Expenses             []*domain.RenovationExpense `json:"expenses"`
+	TotalSpent           decimal.Decimal             `json:"total_spent"`
+	RemainingBudget      decimal.Decimal             `json:"remaining_budget"`
+	BudgetUsagePercent   decimal.Decimal             `json:"budget_usage_percent"`
+	IsOverBudget         bool                        `json:"is_over_budget"`
+	MonthlyRentUplift    decimal.Decimal             `json:"monthly_rent_uplift"`
+	PaybackMonths        *int                        `json:"payback_months,omitempty"`         // nil = sem acréscimo de aluguel
+	EstimatedPaybackDate *time.Time                  `json:"estimated_payback_date,omitempty"` // a partir da conclusão (ou término previsto)
+}
+
+// CreateProject planeja uma nova reforma para uma unidade
+// Cada unidade pode ter apenas um projeto planejado ou em andamento por vez
+func (s *RenovationService) CreateProject(ctx context.Context, req CreateRenovationProjectRequest) (*domain.RenovationProject, error) {
+	unit, err := s.unitRepo.GetByID(ctx, req.UnitID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting unit: %w", err)
+	}
+	if unit == nil {
+		return nil, ErrUnitNotFound
+	}
+
+	active, err := s.projectRepo.CountActiveByUnitID(ctx, req.UnitID)
+	if err != nil {
+		return nil, fmt.Errorf("error counting active renovation projects: %w", err)
+	}
+	if active > 0 {
+		return nil, ErrUnitHasActiveRenovation
+	}
+
+	project, err := domain.NewRenovationProject(req.UnitID, req.Title, req.Description, req.PlannedStartDate, req.PlannedEndDate, req.Budget, req.CreatedBy)
+	if err != nil {
+		return nil, fmt.Errorf("error creating renovation project: %w", err)
+	}
+
+	if err := s.projectRepo.Create(ctx, project); err != nil {
+		return nil, fmt.Errorf("error saving renovation project: %w", err)
+	}
+
+	return project, nil
+}
+
+// GetProjectByID busca um projeto de reforma pelo ID
+func (s *RenovationService) GetProjectByID(ctx context.Context, id uuid.UUID) (*domain.RenovationProject, error) {
+	project, err := s.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting renovation project: %w", err)
+	}
+	if project == nil {
+		return nil, ErrRenovationProjectNotFound
+	}
+	return project, nil
+}
+
+// ListProjects retorna todos os projetos de reforma
+func (s *RenovationService) ListProjects(ctx context.Context) ([]*domain.RenovationProject, error) {
+	projects, err := s.projectRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing renovation projects: %w", err)
+	}
+	return projects, nil
+}
+
+// ListProjectsByUnit retorna os projetos de reforma de uma unidade
+func (s *RenovationService) ListProjectsByUnit(ctx context.Context, unitID uuid.UUID) ([]*domain.RenovationProject, error) {
+	projects, err := s.projectRepo.ListByUnitID(ctx, unitID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing renovation projects by unit: %w", err)
+	}
+	return projects, nil
+}
+
+// ListProjectsByProperty retorna os projetos de reforma das unidades de um imóvel
+func (s *RenovationService) ListProjectsByProperty(ctx context.Context, propertyID uuid.UUID) ([]*domain.RenovationProject, error) {
+	projects, err := s.projectRepo.ListByPropertyID(ctx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing renovation projects by property: %w", err)
+	}
+	return projects, nil
+}
+
+// ListProjectsByStatus retorna os projetos de reforma com determinado status
+func (s *RenovationService) ListProjectsByStatus(ctx context.Context, status domain.RenovationStatus) ([]*domain.RenovationProject, error) {
+	projects, err := s.projectRepo.ListByStatus(ctx, status)
+	if err != nil {
+		return nil, fmt.Errorf("error listing renovation projects by status: %w", err)
+	}
+	return projects, nil
+}
+
+// UpdateProject atualiza o planejamento de uma reforma ainda não finalizada
+func (s *RenovationService) UpdateProject(ctx context.Context, id uuid.UUID, req UpdateRenovationProjectRequest) (*domain.RenovationProject, error) {
+	project, err := s.GetProjectByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := project.UpdateDetails(req.Title, req.Description, req.PlannedStartDate, req.PlannedEndDate, req.Budget, req.Notes); err != nil {
+		return nil, fmt.Errorf("error updating renovation project: %w", err)
+	}
+
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		return nil, fmt.Errorf("error saving renovation project: %w", err)
+	}
+
+	return project, nil
+}
+
+// StartProject inicia a obra e coloca a unidade em status renovation
+func (s *RenovationService) StartProject(ctx context.Context, id uuid.UUID) (*domain.RenovationProject, error) {
+	project, err := s.GetProjectByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	unit, err := s.unitRepo.GetByID(ctx, project.UnitID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting unit: %w", err)
+	}
+	if unit == nil {
+		return nil, ErrUnitNotFound
+	}
+
+	// Regra de negócio: reforma exige a unidade desocupada
+	if unit.IsOccupied() {
+		return nil, ErrCannotRenovateOccupiedUnit
+	}
+
+	if err := project.Start(unit.Status); err != nil {
+		return nil, fmt.Errorf("error starting renovation project: %w", err)
+	}
+
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		return nil, fmt.Errorf("error saving renovation project: %w", err)
+	}
+
+	if unit.Status != domain.UnitStatusRenovation {
+		if err := s.unitRepo.UpdateStatus(ctx, unit.ID, domain.UnitStatusRenovation); err != nil {
+			return nil, fmt.Errorf("error updating unit status: %w", err)
+		}
+		recordUnitStatusChange(ctx, s.historyRepo, s.events, unit.ID, domain.UnitStatusRenovation, domain.UnitStatusChangeReasonRenovationStarted, &project.ID)
+	}
+
+	return project, nil
+}
+
+// CompleteProject conclui a obra, marca a unidade como reformada e a libera para locação
+func (s *RenovationService) CompleteProject(ctx context.Context, id uuid.UUID) (*domain.RenovationProject, error) {
+	project, err := s.GetProjectByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := project.Complete(); err != nil {
+		return nil, fmt.Errorf("error completing renovation project: %w", err)
+	}
+
+	unit, err := s.unitRepo.GetByID(ctx, project.UnitID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting unit: %w", err)
+	}
+	if unit == nil {
+		return nil, ErrUnitNotFound
+	}
+
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		return nil, fmt.Errorf("error saving renovation project: %w", err)
+	}
+
+	// Unidade reformada passa a cobrar o aluguel de reformada
+	unit.MarkAsRenovated()
+	if unit.Status == domain.UnitStatusRenovation {
+		unit.MakeAvailable()
+	}
+	if err := s.unitRepo.Update(ctx, unit); err != nil {
+		return nil, fmt.Errorf("error marking unit as renovated: %w", err)
+	}
+	recordUnitStatusChange(ctx, s.historyRepo, s.events, unit.ID, unit.Status, domain.UnitStatusChangeReasonRenovationFinished, &project.ID)
+
+	return project, nil
+}
+
+// CancelProject cancela a reforma e devolve a unidade ao status anterior
+func (s *RenovationService) CancelProject(ctx context.Context, id uuid.UUID, reason *string) (*domain.RenovationProject, error) {
+	project, err := s.GetProjectByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	wasInProgress := project.Status == domain.RenovationStatusInProgress
+
+	if err := project.Cancel(reason); err != nil {
+		return nil, fmt.Errorf("error cancelling renovation project: %w", err)
+	}
+
+	if err := s.projectRepo.Update(ctx, project); err != nil {
+		return nil, fmt.Errorf("error saving renovation project: %w", err)
+	}
+
+	if !wasInProgress {
+		return project, nil
+	}
+
+	unit, err := s.unitRepo.GetByID(ctx, project.UnitID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting unit: %w", err)
+	}
+	if unit == nil || unit.Status != domain.UnitStatusRenovation {
+		// Status alterado manualmente durante a obra: não sobrescrever
+		return project, nil
+	}
+
+	newStatus := domain.UnitStatusAvailable
+	if project.PreviousUnitStatus != nil && *project.PreviousUnitStatus != domain.UnitStatusRenovation {
+		newStatus = *project.PreviousUnitStatus
+	}
+
+	if err := s.unitRepo.UpdateStatus(ctx, unit.ID, newStatus); err != nil {
+		return nil, fmt.Errorf("error updating unit status: %w", err)
+	}
+	recordUnitStatusChange(ctx, s.historyRepo, s.events, unit.ID, newStatus, domain.UnitStatusChangeReasonRenovationFinished, &project.ID)
+
+	return project, nil
+}
+
+// AddExpense lança uma despesa no projeto de reforma
+func (s *RenovationService) AddExpense(ctx context.Context, projectID uuid.UUID, req AddRenovationExpenseRequest) (*domain.RenovationExpense, error) {
+	project, err := s.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if project.Status == domain.RenovationStatusCancelled {
+		return nil, ErrRenovationProjectCancelled
+	}
+
+	expense, err := domain.NewRenovationExpense(projectID, req.Description, req.Category, req.Amount, req.ExpenseDate, req.Supplier, req.ReceiptURL, req.CreatedBy)
+	if err != nil {
+		return nil, fmt.Errorf("error creating renovation expense: %w", err)
+	}
+
+	if err := s.projectRepo.AddExpense(ctx, expense); err != nil {
+		return nil, fmt.Errorf("error saving renovation expense: %w", err)
+	}
+
+	return expense, nil
+}
+
+// ListExpenses retorna as despesas de um projeto de reforma
+func (s *RenovationService) ListExpenses(ctx context.Context, projectID uuid.UUID) ([]*domain.RenovationExpense, error) {
+	expenses, err := s.projectRepo.ListExpenses(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing renovation expenses: %w", err)
+	}
+	return expenses, nil
+}
+
+// DeleteExpense remove uma despesa lançada por engano
+func (s *RenovationService) DeleteExpense(ctx context.Context, projectID, expenseID uuid.UUID) error {
+	project, err := s.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if project.Status == domain.RenovationStatusCancelled {
+		return ErrRenovationProjectCancelled
+	}
+
+	expense, err := s.projectRepo.GetExpenseByID(ctx, expenseID)
+	if err != nil {
+		return fmt.Errorf("error getting renovation expense: %w", err)
+	}
+	if expense == nil {
+		return ErrRenovationExpenseNotFound
+	}
+	if expense.ProjectID != projectID {
+		return ErrExpenseNotInProject
+	}
+
+	if err := s.projectRepo.DeleteExpense(ctx, expenseID); err != nil {
+		return fmt.Errorf("error deleting renovation expense: %w", err)
+	}
+
+	return nil
+}
+
+// GetProjectSummary calcula gastos, saldo do orçamento e tempo de retorno da reforma
+// O retorno considera o acréscimo mensal entre o aluguel reformado e o aluguel base da unidade
+func (s *RenovationService) GetProjectSummary(ctx context.Context, id uuid.UUID) (*RenovationSummary, error) {
+	project, err := s.GetProjectByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	unit, err := s.unitRepo.GetByID(ctx, project.UnitID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting unit: %w", err)
+	}
+	if unit == nil {
+		return nil, ErrUnitNotFound
+	}
+
+	expenses, err := s.projectRepo.ListExpenses(ctx, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing renovation expenses: %w", err)
+	}
+
+	totalSpent, err := s.projectRepo.GetTotalExpenses(ctx, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting renovation total: %w", err)
+	}
+
+	summary := &RenovationSummary{
+		Project:            project,
+		Expenses:           expenses,
+		TotalSpent:         totalSpent,
+		RemainingBudget:    project.Budget.Sub(totalSpent),
+		BudgetUsagePercent: decimal.Zero,
+		IsOverBudget:       totalSpent.GreaterThan(project.Budget),
+		MonthlyRentUplift:  unit.RenovatedRentValue.Sub(unit.BaseRentValue),
+	}
+
+	if project.Budget.GreaterThan(decimal.Zero) {
+		summary.BudgetUsagePercent = totalSpent.Div(project.Budget).Mul(decimal.NewFromInt(100)).Round(2)
+	}
+
+	// Sem despesas lançadas, o orçamento serve como estimativa do investimento
+	investment := totalSpent
+	if investment.IsZero() {
+		investment = project.Budget
+	}
+
+	if summary.MonthlyRentUplift.GreaterThan(decimal.Zero) {
+		months := int(investment.Div(summary.MonthlyRentUplift).Ceil().IntPart())
+		summary.PaybackMonths = &months
+
+		reference := project.PlannedEndDate
+		if project.CompletedAt != nil {
+			reference = *project.CompletedAt
+		}
+		paybackDate := reference.AddDate(0, months, 0)
+		summary.EstimatedPaybackDate = &paybackDate
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockRenovationProjectRepo é um mock do repository de reformas
+type MockRenovationProjectRepo struct {
+	mock.Mock
+}
+
+func (m *MockRenovationProjectRepo) Create(ctx context.Context, project *domain.RenovationProject) error {
+	args := m.Called(ctx, project)
+	return args.Error(0)
+}
+
+func (m *MockRenovationProjectRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.RenovationProject, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.RenovationProject), args.Error(1)
+}
+
+func (m *MockRenovationProjectRepo) List(ctx context.Context) ([]*domain.RenovationProject, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.RenovationProject), args.Error(1)
+}
+
+func (m *MockRenovationProjectRepo) ListByUnitID(ctx context.Context, unitID uuid.UUID) ([]*domain.RenovationProject, error) {
+	args := m.Called(ctx, unitID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.RenovationProject), args.Error(1)
+}
+
+func (m *MockRenovationProjectRepo) ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.RenovationProject, error) {
+	args := m.Called(ctx, propertyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.RenovationProject), args.Error(1)
+}
+
+func (m *MockRenovationProjectRepo) ListByStatus(ctx context.Context, status domain.RenovationStatus) ([]*domain.RenovationProject, error) {
+	args := m.Called(ctx, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.RenovationProject), args.Error(1)
+}
+
+func (m *MockRenovationProjectRepo) Update(ctx context.Context, project *domain.RenovationProject) error {
+	args := m.Called(ctx, project)
+	return args.Error(0)
+}
+
+func (m *MockRenovationProjectRepo) CountActiveByUnitID(ctx context.Context, unitID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, unitID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRenovationProjectRepo) AddExpense(ctx context.Context, expense *domain.RenovationExpense) error {
+	args := m.Called(ctx, expense)
+	return args.Error(0)
+}
+
+func (m *MockRenovationProjectRepo) GetExpenseByID(ctx context.Context, id uuid.UUID) (*domain.RenovationExpense, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.RenovationExpense), args.Error(1)
+}
+
+func (m *MockRenovationProjectRepo) ListExpenses(ctx context.Context, projectID uuid.UUID) ([]*domain.RenovationExpense, error) {
+	args := m.Called(ctx, projectID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.RenovationExpense), args.Error(1)
+}
+
+func (m *MockRenovationProjectRepo) DeleteExpense(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRenovationProjectRepo) GetTotalExpenses(ctx context.Context, projectID uuid.UUID) (decimal.Decimal, error) {
+	args := m.Called(ctx, projectID)
+	return args.Get(0).(decimal.Decimal), args.Error(1)
+}
+
+func createTestRenovationProject(t *testing.T, unitID uuid.UUID, budget int64) *domain.RenovationProject {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	project, err := domain.NewRenovationProject(unitID, "Reforma completa", "", start, start.AddDate(0, 1, 0), decimal.NewFromInt(budget), nil)
+	require.NoError(t, err)
+	return project
+}
+
+func TestRenovationService_CreateProject(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should reject a second active project for the same unit", func(t *testing.T) {
+		unitID := uuid.New()
+		mockProjectRepo := new(MockRenovationProjectRepo)
+		mockUnitRepo := new(MockUnitRepository)
+		service := NewRenovationService(mockProjectRepo, mockUnitRepo, nil)
+
+		mockUnitRepo.On("GetByID", ctx, unitID).Return(createTestUnit(unitID, domain.UnitStatusAvailable), nil)
+		mockProjectRepo.On("CountActiveByUnitID", ctx, unitID).Return(int64(1), nil)
+
+		project, err := service.CreateProject(ctx, CreateRenovationProjectRequest{
+			UnitID:           unitID,
+			Title:            "Reforma",
+			PlannedStartDate: time.Now(),
+			PlannedEndDate:   time.Now().AddDate(0, 1, 0),
+			Budget:           decimal.NewFromInt(5000),
+		})
+
+		assert.Nil(t, project)
+		assert.ErrorIs(t, err, ErrUnitHasActiveRenovation)
+		mockProjectRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRenovationService_StartProject(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should move available unit to renovation", func(t *testing.T) {
+		unitID := uuid.New()
+		project := createTestRenovationProject(t, unitID, 5000)
+		mockProjectRepo := new(MockRenovationProjectRepo)
+		mockUnitRepo := new(MockUnitRepository)
+		service := NewRenovationService(mockProjectRepo, mockUnitRepo, nil)
+
+		mockProjectRepo.On("GetByID", ctx, project.ID).Return(project, nil)
+		mockUnitRepo.On("GetByID", ctx, unitID).Return(createTestUnit(unitID, domain.UnitStatusAvailable), nil)
+		mockProjectRepo.On("Update", ctx, project).Return(nil)
+		mockUnitRepo.On("UpdateStatus", ctx, unitID, domain.UnitStatusRenovation).Return(nil)
+
+		started, err := service.StartProject(ctx, project.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.RenovationStatusInProgress, started.Status)
+		require.NotNil(t, started.PreviousUnitStatus)
+		assert.Equal(t, domain.UnitStatusAvailable, *started.PreviousUnitStatus)
+		mockUnitRepo.AssertExpectations(t)
+	})
+
+	t.Run("should not start on an occupied unit", func(t *testing.T) {
+		unitID := uuid.New()
+		project := createTestRenovationProject(t, unitID, 5000)
+		mockProjectRepo := new(MockRenovationProjectRepo)
+		mockUnitRepo := new(MockUnitRepository)
+		service := NewRenovationService(mockProjectRepo, mockUnitRepo, nil)
+
+		mockProjectRepo.On("GetByID", ctx, project.ID).Return(project, nil)
+		mockUnitRepo.On("GetByID", ctx, unitID).Return(createTestUnit(unitID, domain.UnitStatusOccupied), nil)
+
+		_, err := service.StartProject(ctx, project.ID)
+
+		assert.ErrorIs(t, err, ErrCannotRenovateOccupiedUnit)
+		assert.Equal(t, domain.RenovationStatusPlanned, project.Status)
+		mockUnitRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestRenovationService_CompleteProject(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should mark unit as renovated and make it available", func(t *testing.T) {
+		unitID := uuid.New()
+		project := createTestRenovationProject(t, unitID, 5000)
+		require.NoError(t, project.Start(domain.UnitStatusAvailable))
+		unit := createTestUnit(unitID, domain.UnitStatusRenovation)
+		mockProjectRepo := new(MockRenovationProjectRepo)
+		mockUnitRepo := new(MockUnitRepository)
+		service := NewRenovationService(mockProjectRepo, mockUnitRepo, nil)
+
+		mockProjectRepo.On("GetByID", ctx, project.ID).Return(project, nil)
+		mockUnitRepo.On("GetByID", ctx, unitID).Return(unit, nil)
+		mockProjectRepo.On("Update", ctx, project).Return(nil)
+		mockUnitRepo.On("Update", ctx, unit).Return(nil)
+
+		completed, err := service.CompleteProject(ctx, project.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.RenovationStatusCompleted, completed.Status)
+		assert.True(t, unit.IsRenovated)
+		assert.True(t, unit.CurrentRentValue.Equal(unit.RenovatedRentValue))
+		assert.Equal(t, domain.UnitStatusAvailable, unit.Status)
+	})
+}
+
+func TestRenovationService_GetProjectSummary(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should compute budget usage and payback from rent uplift", func(t *testing.T) {
+		unitID := uuid.New()
+		project := createTestRenovationProject(t, unitID, 1000)
+		mockProjectRepo := new(MockRenovationProjectRepo)
+		mockUnitRepo := new(MockUnitRepository)
+		service := NewRenovationService(mockProjectRepo, mockUnitRepo, nil)
+
+		// Aluguel base 800 e reformado 850: acréscimo de 50 por mês
+		mockProjectRepo.On("GetByID", ctx, project.ID).Return(project, nil)
+		mockUnitRepo.On("GetByID", ctx, unitID).Return(createTestUnit(unitID, domain.UnitStatusRenovation), nil)
+		mockProjectRepo.On("ListExpenses", ctx, project.ID).Return([]*domain.RenovationExpense{}, nil)
+		mockProjectRepo.On("GetTotalExpenses", ctx, project.ID).Return(decimal.NewFromInt(610), nil)
+
+		summary, err := service.GetProjectSummary(ctx, project.ID)
+
+		require.NoError(t, err)
+		assert.True(t, summary.RemainingBudget.Equal(decimal.NewFromInt(390)))
+		assert.True(t, summary.BudgetUsagePercent.Equal(decimal.NewFromInt(61)))
+		assert.False(t, summary.IsOverBudget)
+		assert.True(t, summary.MonthlyRentUplift.Equal(decimal.NewFromInt(50)))
+		require.NotNil(t, summary.PaybackMonths)
+		assert.Equal(t, 13, *summary.PaybackMonths)
+		require.NotNil(t, summary.EstimatedPaybackDate)
+		assert.Equal(t, project.PlannedEndDate.AddDate(0, 13, 0), *summary.EstimatedPaybackDate)
+	})
+
+	t.Run("should not report payback without rent uplift", func(t *testing.T) {
+		unitID := uuid.New()
+		project := createTestRenovationProject(t, unitID, 1000)
+		unit := createTestUnit(unitID, domain.UnitStatusRenovation)
+		unit.RenovatedRentValue = unit.BaseRentValue
+		mockProjectRepo := new(MockRenovationProjectRepo)
+		mockUnitRepo := new(MockUnitRepository)
+		service := NewRenovationService(mockProjectRepo, mockUnitRepo, nil)
+
+		mockProjectRepo.On("GetByID", ctx, project.ID).Return(project, nil)
+		mockUnitRepo.On("GetByID", ctx, unitID).Return(unit, nil)
+		mockProjectRepo.On("ListExpenses", ctx, project.ID).Return([]*domain.RenovationExpense{}, nil)
+		mockProjectRepo.On("GetTotalExpenses", ctx, project.ID).Return(decimal.NewFromInt(1200), nil)
+
+		summary, err := service.GetProjectSummary(ctx, project.ID)
+
+		require.NoError(t, err)
+		assert.True(t, summary.IsOverBudget)
+		assert.Nil(t, summary.PaybackMonths)
+		assert.Nil(t, summary.EstimatedPaybackDate)
+	})
+}
@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// AgingReportRequest representa os filtros do relatório de inadimplência por faixa de atraso
+type AgingReportRequest struct {
+	PropertyID *uuid.UUID `json:"property_id,omitempty"`
+}
+
+// AgingReportResponse representa os pagamentos em aberto e vencidos agrupados por faixa de atraso
+type AgingReportResponse struct {
+	AsOf        time.Time     `json:"as_of"`
+	Summary     AgingSummary  `json:"summary"`
+	ByTenant    []TenantAging `json:"by_tenant"`
+	ByUnit      []UnitAging   `json:"by_unit"`
+	ByType      []TypeAging   `json:"by_type"`
+	Trend       AgingTrend    `json:"trend"`
+	GeneratedAt time.Time     `json:"generated_at"`
+}
+
+// AgingAmount representa a quantidade e a soma dos pagamentos de uma faixa
+type AgingAmount struct {
+	Count  int             `json:"count"`
+	Amount decimal.Decimal `json:"amount"`
+}
+
+// AgingSummary representa os valores em atraso por faixa (0-30, 31-60, 61-90 e mais de 90 dias)
+type AgingSummary struct {
+	Days0To30  AgingAmount `json:"days_0_30"`
+	Days31To60 AgingAmount `json:"days_31_60"`
+	Days61To90 AgingAmount `json:"days_61_90"`
+	Over90     AgingAmount `json:"over_90"`
+	Total      AgingAmount `json:"total"`
+}
+
+// TenantAging representa os valores em atraso de um morador
+type TenantAging struct {
+	TenantID   uuid.UUID `json:"tenant_id"`
+	TenantName string    `json:"tenant_name"`
+	AgingSummary
+}
+
+// UnitAging representa os valores em atraso de uma unidade
+type UnitAging struct {
+	UnitID     uuid.UUID `json:"unit_id"`
+	UnitNumber string    `json:"unit_number"`
+	AgingSummary
+}
+
+// TypeAging representa os valores em atraso de um tipo de pagamento
+type TypeAging struct {
+	Type domain.PaymentType `json:"type"`
+	AgingSummary
+}
+
+// AgingTrend compara a inadimplência atual com a do mesmo dia do mês anterior
+type AgingTrend struct {
+	PreviousAsOf  time.Time       `json:"previous_as_of"`
+	Previous      AgingSummary    `json:"previous"`
+	AmountChange  decimal.Decimal `json:"amount_change"`
+	CountChange   int             `json:"count_change"`
+	PercentChange *float64        `json:"percent_change"` // Nulo quando não havia valores em atraso no mês anterior
+}
+
+// bucket retorna os valores da faixa informada
+func (s *AgingSummary) bucket(bucket domain.AgingBucket) *AgingAmount {
+	switch bucket {
+	case domain.AgingBucket0To30:
+		return &s.Days0To30
+	case domain.AgingBucket31To60:
+		return &s.Days31To60
+	case domain.AgingBucket61To90:
+		return &s.Days61To90
+	default:
+		return &s.Over90
+	}
+}
+
+// add soma o pagamento na faixa e no total
+func (s *AgingSummary) add(bucket domain.AgingBucket, amount decimal.Decimal) {
+	target := s.bucket(bucket)
+	target.Count++
+	target.Amount = target.Amount.Add(amount)
+	s.Total.Count++
+	s.Total.Amount = s.Total.Amount.Add(amount)
+}
+
+// agingParties guarda morador e unidade de um contrato, buscados uma única vez por relatório
+type agingParties struct {
+	tenant *domain.Tenant
+	unit   *domain.Unit
+}
+
+// GetAgingReport gera o relatório de inadimplência com os pagamentos vencidos e não pagos (pending e overdue)
+// agrupados por faixa de atraso, morador, unidade e tipo, com a comparação com o mês anterior
+// A caução não é aluguel em atraso e fica fora do relatório
+func (s *ReportService) GetAgingReport(ctx context.Context, req AgingReportRequest) (*AgingReportResponse, error) {
+	asOf := startOfDay(time.Now())
+	previousAsOf := asOf.AddDate(0, -1, 0)
+
+	// 1. Buscar pagamentos vencidos em aberto
+	var open []*domain.Payment
+	var err error
+	if req.PropertyID != nil {
+		open, err = s.paymentRepo.GetOverdueByPropertyID(ctx, *req.PropertyID)
+	} else {
+		open, err = s.paymentRepo.GetOverdue(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting overdue payments: %w", err)
+	}
+
+	// 2. Buscar pagamentos que estavam vencidos no mês anterior e já foram quitados
+	var settled []*domain.Payment
+	if req.PropertyID != nil {
+		settled, err = s.paymentRepo.GetOverdueSettledSinceByPropertyID(ctx, *req.PropertyID, previousAsOf)
+	} else {
+		settled, err = s.paymentRepo.GetOverdueSettledSince(ctx, previousAsOf)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting settled overdue payments: %w", err)
+	}
+
+	// 3. Agrupar por faixa, morador, unidade e tipo
+	var summary, previous AgingSummary
+	byTenant := make(map[uuid.UUID]*TenantAging)
+	byUnit := make(map[uuid.UUID]*UnitAging)
+	byType := make(map[domain.PaymentType]*TypeAging)
+	parties := make(map[uuid.UUID]*agingParties)
+
+	for _, payment := range open {
+		if payment.PaymentType == domain.PaymentTypeSecurityDeposit {
+			continue
+		}
+
+		p, err := s.loadAgingParties(ctx, parties, payment.LeaseID)
+		if err != nil {
+			return nil, err
+		}
+
+		bucket := domain.AgingBucketFor(domain.DaysPastDue(payment.DueDate, asOf))
+		summary.add(bucket, payment.Amount)
+
+		tenant, ok := byTenant[p.tenant.ID]
+		if !ok {
+			tenant = &TenantAging{TenantID: p.tenant.ID, TenantName: p.tenant.FullName}
+			byTenant[p.tenant.ID] = tenant
+		}
+		tenant.add(bucket, payment.Amount)
+
+		unit, ok := byUnit[p.unit.ID]
+		if !ok {
+			unit = &UnitAging{UnitID: p.unit.ID, UnitNumber: p.unit.Number}
+			byUnit[p.unit.ID] = unit
+		}
+		unit.add(bucket, payment.Amount)
+
+		paymentType, ok := byType[payment.PaymentType]
+		if !ok {
+			paymentType = &TypeAging{Type: payment.PaymentType}
+			byType[payment.PaymentType] = paymentType
+		}
+		paymentType.add(bucket, payment.Amount)
+
+		// Pagamentos que já estavam vencidos no mês anterior entram também na comparação
+		if payment.DueDate.Before(previousAsOf) {
+			previous.add(domain.AgingBucketFor(domain.DaysPastDue(payment.DueDate, previousAsOf)), payment.Amount)
+		}
+	}
+
+	// 4. Reconstituir o mês anterior com os pagamentos quitados desde então
+	// Pagamentos cancelados depois da data de comparação não são considerados
+	for _, payment := range settled {
+		if payment.PaymentType == domain.PaymentTypeSecurityDeposit {
+			continue
+		}
+		previous.add(domain.AgingBucketFor(domain.DaysPastDue(payment.DueDate, previousAsOf)), payment.Amount)
+	}
+
+	return &AgingReportResponse{
+		AsOf:        asOf,
+		Summary:     summary,
+		ByTenant:    sortedTenantAging(byTenant),
+		ByUnit:      sortedUnitAging(byUnit),
+		ByType:      sortedTypeAging(byType),
+		Trend:       newAgingTrend(previousAsOf, previous, summary),
+		GeneratedAt: time.Now(),
+	}, nil
+}
+
+// loadAgingParties busca morador e unidade do contrato, reaproveitando os já carregados
+func (s *ReportService) loadAgingParties(ctx context.Context, cache map[uuid.UUID]*agingParties, leaseID uuid.UUID) (*agingParties, error) {
+	if p, ok := cache[leaseID]; ok {
+		return p, nil
+	}
+
+	lease, err := s.leaseRepo.GetByID(ctx, leaseID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching lease: %w", err)
+	}
+	if lease == nil {
+		return nil, ErrLeaseNotFoundForPayment
+	}
+
+	tenant, err := s.tenantRepo.GetByID(ctx, lease.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching tenant: %w", err)
+	}
+	if tenant == nil {
+		return nil, ErrTenantNotFound
+	}
+
+	unit, err := s.unitRepo.GetByID(ctx, lease.UnitID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching unit: %w", err)
+	}
+	if unit == nil {
+		return nil, ErrUnitNotFound
+	}
+
+	p := &agingParties{tenant: tenant, unit: unit}
+	cache[leaseID] = p
+	return p, nil
+}
+
+// newAgingTrend calcula a variação da inadimplência em relação ao mês anterior
+func newAgingTrend(previousAsOf time.Time, previous, current AgingSummary) AgingTrend {
+	trend := AgingTrend{
+		PreviousAsOf: previousAsOf,
+		Previous:     previous,
+		AmountChange: current.Total.Amount.Sub(previous.Total.Amount),
+		CountChange:  current.Total.Count - previous.Total.Count,
+	}
+	if !previous.Total.Amount.IsZero() {
+		percent, _ := trend.AmountChange.Div(previous.Total.Amount).Mul(decimal.NewFromInt(100)).Round(2).Float64()
+		trend.PercentChange = &percent
+	}
+	return trend
+}
+
+// sortedTenantAging ordena os moradores do maior para o menor valor em atraso
+func sortedTenantAging(groups map[uuid.UUID]*TenantAging) []TenantAging {
+	result := make([]TenantAging, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, *group)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].Total.Amount.Equal(result[j].Total.Amount) {
+			return result[i].Total.Amount.GreaterThan(result[j].Total.Amount)
+		}
+		return result[i].TenantName < result[j].TenantName
+	})
+	return result
+}
+
+// sortedUnitAging ordena as unidades do maior para o menor valor em atraso
+func sortedUnitAging(groups map[uuid.UUID]*UnitAging) []UnitAging {
+	result := make([]UnitAging, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, *group)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].Total.Amount.Equal(result[j].Total.Amount) {
+			return result[i].Total.Amount.GreaterThan(result[j].Total.Amount)
+		}
+		return result[i].UnitNumber < result[j].UnitNumber
+	})
+	return result
+}
+
+// sortedTypeAging ordena os tipos de pagamento do maior para o menor valor em atraso
+func sortedTypeAging(groups map[domain.PaymentType]*TypeAging) []TypeAging {
+	result := make([]TypeAging, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, *group)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].Total.Amount.Equal(result[j].Total.Amount) {
+			return result[i].Total.Amount.GreaterThan(result[j].Total.Amount)
+		}
+		return result[i].Type < result[j].Type
+	})
+	return result
+}
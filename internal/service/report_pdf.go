@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/pdf"
+	"github.com/shopspring/decimal"
+)
+
+// SortedByType retorna a receita por tipo ordenada pelo tipo de pagamento
+func (r *FinancialReportResponse) SortedByType() []TypeRevenue {
+	result := make([]TypeRevenue, 0, len(r.ByType))
+	for _, revenue := range r.ByType {
+		result = append(result, revenue)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Type < result[j].Type })
+	return result
+}
+
+// Colunas das tabelas de receita agrupada (tipo, mês, unidade e imóvel)
+func revenueColumns(first string) []pdf.ReportColumn {
+	return []pdf.ReportColumn{
+		{Header: first, Width: 3},
+		{Header: "Quantidade", Width: 1, AlignRight: true},
+		{Header: "Valor", Width: 1.5, AlignRight: true},
+	}
+}
+
+// FinancialReportPDF gera o relatório financeiro em PDF, com resumo, gráfico de receita mensal
+// e tabelas por tipo, mês, unidade e imóvel
+func (s *ReportService) FinancialReportPDF(ctx context.Context, req FinancialReportRequest) ([]byte, error) {
+	report, err := s.GetFinancialReport(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	propertyName, err := s.reportPropertyName(ctx, req.PropertyID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := report.Summary
+	data := pdf.ReportData{
+		Title:        "Relatório financeiro",
+		PropertyName: propertyName,
+		Period:       report.Period.StartDate.Format("02/01/2006") + " a " + report.Period.EndDate.Format("02/01/2006"),
+		Summary: []pdf.ReportField{
+			{Label: "Receita total", Value: domain.FormatBRL(summary.TotalRevenue)},
+			{Label: "Recebido", Value: domain.FormatBRL(summary.PaidAmount)},
+			{Label: "Pendente", Value: domain.FormatBRL(summary.PendingAmount)},
+			{Label: "Em atraso", Value: domain.FormatBRL(summary.OverdueAmount)},
+			{Label: "Cancelado", Value: domain.FormatBRL(summary.CancelledAmount)},
+			{Label: "Cauções recebidas (fora da receita)", Value: domain.FormatBRL(summary.SecurityDepositsReceived)},
+			{Label: "Pagamentos", Value: strconv.Itoa(report.TotalPayments)},
+		},
+		GeneratedAt: report.GeneratedAt,
+	}
+
+	chart := &pdf.BarChart{Title: "Receita por mês"}
+	byMonth := pdf.ReportTable{Title: "Receita por mês", Columns: revenueColumns("Mês")}
+	for _, revenue := range report.ByMonth {
+		month := revenue.Month
+		if parsed, err := time.Parse("2006-01", revenue.Month); err == nil {
+			month = parsed.Format("01/2006")
+		}
+		chart.Bars = append(chart.Bars, pdf.Bar{
+			Label:      month,
+			Value:      revenue.Amount.InexactFloat64(),
+			ValueLabel: compactBRL(revenue.Amount),
+		})
+		byMonth.Rows = append(byMonth.Rows, revenueRow(month, revenue.Count, revenue.Amount))
+	}
+	data.Chart = chart
+
+	byType := pdf.ReportTable{Title: "Receita por tipo", Columns: revenueColumns("Tipo"), Empty: "Nenhum pagamento no período"}
+	for _, revenue := range report.SortedByType() {
+		byType.Rows = append(byType.Rows, revenueRow(domain.PaymentType(revenue.Type).Label(), revenue.Count, revenue.Amount))
+	}
+	byType.Footer = revenueRow("Total", report.TotalPayments, summary.TotalRevenue)
+
+	byUnit := pdf.ReportTable{Title: "Receita por unidade", Columns: revenueColumns("Unidade"), Empty: "Nenhum pagamento no período"}
+	for _, revenue := range report.ByUnit {
+		byUnit.Rows = append(byUnit.Rows, revenueRow(revenue.UnitNumber, revenue.Count, revenue.Amount))
+	}
+
+	data.Tables = []pdf.ReportTable{byType, byMonth, byUnit}
+
+	// Com vários imóveis, inclui também a divisão por imóvel
+	if len(report.ByProperty) > 1 {
+		byProperty := pdf.ReportTable{Title: "Receita por imóvel", Columns: revenueColumns("Imóvel")}
+		for _, revenue := range report.ByProperty {
+			byProperty.Rows = append(byProperty.Rows, revenueRow(revenue.PropertyName, revenue.Count, revenue.Amount))
+		}
+		data.Tables = append(data.Tables, byProperty)
+	}
+
+	content, err := pdf.RenderReport(data)
+	if err != nil {
+		return nil, fmt.Errorf("error rendering financial report: %w", err)
+	}
+	return content, nil
+}
+
+// PaymentHistoryPDF gera o histórico de pagamentos em PDF, com totais e a tabela paginada de pagamentos
+func (s *ReportService) PaymentHistoryPDF(ctx context.Context, req PaymentHistoryRequest) ([]byte, error) {
+	// O PDF traz todo o histórico do filtro, ignorando a paginação
+	totals, err := s.reportRepo.GetPaymentHistoryTotals(ctx, paymentHistoryFilter(req))
+	if err != nil {
+		return nil, err
+	}
+
+	propertyName, err := s.reportPropertyName(ctx, req.PropertyID)
+	if err != nil {
+		return nil, err
+	}
+
+	table := pdf.ReportTable{
+		Title: "Pagamentos",
+		Columns: []pdf.ReportColumn{
+			{Header: "Unidade", Width: 1},
+			{Header: "Morador", Width: 3},
+			{Header: "Tipo", Width: 1.8},
+			{Header: "Status", Width: 1.3},
+			{Header: "Vencimento", Width: 1.4},
+			{Header: "Pagamento", Width: 1.4},
+			{Header: "Valor", Width: 1.6, AlignRight: true},
+		},
+		Empty: "Nenhum pagamento encontrado",
+	}
+	err = s.StreamPaymentHistory(ctx, req, func(item PaymentHistoryItem) error {
+		paymentDate := "-"
+		if item.PaymentDate != nil {
+			paymentDate = item.PaymentDate.Format("02/01/2006")
+		}
+		table.Rows = append(table.Rows, []string{
+			item.UnitNumber,
+			item.TenantName,
+			item.PaymentType.Label(),
+			item.Status.Label(),
+			item.DueDate.Format("02/01/2006"),
+			paymentDate,
+			domain.FormatBRL(item.Amount),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	table.Footer = []string{"Total", "", "", "", "", "", domain.FormatBRL(totals.Amount)}
+
+	data := pdf.ReportData{
+		Title:        "Histórico de pagamentos",
+		PropertyName: propertyName,
+		Period:       historyPeriod(req.StartDate, req.EndDate),
+		Summary: []pdf.ReportField{
+			{Label: "Pagamentos", Value: strconv.FormatInt(totals.PaymentCount, 10)},
+			{Label: "Valor total", Value: domain.FormatBRL(totals.Amount)},
+		},
+		Tables:      []pdf.ReportTable{table},
+		GeneratedAt: time.Now(),
+	}
+
+	content, err := pdf.RenderReport(data)
+	if err != nil {
+		return nil, fmt.Errorf("error rendering payment history report: %w", err)
+	}
+	return content, nil
+}
+
+// reportPropertyName retorna o nome do imóvel filtrado no relatório, se houver
+func (s *ReportService) reportPropertyName(ctx context.Context, propertyID *uuid.UUID) (string, error) {
+	if propertyID == nil || s.propertyRepo == nil {
+		return "", nil
+	}
+	property, err := s.propertyRepo.GetByID(ctx, *propertyID)
+	if err != nil {
+		return "", fmt.Errorf("error getting property: %w", err)
+	}
+	if property == nil {
+		return "", nil
+	}
+	return property.Name, nil
+}
+
+// revenueRow monta a linha de uma tabela de receita agrupada
+func revenueRow(label string, count int, amount decimal.Decimal) []string {
+	return []string{label, strconv.Itoa(count), domain.FormatBRL(amount)}
+}
+
+// historyPeriod descreve o período filtrado no histórico de pagamentos
+func historyPeriod(start, end *time.Time) string {
+	switch {
+	case start != nil && end != nil:
+		return start.Format("02/01/2006") + " a " + end.Format("02/01/2006")
+	case start != nil:
+		return "A partir de " + start.Format("02/01/2006")
+	case end != nil:
+		return "Até " + end.Format("02/01/2006")
+	default:
+		return "Todo o período"
+	}
+}
+
+// compactBRL abrevia valores do gráfico para caber acima das barras (ex: 12,5 mil)
+func compactBRL(amount decimal.Decimal) string {
+	switch abs := amount.Abs(); {
+	case abs.GreaterThanOrEqual(decimal.NewFromInt(1_000_000)):
+		return compactUnit(amount, 1_000_000, "mi")
+	case abs.GreaterThanOrEqual(decimal.NewFromInt(1000)):
+		return compactUnit(amount, 1000, "mil")
+	default:
+		return amount.Round(0).String()
+	}
+}
+
+// compactUnit divide o valor pela unidade e formata com uma casa decimal (ex: 12,5 mil)
+func compactUnit(amount decimal.Decimal, unit int64, suffix string) string {
+	formatted := domain.FormatDecimalBR(amount.Div(decimal.NewFromInt(unit)).Round(1))
+	// FormatDecimalBR usa duas casas; a segunda é sempre zero após o arredondamento
+	return formatted[:len(formatted)-1] + " " + suffix
+}
@@ -11,26 +11,40 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// Paginação do histórico de pagamentos
+const (
+	PaymentHistoryDefaultPageSize = 50
+	PaymentHistoryMaxPageSize     = 500
+	// paymentHistoryStreamBatch é a quantidade de pagamentos lidos por consulta nas exportações
+	paymentHistoryStreamBatch = 500
+)
+
 // ReportService contém a lógica de negócio para geração de relatórios
 type ReportService struct {
-	paymentRepo repository.PaymentRepository
-	leaseRepo   repository.LeaseRepository
-	unitRepo    repository.UnitRepository
-	tenantRepo  repository.TenantRepository
+	reportRepo   repository.ReportRepository
+	paymentRepo  repository.PaymentRepository
+	leaseRepo    repository.LeaseRepository
+	unitRepo     repository.UnitRepository
+	tenantRepo   repository.TenantRepository
+	propertyRepo repository.PropertyRepository
 }
 
 // NewReportService cria uma nova instância do serviço de relatórios
 func NewReportService(
+	reportRepo repository.ReportRepository,
 	paymentRepo repository.PaymentRepository,
 	leaseRepo repository.LeaseRepository,
 	unitRepo repository.UnitRepository,
 	tenantRepo repository.TenantRepository,
+	propertyRepo repository.PropertyRepository,
 ) *ReportService {
 	return &ReportService{
-		paymentRepo: paymentRepo,
-		leaseRepo:   leaseRepo,
-		unitRepo:    unitRepo,
-		tenantRepo:  tenantRepo,
+		reportRepo:   reportRepo,
+		paymentRepo:  paymentRepo,
+		leaseRepo:    leaseRepo,
+		unitRepo:     unitRepo,
+		tenantRepo:   tenantRepo,
+		propertyRepo: propertyRepo,
 	}
 }
 
@@ -40,6 +54,7 @@ type FinancialReportRequest struct {
 	EndDate     time.Time             `json:"end_date"`
 	PaymentType *domain.PaymentType   `json:"payment_type,omitempty"` // Opcional: filtrar por tipo
 	Status      *domain.PaymentStatus `json:"status,omitempty"`       // Opcional: filtrar por status
+	PropertyID  *uuid.UUID            `json:"property_id,omitempty"`  // Opcional: filtrar por imóvel
 }
 
 // FinancialReportResponse representa o relatório financeiro consolidado
@@ -49,6 +64,7 @@ type FinancialReportResponse struct {
 	ByType        map[string]TypeRevenue `json:"by_type"`
 	ByMonth       []MonthlyRevenue       `json:"by_month"`
 	ByUnit        []UnitRevenue          `json:"by_unit"`
+	ByProperty    []PropertyRevenue      `json:"by_property"`
 	TotalPayments int                    `json:"total_payments"`
 	GeneratedAt   time.Time              `json:"generated_at"`
 }
@@ -62,11 +78,12 @@ type Period struct {
 
 // FinancialSummary representa o resumo financeiro
 type FinancialSummary struct {
-	TotalRevenue    decimal.Decimal `json:"total_revenue"`
-	PaidAmount      decimal.Decimal `json:"paid_amount"`
-	PendingAmount   decimal.Decimal `json:"pending_amount"`
-	OverdueAmount   decimal.Decimal `json:"overdue_amount"`
-	CancelledAmount decimal.Decimal `json:"cancelled_amount"`
+	TotalRevenue             decimal.Decimal `json:"total_revenue"`
+	PaidAmount               decimal.Decimal `json:"paid_amount"`
+	PendingAmount            decimal.Decimal `json:"pending_amount"`
+	OverdueAmount            decimal.Decimal `json:"overdue_amount"`
+	CancelledAmount          decimal.Decimal `json:"cancelled_amount"`
+	SecurityDepositsReceived decimal.Decimal `json:"security_deposits_received"` // Cauções recebidas no período, fora da receita
 }
 
 // TypeRevenue representa receita por tipo de pagamento
@@ -92,41 +109,64 @@ type UnitRevenue struct {
 	Count      int             `json:"count"`
 }
 
+// PropertyRevenue representa receita por imóvel
+type PropertyRevenue struct {
+	PropertyID   uuid.UUID       `json:"property_id"`
+	PropertyName string          `json:"property_name"`
+	Amount       decimal.Decimal `json:"amount"`
+	Count        int             `json:"count"`
+}
+
 // Erro customizado
 var ErrInvalidDateRange = errors.New("end date must be after start date")
 
 // GetFinancialReport gera um relatório financeiro consolidado
+// Filtros e agrupamentos são feitos no banco, usando o índice da data de competência
 func (s *ReportService) GetFinancialReport(ctx context.Context, req FinancialReportRequest) (*FinancialReportResponse, error) {
 	// 1. Validar datas
 	if req.EndDate.Before(req.StartDate) {
 		return nil, ErrInvalidDateRange
 	}
 
-	// 2. Buscar todos os pagamentos no período
-	allPayments, err := s.paymentRepo.List(ctx)
+	filter := repository.FinancialReportFilter{
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+		PaymentType: req.PaymentType,
+		Status:      req.Status,
+		PropertyID:  req.PropertyID,
+	}
+
+	// 2. Calcular resumo financeiro
+	totals, err := s.reportRepo.GetFinancialTotals(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	// 3. Filtrar pagamentos pelo período e filtros opcionais
-	filteredPayments := s.filterPayments(allPayments, req)
-
-	// 4. Calcular resumo financeiro
-	summary := s.calculateSummary(filteredPayments)
+	// 3. Agrupar por tipo
+	byType, err := s.groupByType(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
 
-	// 5. Agrupar por tipo
-	byType := s.groupByType(filteredPayments)
+	// 4. Agrupar por mês
+	byMonth, err := s.groupByMonth(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
 
-	// 6. Agrupar por mês
-	byMonth := s.groupByMonth(filteredPayments, req.StartDate, req.EndDate)
+	// 5. Agrupar por unidade
+	byUnit, err := s.groupByUnit(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
 
-	// 7. Agrupar por unidade
-	byUnit, err := s.groupByUnit(ctx, filteredPayments)
+	// 6. Agrupar por imóvel
+	byProperty, err := s.groupByProperty(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	// 8. Calcular dias no período
+	// 7. Calcular dias no período
 	days := int(req.EndDate.Sub(req.StartDate).Hours() / 24)
 
 	return &FinancialReportResponse{
@@ -135,196 +175,114 @@ func (s *ReportService) GetFinancialReport(ctx context.Context, req FinancialRep
 			EndDate:   req.EndDate,
 			Days:      days,
 		},
-		Summary:       summary,
+		Summary: FinancialSummary{
+			TotalRevenue:             totals.TotalAmount,
+			PaidAmount:               totals.PaidAmount,
+			PendingAmount:            totals.PendingAmount,
+			OverdueAmount:            totals.OverdueAmount,
+			CancelledAmount:          totals.CancelledAmount,
+			SecurityDepositsReceived: totals.SecurityDepositAmount,
+		},
 		ByType:        byType,
 		ByMonth:       byMonth,
 		ByUnit:        byUnit,
-		TotalPayments: len(filteredPayments),
+		ByProperty:    byProperty,
+		TotalPayments: int(totals.PaymentCount),
 		GeneratedAt:   time.Now(),
 	}, nil
 }
 
-// filterPayments filtra pagamentos baseado nos critérios da request
-func (s *ReportService) filterPayments(payments []*domain.Payment, req FinancialReportRequest) []*domain.Payment {
-	filtered := make([]*domain.Payment, 0)
-
-	for _, p := range payments {
-		// Filtrar por data (usar payment_date se pago, senão due_date)
-		dateToCompare := p.DueDate
-		if p.Status == domain.PaymentStatusPaid && p.PaymentDate != nil {
-			dateToCompare = *p.PaymentDate
-		}
-
-		// Verificar se está no período
-		if dateToCompare.Before(req.StartDate) || dateToCompare.After(req.EndDate) {
-			continue
-		}
-
-		// Filtrar por tipo (se especificado)
-		if req.PaymentType != nil && p.PaymentType != *req.PaymentType {
-			continue
-		}
-
-		// Filtrar por status (se especificado)
-		if req.Status != nil && p.Status != *req.Status {
-			continue
-		}
-
-		filtered = append(filtered, p)
-	}
-
-	return filtered
-}
-
-// calculateSummary calcula o resumo financeiro
-func (s *ReportService) calculateSummary(payments []*domain.Payment) FinancialSummary {
-	summary := FinancialSummary{
-		TotalRevenue:    decimal.Zero,
-		PaidAmount:      decimal.Zero,
-		PendingAmount:   decimal.Zero,
-		OverdueAmount:   decimal.Zero,
-		CancelledAmount: decimal.Zero,
-	}
-
-	for _, p := range payments {
-		summary.TotalRevenue = summary.TotalRevenue.Add(p.Amount)
-
-		switch p.Status {
-		case domain.PaymentStatusPaid:
-			summary.PaidAmount = summary.PaidAmount.Add(p.Amount)
-		case domain.PaymentStatusPending:
-			summary.PendingAmount = summary.PendingAmount.Add(p.Amount)
-		case domain.PaymentStatusOverdue:
-			summary.OverdueAmount = summary.OverdueAmount.Add(p.Amount)
-		case domain.PaymentStatusCancelled:
-			summary.CancelledAmount = summary.CancelledAmount.Add(p.Amount)
-		}
-	}
-
-	return summary
-}
-
 // groupByType agrupa pagamentos por tipo
-func (s *ReportService) groupByType(payments []*domain.Payment) map[string]TypeRevenue {
-	typeMap := make(map[string]decimal.Decimal)
-	typeCount := make(map[string]int)
-
-	for _, p := range payments {
-		typeStr := string(p.PaymentType)
-		typeMap[typeStr] = typeMap[typeStr].Add(p.Amount)
-		typeCount[typeStr]++
+func (s *ReportService) groupByType(ctx context.Context, filter repository.FinancialReportFilter) (map[string]TypeRevenue, error) {
+	rows, err := s.reportRepo.GetRevenueByType(ctx, filter)
+	if err != nil {
+		return nil, err
 	}
 
-	result := make(map[string]TypeRevenue)
-	for typeStr, amount := range typeMap {
+	result := make(map[string]TypeRevenue, len(rows))
+	for _, row := range rows {
+		typeStr := string(row.PaymentType)
 		result[typeStr] = TypeRevenue{
 			Type:   typeStr,
-			Amount: amount,
-			Count:  typeCount[typeStr],
+			Amount: row.Amount,
+			Count:  int(row.PaymentCount),
 		}
 	}
 
-	return result
+	return result, nil
 }
 
-// groupByMonth agrupa pagamentos por mês
-func (s *ReportService) groupByMonth(payments []*domain.Payment, startDate, endDate time.Time) []MonthlyRevenue {
-	monthMap := make(map[string]decimal.Decimal)
-	monthCount := make(map[string]int)
-
-	for _, p := range payments {
-		// Usar payment_date se pago, senão due_date
-		dateToUse := p.DueDate
-		if p.Status == domain.PaymentStatusPaid && p.PaymentDate != nil {
-			dateToUse = *p.PaymentDate
-		}
+// groupByMonth agrupa pagamentos por mês, incluindo os meses sem pagamentos do período
+func (s *ReportService) groupByMonth(ctx context.Context, filter repository.FinancialReportFilter) ([]MonthlyRevenue, error) {
+	rows, err := s.reportRepo.GetRevenueByMonth(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
 
-		monthKey := dateToUse.Format("2006-01")
-		monthMap[monthKey] = monthMap[monthKey].Add(p.Amount)
-		monthCount[monthKey]++
+	monthRows := make(map[string]*repository.RevenueByMonth, len(rows))
+	for _, row := range rows {
+		monthRows[row.Month.Format("2006-01")] = row
 	}
 
 	// Criar slice ordenado por mês
 	result := make([]MonthlyRevenue, 0)
-	current := time.Date(startDate.Year(), startDate.Month(), 1, 0, 0, 0, 0, time.UTC)
-	end := time.Date(endDate.Year(), endDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+	current := time.Date(filter.StartDate.Year(), filter.StartDate.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(filter.EndDate.Year(), filter.EndDate.Month(), 1, 0, 0, 0, 0, time.UTC)
 
 	for !current.After(end) {
 		monthKey := current.Format("2006-01")
-		amount := monthMap[monthKey]
-		count := monthCount[monthKey]
-
-		result = append(result, MonthlyRevenue{
+		revenue := MonthlyRevenue{
 			Month:  monthKey,
 			Year:   current.Year(),
-			Amount: amount,
-			Count:  count,
-		})
+			Amount: decimal.Zero,
+		}
+		if row, ok := monthRows[monthKey]; ok {
+			revenue.Amount = row.Amount
+			revenue.Count = int(row.PaymentCount)
+		}
+		result = append(result, revenue)
 
 		current = current.AddDate(0, 1, 0)
 	}
 
-	return result
+	return result, nil
 }
 
 // groupByUnit agrupa pagamentos por unidade
-func (s *ReportService) groupByUnit(ctx context.Context, payments []*domain.Payment) ([]UnitRevenue, error) {
-	// Mapear lease_id -> unit_id e unit_number
-	leaseUnitMap := make(map[uuid.UUID]struct {
-		UnitID     uuid.UUID
-		UnitNumber string
-	})
-
-	unitAmountMap := make(map[uuid.UUID]decimal.Decimal)
-	unitCountMap := make(map[uuid.UUID]int)
-
-	for _, p := range payments {
-		// Se já temos o mapeamento do lease, usa
-		if _, exists := leaseUnitMap[p.LeaseID]; !exists {
-			// Buscar o lease para pegar o unit_id
-			lease, err := s.leaseRepo.GetByID(ctx, p.LeaseID)
-			if err != nil {
-				continue // Skip se não encontrar o lease
-			}
-
-			// Buscar a unit para pegar o número
-			unit, err := s.unitRepo.GetByID(ctx, lease.UnitID)
-			if err != nil {
-				continue // Skip se não encontrar a unit
-			}
+func (s *ReportService) groupByUnit(ctx context.Context, filter repository.FinancialReportFilter) ([]UnitRevenue, error) {
+	rows, err := s.reportRepo.GetRevenueByUnit(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
 
-			leaseUnitMap[p.LeaseID] = struct {
-				UnitID     uuid.UUID
-				UnitNumber string
-			}{
-				UnitID:     unit.ID,
-				UnitNumber: unit.Number,
-			}
+	result := make([]UnitRevenue, len(rows))
+	for i, row := range rows {
+		result[i] = UnitRevenue{
+			UnitID:     row.UnitID,
+			UnitNumber: row.UnitNumber,
+			Amount:     row.Amount,
+			Count:      int(row.PaymentCount),
 		}
+	}
 
-		unitInfo := leaseUnitMap[p.LeaseID]
-		unitAmountMap[unitInfo.UnitID] = unitAmountMap[unitInfo.UnitID].Add(p.Amount)
-		unitCountMap[unitInfo.UnitID]++
+	return result, nil
+}
+
+// groupByProperty agrupa pagamentos por imóvel
+func (s *ReportService) groupByProperty(ctx context.Context, filter repository.FinancialReportFilter) ([]PropertyRevenue, error) {
+	rows, err := s.reportRepo.GetRevenueByProperty(ctx, filter)
+	if err != nil {
+		return nil, err
 	}
 
-	// Converter map para slice
-	result := make([]UnitRevenue, 0, len(unitAmountMap))
-	for unitID, amount := range unitAmountMap {
-		// Buscar o número da unidade do cache
-		var unitNumber string
-		for _, info := range leaseUnitMap {
-			if info.UnitID == unitID {
-				unitNumber = info.UnitNumber
-				break
-			}
+	result := make([]PropertyRevenue, len(rows))
+	for i, row := range rows {
+		result[i] = PropertyRevenue{
+			PropertyID:   row.PropertyID,
+			PropertyName: row.PropertyName,
+			Amount:       row.Amount,
+			Count:        int(row.PaymentCount),
 		}
-
-		result = append(result, UnitRevenue{
-			UnitID:     unitID,
-			UnitNumber: unitNumber,
-			Amount:     amount,
-			Count:      unitCountMap[unitID],
-		})
 	}
 
 	return result, nil
@@ -332,18 +290,25 @@ func (s *ReportService) groupByUnit(ctx context.Context, payments []*domain.Paym
 
 // PaymentHistoryRequest representa os filtros para histórico de pagamentos
 type PaymentHistoryRequest struct {
-	LeaseID   *uuid.UUID            `json:"lease_id,omitempty"`
-	TenantID  *uuid.UUID            `json:"tenant_id,omitempty"`
-	Status    *domain.PaymentStatus `json:"status,omitempty"`
-	StartDate *time.Time            `json:"start_date,omitempty"`
-	EndDate   *time.Time            `json:"end_date,omitempty"`
+	LeaseID    *uuid.UUID            `json:"lease_id,omitempty"`
+	TenantID   *uuid.UUID            `json:"tenant_id,omitempty"`
+	PropertyID *uuid.UUID            `json:"property_id,omitempty"`
+	Status     *domain.PaymentStatus `json:"status,omitempty"`
+	StartDate  *time.Time            `json:"start_date,omitempty"`
+	EndDate    *time.Time            `json:"end_date,omitempty"`
+	Page       int                   `json:"page,omitempty"`      // Começa em 1 (padrão 1)
+	PageSize   int                   `json:"page_size,omitempty"` // Padrão 50, máximo 500
 }
 
 // PaymentHistoryResponse representa o histórico de pagamentos
+// TotalCount e TotalAmount consideram todos os pagamentos do filtro, não apenas a página
 type PaymentHistoryResponse struct {
 	Payments    []PaymentHistoryItem `json:"payments"`
 	TotalCount  int                  `json:"total_count"`
 	TotalAmount decimal.Decimal      `json:"total_amount"`
+	Page        int                  `json:"page"`
+	PageSize    int                  `json:"page_size"`
+	TotalPages  int                  `json:"total_pages"`
 	GeneratedAt time.Time            `json:"generated_at"`
 }
 
@@ -362,95 +327,100 @@ type PaymentHistoryItem struct {
 	CreatedAt     time.Time             `json:"created_at"`
 }
 
-// GetPaymentHistoryReport gera um relatório de histórico de pagamentos
+// GetPaymentHistoryReport gera uma página do relatório de histórico de pagamentos
 func (s *ReportService) GetPaymentHistoryReport(ctx context.Context, req PaymentHistoryRequest) (*PaymentHistoryResponse, error) {
-	// 1. Buscar pagamentos baseado nos filtros
-	var payments []*domain.Payment
-	var err error
-
-	if req.LeaseID != nil {
-		payments, err = s.paymentRepo.ListByLeaseID(ctx, *req.LeaseID)
-	} else if req.Status != nil {
-		payments, err = s.paymentRepo.ListByStatus(ctx, *req.Status)
-	} else {
-		payments, err = s.paymentRepo.List(ctx)
+	// 1. Normalizar paginação
+	page := req.Page
+	if page <= 0 {
+		page = 1
 	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = PaymentHistoryDefaultPageSize
+	}
+	if pageSize > PaymentHistoryMaxPageSize {
+		pageSize = PaymentHistoryMaxPageSize
+	}
+
+	filter := paymentHistoryFilter(req)
 
+	// 2. Totais de todos os pagamentos do filtro
+	totals, err := s.reportRepo.GetPaymentHistoryTotals(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	// 2. Aplicar filtros adicionais
-	filtered := s.filterPaymentHistory(payments, req)
-
-	// 3. Enriquecer com dados de lease, unit e tenant
-	items := make([]PaymentHistoryItem, 0, len(filtered))
-	totalAmount := decimal.Zero
-
-	for _, p := range filtered {
-		// Buscar lease
-		lease, err := s.leaseRepo.GetByID(ctx, p.LeaseID)
-		if err != nil {
-			continue // Skip se não encontrar
-		}
-
-		// Filtrar por tenant_id se especificado
-		if req.TenantID != nil && lease.TenantID != *req.TenantID {
-			continue
-		}
-
-		// Buscar unit
-		unit, err := s.unitRepo.GetByID(ctx, lease.UnitID)
-		if err != nil {
-			continue
-		}
-
-		// Buscar tenant
-		tenant, err := s.tenantRepo.GetByID(ctx, lease.TenantID)
-		if err != nil {
-			continue
-		}
+	// 3. Buscar a página com unidade e morador
+	rows, err := s.reportRepo.ListPaymentHistory(ctx, filter, pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, err
+	}
 
-		items = append(items, PaymentHistoryItem{
-			PaymentID:     p.ID,
-			LeaseID:       p.LeaseID,
-			UnitNumber:    unit.Number,
-			TenantName:    tenant.FullName,
-			PaymentType:   p.PaymentType,
-			Amount:        p.Amount,
-			Status:        p.Status,
-			DueDate:       p.DueDate,
-			PaymentDate:   p.PaymentDate,
-			PaymentMethod: p.PaymentMethod,
-			CreatedAt:     p.CreatedAt,
-		})
-
-		totalAmount = totalAmount.Add(p.Amount)
+	items := make([]PaymentHistoryItem, len(rows))
+	for i, row := range rows {
+		items[i] = toPaymentHistoryItem(row)
 	}
 
+	totalCount := int(totals.PaymentCount)
 	return &PaymentHistoryResponse{
 		Payments:    items,
-		TotalCount:  len(items),
-		TotalAmount: totalAmount,
+		TotalCount:  totalCount,
+		TotalAmount: totals.Amount,
+		Page:        page,
+		PageSize:    pageSize,
+		TotalPages:  (totalCount + pageSize - 1) / pageSize,
 		GeneratedAt: time.Now(),
 	}, nil
 }
 
-// filterPaymentHistory filtra pagamentos para o histórico
-func (s *ReportService) filterPaymentHistory(payments []*domain.Payment, req PaymentHistoryRequest) []*domain.Payment {
-	filtered := make([]*domain.Payment, 0)
+// StreamPaymentHistory percorre todo o histórico de pagamentos do filtro (ignorando a paginação)
+// entregando um item por vez, lido do banco em lotes. Usado nas exportações
+func (s *ReportService) StreamPaymentHistory(ctx context.Context, req PaymentHistoryRequest, fn func(PaymentHistoryItem) error) error {
+	filter := paymentHistoryFilter(req)
 
-	for _, p := range payments {
-		// Filtrar por data range
-		if req.StartDate != nil && p.DueDate.Before(*req.StartDate) {
-			continue
+	for offset := 0; ; offset += paymentHistoryStreamBatch {
+		rows, err := s.reportRepo.ListPaymentHistory(ctx, filter, paymentHistoryStreamBatch, offset)
+		if err != nil {
+			return err
 		}
-		if req.EndDate != nil && p.DueDate.After(*req.EndDate) {
-			continue
+
+		for _, row := range rows {
+			if err := fn(toPaymentHistoryItem(row)); err != nil {
+				return err
+			}
 		}
 
-		filtered = append(filtered, p)
+		if len(rows) < paymentHistoryStreamBatch {
+			return nil
+		}
 	}
+}
 
-	return filtered
+// paymentHistoryFilter converte os filtros da requisição para o repository
+func paymentHistoryFilter(req PaymentHistoryRequest) repository.PaymentHistoryFilter {
+	return repository.PaymentHistoryFilter{
+		LeaseID:    req.LeaseID,
+		TenantID:   req.TenantID,
+		PropertyID: req.PropertyID,
+		Status:     req.Status,
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+	}
+}
+
+// toPaymentHistoryItem converte a linha do histórico para o item do relatório
+func toPaymentHistoryItem(row *repository.PaymentHistoryRow) PaymentHistoryItem {
+	return PaymentHistoryItem{
+		PaymentID:     row.PaymentID,
+		LeaseID:       row.LeaseID,
+		UnitNumber:    row.UnitNumber,
+		TenantName:    row.TenantName,
+		PaymentType:   row.PaymentType,
+		Amount:        row.Amount,
+		Status:        row.Status,
+		DueDate:       row.DueDate,
+		PaymentDate:   row.PaymentDate,
+		PaymentMethod: row.PaymentMethod,
+		CreatedAt:     row.CreatedAt,
+	}
 }
@@ -1,96 +1,136 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
-// Helper function para criar pagamentos de teste
-func createTestPayments() []*domain.Payment {
-	leaseID := uuid.New()
+// MockReportRepo é um mock do ReportRepository
+type MockReportRepo struct {
+	mock.Mock
+}
+
+func (m *MockReportRepo) GetFinancialTotals(ctx context.Context, filter repository.FinancialReportFilter) (*repository.FinancialTotals, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.FinancialTotals), args.Error(1)
+}
+
+func (m *MockReportRepo) GetRevenueByType(ctx context.Context, filter repository.FinancialReportFilter) ([]*repository.RevenueByType, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*repository.RevenueByType), args.Error(1)
+}
+
+func (m *MockReportRepo) GetRevenueByMonth(ctx context.Context, filter repository.FinancialReportFilter) ([]*repository.RevenueByMonth, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*repository.RevenueByMonth), args.Error(1)
+}
+
+func (m *MockReportRepo) GetRevenueByUnit(ctx context.Context, filter repository.FinancialReportFilter) ([]*repository.RevenueByUnit, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*repository.RevenueByUnit), args.Error(1)
+}
+
+func (m *MockReportRepo) GetRevenueByProperty(ctx context.Context, filter repository.FinancialReportFilter) ([]*repository.RevenueByProperty, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*repository.RevenueByProperty), args.Error(1)
+}
+
+func (m *MockReportRepo) ListPaymentHistory(ctx context.Context, filter repository.PaymentHistoryFilter, limit, offset int) ([]*repository.PaymentHistoryRow, error) {
+	args := m.Called(ctx, filter, limit, offset)
+	return args.Get(0).([]*repository.PaymentHistoryRow), args.Error(1)
+}
+
+func (m *MockReportRepo) GetPaymentHistoryTotals(ctx context.Context, filter repository.PaymentHistoryFilter) (*repository.PaymentHistoryTotals, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.PaymentHistoryTotals), args.Error(1)
+}
 
-	// Datas de pagamento dentro do período do teste (2024-03-01 a 2024-04-30)
-	paymentDate1 := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
-	paymentDate3 := time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)
-
-	return []*domain.Payment{
-		{
-			ID:             uuid.New(),
-			LeaseID:        leaseID,
-			PaymentType:    domain.PaymentTypeRent,
-			ReferenceMonth: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
-			Amount:         decimal.NewFromInt(800),
-			Status:         domain.PaymentStatusPaid,
-			DueDate:        time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC),
-			PaymentDate:    &paymentDate1, // Data de pagamento em março de 2024
-			CreatedAt:      time.Now(),
-			UpdatedAt:      time.Now(),
-		},
-		{
-			ID:             uuid.New(),
-			LeaseID:        leaseID,
-			PaymentType:    domain.PaymentTypeRent,
-			ReferenceMonth: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
-			Amount:         decimal.NewFromInt(800),
-			Status:         domain.PaymentStatusPending,
-			DueDate:        time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC),
-			PaymentDate:    nil, // Pending, sem data de pagamento - usa DueDate
-			CreatedAt:      time.Now(),
-			UpdatedAt:      time.Now(),
-		},
-		{
-			ID:             uuid.New(),
-			LeaseID:        leaseID,
-			PaymentType:    domain.PaymentTypePaintingFee,
-			ReferenceMonth: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
-			Amount:         decimal.NewFromInt(250),
-			Status:         domain.PaymentStatusPaid,
-			DueDate:        time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC),
-			PaymentDate:    &paymentDate3, // Data de pagamento em março de 2024
-			CreatedAt:      time.Now(),
-			UpdatedAt:      time.Now(),
-		},
+// Helper function para configurar os agregados de um relatório financeiro de teste
+// Pagamentos: aluguel pago (800, mar/2024), aluguel pendente (800, abr/2024) e pintura paga (250, mar/2024)
+// A caução recebida (1600, mar/2024) vem à parte, fora da receita
+func mockFinancialReport(mockReportRepo *MockReportRepo, ctx context.Context, filter repository.FinancialReportFilter) {
+	unitID := uuid.New()
+
+	mockReportRepo.On("GetFinancialTotals", ctx, filter).Return(&repository.FinancialTotals{
+		PaymentCount:  3,
+		TotalAmount:   decimal.NewFromInt(1850),
+		PaidAmount:    decimal.NewFromInt(1050),
+		PendingAmount: decimal.NewFromInt(800),
+
+		SecurityDepositAmount: decimal.NewFromInt(1600),
+	}, nil)
+	mockReportRepo.On("GetRevenueByType", ctx, filter).Return([]*repository.RevenueByType{
+		{PaymentType: domain.PaymentTypePaintingFee, PaymentCount: 1, Amount: decimal.NewFromInt(250)},
+		{PaymentType: domain.PaymentTypeRent, PaymentCount: 2, Amount: decimal.NewFromInt(1600)},
+	}, nil)
+	mockReportRepo.On("GetRevenueByMonth", ctx, filter).Return([]*repository.RevenueByMonth{
+		{Month: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), PaymentCount: 2, Amount: decimal.NewFromInt(1050)},
+		{Month: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), PaymentCount: 1, Amount: decimal.NewFromInt(800)},
+	}, nil)
+	mockReportRepo.On("GetRevenueByUnit", ctx, filter).Return([]*repository.RevenueByUnit{
+		{UnitID: unitID, UnitNumber: "101", PaymentCount: 3, Amount: decimal.NewFromInt(1850)},
+	}, nil)
+	mockReportRepo.On("GetRevenueByProperty", ctx, filter).Return([]*repository.RevenueByProperty{
+		{PropertyID: uuid.New(), PropertyName: "Kitnets Gabriel", PaymentCount: 3, Amount: decimal.NewFromInt(1850)},
+	}, nil)
+}
+
+// Helper function para criar linhas do histórico de pagamentos de teste
+func createTestHistoryRows(count int) []*repository.PaymentHistoryRow {
+	leaseID := uuid.New()
+	paymentDate := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	rows := make([]*repository.PaymentHistoryRow, count)
+	for i := range rows {
+		rows[i] = &repository.PaymentHistoryRow{
+			PaymentID:   uuid.New(),
+			LeaseID:     leaseID,
+			UnitNumber:  "101",
+			TenantName:  "Test Tenant",
+			PaymentType: domain.PaymentTypeRent,
+			Amount:      decimal.NewFromInt(800),
+			Status:      domain.PaymentStatusPaid,
+			DueDate:     time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC),
+			PaymentDate: &paymentDate,
+			CreatedAt:   time.Now(),
+		}
 	}
+	return rows
 }
 
 // Test GetFinancialReport - Success
 func TestGetFinancialReport_Success(t *testing.T) {
 	// Arrange
-	mockPaymentRepo := new(MockPaymentRepo)
-	mockLeaseRepo := new(MockLeaseRepo)
-	mockUnitRepo := new(MockUnitRepo)
-	mockTenantRepo := new(MockTenantRepo)
-	service := NewReportService(mockPaymentRepo, mockLeaseRepo, mockUnitRepo, mockTenantRepo)
+	mockReportRepo := new(MockReportRepo)
+	service := NewReportService(mockReportRepo, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
-	payments := createTestPayments()
 
 	req := FinancialReportRequest{
 		StartDate: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
 		EndDate:   time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
 	}
 
-	// Mock repositories
-	mockPaymentRepo.On("List", ctx).Return(payments, nil)
-
-	// Mock lease and unit for groupByUnit
-	lease := &domain.Lease{
-		ID:     payments[0].LeaseID,
-		UnitID: uuid.New(),
-	}
-	unit := &domain.Unit{
-		ID:     lease.UnitID,
-		Number: "101",
-	}
-
-	mockLeaseRepo.On("GetByID", ctx, payments[0].LeaseID).Return(lease, nil)
-	mockUnitRepo.On("GetByID", ctx, lease.UnitID).Return(unit, nil)
+	mockFinancialReport(mockReportRepo, ctx, repository.FinancialReportFilter{
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	})
 
 	// Act
 	report, err := service.GetFinancialReport(ctx, req)
@@ -105,38 +145,34 @@ func TestGetFinancialReport_Success(t *testing.T) {
 	assert.Equal(t, req.EndDate, report.Period.EndDate)
 
 	// Verificar summary
-	expectedTotal := decimal.NewFromInt(1850) // 800 + 800 + 250
-	assert.Equal(t, expectedTotal, report.Summary.TotalRevenue)
-	assert.Equal(t, decimal.NewFromInt(1050), report.Summary.PaidAmount)   // 800 + 250
-	assert.Equal(t, decimal.NewFromInt(800), report.Summary.PendingAmount) // 800
+	assert.Equal(t, decimal.NewFromInt(1850), report.Summary.TotalRevenue)
+	assert.Equal(t, decimal.NewFromInt(1050), report.Summary.PaidAmount)
+	assert.Equal(t, decimal.NewFromInt(800), report.Summary.PendingAmount)
+	assert.Equal(t, decimal.NewFromInt(1600), report.Summary.SecurityDepositsReceived)
 
 	// Verificar agrupamento por tipo
 	assert.Len(t, report.ByType, 2)
-	assert.Contains(t, report.ByType, "rent")
-	assert.Contains(t, report.ByType, "painting_fee")
 	assert.Equal(t, decimal.NewFromInt(1600), report.ByType["rent"].Amount)
 	assert.Equal(t, 2, report.ByType["rent"].Count)
 
 	// Verificar agrupamento por mês
-	assert.True(t, len(report.ByMonth) >= 2)
+	assert.Len(t, report.ByMonth, 2)
+	assert.Equal(t, "2024-03", report.ByMonth[0].Month)
+	assert.Equal(t, decimal.NewFromInt(1050), report.ByMonth[0].Amount)
 
-	// Verificar agrupamento por unidade
+	// Verificar agrupamento por unidade e imóvel
 	assert.Len(t, report.ByUnit, 1)
 	assert.Equal(t, "101", report.ByUnit[0].UnitNumber)
+	assert.Len(t, report.ByProperty, 1)
 
-	mockPaymentRepo.AssertExpectations(t)
-	mockLeaseRepo.AssertExpectations(t)
-	mockUnitRepo.AssertExpectations(t)
+	mockReportRepo.AssertExpectations(t)
 }
 
 // Test GetFinancialReport - Invalid date range
 func TestGetFinancialReport_InvalidDateRange(t *testing.T) {
 	// Arrange
-	mockPaymentRepo := new(MockPaymentRepo)
-	mockLeaseRepo := new(MockLeaseRepo)
-	mockUnitRepo := new(MockUnitRepo)
-	mockTenantRepo := new(MockTenantRepo)
-	service := NewReportService(mockPaymentRepo, mockLeaseRepo, mockUnitRepo, mockTenantRepo)
+	mockReportRepo := new(MockReportRepo)
+	service := NewReportService(mockReportRepo, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 
@@ -152,41 +188,32 @@ func TestGetFinancialReport_InvalidDateRange(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, ErrInvalidDateRange, err)
 	assert.Nil(t, report)
+	mockReportRepo.AssertNotCalled(t, "GetFinancialTotals", mock.Anything, mock.Anything)
 }
 
-// Test GetFinancialReport - Filter by payment type
+// Test GetFinancialReport - Filtros repassados ao repository
 func TestGetFinancialReport_FilterByPaymentType(t *testing.T) {
 	// Arrange
-	mockPaymentRepo := new(MockPaymentRepo)
-	mockLeaseRepo := new(MockLeaseRepo)
-	mockUnitRepo := new(MockUnitRepo)
-	mockTenantRepo := new(MockTenantRepo)
-	service := NewReportService(mockPaymentRepo, mockLeaseRepo, mockUnitRepo, mockTenantRepo)
+	mockReportRepo := new(MockReportRepo)
+	service := NewReportService(mockReportRepo, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
-	payments := createTestPayments()
 
 	rentType := domain.PaymentTypeRent
+	propertyID := uuid.New()
 	req := FinancialReportRequest{
 		StartDate:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
 		EndDate:     time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
 		PaymentType: &rentType,
+		PropertyID:  &propertyID,
 	}
 
-	mockPaymentRepo.On("List", ctx).Return(payments, nil)
-
-	// Mock lease and unit
-	lease := &domain.Lease{
-		ID:     payments[0].LeaseID,
-		UnitID: uuid.New(),
-	}
-	unit := &domain.Unit{
-		ID:     lease.UnitID,
-		Number: "101",
-	}
-
-	mockLeaseRepo.On("GetByID", ctx, payments[0].LeaseID).Return(lease, nil)
-	mockUnitRepo.On("GetByID", ctx, lease.UnitID).Return(unit, nil)
+	mockFinancialReport(mockReportRepo, ctx, repository.FinancialReportFilter{
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+		PaymentType: &rentType,
+		PropertyID:  &propertyID,
+	})
 
 	// Act
 	report, err := service.GetFinancialReport(ctx, req)
@@ -194,24 +221,14 @@ func TestGetFinancialReport_FilterByPaymentType(t *testing.T) {
 	// Assert
 	assert.NoError(t, err)
 	assert.NotNil(t, report)
-	assert.Equal(t, 2, report.TotalPayments) // Apenas rent, não painting_fee
-
-	// Verificar que só tem rent
-	assert.Len(t, report.ByType, 1)
-	assert.Contains(t, report.ByType, "rent")
-	assert.NotContains(t, report.ByType, "painting_fee")
-
-	mockPaymentRepo.AssertExpectations(t)
+	mockReportRepo.AssertExpectations(t)
 }
 
 // Test GetFinancialReport - Empty payments
 func TestGetFinancialReport_EmptyPayments(t *testing.T) {
 	// Arrange
-	mockPaymentRepo := new(MockPaymentRepo)
-	mockLeaseRepo := new(MockLeaseRepo)
-	mockUnitRepo := new(MockUnitRepo)
-	mockTenantRepo := new(MockTenantRepo)
-	service := NewReportService(mockPaymentRepo, mockLeaseRepo, mockUnitRepo, mockTenantRepo)
+	mockReportRepo := new(MockReportRepo)
+	service := NewReportService(mockReportRepo, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
 
@@ -220,7 +237,12 @@ func TestGetFinancialReport_EmptyPayments(t *testing.T) {
 		EndDate:   time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
 	}
 
-	mockPaymentRepo.On("List", ctx).Return([]*domain.Payment{}, nil)
+	filter := repository.FinancialReportFilter{StartDate: req.StartDate, EndDate: req.EndDate}
+	mockReportRepo.On("GetFinancialTotals", ctx, filter).Return(&repository.FinancialTotals{}, nil)
+	mockReportRepo.On("GetRevenueByType", ctx, filter).Return([]*repository.RevenueByType{}, nil)
+	mockReportRepo.On("GetRevenueByMonth", ctx, filter).Return([]*repository.RevenueByMonth{}, nil)
+	mockReportRepo.On("GetRevenueByUnit", ctx, filter).Return([]*repository.RevenueByUnit{}, nil)
+	mockReportRepo.On("GetRevenueByProperty", ctx, filter).Return([]*repository.RevenueByProperty{}, nil)
 
 	// Act
 	report, err := service.GetFinancialReport(ctx, req)
@@ -233,47 +255,32 @@ func TestGetFinancialReport_EmptyPayments(t *testing.T) {
 	assert.Len(t, report.ByType, 0)
 	assert.Len(t, report.ByUnit, 0)
 
-	mockPaymentRepo.AssertExpectations(t)
+	// Meses sem pagamentos continuam no relatório com valor zero
+	assert.Len(t, report.ByMonth, 2)
+	assert.True(t, report.ByMonth[1].Amount.IsZero())
+
+	mockReportRepo.AssertExpectations(t)
 }
 
-// Test GetPaymentHistoryReport - Success
-func TestGetPaymentHistoryReport_Success(t *testing.T) {
+// Test GetPaymentHistoryReport - Filter by LeaseID
+func TestGetPaymentHistoryReport_FilterByLeaseID(t *testing.T) {
 	// Arrange
-	mockPaymentRepo := new(MockPaymentRepo)
-	mockLeaseRepo := new(MockLeaseRepo)
-	mockUnitRepo := new(MockUnitRepo)
-	mockTenantRepo := new(MockTenantRepo)
-	service := NewReportService(mockPaymentRepo, mockLeaseRepo, mockUnitRepo, mockTenantRepo)
+	mockReportRepo := new(MockReportRepo)
+	service := NewReportService(mockReportRepo, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
-	payments := createTestPayments()
+	rows := createTestHistoryRows(3)
+	leaseID := rows[0].LeaseID
 
-	req := PaymentHistoryRequest{}
-
-	// Mock repositories
-	mockPaymentRepo.On("List", ctx).Return(payments, nil)
-
-	// Mock lease, unit, tenant para cada payment
-	for i, p := range payments {
-		lease := &domain.Lease{
-			ID:       p.LeaseID,
-			UnitID:   uuid.New(),
-			TenantID: uuid.New(),
-		}
-		unit := &domain.Unit{
-			ID:     lease.UnitID,
-			Number: "10" + string(rune('1'+i)),
-		}
-		tenant := &domain.Tenant{
-			ID:       lease.TenantID,
-			FullName: "Test Tenant " + string(rune('A'+i)),
-		}
-
-		mockLeaseRepo.On("GetByID", ctx, p.LeaseID).Return(lease, nil).Once()
-		mockUnitRepo.On("GetByID", ctx, lease.UnitID).Return(unit, nil).Once()
-		mockTenantRepo.On("GetByID", ctx, lease.TenantID).Return(tenant, nil).Once()
+	req := PaymentHistoryRequest{
+		LeaseID: &leaseID,
 	}
 
+	filter := repository.PaymentHistoryFilter{LeaseID: &leaseID}
+	mockReportRepo.On("GetPaymentHistoryTotals", ctx, filter).
+		Return(&repository.PaymentHistoryTotals{PaymentCount: 3, Amount: decimal.NewFromInt(2400)}, nil)
+	mockReportRepo.On("ListPaymentHistory", ctx, filter, PaymentHistoryDefaultPageSize, 0).Return(rows, nil)
+
 	// Act
 	report, err := service.GetPaymentHistoryReport(ctx, req)
 
@@ -281,63 +288,65 @@ func TestGetPaymentHistoryReport_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, report)
 	assert.Equal(t, 3, report.TotalCount)
+	assert.Equal(t, decimal.NewFromInt(2400), report.TotalAmount)
 	assert.Len(t, report.Payments, 3)
+	assert.Equal(t, "101", report.Payments[0].UnitNumber)
+	assert.Equal(t, "Test Tenant", report.Payments[0].TenantName)
+	assert.Equal(t, 1, report.Page)
+	assert.Equal(t, 1, report.TotalPages)
 
-	// Verificar total amount
-	expectedTotal := decimal.NewFromInt(1850)
-	assert.Equal(t, expectedTotal, report.TotalAmount)
-
-	// Verificar que cada item tem os dados necessários
-	for _, item := range report.Payments {
-		assert.NotEqual(t, uuid.Nil, item.PaymentID)
-		assert.NotEqual(t, uuid.Nil, item.LeaseID)
-		assert.NotEmpty(t, item.UnitNumber)
-		assert.NotEmpty(t, item.TenantName)
-	}
-
-	mockPaymentRepo.AssertExpectations(t)
-	mockLeaseRepo.AssertExpectations(t)
-	mockUnitRepo.AssertExpectations(t)
-	mockTenantRepo.AssertExpectations(t)
+	mockReportRepo.AssertExpectations(t)
 }
 
-// Test GetPaymentHistoryReport - Filter by LeaseID
-func TestGetPaymentHistoryReport_FilterByLeaseID(t *testing.T) {
+// Test GetPaymentHistoryReport - Filter by Status
+func TestGetPaymentHistoryReport_FilterByStatus(t *testing.T) {
 	// Arrange
-	mockPaymentRepo := new(MockPaymentRepo)
-	mockLeaseRepo := new(MockLeaseRepo)
-	mockUnitRepo := new(MockUnitRepo)
-	mockTenantRepo := new(MockTenantRepo)
-	service := NewReportService(mockPaymentRepo, mockLeaseRepo, mockUnitRepo, mockTenantRepo)
+	mockReportRepo := new(MockReportRepo)
+	service := NewReportService(mockReportRepo, nil, nil, nil, nil, nil)
 
 	ctx := context.Background()
-	payments := createTestPayments()
-	leaseID := payments[0].LeaseID
 
+	status := domain.PaymentStatusPaid
 	req := PaymentHistoryRequest{
-		LeaseID: &leaseID,
+		Status: &status,
 	}
 
-	mockPaymentRepo.On("ListByLeaseID", ctx, leaseID).Return(payments, nil)
+	filter := repository.PaymentHistoryFilter{Status: &status}
+	mockReportRepo.On("GetPaymentHistoryTotals", ctx, filter).
+		Return(&repository.PaymentHistoryTotals{PaymentCount: 2, Amount: decimal.NewFromInt(1600)}, nil)
+	mockReportRepo.On("ListPaymentHistory", ctx, filter, PaymentHistoryDefaultPageSize, 0).Return(createTestHistoryRows(2), nil)
 
-	// Mock lease, unit, tenant
-	lease := &domain.Lease{
-		ID:       leaseID,
-		UnitID:   uuid.New(),
-		TenantID: uuid.New(),
-	}
-	unit := &domain.Unit{
-		ID:     lease.UnitID,
-		Number: "101",
-	}
-	tenant := &domain.Tenant{
-		ID:       lease.TenantID,
-		FullName: "Test Tenant",
+	// Act
+	report, err := service.GetPaymentHistoryReport(ctx, req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, report)
+	assert.Equal(t, 2, report.TotalCount)
+
+	mockReportRepo.AssertExpectations(t)
+}
+
+// Test GetPaymentHistoryReport - Filter by date range
+func TestGetPaymentHistoryReport_FilterByDateRange(t *testing.T) {
+	// Arrange
+	mockReportRepo := new(MockReportRepo)
+	service := NewReportService(mockReportRepo, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+
+	startDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	req := PaymentHistoryRequest{
+		StartDate: &startDate,
+		EndDate:   &endDate,
 	}
 
-	mockLeaseRepo.On("GetByID", ctx, leaseID).Return(lease, nil)
-	mockUnitRepo.On("GetByID", ctx, lease.UnitID).Return(unit, nil)
-	mockTenantRepo.On("GetByID", ctx, lease.TenantID).Return(tenant, nil)
+	filter := repository.PaymentHistoryFilter{StartDate: &startDate, EndDate: &endDate}
+	mockReportRepo.On("GetPaymentHistoryTotals", ctx, filter).
+		Return(&repository.PaymentHistoryTotals{PaymentCount: 2, Amount: decimal.NewFromInt(1600)}, nil)
+	mockReportRepo.On("ListPaymentHistory", ctx, filter, PaymentHistoryDefaultPageSize, 0).Return(createTestHistoryRows(2), nil)
 
 	// Act
 	report, err := service.GetPaymentHistoryReport(ctx, req)
@@ -345,118 +354,282 @@ func TestGetPaymentHistoryReport_FilterByLeaseID(t *testing.T) {
 	// Assert
 	assert.NoError(t, err)
 	assert.NotNil(t, report)
-	assert.Equal(t, 3, report.TotalCount)
+	assert.Equal(t, 2, report.TotalCount)
 
-	mockPaymentRepo.AssertExpectations(t)
+	mockReportRepo.AssertExpectations(t)
 }
 
-// Test GetPaymentHistoryReport - Filter by Status
-func TestGetPaymentHistoryReport_FilterByStatus(t *testing.T) {
+// Test GetPaymentHistoryReport - Paginação
+func TestGetPaymentHistoryReport_Pagination(t *testing.T) {
+	// Arrange
+	mockReportRepo := new(MockReportRepo)
+	service := NewReportService(mockReportRepo, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	filter := repository.PaymentHistoryFilter{}
+
+	mockReportRepo.On("GetPaymentHistoryTotals", ctx, filter).
+		Return(&repository.PaymentHistoryTotals{PaymentCount: 25, Amount: decimal.NewFromInt(20000)}, nil)
+	mockReportRepo.On("ListPaymentHistory", ctx, filter, 10, 20).Return(createTestHistoryRows(5), nil)
+
+	// Act
+	report, err := service.GetPaymentHistoryReport(ctx, PaymentHistoryRequest{Page: 3, PageSize: 10})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, report.Payments, 5)
+	assert.Equal(t, 25, report.TotalCount) // Total do filtro, não da página
+	assert.Equal(t, 3, report.Page)
+	assert.Equal(t, 10, report.PageSize)
+	assert.Equal(t, 3, report.TotalPages)
+
+	mockReportRepo.AssertExpectations(t)
+}
+
+// Test GetPaymentHistoryReport - Tamanho de página limitado ao máximo
+func TestGetPaymentHistoryReport_PageSizeCapped(t *testing.T) {
+	// Arrange
+	mockReportRepo := new(MockReportRepo)
+	service := NewReportService(mockReportRepo, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	filter := repository.PaymentHistoryFilter{}
+
+	mockReportRepo.On("GetPaymentHistoryTotals", ctx, filter).Return(&repository.PaymentHistoryTotals{}, nil)
+	mockReportRepo.On("ListPaymentHistory", ctx, filter, PaymentHistoryMaxPageSize, 0).Return([]*repository.PaymentHistoryRow{}, nil)
+
+	// Act
+	report, err := service.GetPaymentHistoryReport(ctx, PaymentHistoryRequest{PageSize: 10000})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, PaymentHistoryMaxPageSize, report.PageSize)
+	assert.Equal(t, 0, report.TotalPages)
+
+	mockReportRepo.AssertExpectations(t)
+}
+
+// Test StreamPaymentHistory - lê o histórico em lotes até o último lote incompleto
+func TestStreamPaymentHistory_ReadsInBatches(t *testing.T) {
+	mockReportRepo := new(MockReportRepo)
+	service := NewReportService(mockReportRepo, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	filter := repository.PaymentHistoryFilter{}
+
+	mockReportRepo.On("ListPaymentHistory", ctx, filter, paymentHistoryStreamBatch, 0).
+		Return(createTestHistoryRows(paymentHistoryStreamBatch), nil)
+	mockReportRepo.On("ListPaymentHistory", ctx, filter, paymentHistoryStreamBatch, paymentHistoryStreamBatch).
+		Return(createTestHistoryRows(3), nil)
+
+	calls := 0
+	err := service.StreamPaymentHistory(ctx, PaymentHistoryRequest{}, func(item PaymentHistoryItem) error {
+		calls++
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, paymentHistoryStreamBatch+3, calls)
+	mockReportRepo.AssertExpectations(t)
+}
+
+// Test StreamPaymentHistory - interrompe ao receber erro do callback
+func TestStreamPaymentHistory_StopsOnCallbackError(t *testing.T) {
+	mockReportRepo := new(MockReportRepo)
+	service := NewReportService(mockReportRepo, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	mockReportRepo.On("ListPaymentHistory", ctx, repository.PaymentHistoryFilter{}, paymentHistoryStreamBatch, 0).
+		Return(createTestHistoryRows(3), nil)
+
+	writeErr := errors.New("client disconnected")
+	calls := 0
+	err := service.StreamPaymentHistory(ctx, PaymentHistoryRequest{}, func(item PaymentHistoryItem) error {
+		calls++
+		return writeErr
+	})
+
+	assert.ErrorIs(t, err, writeErr)
+	assert.Equal(t, 1, calls)
+}
+
+// Test FinancialReportPDF - Success
+func TestFinancialReportPDF_Success(t *testing.T) {
+	mockReportRepo := new(MockReportRepo)
+	service := NewReportService(mockReportRepo, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	req := FinancialReportRequest{
+		StartDate: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+	}
+	mockFinancialReport(mockReportRepo, ctx, repository.FinancialReportFilter{StartDate: req.StartDate, EndDate: req.EndDate})
+
+	content, err := service.FinancialReportPDF(ctx, req)
+
+	assert.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(content, []byte("%PDF-")))
+}
+
+// Test FinancialReportPDF - Invalid date range
+func TestFinancialReportPDF_InvalidDateRange(t *testing.T) {
+	service := NewReportService(new(MockReportRepo), nil, nil, nil, nil, nil)
+
+	_, err := service.FinancialReportPDF(context.Background(), FinancialReportRequest{
+		StartDate: time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	assert.ErrorIs(t, err, ErrInvalidDateRange)
+}
+
+func TestCompactBRL(t *testing.T) {
+	assert.Equal(t, "850", compactBRL(decimal.RequireFromString("850.40")))
+	assert.Equal(t, "12,5 mil", compactBRL(decimal.NewFromInt(12480)))
+	assert.Equal(t, "1,3 mi", compactBRL(decimal.NewFromInt(1250000)))
+}
+
+// Test GetAgingReport - Faixas de atraso, agrupamentos e comparação com o mês anterior
+func TestGetAgingReport_Success(t *testing.T) {
 	// Arrange
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockUnitRepo := new(MockUnitRepo)
 	mockTenantRepo := new(MockTenantRepo)
-	service := NewReportService(mockPaymentRepo, mockLeaseRepo, mockUnitRepo, mockTenantRepo)
+	service := NewReportService(nil, mockPaymentRepo, mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil)
 
 	ctx := context.Background()
+	today := startOfDay(time.Now())
 
-	// Criar apenas pagamentos pagos
-	paidPayments := []*domain.Payment{createTestPayments()[0], createTestPayments()[2]}
+	leaseA := &domain.Lease{ID: uuid.New(), UnitID: uuid.New(), TenantID: uuid.New()}
+	leaseB := &domain.Lease{ID: uuid.New(), UnitID: uuid.New(), TenantID: uuid.New()}
 
-	status := domain.PaymentStatusPaid
-	req := PaymentHistoryRequest{
-		Status: &status,
+	open := []*domain.Payment{
+		{ID: uuid.New(), LeaseID: leaseB.ID, PaymentType: domain.PaymentTypePaintingFee, Amount: decimal.NewFromInt(250), Status: domain.PaymentStatusOverdue, DueDate: today.AddDate(0, 0, -100)},
+		{ID: uuid.New(), LeaseID: leaseA.ID, PaymentType: domain.PaymentTypeRent, Amount: decimal.NewFromInt(800), Status: domain.PaymentStatusOverdue, DueDate: today.AddDate(0, 0, -45)},
+		{ID: uuid.New(), LeaseID: leaseA.ID, PaymentType: domain.PaymentTypeRent, Amount: decimal.NewFromInt(800), Status: domain.PaymentStatusPending, DueDate: today.AddDate(0, 0, -10)},
+	}
+	paymentDate := today.AddDate(0, 0, -5)
+	settled := []*domain.Payment{
+		{ID: uuid.New(), LeaseID: leaseA.ID, PaymentType: domain.PaymentTypeRent, Amount: decimal.NewFromInt(1000), Status: domain.PaymentStatusPaid, DueDate: today.AddDate(0, 0, -70), PaymentDate: &paymentDate},
 	}
 
-	mockPaymentRepo.On("ListByStatus", ctx, status).Return(paidPayments, nil)
+	mockPaymentRepo.On("GetOverdue", ctx).Return(open, nil)
+	mockPaymentRepo.On("GetOverdueSettledSince", ctx, mock.AnythingOfType("time.Time")).Return(settled, nil)
 
-	// Mock lease, unit, tenant
-	for _, p := range paidPayments {
-		lease := &domain.Lease{
-			ID:       p.LeaseID,
-			UnitID:   uuid.New(),
-			TenantID: uuid.New(),
-		}
-		unit := &domain.Unit{
-			ID:     lease.UnitID,
-			Number: "101",
-		}
-		tenant := &domain.Tenant{
-			ID:       lease.TenantID,
-			FullName: "Test Tenant",
-		}
+	// Morador e unidade são buscados uma única vez por contrato
+	mockLeaseRepo.On("GetByID", ctx, leaseA.ID).Return(leaseA, nil).Once()
+	mockLeaseRepo.On("GetByID", ctx, leaseB.ID).Return(leaseB, nil).Once()
+	mockTenantRepo.On("GetByID", ctx, leaseA.TenantID).Return(&domain.Tenant{ID: leaseA.TenantID, FullName: "Ana Souza"}, nil).Once()
+	mockTenantRepo.On("GetByID", ctx, leaseB.TenantID).Return(&domain.Tenant{ID: leaseB.TenantID, FullName: "Bruno Lima"}, nil).Once()
+	mockUnitRepo.On("GetByID", ctx, leaseA.UnitID).Return(&domain.Unit{ID: leaseA.UnitID, Number: "101"}, nil).Once()
+	mockUnitRepo.On("GetByID", ctx, leaseB.UnitID).Return(&domain.Unit{ID: leaseB.UnitID, Number: "102"}, nil).Once()
 
-		mockLeaseRepo.On("GetByID", ctx, p.LeaseID).Return(lease, nil).Once()
-		mockUnitRepo.On("GetByID", ctx, lease.UnitID).Return(unit, nil).Once()
-		mockTenantRepo.On("GetByID", ctx, lease.TenantID).Return(tenant, nil).Once()
+	// Act
+	report, err := service.GetAgingReport(ctx, AgingReportRequest{})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, today, report.AsOf)
+
+	// Faixas atuais
+	assert.Equal(t, AgingAmount{Count: 1, Amount: decimal.NewFromInt(800)}, report.Summary.Days0To30)
+	assert.Equal(t, AgingAmount{Count: 1, Amount: decimal.NewFromInt(800)}, report.Summary.Days31To60)
+	assert.Equal(t, 0, report.Summary.Days61To90.Count)
+	assert.Equal(t, AgingAmount{Count: 1, Amount: decimal.NewFromInt(250)}, report.Summary.Over90)
+	assert.Equal(t, AgingAmount{Count: 3, Amount: decimal.NewFromInt(1850)}, report.Summary.Total)
+
+	// Agrupamentos ordenados pelo maior valor em atraso
+	assert.Len(t, report.ByTenant, 2)
+	assert.Equal(t, "Ana Souza", report.ByTenant[0].TenantName)
+	assert.Equal(t, decimal.NewFromInt(1600), report.ByTenant[0].Total.Amount)
+	assert.Len(t, report.ByUnit, 2)
+	assert.Equal(t, "101", report.ByUnit[0].UnitNumber)
+	assert.Len(t, report.ByType, 2)
+	assert.Equal(t, domain.PaymentTypeRent, report.ByType[0].Type)
+	assert.Equal(t, decimal.NewFromInt(250), report.ByType[1].Over90.Amount)
+
+	// Mês anterior: pagamentos já vencidos na época (o de 10 dias ainda não vencia) e o quitado desde então
+	previous := report.Trend.Previous
+	assert.Equal(t, AgingAmount{Count: 3, Amount: decimal.NewFromInt(2050)}, previous.Total)
+	assert.Equal(t, decimal.NewFromInt(800), previous.Days0To30.Amount)
+	assert.Equal(t, decimal.NewFromInt(1000), previous.Days31To60.Amount)
+	assert.Equal(t, decimal.NewFromInt(250), previous.Days61To90.Amount)
+	assert.Equal(t, decimal.NewFromInt(-200), report.Trend.AmountChange)
+	assert.Equal(t, 0, report.Trend.CountChange)
+	if assert.NotNil(t, report.Trend.PercentChange) {
+		assert.Equal(t, -9.76, *report.Trend.PercentChange)
 	}
 
+	mockPaymentRepo.AssertExpectations(t)
+	mockLeaseRepo.AssertExpectations(t)
+	mockTenantRepo.AssertExpectations(t)
+	mockUnitRepo.AssertExpectations(t)
+}
+
+// Test GetAgingReport - Filtro por imóvel sem pagamentos em atraso
+func TestGetAgingReport_FilterByPropertyEmpty(t *testing.T) {
+	// Arrange
+	mockPaymentRepo := new(MockPaymentRepo)
+	service := NewReportService(nil, mockPaymentRepo, new(MockLeaseRepo), new(MockUnitRepo), new(MockTenantRepo), nil)
+
+	ctx := context.Background()
+	propertyID := uuid.New()
+
+	mockPaymentRepo.On("GetOverdueByPropertyID", ctx, propertyID).Return([]*domain.Payment{}, nil)
+	mockPaymentRepo.On("GetOverdueSettledSinceByPropertyID", ctx, propertyID, mock.AnythingOfType("time.Time")).Return([]*domain.Payment{}, nil)
+
 	// Act
-	report, err := service.GetPaymentHistoryReport(ctx, req)
+	report, err := service.GetAgingReport(ctx, AgingReportRequest{PropertyID: &propertyID})
 
 	// Assert
 	assert.NoError(t, err)
-	assert.NotNil(t, report)
-	assert.Equal(t, 2, report.TotalCount) // Apenas pagamentos paid
+	assert.True(t, report.Summary.Total.Amount.IsZero())
+	assert.Empty(t, report.ByTenant)
+	assert.Empty(t, report.ByUnit)
+	assert.Empty(t, report.ByType)
+	assert.Nil(t, report.Trend.PercentChange) // Sem inadimplência no mês anterior
 
 	mockPaymentRepo.AssertExpectations(t)
 }
 
-// Test GetPaymentHistoryReport - Filter by date range
-func TestGetPaymentHistoryReport_FilterByDateRange(t *testing.T) {
+// Test GetAgingReport - Caução em aberto não entra como aluguel em atraso
+func TestGetAgingReport_IgnoresSecurityDeposit(t *testing.T) {
 	// Arrange
 	mockPaymentRepo := new(MockPaymentRepo)
 	mockLeaseRepo := new(MockLeaseRepo)
 	mockUnitRepo := new(MockUnitRepo)
 	mockTenantRepo := new(MockTenantRepo)
-	service := NewReportService(mockPaymentRepo, mockLeaseRepo, mockUnitRepo, mockTenantRepo)
+	service := NewReportService(nil, mockPaymentRepo, mockLeaseRepo, mockUnitRepo, mockTenantRepo, nil)
 
 	ctx := context.Background()
-	payments := createTestPayments()
+	today := startOfDay(time.Now())
+	lease := &domain.Lease{ID: uuid.New(), UnitID: uuid.New(), TenantID: uuid.New()}
 
-	startDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
-	endDate := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
-
-	req := PaymentHistoryRequest{
-		StartDate: &startDate,
-		EndDate:   &endDate,
+	open := []*domain.Payment{
+		{ID: uuid.New(), LeaseID: lease.ID, PaymentType: domain.PaymentTypeSecurityDeposit, Amount: decimal.NewFromInt(1600), Status: domain.PaymentStatusOverdue, DueDate: today.AddDate(0, 0, -40)},
+		{ID: uuid.New(), LeaseID: lease.ID, PaymentType: domain.PaymentTypeRent, Amount: decimal.NewFromInt(800), Status: domain.PaymentStatusOverdue, DueDate: today.AddDate(0, 0, -5)},
 	}
-
-	mockPaymentRepo.On("List", ctx).Return(payments, nil)
-
-	// Mock lease, unit, tenant apenas para pagamentos de março
-	for i, p := range payments {
-		if p.DueDate.After(endDate) {
-			continue // Pula pagamentos fora do range
-		}
-
-		lease := &domain.Lease{
-			ID:       p.LeaseID,
-			UnitID:   uuid.New(),
-			TenantID: uuid.New(),
-		}
-		unit := &domain.Unit{
-			ID:     lease.UnitID,
-			Number: "10" + string(rune('1'+i)),
-		}
-		tenant := &domain.Tenant{
-			ID:       lease.TenantID,
-			FullName: "Test Tenant",
-		}
-
-		mockLeaseRepo.On("GetByID", ctx, p.LeaseID).Return(lease, nil).Once()
-		mockUnitRepo.On("GetByID", ctx, lease.UnitID).Return(unit, nil).Once()
-		mockTenantRepo.On("GetByID", ctx, lease.TenantID).Return(tenant, nil).Once()
+	paymentDate := today.AddDate(0, 0, -3)
+	settled := []*domain.Payment{
+		{ID: uuid.New(), LeaseID: lease.ID, PaymentType: domain.PaymentTypeSecurityDeposit, Amount: decimal.NewFromInt(1600), Status: domain.PaymentStatusPaid, DueDate: today.AddDate(0, 0, -70), PaymentDate: &paymentDate},
 	}
 
+	mockPaymentRepo.On("GetOverdue", ctx).Return(open, nil)
+	mockPaymentRepo.On("GetOverdueSettledSince", ctx, mock.AnythingOfType("time.Time")).Return(settled, nil)
+	mockLeaseRepo.On("GetByID", ctx, lease.ID).Return(lease, nil).Once()
+	mockTenantRepo.On("GetByID", ctx, lease.TenantID).Return(&domain.Tenant{ID: lease.TenantID, FullName: "Ana Souza"}, nil).Once()
+	mockUnitRepo.On("GetByID", ctx, lease.UnitID).Return(&domain.Unit{ID: lease.UnitID, Number: "101"}, nil).Once()
+
 	// Act
-	report, err := service.GetPaymentHistoryReport(ctx, req)
+	report, err := service.GetAgingReport(ctx, AgingReportRequest{})
 
 	// Assert
 	assert.NoError(t, err)
-	assert.NotNil(t, report)
-	assert.Equal(t, 2, report.TotalCount) // Apenas pagamentos de março
+	assert.Equal(t, AgingAmount{Count: 1, Amount: decimal.NewFromInt(800)}, report.Summary.Total)
+	assert.Len(t, report.ByType, 1)
+	assert.Equal(t, domain.PaymentTypeRent, report.ByType[0].Type)
+	assert.True(t, report.Trend.Previous.Total.Amount.IsZero())
 
 	mockPaymentRepo.AssertExpectations(t)
 }
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+)
+
+const (
+	// DefaultSearchLimit é a quantidade padrão de resultados por tipo
+	DefaultSearchLimit = 5
+	// MaxSearchLimit é a quantidade máxima de resultados por tipo
+	MaxSearchLimit = 20
+)
+
+// SearchService contém a lógica da busca global usada pela caixa de busca do frontend
+type SearchService struct {
+	searchRepo repository.SearchRepository
+}
+
+// NewSearchService cria uma nova instância do serviço de busca
+func NewSearchService(searchRepo repository.SearchRepository) *SearchService {
+	return &SearchService{
+		searchRepo: searchRepo,
+	}
+}
+
+// Search busca moradores, unidades, contratos e pagamentos e retorna os resultados ordenados por relevância
+// types restringe os tipos pesquisados (vazio = todos) e limit é aplicado a cada tipo
+func (s *SearchService) Search(ctx context.Context, rawQuery string, types []domain.SearchResultType, limit int) ([]*domain.SearchResult, error) {
+	query, err := domain.ParseSearchQuery(rawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	if len(types) == 0 {
+		types = domain.ValidSearchResultTypes
+	}
+	for _, resultType := range types {
+		if !domain.IsValidSearchResultType(resultType) {
+			return nil, fmt.Errorf("validation error: %w", domain.ErrInvalidSearchType)
+		}
+	}
+
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	if limit > MaxSearchLimit {
+		limit = MaxSearchLimit
+	}
+
+	results := make([]*domain.SearchResult, 0)
+	for _, resultType := range uniqueSearchTypes(types) {
+		found, err := s.searchByType(ctx, resultType, query, limit)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, found...)
+	}
+
+	// Ordenação estável mantém a ordem dos tipos em caso de empate
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Rank > results[j].Rank
+	})
+
+	return results, nil
+}
+
+// searchByType executa a consulta correspondente a um tipo de resultado
+func (s *SearchService) searchByType(ctx context.Context, resultType domain.SearchResultType, query *domain.SearchQuery, limit int) ([]*domain.SearchResult, error) {
+	switch resultType {
+	case domain.SearchResultTenant:
+		results, err := s.searchRepo.SearchTenants(ctx, query.Text, query.Digits, limit)
+		if err != nil {
+			return nil, fmt.Errorf("error searching tenants: %w", err)
+		}
+		return results, nil
+
+	case domain.SearchResultUnit:
+		results, err := s.searchRepo.SearchUnits(ctx, query.Text, limit)
+		if err != nil {
+			return nil, fmt.Errorf("error searching units: %w", err)
+		}
+		return results, nil
+
+	case domain.SearchResultLease:
+		results, err := s.searchRepo.SearchLeases(ctx, query.Text, limit)
+		if err != nil {
+			return nil, fmt.Errorf("error searching leases: %w", err)
+		}
+		return results, nil
+
+	case domain.SearchResultPayment:
+		// Pagamentos só são pesquisados quando o termo é um valor ou um mês de referência
+		results := make([]*domain.SearchResult, 0)
+		if query.Amount != nil {
+			found, err := s.searchRepo.SearchPaymentsByAmount(ctx, *query.Amount, limit)
+			if err != nil {
+				return nil, fmt.Errorf("error searching payments by amount: %w", err)
+			}
+			results = append(results, found...)
+		}
+		if query.ReferenceMonth != nil {
+			found, err := s.searchRepo.SearchPaymentsByReferenceMonth(ctx, *query.ReferenceMonth, limit)
+			if err != nil {
+				return nil, fmt.Errorf("error searching payments by reference month: %w", err)
+			}
+			results = append(results, found...)
+		}
+		return results, nil
+	}
+
+	return nil, nil
+}
+
+// uniqueSearchTypes remove tipos repetidos mantendo a ordem informada
+func uniqueSearchTypes(types []domain.SearchResultType) []domain.SearchResultType {
+	seen := make(map[domain.SearchResultType]bool, len(types))
+	unique := make([]domain.SearchResultType, 0, len(types))
+	for _, resultType := range types {
+		if !seen[resultType] {
+			seen[resultType] = true
+			unique = append(unique, resultType)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSearchRepo é um mock do repository de busca global
+type MockSearchRepo struct {
+	mock.Mock
+}
+
+func (m *MockSearchRepo) SearchTenants(ctx context.Context, query, digits string, limit int) ([]*domain.SearchResult, error) {
+	args := m.Called(ctx, query, digits, limit)
+	return args.Get(0).([]*domain.SearchResult), args.Error(1)
+}
+
+func (m *MockSearchRepo) SearchUnits(ctx context.Context, query string, limit int) ([]*domain.SearchResult, error) {
+	args := m.Called(ctx, query, limit)
+	return args.Get(0).([]*domain.SearchResult), args.Error(1)
+}
+
+func (m *MockSearchRepo) SearchLeases(ctx context.Context, query string, limit int) ([]*domain.SearchResult, error) {
+	args := m.Called(ctx, query, limit)
+	return args.Get(0).([]*domain.SearchResult), args.Error(1)
+}
+
+func (m *MockSearchRepo) SearchPaymentsByAmount(ctx context.Context, amount decimal.Decimal, limit int) ([]*domain.SearchResult, error) {
+	args := m.Called(ctx, amount, limit)
+	return args.Get(0).([]*domain.SearchResult), args.Error(1)
+}
+
+func (m *MockSearchRepo) SearchPaymentsByReferenceMonth(ctx context.Context, referenceMonth time.Time, limit int) ([]*domain.SearchResult, error) {
+	args := m.Called(ctx, referenceMonth, limit)
+	return args.Get(0).([]*domain.SearchResult), args.Error(1)
+}
+
+func searchResult(resultType domain.SearchResultType, rank float64) *domain.SearchResult {
+	return &domain.SearchResult{Type: resultType, ID: uuid.New(), Title: string(resultType), Rank: rank}
+}
+
+func TestSearchService_Search(t *testing.T) {
+	ctx := context.Background()
+	none := []*domain.SearchResult{}
+
+	t.Run("should merge results ordered by rank", func(t *testing.T) {
+		repo := new(MockSearchRepo)
+		service := NewSearchService(repo)
+
+		tenant := searchResult(domain.SearchResultTenant, 0.4)
+		unit := searchResult(domain.SearchResultUnit, 1)
+		lease := searchResult(domain.SearchResultLease, 0.6)
+
+		repo.On("SearchTenants", ctx, "101", "101", DefaultSearchLimit).Return([]*domain.SearchResult{tenant}, nil)
+		repo.On("SearchUnits", ctx, "101", DefaultSearchLimit).Return([]*domain.SearchResult{unit}, nil)
+		repo.On("SearchLeases", ctx, "101", DefaultSearchLimit).Return([]*domain.SearchResult{lease}, nil)
+		repo.On("SearchPaymentsByAmount", ctx, decimal.NewFromInt(101), DefaultSearchLimit).Return(none, nil)
+
+		results, err := service.Search(ctx, " 101 ", nil, 0)
+
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		assert.Equal(t, unit.ID, results[0].ID)
+		assert.Equal(t, lease.ID, results[1].ID)
+		assert.Equal(t, tenant.ID, results[2].ID)
+		repo.AssertNotCalled(t, "SearchPaymentsByReferenceMonth", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should only search requested types and cap limit", func(t *testing.T) {
+		repo := new(MockSearchRepo)
+		service := NewSearchService(repo)
+		month := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+		payment := searchResult(domain.SearchResultPayment, 1)
+
+		repo.On("SearchPaymentsByReferenceMonth", ctx, month, MaxSearchLimit).Return([]*domain.SearchResult{payment}, nil)
+
+		results, err := service.Search(ctx, "03/2025", []domain.SearchResultType{domain.SearchResultPayment, domain.SearchResultPayment}, 100)
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, payment.ID, results[0].ID)
+		repo.AssertNumberOfCalls(t, "SearchPaymentsByReferenceMonth", 1)
+		repo.AssertNotCalled(t, "SearchTenants", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should skip payments for text queries", func(t *testing.T) {
+		repo := new(MockSearchRepo)
+		service := NewSearchService(repo)
+
+		results, err := service.Search(ctx, "Maria", []domain.SearchResultType{domain.SearchResultPayment}, 0)
+
+		require.NoError(t, err)
+		assert.Empty(t, results)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("should reject short query and invalid type", func(t *testing.T) {
+		service := NewSearchService(new(MockSearchRepo))
+
+		_, err := service.Search(ctx, "a", nil, 0)
+		assert.True(t, errors.Is(err, domain.ErrSearchQueryTooShort))
+
+		_, err = service.Search(ctx, "Maria", []domain.SearchResultType{"property"}, 0)
+		assert.True(t, errors.Is(err, domain.ErrInvalidSearchType))
+	})
+
+	t.Run("should propagate repository errors", func(t *testing.T) {
+		repo := new(MockSearchRepo)
+		service := NewSearchService(repo)
+
+		repo.On("SearchUnits", ctx, "Maria", DefaultSearchLimit).Return(none, errors.New("db down"))
+
+		results, err := service.Search(ctx, "Maria", []domain.SearchResultType{domain.SearchResultUnit}, 0)
+
+		assert.Nil(t, results)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/storage"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+)
+
+// Service layer errors específicos dos documentos do morador
+var (
+	ErrTenantDocumentNotFound = errors.New("tenant document not found")
+	ErrDocumentLeaseMismatch  = errors.New("lease does not belong to this tenant")
+)
+
+// TenantDocumentService contém a lógica de negócio para os arquivos dos moradores e contratos
+type TenantDocumentService struct {
+	documentRepo repository.TenantDocumentRepository
+	tenantRepo   repository.TenantRepository
+	leaseRepo    repository.LeaseRepository
+	storage      storage.Storage
+}
+
+// NewTenantDocumentService cria uma nova instância do serviço de documentos
+func NewTenantDocumentService(
+	documentRepo repository.TenantDocumentRepository,
+	tenantRepo repository.TenantRepository,
+	leaseRepo repository.LeaseRepository,
+	fileStorage storage.Storage,
+) *TenantDocumentService {
+	return &TenantDocumentService{
+		documentRepo: documentRepo,
+		tenantRepo:   tenantRepo,
+		leaseRepo:    leaseRepo,
+		storage:      fileStorage,
+	}
+}
+
+// UploadTenantDocumentRequest representa o arquivo enviado e seus metadados
+type UploadTenantDocumentRequest struct {
+	Kind        domain.TenantDocumentKind
+	LeaseID     *uuid.UUID
+	ExpiresAt   *time.Time
+	FileName    string
+	ContentType string
+	Content     io.Reader
+	UploadedBy  *uuid.UUID
+}
+
+// Upload grava o arquivo no armazenamento e registra o documento do morador
+// O checksum SHA-256 e o tamanho são calculados durante a gravação
+func (s *TenantDocumentService) Upload(ctx context.Context, tenantID uuid.UUID, req UploadTenantDocumentRequest) (*domain.TenantDocument, error) {
+	tenant, err := s.tenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tenant: %w", err)
+	}
+	if tenant == nil {
+		return nil, ErrTenantNotFound
+	}
+	if tenant.IsAnonymized() {
+		return nil, domain.ErrTenantAnonymized
+	}
+
+	if req.LeaseID != nil {
+		lease, err := s.leaseRepo.GetByID(ctx, *req.LeaseID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting lease: %w", err)
+		}
+		if lease == nil {
+			return nil, ErrLeaseNotFound
+		}
+		if lease.TenantID != tenantID {
+			return nil, ErrDocumentLeaseMismatch
+		}
+	}
+
+	doc, err := domain.NewTenantDocument(tenantID, req.Kind, req.FileName, req.ContentType, req.LeaseID)
+	if err != nil {
+		return nil, fmt.Errorf("error creating tenant document: %w", err)
+	}
+	doc.ExpiresAt = req.ExpiresAt
+	doc.UploadedBy = req.UploadedBy
+
+	hasher := sha256.New()
+	size, err := s.storage.Save(ctx, doc.StorageKey, io.TeeReader(req.Content, hasher))
+	if err != nil {
+		return nil, fmt.Errorf("error storing document file: %w", err)
+	}
+	doc.SizeBytes = size
+	doc.Checksum = hex.EncodeToString(hasher.Sum(nil))
+
+	if err := doc.Validate(); err != nil {
+		s.removeFile(ctx, doc.StorageKey)
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := s.documentRepo.Create(ctx, doc); err != nil {
+		s.removeFile(ctx, doc.StorageKey)
+		return nil, fmt.Errorf("error saving tenant document: %w", err)
+	}
+
+	return doc, nil
+}
+
+// ListByTenant retorna os documentos de um morador
+func (s *TenantDocumentService) ListByTenant(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantDocument, error) {
+	if err := s.ensureTenantExists(ctx, tenantID); err != nil {
+		return nil, err
+	}
+
+	docs, err := s.documentRepo.ListByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tenant documents: %w", err)
+	}
+
+	return docs, nil
+}
+
+// ListByLease retorna os documentos vinculados a um contrato
+func (s *TenantDocumentService) ListByLease(ctx context.Context, leaseID uuid.UUID) ([]*domain.TenantDocument, error) {
+	lease, err := s.leaseRepo.GetByID(ctx, leaseID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting lease: %w", err)
+	}
+	if lease == nil {
+		return nil, ErrLeaseNotFound
+	}
+
+	docs, err := s.documentRepo.ListByLeaseID(ctx, leaseID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing lease documents: %w", err)
+	}
+
+	return docs, nil
+}
+
+// Open retorna o documento e o conteúdo do arquivo; o chamador deve fechar o reader
+func (s *TenantDocumentService) Open(ctx context.Context, tenantID, documentID uuid.UUID) (*domain.TenantDocument, io.ReadCloser, error) {
+	doc, err := s.getTenantDocument(ctx, tenantID, documentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.storage.Open(ctx, doc.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, nil, ErrTenantDocumentNotFound
+		}
+		return nil, nil, fmt.Errorf("error opening document file: %w", err)
+	}
+
+	return doc, content, nil
+}
+
+// Delete remove o registro do documento e o arquivo armazenado
+func (s *TenantDocumentService) Delete(ctx context.Context, tenantID, documentID uuid.UUID) error {
+	doc, err := s.getTenantDocument(ctx, tenantID, documentID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.documentRepo.Delete(ctx, doc.ID); err != nil {
+		return fmt.Errorf("error deleting tenant document: %w", err)
+	}
+
+	s.removeFile(ctx, doc.StorageKey)
+	return nil
+}
+
+// getTenantDocument busca um documento garantindo que pertence ao morador
+func (s *TenantDocumentService) getTenantDocument(ctx context.Context, tenantID, documentID uuid.UUID) (*domain.TenantDocument, error) {
+	doc, err := s.documentRepo.GetByID(ctx, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tenant document: %w", err)
+	}
+	if doc == nil || doc.TenantID != tenantID {
+		return nil, ErrTenantDocumentNotFound
+	}
+
+	return doc, nil
+}
+
+// ensureTenantExists verifica se o morador existe
+func (s *TenantDocumentService) ensureTenantExists(ctx context.Context, tenantID uuid.UUID) error {
+	tenant, err := s.tenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		return fmt.Errorf("error getting tenant: %w", err)
+	}
+	if tenant == nil {
+		return ErrTenantNotFound
+	}
+
+	return nil
+}
+
+// removeFile apaga um arquivo do armazenamento; falhas apenas geram aviso
+func (s *TenantDocumentService) removeFile(ctx context.Context, key string) {
+	if err := s.storage.Delete(ctx, key); err != nil {
+		fmt.Printf("Warning: failed to remove stored document %s: %v\n", key, err)
+	}
+}
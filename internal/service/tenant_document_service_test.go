@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTenantDocumentRepo é um mock do repository de documentos do morador
+type MockTenantDocumentRepo struct {
+	mock.Mock
+}
+
+func (m *MockTenantDocumentRepo) Create(ctx context.Context, doc *domain.TenantDocument) error {
+	args := m.Called(ctx, doc)
+	return args.Error(0)
+}
+
+func (m *MockTenantDocumentRepo) GetByID(ctx context.Context, id uuid.UUID) (*domain.TenantDocument, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TenantDocument), args.Error(1)
+}
+
+func (m *MockTenantDocumentRepo) ListByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantDocument, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TenantDocument), args.Error(1)
+}
+
+func (m *MockTenantDocumentRepo) ListByLeaseID(ctx context.Context, leaseID uuid.UUID) ([]*domain.TenantDocument, error) {
+	args := m.Called(ctx, leaseID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TenantDocument), args.Error(1)
+}
+
+func (m *MockTenantDocumentRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func newTestDocumentStorage(t *testing.T) *storage.LocalStorage {
+	store, err := storage.NewLocalStorage(t.TempDir())
+	require.NoError(t, err)
+	return store
+}
+
+func TestTenantDocumentService_Upload(t *testing.T) {
+	ctx := context.Background()
+	content := "scan do RG"
+	sum := sha256.Sum256([]byte(content))
+
+	t.Run("should store file with checksum and size", func(t *testing.T) {
+		tenantID := uuid.New()
+		uploaderID := uuid.New()
+		mockDocRepo := new(MockTenantDocumentRepo)
+		mockTenantRepo := new(MockTenantRepository)
+		store := newTestDocumentStorage(t)
+		service := NewTenantDocumentService(mockDocRepo, mockTenantRepo, nil, store)
+
+		mockTenantRepo.On("GetByID", ctx, tenantID).Return(createTestTenant(tenantID), nil)
+		mockDocRepo.On("Create", ctx, mock.AnythingOfType("*domain.TenantDocument")).Return(nil)
+
+		doc, err := service.Upload(ctx, tenantID, UploadTenantDocumentRequest{
+			Kind:        domain.DocumentKindRG,
+			FileName:    "C:\\scans\\rg.pdf",
+			ContentType: "application/pdf",
+			Content:     strings.NewReader(content),
+			UploadedBy:  &uploaderID,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "rg.pdf", doc.FileName)
+		assert.Equal(t, int64(len(content)), doc.SizeBytes)
+		assert.Equal(t, hex.EncodeToString(sum[:]), doc.Checksum)
+		assert.Equal(t, &uploaderID, doc.UploadedBy)
+
+		reader, err := store.Open(ctx, doc.StorageKey)
+		require.NoError(t, err)
+		stored, _ := io.ReadAll(reader)
+		reader.Close()
+		assert.Equal(t, content, string(stored))
+	})
+
+	t.Run("should reject lease of another tenant", func(t *testing.T) {
+		tenantID := uuid.New()
+		lease := &domain.Lease{ID: uuid.New(), TenantID: uuid.New()}
+		mockTenantRepo := new(MockTenantRepository)
+		mockLeaseRepo := new(MockLeaseRepo)
+		service := NewTenantDocumentService(new(MockTenantDocumentRepo), mockTenantRepo, mockLeaseRepo, newTestDocumentStorage(t))
+
+		mockTenantRepo.On("GetByID", ctx, tenantID).Return(createTestTenant(tenantID), nil)
+		mockLeaseRepo.On("GetByID", ctx, lease.ID).Return(lease, nil)
+
+		_, err := service.Upload(ctx, tenantID, UploadTenantDocumentRequest{
+			Kind:     domain.DocumentKindSignedContract,
+			LeaseID:  &lease.ID,
+			FileName: "contrato.pdf",
+			Content:  strings.NewReader(content),
+		})
+
+		assert.ErrorIs(t, err, ErrDocumentLeaseMismatch)
+	})
+
+	t.Run("should reject empty file and remove it from storage", func(t *testing.T) {
+		tenantID := uuid.New()
+		mockDocRepo := new(MockTenantDocumentRepo)
+		mockTenantRepo := new(MockTenantRepository)
+		store := newTestDocumentStorage(t)
+		service := NewTenantDocumentService(mockDocRepo, mockTenantRepo, nil, store)
+
+		mockTenantRepo.On("GetByID", ctx, tenantID).Return(createTestTenant(tenantID), nil)
+
+		_, err := service.Upload(ctx, tenantID, UploadTenantDocumentRequest{
+			Kind:     domain.DocumentKindProofOfIncome,
+			FileName: "holerite.pdf",
+			Content:  strings.NewReader(""),
+		})
+
+		assert.ErrorIs(t, err, domain.ErrEmptyDocumentFile)
+		mockDocRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should remove stored file when saving the record fails", func(t *testing.T) {
+		tenantID := uuid.New()
+		mockDocRepo := new(MockTenantDocumentRepo)
+		mockTenantRepo := new(MockTenantRepository)
+		store := newTestDocumentStorage(t)
+		service := NewTenantDocumentService(mockDocRepo, mockTenantRepo, nil, store)
+
+		var created *domain.TenantDocument
+		mockTenantRepo.On("GetByID", ctx, tenantID).Return(createTestTenant(tenantID), nil)
+		mockDocRepo.On("Create", ctx, mock.AnythingOfType("*domain.TenantDocument")).
+			Run(func(args mock.Arguments) { created = args.Get(1).(*domain.TenantDocument) }).
+			Return(errors.New("db error"))
+
+		_, err := service.Upload(ctx, tenantID, UploadTenantDocumentRequest{
+			Kind:     domain.DocumentKindCNH,
+			FileName: "cnh.jpg",
+			Content:  strings.NewReader(content),
+		})
+
+		require.Error(t, err)
+		require.NotNil(t, created)
+		_, err = store.Open(ctx, created.StorageKey)
+		assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+	})
+
+	t.Run("should return error when tenant not found", func(t *testing.T) {
+		tenantID := uuid.New()
+		mockTenantRepo := new(MockTenantRepository)
+		service := NewTenantDocumentService(new(MockTenantDocumentRepo), mockTenantRepo, nil, newTestDocumentStorage(t))
+
+		mockTenantRepo.On("GetByID", ctx, tenantID).Return(nil, nil)
+
+		_, err := service.Upload(ctx, tenantID, UploadTenantDocumentRequest{
+			Kind:     domain.DocumentKindRG,
+			FileName: "rg.pdf",
+			Content:  strings.NewReader(content),
+		})
+
+		assert.ErrorIs(t, err, ErrTenantNotFound)
+	})
+}
+
+func TestTenantDocumentService_OpenAndDelete(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should not expose documents of another tenant", func(t *testing.T) {
+		doc := &domain.TenantDocument{ID: uuid.New(), TenantID: uuid.New(), StorageKey: "tenants/x/y"}
+		mockDocRepo := new(MockTenantDocumentRepo)
+		service := NewTenantDocumentService(mockDocRepo, nil, nil, newTestDocumentStorage(t))
+
+		mockDocRepo.On("GetByID", ctx, doc.ID).Return(doc, nil)
+
+		_, _, err := service.Open(ctx, uuid.New(), doc.ID)
+		assert.ErrorIs(t, err, ErrTenantDocumentNotFound)
+
+		err = service.Delete(ctx, uuid.New(), doc.ID)
+		assert.ErrorIs(t, err, ErrTenantDocumentNotFound)
+		mockDocRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should delete record and stored file", func(t *testing.T) {
+		tenantID := uuid.New()
+		store := newTestDocumentStorage(t)
+		doc := &domain.TenantDocument{ID: uuid.New(), TenantID: tenantID, StorageKey: "tenants/" + tenantID.String() + "/doc"}
+		_, err := store.Save(ctx, doc.StorageKey, strings.NewReader("conteúdo"))
+		require.NoError(t, err)
+
+		mockDocRepo := new(MockTenantDocumentRepo)
+		service := NewTenantDocumentService(mockDocRepo, nil, nil, store)
+
+		mockDocRepo.On("GetByID", ctx, doc.ID).Return(doc, nil)
+		mockDocRepo.On("Delete", ctx, doc.ID).Return(nil)
+
+		require.NoError(t, service.Delete(ctx, tenantID, doc.ID))
+
+		_, err = store.Open(ctx, doc.StorageKey)
+		assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+	})
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/storage"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+)
+
+// Service layer errors específicos das solicitações LGPD
+var (
+	ErrCannotAnonymizeActiveTenant = errors.New("cannot anonymize tenant with an active lease")
+	ErrCannotAnonymizeOpenBalance  = errors.New("cannot anonymize tenant with pending or overdue payments")
+)
+
+// TenantPrivacyService atende às solicitações do titular dos dados (LGPD):
+// exportação dos dados pessoais e anonimização de ex-moradores
+type TenantPrivacyService struct {
+	tenantRepo       repository.TenantRepository
+	leaseRepo        repository.LeaseRepository
+	paymentRepo      repository.PaymentRepository
+	profileRepo      repository.TenantProfileRepository
+	documentRepo     repository.TenantDocumentRepository
+	maintenanceRepo  repository.MaintenanceTicketRepository
+	notificationRepo repository.NotificationRepository
+	prospectRepo     repository.ProspectRepository
+	privacyRepo      repository.TenantPrivacyRepository
+	storage          storage.Storage
+}
+
+// NewTenantPrivacyService cria uma nova instância do serviço de privacidade
+func NewTenantPrivacyService(
+	tenantRepo repository.TenantRepository,
+	leaseRepo repository.LeaseRepository,
+	paymentRepo repository.PaymentRepository,
+	profileRepo repository.TenantProfileRepository,
+	documentRepo repository.TenantDocumentRepository,
+	maintenanceRepo repository.MaintenanceTicketRepository,
+	notificationRepo repository.NotificationRepository,
+	prospectRepo repository.ProspectRepository,
+	privacyRepo repository.TenantPrivacyRepository,
+	fileStorage storage.Storage,
+) *TenantPrivacyService {
+	return &TenantPrivacyService{
+		tenantRepo:       tenantRepo,
+		leaseRepo:        leaseRepo,
+		paymentRepo:      paymentRepo,
+		profileRepo:      profileRepo,
+		documentRepo:     documentRepo,
+		maintenanceRepo:  maintenanceRepo,
+		notificationRepo: notificationRepo,
+		prospectRepo:     prospectRepo,
+		privacyRepo:      privacyRepo,
+		storage:          fileStorage,
+	}
+}
+
+// TenantLeaseExport reúne um contrato e seus pagamentos
+type TenantLeaseExport struct {
+	Lease    *domain.Lease     `json:"lease"`
+	Payments []*domain.Payment `json:"payments"`
+}
+
+// TenantProspectExport reúne o cadastro de interessado que originou o morador e suas visitas
+type TenantProspectExport struct {
+	Prospect *domain.Prospect        `json:"prospect"`
+	Visits   []*domain.ProspectVisit `json:"visits"`
+}
+
+// TenantDataExport reúne todos os dados pessoais do morador e os registros relacionados
+// Os documentos são exportados apenas com os metadados (o conteúdo é baixado separadamente)
+type TenantDataExport struct {
+	GeneratedAt        time.Time                   `json:"generated_at"`
+	Tenant             *domain.Tenant              `json:"tenant"`
+	Contacts           []*domain.TenantContact     `json:"contacts"`
+	Occupants          []*domain.TenantOccupant    `json:"occupants"`
+	Pets               []*domain.TenantPet         `json:"pets"`
+	Documents          []*domain.TenantDocument    `json:"documents"`
+	Leases             []*TenantLeaseExport        `json:"leases"`
+	MaintenanceTickets []*domain.MaintenanceTicket `json:"maintenance_tickets"`
+	Notifications      []*domain.Notification      `json:"notifications"`
+	Prospects          []*TenantProspectExport     `json:"prospects"`
+	DataRequests       []*domain.TenantDataRequest `json:"data_requests"`
+}
+
+// ExportTenantData gera a exportação completa dos dados do morador e registra a solicitação
+func (s *TenantPrivacyService) ExportTenantData(ctx context.Context, tenantID uuid.UUID, performedBy *uuid.UUID) (*TenantDataExport, error) {
+	tenant, err := s.getTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &TenantDataExport{
+		GeneratedAt: time.Now(),
+		Tenant:      tenant,
+	}
+
+	if export.Contacts, err = s.profileRepo.ListContacts(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("error listing tenant contacts: %w", err)
+	}
+	if export.Occupants, err = s.profileRepo.ListOccupants(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("error listing tenant occupants: %w", err)
+	}
+	if export.Pets, err = s.profileRepo.ListPets(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("error listing tenant pets: %w", err)
+	}
+	if export.Documents, err = s.documentRepo.ListByTenantID(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("error listing tenant documents: %w", err)
+	}
+	if export.MaintenanceTickets, err = s.maintenanceRepo.ListByTenantID(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("error listing tenant maintenance tickets: %w", err)
+	}
+	if export.Notifications, err = s.notificationRepo.ListByTenantID(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("error listing tenant notifications: %w", err)
+	}
+
+	prospects, err := s.prospectRepo.ListByConvertedTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tenant prospects: %w", err)
+	}
+	export.Prospects = make([]*TenantProspectExport, len(prospects))
+	for i, prospect := range prospects {
+		visits, err := s.prospectRepo.ListVisits(ctx, prospect.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error listing visits for prospect %s: %w", prospect.ID, err)
+		}
+		export.Prospects[i] = &TenantProspectExport{Prospect: prospect, Visits: visits}
+	}
+
+	leases, err := s.leaseRepo.ListByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tenant leases: %w", err)
+	}
+	export.Leases = make([]*TenantLeaseExport, len(leases))
+	for i, lease := range leases {
+		payments, err := s.paymentRepo.ListByLeaseID(ctx, lease.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error listing payments for lease %s: %w", lease.ID, err)
+		}
+		export.Leases[i] = &TenantLeaseExport{Lease: lease, Payments: payments}
+	}
+
+	request, err := domain.NewTenantDataRequest(tenantID, domain.TenantDataRequestExport, performedBy, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.privacyRepo.CreateRequest(ctx, request); err != nil {
+		return nil, fmt.Errorf("error logging data export: %w", err)
+	}
+
+	if export.DataRequests, err = s.privacyRepo.ListRequestsByTenantID(ctx, tenantID); err != nil {
+		return nil, fmt.Errorf("error listing tenant data requests: %w", err)
+	}
+
+	return export, nil
+}
+
+// AnonymizeTenant remove os dados pessoais de um ex-morador
+// Nome, CPF/CNPJ, telefone, e-mail, documentos, empregador, contatos, ocupantes, animais,
+// conteúdo das notificações, cadastro de interessado e acesso ao portal são removidos; contratos e pagamentos são mantidos para a contabilidade
+func (s *TenantPrivacyService) AnonymizeTenant(ctx context.Context, tenantID uuid.UUID, performedBy *uuid.UUID, notes *string) (*domain.Tenant, error) {
+	tenant, err := s.getTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if tenant.IsAnonymized() {
+		return nil, domain.ErrTenantAlreadyAnonymized
+	}
+
+	leases, err := s.leaseRepo.ListByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tenant leases: %w", err)
+	}
+	for _, lease := range leases {
+		if lease.IsActive() || lease.Status == domain.LeaseStatusExpiringSoon {
+			return nil, ErrCannotAnonymizeActiveTenant
+		}
+
+		// Débitos em aberto ainda exigem a identificação do morador para cobrança
+		payments, err := s.paymentRepo.ListByLeaseID(ctx, lease.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error listing payments for lease %s: %w", lease.ID, err)
+		}
+		for _, payment := range payments {
+			if payment.CanBePaid() {
+				return nil, ErrCannotAnonymizeOpenBalance
+			}
+		}
+	}
+
+	// Os arquivos só são apagados depois que a anonimização for gravada
+	documents, err := s.documentRepo.ListByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tenant documents: %w", err)
+	}
+
+	request, err := domain.NewTenantDataRequest(tenantID, domain.TenantDataRequestAnonymization, performedBy, notes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tenant.Anonymize(performedBy); err != nil {
+		return nil, err
+	}
+
+	if err := s.privacyRepo.Anonymize(ctx, tenant, request); err != nil {
+		return nil, fmt.Errorf("error anonymizing tenant: %w", err)
+	}
+
+	for _, doc := range documents {
+		if err := s.storage.Delete(ctx, doc.StorageKey); err != nil && !errors.Is(err, storage.ErrObjectNotFound) {
+			// O registro já foi removido; o arquivo órfão não contém vínculo com o morador
+			fmt.Printf("Warning: failed to delete stored file %s of anonymized tenant %s: %v\n", doc.StorageKey, tenantID, err)
+		}
+	}
+
+	return tenant, nil
+}
+
+// ListDataRequests retorna o histórico de solicitações LGPD do morador
+func (s *TenantPrivacyService) ListDataRequests(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantDataRequest, error) {
+	if _, err := s.getTenant(ctx, tenantID); err != nil {
+		return nil, err
+	}
+
+	requests, err := s.privacyRepo.ListRequestsByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tenant data requests: %w", err)
+	}
+
+	return requests, nil
+}
+
+// getTenant busca o morador e retorna ErrTenantNotFound se não existir
+func (s *TenantPrivacyService) getTenant(ctx context.Context, tenantID uuid.UUID) (*domain.Tenant, error) {
+	tenant, err := s.tenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tenant: %w", err)
+	}
+	if tenant == nil {
+		return nil, ErrTenantNotFound
+	}
+
+	return tenant, nil
+}
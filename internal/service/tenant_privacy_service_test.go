@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/storage"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTenantPrivacyRepo é um mock do repository de solicitações LGPD
+type MockTenantPrivacyRepo struct {
+	mock.Mock
+}
+
+func (m *MockTenantPrivacyRepo) Anonymize(ctx context.Context, tenant *domain.Tenant, request *domain.TenantDataRequest) error {
+	args := m.Called(ctx, tenant, request)
+	return args.Error(0)
+}
+
+func (m *MockTenantPrivacyRepo) CreateRequest(ctx context.Context, request *domain.TenantDataRequest) error {
+	args := m.Called(ctx, request)
+	return args.Error(0)
+}
+
+func (m *MockTenantPrivacyRepo) ListRequestsByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantDataRequest, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Get(0).([]*domain.TenantDataRequest), args.Error(1)
+}
+
+type privacyServiceMocks struct {
+	tenantRepo       *MockTenantRepository
+	leaseRepo        *MockLeaseRepo
+	paymentRepo      *MockPaymentRepo
+	profileRepo      *MockTenantProfileRepo
+	documentRepo     *MockTenantDocumentRepo
+	maintenanceRepo  *MockMaintenanceTicketRepo
+	notificationRepo *MockNotificationRepo
+	prospectRepo     *MockProspectRepo
+	privacyRepo      *MockTenantPrivacyRepo
+	storage          *storage.LocalStorage
+}
+
+func newTestPrivacyService(t *testing.T) (*TenantPrivacyService, *privacyServiceMocks) {
+	m := &privacyServiceMocks{
+		tenantRepo:       new(MockTenantRepository),
+		leaseRepo:        new(MockLeaseRepo),
+		paymentRepo:      new(MockPaymentRepo),
+		profileRepo:      new(MockTenantProfileRepo),
+		documentRepo:     new(MockTenantDocumentRepo),
+		maintenanceRepo:  new(MockMaintenanceTicketRepo),
+		notificationRepo: new(MockNotificationRepo),
+		prospectRepo:     new(MockProspectRepo),
+		privacyRepo:      new(MockTenantPrivacyRepo),
+		storage:          newTestDocumentStorage(t),
+	}
+	svc := NewTenantPrivacyService(m.tenantRepo, m.leaseRepo, m.paymentRepo, m.profileRepo, m.documentRepo, m.maintenanceRepo, m.notificationRepo, m.prospectRepo, m.privacyRepo, m.storage)
+	return svc, m
+}
+
+func createPaidPayment(leaseID uuid.UUID) *domain.Payment {
+	paidAt := time.Now().AddDate(0, -1, 0)
+	return &domain.Payment{ID: uuid.New(), LeaseID: leaseID, PaymentType: domain.PaymentTypeRent, Amount: decimal.NewFromInt(800), Status: domain.PaymentStatusPaid, DueDate: paidAt, PaymentDate: &paidAt}
+}
+
+func TestTenantPrivacyService_ExportTenantData(t *testing.T) {
+	ctx := context.Background()
+	svc, m := newTestPrivacyService(t)
+
+	tenantID := uuid.New()
+	adminID := uuid.New()
+	lease := &domain.Lease{ID: uuid.New(), TenantID: tenantID, Status: domain.LeaseStatusExpired}
+
+	m.tenantRepo.On("GetByID", ctx, tenantID).Return(createTestTenant(tenantID), nil)
+	m.profileRepo.On("ListContacts", ctx, tenantID).Return([]*domain.TenantContact{}, nil)
+	m.profileRepo.On("ListOccupants", ctx, tenantID).Return([]*domain.TenantOccupant{}, nil)
+	m.profileRepo.On("ListPets", ctx, tenantID).Return([]*domain.TenantPet{}, nil)
+	m.documentRepo.On("ListByTenantID", ctx, tenantID).Return([]*domain.TenantDocument{}, nil)
+	m.maintenanceRepo.On("ListByTenantID", ctx, tenantID).Return([]*domain.MaintenanceTicket{}, nil)
+	m.notificationRepo.On("ListByTenantID", ctx, tenantID).Return([]*domain.Notification{
+		{ID: uuid.New(), LeaseID: &lease.ID, Type: domain.NotificationTypeRentReminder, Recipient: "joao@example.com"},
+	}, nil)
+	prospect := &domain.Prospect{ID: uuid.New(), FullName: "João da Silva", Status: domain.ProspectStatusConverted, ConvertedTenantID: &tenantID}
+	m.prospectRepo.On("ListByConvertedTenantID", ctx, tenantID).Return([]*domain.Prospect{prospect}, nil)
+	m.prospectRepo.On("ListVisits", ctx, prospect.ID).Return([]*domain.ProspectVisit{{ID: uuid.New(), ProspectID: prospect.ID}}, nil)
+	m.leaseRepo.On("ListByTenantID", ctx, tenantID).Return([]*domain.Lease{lease}, nil)
+	m.paymentRepo.On("ListByLeaseID", ctx, lease.ID).Return([]*domain.Payment{createPaidPayment(lease.ID)}, nil)
+	m.privacyRepo.On("CreateRequest", ctx, mock.MatchedBy(func(r *domain.TenantDataRequest) bool {
+		return r.Type == domain.TenantDataRequestExport && r.PerformedBy != nil && *r.PerformedBy == adminID
+	})).Return(nil)
+	m.privacyRepo.On("ListRequestsByTenantID", ctx, tenantID).Return([]*domain.TenantDataRequest{}, nil)
+
+	export, err := svc.ExportTenantData(ctx, tenantID, &adminID)
+
+	require.NoError(t, err)
+	assert.Equal(t, tenantID, export.Tenant.ID)
+	require.Len(t, export.Leases, 1)
+	assert.Len(t, export.Leases[0].Payments, 1)
+	assert.Len(t, export.Notifications, 1)
+	require.Len(t, export.Prospects, 1)
+	assert.Equal(t, prospect.ID, export.Prospects[0].Prospect.ID)
+	assert.Len(t, export.Prospects[0].Visits, 1)
+	m.privacyRepo.AssertExpectations(t)
+}
+
+func TestTenantPrivacyService_AnonymizeTenant(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should anonymize former tenant and remove stored files", func(t *testing.T) {
+		svc, m := newTestPrivacyService(t)
+
+		tenantID := uuid.New()
+		adminID := uuid.New()
+		lease := &domain.Lease{ID: uuid.New(), TenantID: tenantID, Status: domain.LeaseStatusExpired}
+		doc := &domain.TenantDocument{ID: uuid.New(), TenantID: tenantID, StorageKey: "tenants/" + tenantID.String() + "/rg"}
+		_, err := m.storage.Save(ctx, doc.StorageKey, strings.NewReader("scan do RG"))
+		require.NoError(t, err)
+
+		m.tenantRepo.On("GetByID", ctx, tenantID).Return(createTestTenant(tenantID), nil)
+		m.leaseRepo.On("ListByTenantID", ctx, tenantID).Return([]*domain.Lease{lease}, nil)
+		m.paymentRepo.On("ListByLeaseID", ctx, lease.ID).Return([]*domain.Payment{createPaidPayment(lease.ID)}, nil)
+		m.documentRepo.On("ListByTenantID", ctx, tenantID).Return([]*domain.TenantDocument{doc}, nil)
+		m.privacyRepo.On("Anonymize", ctx, mock.AnythingOfType("*domain.Tenant"), mock.MatchedBy(func(r *domain.TenantDataRequest) bool {
+			return r.Type == domain.TenantDataRequestAnonymization
+		})).Return(nil)
+
+		tenant, err := svc.AnonymizeTenant(ctx, tenantID, &adminID, nil)
+
+		require.NoError(t, err)
+		assert.True(t, tenant.IsAnonymized())
+		assert.Equal(t, domain.AnonymizedTenantName, tenant.FullName)
+		_, err = m.storage.Open(ctx, doc.StorageKey)
+		assert.ErrorIs(t, err, storage.ErrObjectNotFound)
+		m.privacyRepo.AssertExpectations(t)
+	})
+
+	t.Run("should refuse tenant with active lease", func(t *testing.T) {
+		svc, m := newTestPrivacyService(t)
+
+		tenantID := uuid.New()
+		m.tenantRepo.On("GetByID", ctx, tenantID).Return(createTestTenant(tenantID), nil)
+		m.leaseRepo.On("ListByTenantID", ctx, tenantID).Return([]*domain.Lease{
+			{ID: uuid.New(), TenantID: tenantID, Status: domain.LeaseStatusActive},
+		}, nil)
+
+		_, err := svc.AnonymizeTenant(ctx, tenantID, nil, nil)
+
+		assert.ErrorIs(t, err, ErrCannotAnonymizeActiveTenant)
+		m.privacyRepo.AssertNotCalled(t, "Anonymize", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should refuse tenant with open balance", func(t *testing.T) {
+		svc, m := newTestPrivacyService(t)
+
+		tenantID := uuid.New()
+		lease := &domain.Lease{ID: uuid.New(), TenantID: tenantID, Status: domain.LeaseStatusCancelled}
+		overdue := createPaidPayment(lease.ID)
+		overdue.Status = domain.PaymentStatusOverdue
+		overdue.PaymentDate = nil
+
+		m.tenantRepo.On("GetByID", ctx, tenantID).Return(createTestTenant(tenantID), nil)
+		m.leaseRepo.On("ListByTenantID", ctx, tenantID).Return([]*domain.Lease{lease}, nil)
+		m.paymentRepo.On("ListByLeaseID", ctx, lease.ID).Return([]*domain.Payment{overdue}, nil)
+
+		_, err := svc.AnonymizeTenant(ctx, tenantID, nil, nil)
+
+		assert.ErrorIs(t, err, ErrCannotAnonymizeOpenBalance)
+	})
+
+	t.Run("should refuse tenant already anonymized", func(t *testing.T) {
+		svc, m := newTestPrivacyService(t)
+
+		tenantID := uuid.New()
+		tenant := createTestTenant(tenantID)
+		require.NoError(t, tenant.Anonymize(nil))
+		m.tenantRepo.On("GetByID", ctx, tenantID).Return(tenant, nil)
+
+		_, err := svc.AnonymizeTenant(ctx, tenantID, nil, nil)
+
+		assert.ErrorIs(t, err, domain.ErrTenantAlreadyAnonymized)
+	})
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/shopspring/decimal"
+)
+
+// TenantProfileService contém a lógica de negócio dos dados complementares do morador
+// (empregador, contatos de emergência, referências, ocupantes e animais)
+type TenantProfileService struct {
+	tenantRepo  repository.TenantRepository
+	profileRepo repository.TenantProfileRepository
+}
+
+// NewTenantProfileService cria uma nova instância do serviço de dados complementares
+func NewTenantProfileService(
+	tenantRepo repository.TenantRepository,
+	profileRepo repository.TenantProfileRepository,
+) *TenantProfileService {
+	return &TenantProfileService{
+		tenantRepo:  tenantRepo,
+		profileRepo: profileRepo,
+	}
+}
+
+// TenantProfile reúne o cadastro do morador e seus dados complementares
+type TenantProfile struct {
+	Tenant            *domain.Tenant           `json:"tenant"`
+	EmergencyContacts []*domain.TenantContact  `json:"emergency_contacts"`
+	References        []*domain.TenantContact  `json:"references"`
+	Occupants         []*domain.TenantOccupant `json:"occupants"`
+	Pets              []*domain.TenantPet      `json:"pets"`
+}
+
+// TenantEmployerRequest representa os dados do empregador do morador
+type TenantEmployerRequest struct {
+	CompanyName   string
+	Phone         *string
+	JobTitle      *string
+	MonthlyIncome *decimal.Decimal
+	StartDate     *time.Time
+}
+
+// TenantContactRequest representa um contato de emergência ou referência
+type TenantContactRequest struct {
+	Name         string
+	Relationship string
+	Phone        string
+	Email        *string
+	Notes        *string
+}
+
+// TenantOccupantRequest representa um ocupante da unidade
+type TenantOccupantRequest struct {
+	FullName     string
+	Relationship string
+	Document     *string
+	BirthDate    *time.Time
+}
+
+// TenantPetRequest representa um animal de estimação
+type TenantPetRequest struct {
+	Name    string
+	Species domain.PetSpecies
+	Breed   *string
+	Notes   *string
+}
+
+// GetProfile retorna o cadastro completo do morador
+func (s *TenantProfileService) GetProfile(ctx context.Context, tenantID uuid.UUID) (*TenantProfile, error) {
+	tenant, err := s.getTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	contacts, err := s.profileRepo.ListContacts(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tenant contacts: %w", err)
+	}
+
+	occupants, err := s.profileRepo.ListOccupants(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tenant occupants: %w", err)
+	}
+
+	pets, err := s.profileRepo.ListPets(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tenant pets: %w", err)
+	}
+
+	profile := &TenantProfile{
+		Tenant:            tenant,
+		EmergencyContacts: []*domain.TenantContact{},
+		References:        []*domain.TenantContact{},
+		Occupants:         occupants,
+		Pets:              pets,
+	}
+	for _, contact := range contacts {
+		if contact.Type == domain.ContactTypeReference {
+			profile.References = append(profile.References, contact)
+		} else {
+			profile.EmergencyContacts = append(profile.EmergencyContacts, contact)
+		}
+	}
+
+	return profile, nil
+}
+
+// UpdateEmployer define os dados do empregador do morador (nil remove)
+func (s *TenantProfileService) UpdateEmployer(ctx context.Context, tenantID uuid.UUID, req *TenantEmployerRequest) (*domain.Tenant, error) {
+	tenant, err := s.getTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	var employer *domain.TenantEmployer
+	if req != nil {
+		employer, err = domain.NewTenantEmployer(req.CompanyName)
+		if err != nil {
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+		employer.Phone = req.Phone
+		employer.JobTitle = req.JobTitle
+		employer.MonthlyIncome = req.MonthlyIncome
+		employer.StartDate = req.StartDate
+	}
+
+	if err := tenant.SetEmployer(employer); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	if err := s.tenantRepo.Update(ctx, tenant); err != nil {
+		return nil, fmt.Errorf("error updating tenant: %w", err)
+	}
+
+	return tenant, nil
+}
+
+// ReplaceEmergencyContacts substitui os contatos de emergência do morador
+func (s *TenantProfileService) ReplaceEmergencyContacts(ctx context.Context, tenantID uuid.UUID, reqs []TenantContactRequest) ([]*domain.TenantContact, error) {
+	return s.replaceContacts(ctx, tenantID, domain.ContactTypeEmergency, reqs)
+}
+
+// ReplaceReferences substitui as referências do morador (ex: antigos locadores)
+func (s *TenantProfileService) ReplaceReferences(ctx context.Context, tenantID uuid.UUID, reqs []TenantContactRequest) ([]*domain.TenantContact, error) {
+	return s.replaceContacts(ctx, tenantID, domain.ContactTypeReference, reqs)
+}
+
+// ReplaceOccupants substitui a lista de ocupantes da unidade
+// O próprio morador titular não pode constar como ocupante
+func (s *TenantProfileService) ReplaceOccupants(ctx context.Context, tenantID uuid.UUID, reqs []TenantOccupantRequest) ([]*domain.TenantOccupant, error) {
+	tenant, err := s.getWritableTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	occupants := make([]*domain.TenantOccupant, len(reqs))
+	for i, req := range reqs {
+		occupant, err := domain.NewTenantOccupant(tenantID, req.FullName, req.Relationship, req.Document)
+		if err != nil {
+			return nil, fmt.Errorf("validation error on occupant %d: %w", i+1, err)
+		}
+		occupant.BirthDate = req.BirthDate
+		if err := occupant.Validate(); err != nil {
+			return nil, fmt.Errorf("validation error on occupant %d: %w", i+1, err)
+		}
+		if occupant.Document != nil && *occupant.Document == tenant.CPF {
+			return nil, domain.ErrOccupantIsTenant
+		}
+		occupants[i] = occupant
+	}
+
+	if err := s.profileRepo.ReplaceOccupants(ctx, tenantID, occupants); err != nil {
+		return nil, fmt.Errorf("error saving tenant occupants: %w", err)
+	}
+
+	return occupants, nil
+}
+
+// ReplacePets substitui a lista de animais de estimação do morador
+func (s *TenantProfileService) ReplacePets(ctx context.Context, tenantID uuid.UUID, reqs []TenantPetRequest) ([]*domain.TenantPet, error) {
+	if _, err := s.getWritableTenant(ctx, tenantID); err != nil {
+		return nil, err
+	}
+
+	pets := make([]*domain.TenantPet, len(reqs))
+	for i, req := range reqs {
+		pet, err := domain.NewTenantPet(tenantID, req.Name, req.Species)
+		if err != nil {
+			return nil, fmt.Errorf("validation error on pet %d: %w", i+1, err)
+		}
+		pet.Breed = req.Breed
+		pet.Notes = req.Notes
+		pets[i] = pet
+	}
+
+	if err := s.profileRepo.ReplacePets(ctx, tenantID, pets); err != nil {
+		return nil, fmt.Errorf("error saving tenant pets: %w", err)
+	}
+
+	return pets, nil
+}
+
+// replaceContacts valida e substitui os contatos de um tipo
+func (s *TenantProfileService) replaceContacts(ctx context.Context, tenantID uuid.UUID, contactType domain.TenantContactType, reqs []TenantContactRequest) ([]*domain.TenantContact, error) {
+	if _, err := s.getWritableTenant(ctx, tenantID); err != nil {
+		return nil, err
+	}
+
+	contacts := make([]*domain.TenantContact, len(reqs))
+	for i, req := range reqs {
+		contact, err := domain.NewTenantContact(tenantID, contactType, req.Name, req.Relationship, req.Phone)
+		if err != nil {
+			return nil, fmt.Errorf("validation error on contact %d: %w", i+1, err)
+		}
+		contact.Email = req.Email
+		contact.Notes = req.Notes
+		if err := contact.Validate(); err != nil {
+			return nil, fmt.Errorf("validation error on contact %d: %w", i+1, err)
+		}
+		contacts[i] = contact
+	}
+
+	if err := s.profileRepo.ReplaceContacts(ctx, tenantID, contactType, contacts); err != nil {
+		return nil, fmt.Errorf("error saving tenant contacts: %w", err)
+	}
+
+	return contacts, nil
+}
+
+// getTenant busca o morador e retorna ErrTenantNotFound se não existir
+func (s *TenantProfileService) getTenant(ctx context.Context, tenantID uuid.UUID) (*domain.Tenant, error) {
+	tenant, err := s.tenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tenant: %w", err)
+	}
+	if tenant == nil {
+		return nil, ErrTenantNotFound
+	}
+
+	return tenant, nil
+}
+
+// getWritableTenant busca o morador e impede alterações em moradores anonimizados
+func (s *TenantProfileService) getWritableTenant(ctx context.Context, tenantID uuid.UUID) (*domain.Tenant, error) {
+	tenant, err := s.getTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if tenant.IsAnonymized() {
+		return nil, domain.ErrTenantAnonymized
+	}
+
+	return tenant, nil
+}
@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockTenantProfileRepo é um mock do repository de dados complementares
+type MockTenantProfileRepo struct {
+	mock.Mock
+}
+
+func (m *MockTenantProfileRepo) ListContacts(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantContact, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Get(0).([]*domain.TenantContact), args.Error(1)
+}
+
+func (m *MockTenantProfileRepo) ReplaceContacts(ctx context.Context, tenantID uuid.UUID, contactType domain.TenantContactType, contacts []*domain.TenantContact) error {
+	args := m.Called(ctx, tenantID, contactType, contacts)
+	return args.Error(0)
+}
+
+func (m *MockTenantProfileRepo) ListOccupants(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantOccupant, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Get(0).([]*domain.TenantOccupant), args.Error(1)
+}
+
+func (m *MockTenantProfileRepo) ReplaceOccupants(ctx context.Context, tenantID uuid.UUID, occupants []*domain.TenantOccupant) error {
+	args := m.Called(ctx, tenantID, occupants)
+	return args.Error(0)
+}
+
+func (m *MockTenantProfileRepo) ListPets(ctx context.Context, tenantID uuid.UUID) ([]*domain.TenantPet, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Get(0).([]*domain.TenantPet), args.Error(1)
+}
+
+func (m *MockTenantProfileRepo) ReplacePets(ctx context.Context, tenantID uuid.UUID, pets []*domain.TenantPet) error {
+	args := m.Called(ctx, tenantID, pets)
+	return args.Error(0)
+}
+
+func TestTenantProfileService_GetProfile(t *testing.T) {
+	ctx := context.Background()
+	tenantRepo := new(MockTenantRepository)
+	profileRepo := new(MockTenantProfileRepo)
+	svc := NewTenantProfileService(tenantRepo, profileRepo)
+
+	tenantID := uuid.New()
+	tenantRepo.On("GetByID", ctx, tenantID).Return(createTestTenant(tenantID), nil)
+	profileRepo.On("ListContacts", ctx, tenantID).Return([]*domain.TenantContact{
+		{ID: uuid.New(), TenantID: tenantID, Type: domain.ContactTypeEmergency, Name: "Maria"},
+		{ID: uuid.New(), TenantID: tenantID, Type: domain.ContactTypeReference, Name: "José"},
+		{ID: uuid.New(), TenantID: tenantID, Type: domain.ContactTypeEmergency, Name: "Ana"},
+	}, nil)
+	profileRepo.On("ListOccupants", ctx, tenantID).Return([]*domain.TenantOccupant{}, nil)
+	profileRepo.On("ListPets", ctx, tenantID).Return([]*domain.TenantPet{}, nil)
+
+	profile, err := svc.GetProfile(ctx, tenantID)
+
+	require.NoError(t, err)
+	assert.Len(t, profile.EmergencyContacts, 2)
+	assert.Len(t, profile.References, 1)
+	assert.Equal(t, "José", profile.References[0].Name)
+}
+
+func TestTenantProfileService_TenantNotFound(t *testing.T) {
+	ctx := context.Background()
+	tenantRepo := new(MockTenantRepository)
+	profileRepo := new(MockTenantProfileRepo)
+	svc := NewTenantProfileService(tenantRepo, profileRepo)
+
+	tenantID := uuid.New()
+	tenantRepo.On("GetByID", ctx, tenantID).Return(nil, nil)
+
+	_, err := svc.ReplacePets(ctx, tenantID, []TenantPetRequest{{Name: "Rex", Species: domain.PetSpeciesDog}})
+
+	assert.ErrorIs(t, err, ErrTenantNotFound)
+	profileRepo.AssertNotCalled(t, "ReplacePets", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTenantProfileService_UpdateEmployer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should set employer", func(t *testing.T) {
+		tenantRepo := new(MockTenantRepository)
+		svc := NewTenantProfileService(tenantRepo, new(MockTenantProfileRepo))
+
+		tenantID := uuid.New()
+		income := decimal.NewFromInt(4200)
+		tenantRepo.On("GetByID", ctx, tenantID).Return(createTestTenant(tenantID), nil)
+		tenantRepo.On("Update", ctx, mock.AnythingOfType("*domain.Tenant")).Return(nil)
+
+		tenant, err := svc.UpdateEmployer(ctx, tenantID, &TenantEmployerRequest{
+			CompanyName:   "Padaria Central",
+			MonthlyIncome: &income,
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, tenant.Employer)
+		assert.Equal(t, "Padaria Central", tenant.Employer.CompanyName)
+		tenantRepo.AssertExpectations(t)
+	})
+
+	t.Run("should remove employer", func(t *testing.T) {
+		tenantRepo := new(MockTenantRepository)
+		svc := NewTenantProfileService(tenantRepo, new(MockTenantProfileRepo))
+
+		tenantID := uuid.New()
+		existing := createTestTenant(tenantID)
+		existing.Employer = &domain.TenantEmployer{CompanyName: "Padaria Central"}
+		tenantRepo.On("GetByID", ctx, tenantID).Return(existing, nil)
+		tenantRepo.On("Update", ctx, existing).Return(nil)
+
+		tenant, err := svc.UpdateEmployer(ctx, tenantID, nil)
+
+		require.NoError(t, err)
+		assert.Nil(t, tenant.Employer)
+	})
+
+	t.Run("should reject negative income", func(t *testing.T) {
+		tenantRepo := new(MockTenantRepository)
+		svc := NewTenantProfileService(tenantRepo, new(MockTenantProfileRepo))
+
+		tenantID := uuid.New()
+		income := decimal.NewFromInt(-10)
+		tenantRepo.On("GetByID", ctx, tenantID).Return(createTestTenant(tenantID), nil)
+
+		_, err := svc.UpdateEmployer(ctx, tenantID, &TenantEmployerRequest{
+			CompanyName:   "Padaria Central",
+			MonthlyIncome: &income,
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidMonthlyIncome)
+		tenantRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTenantProfileService_ReplaceOccupants(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should reject tenant as occupant", func(t *testing.T) {
+		tenantRepo := new(MockTenantRepository)
+		profileRepo := new(MockTenantProfileRepo)
+		svc := NewTenantProfileService(tenantRepo, profileRepo)
+
+		tenantID := uuid.New()
+		tenantRepo.On("GetByID", ctx, tenantID).Return(createTestTenant(tenantID), nil)
+
+		document := "12345678909" // mesmo CPF do morador, sem formatação
+		_, err := svc.ReplaceOccupants(ctx, tenantID, []TenantOccupantRequest{
+			{FullName: "João Silva", Relationship: "titular", Document: &document},
+		})
+
+		assert.ErrorIs(t, err, domain.ErrOccupantIsTenant)
+		profileRepo.AssertNotCalled(t, "ReplaceOccupants", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should replace occupants", func(t *testing.T) {
+		tenantRepo := new(MockTenantRepository)
+		profileRepo := new(MockTenantProfileRepo)
+		svc := NewTenantProfileService(tenantRepo, profileRepo)
+
+		tenantID := uuid.New()
+		tenantRepo.On("GetByID", ctx, tenantID).Return(createTestTenant(tenantID), nil)
+		profileRepo.On("ReplaceOccupants", ctx, tenantID, mock.AnythingOfType("[]*domain.TenantOccupant")).Return(nil)
+
+		occupants, err := svc.ReplaceOccupants(ctx, tenantID, []TenantOccupantRequest{
+			{FullName: "Ana Silva", Relationship: "filha"},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, occupants, 1)
+		assert.Equal(t, tenantID, occupants[0].TenantID)
+		profileRepo.AssertExpectations(t)
+	})
+}
+
+func TestTenantProfileService_ReplaceReferences(t *testing.T) {
+	ctx := context.Background()
+	tenantRepo := new(MockTenantRepository)
+	profileRepo := new(MockTenantProfileRepo)
+	svc := NewTenantProfileService(tenantRepo, profileRepo)
+
+	tenantID := uuid.New()
+	tenantRepo.On("GetByID", ctx, tenantID).Return(createTestTenant(tenantID), nil)
+	profileRepo.On("ReplaceContacts", ctx, tenantID, domain.ContactTypeReference, mock.AnythingOfType("[]*domain.TenantContact")).Return(nil)
+
+	contacts, err := svc.ReplaceReferences(ctx, tenantID, []TenantContactRequest{
+		{Name: "Carlos Souza", Relationship: "antigo locador", Phone: "(11) 91234-5678"},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, contacts, 1)
+	assert.Equal(t, domain.ContactTypeReference, contacts[0].Type)
+	profileRepo.AssertExpectations(t)
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+)
+
+// TenantScoreService calcula a pontuação de pagamento dos moradores
+type TenantScoreService struct {
+	tenantRepo  repository.TenantRepository
+	leaseRepo   repository.LeaseRepository
+	paymentRepo repository.PaymentRepository
+}
+
+// NewTenantScoreService cria uma nova instância do serviço de pontuação
+func NewTenantScoreService(
+	tenantRepo repository.TenantRepository,
+	leaseRepo repository.LeaseRepository,
+	paymentRepo repository.PaymentRepository,
+) *TenantScoreService {
+	return &TenantScoreService{
+		tenantRepo:  tenantRepo,
+		leaseRepo:   leaseRepo,
+		paymentRepo: paymentRepo,
+	}
+}
+
+// GetPaymentScore retorna a pontuação de pagamento do morador em todos os seus contratos
+func (s *TenantScoreService) GetPaymentScore(ctx context.Context, tenantID uuid.UUID) (*domain.TenantPaymentScore, error) {
+	tenant, err := s.tenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting tenant: %w", err)
+	}
+	if tenant == nil {
+		return nil, ErrTenantNotFound
+	}
+
+	return calculateTenantPaymentScore(ctx, s.leaseRepo, s.paymentRepo, tenantID)
+}
+
+// calculateTenantPaymentScore reúne os contratos e pagamentos do morador e calcula a pontuação
+func calculateTenantPaymentScore(ctx context.Context, leaseRepo repository.LeaseRepository, paymentRepo repository.PaymentRepository, tenantID uuid.UUID) (*domain.TenantPaymentScore, error) {
+	leases, err := leaseRepo.ListByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tenant leases: %w", err)
+	}
+
+	var payments []*domain.Payment
+	for _, lease := range leases {
+		leasePayments, err := paymentRepo.ListByLeaseID(ctx, lease.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error listing payments for lease %s: %w", lease.ID, err)
+		}
+		payments = append(payments, leasePayments...)
+	}
+
+	return domain.CalculateTenantPaymentScore(tenantID, leases, payments), nil
+}
+
+// lowPaymentScoreWarning retorna o alerta exibido ao criar ou renovar contrato com morador de baixa pontuação
+func lowPaymentScoreWarning(score *domain.TenantPaymentScore) string {
+	return fmt.Sprintf(
+		"Tenant payment score is %d (below %d): %d of %d payments on time, %.1f average days late, %d renegotiations, %d cancelled leases",
+		score.Score,
+		domain.LowPaymentScoreThreshold,
+		score.OnTimePayments,
+		score.EvaluatedPayments,
+		score.AverageDaysLate,
+		score.Renegotiations,
+		score.CancelledLeases,
+	)
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLatePaymentHistory(leaseID uuid.UUID) []*domain.Payment {
+	dueDate := time.Now().AddDate(0, -2, 0)
+	paidLate := dueDate.AddDate(0, 0, 20)
+	return []*domain.Payment{
+		{ID: uuid.New(), LeaseID: leaseID, PaymentType: domain.PaymentTypeRent, Amount: decimal.NewFromInt(800), Status: domain.PaymentStatusPaid, DueDate: dueDate, PaymentDate: &paidLate},
+		{ID: uuid.New(), LeaseID: leaseID, PaymentType: domain.PaymentTypeRent, Amount: decimal.NewFromInt(800), Status: domain.PaymentStatusPaid, DueDate: dueDate, PaymentDate: &paidLate},
+	}
+}
+
+func TestTenantScoreService_GetPaymentScore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should aggregate payments from all leases", func(t *testing.T) {
+		tenantRepo := new(MockTenantRepository)
+		leaseRepo := new(MockLeaseRepo)
+		paymentRepo := new(MockPaymentRepo)
+		svc := NewTenantScoreService(tenantRepo, leaseRepo, paymentRepo)
+
+		tenantID := uuid.New()
+		oldLease := &domain.Lease{ID: uuid.New(), TenantID: tenantID, Status: domain.LeaseStatusExpired}
+		currentLease := &domain.Lease{ID: uuid.New(), TenantID: tenantID, Status: domain.LeaseStatusActive}
+		paidAt := time.Now().AddDate(0, -1, -1)
+
+		tenantRepo.On("GetByID", ctx, tenantID).Return(createTestTenant(tenantID), nil)
+		leaseRepo.On("ListByTenantID", ctx, tenantID).Return([]*domain.Lease{oldLease, currentLease}, nil)
+		paymentRepo.On("ListByLeaseID", ctx, oldLease.ID).Return(createLatePaymentHistory(oldLease.ID), nil)
+		paymentRepo.On("ListByLeaseID", ctx, currentLease.ID).Return([]*domain.Payment{
+			{ID: uuid.New(), LeaseID: currentLease.ID, PaymentType: domain.PaymentTypeRent, Amount: decimal.NewFromInt(800), Status: domain.PaymentStatusPaid, DueDate: time.Now().AddDate(0, -1, 0), PaymentDate: &paidAt},
+		}, nil)
+
+		score, err := svc.GetPaymentScore(ctx, tenantID)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, score.EvaluatedPayments)
+		assert.Equal(t, 1, score.OnTimePayments)
+		assert.Equal(t, 2, score.LatePayments)
+		leaseRepo.AssertExpectations(t)
+		paymentRepo.AssertExpectations(t)
+	})
+
+	t.Run("should return error when tenant does not exist", func(t *testing.T) {
+		tenantRepo := new(MockTenantRepository)
+		svc := NewTenantScoreService(tenantRepo, new(MockLeaseRepo), new(MockPaymentRepo))
+
+		tenantID := uuid.New()
+		tenantRepo.On("GetByID", ctx, tenantID).Return(nil, nil)
+
+		_, err := svc.GetPaymentScore(ctx, tenantID)
+
+		assert.ErrorIs(t, err, ErrTenantNotFound)
+	})
+}
+
+func TestLeaseService_CheckTenantPaymentScore(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should warn when score is below threshold", func(t *testing.T) {
+		leaseRepo := new(MockLeaseRepo)
+		paymentRepo := new(MockPaymentRepo)
+		svc := NewLeaseService(leaseRepo, nil, nil, NewPaymentService(paymentRepo, leaseRepo), nil, nil, nil)
+
+		tenantID := uuid.New()
+		lease := &domain.Lease{ID: uuid.New(), TenantID: tenantID, Status: domain.LeaseStatusCancelled}
+		leaseRepo.On("ListByTenantID", ctx, tenantID).Return([]*domain.Lease{lease}, nil)
+		paymentRepo.On("ListByLeaseID", ctx, lease.ID).Return(createLatePaymentHistory(lease.ID), nil)
+
+		score, warnings := svc.checkTenantPaymentScore(ctx, tenantID)
+
+		require.NotNil(t, score)
+		assert.True(t, score.IsBelowThreshold())
+		require.Len(t, warnings, 1)
+		assert.Contains(t, warnings[0], "payment score")
+	})
+
+	t.Run("should not warn for tenant without history", func(t *testing.T) {
+		leaseRepo := new(MockLeaseRepo)
+		paymentRepo := new(MockPaymentRepo)
+		svc := NewLeaseService(leaseRepo, nil, nil, NewPaymentService(paymentRepo, leaseRepo), nil, nil, nil)
+
+		tenantID := uuid.New()
+		leaseRepo.On("ListByTenantID", ctx, tenantID).Return([]*domain.Lease{}, nil)
+
+		score, warnings := svc.checkTenantPaymentScore(ctx, tenantID)
+
+		require.NotNil(t, score)
+		assert.Equal(t, domain.PaymentScoreNoHistory, score.Rating)
+		assert.Empty(t, warnings)
+	})
+}
@@ -32,6 +32,9 @@ func NewTenantService(tenantRepo repository.TenantRepository) *TenantService {
 
 // CreateTenant cria um novo morador com validações de negócio
 func (s *TenantService) CreateTenant(ctx context.Context, fullName, cpf, phone, email, idDocType, idDocNumber string) (*domain.Tenant, error) {
+	// Normaliza o documento (CPF ou CNPJ) para comparar com o formato armazenado
+	cpf = domain.NormalizeDocument(cpf)
+
 	// Verifica se CPF já existe
 	exists, err := s.tenantRepo.ExistsByCPF(ctx, cpf)
 	if err != nil {
@@ -76,9 +79,9 @@ func (s *TenantService) GetTenantByID(ctx context.Context, id uuid.UUID) (*domai
 	return tenant, nil
 }
 
-// GetTenantByCPF busca um morador pelo CPF
+// GetTenantByCPF busca um morador pelo CPF ou CNPJ, com ou sem formatação
 func (s *TenantService) GetTenantByCPF(ctx context.Context, cpf string) (*domain.Tenant, error) {
-	tenant, err := s.tenantRepo.GetByCPF(ctx, cpf)
+	tenant, err := s.tenantRepo.GetByCPF(ctx, domain.NormalizeDocument(cpf))
 	if err != nil {
 		return nil, fmt.Errorf("error getting tenant: %w", err)
 	}
@@ -98,6 +101,15 @@ func (s *TenantService) ListTenants(ctx context.Context) ([]*domain.Tenant, erro
 	return tenants, nil
 }
 
+// ListTenantsByProperty retorna os moradores com contratos em um imóvel
+func (s *TenantService) ListTenantsByProperty(ctx context.Context, propertyID uuid.UUID) ([]*domain.Tenant, error) {
+	tenants, err := s.tenantRepo.ListByPropertyID(ctx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tenants by property: %w", err)
+	}
+	return tenants, nil
+}
+
 // SearchTenantsByName busca moradores por nome (case-insensitive)
 func (s *TenantService) SearchTenantsByName(ctx context.Context, name string) ([]*domain.Tenant, error) {
 	tenants, err := s.tenantRepo.SearchByName(ctx, name)
@@ -128,6 +140,24 @@ func (s *TenantService) UpdateTenant(ctx context.Context, id uuid.UUID, fullName
 	return tenant, nil
 }
 
+// SetMessagingOptOut registra se o morador aceita receber mensagens por WhatsApp/SMS
+func (s *TenantService) SetMessagingOptOut(ctx context.Context, id uuid.UUID, optOut bool) (*domain.Tenant, error) {
+	tenant, err := s.GetTenantByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tenant.SetMessagingOptOut(optOut); err != nil {
+		return nil, err
+	}
+
+	if err := s.tenantRepo.Update(ctx, tenant); err != nil {
+		return nil, fmt.Errorf("error updating tenant: %w", err)
+	}
+
+	return tenant, nil
+}
+
 // DeleteTenant remove um morador
 func (s *TenantService) DeleteTenant(ctx context.Context, id uuid.UUID) error {
 	// Buscar morador
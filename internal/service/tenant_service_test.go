@@ -45,6 +45,14 @@ func (m *MockTenantRepository) List(ctx context.Context) ([]*domain.Tenant, erro
 	return args.Get(0).([]*domain.Tenant), args.Error(1)
 }
 
+func (m *MockTenantRepository) ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.Tenant, error) {
+	args := m.Called(ctx, propertyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Tenant), args.Error(1)
+}
+
 func (m *MockTenantRepository) SearchByName(ctx context.Context, name string) ([]*domain.Tenant, error) {
 	args := m.Called(ctx, name)
 	if args.Get(0) == nil {
@@ -83,16 +91,16 @@ func TestTenantService_CreateTenant(t *testing.T) {
 		service := NewTenantService(mockRepo)
 
 		// Mock: CPF não existe
-		mockRepo.On("ExistsByCPF", ctx, "123.456.789-00").Return(false, nil)
+		mockRepo.On("ExistsByCPF", ctx, "123.456.789-09").Return(false, nil)
 		// Mock: criação bem-sucedida
 		mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Tenant")).Return(nil)
 
-		tenant, err := service.CreateTenant(ctx, "João da Silva", "123.456.789-00", "11987654321", "joao@example.com", "RG", "123456")
+		tenant, err := service.CreateTenant(ctx, "João da Silva", "123.456.789-09", "11987654321", "joao@example.com", "RG", "123456")
 
 		require.NoError(t, err)
 		assert.NotNil(t, tenant)
 		assert.Equal(t, "João da Silva", tenant.FullName)
-		assert.Equal(t, "123.456.789-00", tenant.CPF)
+		assert.Equal(t, "123.456.789-09", tenant.CPF)
 		assert.Equal(t, "RG", tenant.IDDocumentType)
 		mockRepo.AssertExpectations(t)
 	})
@@ -117,9 +125,9 @@ func TestTenantService_CreateTenant(t *testing.T) {
 		mockRepo := new(MockTenantRepository)
 		service := NewTenantService(mockRepo)
 
-		mockRepo.On("ExistsByCPF", ctx, "123.456.789-00").Return(true, nil)
+		mockRepo.On("ExistsByCPF", ctx, "123.456.789-09").Return(true, nil)
 
-		tenant, err := service.CreateTenant(ctx, "João da Silva", "123.456.789-00", "11987654321", "", "", "")
+		tenant, err := service.CreateTenant(ctx, "João da Silva", "123.456.789-09", "11987654321", "", "", "")
 
 		assert.Error(t, err)
 		assert.Nil(t, tenant)
@@ -127,15 +135,29 @@ func TestTenantService_CreateTenant(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 
-	t.Run("should fail with invalid CPF format", func(t *testing.T) {
+	t.Run("should normalize CPF before checking duplicates", func(t *testing.T) {
 		mockRepo := new(MockTenantRepository)
 		service := NewTenantService(mockRepo)
 
-		mockRepo.On("ExistsByCPF", ctx, "12345678900").Return(false, nil)
+		mockRepo.On("ExistsByCPF", ctx, "123.456.789-09").Return(false, nil)
+		mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Tenant")).Return(nil)
 
-		tenant, err := service.CreateTenant(ctx, "João da Silva", "12345678900", "11987654321", "", "", "")
+		tenant, err := service.CreateTenant(ctx, "João da Silva", "12345678909", "11987654321", "", "", "")
 
-		assert.Error(t, err)
+		require.NoError(t, err)
+		assert.Equal(t, "123.456.789-09", tenant.CPF)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should fail with invalid CPF check digits", func(t *testing.T) {
+		mockRepo := new(MockTenantRepository)
+		service := NewTenantService(mockRepo)
+
+		mockRepo.On("ExistsByCPF", ctx, "123.456.789-00").Return(false, nil)
+
+		tenant, err := service.CreateTenant(ctx, "João da Silva", "123.456.789-00", "11987654321", "", "", "")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidCPFCheck)
 		assert.Nil(t, tenant)
 		mockRepo.AssertExpectations(t)
 	})
@@ -144,9 +166,9 @@ func TestTenantService_CreateTenant(t *testing.T) {
 		mockRepo := new(MockTenantRepository)
 		service := NewTenantService(mockRepo)
 
-		mockRepo.On("ExistsByCPF", ctx, "123.456.789-00").Return(false, nil)
+		mockRepo.On("ExistsByCPF", ctx, "123.456.789-09").Return(false, nil)
 
-		tenant, err := service.CreateTenant(ctx, "", "123.456.789-00", "11987654321", "", "", "")
+		tenant, err := service.CreateTenant(ctx, "", "123.456.789-09", "11987654321", "", "", "")
 
 		assert.Error(t, err)
 		assert.Nil(t, tenant)
@@ -162,7 +184,7 @@ func TestTenantService_GetTenantByID(t *testing.T) {
 		mockRepo := new(MockTenantRepository)
 		service := NewTenantService(mockRepo)
 
-		expectedTenant, _ := domain.NewTenant("João da Silva", "123.456.789-00", "11987654321", "")
+		expectedTenant, _ := domain.NewTenant("João da Silva", "123.456.789-09", "11987654321", "")
 		expectedTenant.ID = tenantID
 
 		mockRepo.On("GetByID", ctx, tenantID).Return(expectedTenant, nil)
@@ -196,11 +218,11 @@ func TestTenantService_GetTenantByCPF(t *testing.T) {
 		mockRepo := new(MockTenantRepository)
 		service := NewTenantService(mockRepo)
 
-		expectedTenant, _ := domain.NewTenant("João da Silva", "123.456.789-00", "11987654321", "")
+		expectedTenant, _ := domain.NewTenant("João da Silva", "123.456.789-09", "11987654321", "")
 
-		mockRepo.On("GetByCPF", ctx, "123.456.789-00").Return(expectedTenant, nil)
+		mockRepo.On("GetByCPF", ctx, "123.456.789-09").Return(expectedTenant, nil)
 
-		tenant, err := service.GetTenantByCPF(ctx, "123.456.789-00")
+		tenant, err := service.GetTenantByCPF(ctx, "123.456.789-09")
 
 		require.NoError(t, err)
 		assert.Equal(t, expectedTenant, tenant)
@@ -229,7 +251,7 @@ func TestTenantService_ListTenants(t *testing.T) {
 		mockRepo := new(MockTenantRepository)
 		service := NewTenantService(mockRepo)
 
-		tenant1, _ := domain.NewTenant("João da Silva", "123.456.789-00", "11987654321", "")
+		tenant1, _ := domain.NewTenant("João da Silva", "123.456.789-09", "11987654321", "")
 		tenant2, _ := domain.NewTenant("Maria Santos", "987.654.321-00", "11912345678", "")
 		expectedTenants := []*domain.Tenant{tenant1, tenant2}
 
@@ -251,7 +273,7 @@ func TestTenantService_SearchTenantsByName(t *testing.T) {
 		mockRepo := new(MockTenantRepository)
 		service := NewTenantService(mockRepo)
 
-		tenant1, _ := domain.NewTenant("João da Silva", "123.456.789-00", "11987654321", "")
+		tenant1, _ := domain.NewTenant("João da Silva", "123.456.789-09", "11987654321", "")
 		expectedTenants := []*domain.Tenant{tenant1}
 
 		mockRepo.On("SearchByName", ctx, "João").Return(expectedTenants, nil)
@@ -273,7 +295,7 @@ func TestTenantService_UpdateTenant(t *testing.T) {
 		mockRepo := new(MockTenantRepository)
 		service := NewTenantService(mockRepo)
 
-		existingTenant, _ := domain.NewTenant("João da Silva", "123.456.789-00", "11987654321", "joao@example.com")
+		existingTenant, _ := domain.NewTenant("João da Silva", "123.456.789-09", "11987654321", "joao@example.com")
 		existingTenant.ID = tenantID
 
 		mockRepo.On("GetByID", ctx, tenantID).Return(existingTenant, nil)
@@ -303,6 +325,43 @@ func TestTenantService_UpdateTenant(t *testing.T) {
 	})
 }
 
+func TestTenantService_SetMessagingOptOut(t *testing.T) {
+	ctx := context.Background()
+	tenantID := uuid.New()
+
+	t.Run("should toggle messaging opt-out", func(t *testing.T) {
+		mockRepo := new(MockTenantRepository)
+		service := NewTenantService(mockRepo)
+
+		existingTenant, _ := domain.NewTenant("João da Silva", "123.456.789-09", "11987654321", "joao@example.com")
+		existingTenant.ID = tenantID
+
+		mockRepo.On("GetByID", ctx, tenantID).Return(existingTenant, nil)
+		mockRepo.On("Update", ctx, existingTenant).Return(nil)
+
+		tenant, err := service.SetMessagingOptOut(ctx, tenantID, true)
+		require.NoError(t, err)
+		assert.True(t, tenant.HasMessagingOptOut())
+
+		tenant, err = service.SetMessagingOptOut(ctx, tenantID, false)
+		require.NoError(t, err)
+		assert.False(t, tenant.HasMessagingOptOut())
+		mockRepo.AssertNumberOfCalls(t, "Update", 2)
+	})
+
+	t.Run("should fail when tenant not found", func(t *testing.T) {
+		mockRepo := new(MockTenantRepository)
+		service := NewTenantService(mockRepo)
+
+		mockRepo.On("GetByID", ctx, tenantID).Return(nil, nil)
+
+		_, err := service.SetMessagingOptOut(ctx, tenantID, true)
+
+		assert.Equal(t, ErrTenantNotFound, err)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
 func TestTenantService_DeleteTenant(t *testing.T) {
 	ctx := context.Background()
 	tenantID := uuid.New()
@@ -311,7 +370,7 @@ func TestTenantService_DeleteTenant(t *testing.T) {
 		mockRepo := new(MockTenantRepository)
 		service := NewTenantService(mockRepo)
 
-		existingTenant, _ := domain.NewTenant("João da Silva", "123.456.789-00", "11987654321", "")
+		existingTenant, _ := domain.NewTenant("João da Silva", "123.456.789-09", "11987654321", "")
 		existingTenant.ID = tenantID
 
 		mockRepo.On("GetByID", ctx, tenantID).Return(existingTenant, nil)
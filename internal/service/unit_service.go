@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
@@ -21,20 +22,41 @@ var (
 
 // UnitService contém a lógica de negócio para gestão de unidades
 type UnitService struct {
-	unitRepo repository.UnitRepository
+	unitRepo     repository.UnitRepository
+	historyRepo  repository.UnitStatusHistoryRepository
+	propertyRepo repository.PropertyRepository
+	events       EventPublisher
 }
 
 // NewUnitService cria uma nova instância do serviço de unidades
-func NewUnitService(unitRepo repository.UnitRepository) *UnitService {
+func NewUnitService(unitRepo repository.UnitRepository, historyRepo repository.UnitStatusHistoryRepository, propertyRepo repository.PropertyRepository) *UnitService {
 	return &UnitService{
-		unitRepo: unitRepo,
+		unitRepo:     unitRepo,
+		historyRepo:  historyRepo,
+		propertyRepo: propertyRepo,
 	}
 }
 
-// CreateUnit cria uma nova unidade com validações de negócio
-func (s *UnitService) CreateUnit(ctx context.Context, number string, floor int, baseRentValue, renovatedRentValue decimal.Decimal) (*domain.Unit, error) {
-	// Verifica se número já existe
-	existing, err := s.unitRepo.GetByNumber(ctx, number)
+// SetEventPublisher habilita a publicação de eventos para integrações externas (webhooks)
+func (s *UnitService) SetEventPublisher(events EventPublisher) {
+	s.events = events
+}
+
+// CreateUnit cria uma nova unidade em um imóvel com validações de negócio
+func (s *UnitService) CreateUnit(ctx context.Context, propertyID uuid.UUID, number string, floor int, baseRentValue, renovatedRentValue decimal.Decimal) (*domain.Unit, error) {
+	// Verifica se o imóvel existe
+	if s.propertyRepo != nil {
+		property, err := s.propertyRepo.GetByID(ctx, propertyID)
+		if err != nil {
+			return nil, fmt.Errorf("error getting property: %w", err)
+		}
+		if property == nil {
+			return nil, ErrPropertyNotFound
+		}
+	}
+
+	// Verifica se número já existe no imóvel
+	existing, err := s.unitRepo.GetByNumber(ctx, propertyID, number)
 	if err != nil {
 		return nil, fmt.Errorf("error checking unit number: %w", err)
 	}
@@ -47,12 +69,16 @@ func (s *UnitService) CreateUnit(ctx context.Context, number string, floor int,
 	if err != nil {
 		return nil, fmt.Errorf("error creating unit: %w", err)
 	}
+	unit.PropertyID = propertyID
 
 	// Persistir no banco
 	if err := s.unitRepo.Create(ctx, unit); err != nil {
 		return nil, fmt.Errorf("error saving unit: %w", err)
 	}
 
+	// Registrar status inicial no histórico
+	recordUnitStatusChange(ctx, s.historyRepo, s.events, unit.ID, unit.Status, domain.UnitStatusChangeReasonUnitCreated, nil)
+
 	return unit, nil
 }
 
@@ -69,9 +95,9 @@ func (s *UnitService) GetUnitByID(ctx context.Context, id uuid.UUID) (*domain.Un
 	return unit, nil
 }
 
-// GetUnitByNumber busca uma unidade pelo número
-func (s *UnitService) GetUnitByNumber(ctx context.Context, number string) (*domain.Unit, error) {
-	unit, err := s.unitRepo.GetByNumber(ctx, number)
+// GetUnitByNumber busca uma unidade pelo número dentro de um imóvel
+func (s *UnitService) GetUnitByNumber(ctx context.Context, propertyID uuid.UUID, number string) (*domain.Unit, error) {
+	unit, err := s.unitRepo.GetByNumber(ctx, propertyID, number)
 	if err != nil {
 		return nil, fmt.Errorf("error getting unit: %w", err)
 	}
@@ -90,6 +116,15 @@ func (s *UnitService) ListUnits(ctx context.Context) ([]*domain.Unit, error) {
 	return units, nil
 }
 
+// ListUnitsByProperty retorna as unidades de um imóvel
+func (s *UnitService) ListUnitsByProperty(ctx context.Context, propertyID uuid.UUID) ([]*domain.Unit, error) {
+	units, err := s.unitRepo.ListByPropertyID(ctx, propertyID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing units by property: %w", err)
+	}
+	return units, nil
+}
+
 // ListUnitsByStatus retorna unidades filtradas por status
 func (s *UnitService) ListUnitsByStatus(ctx context.Context, status domain.UnitStatus) ([]*domain.Unit, error) {
 	units, err := s.unitRepo.ListByStatus(ctx, status)
@@ -127,7 +162,7 @@ func (s *UnitService) UpdateUnit(ctx context.Context, id uuid.UUID, number strin
 
 	// Validar se o novo número já existe (se mudou)
 	if unit.Number != number {
-		existing, err := s.unitRepo.GetByNumber(ctx, number)
+		existing, err := s.unitRepo.GetByNumber(ctx, unit.PropertyID, number)
 		if err != nil {
 			return nil, fmt.Errorf("error checking unit number: %w", err)
 		}
@@ -178,6 +213,8 @@ func (s *UnitService) UpdateUnitStatus(ctx context.Context, id uuid.UUID, newSta
 		return fmt.Errorf("error updating unit status: %w", err)
 	}
 
+	recordUnitStatusChange(ctx, s.historyRepo, s.events, id, newStatus, domain.UnitStatusChangeReasonManual, nil)
+
 	return nil
 }
 
@@ -272,3 +309,127 @@ type OccupancyStats struct {
 	Renovation    int64   `json:"renovation"`
 	OccupancyRate float64 `json:"occupancy_rate"` // Percentual
 }
+
+// UnitTimeline representa a linha do tempo de status de uma unidade
+type UnitTimeline struct {
+	UnitID              uuid.UUID                  `json:"unit_id"`
+	CurrentStatus       domain.UnitStatus          `json:"current_status"`
+	DaysInCurrentStatus int                        `json:"days_in_current_status"`
+	Changes             []*domain.UnitStatusChange `json:"changes"`
+	Periods             []*domain.UnitStatusPeriod `json:"periods"`
+}
+
+// GetUnitTimeline retorna o histórico de status de uma unidade agrupado em períodos
+func (s *UnitService) GetUnitTimeline(ctx context.Context, id uuid.UUID) (*UnitTimeline, error) {
+	unit, err := s.GetUnitByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.historyRepo.ListByUnitID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error listing unit status history: %w", err)
+	}
+
+	periods := domain.BuildUnitStatusPeriods(changes, time.Now())
+
+	timeline := &UnitTimeline{
+		UnitID:        unit.ID,
+		CurrentStatus: unit.Status,
+		Changes:       changes,
+		Periods:       periods,
+	}
+	if len(periods) > 0 {
+		timeline.DaysInCurrentStatus = periods[len(periods)-1].Days
+	}
+
+	return timeline, nil
+}
+
+// OccupancyHistoryPoint representa a taxa de ocupação de um mês
+type OccupancyHistoryPoint struct {
+	Month            string  `json:"month"` // YYYY-MM
+	OccupiedUnitDays float64 `json:"occupied_unit_days"`
+	TrackedUnitDays  float64 `json:"tracked_unit_days"`
+	OccupancyRate    float64 `json:"occupancy_rate"` // Percentual
+}
+
+// GetOccupancyHistory calcula a taxa de ocupação mensal dos últimos meses a partir do histórico de status
+// A taxa considera dias-unidade ocupados sobre dias-unidade registrados no histórico
+func (s *UnitService) GetOccupancyHistory(ctx context.Context, months int) ([]*OccupancyHistoryPoint, error) {
+	now := time.Now()
+
+	changes, err := s.historyRepo.ListUntil(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("error listing unit status history: %w", err)
+	}
+
+	// Agrupar o histórico por unidade (já vem em ordem cronológica)
+	changesByUnit := make(map[uuid.UUID][]*domain.UnitStatusChange)
+	for _, change := range changes {
+		changesByUnit[change.UnitID] = append(changesByUnit[change.UnitID], change)
+	}
+
+	firstMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, -(months - 1), 0)
+
+	points := make([]*OccupancyHistoryPoint, 0, months)
+	for i := 0; i < months; i++ {
+		start := firstMonth.AddDate(0, i, 0)
+		end := start.AddDate(0, 1, 0)
+		if end.After(now) {
+			end = now
+		}
+
+		var occupied, tracked time.Duration
+		for _, unitChanges := range changesByUnit {
+			o, t := domain.OccupiedDurationBetween(unitChanges, start, end)
+			occupied += o
+			tracked += t
+		}
+
+		occupancyRate := 0.0
+		if tracked > 0 {
+			occupancyRate = (float64(occupied) / float64(tracked)) * 100
+		}
+
+		points = append(points, &OccupancyHistoryPoint{
+			Month:            start.Format("2006-01"),
+			OccupiedUnitDays: occupied.Hours() / 24,
+			TrackedUnitDays:  tracked.Hours() / 24,
+			OccupancyRate:    occupancyRate,
+		})
+	}
+
+	return points, nil
+}
+
+// recordUnitStatusChange registra uma transição de status no histórico da unidade e publica o evento unit.status_changed
+// Falhas no histórico não devem impedir a operação principal
+func recordUnitStatusChange(ctx context.Context, historyRepo repository.UnitStatusHistoryRepository, events EventPublisher, unitID uuid.UUID, to domain.UnitStatus, reason domain.UnitStatusChangeReason, referenceID *uuid.UUID) {
+	var from *domain.UnitStatus
+	if historyRepo != nil {
+		latest, err := historyRepo.GetLatestByUnitID(ctx, unitID)
+		if err != nil {
+			fmt.Printf("Warning: failed to get status history for unit %s: %v\n", unitID, err)
+		} else if latest != nil {
+			// Sem transição real, nada a registrar
+			if latest.ToStatus == to {
+				return
+			}
+			previous := latest.ToStatus
+			from = &previous
+		}
+	}
+
+	change := domain.NewUnitStatusChange(unitID, from, to, reason, referenceID)
+	if historyRepo != nil {
+		if err := historyRepo.Create(ctx, change); err != nil {
+			fmt.Printf("Warning: failed to record status change for unit %s: %v\n", unitID, err)
+		}
+	}
+
+	// O status inicial da unidade não é uma mudança de status para as integrações
+	if reason != domain.UnitStatusChangeReasonUnitCreated {
+		publishEvent(ctx, events, domain.WebhookEventUnitStatusChanged, change)
+	}
+}
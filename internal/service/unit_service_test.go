@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
@@ -30,8 +31,8 @@ func (m *MockUnitRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain
 	return args.Get(0).(*domain.Unit), args.Error(1)
 }
 
-func (m *MockUnitRepository) GetByNumber(ctx context.Context, number string) (*domain.Unit, error) {
-	args := m.Called(ctx, number)
+func (m *MockUnitRepository) GetByNumber(ctx context.Context, propertyID uuid.UUID, number string) (*domain.Unit, error) {
+	args := m.Called(ctx, propertyID, number)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -46,6 +47,14 @@ func (m *MockUnitRepository) List(ctx context.Context) ([]*domain.Unit, error) {
 	return args.Get(0).([]*domain.Unit), args.Error(1)
 }
 
+func (m *MockUnitRepository) ListByPropertyID(ctx context.Context, propertyID uuid.UUID) ([]*domain.Unit, error) {
+	args := m.Called(ctx, propertyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Unit), args.Error(1)
+}
+
 func (m *MockUnitRepository) ListByStatus(ctx context.Context, status domain.UnitStatus) ([]*domain.Unit, error) {
 	args := m.Called(ctx, status)
 	if args.Get(0) == nil {
@@ -95,39 +104,75 @@ func (m *MockUnitRepository) CountByStatus(ctx context.Context, status domain.Un
 	return args.Get(0).(int64), args.Error(1)
 }
 
+// MockUnitStatusHistoryRepo é um mock do repository de histórico de status
+type MockUnitStatusHistoryRepo struct {
+	mock.Mock
+}
+
+func (m *MockUnitStatusHistoryRepo) Create(ctx context.Context, change *domain.UnitStatusChange) error {
+	args := m.Called(ctx, change)
+	return args.Error(0)
+}
+
+func (m *MockUnitStatusHistoryRepo) ListByUnitID(ctx context.Context, unitID uuid.UUID) ([]*domain.UnitStatusChange, error) {
+	args := m.Called(ctx, unitID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.UnitStatusChange), args.Error(1)
+}
+
+func (m *MockUnitStatusHistoryRepo) GetLatestByUnitID(ctx context.Context, unitID uuid.UUID) (*domain.UnitStatusChange, error) {
+	args := m.Called(ctx, unitID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UnitStatusChange), args.Error(1)
+}
+
+func (m *MockUnitStatusHistoryRepo) ListUntil(ctx context.Context, until time.Time) ([]*domain.UnitStatusChange, error) {
+	args := m.Called(ctx, until)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.UnitStatusChange), args.Error(1)
+}
+
 // Tests
 
 func TestUnitService_CreateUnit(t *testing.T) {
 	ctx := context.Background()
 	baseRent := decimal.NewFromInt(800)
 	renovatedRent := decimal.NewFromInt(900)
+	propertyID := uuid.New()
 
 	t.Run("should create unit successfully", func(t *testing.T) {
 		mockRepo := new(MockUnitRepository)
-		service := NewUnitService(mockRepo)
+		service := NewUnitService(mockRepo, nil, nil)
 
 		// Mock: número não existe
-		mockRepo.On("GetByNumber", ctx, "101").Return(nil, nil)
+		mockRepo.On("GetByNumber", ctx, propertyID, "101").Return(nil, nil)
 		// Mock: criação bem-sucedida
 		mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Unit")).Return(nil)
 
-		unit, err := service.CreateUnit(ctx, "101", 1, baseRent, renovatedRent)
+		unit, err := service.CreateUnit(ctx, propertyID, "101", 1, baseRent, renovatedRent)
 
 		require.NoError(t, err)
 		assert.NotNil(t, unit)
 		assert.Equal(t, "101", unit.Number)
 		assert.Equal(t, 1, unit.Floor)
+		assert.Equal(t, propertyID, unit.PropertyID)
 		mockRepo.AssertExpectations(t)
 	})
 
 	t.Run("should fail when number already exists", func(t *testing.T) {
 		mockRepo := new(MockUnitRepository)
-		service := NewUnitService(mockRepo)
+		service := NewUnitService(mockRepo, nil, nil)
 
 		existingUnit, _ := domain.NewUnit("101", 1, baseRent, renovatedRent)
-		mockRepo.On("GetByNumber", ctx, "101").Return(existingUnit, nil)
+		mockRepo.On("GetByNumber", ctx, propertyID, "101").Return(existingUnit, nil)
 
-		unit, err := service.CreateUnit(ctx, "101", 1, baseRent, renovatedRent)
+		unit, err := service.CreateUnit(ctx, propertyID, "101", 1, baseRent, renovatedRent)
 
 		assert.Error(t, err)
 		assert.Nil(t, unit)
@@ -137,16 +182,30 @@ func TestUnitService_CreateUnit(t *testing.T) {
 
 	t.Run("should fail with invalid data", func(t *testing.T) {
 		mockRepo := new(MockUnitRepository)
-		service := NewUnitService(mockRepo)
+		service := NewUnitService(mockRepo, nil, nil)
 
-		mockRepo.On("GetByNumber", ctx, "").Return(nil, nil)
+		mockRepo.On("GetByNumber", ctx, propertyID, "").Return(nil, nil)
 
-		unit, err := service.CreateUnit(ctx, "", 1, baseRent, renovatedRent)
+		unit, err := service.CreateUnit(ctx, propertyID, "", 1, baseRent, renovatedRent)
 
 		assert.Error(t, err)
 		assert.Nil(t, unit)
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("should fail when property does not exist", func(t *testing.T) {
+		mockRepo := new(MockUnitRepository)
+		mockPropertyRepo := new(MockPropertyRepo)
+		service := NewUnitService(mockRepo, nil, mockPropertyRepo)
+
+		mockPropertyRepo.On("GetByID", ctx, propertyID).Return(nil, nil)
+
+		unit, err := service.CreateUnit(ctx, propertyID, "101", 1, baseRent, renovatedRent)
+
+		assert.Nil(t, unit)
+		assert.ErrorIs(t, err, ErrPropertyNotFound)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
 }
 
 func TestUnitService_GetUnitByID(t *testing.T) {
@@ -155,7 +214,7 @@ func TestUnitService_GetUnitByID(t *testing.T) {
 
 	t.Run("should get unit by id", func(t *testing.T) {
 		mockRepo := new(MockUnitRepository)
-		service := NewUnitService(mockRepo)
+		service := NewUnitService(mockRepo, nil, nil)
 
 		expectedUnit, _ := domain.NewUnit("101", 1, decimal.NewFromInt(800), decimal.NewFromInt(900))
 		expectedUnit.ID = unitID
@@ -171,7 +230,7 @@ func TestUnitService_GetUnitByID(t *testing.T) {
 
 	t.Run("should return error when unit not found", func(t *testing.T) {
 		mockRepo := new(MockUnitRepository)
-		service := NewUnitService(mockRepo)
+		service := NewUnitService(mockRepo, nil, nil)
 
 		mockRepo.On("GetByID", ctx, unitID).Return(nil, nil)
 
@@ -190,7 +249,7 @@ func TestUnitService_DeleteUnit(t *testing.T) {
 
 	t.Run("should delete available unit", func(t *testing.T) {
 		mockRepo := new(MockUnitRepository)
-		service := NewUnitService(mockRepo)
+		service := NewUnitService(mockRepo, nil, nil)
 
 		unit, _ := domain.NewUnit("101", 1, decimal.NewFromInt(800), decimal.NewFromInt(900))
 		unit.ID = unitID
@@ -207,7 +266,7 @@ func TestUnitService_DeleteUnit(t *testing.T) {
 
 	t.Run("should not delete occupied unit", func(t *testing.T) {
 		mockRepo := new(MockUnitRepository)
-		service := NewUnitService(mockRepo)
+		service := NewUnitService(mockRepo, nil, nil)
 
 		unit, _ := domain.NewUnit("101", 1, decimal.NewFromInt(800), decimal.NewFromInt(900))
 		unit.ID = unitID
@@ -228,7 +287,7 @@ func TestUnitService_GetOccupancyStats(t *testing.T) {
 
 	t.Run("should calculate occupancy stats correctly", func(t *testing.T) {
 		mockRepo := new(MockUnitRepository)
-		service := NewUnitService(mockRepo)
+		service := NewUnitService(mockRepo, nil, nil)
 
 		mockRepo.On("Count", ctx).Return(int64(31), nil)
 		mockRepo.On("CountByStatus", ctx, domain.UnitStatusOccupied).Return(int64(25), nil)
@@ -246,3 +305,54 @@ func TestUnitService_GetOccupancyStats(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestUnitService_UpdateUnitStatus_RecordsHistory(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should record transition with previous status", func(t *testing.T) {
+		unitID := uuid.New()
+		mockRepo := new(MockUnitRepository)
+		mockHistory := new(MockUnitStatusHistoryRepo)
+		service := NewUnitService(mockRepo, mockHistory, nil)
+
+		unit := &domain.Unit{ID: unitID, Number: "101", Floor: 1, Status: domain.UnitStatusAvailable}
+		previous := &domain.UnitStatusChange{UnitID: unitID, ToStatus: domain.UnitStatusAvailable}
+
+		mockRepo.On("GetByID", ctx, unitID).Return(unit, nil)
+		mockRepo.On("UpdateStatus", ctx, unitID, domain.UnitStatusMaintenance).Return(nil)
+		mockHistory.On("GetLatestByUnitID", ctx, unitID).Return(previous, nil)
+		mockHistory.On("Create", ctx, mock.MatchedBy(func(change *domain.UnitStatusChange) bool {
+			return change.UnitID == unitID &&
+				change.FromStatus != nil && *change.FromStatus == domain.UnitStatusAvailable &&
+				change.ToStatus == domain.UnitStatusMaintenance &&
+				change.Reason == domain.UnitStatusChangeReasonManual
+		})).Return(nil)
+
+		err := service.UpdateUnitStatus(ctx, unitID, domain.UnitStatusMaintenance)
+
+		require.NoError(t, err)
+		mockHistory.AssertExpectations(t)
+	})
+}
+
+func TestUnitService_GetOccupancyHistory(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should compute monthly occupancy from history", func(t *testing.T) {
+		mockHistory := new(MockUnitStatusHistoryRepo)
+		service := NewUnitService(new(MockUnitRepository), mockHistory, nil)
+
+		// Unidade ocupada desde antes da janela: 100% em todos os meses
+		change := &domain.UnitStatusChange{UnitID: uuid.New(), ToStatus: domain.UnitStatusOccupied, ChangedAt: time.Now().AddDate(-1, 0, 0)}
+		mockHistory.On("ListUntil", ctx, mock.AnythingOfType("time.Time")).Return([]*domain.UnitStatusChange{change}, nil)
+
+		points, err := service.GetOccupancyHistory(ctx, 3)
+
+		require.NoError(t, err)
+		require.Len(t, points, 3)
+		assert.Equal(t, time.Now().Format("2006-01"), points[2].Month)
+		for _, point := range points {
+			assert.InDelta(t, 100.0, point.OccupancyRate, 0.01)
+		}
+	})
+}
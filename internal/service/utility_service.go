@@ -0,0 +1,438 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+	"github.com/shopspring/decimal"
+)
+
+// Service layer errors específicos de cobrança de consumo
+var (
+	ErrMeterReadingNotFound      = errors.New("meter reading not found")
+	ErrMeterReadingAlreadyExists = errors.New("meter reading already exists for this unit and month")
+	ErrInvalidSplitMethod        = errors.New("building bill split method must be split_occupants or split_equal")
+	ErrDuplicateBuildingBill     = errors.New("only one building bill per utility type is allowed")
+)
+
+// Motivos para um contrato não ser cobrado na rodada de faturamento
+const (
+	skipReasonAlreadyBilled  = "utilities already billed for this month"
+	skipReasonMissingReading = "missing meter reading"
+	skipReasonNoConsumption  = "no consumption to bill"
+)
+
+// UtilityService contém a lógica de negócio para leituras, tarifas e cobrança de consumo
+type UtilityService struct {
+	utilityRepo repository.UtilityRepository
+	unitRepo    repository.UnitRepository
+	leaseRepo   repository.LeaseRepository
+	paymentRepo repository.PaymentRepository
+}
+
+// NewUtilityService cria uma nova instância do serviço de cobrança de consumo
+func NewUtilityService(
+	utilityRepo repository.UtilityRepository,
+	unitRepo repository.UnitRepository,
+	leaseRepo repository.LeaseRepository,
+	paymentRepo repository.PaymentRepository,
+) *UtilityService {
+	return &UtilityService{
+		utilityRepo: utilityRepo,
+		unitRepo:    unitRepo,
+		leaseRepo:   leaseRepo,
+		paymentRepo: paymentRepo,
+	}
+}
+
+// CreateTariffRequest representa os dados para cadastrar uma tarifa
+type CreateTariffRequest struct {
+	UtilityType   domain.UtilityType
+	PricePerUnit  decimal.Decimal
+	FixedFee      decimal.Decimal
+	EffectiveFrom time.Time
+}
+
+// RecordReadingRequest representa os dados de uma leitura de medidor
+type RecordReadingRequest struct {
+	UtilityType    domain.UtilityType
+	ReferenceMonth time.Time
+	Reading        decimal.Decimal
+	ReadingDate    *time.Time
+	Notes          *string
+	CreatedBy      *uuid.UUID
+}
+
+// BuildingBillRequest representa uma conta única do prédio a ser rateada entre os contratos
+type BuildingBillRequest struct {
+	UtilityType domain.UtilityType
+	TotalAmount decimal.Decimal
+	SplitMethod domain.UtilityBillingMethod
+	Occupants   map[uuid.UUID]int // Moradores por unidade (padrão 1), usado no rateio por moradores
+}
+
+// UtilityBillingRunRequest representa os parâmetros de uma rodada de faturamento de consumo
+type UtilityBillingRunRequest struct {
+	ReferenceMonth time.Time
+	DueDate        time.Time
+	PropertyID     *uuid.UUID
+	BuildingBills  []BuildingBillRequest
+}
+
+// UtilityBill representa o pagamento de consumo gerado para um contrato
+type UtilityBill struct {
+	Payment *domain.Payment         `json:"payment"`
+	Charges []*domain.UtilityCharge `json:"charges"`
+}
+
+// SkippedUtilityCharge representa um contrato (ou consumo) que não foi cobrado na rodada
+type SkippedUtilityCharge struct {
+	LeaseID     uuid.UUID           `json:"lease_id"`
+	UnitID      uuid.UUID           `json:"unit_id"`
+	UtilityType *domain.UtilityType `json:"utility_type,omitempty"`
+	Reason      string              `json:"reason"`
+}
+
+// UtilityBillingRunResult representa o resultado de uma rodada de faturamento
+type UtilityBillingRunResult struct {
+	ReferenceMonth time.Time               `json:"reference_month"`
+	Bills          []*UtilityBill          `json:"bills"`
+	Skipped        []*SkippedUtilityCharge `json:"skipped"`
+	TotalAmount    decimal.Decimal         `json:"total_amount"`
+}
+
+// CreateTariff cadastra uma nova tarifa de consumo
+func (s *UtilityService) CreateTariff(ctx context.Context, req CreateTariffRequest) (*domain.UtilityTariff, error) {
+	tariff, err := domain.NewUtilityTariff(req.UtilityType, req.PricePerUnit, req.FixedFee, req.EffectiveFrom)
+	if err != nil {
+		return nil, fmt.Errorf("error creating tariff: %w", err)
+	}
+
+	if err := s.utilityRepo.CreateTariff(ctx, tariff); err != nil {
+		return nil, fmt.Errorf("error saving tariff: %w", err)
+	}
+
+	return tariff, nil
+}
+
+// ListTariffs lista as tarifas cadastradas
+func (s *UtilityService) ListTariffs(ctx context.Context) ([]*domain.UtilityTariff, error) {
+	tariffs, err := s.utilityRepo.ListTariffs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing tariffs: %w", err)
+	}
+	return tariffs, nil
+}
+
+// RecordReading registra a leitura mensal do medidor de uma unidade
+func (s *UtilityService) RecordReading(ctx context.Context, unitID uuid.UUID, req RecordReadingRequest) (*domain.MeterReading, error) {
+	unit, err := s.unitRepo.GetByID(ctx, unitID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting unit: %w", err)
+	}
+	if unit == nil {
+		return nil, ErrUnitNotFound
+	}
+
+	readingDate := time.Now()
+	if req.ReadingDate != nil {
+		readingDate = *req.ReadingDate
+	}
+
+	reading, err := domain.NewMeterReading(unitID, req.UtilityType, req.ReferenceMonth, req.Reading, readingDate, req.CreatedBy)
+	if err != nil {
+		return nil, fmt.Errorf("error creating meter reading: %w", err)
+	}
+	reading.Notes = req.Notes
+
+	existing, err := s.utilityRepo.GetReading(ctx, unitID, reading.UtilityType, reading.ReferenceMonth)
+	if err != nil {
+		return nil, fmt.Errorf("error checking existing reading: %w", err)
+	}
+	if existing != nil {
+		return nil, ErrMeterReadingAlreadyExists
+	}
+
+	if err := s.utilityRepo.CreateReading(ctx, reading); err != nil {
+		return nil, fmt.Errorf("error saving meter reading: %w", err)
+	}
+
+	return reading, nil
+}
+
+// ListReadings lista as leituras de uma unidade
+func (s *UtilityService) ListReadings(ctx context.Context, unitID uuid.UUID) ([]*domain.MeterReading, error) {
+	readings, err := s.utilityRepo.ListReadingsByUnitID(ctx, unitID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing meter readings: %w", err)
+	}
+	return readings, nil
+}
+
+// DeleteReading remove uma leitura de medidor da unidade
+func (s *UtilityService) DeleteReading(ctx context.Context, unitID, readingID uuid.UUID) error {
+	reading, err := s.utilityRepo.GetReadingByID(ctx, readingID)
+	if err != nil {
+		return fmt.Errorf("error getting meter reading: %w", err)
+	}
+	if reading == nil || reading.UnitID != unitID {
+		return ErrMeterReadingNotFound
+	}
+
+	if err := s.utilityRepo.DeleteReading(ctx, readingID); err != nil {
+		return fmt.Errorf("error deleting meter reading: %w", err)
+	}
+
+	return nil
+}
+
+// RunBilling calcula o consumo do mês e gera um pagamento de consumo para cada contrato ativo
+// Tipos com conta do prédio informada são rateados; os demais são cobrados pelas leituras
+// dos medidores, desde que exista tarifa vigente. Contratos já faturados no mês são ignorados.
+func (s *UtilityService) RunBilling(ctx context.Context, req UtilityBillingRunRequest) (*UtilityBillingRunResult, error) {
+	month := domain.ReferenceMonthOf(req.ReferenceMonth)
+
+	buildingBills := make(map[domain.UtilityType]BuildingBillRequest, len(req.BuildingBills))
+	for _, bill := range req.BuildingBills {
+		if !domain.IsValidUtilityType(bill.UtilityType) {
+			return nil, domain.ErrInvalidUtilityType
+		}
+		if bill.SplitMethod != domain.UtilityBillingSplitOccupants && bill.SplitMethod != domain.UtilityBillingSplitEqual {
+			return nil, ErrInvalidSplitMethod
+		}
+		if _, exists := buildingBills[bill.UtilityType]; exists {
+			return nil, ErrDuplicateBuildingBill
+		}
+		buildingBills[bill.UtilityType] = bill
+	}
+
+	leases, err := s.activeLeases(ctx, req.PropertyID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &UtilityBillingRunResult{
+		ReferenceMonth: month,
+		Bills:          []*UtilityBill{},
+		Skipped:        []*SkippedUtilityCharge{},
+		TotalAmount:    decimal.Zero,
+	}
+
+	// Contratos já faturados no mês continuam participando do rateio para não inflar as demais partes
+	billed := make(map[uuid.UUID]bool, len(leases))
+	for _, lease := range leases {
+		count, err := s.utilityRepo.CountChargesByLeaseAndMonth(ctx, lease.ID, month)
+		if err != nil {
+			return nil, fmt.Errorf("error checking existing utility charges: %w", err)
+		}
+		if count > 0 {
+			billed[lease.ID] = true
+			result.Skipped = append(result.Skipped, &SkippedUtilityCharge{
+				LeaseID: lease.ID,
+				UnitID:  lease.UnitID,
+				Reason:  skipReasonAlreadyBilled,
+			})
+		}
+	}
+
+	charges := make(map[uuid.UUID][]*domain.UtilityCharge, len(leases))
+
+	for _, utilityType := range domain.ValidUtilityTypes {
+		if bill, ok := buildingBills[utilityType]; ok {
+			if len(leases) == 0 {
+				continue
+			}
+			splitCharges, err := splitBuildingBill(leases, month, bill)
+			if err != nil {
+				return nil, fmt.Errorf("error splitting building bill: %w", err)
+			}
+			for _, charge := range splitCharges {
+				charges[charge.LeaseID] = append(charges[charge.LeaseID], charge)
+			}
+			continue
+		}
+
+		tariff, err := s.utilityRepo.GetEffectiveTariff(ctx, utilityType, month)
+		if err != nil {
+			return nil, fmt.Errorf("error getting tariff: %w", err)
+		}
+		if tariff == nil {
+			continue
+		}
+
+		for _, lease := range leases {
+			if billed[lease.ID] {
+				continue
+			}
+
+			charge, reason, err := s.meteredCharge(ctx, lease, utilityType, month, tariff)
+			if err != nil {
+				return nil, err
+			}
+			if charge == nil {
+				ut := utilityType
+				result.Skipped = append(result.Skipped, &SkippedUtilityCharge{
+					LeaseID:     lease.ID,
+					UnitID:      lease.UnitID,
+					UtilityType: &ut,
+					Reason:      reason,
+				})
+				continue
+			}
+			charges[lease.ID] = append(charges[lease.ID], charge)
+		}
+	}
+
+	for _, lease := range leases {
+		if billed[lease.ID] || len(charges[lease.ID]) == 0 {
+			continue
+		}
+
+		bill, err := s.createBill(ctx, lease, month, req.DueDate, charges[lease.ID])
+		if err != nil {
+			return nil, err
+		}
+		if bill == nil {
+			result.Skipped = append(result.Skipped, &SkippedUtilityCharge{
+				LeaseID: lease.ID,
+				UnitID:  lease.UnitID,
+				Reason:  skipReasonNoConsumption,
+			})
+			continue
+		}
+
+		result.Bills = append(result.Bills, bill)
+		result.TotalAmount = result.TotalAmount.Add(bill.Payment.Amount)
+	}
+
+	return result, nil
+}
+
+// GetPaymentCharges retorna o detalhamento de consumo de um pagamento
+func (s *UtilityService) GetPaymentCharges(ctx context.Context, paymentID uuid.UUID) ([]*domain.UtilityCharge, error) {
+	payment, err := s.paymentRepo.GetByID(ctx, paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting payment: %w", err)
+	}
+	if payment == nil {
+		return nil, ErrPaymentNotFound
+	}
+
+	charges, err := s.utilityRepo.ListChargesByPaymentID(ctx, paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing utility charges: %w", err)
+	}
+	return charges, nil
+}
+
+// activeLeases retorna os contratos vigentes, opcionalmente filtrados por prédio
+func (s *UtilityService) activeLeases(ctx context.Context, propertyID *uuid.UUID) ([]*domain.Lease, error) {
+	if propertyID != nil {
+		all, err := s.leaseRepo.ListByPropertyID(ctx, *propertyID)
+		if err != nil {
+			return nil, fmt.Errorf("error listing leases: %w", err)
+		}
+
+		leases := make([]*domain.Lease, 0, len(all))
+		for _, lease := range all {
+			if lease.Status == domain.LeaseStatusActive || lease.Status == domain.LeaseStatusExpiringSoon {
+				leases = append(leases, lease)
+			}
+		}
+		return leases, nil
+	}
+
+	leases := []*domain.Lease{}
+	for _, status := range []domain.LeaseStatus{domain.LeaseStatusActive, domain.LeaseStatusExpiringSoon} {
+		byStatus, err := s.leaseRepo.ListByStatus(ctx, status)
+		if err != nil {
+			return nil, fmt.Errorf("error listing leases: %w", err)
+		}
+		leases = append(leases, byStatus...)
+	}
+	return leases, nil
+}
+
+// meteredCharge calcula a cobrança pelo medidor da unidade do contrato
+// Retorna nil e o motivo quando não há leituras suficientes para o mês
+func (s *UtilityService) meteredCharge(ctx context.Context, lease *domain.Lease, utilityType domain.UtilityType, month time.Time, tariff *domain.UtilityTariff) (*domain.UtilityCharge, string, error) {
+	current, err := s.utilityRepo.GetReading(ctx, lease.UnitID, utilityType, month)
+	if err != nil {
+		return nil, "", fmt.Errorf("error getting meter reading: %w", err)
+	}
+	previous, err := s.utilityRepo.GetPreviousReading(ctx, lease.UnitID, utilityType, month)
+	if err != nil {
+		return nil, "", fmt.Errorf("error getting previous meter reading: %w", err)
+	}
+	if current == nil || previous == nil {
+		return nil, skipReasonMissingReading, nil
+	}
+
+	charge, err := domain.NewMeteredCharge(lease, previous, current, tariff)
+	if err != nil {
+		return nil, err.Error(), nil
+	}
+	return charge, "", nil
+}
+
+// createBill gera o pagamento de consumo do contrato com o detalhamento nas observações
+// Retorna nil quando o valor total é zero
+func (s *UtilityService) createBill(ctx context.Context, lease *domain.Lease, month, dueDate time.Time, charges []*domain.UtilityCharge) (*UtilityBill, error) {
+	total := decimal.Zero
+	lines := make([]string, len(charges))
+	for i, charge := range charges {
+		total = total.Add(charge.Amount)
+		lines[i] = charge.Describe()
+	}
+	if total.LessThanOrEqual(decimal.Zero) {
+		return nil, nil
+	}
+
+	payment, err := domain.NewPayment(lease.ID, domain.PaymentTypeUtility, month, total, dueDate)
+	if err != nil {
+		return nil, fmt.Errorf("error creating utility payment: %w", err)
+	}
+	payment.AddNote(strings.Join(lines, "\n"))
+
+	for _, charge := range charges {
+		charge.PaymentID = payment.ID
+	}
+
+	if err := s.utilityRepo.CreateBill(ctx, payment, charges); err != nil {
+		return nil, fmt.Errorf("error saving utility payment: %w", err)
+	}
+
+	return &UtilityBill{Payment: payment, Charges: charges}, nil
+}
+
+// splitBuildingBill divide a conta do prédio entre os contratos conforme o método escolhido
+func splitBuildingBill(leases []*domain.Lease, month time.Time, bill BuildingBillRequest) ([]*domain.UtilityCharge, error) {
+	weights := make([]int, len(leases))
+	totalShares := 0
+	for i, lease := range leases {
+		weights[i] = 1
+		if bill.SplitMethod == domain.UtilityBillingSplitOccupants {
+			if occupants, ok := bill.Occupants[lease.UnitID]; ok {
+				weights[i] = occupants
+			}
+		}
+		totalShares += weights[i]
+	}
+
+	shares, err := domain.SplitBuildingBill(bill.TotalAmount, weights)
+	if err != nil {
+		return nil, err
+	}
+
+	charges := make([]*domain.UtilityCharge, len(leases))
+	for i, lease := range leases {
+		charges[i] = domain.NewSplitCharge(lease, bill.UtilityType, month, bill.SplitMethod, bill.TotalAmount, weights[i], totalShares, shares[i])
+	}
+	return charges, nil
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockUtilityRepo é um mock do repository de cobrança de consumo
+type MockUtilityRepo struct {
+	mock.Mock
+}
+
+func (m *MockUtilityRepo) CreateTariff(ctx context.Context, tariff *domain.UtilityTariff) error {
+	args := m.Called(ctx, tariff)
+	return args.Error(0)
+}
+
+func (m *MockUtilityRepo) ListTariffs(ctx context.Context) ([]*domain.UtilityTariff, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.UtilityTariff), args.Error(1)
+}
+
+func (m *MockUtilityRepo) GetEffectiveTariff(ctx context.Context, utilityType domain.UtilityType, date time.Time) (*domain.UtilityTariff, error) {
+	args := m.Called(ctx, utilityType, date)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.UtilityTariff), args.Error(1)
+}
+
+func (m *MockUtilityRepo) CreateReading(ctx context.Context, reading *domain.MeterReading) error {
+	args := m.Called(ctx, reading)
+	return args.Error(0)
+}
+
+func (m *MockUtilityRepo) GetReadingByID(ctx context.Context, id uuid.UUID) (*domain.MeterReading, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MeterReading), args.Error(1)
+}
+
+func (m *MockUtilityRepo) GetReading(ctx context.Context, unitID uuid.UUID, utilityType domain.UtilityType, referenceMonth time.Time) (*domain.MeterReading, error) {
+	args := m.Called(ctx, unitID, utilityType, referenceMonth)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MeterReading), args.Error(1)
+}
+
+func (m *MockUtilityRepo) GetPreviousReading(ctx context.Context, unitID uuid.UUID, utilityType domain.UtilityType, referenceMonth time.Time) (*domain.MeterReading, error) {
+	args := m.Called(ctx, unitID, utilityType, referenceMonth)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MeterReading), args.Error(1)
+}
+
+func (m *MockUtilityRepo) ListReadingsByUnitID(ctx context.Context, unitID uuid.UUID) ([]*domain.MeterReading, error) {
+	args := m.Called(ctx, unitID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MeterReading), args.Error(1)
+}
+
+func (m *MockUtilityRepo) DeleteReading(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockUtilityRepo) CreateBill(ctx context.Context, payment *domain.Payment, charges []*domain.UtilityCharge) error {
+	args := m.Called(ctx, payment, charges)
+	return args.Error(0)
+}
+
+func (m *MockUtilityRepo) ListChargesByPaymentID(ctx context.Context, paymentID uuid.UUID) ([]*domain.UtilityCharge, error) {
+	args := m.Called(ctx, paymentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.UtilityCharge), args.Error(1)
+}
+
+func (m *MockUtilityRepo) CountChargesByLeaseAndMonth(ctx context.Context, leaseID uuid.UUID, referenceMonth time.Time) (int64, error) {
+	args := m.Called(ctx, leaseID, referenceMonth)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestUtilityService_RecordReading(t *testing.T) {
+	ctx := context.Background()
+	unitID := uuid.New()
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should fail when reading already exists for the month", func(t *testing.T) {
+		mockUtilityRepo := new(MockUtilityRepo)
+		mockUnitRepo := new(MockUnitRepo)
+		service := NewUtilityService(mockUtilityRepo, mockUnitRepo, nil, nil)
+
+		existing, _ := domain.NewMeterReading(unitID, domain.UtilityTypeElectricity, march, decimal.NewFromInt(900), march, nil)
+		mockUnitRepo.On("GetByID", ctx, unitID).Return(&domain.Unit{ID: unitID}, nil)
+		mockUtilityRepo.On("GetReading", ctx, unitID, domain.UtilityTypeElectricity, march).Return(existing, nil)
+
+		reading, err := service.RecordReading(ctx, unitID, RecordReadingRequest{
+			UtilityType:    domain.UtilityTypeElectricity,
+			ReferenceMonth: march.AddDate(0, 0, 10),
+			Reading:        decimal.NewFromInt(1000),
+		})
+
+		assert.Nil(t, reading)
+		assert.ErrorIs(t, err, ErrMeterReadingAlreadyExists)
+		mockUtilityRepo.AssertNotCalled(t, "CreateReading", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUtilityService_RunBilling(t *testing.T) {
+	ctx := context.Background()
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2026, 4, 10, 0, 0, 0, 0, time.UTC)
+
+	leaseA := &domain.Lease{ID: uuid.New(), UnitID: uuid.New(), Status: domain.LeaseStatusActive}
+	leaseB := &domain.Lease{ID: uuid.New(), UnitID: uuid.New(), Status: domain.LeaseStatusActive}
+
+	tariff, _ := domain.NewUtilityTariff(domain.UtilityTypeElectricity, decimal.RequireFromString("0.80"), decimal.Zero, march)
+
+	t.Run("should bill metered electricity and split water by occupants", func(t *testing.T) {
+		mockUtilityRepo := new(MockUtilityRepo)
+		mockLeaseRepo := new(MockLeaseRepo)
+		service := NewUtilityService(mockUtilityRepo, nil, mockLeaseRepo, nil)
+
+		mockLeaseRepo.On("ListByStatus", ctx, domain.LeaseStatusActive).Return([]*domain.Lease{leaseA, leaseB}, nil)
+		mockLeaseRepo.On("ListByStatus", ctx, domain.LeaseStatusExpiringSoon).Return([]*domain.Lease{}, nil)
+		mockUtilityRepo.On("CountChargesByLeaseAndMonth", ctx, mock.Anything, march).Return(int64(0), nil)
+		mockUtilityRepo.On("GetEffectiveTariff", ctx, domain.UtilityTypeElectricity, march).Return(tariff, nil)
+
+		previousA, _ := domain.NewMeterReading(leaseA.UnitID, domain.UtilityTypeElectricity, march.AddDate(0, -1, 0), decimal.NewFromInt(500), march, nil)
+		currentA, _ := domain.NewMeterReading(leaseA.UnitID, domain.UtilityTypeElectricity, march, decimal.NewFromInt(600), march, nil)
+		mockUtilityRepo.On("GetReading", ctx, leaseA.UnitID, domain.UtilityTypeElectricity, march).Return(currentA, nil)
+		mockUtilityRepo.On("GetPreviousReading", ctx, leaseA.UnitID, domain.UtilityTypeElectricity, march).Return(previousA, nil)
+		mockUtilityRepo.On("GetReading", ctx, leaseB.UnitID, domain.UtilityTypeElectricity, march).Return(nil, nil)
+		mockUtilityRepo.On("GetPreviousReading", ctx, leaseB.UnitID, domain.UtilityTypeElectricity, march).Return(nil, nil)
+		mockUtilityRepo.On("CreateBill", ctx, mock.AnythingOfType("*domain.Payment"), mock.Anything).Return(nil)
+
+		result, err := service.RunBilling(ctx, UtilityBillingRunRequest{
+			ReferenceMonth: march,
+			DueDate:        dueDate,
+			BuildingBills: []BuildingBillRequest{{
+				UtilityType: domain.UtilityTypeWater,
+				TotalAmount: decimal.NewFromInt(150),
+				SplitMethod: domain.UtilityBillingSplitOccupants,
+				Occupants:   map[uuid.UUID]int{leaseA.UnitID: 2},
+			}},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Bills, 2)
+
+		billA := result.Bills[0]
+		assert.Equal(t, domain.PaymentTypeUtility, billA.Payment.PaymentType)
+		assert.Len(t, billA.Charges, 2)
+		// Água: 2/3 de 150 = 100; Energia: 100 x 0.80 = 80
+		assert.True(t, decimal.NewFromInt(180).Equal(billA.Payment.Amount))
+		assert.Contains(t, *billA.Payment.Notes, "Água: rateio por moradores (2/3)")
+		assert.Equal(t, billA.Payment.ID, billA.Charges[0].PaymentID)
+
+		billB := result.Bills[1]
+		assert.Len(t, billB.Charges, 1)
+		assert.True(t, decimal.NewFromInt(50).Equal(billB.Payment.Amount))
+
+		require.Len(t, result.Skipped, 1)
+		assert.Equal(t, leaseB.ID, result.Skipped[0].LeaseID)
+		assert.Equal(t, skipReasonMissingReading, result.Skipped[0].Reason)
+		assert.True(t, decimal.NewFromInt(230).Equal(result.TotalAmount))
+	})
+
+	t.Run("should skip leases already billed but keep their share in the split", func(t *testing.T) {
+		mockUtilityRepo := new(MockUtilityRepo)
+		mockLeaseRepo := new(MockLeaseRepo)
+		service := NewUtilityService(mockUtilityRepo, nil, mockLeaseRepo, nil)
+
+		mockLeaseRepo.On("ListByStatus", ctx, domain.LeaseStatusActive).Return([]*domain.Lease{leaseA, leaseB}, nil)
+		mockLeaseRepo.On("ListByStatus", ctx, domain.LeaseStatusExpiringSoon).Return([]*domain.Lease{}, nil)
+		mockUtilityRepo.On("CountChargesByLeaseAndMonth", ctx, leaseA.ID, march).Return(int64(1), nil)
+		mockUtilityRepo.On("CountChargesByLeaseAndMonth", ctx, leaseB.ID, march).Return(int64(0), nil)
+		mockUtilityRepo.On("GetEffectiveTariff", ctx, domain.UtilityTypeElectricity, march).Return(nil, nil)
+		mockUtilityRepo.On("CreateBill", ctx, mock.AnythingOfType("*domain.Payment"), mock.Anything).Return(nil)
+
+		result, err := service.RunBilling(ctx, UtilityBillingRunRequest{
+			ReferenceMonth: march,
+			DueDate:        dueDate,
+			BuildingBills: []BuildingBillRequest{{
+				UtilityType: domain.UtilityTypeWater,
+				TotalAmount: decimal.NewFromInt(100),
+				SplitMethod: domain.UtilityBillingSplitEqual,
+			}},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, result.Bills, 1)
+		assert.Equal(t, leaseB.ID, result.Bills[0].Payment.LeaseID)
+		assert.True(t, decimal.NewFromInt(50).Equal(result.Bills[0].Payment.Amount))
+		require.Len(t, result.Skipped, 1)
+		assert.Equal(t, skipReasonAlreadyBilled, result.Skipped[0].Reason)
+	})
+
+	t.Run("should reject invalid split method", func(t *testing.T) {
+		service := NewUtilityService(new(MockUtilityRepo), nil, new(MockLeaseRepo), nil)
+
+		result, err := service.RunBilling(ctx, UtilityBillingRunRequest{
+			ReferenceMonth: march,
+			DueDate:        dueDate,
+			BuildingBills: []BuildingBillRequest{{
+				UtilityType: domain.UtilityTypeWater,
+				TotalAmount: decimal.NewFromInt(100),
+				SplitMethod: domain.UtilityBillingMetered,
+			}},
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, ErrInvalidSplitMethod)
+	})
+}
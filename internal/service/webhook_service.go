@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/webhook"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/repository"
+)
+
+// Service layer errors específicos de webhooks
+var (
+	ErrWebhookEndpointNotFound = errors.New("webhook endpoint not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+)
+
+const (
+	// webhookDispatchBatchSize limita quantas entregas são processadas por execução
+	webhookDispatchBatchSize = 100
+	// WebhookDeliveriesDefaultLimit é a quantidade de entregas retornada no registro por padrão
+	WebhookDeliveriesDefaultLimit = 50
+)
+
+// EventPublisher publica eventos do sistema para integrações externas
+// Falhas na publicação não devem impedir a operação que originou o evento
+type EventPublisher interface {
+	Publish(ctx context.Context, eventType domain.WebhookEventType, data any)
+}
+
+// publishEvent publica o evento se houver um publisher configurado
+func publishEvent(ctx context.Context, events EventPublisher, eventType domain.WebhookEventType, data any) {
+	if events == nil {
+		return
+	}
+	events.Publish(ctx, eventType, data)
+}
+
+// WebhookService contém a lógica de cadastro de webhooks e entrega dos eventos
+type WebhookService struct {
+	webhookRepo repository.WebhookRepository
+	sender      webhook.Sender
+}
+
+// NewWebhookService cria uma nova instância do serviço de webhooks
+func NewWebhookService(webhookRepo repository.WebhookRepository, sender webhook.Sender) *WebhookService {
+	return &WebhookService{
+		webhookRepo: webhookRepo,
+		sender:      sender,
+	}
+}
+
+// WebhookDispatchResult representa o resultado de uma execução de entregas
+type WebhookDispatchResult struct {
+	Delivered   int       `json:"delivered"`
+	Retrying    int       `json:"retrying"`
+	Failed      int       `json:"failed"`
+	ProcessedAt time.Time `json:"processed_at"`
+}
+
+// CreateEndpoint cadastra um endpoint; o segredo de assinatura é gerado pelo sistema
+func (s *WebhookService) CreateEndpoint(ctx context.Context, url, description string, events []domain.WebhookEventType) (*domain.WebhookEndpoint, error) {
+	endpoint, err := domain.NewWebhookEndpoint(url, description, events)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.webhookRepo.CreateEndpoint(ctx, endpoint); err != nil {
+		return nil, fmt.Errorf("error creating webhook endpoint: %w", err)
+	}
+
+	return endpoint, nil
+}
+
+// GetEndpoint busca um endpoint por ID
+func (s *WebhookService) GetEndpoint(ctx context.Context, id uuid.UUID) (*domain.WebhookEndpoint, error) {
+	endpoint, err := s.webhookRepo.GetEndpointByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching webhook endpoint: %w", err)
+	}
+	if endpoint == nil {
+		return nil, ErrWebhookEndpointNotFound
+	}
+	return endpoint, nil
+}
+
+// ListEndpoints lista os endpoints cadastrados
+func (s *WebhookService) ListEndpoints(ctx context.Context) ([]*domain.WebhookEndpoint, error) {
+	endpoints, err := s.webhookRepo.ListEndpoints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing webhook endpoints: %w", err)
+	}
+	return endpoints, nil
+}
+
+// UpdateEndpoint altera URL, descrição, eventos e situação do endpoint
+func (s *WebhookService) UpdateEndpoint(ctx context.Context, id uuid.UUID, url, description string, events []domain.WebhookEventType, active bool) (*domain.WebhookEndpoint, error) {
+	endpoint, err := s.GetEndpoint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.Update(url, description, events, active); err != nil {
+		return nil, err
+	}
+
+	if err := s.webhookRepo.UpdateEndpoint(ctx, endpoint); err != nil {
+		return nil, fmt.Errorf("error updating webhook endpoint: %w", err)
+	}
+
+	return endpoint, nil
+}
+
+// RotateSecret gera um novo segredo de assinatura para o endpoint
+func (s *WebhookService) RotateSecret(ctx context.Context, id uuid.UUID) (*domain.WebhookEndpoint, error) {
+	endpoint, err := s.GetEndpoint(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.RotateSecret(); err != nil {
+		return nil, fmt.Errorf("error generating webhook secret: %w", err)
+	}
+
+	if err := s.webhookRepo.UpdateEndpoint(ctx, endpoint); err != nil {
+		return nil, fmt.Errorf("error updating webhook endpoint: %w", err)
+	}
+
+	return endpoint, nil
+}
+
+// DeleteEndpoint remove o endpoint e seu registro de entregas
+func (s *WebhookService) DeleteEndpoint(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.GetEndpoint(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.webhookRepo.DeleteEndpoint(ctx, id); err != nil {
+		return fmt.Errorf("error deleting webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+// ListDeliveries retorna o registro de entregas mais recentes do endpoint
+func (s *WebhookService) ListDeliveries(ctx context.Context, endpointID uuid.UUID, limit int) ([]*domain.WebhookDelivery, error) {
+	if _, err := s.GetEndpoint(ctx, endpointID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = WebhookDeliveriesDefaultLimit
+	}
+
+	deliveries, err := s.webhookRepo.ListDeliveriesByEndpointID(ctx, endpointID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error listing webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// ReplayDelivery reenvia um evento já entregue (ou que falhou) criando uma nova entrega pendente
+func (s *WebhookService) ReplayDelivery(ctx context.Context, deliveryID uuid.UUID) (*domain.WebhookDelivery, error) {
+	delivery, err := s.webhookRepo.GetDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching webhook delivery: %w", err)
+	}
+	if delivery == nil {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+
+	replay, err := delivery.Replay()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.webhookRepo.CreateDelivery(ctx, replay); err != nil {
+		return nil, fmt.Errorf("error creating webhook delivery: %w", err)
+	}
+
+	return s.attempt(ctx, replay), nil
+}
+
+// Publish registra uma entrega pendente do evento para cada endpoint ativo inscrito
+// As entregas são enviadas pelo dispatcher; falhas são apenas registradas no log
+func (s *WebhookService) Publish(ctx context.Context, eventType domain.WebhookEventType, data any) {
+	endpoints, err := s.webhookRepo.ListActiveEndpointsByEvent(ctx, eventType)
+	if err != nil {
+		fmt.Printf("Warning: failed to list webhook endpoints for %s: %v\n", eventType, err)
+		return
+	}
+	if len(endpoints) == 0 {
+		return
+	}
+
+	event, err := domain.NewWebhookEvent(eventType, data)
+	if err != nil {
+		fmt.Printf("Warning: failed to build webhook event %s: %v\n", eventType, err)
+		return
+	}
+
+	for _, endpoint := range endpoints {
+		delivery, err := domain.NewWebhookDelivery(endpoint.ID, event)
+		if err != nil {
+			fmt.Printf("Warning: failed to encode webhook event %s: %v\n", eventType, err)
+			return
+		}
+		if err := s.webhookRepo.CreateDelivery(ctx, delivery); err != nil {
+			fmt.Printf("Warning: failed to queue webhook %s for endpoint %s: %v\n", eventType, endpoint.ID, err)
+		}
+	}
+}
+
+// DispatchDue envia as entregas pendentes cuja próxima tentativa já chegou
+// Este método deve ser executado periodicamente pelo scheduler
+func (s *WebhookService) DispatchDue(ctx context.Context) (*WebhookDispatchResult, error) {
+	result := &WebhookDispatchResult{ProcessedAt: time.Now()}
+
+	deliveries, err := s.webhookRepo.ListDueDeliveries(ctx, time.Now(), webhookDispatchBatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("error listing due webhook deliveries: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		switch s.attempt(ctx, delivery).Status {
+		case domain.WebhookDeliveryDelivered:
+			result.Delivered++
+		case domain.WebhookDeliveryFailed:
+			result.Failed++
+		default:
+			result.Retrying++
+		}
+	}
+
+	return result, nil
+}
+
+// attempt envia a entrega ao endpoint e grava o resultado da tentativa
+func (s *WebhookService) attempt(ctx context.Context, delivery *domain.WebhookDelivery) *domain.WebhookDelivery {
+	now := time.Now()
+
+	endpoint, err := s.webhookRepo.GetEndpointByID(ctx, delivery.EndpointID)
+	switch {
+	case err != nil:
+		fmt.Printf("Warning: failed to get webhook endpoint %s: %v\n", delivery.EndpointID, err)
+		return delivery
+	case endpoint == nil || !endpoint.Active:
+		_ = delivery.Abandon("webhook endpoint is disabled", now)
+	default:
+		statusCode, sendErr := s.sender.Send(ctx, webhook.Request{
+			URL:        endpoint.URL,
+			Secret:     endpoint.Secret,
+			EventType:  string(delivery.EventType),
+			EventID:    delivery.EventID.String(),
+			DeliveryID: delivery.ID.String(),
+			Payload:    delivery.Payload,
+		})
+		if sendErr != nil {
+			_ = delivery.RegisterFailure(sendErr.Error(), statusCode, now)
+		} else {
+			_ = delivery.MarkAsDelivered(statusCode, now)
+		}
+	}
+
+	if err := s.webhookRepo.UpdateDeliveryAttempt(ctx, delivery); err != nil {
+		fmt.Printf("Warning: failed to record webhook delivery %s: %v\n", delivery.ID, err)
+	}
+	return delivery
+}
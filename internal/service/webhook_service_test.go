@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/domain"
+	"github.com/lucianoZgabriel/kitnet-manager/internal/pkg/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockWebhookRepo é um mock do repository de webhooks
+type MockWebhookRepo struct {
+	mock.Mock
+}
+
+func (m *MockWebhookRepo) CreateEndpoint(ctx context.Context, endpoint *domain.WebhookEndpoint) error {
+	args := m.Called(ctx, endpoint)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepo) GetEndpointByID(ctx context.Context, id uuid.UUID) (*domain.WebhookEndpoint, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WebhookEndpoint), args.Error(1)
+}
+
+func (m *MockWebhookRepo) ListEndpoints(ctx context.Context) ([]*domain.WebhookEndpoint, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*domain.WebhookEndpoint), args.Error(1)
+}
+
+func (m *MockWebhookRepo) ListActiveEndpointsByEvent(ctx context.Context, eventType domain.WebhookEventType) ([]*domain.WebhookEndpoint, error) {
+	args := m.Called(ctx, eventType)
+	return args.Get(0).([]*domain.WebhookEndpoint), args.Error(1)
+}
+
+func (m *MockWebhookRepo) UpdateEndpoint(ctx context.Context, endpoint *domain.WebhookEndpoint) error {
+	args := m.Called(ctx, endpoint)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepo) DeleteEndpoint(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepo) CreateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+func (m *MockWebhookRepo) GetDeliveryByID(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookRepo) ListDeliveriesByEndpointID(ctx context.Context, endpointID uuid.UUID, limit int) ([]*domain.WebhookDelivery, error) {
+	args := m.Called(ctx, endpointID, limit)
+	return args.Get(0).([]*domain.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookRepo) ListDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*domain.WebhookDelivery, error) {
+	args := m.Called(ctx, now, limit)
+	return args.Get(0).([]*domain.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookRepo) UpdateDeliveryAttempt(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+// mockWebhookSender registra as requisições e responde conforme o status configurado por URL
+type mockWebhookSender struct {
+	requests  []webhook.Request
+	responses map[string]int
+}
+
+func (m *mockWebhookSender) Send(_ context.Context, req webhook.Request) (int, error) {
+	m.requests = append(m.requests, req)
+	status, ok := m.responses[req.URL]
+	if !ok {
+		return 0, errors.New("connection refused")
+	}
+	if status >= 300 {
+		return status, errors.New("webhook endpoint responded with error")
+	}
+	return status, nil
+}
+
+// recordingPublisher registra os eventos publicados pelos services
+type recordingPublisher struct {
+	events []domain.WebhookEventType
+	data   []any
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, eventType domain.WebhookEventType, data any) {
+	p.events = append(p.events, eventType)
+	p.data = append(p.data, data)
+}
+
+func newTestWebhookEndpoint(t *testing.T, url string, events ...domain.WebhookEventType) *domain.WebhookEndpoint {
+	endpoint, err := domain.NewWebhookEndpoint(url, "", events)
+	require.NoError(t, err)
+	return endpoint
+}
+
+func newTestPendingDelivery(t *testing.T, endpointID uuid.UUID) *domain.WebhookDelivery {
+	event, err := domain.NewWebhookEvent(domain.WebhookEventPaymentPaid, map[string]string{"id": "1"})
+	require.NoError(t, err)
+	delivery, err := domain.NewWebhookDelivery(endpointID, event)
+	require.NoError(t, err)
+	return delivery
+}
+
+func TestWebhookService_Publish(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockWebhookRepo)
+	service := NewWebhookService(repo, &mockWebhookSender{})
+
+	first := newTestWebhookEndpoint(t, "https://a.example.com", domain.WebhookEventLeaseCreated)
+	second := newTestWebhookEndpoint(t, "https://b.example.com", domain.WebhookEventLeaseCreated)
+	lease := createTestLease()
+
+	repo.On("ListActiveEndpointsByEvent", ctx, domain.WebhookEventLeaseCreated).
+		Return([]*domain.WebhookEndpoint{first, second}, nil)
+	repo.On("CreateDelivery", ctx, mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+		return d.EventType == domain.WebhookEventLeaseCreated && d.Status == domain.WebhookDeliveryPending
+	})).Return(nil).Twice()
+
+	service.Publish(ctx, domain.WebhookEventLeaseCreated, lease)
+
+	repo.AssertExpectations(t)
+	firstDelivery := repo.Calls[1].Arguments.Get(1).(*domain.WebhookDelivery)
+	secondDelivery := repo.Calls[2].Arguments.Get(1).(*domain.WebhookDelivery)
+	assert.Equal(t, first.ID, firstDelivery.EndpointID)
+	assert.Equal(t, second.ID, secondDelivery.EndpointID)
+	// O mesmo evento é entregue a todos os endpoints
+	assert.Equal(t, firstDelivery.EventID, secondDelivery.EventID)
+}
+
+func TestWebhookService_DispatchDue(t *testing.T) {
+	ctx := context.Background()
+	repo := new(MockWebhookRepo)
+	sender := &mockWebhookSender{responses: map[string]int{
+		"https://ok.example.com":   204,
+		"https://down.example.com": 503,
+	}}
+	service := NewWebhookService(repo, sender)
+
+	ok := newTestWebhookEndpoint(t, "https://ok.example.com", domain.WebhookEventPaymentPaid)
+	down := newTestWebhookEndpoint(t, "https://down.example.com", domain.WebhookEventPaymentPaid)
+	disabled := newTestWebhookEndpoint(t, "https://off.example.com", domain.WebhookEventPaymentPaid)
+	disabled.Active = false
+
+	delivered := newTestPendingDelivery(t, ok.ID)
+	retrying := newTestPendingDelivery(t, down.ID)
+	abandoned := newTestPendingDelivery(t, disabled.ID)
+
+	repo.On("ListDueDeliveries", ctx, mock.AnythingOfType("time.Time"), webhookDispatchBatchSize).
+		Return([]*domain.WebhookDelivery{delivered, retrying, abandoned}, nil)
+	repo.On("GetEndpointByID", ctx, ok.ID).Return(ok, nil)
+	repo.On("GetEndpointByID", ctx, down.ID).Return(down, nil)
+	repo.On("GetEndpointByID", ctx, disabled.ID).Return(disabled, nil)
+	repo.On("UpdateDeliveryAttempt", ctx, mock.Anything).Return(nil).Times(3)
+
+	result, err := service.DispatchDue(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Delivered)
+	assert.Equal(t, 1, result.Retrying)
+	assert.Equal(t, 1, result.Failed)
+
+	// Endpoint desativado não recebe a requisição
+	require.Len(t, sender.requests, 2)
+	assert.Equal(t, ok.Secret, sender.requests[0].Secret)
+	assert.Equal(t, delivered.ID.String(), sender.requests[0].DeliveryID)
+	assert.Equal(t, "payment.paid", sender.requests[0].EventType)
+
+	assert.Equal(t, domain.WebhookDeliveryDelivered, delivered.Status)
+	assert.Equal(t, domain.WebhookDeliveryPending, retrying.Status)
+	assert.Equal(t, 503, *retrying.ResponseStatus)
+	assert.NotNil(t, retrying.NextAttemptAt)
+	assert.Equal(t, domain.WebhookDeliveryFailed, abandoned.Status)
+	assert.Zero(t, abandoned.Attempts)
+	repo.AssertExpectations(t)
+}
+
+func TestWebhookService_ReplayDelivery(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should send a new delivery of the same event", func(t *testing.T) {
+		repo := new(MockWebhookRepo)
+		sender := &mockWebhookSender{responses: map[string]int{"https://ok.example.com": 200}}
+		service := NewWebhookService(repo, sender)
+
+		endpoint := newTestWebhookEndpoint(t, "https://ok.example.com", domain.WebhookEventPaymentPaid)
+		original := newTestPendingDelivery(t, endpoint.ID)
+		require.NoError(t, original.Abandon("endpoint disabled", time.Now()))
+
+		repo.On("GetDeliveryByID", ctx, original.ID).Return(original, nil)
+		repo.On("CreateDelivery", ctx, mock.AnythingOfType("*domain.WebhookDelivery")).Return(nil)
+		repo.On("GetEndpointByID", ctx, endpoint.ID).Return(endpoint, nil)
+		repo.On("UpdateDeliveryAttempt", ctx, mock.AnythingOfType("*domain.WebhookDelivery")).Return(nil)
+
+		replay, err := service.ReplayDelivery(ctx, original.ID)
+
+		require.NoError(t, err)
+		assert.Equal(t, original.ID, *replay.ReplayOf)
+		assert.Equal(t, domain.WebhookDeliveryDelivered, replay.Status)
+		require.Len(t, sender.requests, 1)
+		assert.Equal(t, original.EventID.String(), sender.requests[0].EventID)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("should not replay pending delivery", func(t *testing.T) {
+		repo := new(MockWebhookRepo)
+		service := NewWebhookService(repo, &mockWebhookSender{})
+		pending := newTestPendingDelivery(t, uuid.New())
+
+		repo.On("GetDeliveryByID", ctx, pending.ID).Return(pending, nil)
+
+		_, err := service.ReplayDelivery(ctx, pending.ID)
+
+		assert.ErrorIs(t, err, domain.ErrWebhookDeliveryPending)
+		repo.AssertNotCalled(t, "CreateDelivery", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return not found", func(t *testing.T) {
+		repo := new(MockWebhookRepo)
+		service := NewWebhookService(repo, &mockWebhookSender{})
+		id := uuid.New()
+
+		repo.On("GetDeliveryByID", ctx, id).Return(nil, nil)
+
+		_, err := service.ReplayDelivery(ctx, id)
+
+		assert.ErrorIs(t, err, ErrWebhookDeliveryNotFound)
+	})
+}
+
+func TestCheckOverduePayments_PublishesEvents(t *testing.T) {
+	ctx := context.Background()
+	mockPaymentRepo := new(MockPaymentRepo)
+	service := NewPaymentService(mockPaymentRepo, new(MockLeaseRepo))
+	publisher := &recordingPublisher{}
+	service.SetEventPublisher(publisher)
+
+	marked := []*domain.Payment{
+		{ID: uuid.New(), Status: domain.PaymentStatusOverdue},
+		{ID: uuid.New(), Status: domain.PaymentStatusOverdue},
+	}
+	mockPaymentRepo.On("MarkOverduePayments", ctx).Return(marked, nil)
+	mockPaymentRepo.On("GetOverdue", ctx).Return(marked, nil)
+
+	_, err := service.CheckOverduePayments(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.WebhookEventType{domain.WebhookEventPaymentOverdue, domain.WebhookEventPaymentOverdue}, publisher.events)
+	assert.Equal(t, marked[1], publisher.data[1])
+}
+
+func TestRecordUnitStatusChange_PublishesEvent(t *testing.T) {
+	ctx := context.Background()
+	publisher := &recordingPublisher{}
+	unitID := uuid.New()
+
+	recordUnitStatusChange(ctx, nil, publisher, unitID, domain.UnitStatusAvailable, domain.UnitStatusChangeReasonUnitCreated, nil)
+	assert.Empty(t, publisher.events)
+
+	recordUnitStatusChange(ctx, nil, publisher, unitID, domain.UnitStatusOccupied, domain.UnitStatusChangeReasonLeaseCreated, nil)
+	require.Equal(t, []domain.WebhookEventType{domain.WebhookEventUnitStatusChanged}, publisher.events)
+	change := publisher.data[0].(*domain.UnitStatusChange)
+	assert.Equal(t, unitID, change.UnitID)
+	assert.Equal(t, domain.UnitStatusOccupied, change.ToStatus)
+}
@@ -0,0 +1,6 @@
+-- Migration DOWN: Remover chamados de manutenção
+
+DROP TABLE IF EXISTS maintenance_ticket_photos;
+
+DROP TRIGGER IF EXISTS update_maintenance_tickets_updated_at ON maintenance_tickets;
+DROP TABLE IF EXISTS maintenance_tickets;
@@ -0,0 +1,74 @@
+-- Migration: Create maintenance tickets
+-- Description: Chamados de manutenção (ordens de serviço) por unidade, com fotos anexadas
+
+CREATE TABLE IF NOT EXISTS maintenance_tickets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Relacionamentos
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE RESTRICT,
+    lease_id UUID REFERENCES leases(id) ON DELETE SET NULL,
+    tenant_id UUID REFERENCES tenants(id) ON DELETE SET NULL,
+
+    -- Descrição do chamado
+    title VARCHAR(200) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    category VARCHAR(20) NOT NULL CHECK (category IN ('plumbing', 'electrical', 'appliance', 'structural', 'painting', 'pest_control', 'other')),
+    priority VARCHAR(10) NOT NULL CHECK (priority IN ('low', 'medium', 'high', 'urgent')),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('open', 'in_progress', 'resolved', 'closed', 'cancelled')),
+
+    -- Desocupação da unidade
+    requires_vacancy BOOLEAN NOT NULL DEFAULT FALSE,
+    previous_unit_status unit_status,
+
+    -- Prestador e custos
+    assigned_contractor VARCHAR(255),
+    contractor_phone VARCHAR(20),
+    estimated_cost DECIMAL(10,2) CHECK (estimated_cost >= 0),
+    actual_cost DECIMAL(10,2) CHECK (actual_cost >= 0),
+    resolution_notes TEXT,
+
+    -- Datas do fluxo
+    opened_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    opened_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    started_at TIMESTAMP,
+    resolved_at TIMESTAMP,
+    closed_at TIMESTAMP,
+
+    -- Auditoria
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Índices para otimizar queries mais comuns
+CREATE INDEX idx_maintenance_tickets_unit_id ON maintenance_tickets(unit_id);
+CREATE INDEX idx_maintenance_tickets_status ON maintenance_tickets(status);
+CREATE INDEX idx_maintenance_tickets_priority ON maintenance_tickets(priority);
+CREATE INDEX idx_maintenance_tickets_unit_status ON maintenance_tickets(unit_id, status);
+
+CREATE TRIGGER update_maintenance_tickets_updated_at
+    BEFORE UPDATE ON maintenance_tickets
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Fotos anexadas aos chamados
+CREATE TABLE IF NOT EXISTS maintenance_ticket_photos (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    ticket_id UUID NOT NULL REFERENCES maintenance_tickets(id) ON DELETE CASCADE,
+    url TEXT NOT NULL,
+    caption TEXT,
+    uploaded_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_maintenance_ticket_photos_ticket_id ON maintenance_ticket_photos(ticket_id);
+
+-- Comentários explicativos
+COMMENT ON TABLE maintenance_tickets IS 'Chamados de manutenção / ordens de serviço das unidades';
+COMMENT ON COLUMN maintenance_tickets.category IS 'Categoria: plumbing, electrical, appliance, structural, painting, pest_control, other';
+COMMENT ON COLUMN maintenance_tickets.priority IS 'Prioridade: low, medium, high, urgent';
+COMMENT ON COLUMN maintenance_tickets.status IS 'Fluxo: open -> in_progress -> resolved -> closed (ou cancelled)';
+COMMENT ON COLUMN maintenance_tickets.requires_vacancy IS 'Se true, a unidade vai para status maintenance enquanto o chamado estiver ativo';
+COMMENT ON COLUMN maintenance_tickets.previous_unit_status IS 'Status da unidade antes do chamado, restaurado ao resolver/cancelar';
+COMMENT ON COLUMN maintenance_tickets.estimated_cost IS 'Custo estimado pelo prestador';
+COMMENT ON COLUMN maintenance_tickets.actual_cost IS 'Custo real após conclusão';
+COMMENT ON TABLE maintenance_ticket_photos IS 'Fotos anexadas aos chamados de manutenção';
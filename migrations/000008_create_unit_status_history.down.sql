@@ -0,0 +1,3 @@
+-- Migration DOWN: Remover histórico de status das unidades
+
+DROP TABLE IF EXISTS unit_status_history;
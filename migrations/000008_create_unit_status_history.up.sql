@@ -0,0 +1,52 @@
+-- Migration: Create unit status history
+-- Description: Histórico de transições de status das unidades (ocupação, vacância, manutenção)
+
+CREATE TABLE IF NOT EXISTS unit_status_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE CASCADE,
+
+    -- Transição
+    from_status unit_status,
+    to_status unit_status NOT NULL,
+
+    -- Causa da transição
+    reason VARCHAR(30) NOT NULL CHECK (reason IN ('initial', 'unit_created', 'manual', 'lease_created', 'lease_cancelled', 'lease_expired', 'maintenance_started', 'maintenance_finished')),
+    reference_id UUID,
+
+    -- Datas
+    changed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Índices
+CREATE INDEX idx_unit_status_history_unit_changed ON unit_status_history(unit_id, changed_at);
+CREATE INDEX idx_unit_status_history_changed_at ON unit_status_history(changed_at);
+
+-- Registro inicial para unidades existentes
+-- Unidades ocupadas usam o início do contrato ativo; as demais, a última atualização da unidade (melhor estimativa disponível)
+INSERT INTO unit_status_history (unit_id, from_status, to_status, reason, reference_id, changed_at)
+SELECT
+    u.id,
+    NULL,
+    u.status,
+    'initial',
+    l.id,
+    CASE
+        WHEN u.status = 'occupied' AND l.start_date IS NOT NULL THEN l.start_date::TIMESTAMP
+        ELSE u.updated_at
+    END
+FROM units u
+LEFT JOIN LATERAL (
+    SELECT id, start_date
+    FROM leases
+    WHERE unit_id = u.id AND status IN ('active', 'expiring_soon')
+    ORDER BY start_date DESC
+    LIMIT 1
+) l ON TRUE;
+
+-- Comentários
+COMMENT ON TABLE unit_status_history IS 'Histórico de mudanças de status das unidades';
+COMMENT ON COLUMN unit_status_history.from_status IS 'Status anterior (NULL no registro inicial)';
+COMMENT ON COLUMN unit_status_history.reason IS 'Causa da mudança: initial, unit_created, manual, lease_created, lease_cancelled, lease_expired, maintenance_started, maintenance_finished';
+COMMENT ON COLUMN unit_status_history.reference_id IS 'Contrato ou chamado de manutenção que originou a mudança';
+COMMENT ON COLUMN unit_status_history.changed_at IS 'Momento em que a unidade mudou de status';
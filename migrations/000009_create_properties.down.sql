@@ -0,0 +1,11 @@
+-- Migration DOWN: Remover imóveis
+
+DROP INDEX IF EXISTS idx_units_property_id;
+
+ALTER TABLE units DROP CONSTRAINT IF EXISTS uq_units_property_number;
+ALTER TABLE units ADD CONSTRAINT units_number_key UNIQUE (number);
+
+ALTER TABLE units DROP COLUMN IF EXISTS property_id;
+
+DROP TRIGGER IF EXISTS update_properties_updated_at ON properties;
+DROP TABLE IF EXISTS properties;
@@ -0,0 +1,61 @@
+-- Migration: Create properties
+-- Description: Suporte a múltiplos imóveis (prédios); cada unidade pertence a um imóvel com numeração própria
+
+CREATE TABLE IF NOT EXISTS properties (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL UNIQUE,
+
+    -- Endereço
+    address_street VARCHAR(255) NOT NULL,
+    address_number VARCHAR(20) NOT NULL,
+    address_complement VARCHAR(100),
+    address_neighborhood VARCHAR(100) NOT NULL,
+    address_city VARCHAR(100) NOT NULL,
+    address_state VARCHAR(2) NOT NULL,
+    address_zip_code VARCHAR(9) NOT NULL,
+
+    -- Conta bancária para recebimentos
+    bank_name VARCHAR(100),
+    bank_branch VARCHAR(10),
+    bank_account VARCHAR(20),
+    pix_key VARCHAR(100),
+
+    -- Configurações
+    default_payment_due_day INTEGER CHECK (default_payment_due_day BETWEEN 1 AND 31),
+    default_painting_fee DECIMAL(10,2) NOT NULL DEFAULT 250.00 CHECK (default_painting_fee >= 0),
+    notes TEXT,
+
+    -- Auditoria
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER update_properties_updated_at
+    BEFORE UPDATE ON properties
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Imóvel padrão para as unidades já cadastradas (endereço deve ser atualizado via API)
+INSERT INTO properties (name, address_street, address_number, address_neighborhood, address_city, address_state, address_zip_code)
+SELECT 'Edifício Principal', 'A definir', 'S/N', 'A definir', 'A definir', 'SP', '00000-000'
+WHERE EXISTS (SELECT 1 FROM units);
+
+-- Vincular unidades ao imóvel
+ALTER TABLE units ADD COLUMN property_id UUID REFERENCES properties(id) ON DELETE RESTRICT;
+
+UPDATE units SET property_id = (SELECT id FROM properties ORDER BY created_at LIMIT 1);
+
+ALTER TABLE units ALTER COLUMN property_id SET NOT NULL;
+
+-- Numeração passa a ser única por imóvel
+ALTER TABLE units DROP CONSTRAINT IF EXISTS units_number_key;
+ALTER TABLE units ADD CONSTRAINT uq_units_property_number UNIQUE (property_id, number);
+
+CREATE INDEX idx_units_property_id ON units(property_id);
+
+-- Comentários
+COMMENT ON TABLE properties IS 'Imóveis (prédios) que agrupam unidades';
+COMMENT ON COLUMN properties.pix_key IS 'Chave PIX para recebimento dos aluguéis do imóvel';
+COMMENT ON COLUMN properties.default_payment_due_day IS 'Dia de vencimento sugerido para novos contratos';
+COMMENT ON COLUMN properties.default_painting_fee IS 'Taxa de pintura sugerida para novos contratos';
+COMMENT ON COLUMN units.property_id IS 'Imóvel ao qual a unidade pertence';
@@ -0,0 +1,11 @@
+-- Migration DOWN: Remover projetos de reforma
+
+DELETE FROM unit_status_history WHERE reason IN ('renovation_started', 'renovation_finished');
+
+ALTER TABLE unit_status_history DROP CONSTRAINT unit_status_history_reason_check;
+ALTER TABLE unit_status_history ADD CONSTRAINT unit_status_history_reason_check
+    CHECK (reason IN ('initial', 'unit_created', 'manual', 'lease_created', 'lease_cancelled', 'lease_expired', 'maintenance_started', 'maintenance_finished'));
+
+DROP TABLE IF EXISTS renovation_expenses;
+DROP TRIGGER IF EXISTS update_renovation_projects_updated_at ON renovation_projects;
+DROP TABLE IF EXISTS renovation_projects;
@@ -0,0 +1,74 @@
+-- Migration: Create renovation projects
+-- Description: Projetos de reforma das unidades com orçamento, despesas detalhadas e status
+
+CREATE TABLE IF NOT EXISTS renovation_projects (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Relacionamentos
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE RESTRICT,
+
+    -- Descrição do projeto
+    title VARCHAR(200) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL CHECK (status IN ('planned', 'in_progress', 'completed', 'cancelled')),
+
+    -- Planejamento
+    planned_start_date DATE NOT NULL,
+    planned_end_date DATE NOT NULL,
+    budget DECIMAL(10,2) NOT NULL CHECK (budget >= 0),
+
+    -- Status da unidade antes da reforma, restaurado ao cancelar
+    previous_unit_status unit_status,
+
+    -- Datas do fluxo
+    started_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    cancelled_at TIMESTAMP,
+    notes TEXT,
+
+    -- Auditoria
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_renovation_planned_dates CHECK (planned_start_date <= planned_end_date)
+);
+
+-- Índices para otimizar queries mais comuns
+CREATE INDEX idx_renovation_projects_unit_id ON renovation_projects(unit_id);
+CREATE INDEX idx_renovation_projects_status ON renovation_projects(status);
+CREATE INDEX idx_renovation_projects_unit_status ON renovation_projects(unit_id, status);
+
+CREATE TRIGGER update_renovation_projects_updated_at
+    BEFORE UPDATE ON renovation_projects
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Despesas detalhadas de cada projeto
+CREATE TABLE IF NOT EXISTS renovation_expenses (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES renovation_projects(id) ON DELETE CASCADE,
+    description VARCHAR(255) NOT NULL,
+    category VARCHAR(20) NOT NULL CHECK (category IN ('materials', 'labor', 'furniture', 'appliances', 'services', 'other')),
+    amount DECIMAL(10,2) NOT NULL CHECK (amount > 0),
+    expense_date DATE NOT NULL,
+    supplier VARCHAR(255),
+    receipt_url TEXT,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_renovation_expenses_project_id ON renovation_expenses(project_id);
+
+-- Novos motivos no histórico de status das unidades
+ALTER TABLE unit_status_history DROP CONSTRAINT unit_status_history_reason_check;
+ALTER TABLE unit_status_history ADD CONSTRAINT unit_status_history_reason_check
+    CHECK (reason IN ('initial', 'unit_created', 'manual', 'lease_created', 'lease_cancelled', 'lease_expired', 'maintenance_started', 'maintenance_finished', 'renovation_started', 'renovation_finished'));
+
+-- Comentários explicativos
+COMMENT ON TABLE renovation_projects IS 'Projetos de reforma das unidades';
+COMMENT ON COLUMN renovation_projects.status IS 'Fluxo: planned -> in_progress -> completed (ou cancelled)';
+COMMENT ON COLUMN renovation_projects.budget IS 'Orçamento previsto para a reforma';
+COMMENT ON COLUMN renovation_projects.previous_unit_status IS 'Status da unidade antes da reforma, restaurado ao cancelar';
+COMMENT ON TABLE renovation_expenses IS 'Despesas detalhadas dos projetos de reforma';
+COMMENT ON COLUMN renovation_expenses.category IS 'Categoria: materials, labor, furniture, appliances, services, other';
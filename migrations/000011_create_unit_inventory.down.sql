@@ -0,0 +1,6 @@
+-- Migration DOWN: Remover inventário das unidades
+
+DROP TABLE IF EXISTS inventory_checklist_items;
+DROP TABLE IF EXISTS inventory_checklists;
+DROP TRIGGER IF EXISTS update_unit_inventory_items_updated_at ON unit_inventory_items;
+DROP TABLE IF EXISTS unit_inventory_items;
@@ -0,0 +1,71 @@
+-- Migration: Create unit inventory
+-- Description: Inventário de móveis e eletrodomésticos por unidade e checklists de entrada/saída por contrato
+
+CREATE TABLE IF NOT EXISTS unit_inventory_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Relacionamentos
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE CASCADE,
+
+    -- Identificação do item
+    name VARCHAR(150) NOT NULL,
+    item_type VARCHAR(20) NOT NULL CHECK (item_type IN ('furniture', 'appliance', 'electronics', 'fixture', 'other')),
+    brand VARCHAR(100),
+    serial_number VARCHAR(100),
+    condition VARCHAR(20) NOT NULL CHECK (condition IN ('new', 'good', 'fair', 'damaged', 'broken')),
+
+    -- Aquisição (base para custo de reposição)
+    purchase_date DATE,
+    purchase_value DECIMAL(10,2) CHECK (purchase_value >= 0),
+
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_unit_inventory_items_unit_id ON unit_inventory_items(unit_id);
+
+CREATE TRIGGER update_unit_inventory_items_updated_at
+    BEFORE UPDATE ON unit_inventory_items
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Checklists de entrada (snapshot do inventário no início do contrato) e de saída
+CREATE TABLE IF NOT EXISTS inventory_checklists (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    lease_id UUID NOT NULL REFERENCES leases(id) ON DELETE CASCADE,
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE CASCADE,
+    checklist_type VARCHAR(10) NOT NULL CHECK (checklist_type IN ('move_in', 'move_out')),
+    notes TEXT,
+    performed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    performed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_inventory_checklists_lease_type UNIQUE (lease_id, checklist_type)
+);
+
+CREATE INDEX idx_inventory_checklists_lease_id ON inventory_checklists(lease_id);
+
+-- Itens conferidos em cada checklist (cópia dos dados do item no momento da conferência)
+CREATE TABLE IF NOT EXISTS inventory_checklist_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    checklist_id UUID NOT NULL REFERENCES inventory_checklists(id) ON DELETE CASCADE,
+    item_id UUID REFERENCES unit_inventory_items(id) ON DELETE SET NULL,
+    item_name VARCHAR(150) NOT NULL,
+    item_type VARCHAR(20) NOT NULL,
+    brand VARCHAR(100),
+    serial_number VARCHAR(100),
+    condition VARCHAR(20) NOT NULL CHECK (condition IN ('new', 'good', 'fair', 'damaged', 'broken')),
+    present BOOLEAN NOT NULL DEFAULT TRUE,
+    replacement_value DECIMAL(10,2) CHECK (replacement_value >= 0),
+    notes TEXT
+);
+
+CREATE INDEX idx_inventory_checklist_items_checklist_id ON inventory_checklist_items(checklist_id);
+
+-- Comentários para documentação
+COMMENT ON TABLE unit_inventory_items IS 'Móveis, eletrodomésticos e demais itens que mobiliam cada unidade';
+COMMENT ON COLUMN unit_inventory_items.purchase_value IS 'Valor de compra, usado como custo de reposição';
+COMMENT ON TABLE inventory_checklists IS 'Conferência do inventário na entrada (move_in) e saída (move_out) do morador';
+COMMENT ON TABLE inventory_checklist_items IS 'Estado de cada item no momento da conferência';
+COMMENT ON COLUMN inventory_checklist_items.present IS 'FALSE quando o item não foi encontrado na conferência';
@@ -0,0 +1,13 @@
+-- Migration DOWN: Remover cobrança de consumo
+
+DROP TABLE IF EXISTS utility_charges;
+DELETE FROM payments WHERE payment_type = 'utility';
+
+ALTER TABLE payments DROP CONSTRAINT payments_payment_type_check;
+ALTER TABLE payments ADD CONSTRAINT payments_payment_type_check
+    CHECK (payment_type IN ('rent', 'painting_fee', 'adjustment'));
+
+COMMENT ON COLUMN payments.payment_type IS 'Tipo: rent (aluguel), painting_fee (taxa pintura), adjustment (ajuste)';
+
+DROP TABLE IF EXISTS meter_readings;
+DROP TABLE IF EXISTS utility_tariffs;
@@ -0,0 +1,73 @@
+-- Migration: Create utility billing
+-- Description: Leituras de medidores, tarifas e cobrança de consumo de água e energia por contrato
+
+-- Tarifas por tipo de consumo, válidas a partir de uma data
+CREATE TABLE IF NOT EXISTS utility_tariffs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    utility_type VARCHAR(20) NOT NULL CHECK (utility_type IN ('water', 'electricity')),
+    price_per_unit DECIMAL(10,4) NOT NULL CHECK (price_per_unit > 0),
+    fixed_fee DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (fixed_fee >= 0),
+    effective_from DATE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_utility_tariffs_type_effective UNIQUE (utility_type, effective_from)
+);
+
+-- Leituras mensais dos medidores de cada unidade
+CREATE TABLE IF NOT EXISTS meter_readings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE CASCADE,
+    utility_type VARCHAR(20) NOT NULL CHECK (utility_type IN ('water', 'electricity')),
+    reference_month DATE NOT NULL,
+    reading DECIMAL(12,3) NOT NULL CHECK (reading >= 0),
+    reading_date DATE NOT NULL,
+    notes TEXT,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_meter_readings_unit_type_month UNIQUE (unit_id, utility_type, reference_month)
+);
+
+CREATE INDEX idx_meter_readings_unit_id ON meter_readings(unit_id);
+
+-- Detalhamento de cada cobrança de consumo vinculada a um pagamento
+CREATE TABLE IF NOT EXISTS utility_charges (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    payment_id UUID NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+    lease_id UUID NOT NULL REFERENCES leases(id) ON DELETE CASCADE,
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE CASCADE,
+    utility_type VARCHAR(20) NOT NULL CHECK (utility_type IN ('water', 'electricity')),
+    reference_month DATE NOT NULL,
+    billing_method VARCHAR(20) NOT NULL CHECK (billing_method IN ('metered', 'split_occupants', 'split_equal')),
+
+    -- Cobrança por medidor
+    previous_reading DECIMAL(12,3),
+    current_reading DECIMAL(12,3),
+    consumption DECIMAL(12,3),
+    price_per_unit DECIMAL(10,4),
+    fixed_fee DECIMAL(10,2) NOT NULL DEFAULT 0,
+
+    -- Rateio da conta do prédio
+    building_total DECIMAL(10,2),
+    share_weight INTEGER,
+    total_shares INTEGER,
+
+    amount DECIMAL(10,2) NOT NULL CHECK (amount >= 0),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_utility_charges_lease_type_month UNIQUE (lease_id, utility_type, reference_month)
+);
+
+CREATE INDEX idx_utility_charges_payment_id ON utility_charges(payment_id);
+CREATE INDEX idx_utility_charges_lease_month ON utility_charges(lease_id, reference_month);
+
+-- Novo tipo de pagamento para consumo
+ALTER TABLE payments DROP CONSTRAINT payments_payment_type_check;
+ALTER TABLE payments ADD CONSTRAINT payments_payment_type_check
+    CHECK (payment_type IN ('rent', 'painting_fee', 'adjustment', 'utility'));
+
+-- Comentários explicativos
+COMMENT ON TABLE utility_tariffs IS 'Tarifas de água e energia usadas na cobrança por medidor';
+COMMENT ON COLUMN utility_tariffs.effective_from IS 'Data a partir da qual a tarifa é aplicada';
+COMMENT ON TABLE meter_readings IS 'Leituras mensais dos medidores das unidades';
+COMMENT ON COLUMN meter_readings.reference_month IS 'Mês de referência da leitura (primeiro dia do mês)';
+COMMENT ON TABLE utility_charges IS 'Detalhamento das cobranças de consumo por contrato';
+COMMENT ON COLUMN utility_charges.billing_method IS 'Método: metered (medidor), split_occupants (rateio por moradores), split_equal (rateio igual)';
+COMMENT ON COLUMN payments.payment_type IS 'Tipo: rent (aluguel), painting_fee (taxa pintura), adjustment (ajuste), utility (consumo)';
@@ -0,0 +1,16 @@
+-- Migration DOWN: Remover caução
+
+DROP TABLE IF EXISTS lease_deposit_settlements;
+DELETE FROM payments WHERE payment_type = 'security_deposit';
+
+ALTER TABLE payments DROP CONSTRAINT payments_payment_type_check;
+ALTER TABLE payments ADD CONSTRAINT payments_payment_type_check
+    CHECK (payment_type IN ('rent', 'painting_fee', 'adjustment', 'utility'));
+
+COMMENT ON COLUMN payments.payment_type IS 'Tipo: rent (aluguel), painting_fee (taxa pintura), adjustment (ajuste), utility (consumo)';
+
+ALTER TABLE leases
+  DROP COLUMN IF EXISTS deposit_correction_index,
+  DROP COLUMN IF EXISTS deposit_held_at,
+  DROP COLUMN IF EXISTS deposit_received_date,
+  DROP COLUMN IF EXISTS security_deposit;
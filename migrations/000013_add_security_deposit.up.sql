@@ -0,0 +1,49 @@
+-- Migration: Add security deposit
+-- Description: Adiciona caução ao contrato e registra o acerto de devolução na saída do morador
+
+-- Dados da caução no contrato
+ALTER TABLE leases
+  ADD COLUMN security_deposit DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (security_deposit >= 0),
+  ADD COLUMN deposit_received_date DATE,
+  ADD COLUMN deposit_held_at VARCHAR(20) CHECK (deposit_held_at IN ('savings_account', 'bank_account', 'cash', 'other')),
+  ADD COLUMN deposit_correction_index VARCHAR(20) CHECK (deposit_correction_index IN ('savings', 'ipca', 'igpm', 'none'));
+
+-- Acerto da caução na saída do morador
+CREATE TABLE IF NOT EXISTS lease_deposit_settlements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    lease_id UUID NOT NULL UNIQUE REFERENCES leases(id) ON DELETE CASCADE,
+
+    -- Valores do acerto
+    deposit_amount DECIMAL(10,2) NOT NULL CHECK (deposit_amount >= 0),
+    correction_rate DECIMAL(7,4) NOT NULL DEFAULT 0,
+    correction_amount DECIMAL(10,2) NOT NULL DEFAULT 0,
+    damage_deductions DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (damage_deductions >= 0),
+    debt_deductions DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (debt_deductions >= 0),
+    other_deductions DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (other_deductions >= 0),
+    refund_amount DECIMAL(10,2) NOT NULL CHECK (refund_amount >= 0),
+    balance_due DECIMAL(10,2) NOT NULL DEFAULT 0 CHECK (balance_due >= 0),
+
+    -- Devolução
+    refunded_at DATE,
+    refund_method VARCHAR(20),
+    notes TEXT,
+
+    -- Auditoria
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Novo tipo de pagamento para a caução
+ALTER TABLE payments DROP CONSTRAINT payments_payment_type_check;
+ALTER TABLE payments ADD CONSTRAINT payments_payment_type_check
+    CHECK (payment_type IN ('rent', 'painting_fee', 'adjustment', 'utility', 'security_deposit'));
+
+-- Comentários explicativos
+COMMENT ON COLUMN leases.security_deposit IS 'Valor da caução (até três aluguéis)';
+COMMENT ON COLUMN leases.deposit_received_date IS 'Data em que a caução foi recebida';
+COMMENT ON COLUMN leases.deposit_held_at IS 'Onde a caução está guardada: savings_account, bank_account, cash, other';
+COMMENT ON COLUMN leases.deposit_correction_index IS 'Índice de correção da caução: savings (poupança), ipca, igpm, none';
+COMMENT ON TABLE lease_deposit_settlements IS 'Acerto da caução na saída: correção, descontos e valor devolvido';
+COMMENT ON COLUMN lease_deposit_settlements.correction_rate IS 'Percentual de correção acumulado no período';
+COMMENT ON COLUMN lease_deposit_settlements.balance_due IS 'Valor que o morador ainda deve quando os descontos superam a caução';
+COMMENT ON COLUMN payments.payment_type IS 'Tipo: rent (aluguel), painting_fee (taxa pintura), adjustment (ajuste), utility (consumo), security_deposit (caução)';
@@ -0,0 +1,19 @@
+-- Migration DOWN: Voltar a aceitar apenas CPF
+-- Não remove dados: falha se houver moradores cadastrados por CNPJ, que precisam ser tratados antes
+
+DO $$
+DECLARE
+    cnpj_count INTEGER;
+BEGIN
+    SELECT COUNT(*) INTO cnpj_count FROM tenants WHERE cpf !~ '^\d{3}\.\d{3}\.\d{3}-\d{2}$';
+    IF cnpj_count > 0 THEN
+        RAISE EXCEPTION 'cannot revert migration 000014: % tenant(s) registered with CNPJ', cnpj_count;
+    END IF;
+END $$;
+
+ALTER TABLE tenants DROP CONSTRAINT check_cpf_format;
+ALTER TABLE tenants ADD CONSTRAINT check_cpf_format CHECK (cpf ~ '^\d{3}\.\d{3}\.\d{3}-\d{2}$');
+
+ALTER TABLE tenants ALTER COLUMN cpf TYPE VARCHAR(14);
+
+COMMENT ON COLUMN tenants.cpf IS 'Brazilian CPF document (formatted: XXX.XXX.XXX-XX)';
@@ -0,0 +1,12 @@
+-- Migration: Allow CNPJ tenants
+-- Description: Permite cadastrar empresas por CNPJ (inclusive alfanumérico) na coluna de documento do morador
+
+ALTER TABLE tenants ALTER COLUMN cpf TYPE VARCHAR(18);
+
+ALTER TABLE tenants DROP CONSTRAINT check_cpf_format;
+ALTER TABLE tenants ADD CONSTRAINT check_cpf_format CHECK (
+    cpf ~ '^\d{3}\.\d{3}\.\d{3}-\d{2}$'
+    OR cpf ~ '^[0-9A-Z]{2}\.[0-9A-Z]{3}\.[0-9A-Z]{3}/[0-9A-Z]{4}-\d{2}$'
+);
+
+COMMENT ON COLUMN tenants.cpf IS 'Documento do morador: CPF (XXX.XXX.XXX-XX) ou CNPJ para empresas (XX.XXX.XXX/XXXX-XX)';
@@ -0,0 +1,15 @@
+-- Migration DOWN: Remover portal do morador
+
+DROP TABLE IF EXISTS tenant_login_codes;
+DELETE FROM users WHERE role = 'tenant';
+ALTER TABLE users DROP COLUMN IF EXISTS tenant_id;
+
+-- PostgreSQL não remove valores de ENUM: recriar o tipo sem 'tenant'
+ALTER TYPE user_role RENAME TO user_role_old;
+CREATE TYPE user_role AS ENUM ('admin', 'manager', 'viewer');
+ALTER TABLE users ALTER COLUMN role DROP DEFAULT;
+ALTER TABLE users ALTER COLUMN role TYPE user_role USING role::text::user_role;
+ALTER TABLE users ALTER COLUMN role SET DEFAULT 'viewer';
+DROP TYPE user_role_old;
+
+COMMENT ON COLUMN users.role IS 'User role: admin (full access), manager (manage operations), viewer (read-only)';
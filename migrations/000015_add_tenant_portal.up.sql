@@ -0,0 +1,25 @@
+-- Migration: Add tenant portal
+-- Description: Adiciona o papel de morador aos usuários e códigos de acesso de uso único para o portal
+
+ALTER TYPE user_role ADD VALUE IF NOT EXISTS 'tenant';
+
+ALTER TABLE users
+  ADD COLUMN tenant_id UUID UNIQUE REFERENCES tenants(id) ON DELETE CASCADE;
+
+CREATE TABLE tenant_login_codes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash VARCHAR(255) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0 CHECK (attempts >= 0),
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_tenant_login_codes_user_id ON tenant_login_codes(user_id);
+
+COMMENT ON COLUMN users.tenant_id IS 'Morador vinculado ao usuário (apenas para o papel tenant)';
+COMMENT ON COLUMN users.role IS 'User role: admin (full access), manager (manage operations), viewer (read-only), tenant (portal do morador)';
+COMMENT ON TABLE tenant_login_codes IS 'Códigos de acesso de uso único do portal do morador';
+COMMENT ON COLUMN tenant_login_codes.code_hash IS 'Hash bcrypt do código de 6 dígitos';
+COMMENT ON COLUMN tenant_login_codes.attempts IS 'Tentativas de login com o código (bloqueado após o limite)';
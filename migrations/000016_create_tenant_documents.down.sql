@@ -0,0 +1,3 @@
+-- Migration DOWN: Remover documentos dos moradores
+
+DROP TABLE IF EXISTS tenant_documents;
@@ -0,0 +1,34 @@
+-- Migration: Create tenant documents
+-- Description: Arquivos anexados aos moradores e contratos (documento de identidade, comprovante de renda, contrato assinado, vistoria)
+
+CREATE TABLE IF NOT EXISTS tenant_documents (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Relacionamentos
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    lease_id UUID REFERENCES leases(id) ON DELETE SET NULL,
+
+    -- Classificação do documento
+    document_type VARCHAR(30) NOT NULL CHECK (document_type IN ('id_rg', 'id_cnh', 'proof_of_income', 'signed_contract', 'move_in_inspection', 'other')),
+    expires_at DATE,
+
+    -- Arquivo armazenado
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes > 0),
+    checksum VARCHAR(64) NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+
+    -- Auditoria
+    uploaded_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_tenant_documents_tenant_id ON tenant_documents(tenant_id);
+CREATE INDEX idx_tenant_documents_lease_id ON tenant_documents(lease_id);
+
+COMMENT ON TABLE tenant_documents IS 'Arquivos dos moradores e contratos guardados no backend de armazenamento';
+COMMENT ON COLUMN tenant_documents.document_type IS 'Tipo: id_rg, id_cnh, proof_of_income, signed_contract, move_in_inspection, other';
+COMMENT ON COLUMN tenant_documents.expires_at IS 'Validade do documento (ex: CNH, comprovante de renda)';
+COMMENT ON COLUMN tenant_documents.checksum IS 'SHA-256 do conteúdo em hexadecimal';
+COMMENT ON COLUMN tenant_documents.storage_key IS 'Chave do arquivo no backend de armazenamento';
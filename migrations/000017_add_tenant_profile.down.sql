@@ -0,0 +1,12 @@
+-- Migration DOWN: Remover dados de triagem do morador
+
+DROP TABLE IF EXISTS tenant_pets;
+DROP TABLE IF EXISTS tenant_occupants;
+DROP TABLE IF EXISTS tenant_contacts;
+
+ALTER TABLE tenants
+  DROP COLUMN IF EXISTS employer_start_date,
+  DROP COLUMN IF EXISTS employer_monthly_income,
+  DROP COLUMN IF EXISTS employer_job_title,
+  DROP COLUMN IF EXISTS employer_phone,
+  DROP COLUMN IF EXISTS employer_name;
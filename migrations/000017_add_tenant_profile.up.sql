@@ -0,0 +1,57 @@
+-- Migration: Add tenant profile
+-- Description: Dados de triagem e segurança do morador: empregador, contatos de emergência, referências, ocupantes e animais
+
+ALTER TABLE tenants
+  ADD COLUMN employer_name VARCHAR(150),
+  ADD COLUMN employer_phone VARCHAR(20),
+  ADD COLUMN employer_job_title VARCHAR(100),
+  ADD COLUMN employer_monthly_income DECIMAL(10,2) CHECK (employer_monthly_income >= 0),
+  ADD COLUMN employer_start_date DATE;
+
+-- Contatos de emergência e referências (ex: antigo locador)
+CREATE TABLE IF NOT EXISTS tenant_contacts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    contact_type VARCHAR(20) NOT NULL CHECK (contact_type IN ('emergency', 'reference')),
+    name VARCHAR(150) NOT NULL,
+    relationship VARCHAR(50) NOT NULL,
+    phone VARCHAR(20) NOT NULL,
+    email VARCHAR(255),
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_tenant_contacts_tenant_id ON tenant_contacts(tenant_id);
+
+-- Pessoas que moram na unidade junto com o morador titular
+CREATE TABLE IF NOT EXISTS tenant_occupants (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    full_name VARCHAR(255) NOT NULL,
+    relationship VARCHAR(50) NOT NULL,
+    document VARCHAR(50),
+    birth_date DATE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_tenant_occupants_tenant_id ON tenant_occupants(tenant_id);
+
+CREATE TABLE IF NOT EXISTS tenant_pets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    species VARCHAR(20) NOT NULL CHECK (species IN ('dog', 'cat', 'bird', 'fish', 'rodent', 'other')),
+    breed VARCHAR(100),
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_tenant_pets_tenant_id ON tenant_pets(tenant_id);
+
+COMMENT ON COLUMN tenants.employer_name IS 'Empresa onde o morador trabalha';
+COMMENT ON COLUMN tenants.employer_monthly_income IS 'Renda mensal declarada';
+COMMENT ON TABLE tenant_contacts IS 'Contatos de emergência e referências do morador';
+COMMENT ON COLUMN tenant_contacts.contact_type IS 'Tipo: emergency (contato de emergência), reference (referência, ex: antigo locador)';
+COMMENT ON TABLE tenant_occupants IS 'Ocupantes da unidade além do morador titular';
+COMMENT ON COLUMN tenant_occupants.document IS 'Documento do ocupante (CPF ou RG), opcional para menores';
+COMMENT ON TABLE tenant_pets IS 'Animais de estimação do morador';
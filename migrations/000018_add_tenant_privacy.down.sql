@@ -0,0 +1,17 @@
+-- Migration DOWN: Remover anonimização e histórico de solicitações LGPD
+
+DROP TABLE IF EXISTS tenant_data_requests;
+
+-- Moradores já anonimizados não passam na validação anterior e continuam referenciados
+-- por contratos e pagamentos: a restrição volta sem revalidar as linhas existentes
+ALTER TABLE tenants DROP CONSTRAINT check_cpf_format;
+ALTER TABLE tenants ADD CONSTRAINT check_cpf_format CHECK (
+    cpf ~ '^\d{3}\.\d{3}\.\d{3}-\d{2}$'
+    OR cpf ~ '^[0-9A-Z]{2}\.[0-9A-Z]{3}\.[0-9A-Z]{3}/[0-9A-Z]{4}-\d{2}$'
+) NOT VALID;
+ALTER TABLE tenants DROP CONSTRAINT check_phone_not_empty;
+ALTER TABLE tenants ADD CONSTRAINT check_phone_not_empty CHECK (length(trim(phone)) > 0) NOT VALID;
+
+ALTER TABLE tenants
+  DROP COLUMN IF EXISTS anonymized_by,
+  DROP COLUMN IF EXISTS anonymized_at;
@@ -0,0 +1,38 @@
+-- Migration: Add tenant privacy
+-- Description: Atendimento à LGPD: anonimização de ex-moradores e registro das solicitações do titular (exportação e anonimização)
+
+ALTER TABLE tenants
+  ADD COLUMN anonymized_at TIMESTAMP,
+  ADD COLUMN anonymized_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+-- Moradores anonimizados recebem um identificador no lugar do CPF/CNPJ (mantém a unicidade)
+ALTER TABLE tenants DROP CONSTRAINT check_cpf_format;
+ALTER TABLE tenants ADD CONSTRAINT check_cpf_format CHECK (
+    cpf ~ '^\d{3}\.\d{3}\.\d{3}-\d{2}$'
+    OR cpf ~ '^[0-9A-Z]{2}\.[0-9A-Z]{3}\.[0-9A-Z]{3}/[0-9A-Z]{4}-\d{2}$'
+    OR (anonymized_at IS NOT NULL AND cpf ~ '^ANON-[0-9a-f]{12}$')
+);
+
+-- O telefone é removido na anonimização
+ALTER TABLE tenants DROP CONSTRAINT check_phone_not_empty;
+ALTER TABLE tenants ADD CONSTRAINT check_phone_not_empty CHECK (
+    length(trim(phone)) > 0
+    OR anonymized_at IS NOT NULL
+);
+
+-- Registro de quando e por quem os dados do titular foram exportados ou anonimizados
+CREATE TABLE IF NOT EXISTS tenant_data_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    request_type VARCHAR(20) NOT NULL CHECK (request_type IN ('export', 'anonymization')),
+    performed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    performed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    notes TEXT
+);
+
+CREATE INDEX idx_tenant_data_requests_tenant_id ON tenant_data_requests(tenant_id);
+
+COMMENT ON COLUMN tenants.anonymized_at IS 'Data da anonimização dos dados pessoais (LGPD); registros financeiros são mantidos';
+COMMENT ON COLUMN tenants.anonymized_by IS 'Usuário que executou a anonimização';
+COMMENT ON TABLE tenant_data_requests IS 'Histórico de solicitações do titular dos dados (LGPD)';
+COMMENT ON COLUMN tenant_data_requests.request_type IS 'Tipo: export (exportação dos dados), anonymization (anonimização)';
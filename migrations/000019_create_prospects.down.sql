@@ -0,0 +1,7 @@
+-- Migration DOWN: Remover interessados e visitas
+
+DROP TRIGGER IF EXISTS update_prospect_visits_updated_at ON prospect_visits;
+DROP TABLE IF EXISTS prospect_visits;
+
+DROP TRIGGER IF EXISTS update_prospects_updated_at ON prospects;
+DROP TABLE IF EXISTS prospects;
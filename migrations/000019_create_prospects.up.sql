@@ -0,0 +1,70 @@
+-- Migration: Create prospects
+-- Description: Funil de interessados (leads) com agendamento de visitas e lista de espera para unidades vagas
+
+CREATE TABLE IF NOT EXISTS prospects (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Contato
+    full_name VARCHAR(255) NOT NULL,
+    phone VARCHAR(20) NOT NULL,
+    email VARCHAR(255),
+    cpf VARCHAR(18),
+    source VARCHAR(20) NOT NULL CHECK (source IN ('whatsapp', 'phone', 'walk_in', 'referral', 'website', 'other')),
+
+    -- Preferências para a busca de unidades
+    desired_move_in_date DATE,
+    max_budget DECIMAL(10,2) CHECK (max_budget > 0),
+    preferred_floor INTEGER CHECK (preferred_floor >= 1),
+
+    -- Funil
+    status VARCHAR(20) NOT NULL CHECK (status IN ('new', 'visited', 'applied', 'approved', 'converted', 'lost')),
+    lost_reason TEXT,
+    notes TEXT,
+
+    -- Conversão em morador
+    converted_tenant_id UUID REFERENCES tenants(id) ON DELETE SET NULL,
+    converted_at TIMESTAMP,
+
+    -- Auditoria
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_prospects_status ON prospects(status);
+CREATE INDEX idx_prospects_desired_move_in_date ON prospects(desired_move_in_date);
+
+CREATE TRIGGER update_prospects_updated_at
+    BEFORE UPDATE ON prospects
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Visitas agendadas com os interessados
+CREATE TABLE IF NOT EXISTS prospect_visits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    prospect_id UUID NOT NULL REFERENCES prospects(id) ON DELETE CASCADE,
+    unit_id UUID REFERENCES units(id) ON DELETE SET NULL,
+    scheduled_at TIMESTAMP NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('scheduled', 'completed', 'cancelled', 'no_show')),
+    notes TEXT,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_prospect_visits_prospect_id ON prospect_visits(prospect_id);
+CREATE INDEX idx_prospect_visits_scheduled_at ON prospect_visits(scheduled_at);
+
+CREATE TRIGGER update_prospect_visits_updated_at
+    BEFORE UPDATE ON prospect_visits
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Comentários explicativos
+COMMENT ON TABLE prospects IS 'Interessados em alugar uma unidade (leads) e lista de espera';
+COMMENT ON COLUMN prospects.source IS 'Origem do contato: whatsapp, phone, walk_in, referral, website, other';
+COMMENT ON COLUMN prospects.status IS 'Fluxo: new -> visited -> applied -> approved -> converted (ou lost, que pode ser reaberto)';
+COMMENT ON COLUMN prospects.max_budget IS 'Valor máximo de aluguel aceito pelo interessado';
+COMMENT ON COLUMN prospects.converted_tenant_id IS 'Morador criado a partir do interessado';
+COMMENT ON TABLE prospect_visits IS 'Visitas agendadas com interessados';
+COMMENT ON COLUMN prospect_visits.status IS 'Status: scheduled, completed, cancelled, no_show';
@@ -0,0 +1,12 @@
+-- Migration DOWN: Remover índices da busca global
+
+DROP INDEX IF EXISTS idx_payments_reference_month;
+DROP INDEX IF EXISTS idx_payments_amount;
+DROP INDEX IF EXISTS idx_units_number_trgm;
+DROP INDEX IF EXISTS idx_tenants_email_trgm;
+DROP INDEX IF EXISTS idx_tenants_phone_digits_trgm;
+DROP INDEX IF EXISTS idx_tenants_cpf_digits_trgm;
+DROP INDEX IF EXISTS idx_tenants_full_name_trgm;
+DROP INDEX IF EXISTS idx_tenants_full_name_fts;
+
+-- A extensão pg_trgm é mantida, pois pode ser usada por outros objetos
@@ -0,0 +1,22 @@
+-- Migration: Add global search indexes
+-- Description: Índices de texto completo e trigramas para a busca global (moradores, unidades, contratos e pagamentos)
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Moradores: nome (texto completo e trigramas), fragmentos de CPF/CNPJ e telefone, e-mail
+CREATE INDEX IF NOT EXISTS idx_tenants_full_name_fts ON tenants USING GIN (to_tsvector('simple', full_name));
+CREATE INDEX IF NOT EXISTS idx_tenants_full_name_trgm ON tenants USING GIN (full_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_tenants_cpf_digits_trgm ON tenants USING GIN ((regexp_replace(cpf, '[^0-9A-Za-z]', '', 'g')) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_tenants_phone_digits_trgm ON tenants USING GIN ((regexp_replace(phone, '[^0-9]', '', 'g')) gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_tenants_email_trgm ON tenants USING GIN (email gin_trgm_ops);
+
+-- Unidades: número
+CREATE INDEX IF NOT EXISTS idx_units_number_trgm ON units USING GIN (number gin_trgm_ops);
+
+-- Pagamentos: valor e mês de referência
+CREATE INDEX IF NOT EXISTS idx_payments_amount ON payments(amount);
+CREATE INDEX IF NOT EXISTS idx_payments_reference_month ON payments(reference_month);
+
+-- Comentários explicativos
+COMMENT ON INDEX idx_tenants_cpf_digits_trgm IS 'Busca por fragmento do CPF/CNPJ sem pontuação';
+COMMENT ON INDEX idx_tenants_phone_digits_trgm IS 'Busca por fragmento do telefone sem formatação';
@@ -0,0 +1,4 @@
+-- Migration DOWN: Remover notificações
+
+DROP TRIGGER IF EXISTS update_notifications_updated_at ON notifications;
+DROP TABLE IF EXISTS notifications;
@@ -0,0 +1,49 @@
+-- Migration: Create notifications
+-- Description: Fila persistente de notificações (lembretes de aluguel e contratos expirando) com tentativas de envio
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    -- Origem
+    lease_id UUID REFERENCES leases(id) ON DELETE CASCADE,
+    tenant_id UUID REFERENCES tenants(id) ON DELETE CASCADE,
+    payment_id UUID REFERENCES payments(id) ON DELETE CASCADE,
+
+    -- Mensagem
+    type VARCHAR(50) NOT NULL CHECK (type IN ('rent_reminder', 'contract_expiring')),
+    channel VARCHAR(20) NOT NULL CHECK (channel IN ('internal', 'email', 'whatsapp', 'sms')),
+    recipient VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    message_content TEXT NOT NULL,
+
+    -- Fila
+    scheduled_date DATE NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'sent', 'failed', 'cancelled')),
+    attempts INTEGER NOT NULL DEFAULT 0 CHECK (attempts >= 0),
+    next_attempt_at TIMESTAMP,
+    last_error TEXT,
+    sent_date TIMESTAMP,
+
+    -- Evita gerar a mesma notificação duas vezes (ex: rent_reminder:<payment_id>:<channel>)
+    dedup_key VARCHAR(255) NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_notifications_dedup_key ON notifications(dedup_key);
+CREATE INDEX idx_notifications_status_scheduled_date ON notifications(status, scheduled_date);
+CREATE INDEX idx_notifications_lease_id ON notifications(lease_id);
+
+CREATE TRIGGER update_notifications_updated_at
+    BEFORE UPDATE ON notifications
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Comentários explicativos
+COMMENT ON TABLE notifications IS 'Fila de notificações enviadas aos moradores';
+COMMENT ON COLUMN notifications.type IS 'Tipo: rent_reminder (3 dias antes do vencimento), contract_expiring (45 dias antes do fim)';
+COMMENT ON COLUMN notifications.channel IS 'Canal de envio: internal, email, whatsapp, sms';
+COMMENT ON COLUMN notifications.status IS 'Fluxo: pending -> sent (ou failed após esgotar as tentativas, ou cancelled)';
+COMMENT ON COLUMN notifications.next_attempt_at IS 'Próxima tentativa após falha (backoff exponencial)';
+COMMENT ON COLUMN notifications.dedup_key IS 'Chave única que impede notificações duplicadas';
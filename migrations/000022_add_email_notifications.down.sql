@@ -0,0 +1,23 @@
+-- Migration DOWN: Remover envio por e-mail
+
+DELETE FROM notifications WHERE type IN ('overdue_notice', 'payment_receipt');
+UPDATE notifications SET status = 'failed' WHERE status = 'bounced';
+
+ALTER TABLE notifications DROP CONSTRAINT notifications_status_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_status_check
+    CHECK (status IN ('pending', 'sent', 'failed', 'cancelled'));
+
+ALTER TABLE notifications DROP CONSTRAINT notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+    CHECK (type IN ('rent_reminder', 'contract_expiring'));
+
+COMMENT ON COLUMN notifications.type IS 'Tipo: rent_reminder (3 dias antes do vencimento), contract_expiring (45 dias antes do fim)';
+COMMENT ON COLUMN notifications.status IS 'Fluxo: pending -> sent (ou failed após esgotar as tentativas, ou cancelled)';
+
+DROP INDEX IF EXISTS idx_notifications_provider_message_id;
+
+ALTER TABLE notifications
+  DROP COLUMN IF EXISTS bounce_reason,
+  DROP COLUMN IF EXISTS bounced_at,
+  DROP COLUMN IF EXISTS provider_message_id,
+  DROP COLUMN IF EXISTS html_content;
@@ -0,0 +1,27 @@
+-- Migration: Add email notifications
+-- Description: Envio por e-mail com conteúdo HTML, novos tipos (aviso de atraso e recibo) e registro de devolução (bounce)
+
+ALTER TABLE notifications
+  ADD COLUMN html_content TEXT,
+  ADD COLUMN provider_message_id VARCHAR(255),
+  ADD COLUMN bounced_at TIMESTAMP,
+  ADD COLUMN bounce_reason TEXT;
+
+CREATE INDEX idx_notifications_provider_message_id ON notifications(provider_message_id);
+
+-- Novos tipos de notificação
+ALTER TABLE notifications DROP CONSTRAINT notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+    CHECK (type IN ('rent_reminder', 'contract_expiring', 'overdue_notice', 'payment_receipt'));
+
+-- Novo status para mensagens devolvidas pelo provedor
+ALTER TABLE notifications DROP CONSTRAINT notifications_status_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_status_check
+    CHECK (status IN ('pending', 'sent', 'failed', 'cancelled', 'bounced'));
+
+-- Comentários explicativos
+COMMENT ON COLUMN notifications.type IS 'Tipo: rent_reminder (3 dias antes do vencimento), contract_expiring (45 dias antes do fim), overdue_notice (pagamento em atraso), payment_receipt (recibo)';
+COMMENT ON COLUMN notifications.status IS 'Fluxo: pending -> sent (ou failed após esgotar as tentativas, ou cancelled); bounced quando o provedor recusa o destinatário';
+COMMENT ON COLUMN notifications.html_content IS 'Versão HTML da mensagem (e-mail)';
+COMMENT ON COLUMN notifications.provider_message_id IS 'Identificador retornado pelo provedor (Message-ID do e-mail), usado para registrar devoluções';
+COMMENT ON COLUMN notifications.bounce_reason IS 'Motivo informado pelo provedor ao devolver a mensagem';
@@ -0,0 +1,15 @@
+-- Migration DOWN: Remover canal de mensagens
+
+UPDATE notifications SET status = 'sent' WHERE status = 'delivered';
+
+ALTER TABLE notifications DROP CONSTRAINT notifications_status_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_status_check
+    CHECK (status IN ('pending', 'sent', 'failed', 'cancelled', 'bounced'));
+
+COMMENT ON COLUMN notifications.status IS 'Fluxo: pending -> sent (ou failed após esgotar as tentativas, ou cancelled); bounced quando o provedor recusa o destinatário';
+
+ALTER TABLE notifications
+  DROP COLUMN IF EXISTS delivered_at;
+
+ALTER TABLE tenants
+  DROP COLUMN IF EXISTS messaging_opt_out_at;
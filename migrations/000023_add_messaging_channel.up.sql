@@ -0,0 +1,19 @@
+-- Migration: Add messaging channel
+-- Description: Envio por WhatsApp/SMS: opt-out do morador e confirmação de entrega informada pelo provedor
+
+-- Morador que não deseja receber mensagens por WhatsApp/SMS
+ALTER TABLE tenants
+  ADD COLUMN messaging_opt_out_at TIMESTAMP;
+
+-- Confirmação de entrega (webhook do provedor)
+ALTER TABLE notifications
+  ADD COLUMN delivered_at TIMESTAMP;
+
+ALTER TABLE notifications DROP CONSTRAINT notifications_status_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_status_check
+    CHECK (status IN ('pending', 'sent', 'delivered', 'failed', 'cancelled', 'bounced'));
+
+-- Comentários explicativos
+COMMENT ON COLUMN tenants.messaging_opt_out_at IS 'Data em que o morador pediu para não receber mensagens por WhatsApp/SMS (NULL = recebe)';
+COMMENT ON COLUMN notifications.status IS 'Fluxo: pending -> sent -> delivered (ou failed após esgotar as tentativas, ou cancelled); bounced quando o provedor recusa o destinatário';
+COMMENT ON COLUMN notifications.delivered_at IS 'Data da confirmação de entrega informada pelo provedor';
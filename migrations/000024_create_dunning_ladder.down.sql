@@ -0,0 +1,12 @@
+-- Migration DOWN: Remover régua de cobrança
+
+DELETE FROM notifications WHERE type = 'formal_notice';
+
+ALTER TABLE notifications DROP CONSTRAINT notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+    CHECK (type IN ('rent_reminder', 'contract_expiring', 'overdue_notice', 'payment_receipt'));
+
+COMMENT ON COLUMN notifications.type IS 'Tipo: rent_reminder (3 dias antes do vencimento), contract_expiring (45 dias antes do fim), overdue_notice (pagamento em atraso), payment_receipt (recibo)';
+
+DROP TABLE IF EXISTS payment_collection_actions;
+DROP TABLE IF EXISTS dunning_steps;
@@ -0,0 +1,64 @@
+-- Migration: Create dunning ladder
+-- Description: Régua de cobrança configurável (etapas relativas ao vencimento) e histórico de cobrança dos pagamentos
+
+CREATE TABLE IF NOT EXISTS dunning_steps (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(50) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    offset_days INTEGER NOT NULL CHECK (offset_days BETWEEN -30 AND 365),
+    action VARCHAR(30) NOT NULL CHECK (action IN ('reminder', 'notice', 'formal_notice', 'eviction_review')),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER update_dunning_steps_updated_at
+    BEFORE UPDATE ON dunning_steps
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS payment_collection_actions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    payment_id UUID NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+    step_id UUID NOT NULL REFERENCES dunning_steps(id) ON DELETE RESTRICT,
+
+    -- Cópia da etapa no momento da execução
+    step_code VARCHAR(50) NOT NULL,
+    step_name VARCHAR(100) NOT NULL,
+    offset_days INTEGER NOT NULL,
+    action VARCHAR(30) NOT NULL CHECK (action IN ('reminder', 'notice', 'formal_notice', 'eviction_review')),
+
+    status VARCHAR(20) NOT NULL CHECK (status IN ('executed', 'skipped')),
+    notifications_created INTEGER NOT NULL DEFAULT 0 CHECK (notifications_created >= 0),
+    notes TEXT,
+    executed_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    -- Cada etapa é executada uma única vez por pagamento
+    UNIQUE (payment_id, step_id)
+);
+
+CREATE INDEX idx_payment_collection_actions_payment_id ON payment_collection_actions(payment_id);
+CREATE INDEX idx_payment_collection_actions_action ON payment_collection_actions(action);
+
+-- Notificação formal de atraso enviada pela régua
+ALTER TABLE notifications DROP CONSTRAINT notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+    CHECK (type IN ('rent_reminder', 'contract_expiring', 'overdue_notice', 'payment_receipt', 'formal_notice'));
+
+-- Régua padrão
+INSERT INTO dunning_steps (code, name, offset_days, action) VALUES
+    ('friendly_reminder', 'Lembrete amigável', -3, 'reminder'),
+    ('first_notice', 'Aviso de atraso', 1, 'notice'),
+    ('second_notice', 'Segundo aviso de atraso', 5, 'notice'),
+    ('formal_notice', 'Notificação formal', 15, 'formal_notice'),
+    ('eviction_review', 'Análise para despejo', 30, 'eviction_review');
+
+-- Comentários explicativos
+COMMENT ON TABLE dunning_steps IS 'Etapas da régua de cobrança, executadas pelo scheduler para pagamentos em aberto';
+COMMENT ON COLUMN dunning_steps.offset_days IS 'Dias em relação ao vencimento (negativo = antes, ex: -3 = D-3)';
+COMMENT ON COLUMN dunning_steps.action IS 'Ação: reminder (lembrete), notice (aviso de atraso), formal_notice (notificação formal), eviction_review (sinaliza análise para despejo)';
+COMMENT ON TABLE payment_collection_actions IS 'Histórico de cobrança: etapas da régua executadas para cada pagamento';
+COMMENT ON COLUMN payment_collection_actions.status IS 'executed (ação realizada) ou skipped (etapa superada por outra mais avançada)';
+COMMENT ON COLUMN notifications.type IS 'Tipo: rent_reminder (lembrete antes do vencimento), contract_expiring (45 dias antes do fim), overdue_notice (pagamento em atraso), payment_receipt (recibo), formal_notice (notificação formal de atraso)';
@@ -0,0 +1,67 @@
+-- Migration: Create outbound webhooks
+-- Description: Endpoints externos inscritos em eventos do sistema e registro das entregas (assinadas com HMAC-SHA256)
+
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    url VARCHAR(500) NOT NULL,
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    secret VARCHAR(100) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER update_webhook_endpoints_updated_at
+    BEFORE UPDATE ON webhook_endpoints
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    endpoint_id UUID NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+    event_type VARCHAR(50) NOT NULL CHECK (event_type IN (
+        'lease.created', 'lease.renewed', 'lease.cancelled',
+        'payment.paid', 'payment.overdue', 'unit.status_changed'
+    )),
+
+    PRIMARY KEY (endpoint_id, event_type)
+);
+
+CREATE INDEX idx_webhook_subscriptions_event_type ON webhook_subscriptions(event_type);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    endpoint_id UUID NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+
+    -- Evento entregue (o mesmo event_id é enviado a todos os endpoints inscritos e nos reenvios)
+    event_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'failed')),
+    attempts INTEGER NOT NULL DEFAULT 0 CHECK (attempts >= 0),
+    next_attempt_at TIMESTAMP,
+    last_error TEXT,
+    response_status INTEGER,
+    delivered_at TIMESTAMP,
+    replay_of UUID REFERENCES webhook_deliveries(id) ON DELETE SET NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_webhook_deliveries_pending ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_endpoint_id ON webhook_deliveries(endpoint_id, created_at DESC);
+CREATE INDEX idx_webhook_deliveries_event_id ON webhook_deliveries(event_id);
+
+CREATE TRIGGER update_webhook_deliveries_updated_at
+    BEFORE UPDATE ON webhook_deliveries
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Comentários explicativos
+COMMENT ON TABLE webhook_endpoints IS 'Integrações externas que recebem os eventos do sistema via HTTP POST';
+COMMENT ON COLUMN webhook_endpoints.secret IS 'Segredo usado na assinatura HMAC-SHA256 enviada no header X-Webhook-Signature';
+COMMENT ON TABLE webhook_deliveries IS 'Registro das entregas de eventos aos endpoints, com novas tentativas (backoff exponencial)';
+COMMENT ON COLUMN webhook_deliveries.status IS 'Fluxo: pending -> delivered (resposta 2xx) ou failed após esgotar as tentativas';
+COMMENT ON COLUMN webhook_deliveries.replay_of IS 'Entrega original quando a entrega foi criada por um reenvio manual';
@@ -0,0 +1,3 @@
+-- Migration DOWN: Remover feeds de calendário
+
+DROP TABLE IF EXISTS calendar_feeds;
@@ -0,0 +1,13 @@
+-- Migration: Create calendar feeds
+-- Description: Tokens de acesso aos feeds iCalendar (.ics) de cada usuário da equipe
+
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    last_accessed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+COMMENT ON TABLE calendar_feeds IS 'Feeds iCalendar por usuário (vencimentos, fim de contratos, entradas e visitas)';
+COMMENT ON COLUMN calendar_feeds.token_hash IS 'Hash SHA-256 do token informado na URL do feed (o token só é exibido ao ser gerado)';
+COMMENT ON COLUMN calendar_feeds.last_accessed_at IS 'Último acesso de um aplicativo de calendário ao feed';
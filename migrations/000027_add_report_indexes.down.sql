@@ -0,0 +1,4 @@
+-- Migration DOWN: Remover índices dos relatórios
+
+DROP INDEX IF EXISTS idx_payments_lease_due_date;
+DROP INDEX IF EXISTS idx_payments_report_date;
@@ -0,0 +1,10 @@
+-- Migration: Add report indexes
+-- Description: Índices por período para as consultas agregadas dos relatórios financeiro e de histórico de pagamentos
+
+-- Data de competência do relatório financeiro: data do pagamento quando pago, senão o vencimento
+CREATE INDEX IF NOT EXISTS idx_payments_report_date ON payments ((CASE WHEN status = 'paid' AND payment_date IS NOT NULL THEN payment_date ELSE due_date END));
+
+-- Histórico de pagamentos por contrato ordenado por vencimento
+CREATE INDEX IF NOT EXISTS idx_payments_lease_due_date ON payments(lease_id, due_date);
+
+COMMENT ON INDEX idx_payments_report_date IS 'Filtro por período do relatório financeiro (payment_date se pago, senão due_date)';
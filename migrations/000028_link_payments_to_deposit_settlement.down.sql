@@ -0,0 +1,5 @@
+-- Migration DOWN: Remover vínculo dos pagamentos com o acerto da caução
+
+DROP INDEX IF EXISTS idx_payments_deposit_settlement_id;
+
+ALTER TABLE payments DROP COLUMN IF EXISTS deposit_settlement_id;
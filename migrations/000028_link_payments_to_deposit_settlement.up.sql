@@ -0,0 +1,9 @@
+-- Migration: Link payments to deposit settlement
+-- Description: Pagamentos em aberto quitados com o desconto da caução no acerto de saída
+
+ALTER TABLE payments
+    ADD COLUMN deposit_settlement_id UUID REFERENCES lease_deposit_settlements(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_payments_deposit_settlement_id ON payments(deposit_settlement_id);
+
+COMMENT ON COLUMN payments.deposit_settlement_id IS 'Acerto da caução que quitou o pagamento';
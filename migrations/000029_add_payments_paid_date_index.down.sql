@@ -0,0 +1,3 @@
+-- Migration DOWN: Remover índice de pagamentos por data de quitação
+
+DROP INDEX IF EXISTS idx_payments_status_payment_date;
@@ -0,0 +1,4 @@
+-- Migration: Add payments paid date index
+-- Description: Índice para buscar os pagamentos quitados a partir de uma data (recibos automáticos)
+
+CREATE INDEX IF NOT EXISTS idx_payments_status_payment_date ON payments(status, payment_date);